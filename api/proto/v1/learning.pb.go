@@ -29,9 +29,13 @@ const (
 type MemoryUnitType int32
 
 const (
-	MemoryUnitType_MEMORY_UNIT_TYPE_UNSPECIFIED MemoryUnitType = 0
-	MemoryUnitType_MEMORY_UNIT_TYPE_HAN_CHAR    MemoryUnitType = 1 // 汉字
-	MemoryUnitType_MEMORY_UNIT_TYPE_WORD        MemoryUnitType = 2 // 单词
+	MemoryUnitType_MEMORY_UNIT_TYPE_UNSPECIFIED   MemoryUnitType = 0
+	MemoryUnitType_MEMORY_UNIT_TYPE_HAN_CHAR      MemoryUnitType = 1 // 汉字
+	MemoryUnitType_MEMORY_UNIT_TYPE_WORD          MemoryUnitType = 2 // 单词
+	MemoryUnitType_MEMORY_UNIT_TYPE_PHRASE        MemoryUnitType = 3 // 短语（含固定搭配、习语）
+	MemoryUnitType_MEMORY_UNIT_TYPE_SENTENCE      MemoryUnitType = 4 // 例句
+	MemoryUnitType_MEMORY_UNIT_TYPE_GRAMMAR_POINT MemoryUnitType = 5 // 语法点
+	MemoryUnitType_MEMORY_UNIT_TYPE_CUSTOM_WORD   MemoryUnitType = 6 // 生词本中的自定义词条
 )
 
 // Enum value maps for MemoryUnitType.
@@ -40,11 +44,19 @@ var (
 		0: "MEMORY_UNIT_TYPE_UNSPECIFIED",
 		1: "MEMORY_UNIT_TYPE_HAN_CHAR",
 		2: "MEMORY_UNIT_TYPE_WORD",
+		3: "MEMORY_UNIT_TYPE_PHRASE",
+		4: "MEMORY_UNIT_TYPE_SENTENCE",
+		5: "MEMORY_UNIT_TYPE_GRAMMAR_POINT",
+		6: "MEMORY_UNIT_TYPE_CUSTOM_WORD",
 	}
 	MemoryUnitType_value = map[string]int32{
-		"MEMORY_UNIT_TYPE_UNSPECIFIED": 0,
-		"MEMORY_UNIT_TYPE_HAN_CHAR":    1,
-		"MEMORY_UNIT_TYPE_WORD":        2,
+		"MEMORY_UNIT_TYPE_UNSPECIFIED":   0,
+		"MEMORY_UNIT_TYPE_HAN_CHAR":      1,
+		"MEMORY_UNIT_TYPE_WORD":          2,
+		"MEMORY_UNIT_TYPE_PHRASE":        3,
+		"MEMORY_UNIT_TYPE_SENTENCE":      4,
+		"MEMORY_UNIT_TYPE_GRAMMAR_POINT": 5,
+		"MEMORY_UNIT_TYPE_CUSTOM_WORD":   6,
 	}
 )
 
@@ -187,6 +199,230 @@ func (ReviewResult) EnumDescriptor() ([]byte, []int) {
 	return file_proto_v1_learning_proto_rawDescGZIP(), []int{2}
 }
 
+// ReviewGrade 复习评分
+type ReviewGrade int32
+
+const (
+	ReviewGrade_REVIEW_GRADE_UNSPECIFIED ReviewGrade = 0 // 未指定，按 result 推断
+	ReviewGrade_REVIEW_GRADE_AGAIN       ReviewGrade = 1 // 忘记
+	ReviewGrade_REVIEW_GRADE_HARD        ReviewGrade = 2 // 困难，犹豫后想起
+	ReviewGrade_REVIEW_GRADE_GOOD        ReviewGrade = 3 // 良好
+	ReviewGrade_REVIEW_GRADE_EASY        ReviewGrade = 4 // 简单，立即想起
+)
+
+// Enum value maps for ReviewGrade.
+var (
+	ReviewGrade_name = map[int32]string{
+		0: "REVIEW_GRADE_UNSPECIFIED",
+		1: "REVIEW_GRADE_AGAIN",
+		2: "REVIEW_GRADE_HARD",
+		3: "REVIEW_GRADE_GOOD",
+		4: "REVIEW_GRADE_EASY",
+	}
+	ReviewGrade_value = map[string]int32{
+		"REVIEW_GRADE_UNSPECIFIED": 0,
+		"REVIEW_GRADE_AGAIN":       1,
+		"REVIEW_GRADE_HARD":        2,
+		"REVIEW_GRADE_GOOD":        3,
+		"REVIEW_GRADE_EASY":        4,
+	}
+)
+
+func (x ReviewGrade) Enum() *ReviewGrade {
+	p := new(ReviewGrade)
+	*p = x
+	return p
+}
+
+func (x ReviewGrade) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReviewGrade) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_v1_learning_proto_enumTypes[3].Descriptor()
+}
+
+func (ReviewGrade) Type() protoreflect.EnumType {
+	return &file_proto_v1_learning_proto_enumTypes[3]
+}
+
+func (x ReviewGrade) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReviewGrade.Descriptor instead.
+func (ReviewGrade) EnumDescriptor() ([]byte, []int) {
+	return file_proto_v1_learning_proto_rawDescGZIP(), []int{3}
+}
+
+// ReviewAction 复习记录的操作类型
+type ReviewAction int32
+
+const (
+	ReviewAction_REVIEW_ACTION_REVIEW      ReviewAction = 0 // 复习
+	ReviewAction_REVIEW_ACTION_RESET       ReviewAction = 1 // 重置为新内容
+	ReviewAction_REVIEW_ACTION_BURY        ReviewAction = 2 // 搁置到下一个学习日
+	ReviewAction_REVIEW_ACTION_RESCHEDULE  ReviewAction = 3 // 调整复习日期
+	ReviewAction_REVIEW_ACTION_SET_MASTERY ReviewAction = 4 // 手动设置掌握程度
+)
+
+// Enum value maps for ReviewAction.
+var (
+	ReviewAction_name = map[int32]string{
+		0: "REVIEW_ACTION_REVIEW",
+		1: "REVIEW_ACTION_RESET",
+		2: "REVIEW_ACTION_BURY",
+		3: "REVIEW_ACTION_RESCHEDULE",
+		4: "REVIEW_ACTION_SET_MASTERY",
+	}
+	ReviewAction_value = map[string]int32{
+		"REVIEW_ACTION_REVIEW":      0,
+		"REVIEW_ACTION_RESET":       1,
+		"REVIEW_ACTION_BURY":        2,
+		"REVIEW_ACTION_RESCHEDULE":  3,
+		"REVIEW_ACTION_SET_MASTERY": 4,
+	}
+)
+
+func (x ReviewAction) Enum() *ReviewAction {
+	p := new(ReviewAction)
+	*p = x
+	return p
+}
+
+func (x ReviewAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReviewAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_v1_learning_proto_enumTypes[4].Descriptor()
+}
+
+func (ReviewAction) Type() protoreflect.EnumType {
+	return &file_proto_v1_learning_proto_enumTypes[4]
+}
+
+func (x ReviewAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReviewAction.Descriptor instead.
+func (ReviewAction) EnumDescriptor() ([]byte, []int) {
+	return file_proto_v1_learning_proto_rawDescGZIP(), []int{4}
+}
+
+// BatchReviewStatus 批量复习中单条复习的处理状态
+type BatchReviewStatus int32
+
+const (
+	BatchReviewStatus_BATCH_REVIEW_STATUS_UNSPECIFIED BatchReviewStatus = 0 // 未指定
+	BatchReviewStatus_BATCH_REVIEW_STATUS_APPLIED     BatchReviewStatus = 1 // 已应用
+	BatchReviewStatus_BATCH_REVIEW_STATUS_DUPLICATE   BatchReviewStatus = 2 // 幂等键已提交过，未重复应用
+	BatchReviewStatus_BATCH_REVIEW_STATUS_CONFLICT    BatchReviewStatus = 3 // 早于该内容最近一次复习的时间，未应用
+	BatchReviewStatus_BATCH_REVIEW_STATUS_NOT_FOUND   BatchReviewStatus = 4 // 复习内容不存在
+	BatchReviewStatus_BATCH_REVIEW_STATUS_INVALID     BatchReviewStatus = 5 // 参数无效
+)
+
+// Enum value maps for BatchReviewStatus.
+var (
+	BatchReviewStatus_name = map[int32]string{
+		0: "BATCH_REVIEW_STATUS_UNSPECIFIED",
+		1: "BATCH_REVIEW_STATUS_APPLIED",
+		2: "BATCH_REVIEW_STATUS_DUPLICATE",
+		3: "BATCH_REVIEW_STATUS_CONFLICT",
+		4: "BATCH_REVIEW_STATUS_NOT_FOUND",
+		5: "BATCH_REVIEW_STATUS_INVALID",
+	}
+	BatchReviewStatus_value = map[string]int32{
+		"BATCH_REVIEW_STATUS_UNSPECIFIED": 0,
+		"BATCH_REVIEW_STATUS_APPLIED":     1,
+		"BATCH_REVIEW_STATUS_DUPLICATE":   2,
+		"BATCH_REVIEW_STATUS_CONFLICT":    3,
+		"BATCH_REVIEW_STATUS_NOT_FOUND":   4,
+		"BATCH_REVIEW_STATUS_INVALID":     5,
+	}
+)
+
+func (x BatchReviewStatus) Enum() *BatchReviewStatus {
+	p := new(BatchReviewStatus)
+	*p = x
+	return p
+}
+
+func (x BatchReviewStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BatchReviewStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_v1_learning_proto_enumTypes[5].Descriptor()
+}
+
+func (BatchReviewStatus) Type() protoreflect.EnumType {
+	return &file_proto_v1_learning_proto_enumTypes[5]
+}
+
+func (x BatchReviewStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BatchReviewStatus.Descriptor instead.
+func (BatchReviewStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_v1_learning_proto_rawDescGZIP(), []int{5}
+}
+
+// HanCharQuestionType 汉字测试题型
+type HanCharQuestionType int32
+
+const (
+	HanCharQuestionType_HAN_CHAR_QUESTION_TYPE_UNSPECIFIED     HanCharQuestionType = 0
+	HanCharQuestionType_HAN_CHAR_QUESTION_TYPE_CHAR_TO_PINYIN  HanCharQuestionType = 1 // 看字选拼音
+	HanCharQuestionType_HAN_CHAR_QUESTION_TYPE_PINYIN_TO_CHAR  HanCharQuestionType = 2 // 看拼音选字
+	HanCharQuestionType_HAN_CHAR_QUESTION_TYPE_CHAR_TO_EXAMPLE HanCharQuestionType = 3 // 看字选含该字的词语
+)
+
+// Enum value maps for HanCharQuestionType.
+var (
+	HanCharQuestionType_name = map[int32]string{
+		0: "HAN_CHAR_QUESTION_TYPE_UNSPECIFIED",
+		1: "HAN_CHAR_QUESTION_TYPE_CHAR_TO_PINYIN",
+		2: "HAN_CHAR_QUESTION_TYPE_PINYIN_TO_CHAR",
+		3: "HAN_CHAR_QUESTION_TYPE_CHAR_TO_EXAMPLE",
+	}
+	HanCharQuestionType_value = map[string]int32{
+		"HAN_CHAR_QUESTION_TYPE_UNSPECIFIED":     0,
+		"HAN_CHAR_QUESTION_TYPE_CHAR_TO_PINYIN":  1,
+		"HAN_CHAR_QUESTION_TYPE_PINYIN_TO_CHAR":  2,
+		"HAN_CHAR_QUESTION_TYPE_CHAR_TO_EXAMPLE": 3,
+	}
+)
+
+func (x HanCharQuestionType) Enum() *HanCharQuestionType {
+	p := new(HanCharQuestionType)
+	*p = x
+	return p
+}
+
+func (x HanCharQuestionType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HanCharQuestionType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_v1_learning_proto_enumTypes[6].Descriptor()
+}
+
+func (HanCharQuestionType) Type() protoreflect.EnumType {
+	return &file_proto_v1_learning_proto_enumTypes[6]
+}
+
+func (x HanCharQuestionType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HanCharQuestionType.Descriptor instead.
+func (HanCharQuestionType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_v1_learning_proto_rawDescGZIP(), []int{6}
+}
+
 // MemoryUnit 记忆单元
 type MemoryUnit struct {
 	state     protoimpl.MessageState `protogen:"open.v1"`
@@ -205,8 +441,12 @@ type MemoryUnit struct {
 	RetentionRate      float32                `protobuf:"fixed32,12,opt,name=retention_rate,json=retentionRate,proto3" json:"retention_rate,omitempty"`                       // 记忆保持率（0-1）
 	ConsecutiveCorrect uint32                 `protobuf:"varint,13,opt,name=consecutive_correct,json=consecutiveCorrect,proto3" json:"consecutive_correct,omitempty"`         // 连续正确次数
 	ConsecutiveWrong   uint32                 `protobuf:"varint,14,opt,name=consecutive_wrong,json=consecutiveWrong,proto3" json:"consecutive_wrong,omitempty"`               // 连续错误次数
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+	// 顽固项状态
+	Lapses        uint32 `protobuf:"varint,15,opt,name=lapses,proto3" json:"lapses,omitempty"`                  // 遗忘次数
+	IsLeech       bool   `protobuf:"varint,16,opt,name=is_leech,json=isLeech,proto3" json:"is_leech,omitempty"` // 是否为顽固项
+	Suspended     bool   `protobuf:"varint,17,opt,name=suspended,proto3" json:"suspended,omitempty"`            // 是否已暂停复习
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *MemoryUnit) Reset() {
@@ -337,6 +577,27 @@ func (x *MemoryUnit) GetConsecutiveWrong() uint32 {
 	return 0
 }
 
+func (x *MemoryUnit) GetLapses() uint32 {
+	if x != nil {
+		return x.Lapses
+	}
+	return 0
+}
+
+func (x *MemoryUnit) GetIsLeech() bool {
+	if x != nil {
+		return x.IsLeech
+	}
+	return false
+}
+
+func (x *MemoryUnit) GetSuspended() bool {
+	if x != nil {
+		return x.Suspended
+	}
+	return false
+}
+
 // ReviewInterval 复习间隔
 type ReviewInterval struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -1112,12 +1373,13 @@ func (x *ListMemoriesForReviewResponse) GetTotal() uint32 {
 }
 
 type GetMemoryStatsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Type          *MemoryUnitType        `protobuf:"varint,1,opt,name=type,proto3,enum=proto.v1.MemoryUnitType,oneof" json:"type,omitempty"` // 可选的记忆单元类型过滤
-	Tag           *string                `protobuf:"bytes,2,opt,name=tag,proto3,oneof" json:"tag,omitempty"`                                 // 可选的标签过滤
-	Category      *string                `protobuf:"bytes,3,opt,name=category,proto3,oneof" json:"category,omitempty"`                       // 可选的分类过滤
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Type             *MemoryUnitType        `protobuf:"varint,1,opt,name=type,proto3,enum=proto.v1.MemoryUnitType,oneof" json:"type,omitempty"`                     // 可选的记忆单元类型过滤，未指定时汇总全部类型
+	Tag              *string                `protobuf:"bytes,2,opt,name=tag,proto3,oneof" json:"tag,omitempty"`                                                     // 可选的标签过滤
+	Category         *string                `protobuf:"bytes,3,opt,name=category,proto3,oneof" json:"category,omitempty"`                                           // 可选的分类过滤
+	RetentionWindows []uint32               `protobuf:"varint,4,rep,packed,name=retention_windows,json=retentionWindows,proto3" json:"retention_windows,omitempty"` // 实际记忆保持率的统计窗口（1-365天），默认 7、30、90 天
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *GetMemoryStatsRequest) Reset() {
@@ -1171,6 +1433,91 @@ func (x *GetMemoryStatsRequest) GetCategory() string {
 	return ""
 }
 
+func (x *GetMemoryStatsRequest) GetRetentionWindows() []uint32 {
+	if x != nil {
+		return x.RetentionWindows
+	}
+	return nil
+}
+
+// RetentionWindow 一个统计窗口内的实际记忆保持率
+// 保持率为到期复习（计划间隔不少于一天且未提前）中成功回忆的比例，不含首次学习和当天的重学
+type RetentionWindow struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Days          uint32                 `protobuf:"varint,1,opt,name=days,proto3" json:"days,omitempty"`                                                                                                             // 统计最近多少天
+	ReviewCount   uint32                 `protobuf:"varint,2,opt,name=review_count,json=reviewCount,proto3" json:"review_count,omitempty"`                                                                            // 到期复习次数
+	CorrectCount  uint32                 `protobuf:"varint,3,opt,name=correct_count,json=correctCount,proto3" json:"correct_count,omitempty"`                                                                         // 成功回忆的次数
+	Rate          float32                `protobuf:"fixed32,4,opt,name=rate,proto3" json:"rate,omitempty"`                                                                                                            // 实际记忆保持率（0-1），没有到期复习时为 0
+	RateByType    map[uint32]float32     `protobuf:"bytes,5,rep,name=rate_by_type,json=rateByType,proto3" json:"rate_by_type,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"fixed32,2,opt,name=value"` // 各类型的实际记忆保持率，key 为记忆单元类型
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetentionWindow) Reset() {
+	*x = RetentionWindow{}
+	mi := &file_proto_v1_learning_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetentionWindow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetentionWindow) ProtoMessage() {}
+
+func (x *RetentionWindow) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_learning_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetentionWindow.ProtoReflect.Descriptor instead.
+func (*RetentionWindow) Descriptor() ([]byte, []int) {
+	return file_proto_v1_learning_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RetentionWindow) GetDays() uint32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+func (x *RetentionWindow) GetReviewCount() uint32 {
+	if x != nil {
+		return x.ReviewCount
+	}
+	return 0
+}
+
+func (x *RetentionWindow) GetCorrectCount() uint32 {
+	if x != nil {
+		return x.CorrectCount
+	}
+	return 0
+}
+
+func (x *RetentionWindow) GetRate() float32 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *RetentionWindow) GetRateByType() map[uint32]float32 {
+	if x != nil {
+		return x.RateByType
+	}
+	return nil
+}
+
 type GetMemoryStatsResponse struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	TotalLearned    uint32                 `protobuf:"varint,1,opt,name=total_learned,json=totalLearned,proto3" json:"total_learned,omitempty"`                                                                                   // 已学习总数
@@ -1178,14 +1525,16 @@ type GetMemoryStatsResponse struct {
 	NeedReviewCount uint32                 `protobuf:"varint,3,opt,name=need_review_count,json=needReviewCount,proto3" json:"need_review_count,omitempty"`                                                                        // 需要复习数量
 	TotalStudyTime  uint32                 `protobuf:"varint,4,opt,name=total_study_time,json=totalStudyTime,proto3" json:"total_study_time,omitempty"`                                                                           // 总学习时长（秒）
 	LevelStats      map[uint32]uint32      `protobuf:"bytes,5,rep,name=level_stats,json=levelStats,proto3" json:"level_stats,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`              // 各掌握程度统计
-	RetentionRates  map[uint32]float32     `protobuf:"bytes,6,rep,name=retention_rates,json=retentionRates,proto3" json:"retention_rates,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"fixed32,2,opt,name=value"` // 各类型记忆保持率
+	RetentionRates  map[uint32]float32     `protobuf:"bytes,6,rep,name=retention_rates,json=retentionRates,proto3" json:"retention_rates,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"fixed32,2,opt,name=value"` // 各类型实际记忆保持率，按最长的统计窗口计算
+	Retention       []*RetentionWindow     `protobuf:"bytes,7,rep,name=retention,proto3" json:"retention,omitempty"`                                                                                                              // 各统计窗口的实际记忆保持率，与请求的窗口一一对应
+	TypeStats       map[uint32]uint32      `protobuf:"bytes,8,rep,name=type_stats,json=typeStats,proto3" json:"type_stats,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`                 // 各类型的数量，key 为记忆单元类型
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
 
 func (x *GetMemoryStatsResponse) Reset() {
 	*x = GetMemoryStatsResponse{}
-	mi := &file_proto_v1_learning_proto_msgTypes[17]
+	mi := &file_proto_v1_learning_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1197,7 +1546,7 @@ func (x *GetMemoryStatsResponse) String() string {
 func (*GetMemoryStatsResponse) ProtoMessage() {}
 
 func (x *GetMemoryStatsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_learning_proto_msgTypes[17]
+	mi := &file_proto_v1_learning_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1210,7 +1559,7 @@ func (x *GetMemoryStatsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMemoryStatsResponse.ProtoReflect.Descriptor instead.
 func (*GetMemoryStatsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_v1_learning_proto_rawDescGZIP(), []int{17}
+	return file_proto_v1_learning_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *GetMemoryStatsResponse) GetTotalLearned() uint32 {
@@ -1255,19 +1604,35 @@ func (x *GetMemoryStatsResponse) GetRetentionRates() map[uint32]float32 {
 	return nil
 }
 
+func (x *GetMemoryStatsResponse) GetRetention() []*RetentionWindow {
+	if x != nil {
+		return x.Retention
+	}
+	return nil
+}
+
+func (x *GetMemoryStatsResponse) GetTypeStats() map[uint32]uint32 {
+	if x != nil {
+		return x.TypeStats
+	}
+	return nil
+}
+
 // ReviewWordRequest 复习单词请求
 type ReviewWordRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	WordId        uint32                 `protobuf:"varint,1,opt,name=word_id,json=wordId,proto3" json:"word_id,omitempty"`                   // 单词ID (对应 MemoryUnit 的 ContentID)
-	Result        ReviewResult           `protobuf:"varint,2,opt,name=result,proto3,enum=proto.v1.ReviewResult" json:"result,omitempty"`      // 复习结果
+	Result        ReviewResult           `protobuf:"varint,2,opt,name=result,proto3,enum=proto.v1.ReviewResult" json:"result,omitempty"`      // 复习结果，为 REVIEW_RESULT_SKIP 时忽略 grade
 	ResponseTime  uint32                 `protobuf:"varint,3,opt,name=response_time,json=responseTime,proto3" json:"response_time,omitempty"` // 响应时间（毫秒）
+	Grade         ReviewGrade            `protobuf:"varint,4,opt,name=grade,proto3,enum=proto.v1.ReviewGrade" json:"grade,omitempty"`         // 复习评分，未指定时按 result 推断（正确为良好，错误为忘记）
+	SessionId     uint32                 `protobuf:"varint,5,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`          // 所属学习会话ID，不在学习会话中时为0
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ReviewWordRequest) Reset() {
 	*x = ReviewWordRequest{}
-	mi := &file_proto_v1_learning_proto_msgTypes[18]
+	mi := &file_proto_v1_learning_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1279,7 +1644,7 @@ func (x *ReviewWordRequest) String() string {
 func (*ReviewWordRequest) ProtoMessage() {}
 
 func (x *ReviewWordRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_learning_proto_msgTypes[18]
+	mi := &file_proto_v1_learning_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1292,7 +1657,7 @@ func (x *ReviewWordRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReviewWordRequest.ProtoReflect.Descriptor instead.
 func (*ReviewWordRequest) Descriptor() ([]byte, []int) {
-	return file_proto_v1_learning_proto_rawDescGZIP(), []int{18}
+	return file_proto_v1_learning_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *ReviewWordRequest) GetWordId() uint32 {
@@ -1316,6 +1681,20 @@ func (x *ReviewWordRequest) GetResponseTime() uint32 {
 	return 0
 }
 
+func (x *ReviewWordRequest) GetGrade() ReviewGrade {
+	if x != nil {
+		return x.Grade
+	}
+	return ReviewGrade_REVIEW_GRADE_UNSPECIFIED
+}
+
+func (x *ReviewWordRequest) GetSessionId() uint32 {
+	if x != nil {
+		return x.SessionId
+	}
+	return 0
+}
+
 // ReviewWordResponse 复习单词响应
 type ReviewWordResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -1325,7 +1704,7 @@ type ReviewWordResponse struct {
 
 func (x *ReviewWordResponse) Reset() {
 	*x = ReviewWordResponse{}
-	mi := &file_proto_v1_learning_proto_msgTypes[19]
+	mi := &file_proto_v1_learning_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1337,7 +1716,7 @@ func (x *ReviewWordResponse) String() string {
 func (*ReviewWordResponse) ProtoMessage() {}
 
 func (x *ReviewWordResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_learning_proto_msgTypes[19]
+	mi := &file_proto_v1_learning_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1350,22 +1729,24 @@ func (x *ReviewWordResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReviewWordResponse.ProtoReflect.Descriptor instead.
 func (*ReviewWordResponse) Descriptor() ([]byte, []int) {
-	return file_proto_v1_learning_proto_rawDescGZIP(), []int{19}
+	return file_proto_v1_learning_proto_rawDescGZIP(), []int{20}
 }
 
 // ReviewHanCharRequest 复习汉字请求
 type ReviewHanCharRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	HanCharId     uint32                 `protobuf:"varint,1,opt,name=han_char_id,json=hanCharId,proto3" json:"han_char_id,omitempty"`        // 汉字ID (对应 MemoryUnit 的 ContentID)
-	Result        ReviewResult           `protobuf:"varint,2,opt,name=result,proto3,enum=proto.v1.ReviewResult" json:"result,omitempty"`      // 复习结果
+	Result        ReviewResult           `protobuf:"varint,2,opt,name=result,proto3,enum=proto.v1.ReviewResult" json:"result,omitempty"`      // 复习结果，为 REVIEW_RESULT_SKIP 时忽略 grade
 	ResponseTime  uint32                 `protobuf:"varint,3,opt,name=response_time,json=responseTime,proto3" json:"response_time,omitempty"` // 响应时间（毫秒）
+	Grade         ReviewGrade            `protobuf:"varint,4,opt,name=grade,proto3,enum=proto.v1.ReviewGrade" json:"grade,omitempty"`         // 复习评分，未指定时按 result 推断（正确为良好，错误为忘记）
+	SessionId     uint32                 `protobuf:"varint,5,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`          // 所属学习会话ID，不在学习会话中时为0
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ReviewHanCharRequest) Reset() {
 	*x = ReviewHanCharRequest{}
-	mi := &file_proto_v1_learning_proto_msgTypes[20]
+	mi := &file_proto_v1_learning_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1377,7 +1758,7 @@ func (x *ReviewHanCharRequest) String() string {
 func (*ReviewHanCharRequest) ProtoMessage() {}
 
 func (x *ReviewHanCharRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_learning_proto_msgTypes[20]
+	mi := &file_proto_v1_learning_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1390,7 +1771,7 @@ func (x *ReviewHanCharRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReviewHanCharRequest.ProtoReflect.Descriptor instead.
 func (*ReviewHanCharRequest) Descriptor() ([]byte, []int) {
-	return file_proto_v1_learning_proto_rawDescGZIP(), []int{20}
+	return file_proto_v1_learning_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *ReviewHanCharRequest) GetHanCharId() uint32 {
@@ -1414,6 +1795,20 @@ func (x *ReviewHanCharRequest) GetResponseTime() uint32 {
 	return 0
 }
 
+func (x *ReviewHanCharRequest) GetGrade() ReviewGrade {
+	if x != nil {
+		return x.Grade
+	}
+	return ReviewGrade_REVIEW_GRADE_UNSPECIFIED
+}
+
+func (x *ReviewHanCharRequest) GetSessionId() uint32 {
+	if x != nil {
+		return x.SessionId
+	}
+	return 0
+}
+
 // ReviewHanCharResponse 复习汉字响应
 type ReviewHanCharResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -1423,7 +1818,7 @@ type ReviewHanCharResponse struct {
 
 func (x *ReviewHanCharResponse) Reset() {
 	*x = ReviewHanCharResponse{}
-	mi := &file_proto_v1_learning_proto_msgTypes[21]
+	mi := &file_proto_v1_learning_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1435,7 +1830,7 @@ func (x *ReviewHanCharResponse) String() string {
 func (*ReviewHanCharResponse) ProtoMessage() {}
 
 func (x *ReviewHanCharResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_learning_proto_msgTypes[21]
+	mi := &file_proto_v1_learning_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1448,38 +1843,37 @@ func (x *ReviewHanCharResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReviewHanCharResponse.ProtoReflect.Descriptor instead.
 func (*ReviewHanCharResponse) Descriptor() ([]byte, []int) {
-	return file_proto_v1_learning_proto_rawDescGZIP(), []int{21}
+	return file_proto_v1_learning_proto_rawDescGZIP(), []int{22}
 }
 
-// MemoryReview 记忆复习记录
-type MemoryReview struct {
+// ReviewRequest 复习学习内容请求
+type ReviewRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                           // 记忆复习记录ID
-	MemoryUnitId  uint32                 `protobuf:"varint,2,opt,name=memory_unit_id,json=memoryUnitId,proto3" json:"memory_unit_id,omitempty"` // 记忆单元ID
-	UserId        uint32                 `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                     // 用户ID
-	Result        ReviewResult           `protobuf:"varint,4,opt,name=result,proto3,enum=proto.v1.ReviewResult" json:"result,omitempty"`        // 复习结果
-	ResponseTime  uint32                 `protobuf:"varint,5,opt,name=response_time,json=responseTime,proto3" json:"response_time,omitempty"`   // 响应时间（毫秒）
-	ReviewTime    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=review_time,json=reviewTime,proto3" json:"review_time,omitempty"`          // 复习时间
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`             // 创建时间
+	Type          MemoryUnitType         `protobuf:"varint,1,opt,name=type,proto3,enum=proto.v1.MemoryUnitType" json:"type,omitempty"`        // 记忆单元类型
+	ContentId     uint32                 `protobuf:"varint,2,opt,name=content_id,json=contentId,proto3" json:"content_id,omitempty"`          // 内容ID (对应 MemoryUnit 的 ContentID)
+	Result        ReviewResult           `protobuf:"varint,3,opt,name=result,proto3,enum=proto.v1.ReviewResult" json:"result,omitempty"`      // 复习结果，为 REVIEW_RESULT_SKIP 时忽略 grade
+	Grade         ReviewGrade            `protobuf:"varint,4,opt,name=grade,proto3,enum=proto.v1.ReviewGrade" json:"grade,omitempty"`         // 复习评分，未指定时按 result 推断（正确为良好，错误为忘记）
+	ResponseTime  uint32                 `protobuf:"varint,5,opt,name=response_time,json=responseTime,proto3" json:"response_time,omitempty"` // 响应时间（毫秒）
+	SessionId     uint32                 `protobuf:"varint,6,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`          // 所属学习会话ID，不在学习会话中时为0
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MemoryReview) Reset() {
-	*x = MemoryReview{}
-	mi := &file_proto_v1_learning_proto_msgTypes[22]
+func (x *ReviewRequest) Reset() {
+	*x = ReviewRequest{}
+	mi := &file_proto_v1_learning_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MemoryReview) String() string {
+func (x *ReviewRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MemoryReview) ProtoMessage() {}
+func (*ReviewRequest) ProtoMessage() {}
 
-func (x *MemoryReview) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_learning_proto_msgTypes[22]
+func (x *ReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_learning_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1490,58 +1884,2289 @@ func (x *MemoryReview) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MemoryReview.ProtoReflect.Descriptor instead.
-func (*MemoryReview) Descriptor() ([]byte, []int) {
-	return file_proto_v1_learning_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use ReviewRequest.ProtoReflect.Descriptor instead.
+func (*ReviewRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_learning_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ReviewRequest) GetType() MemoryUnitType {
+	if x != nil {
+		return x.Type
+	}
+	return MemoryUnitType_MEMORY_UNIT_TYPE_UNSPECIFIED
+}
+
+func (x *ReviewRequest) GetContentId() uint32 {
+	if x != nil {
+		return x.ContentId
+	}
+	return 0
+}
+
+func (x *ReviewRequest) GetResult() ReviewResult {
+	if x != nil {
+		return x.Result
+	}
+	return ReviewResult_REVIEW_RESULT_UNSPECIFIED
+}
+
+func (x *ReviewRequest) GetGrade() ReviewGrade {
+	if x != nil {
+		return x.Grade
+	}
+	return ReviewGrade_REVIEW_GRADE_UNSPECIFIED
+}
+
+func (x *ReviewRequest) GetResponseTime() uint32 {
+	if x != nil {
+		return x.ResponseTime
+	}
+	return 0
+}
+
+func (x *ReviewRequest) GetSessionId() uint32 {
+	if x != nil {
+		return x.SessionId
+	}
+	return 0
+}
+
+// ReviewResponse 复习学习内容响应
+type ReviewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewResponse) Reset() {
+	*x = ReviewResponse{}
+	mi := &file_proto_v1_learning_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewResponse) ProtoMessage() {}
+
+func (x *ReviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_learning_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewResponse.ProtoReflect.Descriptor instead.
+func (*ReviewResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_learning_proto_rawDescGZIP(), []int{24}
+}
+
+// MemoryReview 记忆复习记录
+type MemoryReview struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Id                  uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                                                        // 记忆复习记录ID
+	MemoryUnitId        uint32                 `protobuf:"varint,2,opt,name=memory_unit_id,json=memoryUnitId,proto3" json:"memory_unit_id,omitempty"`                              // 记忆单元ID
+	UserId              uint32                 `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                                                  // 用户ID
+	Result              ReviewResult           `protobuf:"varint,4,opt,name=result,proto3,enum=proto.v1.ReviewResult" json:"result,omitempty"`                                     // 复习结果
+	ResponseTime        uint32                 `protobuf:"varint,5,opt,name=response_time,json=responseTime,proto3" json:"response_time,omitempty"`                                // 响应时间（毫秒）
+	ReviewTime          *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=review_time,json=reviewTime,proto3" json:"review_time,omitempty"`                                       // 复习时间
+	CreatedAt           *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                                          // 创建时间
+	Grade               ReviewGrade            `protobuf:"varint,8,opt,name=grade,proto3,enum=proto.v1.ReviewGrade" json:"grade,omitempty"`                                        // 复习评分，跳过时为未指定
+	ScheduledReviewTime *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=scheduled_review_time,json=scheduledReviewTime,proto3" json:"scheduled_review_time,omitempty"`          // 原定的复习时间
+	MasteryBefore       MasteryLevel           `protobuf:"varint,10,opt,name=mastery_before,json=masteryBefore,proto3,enum=proto.v1.MasteryLevel" json:"mastery_before,omitempty"` // 复习前的掌握程度
+	MasteryAfter        MasteryLevel           `protobuf:"varint,11,opt,name=mastery_after,json=masteryAfter,proto3,enum=proto.v1.MasteryLevel" json:"mastery_after,omitempty"`    // 复习后的掌握程度
+	IntervalBefore      uint32                 `protobuf:"varint,12,opt,name=interval_before,json=intervalBefore,proto3" json:"interval_before,omitempty"`                         // 复习前的计划间隔（秒）
+	IntervalAfter       uint32                 `protobuf:"varint,13,opt,name=interval_after,json=intervalAfter,proto3" json:"interval_after,omitempty"`                            // 复习后的新间隔（秒）
+	Action              ReviewAction           `protobuf:"varint,14,opt,name=action,proto3,enum=proto.v1.ReviewAction" json:"action,omitempty"`                                    // 操作类型，人工调整记录的评分和结果为未指定
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *MemoryReview) Reset() {
+	*x = MemoryReview{}
+	mi := &file_proto_v1_learning_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemoryReview) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoryReview) ProtoMessage() {}
+
+func (x *MemoryReview) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_learning_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoryReview.ProtoReflect.Descriptor instead.
+func (*MemoryReview) Descriptor() ([]byte, []int) {
+	return file_proto_v1_learning_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *MemoryReview) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MemoryReview) GetMemoryUnitId() uint32 {
+	if x != nil {
+		return x.MemoryUnitId
+	}
+	return 0
+}
+
+func (x *MemoryReview) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *MemoryReview) GetResult() ReviewResult {
+	if x != nil {
+		return x.Result
+	}
+	return ReviewResult_REVIEW_RESULT_UNSPECIFIED
+}
+
+func (x *MemoryReview) GetResponseTime() uint32 {
+	if x != nil {
+		return x.ResponseTime
+	}
+	return 0
+}
+
+func (x *MemoryReview) GetReviewTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReviewTime
+	}
+	return nil
+}
+
+func (x *MemoryReview) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *MemoryReview) GetGrade() ReviewGrade {
+	if x != nil {
+		return x.Grade
+	}
+	return ReviewGrade_REVIEW_GRADE_UNSPECIFIED
+}
+
+func (x *MemoryReview) GetScheduledReviewTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScheduledReviewTime
+	}
+	return nil
+}
+
+func (x *MemoryReview) GetMasteryBefore() MasteryLevel {
+	if x != nil {
+		return x.MasteryBefore
+	}
+	return MasteryLevel_MASTERY_LEVEL_UNSPECIFIED
+}
+
+func (x *MemoryReview) GetMasteryAfter() MasteryLevel {
+	if x != nil {
+		return x.MasteryAfter
+	}
+	return MasteryLevel_MASTERY_LEVEL_UNSPECIFIED
+}
+
+func (x *MemoryReview) GetIntervalBefore() uint32 {
+	if x != nil {
+		return x.IntervalBefore
+	}
+	return 0
+}
+
+func (x *MemoryReview) GetIntervalAfter() uint32 {
+	if x != nil {
+		return x.IntervalAfter
+	}
+	return 0
+}
+
+func (x *MemoryReview) GetAction() ReviewAction {
+	if x != nil {
+		return x.Action
+	}
+	return ReviewAction_REVIEW_ACTION_REVIEW
+}
+
+// ListMemoryReviewsRequest 获取记忆单元复习记录请求
+type ListMemoryReviewsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MemoryUnitId  uint32                 `protobuf:"varint,1,opt,name=memory_unit_id,json=memoryUnitId,proto3" json:"memory_unit_id,omitempty"` // 记忆单元ID
+	Page          uint32                 `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`                                       // 页码，从1开始
+	PageSize      uint32                 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`               // 每页数量，默认20，最大100
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemoryReviewsRequest) Reset() {
+	*x = ListMemoryReviewsRequest{}
+	mi := &file_proto_v1_learning_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemoryReviewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemoryReviewsRequest) ProtoMessage() {}
+
+func (x *ListMemoryReviewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_learning_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemoryReviewsRequest.ProtoReflect.Descriptor instead.
+func (*ListMemoryReviewsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_learning_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListMemoryReviewsRequest) GetMemoryUnitId() uint32 {
+	if x != nil {
+		return x.MemoryUnitId
+	}
+	return 0
+}
+
+func (x *ListMemoryReviewsRequest) GetPage() uint32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListMemoryReviewsRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// ListMemoryReviewsResponse 获取记忆单元复习记录响应
+type ListMemoryReviewsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reviews       []*MemoryReview        `protobuf:"bytes,1,rep,name=reviews,proto3" json:"reviews,omitempty"` // 复习记录，按复习时间升序
+	Total         uint32                 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`    // 总数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMemoryReviewsResponse) Reset() {
+	*x = ListMemoryReviewsResponse{}
+	mi := &file_proto_v1_learning_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMemoryReviewsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMemoryReviewsResponse) ProtoMessage() {}
+
+func (x *ListMemoryReviewsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_learning_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMemoryReviewsResponse.ProtoReflect.Descriptor instead.
+func (*ListMemoryReviewsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_learning_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListMemoryReviewsResponse) GetReviews() []*MemoryReview {
+	if x != nil {
+		return x.Reviews
+	}
+	return nil
+}
+
+func (x *ListMemoryReviewsResponse) GetTotal() uint32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// StudyItem 学习队列中的学习项
+type StudyItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          MemoryUnitType         `protobuf:"varint,1,opt,name=type,proto3,enum=proto.v1.MemoryUnitType" json:"type,omitempty"`          // 记忆单元类型
+	ContentId     uint32                 `protobuf:"varint,2,opt,name=content_id,json=contentId,proto3" json:"content_id,omitempty"`            // 内容ID（单词ID或汉字ID）
+	MemoryUnitId  uint32                 `protobuf:"varint,3,opt,name=memory_unit_id,json=memoryUnitId,proto3" json:"memory_unit_id,omitempty"` // 记忆单元ID，新学习项为0
+	IsNew         bool                   `protobuf:"varint,4,opt,name=is_new,json=isNew,proto3" json:"is_new,omitempty"`                        // 是否为新学习项
+	Text          string                 `protobuf:"bytes,5,opt,name=text,proto3" json:"text,omitempty"`                                        // 学习内容（单词拼写或汉字）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StudyItem) Reset() {
+	*x = StudyItem{}
+	mi := &file_proto_v1_learning_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StudyItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StudyItem) ProtoMessage() {}
+
+func (x *StudyItem) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_learning_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StudyItem.ProtoReflect.Descriptor instead.
+func (*StudyItem) Descriptor() ([]byte, []int) {
+	return file_proto_v1_learning_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *StudyItem) GetType() MemoryUnitType {
+	if x != nil {
+		return x.Type
+	}
+	return MemoryUnitType_MEMORY_UNIT_TYPE_UNSPECIFIED
+}
+
+func (x *StudyItem) GetContentId() uint32 {
+	if x != nil {
+		return x.ContentId
+	}
+	return 0
+}
+
+func (x *StudyItem) GetMemoryUnitId() uint32 {
+	if x != nil {
+		return x.MemoryUnitId
+	}
+	return 0
+}
+
+func (x *StudyItem) GetIsNew() bool {
+	if x != nil {
+		return x.IsNew
+	}
+	return false
+}
+
+func (x *StudyItem) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+// StartStudySessionRequest 开始学习会话请求
+type StartStudySessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Types         []MemoryUnitType       `protobuf:"varint,1,rep,packed,name=types,proto3,enum=proto.v1.MemoryUnitType" json:"types,omitempty"` // 学习内容类型，为空时使用用户偏好的类型，未设置偏好时包含全部类型
+	NewCount      *uint32                `protobuf:"varint,2,opt,name=new_count,json=newCount,proto3,oneof" json:"new_count,omitempty"`         // 新学习项数量，未设置时使用每日新学目标的剩余量
+	Level         WordDifficultyLevel    `protobuf:"varint,3,opt,name=level,proto3,enum=proto.v1.WordDifficultyLevel" json:"level,omitempty"`   // 新学习项难度等级过滤
+	Tags          []string               `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`                                        // 新学习项标签过滤
+	CourseId      uint32                 `protobuf:"varint,5,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`               // 新学习项所属课程，按课程标签过滤
+	NotebookId    uint32                 `protobuf:"varint,6,opt,name=notebook_id,json=notebookId,proto3" json:"notebook_id,omitempty"`         // 只学习该生词本中的单词和自定义词条，复习项和新学习项都限定在生词本内
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartStudySessionRequest) Reset() {
+	*x = StartStudySessionRequest{}
+	mi := &file_proto_v1_learning_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartStudySessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartStudySessionRequest) ProtoMessage() {}
+
+func (x *StartStudySessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_learning_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartStudySessionRequest.ProtoReflect.Descriptor instead.
+func (*StartStudySessionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_learning_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *StartStudySessionRequest) GetTypes() []MemoryUnitType {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *StartStudySessionRequest) GetNewCount() uint32 {
+	if x != nil && x.NewCount != nil {
+		return *x.NewCount
+	}
+	return 0
+}
+
+func (x *StartStudySessionRequest) GetLevel() WordDifficultyLevel {
+	if x != nil {
+		return x.Level
+	}
+	return WordDifficultyLevel_WORD_DIFFICULTY_LEVEL_UNSPECIFIED
+}
+
+func (x *StartStudySessionRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *StartStudySessionRequest) GetCourseId() uint32 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+func (x *StartStudySessionRequest) GetNotebookId() uint32 {
+	if x != nil {
+		return x.NotebookId
+	}
+	return 0
+}
+
+// StartStudySessionResponse 开始学习会话响应
+type StartStudySessionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     uint32                 `protobuf:"varint,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`       // 学习会话ID，复习时携带以归属到本会话
+	Items         []*StudyItem           `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`                                 // 有序的学习队列
+	ReviewCount   uint32                 `protobuf:"varint,3,opt,name=review_count,json=reviewCount,proto3" json:"review_count,omitempty"` // 待复习项数量
+	NewCount      uint32                 `protobuf:"varint,4,opt,name=new_count,json=newCount,proto3" json:"new_count,omitempty"`          // 新学习项数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartStudySessionResponse) Reset() {
+	*x = StartStudySessionResponse{}
+	mi := &file_proto_v1_learning_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartStudySessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartStudySessionResponse) ProtoMessage() {}
+
+func (x *StartStudySessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_learning_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartStudySessionResponse.ProtoReflect.Descriptor instead.
+func (*StartStudySessionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_learning_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *StartStudySessionResponse) GetSessionId() uint32 {
+	if x != nil {
+		return x.SessionId
+	}
+	return 0
+}
+
+func (x *StartStudySessionResponse) GetItems() []*StudyItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *StartStudySessionResponse) GetReviewCount() uint32 {
+	if x != nil {
+		return x.ReviewCount
+	}
+	return 0
+}
+
+func (x *StartStudySessionResponse) GetNewCount() uint32 {
+	if x != nil {
+		return x.NewCount
+	}
+	return 0
+}
+
+// BatchReviewItem 批量提交中的单条复习
+type BatchReviewItem struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	IdempotencyKey string                 `protobuf:"bytes,1,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"` // 幂等键，由客户端生成，同一用户内唯一，最长64个字符
+	Type           MemoryUnitType         `protobuf:"varint,2,opt,name=type,proto3,enum=proto.v1.MemoryUnitType" json:"type,omitempty"`             // 记忆单元类型
+	ContentId      uint32                 `protobuf:"varint,3,opt,name=content_id,json=contentId,proto3" json:"content_id,omitempty"`               // 内容ID（单词ID或汉字ID）
+	Result         ReviewResult           `protobuf:"varint,4,opt,name=result,proto3,enum=proto.v1.ReviewResult" json:"result,omitempty"`           // 复习结果，为 REVIEW_RESULT_SKIP 时忽略 grade
+	Grade          ReviewGrade            `protobuf:"varint,5,opt,name=grade,proto3,enum=proto.v1.ReviewGrade" json:"grade,omitempty"`              // 复习评分，未指定时按 result 推断（正确为良好，错误为忘记）
+	ResponseTime   uint32                 `protobuf:"varint,6,opt,name=response_time,json=responseTime,proto3" json:"response_time,omitempty"`      // 响应时间（毫秒）
+	ReviewedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=reviewed_at,json=reviewedAt,proto3" json:"reviewed_at,omitempty"`             // 客户端记录的复习时间
+	SessionId      uint32                 `protobuf:"varint,8,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`               // 所属学习会话ID，不在学习会话中时为0
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *BatchReviewItem) Reset() {
+	*x = BatchReviewItem{}
+	mi := &file_proto_v1_learning_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchReviewItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchReviewItem) ProtoMessage() {}
+
+func (x *BatchReviewItem) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_learning_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchReviewItem.ProtoReflect.Descriptor instead.
+func (*BatchReviewItem) Descriptor() ([]byte, []int) {
+	return file_proto_v1_learning_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *BatchReviewItem) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *BatchReviewItem) GetType() MemoryUnitType {
+	if x != nil {
+		return x.Type
+	}
+	return MemoryUnitType_MEMORY_UNIT_TYPE_UNSPECIFIED
+}
+
+func (x *BatchReviewItem) GetContentId() uint32 {
+	if x != nil {
+		return x.ContentId
+	}
+	return 0
+}
+
+func (x *BatchReviewItem) GetResult() ReviewResult {
+	if x != nil {
+		return x.Result
+	}
+	return ReviewResult_REVIEW_RESULT_UNSPECIFIED
+}
+
+func (x *BatchReviewItem) GetGrade() ReviewGrade {
+	if x != nil {
+		return x.Grade
+	}
+	return ReviewGrade_REVIEW_GRADE_UNSPECIFIED
+}
+
+func (x *BatchReviewItem) GetResponseTime() uint32 {
+	if x != nil {
+		return x.ResponseTime
+	}
+	return 0
+}
+
+func (x *BatchReviewItem) GetReviewedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReviewedAt
+	}
+	return nil
+}
+
+func (x *BatchReviewItem) GetSessionId() uint32 {
+	if x != nil {
+		return x.SessionId
+	}
+	return 0
+}
+
+// BatchReviewItemResult 单条复习的处理结果
+type BatchReviewItemResult struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	IdempotencyKey string                 `protobuf:"bytes,1,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"` // 幂等键
+	Status         BatchReviewStatus      `protobuf:"varint,2,opt,name=status,proto3,enum=proto.v1.BatchReviewStatus" json:"status,omitempty"`      // 处理状态
+	MemoryUnitId   uint32                 `protobuf:"varint,3,opt,name=memory_unit_id,json=memoryUnitId,proto3" json:"memory_unit_id,omitempty"`    // 记忆单元ID，内容不存在、参数无效或重复提交时为0
+	Message        string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`                                     // 未应用的原因
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *BatchReviewItemResult) Reset() {
+	*x = BatchReviewItemResult{}
+	mi := &file_proto_v1_learning_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchReviewItemResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchReviewItemResult) ProtoMessage() {}
+
+func (x *BatchReviewItemResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_learning_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchReviewItemResult.ProtoReflect.Descriptor instead.
+func (*BatchReviewItemResult) Descriptor() ([]byte, []int) {
+	return file_proto_v1_learning_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *BatchReviewItemResult) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *BatchReviewItemResult) GetStatus() BatchReviewStatus {
+	if x != nil {
+		return x.Status
+	}
+	return BatchReviewStatus_BATCH_REVIEW_STATUS_UNSPECIFIED
+}
+
+func (x *BatchReviewItemResult) GetMemoryUnitId() uint32 {
+	if x != nil {
+		return x.MemoryUnitId
+	}
+	return 0
+}
+
+func (x *BatchReviewItemResult) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// SubmitReviewBatchRequest 批量提交复习请求
+type SubmitReviewBatchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reviews       []*BatchReviewItem     `protobuf:"bytes,1,rep,name=reviews,proto3" json:"reviews,omitempty"` // 复习列表，最多500条
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitReviewBatchRequest) Reset() {
+	*x = SubmitReviewBatchRequest{}
+	mi := &file_proto_v1_learning_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitReviewBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitReviewBatchRequest) ProtoMessage() {}
+
+func (x *SubmitReviewBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_learning_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitReviewBatchRequest.ProtoReflect.Descriptor instead.
+func (*SubmitReviewBatchRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_learning_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *SubmitReviewBatchRequest) GetReviews() []*BatchReviewItem {
+	if x != nil {
+		return x.Reviews
+	}
+	return nil
+}
+
+// SubmitReviewBatchResponse 批量提交复习响应
+type SubmitReviewBatchResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Results       []*BatchReviewItemResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"` // 处理结果，与请求中的复习一一对应
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitReviewBatchResponse) Reset() {
+	*x = SubmitReviewBatchResponse{}
+	mi := &file_proto_v1_learning_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitReviewBatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitReviewBatchResponse) ProtoMessage() {}
+
+func (x *SubmitReviewBatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_learning_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitReviewBatchResponse.ProtoReflect.Descriptor instead.
+func (*SubmitReviewBatchResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_learning_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *SubmitReviewBatchResponse) GetResults() []*BatchReviewItemResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// ForecastReviewsRequest 复习量预测请求
+type ForecastReviewsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Days          uint32                 `protobuf:"varint,1,opt,name=days,proto3" json:"days,omitempty"`                                       // 预测天数（含今天），默认7，最多365
+	Types         []MemoryUnitType       `protobuf:"varint,2,rep,packed,name=types,proto3,enum=proto.v1.MemoryUnitType" json:"types,omitempty"` // 可选的记忆单元类型过滤
+	NewPerDay     uint32                 `protobuf:"varint,3,opt,name=new_per_day,json=newPerDay,proto3" json:"new_per_day,omitempty"`          // 模拟每天新学习的内容数量，0 表示不模拟
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForecastReviewsRequest) Reset() {
+	*x = ForecastReviewsRequest{}
+	mi := &file_proto_v1_learning_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForecastReviewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForecastReviewsRequest) ProtoMessage() {}
+
+func (x *ForecastReviewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_learning_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForecastReviewsRequest.ProtoReflect.Descriptor instead.
+func (*ForecastReviewsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_learning_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ForecastReviewsRequest) GetDays() uint32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+func (x *ForecastReviewsRequest) GetTypes() []MemoryUnitType {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *ForecastReviewsRequest) GetNewPerDay() uint32 {
+	if x != nil {
+		return x.NewPerDay
+	}
+	return 0
+}
+
+// MemoryUnitTypeCount 按记忆单元类型的数量
+type MemoryUnitTypeCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          MemoryUnitType         `protobuf:"varint,1,opt,name=type,proto3,enum=proto.v1.MemoryUnitType" json:"type,omitempty"`
+	Count         uint32                 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemoryUnitTypeCount) Reset() {
+	*x = MemoryUnitTypeCount{}
+	mi := &file_proto_v1_learning_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemoryUnitTypeCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoryUnitTypeCount) ProtoMessage() {}
+
+func (x *MemoryUnitTypeCount) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_learning_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoryUnitTypeCount.ProtoReflect.Descriptor instead.
+func (*MemoryUnitTypeCount) Descriptor() ([]byte, []int) {
+	return file_proto_v1_learning_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *MemoryUnitTypeCount) GetType() MemoryUnitType {
+	if x != nil {
+		return x.Type
+	}
+	return MemoryUnitType_MEMORY_UNIT_TYPE_UNSPECIFIED
+}
+
+func (x *MemoryUnitTypeCount) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// ReviewForecastDay 一个学习日的预测复习量
+type ReviewForecastDay struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Date           string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`                                            // 学习日日期（用户时区），格式 YYYY-MM-DD
+	DueCount       uint32                 `protobuf:"varint,2,opt,name=due_count,json=dueCount,proto3" json:"due_count,omitempty"`                   // 已安排的复习数量，今天包含已逾期的复习
+	DueByType      []*MemoryUnitTypeCount `protobuf:"bytes,3,rep,name=due_by_type,json=dueByType,proto3" json:"due_by_type,omitempty"`               // 已安排的复习数量按类型分布
+	NewCount       uint32                 `protobuf:"varint,4,opt,name=new_count,json=newCount,proto3" json:"new_count,omitempty"`                   // 模拟新学习的内容数量
+	SimulatedCount uint32                 `protobuf:"varint,5,opt,name=simulated_count,json=simulatedCount,proto3" json:"simulated_count,omitempty"` // 模拟新学习的内容产生的复习数量
+	TotalCount     uint32                 `protobuf:"varint,6,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`             // 预计复习总量（已安排 + 模拟）
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ReviewForecastDay) Reset() {
+	*x = ReviewForecastDay{}
+	mi := &file_proto_v1_learning_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewForecastDay) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewForecastDay) ProtoMessage() {}
+
+func (x *ReviewForecastDay) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_learning_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewForecastDay.ProtoReflect.Descriptor instead.
+func (*ReviewForecastDay) Descriptor() ([]byte, []int) {
+	return file_proto_v1_learning_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ReviewForecastDay) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *ReviewForecastDay) GetDueCount() uint32 {
+	if x != nil {
+		return x.DueCount
+	}
+	return 0
+}
+
+func (x *ReviewForecastDay) GetDueByType() []*MemoryUnitTypeCount {
+	if x != nil {
+		return x.DueByType
+	}
+	return nil
+}
+
+func (x *ReviewForecastDay) GetNewCount() uint32 {
+	if x != nil {
+		return x.NewCount
+	}
+	return 0
+}
+
+func (x *ReviewForecastDay) GetSimulatedCount() uint32 {
+	if x != nil {
+		return x.SimulatedCount
+	}
+	return 0
+}
+
+func (x *ReviewForecastDay) GetTotalCount() uint32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+// ForecastReviewsResponse 复习量预测响应
+type ForecastReviewsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Days          []*ReviewForecastDay   `protobuf:"bytes,1,rep,name=days,proto3" json:"days,omitempty"`                                      // 从今天开始的各学习日
+	OverdueCount  uint32                 `protobuf:"varint,2,opt,name=overdue_count,json=overdueCount,proto3" json:"overdue_count,omitempty"` // 今天之前已到期但尚未复习的数量，已计入今天
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForecastReviewsResponse) Reset() {
+	*x = ForecastReviewsResponse{}
+	mi := &file_proto_v1_learning_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForecastReviewsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForecastReviewsResponse) ProtoMessage() {}
+
+func (x *ForecastReviewsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_learning_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForecastReviewsResponse.ProtoReflect.Descriptor instead.
+func (*ForecastReviewsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_learning_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ForecastReviewsResponse) GetDays() []*ReviewForecastDay {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+func (x *ForecastReviewsResponse) GetOverdueCount() uint32 {
+	if x != nil {
+		return x.OverdueCount
+	}
+	return 0
+}
+
+// ListLeechesRequest 获取我的顽固项请求
+type ListLeechesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          uint32                 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      uint32                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`               // 每页数量，默认20，最大100
+	Types         []MemoryUnitType       `protobuf:"varint,3,rep,packed,name=types,proto3,enum=proto.v1.MemoryUnitType" json:"types,omitempty"` // 可选的记忆单元类型过滤
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLeechesRequest) Reset() {
+	*x = ListLeechesRequest{}
+	mi := &file_proto_v1_learning_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLeechesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLeechesRequest) ProtoMessage() {}
+
+func (x *ListLeechesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_learning_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLeechesRequest.ProtoReflect.Descriptor instead.
+func (*ListLeechesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_learning_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ListLeechesRequest) GetPage() uint32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListLeechesRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListLeechesRequest) GetTypes() []MemoryUnitType {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+// ListLeechesResponse 获取我的顽固项响应
+type ListLeechesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Leeches       []*MemoryUnit          `protobuf:"bytes,1,rep,name=leeches,proto3" json:"leeches,omitempty"` // 顽固项，按遗忘次数降序
+	Total         uint32                 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLeechesResponse) Reset() {
+	*x = ListLeechesResponse{}
+	mi := &file_proto_v1_learning_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLeechesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLeechesResponse) ProtoMessage() {}
+
+func (x *ListLeechesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_learning_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLeechesResponse.ProtoReflect.Descriptor instead.
+func (*ListLeechesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_learning_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListLeechesResponse) GetLeeches() []*MemoryUnit {
+	if x != nil {
+		return x.Leeches
+	}
+	return nil
+}
+
+func (x *ListLeechesResponse) GetTotal() uint32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// UnsuspendMemoryUnitRequest 恢复复习请求
+type UnsuspendMemoryUnitRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MemoryUnitId  uint32                 `protobuf:"varint,1,opt,name=memory_unit_id,json=memoryUnitId,proto3" json:"memory_unit_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnsuspendMemoryUnitRequest) Reset() {
+	*x = UnsuspendMemoryUnitRequest{}
+	mi := &file_proto_v1_learning_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnsuspendMemoryUnitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsuspendMemoryUnitRequest) ProtoMessage() {}
+
+func (x *UnsuspendMemoryUnitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_learning_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsuspendMemoryUnitRequest.ProtoReflect.Descriptor instead.
+func (*UnsuspendMemoryUnitRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_learning_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *UnsuspendMemoryUnitRequest) GetMemoryUnitId() uint32 {
+	if x != nil {
+		return x.MemoryUnitId
+	}
+	return 0
+}
+
+// UnsuspendMemoryUnitResponse 恢复复习响应
+type UnsuspendMemoryUnitResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *MemoryUnit            `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnsuspendMemoryUnitResponse) Reset() {
+	*x = UnsuspendMemoryUnitResponse{}
+	mi := &file_proto_v1_learning_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnsuspendMemoryUnitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsuspendMemoryUnitResponse) ProtoMessage() {}
+
+func (x *UnsuspendMemoryUnitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_learning_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsuspendMemoryUnitResponse.ProtoReflect.Descriptor instead.
+func (*UnsuspendMemoryUnitResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_learning_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *UnsuspendMemoryUnitResponse) GetStatus() *MemoryUnit {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// ResetLeechRequest 清除顽固项状态请求
+type ResetLeechRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MemoryUnitId  uint32                 `protobuf:"varint,1,opt,name=memory_unit_id,json=memoryUnitId,proto3" json:"memory_unit_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetLeechRequest) Reset() {
+	*x = ResetLeechRequest{}
+	mi := &file_proto_v1_learning_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetLeechRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetLeechRequest) ProtoMessage() {}
+
+func (x *ResetLeechRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_learning_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetLeechRequest.ProtoReflect.Descriptor instead.
+func (*ResetLeechRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_learning_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ResetLeechRequest) GetMemoryUnitId() uint32 {
+	if x != nil {
+		return x.MemoryUnitId
+	}
+	return 0
+}
+
+// ResetLeechResponse 清除顽固项状态响应
+type ResetLeechResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *MemoryUnit            `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetLeechResponse) Reset() {
+	*x = ResetLeechResponse{}
+	mi := &file_proto_v1_learning_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetLeechResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetLeechResponse) ProtoMessage() {}
+
+func (x *ResetLeechResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_learning_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetLeechResponse.ProtoReflect.Descriptor instead.
+func (*ResetLeechResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_learning_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ResetLeechResponse) GetStatus() *MemoryUnit {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// HardContent 被多名用户标记为顽固项的学习内容
+type HardContent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          MemoryUnitType         `protobuf:"varint,1,opt,name=type,proto3,enum=proto.v1.MemoryUnitType" json:"type,omitempty"`     // 记忆单元类型
+	ContentId     uint32                 `protobuf:"varint,2,opt,name=content_id,json=contentId,proto3" json:"content_id,omitempty"`       // 内容ID
+	Text          string                 `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`                                   // 内容的展示文本
+	LeechCount    uint32                 `protobuf:"varint,4,opt,name=leech_count,json=leechCount,proto3" json:"leech_count,omitempty"`    // 将该内容标记为顽固项的用户数
+	TotalLapses   uint32                 `protobuf:"varint,5,opt,name=total_lapses,json=totalLapses,proto3" json:"total_lapses,omitempty"` // 这些用户的累计遗忘次数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HardContent) Reset() {
+	*x = HardContent{}
+	mi := &file_proto_v1_learning_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HardContent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HardContent) ProtoMessage() {}
+
+func (x *HardContent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_learning_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HardContent.ProtoReflect.Descriptor instead.
+func (*HardContent) Descriptor() ([]byte, []int) {
+	return file_proto_v1_learning_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *HardContent) GetType() MemoryUnitType {
+	if x != nil {
+		return x.Type
+	}
+	return MemoryUnitType_MEMORY_UNIT_TYPE_UNSPECIFIED
+}
+
+func (x *HardContent) GetContentId() uint32 {
+	if x != nil {
+		return x.ContentId
+	}
+	return 0
+}
+
+func (x *HardContent) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *HardContent) GetLeechCount() uint32 {
+	if x != nil {
+		return x.LeechCount
+	}
+	return 0
+}
+
+func (x *HardContent) GetTotalLapses() uint32 {
+	if x != nil {
+		return x.TotalLapses
+	}
+	return 0
+}
+
+// ListHardContentsRequest 获取难点内容请求
+type ListHardContentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Types         []MemoryUnitType       `protobuf:"varint,1,rep,packed,name=types,proto3,enum=proto.v1.MemoryUnitType" json:"types,omitempty"` // 可选的记忆单元类型过滤
+	Limit         uint32                 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`                                     // 返回数量，默认20，最多100
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListHardContentsRequest) Reset() {
+	*x = ListHardContentsRequest{}
+	mi := &file_proto_v1_learning_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListHardContentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHardContentsRequest) ProtoMessage() {}
+
+func (x *ListHardContentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_learning_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHardContentsRequest.ProtoReflect.Descriptor instead.
+func (*ListHardContentsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_learning_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ListHardContentsRequest) GetTypes() []MemoryUnitType {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *ListHardContentsRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// ListHardContentsResponse 获取难点内容响应
+type ListHardContentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Contents      []*HardContent         `protobuf:"bytes,1,rep,name=contents,proto3" json:"contents,omitempty"` // 按标记人数降序
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListHardContentsResponse) Reset() {
+	*x = ListHardContentsResponse{}
+	mi := &file_proto_v1_learning_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListHardContentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHardContentsResponse) ProtoMessage() {}
+
+func (x *ListHardContentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_learning_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHardContentsResponse.ProtoReflect.Descriptor instead.
+func (*ListHardContentsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_learning_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ListHardContentsResponse) GetContents() []*HardContent {
+	if x != nil {
+		return x.Contents
+	}
+	return nil
+}
+
+// GetStudyActivityRequest 获取学习活动请求
+type GetStudyActivityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartDate     string                 `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"` // 热力图开始日期（用户时区），格式 YYYY-MM-DD，默认最近一年
+	EndDate       string                 `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`       // 热力图结束日期（用户时区），格式 YYYY-MM-DD，默认今天
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStudyActivityRequest) Reset() {
+	*x = GetStudyActivityRequest{}
+	mi := &file_proto_v1_learning_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStudyActivityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStudyActivityRequest) ProtoMessage() {}
+
+func (x *GetStudyActivityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_learning_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStudyActivityRequest.ProtoReflect.Descriptor instead.
+func (*GetStudyActivityRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_learning_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetStudyActivityRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *GetStudyActivityRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+// StudyStreak 连续学习天数
+type StudyStreak struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Current        uint32                 `protobuf:"varint,1,opt,name=current,proto3" json:"current,omitempty"`                                       // 当前连续学习天数，今天尚未学习时不中断
+	Longest        uint32                 `protobuf:"varint,2,opt,name=longest,proto3" json:"longest,omitempty"`                                       // 复习记录保留期内的最长连续学习天数
+	FreezeDaysUsed uint32                 `protobuf:"varint,3,opt,name=freeze_days_used,json=freezeDaysUsed,proto3" json:"freeze_days_used,omitempty"` // 当前连续学习中已使用的断签保护天数
+	FreezeDays     uint32                 `protobuf:"varint,4,opt,name=freeze_days,json=freezeDays,proto3" json:"freeze_days,omitempty"`               // 允许的断签保护天数
+	StudiedToday   bool                   `protobuf:"varint,5,opt,name=studied_today,json=studiedToday,proto3" json:"studied_today,omitempty"`         // 今天是否已学习
+	LastStudyDate  string                 `protobuf:"bytes,6,opt,name=last_study_date,json=lastStudyDate,proto3" json:"last_study_date,omitempty"`     // 最近一次学习的日期，格式 YYYY-MM-DD，从未学习时为空
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *StudyStreak) Reset() {
+	*x = StudyStreak{}
+	mi := &file_proto_v1_learning_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StudyStreak) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StudyStreak) ProtoMessage() {}
+
+func (x *StudyStreak) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_learning_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StudyStreak.ProtoReflect.Descriptor instead.
+func (*StudyStreak) Descriptor() ([]byte, []int) {
+	return file_proto_v1_learning_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *StudyStreak) GetCurrent() uint32 {
+	if x != nil {
+		return x.Current
+	}
+	return 0
+}
+
+func (x *StudyStreak) GetLongest() uint32 {
+	if x != nil {
+		return x.Longest
+	}
+	return 0
+}
+
+func (x *StudyStreak) GetFreezeDaysUsed() uint32 {
+	if x != nil {
+		return x.FreezeDaysUsed
+	}
+	return 0
+}
+
+func (x *StudyStreak) GetFreezeDays() uint32 {
+	if x != nil {
+		return x.FreezeDays
+	}
+	return 0
+}
+
+func (x *StudyStreak) GetStudiedToday() bool {
+	if x != nil {
+		return x.StudiedToday
+	}
+	return false
+}
+
+func (x *StudyStreak) GetLastStudyDate() string {
+	if x != nil {
+		return x.LastStudyDate
+	}
+	return ""
+}
+
+// StudyActivityDay 一个学习日的学习活动
+type StudyActivityDay struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`                                      // 学习日日期（用户时区），格式 YYYY-MM-DD
+	ReviewCount   uint32                 `protobuf:"varint,2,opt,name=review_count,json=reviewCount,proto3" json:"review_count,omitempty"`    // 复习次数
+	NewCount      uint32                 `protobuf:"varint,3,opt,name=new_count,json=newCount,proto3" json:"new_count,omitempty"`             // 新学习的内容数量
+	StudyMinutes  uint32                 `protobuf:"varint,4,opt,name=study_minutes,json=studyMinutes,proto3" json:"study_minutes,omitempty"` // 学习时长（分钟）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StudyActivityDay) Reset() {
+	*x = StudyActivityDay{}
+	mi := &file_proto_v1_learning_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StudyActivityDay) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StudyActivityDay) ProtoMessage() {}
+
+func (x *StudyActivityDay) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_learning_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StudyActivityDay.ProtoReflect.Descriptor instead.
+func (*StudyActivityDay) Descriptor() ([]byte, []int) {
+	return file_proto_v1_learning_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *StudyActivityDay) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *StudyActivityDay) GetReviewCount() uint32 {
+	if x != nil {
+		return x.ReviewCount
+	}
+	return 0
+}
+
+func (x *StudyActivityDay) GetNewCount() uint32 {
+	if x != nil {
+		return x.NewCount
+	}
+	return 0
+}
+
+func (x *StudyActivityDay) GetStudyMinutes() uint32 {
+	if x != nil {
+		return x.StudyMinutes
+	}
+	return 0
+}
+
+// GetStudyActivityResponse 获取学习活动响应
+type GetStudyActivityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Streak        *StudyStreak           `protobuf:"bytes,1,opt,name=streak,proto3" json:"streak,omitempty"`
+	Days          []*StudyActivityDay    `protobuf:"bytes,2,rep,name=days,proto3" json:"days,omitempty"` // 日期范围内有学习记录的学习日，按日期升序
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStudyActivityResponse) Reset() {
+	*x = GetStudyActivityResponse{}
+	mi := &file_proto_v1_learning_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStudyActivityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStudyActivityResponse) ProtoMessage() {}
+
+func (x *GetStudyActivityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_learning_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStudyActivityResponse.ProtoReflect.Descriptor instead.
+func (*GetStudyActivityResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_learning_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetStudyActivityResponse) GetStreak() *StudyStreak {
+	if x != nil {
+		return x.Streak
+	}
+	return nil
+}
+
+func (x *GetStudyActivityResponse) GetDays() []*StudyActivityDay {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+// ResetMemoryUnitRequest 重置记忆单元请求
+type ResetMemoryUnitRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MemoryUnitId  uint32                 `protobuf:"varint,1,opt,name=memory_unit_id,json=memoryUnitId,proto3" json:"memory_unit_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetMemoryUnitRequest) Reset() {
+	*x = ResetMemoryUnitRequest{}
+	mi := &file_proto_v1_learning_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetMemoryUnitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetMemoryUnitRequest) ProtoMessage() {}
+
+func (x *ResetMemoryUnitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_learning_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetMemoryUnitRequest.ProtoReflect.Descriptor instead.
+func (*ResetMemoryUnitRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_learning_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ResetMemoryUnitRequest) GetMemoryUnitId() uint32 {
+	if x != nil {
+		return x.MemoryUnitId
+	}
+	return 0
+}
+
+// ResetMemoryUnitResponse 重置记忆单元响应
+type ResetMemoryUnitResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *MemoryUnit            `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetMemoryUnitResponse) Reset() {
+	*x = ResetMemoryUnitResponse{}
+	mi := &file_proto_v1_learning_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetMemoryUnitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetMemoryUnitResponse) ProtoMessage() {}
+
+func (x *ResetMemoryUnitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_learning_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetMemoryUnitResponse.ProtoReflect.Descriptor instead.
+func (*ResetMemoryUnitResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_learning_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ResetMemoryUnitResponse) GetStatus() *MemoryUnit {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// BuryMemoryUnitRequest 搁置记忆单元请求
+type BuryMemoryUnitRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MemoryUnitId  uint32                 `protobuf:"varint,1,opt,name=memory_unit_id,json=memoryUnitId,proto3" json:"memory_unit_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BuryMemoryUnitRequest) Reset() {
+	*x = BuryMemoryUnitRequest{}
+	mi := &file_proto_v1_learning_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuryMemoryUnitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuryMemoryUnitRequest) ProtoMessage() {}
+
+func (x *BuryMemoryUnitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_learning_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuryMemoryUnitRequest.ProtoReflect.Descriptor instead.
+func (*BuryMemoryUnitRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_learning_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *BuryMemoryUnitRequest) GetMemoryUnitId() uint32 {
+	if x != nil {
+		return x.MemoryUnitId
+	}
+	return 0
+}
+
+// BuryMemoryUnitResponse 搁置记忆单元响应
+type BuryMemoryUnitResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *MemoryUnit            `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BuryMemoryUnitResponse) Reset() {
+	*x = BuryMemoryUnitResponse{}
+	mi := &file_proto_v1_learning_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuryMemoryUnitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuryMemoryUnitResponse) ProtoMessage() {}
+
+func (x *BuryMemoryUnitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_learning_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuryMemoryUnitResponse.ProtoReflect.Descriptor instead.
+func (*BuryMemoryUnitResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_learning_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *BuryMemoryUnitResponse) GetStatus() *MemoryUnit {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// RescheduleMemoryUnitRequest 调整复习日期请求
+type RescheduleMemoryUnitRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MemoryUnitId  uint32                 `protobuf:"varint,1,opt,name=memory_unit_id,json=memoryUnitId,proto3" json:"memory_unit_id,omitempty"`
+	Date          string                 `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"` // 下次复习的学习日（用户时区，YYYY-MM-DD），不能早于今天
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RescheduleMemoryUnitRequest) Reset() {
+	*x = RescheduleMemoryUnitRequest{}
+	mi := &file_proto_v1_learning_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RescheduleMemoryUnitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RescheduleMemoryUnitRequest) ProtoMessage() {}
+
+func (x *RescheduleMemoryUnitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_learning_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RescheduleMemoryUnitRequest.ProtoReflect.Descriptor instead.
+func (*RescheduleMemoryUnitRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_learning_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *RescheduleMemoryUnitRequest) GetMemoryUnitId() uint32 {
+	if x != nil {
+		return x.MemoryUnitId
+	}
+	return 0
+}
+
+func (x *RescheduleMemoryUnitRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+// RescheduleMemoryUnitResponse 调整复习日期响应
+type RescheduleMemoryUnitResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *MemoryUnit            `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RescheduleMemoryUnitResponse) Reset() {
+	*x = RescheduleMemoryUnitResponse{}
+	mi := &file_proto_v1_learning_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RescheduleMemoryUnitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RescheduleMemoryUnitResponse) ProtoMessage() {}
+
+func (x *RescheduleMemoryUnitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_learning_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RescheduleMemoryUnitResponse.ProtoReflect.Descriptor instead.
+func (*RescheduleMemoryUnitResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_learning_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *RescheduleMemoryUnitResponse) GetStatus() *MemoryUnit {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// RescheduleDueReviewsRequest 批量调整到期复习请求
+type RescheduleDueReviewsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Days          uint32                 `protobuf:"varint,1,opt,name=days,proto3" json:"days,omitempty"`                                       // 分散到的学习日天数（含今天），1-365
+	Types         []MemoryUnitType       `protobuf:"varint,2,rep,packed,name=types,proto3,enum=proto.v1.MemoryUnitType" json:"types,omitempty"` // 可选的记忆单元类型过滤
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RescheduleDueReviewsRequest) Reset() {
+	*x = RescheduleDueReviewsRequest{}
+	mi := &file_proto_v1_learning_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RescheduleDueReviewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RescheduleDueReviewsRequest) ProtoMessage() {}
+
+func (x *RescheduleDueReviewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_learning_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *MemoryReview) GetId() uint32 {
+// Deprecated: Use RescheduleDueReviewsRequest.ProtoReflect.Descriptor instead.
+func (*RescheduleDueReviewsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_learning_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *RescheduleDueReviewsRequest) GetDays() uint32 {
 	if x != nil {
-		return x.Id
+		return x.Days
 	}
 	return 0
 }
 
-func (x *MemoryReview) GetMemoryUnitId() uint32 {
+func (x *RescheduleDueReviewsRequest) GetTypes() []MemoryUnitType {
 	if x != nil {
-		return x.MemoryUnitId
+		return x.Types
 	}
-	return 0
+	return nil
 }
 
-func (x *MemoryReview) GetUserId() uint32 {
+// RescheduleDueReviewsResponse 批量调整到期复习响应
+type RescheduleDueReviewsResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	RescheduledCount uint32                 `protobuf:"varint,1,opt,name=rescheduled_count,json=rescheduledCount,proto3" json:"rescheduled_count,omitempty"` // 调整到之后学习日的记忆单元数量
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *RescheduleDueReviewsResponse) Reset() {
+	*x = RescheduleDueReviewsResponse{}
+	mi := &file_proto_v1_learning_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RescheduleDueReviewsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RescheduleDueReviewsResponse) ProtoMessage() {}
+
+func (x *RescheduleDueReviewsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_learning_proto_msgTypes[59]
 	if x != nil {
-		return x.UserId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RescheduleDueReviewsResponse.ProtoReflect.Descriptor instead.
+func (*RescheduleDueReviewsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_learning_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *RescheduleDueReviewsResponse) GetRescheduledCount() uint32 {
+	if x != nil {
+		return x.RescheduledCount
 	}
 	return 0
 }
 
-func (x *MemoryReview) GetResult() ReviewResult {
+// ForgetCourseRequest 遗忘课程请求
+type ForgetCourseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      uint32                 `protobuf:"varint,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForgetCourseRequest) Reset() {
+	*x = ForgetCourseRequest{}
+	mi := &file_proto_v1_learning_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForgetCourseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForgetCourseRequest) ProtoMessage() {}
+
+func (x *ForgetCourseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_learning_proto_msgTypes[60]
 	if x != nil {
-		return x.Result
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ReviewResult_REVIEW_RESULT_UNSPECIFIED
+	return mi.MessageOf(x)
 }
 
-func (x *MemoryReview) GetResponseTime() uint32 {
+// Deprecated: Use ForgetCourseRequest.ProtoReflect.Descriptor instead.
+func (*ForgetCourseRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_learning_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ForgetCourseRequest) GetCourseId() uint32 {
 	if x != nil {
-		return x.ResponseTime
+		return x.CourseId
 	}
 	return 0
 }
 
-func (x *MemoryReview) GetReviewTime() *timestamppb.Timestamp {
+// ForgetCourseResponse 遗忘课程响应
+type ForgetCourseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ResetCount    uint32                 `protobuf:"varint,1,opt,name=reset_count,json=resetCount,proto3" json:"reset_count,omitempty"` // 重置的记忆单元数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForgetCourseResponse) Reset() {
+	*x = ForgetCourseResponse{}
+	mi := &file_proto_v1_learning_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForgetCourseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForgetCourseResponse) ProtoMessage() {}
+
+func (x *ForgetCourseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_learning_proto_msgTypes[61]
 	if x != nil {
-		return x.ReviewTime
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *MemoryReview) GetCreatedAt() *timestamppb.Timestamp {
+// Deprecated: Use ForgetCourseResponse.ProtoReflect.Descriptor instead.
+func (*ForgetCourseResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_learning_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ForgetCourseResponse) GetResetCount() uint32 {
 	if x != nil {
-		return x.CreatedAt
+		return x.ResetCount
 	}
-	return nil
+	return 0
 }
 
 // 提交汉字复习结果请求
@@ -1559,7 +4184,7 @@ type SubmitHanCharReviewRequest struct {
 
 func (x *SubmitHanCharReviewRequest) Reset() {
 	*x = SubmitHanCharReviewRequest{}
-	mi := &file_proto_v1_learning_proto_msgTypes[23]
+	mi := &file_proto_v1_learning_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1571,7 +4196,7 @@ func (x *SubmitHanCharReviewRequest) String() string {
 func (*SubmitHanCharReviewRequest) ProtoMessage() {}
 
 func (x *SubmitHanCharReviewRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_learning_proto_msgTypes[23]
+	mi := &file_proto_v1_learning_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1584,7 +4209,7 @@ func (x *SubmitHanCharReviewRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubmitHanCharReviewRequest.ProtoReflect.Descriptor instead.
 func (*SubmitHanCharReviewRequest) Descriptor() ([]byte, []int) {
-	return file_proto_v1_learning_proto_rawDescGZIP(), []int{23}
+	return file_proto_v1_learning_proto_rawDescGZIP(), []int{62}
 }
 
 func (x *SubmitHanCharReviewRequest) GetHanCharId() string {
@@ -1619,7 +4244,7 @@ type SubmitHanCharReviewResponse struct {
 
 func (x *SubmitHanCharReviewResponse) Reset() {
 	*x = SubmitHanCharReviewResponse{}
-	mi := &file_proto_v1_learning_proto_msgTypes[24]
+	mi := &file_proto_v1_learning_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1631,7 +4256,7 @@ func (x *SubmitHanCharReviewResponse) String() string {
 func (*SubmitHanCharReviewResponse) ProtoMessage() {}
 
 func (x *SubmitHanCharReviewResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_learning_proto_msgTypes[24]
+	mi := &file_proto_v1_learning_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1644,7 +4269,7 @@ func (x *SubmitHanCharReviewResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubmitHanCharReviewResponse.ProtoReflect.Descriptor instead.
 func (*SubmitHanCharReviewResponse) Descriptor() ([]byte, []int) {
-	return file_proto_v1_learning_proto_rawDescGZIP(), []int{24}
+	return file_proto_v1_learning_proto_rawDescGZIP(), []int{63}
 }
 
 func (x *SubmitHanCharReviewResponse) GetNextReviewTime() *timestamppb.Timestamp {
@@ -1654,32 +4279,244 @@ func (x *SubmitHanCharReviewResponse) GetNextReviewTime() *timestamppb.Timestamp
 	return nil
 }
 
+// 提交汉字书写练习请求
+type SubmitHanCharHandwritingRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 书写的汉字ID
+	HanCharId string `protobuf:"bytes,1,opt,name=han_char_id,json=hanCharId,proto3" json:"han_char_id,omitempty"`
+	// 第几次作答(1-3), 0 表示第一次
+	Attempt uint32 `protobuf:"varint,2,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	// 按书写顺序排列的每一笔, 坐标系与笔顺数据一致
+	Strokes []*StrokePolyline `protobuf:"bytes,3,rep,name=strokes,proto3" json:"strokes,omitempty"`
+	// 书写时长(秒)
+	StudyDuration uint32 `protobuf:"varint,4,opt,name=study_duration,json=studyDuration,proto3" json:"study_duration,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitHanCharHandwritingRequest) Reset() {
+	*x = SubmitHanCharHandwritingRequest{}
+	mi := &file_proto_v1_learning_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitHanCharHandwritingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitHanCharHandwritingRequest) ProtoMessage() {}
+
+func (x *SubmitHanCharHandwritingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_learning_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitHanCharHandwritingRequest.ProtoReflect.Descriptor instead.
+func (*SubmitHanCharHandwritingRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_learning_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *SubmitHanCharHandwritingRequest) GetHanCharId() string {
+	if x != nil {
+		return x.HanCharId
+	}
+	return ""
+}
+
+func (x *SubmitHanCharHandwritingRequest) GetAttempt() uint32 {
+	if x != nil {
+		return x.Attempt
+	}
+	return 0
+}
+
+func (x *SubmitHanCharHandwritingRequest) GetStrokes() []*StrokePolyline {
+	if x != nil {
+		return x.Strokes
+	}
+	return nil
+}
+
+func (x *SubmitHanCharHandwritingRequest) GetStudyDuration() uint32 {
+	if x != nil {
+		return x.StudyDuration
+	}
+	return 0
+}
+
+// 提交汉字书写练习响应
+type SubmitHanCharHandwritingResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 整字是否写对: 每一笔都写对且没有多写
+	Correct bool `protobuf:"varint,1,opt,name=correct,proto3" json:"correct,omitempty"`
+	// 按标准笔顺每一笔是否写对, 缺少的笔画为 false
+	StrokeCorrect []bool `protobuf:"varint,2,rep,packed,name=stroke_correct,json=strokeCorrect,proto3" json:"stroke_correct,omitempty"`
+	// 多写的笔画数
+	ExtraStrokes  uint32 `protobuf:"varint,3,opt,name=extra_strokes,json=extraStrokes,proto3" json:"extra_strokes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitHanCharHandwritingResponse) Reset() {
+	*x = SubmitHanCharHandwritingResponse{}
+	mi := &file_proto_v1_learning_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitHanCharHandwritingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitHanCharHandwritingResponse) ProtoMessage() {}
+
+func (x *SubmitHanCharHandwritingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_learning_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitHanCharHandwritingResponse.ProtoReflect.Descriptor instead.
+func (*SubmitHanCharHandwritingResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_learning_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *SubmitHanCharHandwritingResponse) GetCorrect() bool {
+	if x != nil {
+		return x.Correct
+	}
+	return false
+}
+
+func (x *SubmitHanCharHandwritingResponse) GetStrokeCorrect() []bool {
+	if x != nil {
+		return x.StrokeCorrect
+	}
+	return nil
+}
+
+func (x *SubmitHanCharHandwritingResponse) GetExtraStrokes() uint32 {
+	if x != nil {
+		return x.ExtraStrokes
+	}
+	return 0
+}
+
 // 获取汉字测试请求
 type GetHanCharTestRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// 测试数量
+	// 测试数量，不超过100，0 表示使用默认数量
 	Count int32 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
-	// 难度等级（1-5，数字越大难度越高）
+	// 难度等级（WordDifficultyLevel 的取值），0 表示不过滤
 	DifficultyLevel int32 `protobuf:"varint,2,opt,name=difficulty_level,json=difficultyLevel,proto3" json:"difficulty_level,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// 可用的题型，为空时使用全部题型
+	Types []HanCharQuestionType `protobuf:"varint,3,rep,packed,name=types,proto3,enum=proto.v1.HanCharQuestionType" json:"types,omitempty"`
+	// 随机种子，相同的种子生成相同的题目，0 表示由服务端生成
+	Seed          int64 `protobuf:"varint,4,opt,name=seed,proto3" json:"seed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHanCharTestRequest) Reset() {
+	*x = GetHanCharTestRequest{}
+	mi := &file_proto_v1_learning_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHanCharTestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHanCharTestRequest) ProtoMessage() {}
+
+func (x *GetHanCharTestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_learning_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHanCharTestRequest.ProtoReflect.Descriptor instead.
+func (*GetHanCharTestRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_learning_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *GetHanCharTestRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *GetHanCharTestRequest) GetDifficultyLevel() int32 {
+	if x != nil {
+		return x.DifficultyLevel
+	}
+	return 0
+}
+
+func (x *GetHanCharTestRequest) GetTypes() []HanCharQuestionType {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *GetHanCharTestRequest) GetSeed() int64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+// 获取汉字测试响应
+type GetHanCharTestResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 测试汉字列表
+	HanChars []*HanChar `protobuf:"bytes,1,rep,name=han_chars,json=hanChars,proto3" json:"han_chars,omitempty"`
+	// 随机种子，提交答案时需要原样提交
+	Seed int64 `protobuf:"varint,2,opt,name=seed,proto3" json:"seed,omitempty"`
+	// 选择题，不适用任何题型的汉字没有对应的题目
+	Questions     []*HanCharQuestion `protobuf:"bytes,3,rep,name=questions,proto3" json:"questions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetHanCharTestRequest) Reset() {
-	*x = GetHanCharTestRequest{}
-	mi := &file_proto_v1_learning_proto_msgTypes[25]
+func (x *GetHanCharTestResponse) Reset() {
+	*x = GetHanCharTestResponse{}
+	mi := &file_proto_v1_learning_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetHanCharTestRequest) String() string {
+func (x *GetHanCharTestResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetHanCharTestRequest) ProtoMessage() {}
+func (*GetHanCharTestResponse) ProtoMessage() {}
 
-func (x *GetHanCharTestRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_learning_proto_msgTypes[25]
+func (x *GetHanCharTestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_learning_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1690,49 +4527,62 @@ func (x *GetHanCharTestRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetHanCharTestRequest.ProtoReflect.Descriptor instead.
-func (*GetHanCharTestRequest) Descriptor() ([]byte, []int) {
-	return file_proto_v1_learning_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use GetHanCharTestResponse.ProtoReflect.Descriptor instead.
+func (*GetHanCharTestResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_learning_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *GetHanCharTestRequest) GetCount() int32 {
+func (x *GetHanCharTestResponse) GetHanChars() []*HanChar {
 	if x != nil {
-		return x.Count
+		return x.HanChars
 	}
-	return 0
+	return nil
 }
 
-func (x *GetHanCharTestRequest) GetDifficultyLevel() int32 {
+func (x *GetHanCharTestResponse) GetSeed() int64 {
 	if x != nil {
-		return x.DifficultyLevel
+		return x.Seed
 	}
 	return 0
 }
 
-// 获取汉字测试响应
-type GetHanCharTestResponse struct {
+func (x *GetHanCharTestResponse) GetQuestions() []*HanCharQuestion {
+	if x != nil {
+		return x.Questions
+	}
+	return nil
+}
+
+// 汉字选择题
+type HanCharQuestion struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// 测试汉字列表
-	HanChars      []*HanChar `protobuf:"bytes,1,rep,name=han_chars,json=hanChars,proto3" json:"han_chars,omitempty"`
+	// 考查的汉字ID
+	HanCharId uint32 `protobuf:"varint,1,opt,name=han_char_id,json=hanCharId,proto3" json:"han_char_id,omitempty"`
+	// 题型
+	Type HanCharQuestionType `protobuf:"varint,2,opt,name=type,proto3,enum=proto.v1.HanCharQuestionType" json:"type,omitempty"`
+	// 题干
+	Prompt string `protobuf:"bytes,3,opt,name=prompt,proto3" json:"prompt,omitempty"`
+	// 选项
+	Options       []string `protobuf:"bytes,4,rep,name=options,proto3" json:"options,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetHanCharTestResponse) Reset() {
-	*x = GetHanCharTestResponse{}
-	mi := &file_proto_v1_learning_proto_msgTypes[26]
+func (x *HanCharQuestion) Reset() {
+	*x = HanCharQuestion{}
+	mi := &file_proto_v1_learning_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetHanCharTestResponse) String() string {
+func (x *HanCharQuestion) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetHanCharTestResponse) ProtoMessage() {}
+func (*HanCharQuestion) ProtoMessage() {}
 
-func (x *GetHanCharTestResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_learning_proto_msgTypes[26]
+func (x *HanCharQuestion) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_learning_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1743,30 +4593,58 @@ func (x *GetHanCharTestResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetHanCharTestResponse.ProtoReflect.Descriptor instead.
-func (*GetHanCharTestResponse) Descriptor() ([]byte, []int) {
-	return file_proto_v1_learning_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use HanCharQuestion.ProtoReflect.Descriptor instead.
+func (*HanCharQuestion) Descriptor() ([]byte, []int) {
+	return file_proto_v1_learning_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *GetHanCharTestResponse) GetHanChars() []*HanChar {
+func (x *HanCharQuestion) GetHanCharId() uint32 {
 	if x != nil {
-		return x.HanChars
+		return x.HanCharId
+	}
+	return 0
+}
+
+func (x *HanCharQuestion) GetType() HanCharQuestionType {
+	if x != nil {
+		return x.Type
+	}
+	return HanCharQuestionType_HAN_CHAR_QUESTION_TYPE_UNSPECIFIED
+}
+
+func (x *HanCharQuestion) GetPrompt() string {
+	if x != nil {
+		return x.Prompt
+	}
+	return ""
+}
+
+func (x *HanCharQuestion) GetOptions() []string {
+	if x != nil {
+		return x.Options
 	}
 	return nil
 }
 
 // 提交汉字测试结果请求
+// 提交选择题答案时由服务端评分，否则按自评的是否认识记录
 type SubmitHanCharTestResultRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// 测试结果
-	Results       []*HanCharTestResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	// 自评的测试结果
+	Results []*HanCharTestResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	// 获取测试时返回的随机种子，提交选择题答案时必填
+	Seed int64 `protobuf:"varint,2,opt,name=seed,proto3" json:"seed,omitempty"`
+	// 选择题答案
+	Answers []*HanCharTestAnswer `protobuf:"bytes,3,rep,name=answers,proto3" json:"answers,omitempty"`
+	// 测试时间，提交自评结果时必填，重试同一次提交时保持不变以免重复计入
+	TestTime      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=test_time,json=testTime,proto3" json:"test_time,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SubmitHanCharTestResultRequest) Reset() {
 	*x = SubmitHanCharTestResultRequest{}
-	mi := &file_proto_v1_learning_proto_msgTypes[27]
+	mi := &file_proto_v1_learning_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1778,7 +4656,7 @@ func (x *SubmitHanCharTestResultRequest) String() string {
 func (*SubmitHanCharTestResultRequest) ProtoMessage() {}
 
 func (x *SubmitHanCharTestResultRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_learning_proto_msgTypes[27]
+	mi := &file_proto_v1_learning_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1791,7 +4669,7 @@ func (x *SubmitHanCharTestResultRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubmitHanCharTestResultRequest.ProtoReflect.Descriptor instead.
 func (*SubmitHanCharTestResultRequest) Descriptor() ([]byte, []int) {
-	return file_proto_v1_learning_proto_rawDescGZIP(), []int{27}
+	return file_proto_v1_learning_proto_rawDescGZIP(), []int{69}
 }
 
 func (x *SubmitHanCharTestResultRequest) GetResults() []*HanCharTestResult {
@@ -1801,6 +4679,27 @@ func (x *SubmitHanCharTestResultRequest) GetResults() []*HanCharTestResult {
 	return nil
 }
 
+func (x *SubmitHanCharTestResultRequest) GetSeed() int64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+func (x *SubmitHanCharTestResultRequest) GetAnswers() []*HanCharTestAnswer {
+	if x != nil {
+		return x.Answers
+	}
+	return nil
+}
+
+func (x *SubmitHanCharTestResultRequest) GetTestTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TestTime
+	}
+	return nil
+}
+
 // 汉字测试结果
 type HanCharTestResult struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -1814,7 +4713,7 @@ type HanCharTestResult struct {
 
 func (x *HanCharTestResult) Reset() {
 	*x = HanCharTestResult{}
-	mi := &file_proto_v1_learning_proto_msgTypes[28]
+	mi := &file_proto_v1_learning_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1826,7 +4725,7 @@ func (x *HanCharTestResult) String() string {
 func (*HanCharTestResult) ProtoMessage() {}
 
 func (x *HanCharTestResult) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_learning_proto_msgTypes[28]
+	mi := &file_proto_v1_learning_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1839,7 +4738,7 @@ func (x *HanCharTestResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HanCharTestResult.ProtoReflect.Descriptor instead.
 func (*HanCharTestResult) Descriptor() ([]byte, []int) {
-	return file_proto_v1_learning_proto_rawDescGZIP(), []int{28}
+	return file_proto_v1_learning_proto_rawDescGZIP(), []int{70}
 }
 
 func (x *HanCharTestResult) GetHanCharId() uint32 {
@@ -1856,16 +4755,155 @@ func (x *HanCharTestResult) GetIsRecognized() bool {
 	return false
 }
 
+// 汉字选择题答案
+type HanCharTestAnswer struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 汉字ID
+	HanCharId uint32 `protobuf:"varint,1,opt,name=han_char_id,json=hanCharId,proto3" json:"han_char_id,omitempty"`
+	// 题型
+	Type HanCharQuestionType `protobuf:"varint,2,opt,name=type,proto3,enum=proto.v1.HanCharQuestionType" json:"type,omitempty"`
+	// 所选选项的文本，按文本评分，不受重新生成题目时选项变化的影响
+	SelectedOption string `protobuf:"bytes,4,opt,name=selected_option,json=selectedOption,proto3" json:"selected_option,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *HanCharTestAnswer) Reset() {
+	*x = HanCharTestAnswer{}
+	mi := &file_proto_v1_learning_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HanCharTestAnswer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HanCharTestAnswer) ProtoMessage() {}
+
+func (x *HanCharTestAnswer) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_learning_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HanCharTestAnswer.ProtoReflect.Descriptor instead.
+func (*HanCharTestAnswer) Descriptor() ([]byte, []int) {
+	return file_proto_v1_learning_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *HanCharTestAnswer) GetHanCharId() uint32 {
+	if x != nil {
+		return x.HanCharId
+	}
+	return 0
+}
+
+func (x *HanCharTestAnswer) GetType() HanCharQuestionType {
+	if x != nil {
+		return x.Type
+	}
+	return HanCharQuestionType_HAN_CHAR_QUESTION_TYPE_UNSPECIFIED
+}
+
+func (x *HanCharTestAnswer) GetSelectedOption() string {
+	if x != nil {
+		return x.SelectedOption
+	}
+	return ""
+}
+
+// 汉字选择题评分
+type HanCharTestGrade struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 汉字ID
+	HanCharId uint32 `protobuf:"varint,1,opt,name=han_char_id,json=hanCharId,proto3" json:"han_char_id,omitempty"`
+	// 题型
+	Type HanCharQuestionType `protobuf:"varint,2,opt,name=type,proto3,enum=proto.v1.HanCharQuestionType" json:"type,omitempty"`
+	// 是否答对
+	Correct bool `protobuf:"varint,3,opt,name=correct,proto3" json:"correct,omitempty"`
+	// 正确答案的文本
+	Answer        string `protobuf:"bytes,5,opt,name=answer,proto3" json:"answer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HanCharTestGrade) Reset() {
+	*x = HanCharTestGrade{}
+	mi := &file_proto_v1_learning_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HanCharTestGrade) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HanCharTestGrade) ProtoMessage() {}
+
+func (x *HanCharTestGrade) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_learning_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HanCharTestGrade.ProtoReflect.Descriptor instead.
+func (*HanCharTestGrade) Descriptor() ([]byte, []int) {
+	return file_proto_v1_learning_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *HanCharTestGrade) GetHanCharId() uint32 {
+	if x != nil {
+		return x.HanCharId
+	}
+	return 0
+}
+
+func (x *HanCharTestGrade) GetType() HanCharQuestionType {
+	if x != nil {
+		return x.Type
+	}
+	return HanCharQuestionType_HAN_CHAR_QUESTION_TYPE_UNSPECIFIED
+}
+
+func (x *HanCharTestGrade) GetCorrect() bool {
+	if x != nil {
+		return x.Correct
+	}
+	return false
+}
+
+func (x *HanCharTestGrade) GetAnswer() string {
+	if x != nil {
+		return x.Answer
+	}
+	return ""
+}
+
 // 提交汉字测试结果响应
 type SubmitHanCharTestResultResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 选择题的评分，与请求中的答案一一对应
+	Grades        []*HanCharTestGrade `protobuf:"bytes,1,rep,name=grades,proto3" json:"grades,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SubmitHanCharTestResultResponse) Reset() {
 	*x = SubmitHanCharTestResultResponse{}
-	mi := &file_proto_v1_learning_proto_msgTypes[29]
+	mi := &file_proto_v1_learning_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1877,7 +4915,7 @@ func (x *SubmitHanCharTestResultResponse) String() string {
 func (*SubmitHanCharTestResultResponse) ProtoMessage() {}
 
 func (x *SubmitHanCharTestResultResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_learning_proto_msgTypes[29]
+	mi := &file_proto_v1_learning_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1890,21 +4928,32 @@ func (x *SubmitHanCharTestResultResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubmitHanCharTestResultResponse.ProtoReflect.Descriptor instead.
 func (*SubmitHanCharTestResultResponse) Descriptor() ([]byte, []int) {
-	return file_proto_v1_learning_proto_rawDescGZIP(), []int{29}
+	return file_proto_v1_learning_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *SubmitHanCharTestResultResponse) GetGrades() []*HanCharTestGrade {
+	if x != nil {
+		return x.Grades
+	}
+	return nil
 }
 
 // 获取生字学习内容请求
 type GetNewHanCharLearningRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// 学习数量
-	Count         int32 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	// 学习数量，不超过100，0 表示使用默认数量
+	Count int32 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	// 难度等级，未指定时不过滤
+	Level WordDifficultyLevel `protobuf:"varint,2,opt,name=level,proto3,enum=proto.v1.WordDifficultyLevel" json:"level,omitempty"`
+	// 分类，为空时不过滤
+	Category      string `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetNewHanCharLearningRequest) Reset() {
 	*x = GetNewHanCharLearningRequest{}
-	mi := &file_proto_v1_learning_proto_msgTypes[30]
+	mi := &file_proto_v1_learning_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1916,7 +4965,7 @@ func (x *GetNewHanCharLearningRequest) String() string {
 func (*GetNewHanCharLearningRequest) ProtoMessage() {}
 
 func (x *GetNewHanCharLearningRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_learning_proto_msgTypes[30]
+	mi := &file_proto_v1_learning_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1929,7 +4978,7 @@ func (x *GetNewHanCharLearningRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetNewHanCharLearningRequest.ProtoReflect.Descriptor instead.
 func (*GetNewHanCharLearningRequest) Descriptor() ([]byte, []int) {
-	return file_proto_v1_learning_proto_rawDescGZIP(), []int{30}
+	return file_proto_v1_learning_proto_rawDescGZIP(), []int{74}
 }
 
 func (x *GetNewHanCharLearningRequest) GetCount() int32 {
@@ -1939,6 +4988,20 @@ func (x *GetNewHanCharLearningRequest) GetCount() int32 {
 	return 0
 }
 
+func (x *GetNewHanCharLearningRequest) GetLevel() WordDifficultyLevel {
+	if x != nil {
+		return x.Level
+	}
+	return WordDifficultyLevel_WORD_DIFFICULTY_LEVEL_UNSPECIFIED
+}
+
+func (x *GetNewHanCharLearningRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
 // 获取生字学习内容响应
 type GetNewHanCharLearningResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -1950,7 +5013,7 @@ type GetNewHanCharLearningResponse struct {
 
 func (x *GetNewHanCharLearningResponse) Reset() {
 	*x = GetNewHanCharLearningResponse{}
-	mi := &file_proto_v1_learning_proto_msgTypes[31]
+	mi := &file_proto_v1_learning_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1962,7 +5025,7 @@ func (x *GetNewHanCharLearningResponse) String() string {
 func (*GetNewHanCharLearningResponse) ProtoMessage() {}
 
 func (x *GetNewHanCharLearningResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_learning_proto_msgTypes[31]
+	mi := &file_proto_v1_learning_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1975,7 +5038,7 @@ func (x *GetNewHanCharLearningResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetNewHanCharLearningResponse.ProtoReflect.Descriptor instead.
 func (*GetNewHanCharLearningResponse) Descriptor() ([]byte, []int) {
-	return file_proto_v1_learning_proto_rawDescGZIP(), []int{31}
+	return file_proto_v1_learning_proto_rawDescGZIP(), []int{75}
 }
 
 func (x *GetNewHanCharLearningResponse) GetContents() []*HanCharLearningContent {
@@ -2004,7 +5067,7 @@ type HanCharLearningContent struct {
 
 func (x *HanCharLearningContent) Reset() {
 	*x = HanCharLearningContent{}
-	mi := &file_proto_v1_learning_proto_msgTypes[32]
+	mi := &file_proto_v1_learning_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2016,7 +5079,7 @@ func (x *HanCharLearningContent) String() string {
 func (*HanCharLearningContent) ProtoMessage() {}
 
 func (x *HanCharLearningContent) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_learning_proto_msgTypes[32]
+	mi := &file_proto_v1_learning_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2029,7 +5092,7 @@ func (x *HanCharLearningContent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HanCharLearningContent.ProtoReflect.Descriptor instead.
 func (*HanCharLearningContent) Descriptor() ([]byte, []int) {
-	return file_proto_v1_learning_proto_rawDescGZIP(), []int{32}
+	return file_proto_v1_learning_proto_rawDescGZIP(), []int{76}
 }
 
 func (x *HanCharLearningContent) GetHanCharId() string {
@@ -2082,7 +5145,7 @@ type SubmitNewHanCharLearningResultRequest struct {
 
 func (x *SubmitNewHanCharLearningResultRequest) Reset() {
 	*x = SubmitNewHanCharLearningResultRequest{}
-	mi := &file_proto_v1_learning_proto_msgTypes[33]
+	mi := &file_proto_v1_learning_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2094,7 +5157,7 @@ func (x *SubmitNewHanCharLearningResultRequest) String() string {
 func (*SubmitNewHanCharLearningResultRequest) ProtoMessage() {}
 
 func (x *SubmitNewHanCharLearningResultRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_learning_proto_msgTypes[33]
+	mi := &file_proto_v1_learning_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2107,7 +5170,7 @@ func (x *SubmitNewHanCharLearningResultRequest) ProtoReflect() protoreflect.Mess
 
 // Deprecated: Use SubmitNewHanCharLearningResultRequest.ProtoReflect.Descriptor instead.
 func (*SubmitNewHanCharLearningResultRequest) Descriptor() ([]byte, []int) {
-	return file_proto_v1_learning_proto_rawDescGZIP(), []int{33}
+	return file_proto_v1_learning_proto_rawDescGZIP(), []int{77}
 }
 
 func (x *SubmitNewHanCharLearningResultRequest) GetLearningTime() *timestamppb.Timestamp {
@@ -2144,7 +5207,7 @@ type HanCharLearningResultItem struct {
 
 func (x *HanCharLearningResultItem) Reset() {
 	*x = HanCharLearningResultItem{}
-	mi := &file_proto_v1_learning_proto_msgTypes[34]
+	mi := &file_proto_v1_learning_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2156,7 +5219,7 @@ func (x *HanCharLearningResultItem) String() string {
 func (*HanCharLearningResultItem) ProtoMessage() {}
 
 func (x *HanCharLearningResultItem) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_learning_proto_msgTypes[34]
+	mi := &file_proto_v1_learning_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2169,7 +5232,7 @@ func (x *HanCharLearningResultItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HanCharLearningResultItem.ProtoReflect.Descriptor instead.
 func (*HanCharLearningResultItem) Descriptor() ([]byte, []int) {
-	return file_proto_v1_learning_proto_rawDescGZIP(), []int{34}
+	return file_proto_v1_learning_proto_rawDescGZIP(), []int{78}
 }
 
 func (x *HanCharLearningResultItem) GetNewHanCharId() string {
@@ -2195,7 +5258,7 @@ type SubmitNewHanCharLearningResultResponse struct {
 
 func (x *SubmitNewHanCharLearningResultResponse) Reset() {
 	*x = SubmitNewHanCharLearningResultResponse{}
-	mi := &file_proto_v1_learning_proto_msgTypes[35]
+	mi := &file_proto_v1_learning_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2207,7 +5270,7 @@ func (x *SubmitNewHanCharLearningResultResponse) String() string {
 func (*SubmitNewHanCharLearningResultResponse) ProtoMessage() {}
 
 func (x *SubmitNewHanCharLearningResultResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_learning_proto_msgTypes[35]
+	mi := &file_proto_v1_learning_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2220,7 +5283,7 @@ func (x *SubmitNewHanCharLearningResultResponse) ProtoReflect() protoreflect.Mes
 
 // Deprecated: Use SubmitNewHanCharLearningResultResponse.ProtoReflect.Descriptor instead.
 func (*SubmitNewHanCharLearningResultResponse) Descriptor() ([]byte, []int) {
-	return file_proto_v1_learning_proto_rawDescGZIP(), []int{35}
+	return file_proto_v1_learning_proto_rawDescGZIP(), []int{79}
 }
 
 // 汉字学习结果
@@ -2244,7 +5307,7 @@ type HanCharLearningResult struct {
 
 func (x *HanCharLearningResult) Reset() {
 	*x = HanCharLearningResult{}
-	mi := &file_proto_v1_learning_proto_msgTypes[36]
+	mi := &file_proto_v1_learning_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2256,7 +5319,7 @@ func (x *HanCharLearningResult) String() string {
 func (*HanCharLearningResult) ProtoMessage() {}
 
 func (x *HanCharLearningResult) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_learning_proto_msgTypes[36]
+	mi := &file_proto_v1_learning_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2269,7 +5332,7 @@ func (x *HanCharLearningResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HanCharLearningResult.ProtoReflect.Descriptor instead.
 func (*HanCharLearningResult) Descriptor() ([]byte, []int) {
-	return file_proto_v1_learning_proto_rawDescGZIP(), []int{36}
+	return file_proto_v1_learning_proto_rawDescGZIP(), []int{80}
 }
 
 func (x *HanCharLearningResult) GetFirstTryCorrect() bool {
@@ -2331,7 +5394,7 @@ var file_proto_v1_learning_proto_rawDesc = string([]byte{
 	0x6e, 0x61, 0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61,
 	0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x1a, 0x19, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x6f, 0x63, 0x61, 0x62,
-	0x75, 0x6c, 0x61, 0x72, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x88, 0x05, 0x0a, 0x0a,
+	0x75, 0x6c, 0x61, 0x72, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd9, 0x05, 0x0a, 0x0a,
 	0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
 	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x75, 0x73, 0x65,
@@ -2372,448 +5435,1070 @@ var file_proto_v1_learning_proto_rawDesc = string([]byte{
 	0x76, 0x65, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x6f, 0x6e,
 	0x73, 0x65, 0x63, 0x75, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x77, 0x72, 0x6f, 0x6e, 0x67, 0x18, 0x0e,
 	0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x74, 0x69, 0x76,
-	0x65, 0x57, 0x72, 0x6f, 0x6e, 0x67, 0x22, 0x54, 0x0a, 0x0e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77,
-	0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x79, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x64, 0x61, 0x79, 0x73, 0x12, 0x14, 0x0a, 0x05,
-	0x68, 0x6f, 0x75, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x68, 0x6f, 0x75,
-	0x72, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x07, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x22, 0x78, 0x0a, 0x10,
-	0x4c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73,
-	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x02, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x27, 0x0a, 0x0f,
-	0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64,
-	0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x69,
-	0x74, 0x65, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61,
-	0x6c, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x46, 0x0a, 0x27, 0x4c, 0x65, 0x61, 0x72, 0x6e, 0x69,
-	0x6e, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72,
-	0x73, 0x65, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x64, 0x22, 0x62,
-	0x0a, 0x28, 0x4c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65,
-	0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x08, 0x70, 0x72,
-	0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67,
-	0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65,
-	0x73, 0x73, 0x22, 0x49, 0x0a, 0x28, 0x4c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x47, 0x65, 0x74, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50,
-	0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
-	0x0a, 0x0a, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x09, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x91, 0x01,
-	0x0a, 0x29, 0x4c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x47, 0x65, 0x74, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x67, 0x72,
+	0x65, 0x57, 0x72, 0x6f, 0x6e, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x73,
+	0x18, 0x0f, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x73, 0x12, 0x19,
+	0x0a, 0x08, 0x69, 0x73, 0x5f, 0x6c, 0x65, 0x65, 0x63, 0x68, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x69, 0x73, 0x4c, 0x65, 0x65, 0x63, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x75, 0x73,
+	0x70, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x75,
+	0x73, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x22, 0x54, 0x0a, 0x0e, 0x52, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x79,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x64, 0x61, 0x79, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x68, 0x6f, 0x75, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x68, 0x6f,
+	0x75, 0x72, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x22, 0x78, 0x0a,
+	0x10, 0x4c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x02, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x27, 0x0a,
+	0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
+	0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x46, 0x0a, 0x27, 0x4c, 0x65, 0x61, 0x72, 0x6e,
+	0x69, 0x6e, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x64, 0x22,
+	0x62, 0x0a, 0x28, 0x4c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x67, 0x72,
 	0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x08, 0x70,
 	0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e,
 	0x67, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72,
-	0x65, 0x73, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64,
-	0x5f, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0d, 0x52,
-	0x10, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x55, 0x6e, 0x69, 0x74, 0x49, 0x64,
-	0x73, 0x22, 0x40, 0x0a, 0x25, 0x4c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x47, 0x65, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x50, 0x72, 0x6f, 0x67, 0x72,
-	0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x6e,
-	0x69, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x75, 0x6e, 0x69,
-	0x74, 0x49, 0x64, 0x22, 0x80, 0x01, 0x0a, 0x28, 0x4c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x6e, 0x69,
-	0x74, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x17, 0x0a, 0x07, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x06, 0x75, 0x6e, 0x69, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x73,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70,
-	0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x6f, 0x6d,
-	0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x22, 0x2b, 0x0a, 0x29, 0x4c, 0x65, 0x61, 0x72, 0x6e, 0x69,
-	0x6e, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55,
-	0x6e, 0x69, 0x74, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x3e, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a,
-	0x0e, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x55, 0x6e, 0x69,
-	0x74, 0x49, 0x64, 0x22, 0x47, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c,
-	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
-	0x55, 0x6e, 0x69, 0x74, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xbb, 0x01, 0x0a,
-	0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x65,
-	0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x0c, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x55, 0x6e, 0x69, 0x74, 0x49, 0x64,
-	0x12, 0x3b, 0x0a, 0x0d, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x79, 0x5f, 0x6c, 0x65, 0x76, 0x65,
-	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x76, 0x31, 0x2e, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x79, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52,
-	0x0c, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x79, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x14, 0x0a,
-	0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f,
-	0x74, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x64, 0x75, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x73, 0x74, 0x75,
-	0x64, 0x79, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x1c, 0x0a, 0x1a, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xb3, 0x01, 0x0a, 0x1c, 0x4c, 0x69, 0x73,
-	0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x46, 0x6f, 0x72, 0x52, 0x65, 0x76, 0x69,
-	0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a,
-	0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x2e, 0x0a, 0x05, 0x74, 0x79,
-	0x70, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x55, 0x6e, 0x69, 0x74, 0x54,
-	0x79, 0x70, 0x65, 0x52, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61,
-	0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x1e,
-	0x0a, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x22, 0x67,
-	0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x46, 0x6f,
-	0x72, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x30, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x6d,
-	0x6f, 0x72, 0x79, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65,
-	0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0xa0, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4d,
-	0x65, 0x6d, 0x6f, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x31, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72,
-	0x79, 0x55, 0x6e, 0x69, 0x74, 0x54, 0x79, 0x70, 0x65, 0x48, 0x00, 0x52, 0x04, 0x74, 0x79, 0x70,
-	0x65, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x48, 0x01, 0x52, 0x03, 0x74, 0x61, 0x67, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x63,
-	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52,
-	0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05,
-	0x5f, 0x74, 0x79, 0x70, 0x65, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x74, 0x61, 0x67, 0x42, 0x0b, 0x0a,
-	0x09, 0x5f, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x22, 0xee, 0x03, 0x0a, 0x16, 0x47,
-	0x65, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6c,
-	0x65, 0x61, 0x72, 0x6e, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x74, 0x6f,
-	0x74, 0x61, 0x6c, 0x4c, 0x65, 0x61, 0x72, 0x6e, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x61,
-	0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x0d, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e,
-	0x74, 0x12, 0x2a, 0x0a, 0x11, 0x6e, 0x65, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
-	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x6e, 0x65,
-	0x65, 0x64, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x28, 0x0a,
-	0x10, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x74, 0x69, 0x6d,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x74,
-	0x75, 0x64, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x51, 0x0a, 0x0b, 0x6c, 0x65, 0x76, 0x65, 0x6c,
-	0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72,
-	0x79, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4c,
-	0x65, 0x76, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a,
-	0x6c, 0x65, 0x76, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x5d, 0x0a, 0x0f, 0x72, 0x65,
-	0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x47,
-	0x65, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x61, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x72, 0x65, 0x74, 0x65, 0x6e,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x73, 0x1a, 0x3d, 0x0a, 0x0f, 0x4c, 0x65, 0x76,
-	0x65, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x65, 0x73, 0x73, 0x22, 0x49, 0x0a, 0x28, 0x4c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x47, 0x65, 0x74, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x09, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x91,
+	0x01, 0x0a, 0x29, 0x4c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x47, 0x65, 0x74, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x67,
+	0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x08,
+	0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x61, 0x72, 0x6e, 0x69,
+	0x6e, 0x67, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x67,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0d,
+	0x52, 0x10, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x55, 0x6e, 0x69, 0x74, 0x49,
+	0x64, 0x73, 0x22, 0x40, 0x0a, 0x25, 0x4c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x47, 0x65, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x50, 0x72, 0x6f, 0x67,
+	0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75,
+	0x6e, 0x69, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x75, 0x6e,
+	0x69, 0x74, 0x49, 0x64, 0x22, 0x80, 0x01, 0x0a, 0x28, 0x4c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e,
+	0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x6e,
+	0x69, 0x74, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x06, 0x75, 0x6e, 0x69, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09,
+	0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d,
+	0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x6f,
+	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x22, 0x2b, 0x0a, 0x29, 0x4c, 0x65, 0x61, 0x72, 0x6e,
+	0x69, 0x6e, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x55, 0x6e, 0x69, 0x74, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3e, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72,
+	0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24,
+	0x0a, 0x0e, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x55, 0x6e,
+	0x69, 0x74, 0x49, 0x64, 0x22, 0x47, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72,
+	0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72,
+	0x79, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xbb, 0x01,
+	0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x6d,
+	0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x55, 0x6e, 0x69, 0x74, 0x49,
+	0x64, 0x12, 0x3b, 0x0a, 0x0d, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x79, 0x5f, 0x6c, 0x65, 0x76,
+	0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x79, 0x4c, 0x65, 0x76, 0x65, 0x6c,
+	0x52, 0x0c, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x79, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x14,
+	0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6e,
+	0x6f, 0x74, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x64, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x73, 0x74,
+	0x75, 0x64, 0x79, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x1c, 0x0a, 0x1a, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xb3, 0x01, 0x0a, 0x1c, 0x4c, 0x69,
+	0x73, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x46, 0x6f, 0x72, 0x52, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
+	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1b,
+	0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x2e, 0x0a, 0x05, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x55, 0x6e, 0x69, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x61, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12,
+	0x1e, 0x0a, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x22,
+	0x67, 0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x46,
+	0x6f, 0x72, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x30, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65,
+	0x6d, 0x6f, 0x72, 0x79, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0xcd, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74,
+	0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x31, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x6d, 0x6f,
+	0x72, 0x79, 0x55, 0x6e, 0x69, 0x74, 0x54, 0x79, 0x70, 0x65, 0x48, 0x00, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x01, 0x52, 0x03, 0x74, 0x61, 0x67, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08,
+	0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02,
+	0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x88, 0x01, 0x01, 0x12, 0x2b, 0x0a,
+	0x11, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f,
+	0x77, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x10, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74,
+	0x69, 0x6f, 0x6e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x74, 0x61, 0x67, 0x42, 0x0b, 0x0a, 0x09, 0x5f,
+	0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x22, 0x8d, 0x02, 0x0a, 0x0f, 0x52, 0x65, 0x74,
+	0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x12, 0x0a, 0x04,
+	0x64, 0x61, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x64, 0x61, 0x79, 0x73,
+	0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x63, 0x6f, 0x72, 0x72,
+	0x65, 0x63, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65, 0x12, 0x4b, 0x0a, 0x0c,
+	0x72, 0x61, 0x74, 0x65, 0x5f, 0x62, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x29, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x2e, 0x52, 0x61,
+	0x74, 0x65, 0x42, 0x79, 0x54, 0x79, 0x70, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x72,
+	0x61, 0x74, 0x65, 0x42, 0x79, 0x54, 0x79, 0x70, 0x65, 0x1a, 0x3d, 0x0a, 0x0f, 0x52, 0x61, 0x74,
+	0x65, 0x42, 0x79, 0x54, 0x79, 0x70, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
 	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x41, 0x0a, 0x13, 0x52, 0x65, 0x74, 0x65,
-	0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
-	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65,
-	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x81, 0x01, 0x0a, 0x11,
-	0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x57, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x17, 0x0a, 0x07, 0x77, 0x6f, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x06, 0x77, 0x6f, 0x72, 0x64, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x06, 0x72, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73, 0x75,
-	0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22,
-	0x14, 0x0a, 0x12, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x57, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8b, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77,
-	0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e,
-	0x0a, 0x0b, 0x68, 0x61, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x09, 0x68, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x49, 0x64, 0x12, 0x2e,
-	0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77,
-	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x23,
-	0x0a, 0x0d, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54,
-	0x69, 0x6d, 0x65, 0x22, 0x17, 0x0a, 0x15, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x48, 0x61, 0x6e,
-	0x43, 0x68, 0x61, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xaa, 0x02, 0x0a,
-	0x0c, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x24, 0x0a,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xb5, 0x05, 0x0a, 0x16, 0x47, 0x65, 0x74,
+	0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6c, 0x65, 0x61,
+	0x72, 0x6e, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x4c, 0x65, 0x61, 0x72, 0x6e, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x61, 0x73, 0x74,
+	0x65, 0x72, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0d, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x2a, 0x0a, 0x11, 0x6e, 0x65, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x6e, 0x65, 0x65, 0x64,
+	0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x74, 0x75, 0x64,
+	0x79, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x51, 0x0a, 0x0b, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x5f, 0x73,
+	0x74, 0x61, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4c, 0x65, 0x76,
+	0x65, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x6c, 0x65,
+	0x76, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x5d, 0x0a, 0x0f, 0x72, 0x65, 0x74, 0x65,
+	0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x34, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74,
+	0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x73, 0x12, 0x37, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x65, 0x6e,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x57,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x09, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x4e, 0x0a, 0x0a, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x08,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x74, 0x79, 0x70, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x1a, 0x3d, 0x0a, 0x0f, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a,
+	0x41, 0x0a, 0x13, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x1a, 0x3c, 0x0a, 0x0e, 0x54, 0x79, 0x70, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0xcd, 0x01, 0x0a, 0x11, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x57, 0x6f, 0x72, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x77, 0x6f, 0x72, 0x64, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x77, 0x6f, 0x72, 0x64, 0x49, 0x64, 0x12,
+	0x2e, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
+	0x23, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x05, 0x67, 0x72, 0x61, 0x64,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x22, 0x14, 0x0a, 0x12, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x57, 0x6f, 0x72, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xd7, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1e, 0x0a, 0x0b, 0x68, 0x61, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x68, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x49, 0x64, 0x12,
+	0x2e, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
+	0x23, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x05, 0x67, 0x72, 0x61, 0x64,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x22, 0x17, 0x0a, 0x15, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xfd, 0x01, 0x0a, 0x0d, 0x52, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x55, 0x6e, 0x69, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2b, 0x0a, 0x05, 0x67, 0x72, 0x61, 0x64,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x05,
+	0x67, 0x72, 0x61, 0x64, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x10, 0x0a, 0x0e, 0x52, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa3, 0x05, 0x0a, 0x0c,
+	0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x24, 0x0a, 0x0e,
+	0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x55, 0x6e, 0x69, 0x74,
+	0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x06, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x3b, 0x0a, 0x0b, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x0a, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x39, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x2b, 0x0a, 0x05, 0x67, 0x72, 0x61, 0x64,
+	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x05,
+	0x67, 0x72, 0x61, 0x64, 0x65, 0x12, 0x4e, 0x0a, 0x15, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x64, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x13, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x52, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x3d, 0x0a, 0x0e, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x79,
+	0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x79,
+	0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x0d, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x79, 0x42, 0x65,
+	0x66, 0x6f, 0x72, 0x65, 0x12, 0x3b, 0x0a, 0x0d, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x79, 0x5f,
+	0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x79, 0x4c, 0x65,
+	0x76, 0x65, 0x6c, 0x52, 0x0c, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x79, 0x41, 0x66, 0x74, 0x65,
+	0x72, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x62, 0x65,
+	0x66, 0x6f, 0x72, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x41, 0x66, 0x74, 0x65,
+	0x72, 0x12, 0x2e, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x71, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a,
 	0x0e, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x55, 0x6e, 0x69,
-	0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x06,
-	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x23, 0x0a, 0x0d,
-	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x69, 0x6d,
-	0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x74, 0x69, 0x6d, 0x65,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x52, 0x0a, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x39,
-	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xad, 0x01, 0x0a, 0x1a, 0x53, 0x75,
-	0x62, 0x6d, 0x69, 0x74, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x52, 0x65, 0x76, 0x69, 0x65,
-	0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0b, 0x68, 0x61, 0x6e, 0x5f,
-	0x63, 0x68, 0x61, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0,
-	0x41, 0x02, 0x52, 0x09, 0x68, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x49, 0x64, 0x12, 0x28, 0x0a,
-	0x0d, 0x69, 0x73, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x67, 0x6e, 0x69, 0x7a, 0x65, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x08, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x0c, 0x69, 0x73, 0x52, 0x65, 0x63,
-	0x6f, 0x67, 0x6e, 0x69, 0x7a, 0x65, 0x64, 0x12, 0x40, 0x0a, 0x0b, 0x72, 0x65, 0x76, 0x69, 0x65,
-	0x77, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x0a, 0x72,
-	0x65, 0x76, 0x69, 0x65, 0x77, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x63, 0x0a, 0x1b, 0x53, 0x75, 0x62,
-	0x6d, 0x69, 0x74, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x10, 0x6e, 0x65, 0x78, 0x74,
-	0x5f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x55, 0x6e, 0x69,
+	0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65,
+	0x53, 0x69, 0x7a, 0x65, 0x22, 0x63, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x6f,
+	0x72, 0x79, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x30, 0x0a, 0x07, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65,
+	0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x07, 0x72, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0xa9, 0x01, 0x0a, 0x09, 0x53, 0x74,
+	0x75, 0x64, 0x79, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x2c, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x55, 0x6e, 0x69, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x75,
+	0x6e, 0x69, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6d, 0x65,
+	0x6d, 0x6f, 0x72, 0x79, 0x55, 0x6e, 0x69, 0x74, 0x49, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x69, 0x73,
+	0x5f, 0x6e, 0x65, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x69, 0x73, 0x4e, 0x65,
+	0x77, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x65, 0x78, 0x74, 0x22, 0x81, 0x02, 0x0a, 0x18, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53,
+	0x74, 0x75, 0x64, 0x79, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x2e, 0x0a, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0e, 0x32, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x6d,
+	0x6f, 0x72, 0x79, 0x55, 0x6e, 0x69, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x12, 0x20, 0x0a, 0x09, 0x6e, 0x65, 0x77, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x08, 0x6e, 0x65, 0x77, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x57,
+	0x6f, 0x72, 0x64, 0x44, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x4c, 0x65, 0x76,
+	0x65, 0x6c, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x1b, 0x0a,
+	0x09, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x6f,
+	0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0a, 0x6e, 0x6f, 0x74, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x49, 0x64, 0x42, 0x0c, 0x0a, 0x0a, 0x5f,
+	0x6e, 0x65, 0x77, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xa5, 0x01, 0x0a, 0x19, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x53, 0x74, 0x75, 0x64, 0x79, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x74, 0x75, 0x64, 0x79, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d,
+	0x73, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x65, 0x77, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6e, 0x65, 0x77, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x22, 0xe5, 0x02, 0x0a, 0x0f, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74,
+	0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x2c,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x55, 0x6e,
+	0x69, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x06, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2b, 0x0a, 0x05, 0x67,
+	0x72, 0x61, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x47, 0x72, 0x61, 0x64,
+	0x65, 0x52, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0c, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x3b, 0x0a,
+	0x0b, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0e,
-	0x6e, 0x65, 0x78, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x62,
-	0x0a, 0x15, 0x47, 0x65, 0x74, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x54, 0x65, 0x73, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x05, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x10, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79,
-	0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x03, 0xe0, 0x41,
-	0x02, 0x52, 0x0f, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x4c, 0x65, 0x76,
-	0x65, 0x6c, 0x22, 0x48, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72,
-	0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x09,
-	0x68, 0x61, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x61, 0x6e, 0x43, 0x68,
-	0x61, 0x72, 0x52, 0x08, 0x68, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x73, 0x22, 0x5c, 0x0a, 0x1e,
-	0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x54, 0x65, 0x73,
-	0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a,
-	0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x61, 0x6e, 0x43, 0x68,
-	0x61, 0x72, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x42, 0x03, 0xe0, 0x41,
-	0x02, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x58, 0x0a, 0x11, 0x48, 0x61,
-	0x6e, 0x43, 0x68, 0x61, 0x72, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a,
+	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0xb5, 0x01, 0x0a, 0x15, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e,
+	0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x64,
+	0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x33, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x75, 0x6e, 0x69, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6d, 0x65, 0x6d, 0x6f, 0x72,
+	0x79, 0x55, 0x6e, 0x69, 0x74, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x22, 0x4f, 0x0a, 0x18, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x33, 0x0a,
+	0x07, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x07, 0x72, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x73, 0x22, 0x56, 0x0a, 0x19, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x39, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x7c, 0x0a, 0x16, 0x46, 0x6f,
+	0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x04, 0x64, 0x61, 0x79, 0x73, 0x12, 0x2e, 0x0a, 0x05, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x55, 0x6e, 0x69, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0b, 0x6e, 0x65, 0x77, 0x5f,
+	0x70, 0x65, 0x72, 0x5f, 0x64, 0x61, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6e,
+	0x65, 0x77, 0x50, 0x65, 0x72, 0x44, 0x61, 0x79, 0x22, 0x59, 0x0a, 0x13, 0x4d, 0x65, 0x6d, 0x6f,
+	0x72, 0x79, 0x55, 0x6e, 0x69, 0x74, 0x54, 0x79, 0x70, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x2c, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x55,
+	0x6e, 0x69, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x22, 0xea, 0x01, 0x0a, 0x11, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x46, 0x6f,
+	0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x44, 0x61, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x0a,
+	0x09, 0x64, 0x75, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x08, 0x64, 0x75, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3d, 0x0a, 0x0b, 0x64, 0x75,
+	0x65, 0x5f, 0x62, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72,
+	0x79, 0x55, 0x6e, 0x69, 0x74, 0x54, 0x79, 0x70, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x09,
+	0x64, 0x75, 0x65, 0x42, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x65, 0x77,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6e, 0x65,
+	0x77, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x69, 0x6d, 0x75, 0x6c, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0e, 0x73, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0x6f, 0x0a, 0x17, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x04, 0x64,
+	0x61, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x46, 0x6f, 0x72, 0x65, 0x63,
+	0x61, 0x73, 0x74, 0x44, 0x61, 0x79, 0x52, 0x04, 0x64, 0x61, 0x79, 0x73, 0x12, 0x23, 0x0a, 0x0d,
+	0x6f, 0x76, 0x65, 0x72, 0x64, 0x75, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6f, 0x76, 0x65, 0x72, 0x64, 0x75, 0x65, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x22, 0x75, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x65, 0x65, 0x63, 0x68, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08,
+	0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x2e, 0x0a, 0x05, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x55, 0x6e, 0x69, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x22, 0x5b, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74,
+	0x4c, 0x65, 0x65, 0x63, 0x68, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2e, 0x0a, 0x07, 0x6c, 0x65, 0x65, 0x63, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x6d, 0x6f,
+	0x72, 0x79, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x07, 0x6c, 0x65, 0x65, 0x63, 0x68, 0x65, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x42, 0x0a, 0x1a, 0x55, 0x6e, 0x73, 0x75, 0x73, 0x70, 0x65,
+	0x6e, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x75, 0x6e,
+	0x69, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6d, 0x65, 0x6d,
+	0x6f, 0x72, 0x79, 0x55, 0x6e, 0x69, 0x74, 0x49, 0x64, 0x22, 0x4b, 0x0a, 0x1b, 0x55, 0x6e, 0x73,
+	0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x55, 0x6e, 0x69, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x39, 0x0a, 0x11, 0x52, 0x65, 0x73, 0x65, 0x74, 0x4c,
+	0x65, 0x65, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x6d,
+	0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x55, 0x6e, 0x69, 0x74, 0x49,
+	0x64, 0x22, 0x42, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x65, 0x74, 0x4c, 0x65, 0x65, 0x63, 0x68, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xb2, 0x01, 0x0a, 0x0b, 0x48, 0x61, 0x72, 0x64, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x65, 0x6d, 0x6f, 0x72, 0x79, 0x55, 0x6e, 0x69, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x65, 0x65, 0x63, 0x68, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6c, 0x65, 0x65,
+	0x63, 0x68, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x5f, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x4c, 0x61, 0x70, 0x73, 0x65, 0x73, 0x22, 0x5f, 0x0a, 0x17, 0x4c, 0x69,
+	0x73, 0x74, 0x48, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x55, 0x6e, 0x69, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x05,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x4d, 0x0a, 0x18, 0x4c,
+	0x69, 0x73, 0x74, 0x48, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x53, 0x0a, 0x17, 0x47, 0x65,
+	0x74, 0x53, 0x74, 0x75, 0x64, 0x79, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64,
+	0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x44, 0x61, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x22,
+	0xd9, 0x01, 0x0a, 0x0b, 0x53, 0x74, 0x75, 0x64, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x6f, 0x6e,
+	0x67, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x6c, 0x6f, 0x6e, 0x67,
+	0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x5f, 0x64, 0x61,
+	0x79, 0x73, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x66,
+	0x72, 0x65, 0x65, 0x7a, 0x65, 0x44, 0x61, 0x79, 0x73, 0x55, 0x73, 0x65, 0x64, 0x12, 0x1f, 0x0a,
+	0x0b, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0a, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x44, 0x61, 0x79, 0x73, 0x12, 0x23,
+	0x0a, 0x0d, 0x73, 0x74, 0x75, 0x64, 0x69, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x64, 0x61, 0x79, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x73, 0x74, 0x75, 0x64, 0x69, 0x65, 0x64, 0x54, 0x6f,
+	0x64, 0x61, 0x79, 0x12, 0x26, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x74, 0x75, 0x64,
+	0x79, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x61,
+	0x73, 0x74, 0x53, 0x74, 0x75, 0x64, 0x79, 0x44, 0x61, 0x74, 0x65, 0x22, 0x8b, 0x01, 0x0a, 0x10,
+	0x53, 0x74, 0x75, 0x64, 0x79, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x44, 0x61, 0x79,
+	0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x64, 0x61, 0x74, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x72, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x65, 0x77, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6e, 0x65, 0x77, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x6d, 0x69,
+	0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x73, 0x74, 0x75,
+	0x64, 0x79, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x22, 0x79, 0x0a, 0x18, 0x47, 0x65, 0x74,
+	0x53, 0x74, 0x75, 0x64, 0x79, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x74, 0x75, 0x64, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x52, 0x06, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6b, 0x12, 0x2e, 0x0a, 0x04, 0x64, 0x61, 0x79, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74,
+	0x75, 0x64, 0x79, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x44, 0x61, 0x79, 0x52, 0x04,
+	0x64, 0x61, 0x79, 0x73, 0x22, 0x3e, 0x0a, 0x16, 0x52, 0x65, 0x73, 0x65, 0x74, 0x4d, 0x65, 0x6d,
+	0x6f, 0x72, 0x79, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24,
+	0x0a, 0x0e, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x55, 0x6e,
+	0x69, 0x74, 0x49, 0x64, 0x22, 0x47, 0x0a, 0x17, 0x52, 0x65, 0x73, 0x65, 0x74, 0x4d, 0x65, 0x6d,
+	0x6f, 0x72, 0x79, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72,
+	0x79, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x3d, 0x0a,
+	0x15, 0x42, 0x75, 0x72, 0x79, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x55, 0x6e, 0x69, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
+	0x5f, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c,
+	0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x55, 0x6e, 0x69, 0x74, 0x49, 0x64, 0x22, 0x46, 0x0a, 0x16,
+	0x42, 0x75, 0x72, 0x79, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x22, 0x5c, 0x0a, 0x1b, 0x52, 0x65, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x75, 0x6e,
+	0x69, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6d, 0x65, 0x6d,
+	0x6f, 0x72, 0x79, 0x55, 0x6e, 0x69, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x04, 0x64, 0x61,
+	0x74, 0x65, 0x22, 0x4c, 0x0a, 0x1c, 0x52, 0x65, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65,
+	0x6d, 0x6f, 0x72, 0x79, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x22, 0x66, 0x0a, 0x1b, 0x52, 0x65, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x44, 0x75,
+	0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x17, 0x0a, 0x04, 0x64, 0x61, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x03, 0xe0,
+	0x41, 0x02, 0x52, 0x04, 0x64, 0x61, 0x79, 0x73, 0x12, 0x2e, 0x0a, 0x05, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x55, 0x6e, 0x69, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x22, 0x4b, 0x0a, 0x1c, 0x52, 0x65, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x44, 0x75, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x72, 0x65, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x10, 0x72, 0x65, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x32, 0x0a, 0x13, 0x46, 0x6f, 0x72, 0x67, 0x65, 0x74, 0x43,
+	0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09,
+	0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x49, 0x64, 0x22, 0x37, 0x0a, 0x14, 0x46, 0x6f, 0x72,
+	0x67, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x65, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x65, 0x74, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x22, 0xad, 0x01, 0x0a, 0x1a, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x48, 0x61, 0x6e,
+	0x43, 0x68, 0x61, 0x72, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x23, 0x0a, 0x0b, 0x68, 0x61, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x09, 0x68, 0x61, 0x6e,
+	0x43, 0x68, 0x61, 0x72, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x0d, 0x69, 0x73, 0x5f, 0x72, 0x65, 0x63,
+	0x6f, 0x67, 0x6e, 0x69, 0x7a, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x42, 0x03, 0xe0,
+	0x41, 0x02, 0x52, 0x0c, 0x69, 0x73, 0x52, 0x65, 0x63, 0x6f, 0x67, 0x6e, 0x69, 0x7a, 0x65, 0x64,
+	0x12, 0x40, 0x0a, 0x0b, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x0a, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x54, 0x69,
+	0x6d, 0x65, 0x22, 0x63, 0x0a, 0x1b, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x48, 0x61, 0x6e, 0x43,
+	0x68, 0x61, 0x72, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x44, 0x0a, 0x10, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0e, 0x6e, 0x65, 0x78, 0x74, 0x52, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xc0, 0x01, 0x0a, 0x1f, 0x53, 0x75, 0x62, 0x6d,
+	0x69, 0x74, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x48, 0x61, 0x6e, 0x64, 0x77, 0x72, 0x69,
+	0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0b, 0x68,
+	0x61, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x09, 0x68, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x49, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x07, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x12, 0x37, 0x0a, 0x07, 0x73, 0x74,
+	0x72, 0x6f, 0x6b, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x6f, 0x6b, 0x65, 0x50, 0x6f, 0x6c,
+	0x79, 0x6c, 0x69, 0x6e, 0x65, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x07, 0x73, 0x74, 0x72, 0x6f,
+	0x6b, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x73, 0x74, 0x75,
+	0x64, 0x79, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x88, 0x01, 0x0a, 0x20, 0x53,
+	0x75, 0x62, 0x6d, 0x69, 0x74, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x48, 0x61, 0x6e, 0x64,
+	0x77, 0x72, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x74, 0x72,
+	0x6f, 0x6b, 0x65, 0x5f, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x08, 0x52, 0x0d, 0x73, 0x74, 0x72, 0x6f, 0x6b, 0x65, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74,
+	0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x73, 0x74, 0x72, 0x6f, 0x6b, 0x65,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x65, 0x78, 0x74, 0x72, 0x61, 0x53, 0x74,
+	0x72, 0x6f, 0x6b, 0x65, 0x73, 0x22, 0xab, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x48, 0x61, 0x6e,
+	0x43, 0x68, 0x61, 0x72, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x19, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x03,
+	0xe0, 0x41, 0x02, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x10, 0x64, 0x69,
+	0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x0f, 0x64, 0x69, 0x66, 0x66, 0x69,
+	0x63, 0x75, 0x6c, 0x74, 0x79, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x33, 0x0a, 0x05, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x51, 0x75, 0x65, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x12,
+	0x12, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73,
+	0x65, 0x65, 0x64, 0x22, 0x95, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x48, 0x61, 0x6e, 0x43, 0x68,
+	0x61, 0x72, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e,
+	0x0a, 0x09, 0x68, 0x61, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x61, 0x6e,
+	0x43, 0x68, 0x61, 0x72, 0x52, 0x08, 0x68, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x73, 0x12, 0x12,
+	0x0a, 0x04, 0x73, 0x65, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x65,
+	0x65, 0x64, 0x12, 0x37, 0x0a, 0x09, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31,
+	0x2e, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x09, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x96, 0x01, 0x0a, 0x0f,
+	0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12,
 	0x1e, 0x0a, 0x0b, 0x68, 0x61, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x68, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x49, 0x64, 0x12,
-	0x23, 0x0a, 0x0d, 0x69, 0x73, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x67, 0x6e, 0x69, 0x7a, 0x65, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x73, 0x52, 0x65, 0x63, 0x6f, 0x67, 0x6e,
-	0x69, 0x7a, 0x65, 0x64, 0x22, 0x21, 0x0a, 0x1f, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x48, 0x61,
+	0x31, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72,
+	0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x22, 0xdb, 0x01, 0x0a, 0x1e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x48,
+	0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x76, 0x31, 0x2e, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x54, 0x65, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x12,
+	0x0a, 0x04, 0x73, 0x65, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x65,
+	0x65, 0x64, 0x12, 0x35, 0x0a, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48,
+	0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x54, 0x65, 0x73, 0x74, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72,
+	0x52, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x12, 0x37, 0x0a, 0x09, 0x74, 0x65, 0x73,
+	0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x74, 0x65, 0x73, 0x74, 0x54, 0x69,
+	0x6d, 0x65, 0x22, 0x58, 0x0a, 0x11, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x54, 0x65, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1e, 0x0a, 0x0b, 0x68, 0x61, 0x6e, 0x5f, 0x63,
+	0x68, 0x61, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x68, 0x61,
+	0x6e, 0x43, 0x68, 0x61, 0x72, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x73, 0x5f, 0x72, 0x65,
+	0x63, 0x6f, 0x67, 0x6e, 0x69, 0x7a, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c,
+	0x69, 0x73, 0x52, 0x65, 0x63, 0x6f, 0x67, 0x6e, 0x69, 0x7a, 0x65, 0x64, 0x22, 0x95, 0x01, 0x0a,
+	0x11, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x54, 0x65, 0x73, 0x74, 0x41, 0x6e, 0x73, 0x77,
+	0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0b, 0x68, 0x61, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x68, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72,
+	0x49, 0x64, 0x12, 0x31, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x61, 0x6e, 0x43,
+	0x68, 0x61, 0x72, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4a, 0x04,
+	0x08, 0x03, 0x10, 0x04, 0x22, 0x9d, 0x01, 0x0a, 0x10, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72,
+	0x54, 0x65, 0x73, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x12, 0x1e, 0x0a, 0x0b, 0x68, 0x61, 0x6e,
+	0x5f, 0x63, 0x68, 0x61, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09,
+	0x68, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x76, 0x31, 0x2e, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63,
+	0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x4a, 0x04,
+	0x08, 0x04, 0x10, 0x05, 0x22, 0x55, 0x0a, 0x1f, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x48, 0x61,
 	0x6e, 0x43, 0x68, 0x61, 0x72, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x39, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x4e, 0x65,
-	0x77, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x4c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x05, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x22, 0x5d, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x77, 0x48, 0x61, 0x6e, 0x43,
-	0x68, 0x61, 0x72, 0x4c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x06, 0x67, 0x72, 0x61, 0x64, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x76, 0x31, 0x2e, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x54, 0x65, 0x73, 0x74, 0x47, 0x72,
+	0x61, 0x64, 0x65, 0x52, 0x06, 0x67, 0x72, 0x61, 0x64, 0x65, 0x73, 0x22, 0x8a, 0x01, 0x0a, 0x1c,
+	0x47, 0x65, 0x74, 0x4e, 0x65, 0x77, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x4c, 0x65, 0x61,
+	0x72, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x03, 0xe0, 0x41, 0x02,
+	0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x33, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76,
+	0x31, 0x2e, 0x57, 0x6f, 0x72, 0x64, 0x44, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79,
+	0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x1a, 0x0a, 0x08,
+	0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x22, 0x5d, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x4e,
+	0x65, 0x77, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x4c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x08, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x4c, 0x65,
+	0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xa1, 0x01, 0x0a, 0x16, 0x48, 0x61, 0x6e, 0x43,
+	0x68, 0x61, 0x72, 0x4c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0b, 0x68, 0x61, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x68, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72,
+	0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x68, 0x61, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x68, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x12, 0x16, 0x0a,
+	0x06, 0x70, 0x69, 0x6e, 0x79, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70,
+	0x69, 0x6e, 0x79, 0x69, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x12,
+	0x1a, 0x0a, 0x08, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x08, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x22, 0xdd, 0x01, 0x0a, 0x25,
+	0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x4e, 0x65, 0x77, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72,
+	0x4c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x44, 0x0a, 0x0d, 0x6c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e,
+	0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x0c, 0x6c,
+	0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x0e, 0x73,
+	0x74, 0x75, 0x64, 0x79, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x0d, 0x73, 0x74, 0x75, 0x64, 0x79, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x42, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x76, 0x31, 0x2e, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x4c, 0x65, 0x61, 0x72, 0x6e,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x42, 0x03, 0xe0,
+	0x41, 0x02, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x85, 0x01, 0x0a, 0x19,
+	0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x4c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x2a, 0x0a, 0x0f, 0x6e, 0x65, 0x77,
+	0x5f, 0x68, 0x61, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x0c, 0x6e, 0x65, 0x77, 0x48, 0x61, 0x6e, 0x43,
+	0x68, 0x61, 0x72, 0x49, 0x64, 0x12, 0x3c, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31,
 	0x2e, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x4c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67,
-	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
-	0x73, 0x22, 0xa1, 0x01, 0x0a, 0x16, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x4c, 0x65, 0x61,
-	0x72, 0x6e, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0b,
-	0x68, 0x61, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x68, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08,
-	0x68, 0x61, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x68, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x69, 0x6e, 0x79, 0x69,
-	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x69, 0x6e, 0x79, 0x69, 0x6e, 0x12,
-	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x6d, 0x65, 0x61, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x61,
-	0x6d, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x65, 0x78, 0x61,
-	0x6d, 0x70, 0x6c, 0x65, 0x73, 0x22, 0xdd, 0x01, 0x0a, 0x25, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74,
-	0x4e, 0x65, 0x77, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x4c, 0x65, 0x61, 0x72, 0x6e, 0x69,
-	0x6e, 0x67, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x44, 0x0a, 0x0d, 0x6c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x0c, 0x6c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e,
-	0x67, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x0e, 0x73, 0x74, 0x75, 0x64, 0x79, 0x5f, 0x64,
-	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x03, 0xe0,
-	0x41, 0x02, 0x52, 0x0d, 0x73, 0x74, 0x75, 0x64, 0x79, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x42, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x61,
-	0x6e, 0x43, 0x68, 0x61, 0x72, 0x4c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x07, 0x72, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x85, 0x01, 0x0a, 0x19, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61,
-	0x72, 0x4c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x49,
-	0x74, 0x65, 0x6d, 0x12, 0x2a, 0x0a, 0x0f, 0x6e, 0x65, 0x77, 0x5f, 0x68, 0x61, 0x6e, 0x5f, 0x63,
-	0x68, 0x61, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41,
-	0x02, 0x52, 0x0c, 0x6e, 0x65, 0x77, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x49, 0x64, 0x12,
-	0x3c, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x61, 0x6e, 0x43, 0x68,
-	0x61, 0x72, 0x4c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x28, 0x0a,
-	0x26, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x4e, 0x65, 0x77, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61,
-	0x72, 0x4c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xff, 0x01, 0x0a, 0x15, 0x48, 0x61, 0x6e, 0x43,
-	0x68, 0x61, 0x72, 0x4c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x12, 0x2a, 0x0a, 0x11, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x74, 0x72, 0x79, 0x5f, 0x63,
-	0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x66, 0x69,
-	0x72, 0x73, 0x74, 0x54, 0x72, 0x79, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x12, 0x2c, 0x0a,
-	0x12, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x5f, 0x74, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x72, 0x72,
-	0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x73, 0x65, 0x63, 0x6f, 0x6e,
-	0x64, 0x54, 0x72, 0x79, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x74,
-	0x68, 0x69, 0x72, 0x64, 0x5f, 0x74, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x74, 0x68, 0x69, 0x72, 0x64, 0x54, 0x72, 0x79,
-	0x43, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x61, 0x73, 0x74, 0x65,
-	0x72, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6d, 0x61, 0x73, 0x74, 0x65,
-	0x72, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43,
-	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x5f,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x63, 0x6f, 0x72,
-	0x72, 0x65, 0x63, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x2a, 0x6c, 0x0a, 0x0e, 0x4d, 0x65, 0x6d,
-	0x6f, 0x72, 0x79, 0x55, 0x6e, 0x69, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x1c, 0x4d,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x06, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x22, 0x28, 0x0a, 0x26, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x4e, 0x65, 0x77,
+	0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x4c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xff, 0x01,
+	0x0a, 0x15, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x4c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x66, 0x69, 0x72, 0x73, 0x74,
+	0x5f, 0x74, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0f, 0x66, 0x69, 0x72, 0x73, 0x74, 0x54, 0x72, 0x79, 0x43, 0x6f, 0x72, 0x72,
+	0x65, 0x63, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x5f, 0x74, 0x72,
+	0x79, 0x5f, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x10, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x54, 0x72, 0x79, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x63,
+	0x74, 0x12, 0x2a, 0x0a, 0x11, 0x74, 0x68, 0x69, 0x72, 0x64, 0x5f, 0x74, 0x72, 0x79, 0x5f, 0x63,
+	0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x74, 0x68,
+	0x69, 0x72, 0x64, 0x54, 0x72, 0x79, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f,
+	0x72, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0c, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x2a,
+	0xee, 0x01, 0x0a, 0x0e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x55, 0x6e, 0x69, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x20, 0x0a, 0x1c, 0x4d, 0x45, 0x4d, 0x4f, 0x52, 0x59, 0x5f, 0x55, 0x4e, 0x49,
+	0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x4d, 0x45, 0x4d, 0x4f, 0x52, 0x59, 0x5f, 0x55,
+	0x4e, 0x49, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x48, 0x41, 0x4e, 0x5f, 0x43, 0x48, 0x41,
+	0x52, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x4d, 0x45, 0x4d, 0x4f, 0x52, 0x59, 0x5f, 0x55, 0x4e,
+	0x49, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x57, 0x4f, 0x52, 0x44, 0x10, 0x02, 0x12, 0x1b,
+	0x0a, 0x17, 0x4d, 0x45, 0x4d, 0x4f, 0x52, 0x59, 0x5f, 0x55, 0x4e, 0x49, 0x54, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x50, 0x48, 0x52, 0x41, 0x53, 0x45, 0x10, 0x03, 0x12, 0x1d, 0x0a, 0x19, 0x4d,
 	0x45, 0x4d, 0x4f, 0x52, 0x59, 0x5f, 0x55, 0x4e, 0x49, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
-	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1d, 0x0a,
-	0x19, 0x4d, 0x45, 0x4d, 0x4f, 0x52, 0x59, 0x5f, 0x55, 0x4e, 0x49, 0x54, 0x5f, 0x54, 0x59, 0x50,
-	0x45, 0x5f, 0x48, 0x41, 0x4e, 0x5f, 0x43, 0x48, 0x41, 0x52, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15,
-	0x4d, 0x45, 0x4d, 0x4f, 0x52, 0x59, 0x5f, 0x55, 0x4e, 0x49, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45,
-	0x5f, 0x57, 0x4f, 0x52, 0x44, 0x10, 0x02, 0x2a, 0xb8, 0x01, 0x0a, 0x0c, 0x4d, 0x61, 0x73, 0x74,
-	0x65, 0x72, 0x79, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x1d, 0x0a, 0x19, 0x4d, 0x41, 0x53, 0x54,
-	0x45, 0x52, 0x59, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
-	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x4d, 0x41, 0x53, 0x54, 0x45,
-	0x52, 0x59, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x55, 0x4e, 0x4c, 0x45, 0x41, 0x52, 0x4e,
-	0x45, 0x44, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x4d, 0x41, 0x53, 0x54, 0x45, 0x52, 0x59, 0x5f,
-	0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x42, 0x45, 0x47, 0x49, 0x4e, 0x4e, 0x45, 0x52, 0x10, 0x02,
-	0x12, 0x1a, 0x0a, 0x16, 0x4d, 0x41, 0x53, 0x54, 0x45, 0x52, 0x59, 0x5f, 0x4c, 0x45, 0x56, 0x45,
-	0x4c, 0x5f, 0x46, 0x41, 0x4d, 0x49, 0x4c, 0x49, 0x41, 0x52, 0x10, 0x03, 0x12, 0x1a, 0x0a, 0x16,
-	0x4d, 0x41, 0x53, 0x54, 0x45, 0x52, 0x59, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x4d, 0x41,
-	0x53, 0x54, 0x45, 0x52, 0x45, 0x44, 0x10, 0x04, 0x12, 0x18, 0x0a, 0x14, 0x4d, 0x41, 0x53, 0x54,
-	0x45, 0x52, 0x59, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x45, 0x58, 0x50, 0x45, 0x52, 0x54,
-	0x10, 0x05, 0x2a, 0x79, 0x0a, 0x0c, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73, 0x75,
-	0x6c, 0x74, 0x12, 0x1d, 0x0a, 0x19, 0x52, 0x45, 0x56, 0x49, 0x45, 0x57, 0x5f, 0x52, 0x45, 0x53,
-	0x55, 0x4c, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
-	0x00, 0x12, 0x19, 0x0a, 0x15, 0x52, 0x45, 0x56, 0x49, 0x45, 0x57, 0x5f, 0x52, 0x45, 0x53, 0x55,
-	0x4c, 0x54, 0x5f, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13,
-	0x52, 0x45, 0x56, 0x49, 0x45, 0x57, 0x5f, 0x52, 0x45, 0x53, 0x55, 0x4c, 0x54, 0x5f, 0x57, 0x52,
-	0x4f, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x16, 0x0a, 0x12, 0x52, 0x45, 0x56, 0x49, 0x45, 0x57, 0x5f,
-	0x52, 0x45, 0x53, 0x55, 0x4c, 0x54, 0x5f, 0x53, 0x4b, 0x49, 0x50, 0x10, 0x03, 0x32, 0xea, 0x10,
-	0x0a, 0x0f, 0x4c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x12, 0xb1, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x50,
-	0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x31, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x76, 0x31, 0x2e, 0x4c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x67, 0x72,
-	0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x50, 0x72,
-	0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x35,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x12, 0x2d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
-	0x6c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x2f, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x73,
-	0x2f, 0x7b, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x70, 0x72, 0x6f,
-	0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0xb6, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x53, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x32, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x47, 0x65, 0x74, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x33, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x61, 0x72,
+	0x53, 0x45, 0x4e, 0x54, 0x45, 0x4e, 0x43, 0x45, 0x10, 0x04, 0x12, 0x22, 0x0a, 0x1e, 0x4d, 0x45,
+	0x4d, 0x4f, 0x52, 0x59, 0x5f, 0x55, 0x4e, 0x49, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x47,
+	0x52, 0x41, 0x4d, 0x4d, 0x41, 0x52, 0x5f, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x10, 0x05, 0x12, 0x20,
+	0x0a, 0x1c, 0x4d, 0x45, 0x4d, 0x4f, 0x52, 0x59, 0x5f, 0x55, 0x4e, 0x49, 0x54, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x5f, 0x57, 0x4f, 0x52, 0x44, 0x10, 0x06,
+	0x2a, 0xb8, 0x01, 0x0a, 0x0c, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x79, 0x4c, 0x65, 0x76, 0x65,
+	0x6c, 0x12, 0x1d, 0x0a, 0x19, 0x4d, 0x41, 0x53, 0x54, 0x45, 0x52, 0x59, 0x5f, 0x4c, 0x45, 0x56,
+	0x45, 0x4c, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x1b, 0x0a, 0x17, 0x4d, 0x41, 0x53, 0x54, 0x45, 0x52, 0x59, 0x5f, 0x4c, 0x45, 0x56, 0x45,
+	0x4c, 0x5f, 0x55, 0x4e, 0x4c, 0x45, 0x41, 0x52, 0x4e, 0x45, 0x44, 0x10, 0x01, 0x12, 0x1a, 0x0a,
+	0x16, 0x4d, 0x41, 0x53, 0x54, 0x45, 0x52, 0x59, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x42,
+	0x45, 0x47, 0x49, 0x4e, 0x4e, 0x45, 0x52, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x4d, 0x41, 0x53,
+	0x54, 0x45, 0x52, 0x59, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x46, 0x41, 0x4d, 0x49, 0x4c,
+	0x49, 0x41, 0x52, 0x10, 0x03, 0x12, 0x1a, 0x0a, 0x16, 0x4d, 0x41, 0x53, 0x54, 0x45, 0x52, 0x59,
+	0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x4d, 0x41, 0x53, 0x54, 0x45, 0x52, 0x45, 0x44, 0x10,
+	0x04, 0x12, 0x18, 0x0a, 0x14, 0x4d, 0x41, 0x53, 0x54, 0x45, 0x52, 0x59, 0x5f, 0x4c, 0x45, 0x56,
+	0x45, 0x4c, 0x5f, 0x45, 0x58, 0x50, 0x45, 0x52, 0x54, 0x10, 0x05, 0x2a, 0x79, 0x0a, 0x0c, 0x52,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1d, 0x0a, 0x19, 0x52,
+	0x45, 0x56, 0x49, 0x45, 0x57, 0x5f, 0x52, 0x45, 0x53, 0x55, 0x4c, 0x54, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x52, 0x45,
+	0x56, 0x49, 0x45, 0x57, 0x5f, 0x52, 0x45, 0x53, 0x55, 0x4c, 0x54, 0x5f, 0x43, 0x4f, 0x52, 0x52,
+	0x45, 0x43, 0x54, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x52, 0x45, 0x56, 0x49, 0x45, 0x57, 0x5f,
+	0x52, 0x45, 0x53, 0x55, 0x4c, 0x54, 0x5f, 0x57, 0x52, 0x4f, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x16,
+	0x0a, 0x12, 0x52, 0x45, 0x56, 0x49, 0x45, 0x57, 0x5f, 0x52, 0x45, 0x53, 0x55, 0x4c, 0x54, 0x5f,
+	0x53, 0x4b, 0x49, 0x50, 0x10, 0x03, 0x2a, 0x88, 0x01, 0x0a, 0x0b, 0x52, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x47, 0x72, 0x61, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x18, 0x52, 0x45, 0x56, 0x49, 0x45, 0x57,
+	0x5f, 0x47, 0x52, 0x41, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x52, 0x45, 0x56, 0x49, 0x45, 0x57, 0x5f, 0x47,
+	0x52, 0x41, 0x44, 0x45, 0x5f, 0x41, 0x47, 0x41, 0x49, 0x4e, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11,
+	0x52, 0x45, 0x56, 0x49, 0x45, 0x57, 0x5f, 0x47, 0x52, 0x41, 0x44, 0x45, 0x5f, 0x48, 0x41, 0x52,
+	0x44, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11, 0x52, 0x45, 0x56, 0x49, 0x45, 0x57, 0x5f, 0x47, 0x52,
+	0x41, 0x44, 0x45, 0x5f, 0x47, 0x4f, 0x4f, 0x44, 0x10, 0x03, 0x12, 0x15, 0x0a, 0x11, 0x52, 0x45,
+	0x56, 0x49, 0x45, 0x57, 0x5f, 0x47, 0x52, 0x41, 0x44, 0x45, 0x5f, 0x45, 0x41, 0x53, 0x59, 0x10,
+	0x04, 0x2a, 0x96, 0x01, 0x0a, 0x0c, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x41, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x14, 0x52, 0x45, 0x56, 0x49, 0x45, 0x57, 0x5f, 0x41, 0x43, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x56, 0x49, 0x45, 0x57, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13,
+	0x52, 0x45, 0x56, 0x49, 0x45, 0x57, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45,
+	0x53, 0x45, 0x54, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x52, 0x45, 0x56, 0x49, 0x45, 0x57, 0x5f,
+	0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x42, 0x55, 0x52, 0x59, 0x10, 0x02, 0x12, 0x1c, 0x0a,
+	0x18, 0x52, 0x45, 0x56, 0x49, 0x45, 0x57, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52,
+	0x45, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x10, 0x03, 0x12, 0x1d, 0x0a, 0x19, 0x52,
+	0x45, 0x56, 0x49, 0x45, 0x57, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x45, 0x54,
+	0x5f, 0x4d, 0x41, 0x53, 0x54, 0x45, 0x52, 0x59, 0x10, 0x04, 0x2a, 0xe2, 0x01, 0x0a, 0x11, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x23, 0x0a, 0x1f, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x52, 0x45, 0x56, 0x49, 0x45, 0x57,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1f, 0x0a, 0x1b, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x52,
+	0x45, 0x56, 0x49, 0x45, 0x57, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x50, 0x50,
+	0x4c, 0x49, 0x45, 0x44, 0x10, 0x01, 0x12, 0x21, 0x0a, 0x1d, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f,
+	0x52, 0x45, 0x56, 0x49, 0x45, 0x57, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x44, 0x55,
+	0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x45, 0x10, 0x02, 0x12, 0x20, 0x0a, 0x1c, 0x42, 0x41, 0x54,
+	0x43, 0x48, 0x5f, 0x52, 0x45, 0x56, 0x49, 0x45, 0x57, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x4c, 0x49, 0x43, 0x54, 0x10, 0x03, 0x12, 0x21, 0x0a, 0x1d, 0x42,
+	0x41, 0x54, 0x43, 0x48, 0x5f, 0x52, 0x45, 0x56, 0x49, 0x45, 0x57, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x04, 0x12, 0x1f,
+	0x0a, 0x1b, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x52, 0x45, 0x56, 0x49, 0x45, 0x57, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0x05, 0x2a,
+	0xbf, 0x01, 0x0a, 0x13, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x51, 0x75, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x26, 0x0a, 0x22, 0x48, 0x41, 0x4e, 0x5f, 0x43,
+	0x48, 0x41, 0x52, 0x5f, 0x51, 0x55, 0x45, 0x53, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x29, 0x0a, 0x25, 0x48, 0x41, 0x4e, 0x5f, 0x43, 0x48, 0x41, 0x52, 0x5f, 0x51, 0x55, 0x45, 0x53,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x48, 0x41, 0x52, 0x5f, 0x54,
+	0x4f, 0x5f, 0x50, 0x49, 0x4e, 0x59, 0x49, 0x4e, 0x10, 0x01, 0x12, 0x29, 0x0a, 0x25, 0x48, 0x41,
+	0x4e, 0x5f, 0x43, 0x48, 0x41, 0x52, 0x5f, 0x51, 0x55, 0x45, 0x53, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x49, 0x4e, 0x59, 0x49, 0x4e, 0x5f, 0x54, 0x4f, 0x5f, 0x43,
+	0x48, 0x41, 0x52, 0x10, 0x02, 0x12, 0x2a, 0x0a, 0x26, 0x48, 0x41, 0x4e, 0x5f, 0x43, 0x48, 0x41,
+	0x52, 0x5f, 0x51, 0x55, 0x45, 0x53, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x43, 0x48, 0x41, 0x52, 0x5f, 0x54, 0x4f, 0x5f, 0x45, 0x58, 0x41, 0x4d, 0x50, 0x4c, 0x45, 0x10,
+	0x03, 0x32, 0xb9, 0x22, 0x0a, 0x0f, 0x4c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0xb1, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x31, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x50,
+	0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x61, 0x72, 0x6e, 0x69,
+	0x6e, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x35, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x12, 0x2d, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x2f, 0x63, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x73, 0x2f, 0x7b, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x7d,
+	0x2f, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0xb6, 0x01, 0x0a, 0x12, 0x47, 0x65,
+	0x74, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x32, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x61, 0x72,
 	0x6e, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x47, 0x65, 0x74, 0x53, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x37, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x31, 0x12, 0x2f, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x2f,
-	0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0xb3,
-	0x01, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x50, 0x72, 0x6f,
-	0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x32, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31,
-	0x2e, 0x4c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65,
-	0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x47,
+	0x65, 0x74, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x37, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x31, 0x12, 0x2f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x65, 0x61, 0x72, 0x6e,
+	0x69, 0x6e, 0x67, 0x2f, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x73, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0xb3, 0x01, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x6e, 0x69,
+	0x74, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x32, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x72,
 	0x76, 0x69, 0x63, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x50, 0x72,
-	0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x3a, 0x01, 0x2a, 0x1a, 0x29, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x76, 0x31, 0x2f, 0x6c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x2f, 0x75, 0x6e, 0x69, 0x74,
-	0x73, 0x2f, 0x7b, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x70, 0x72, 0x6f, 0x67,
-	0x72, 0x65, 0x73, 0x73, 0x12, 0x91, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x6f,
-	0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x39, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x33, 0x12, 0x31, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6c,
-	0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x2f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x69, 0x65, 0x73,
-	0x2f, 0x7b, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x69, 0x64,
-	0x7d, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x9d, 0x01, 0x0a, 0x12, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
-	0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3c, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x36, 0x3a, 0x01, 0x2a, 0x1a, 0x31, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6c,
-	0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x2f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x69, 0x65, 0x73,
-	0x2f, 0x7b, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x69, 0x64,
-	0x7d, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x92, 0x01, 0x0a, 0x15, 0x4c, 0x69, 0x73,
-	0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x46, 0x6f, 0x72, 0x52, 0x65, 0x76, 0x69,
-	0x65, 0x77, 0x12, 0x26, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x46, 0x6f, 0x72, 0x52, 0x65, 0x76,
-	0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x69,
-	0x65, 0x73, 0x46, 0x6f, 0x72, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x12, 0x20, 0x2f, 0x61, 0x70,
+	0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e,
+	0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x6e,
+	0x69, 0x74, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x34, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x3a, 0x01, 0x2a, 0x1a, 0x29, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x2f,
+	0x75, 0x6e, 0x69, 0x74, 0x73, 0x2f, 0x7b, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f,
+	0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x91, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74,
+	0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x20, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72,
+	0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d,
+	0x6f, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x39, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x33, 0x12, 0x31, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x76, 0x31, 0x2f, 0x6c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x2f, 0x6d, 0x65, 0x6d, 0x6f,
+	0x72, 0x69, 0x65, 0x73, 0x2f, 0x7b, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x75, 0x6e, 0x69,
+	0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x9d, 0x01, 0x0a,
+	0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3c,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x36, 0x3a, 0x01, 0x2a, 0x1a, 0x31, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x76, 0x31, 0x2f, 0x6c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x2f, 0x6d, 0x65, 0x6d, 0x6f,
+	0x72, 0x69, 0x65, 0x73, 0x2f, 0x7b, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x75, 0x6e, 0x69,
+	0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x92, 0x01, 0x0a,
+	0x15, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x46, 0x6f, 0x72,
+	0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x26, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x46, 0x6f,
+	0x72, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65,
+	0x6d, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x46, 0x6f, 0x72, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x12,
+	0x20, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e,
+	0x67, 0x2f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x2f, 0x72, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x12, 0x7c, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x12, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67,
+	0x2f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x12,
+	0x71, 0x0a, 0x0a, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x57, 0x6f, 0x72, 0x64, 0x12, 0x1b, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x57,
+	0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x57, 0x6f, 0x72, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22,
+	0x3a, 0x01, 0x2a, 0x22, 0x1d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x65, 0x61,
+	0x72, 0x6e, 0x69, 0x6e, 0x67, 0x2f, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x2f, 0x72, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x12, 0x7e, 0x0a, 0x0d, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x48, 0x61, 0x6e, 0x43,
+	0x68, 0x61, 0x72, 0x12, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x3a, 0x01, 0x2a, 0x22,
+	0x21, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e,
+	0x67, 0x2f, 0x68, 0x61, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x73, 0x2f, 0x72, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x12, 0x60, 0x0a, 0x06, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x17, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x3a, 0x01, 0x2a, 0x22, 0x18, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x2f, 0x72, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x73, 0x12, 0x98, 0x01, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6d,
+	0x6f, 0x72, 0x79, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x12, 0x22, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
+	0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65,
+	0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x3a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x34, 0x12, 0x32, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x2f, 0x6d, 0x65,
+	0x6d, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x2f, 0x7b, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x75,
+	0x6e, 0x69, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x12,
+	0x82, 0x01, 0x0a, 0x11, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x74, 0x75, 0x64, 0x79, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x74, 0x75, 0x64, 0x79, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x74, 0x75, 0x64, 0x79, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x3a, 0x01, 0x2a, 0x22, 0x19, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x76, 0x31, 0x2f, 0x6c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x2f, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x87, 0x01, 0x0a, 0x11, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x52,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x22, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74,
+	0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x3a, 0x01, 0x2a, 0x22, 0x1e,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67,
+	0x2f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x2f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x12, 0x81,
+	0x01, 0x0a, 0x0f, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x73, 0x12, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6f,
+	0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e,
+	0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x12,
+	0x21, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e,
+	0x67, 0x2f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x2f, 0x66, 0x6f, 0x72, 0x65, 0x63, 0x61,
+	0x73, 0x74, 0x12, 0x6c, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x65, 0x65, 0x63, 0x68, 0x65,
+	0x73, 0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x4c, 0x65, 0x65, 0x63, 0x68, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c,
+	0x65, 0x65, 0x63, 0x68, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
+	0x6c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x2f, 0x6c, 0x65, 0x65, 0x63, 0x68, 0x65, 0x73,
+	0x12, 0xa3, 0x01, 0x0a, 0x13, 0x55, 0x6e, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x4d, 0x65,
+	0x6d, 0x6f, 0x72, 0x79, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x24, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x6d,
+	0x6f, 0x72, 0x79, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x73, 0x75, 0x73, 0x70,
+	0x65, 0x6e, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x39, 0x3a, 0x01, 0x2a,
+	0x22, 0x34, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x65, 0x61, 0x72, 0x6e, 0x69,
+	0x6e, 0x67, 0x2f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x2f, 0x7b, 0x6d, 0x65, 0x6d,
+	0x6f, 0x72, 0x79, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x75, 0x6e, 0x73,
+	0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x12, 0x8a, 0x01, 0x0a, 0x0a, 0x52, 0x65, 0x73, 0x65, 0x74,
+	0x4c, 0x65, 0x65, 0x63, 0x68, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x4c, 0x65, 0x65, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x73, 0x65, 0x74, 0x4c, 0x65, 0x65, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x41, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3b, 0x3a, 0x01, 0x2a, 0x22, 0x36, 0x2f, 0x61, 0x70,
 	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x2f, 0x6d, 0x65,
-	0x6d, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x2f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x7c, 0x0a,
-	0x0e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12,
-	0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65,
-	0x6d, 0x6f, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d,
-	0x65, 0x6d, 0x6f, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x2f, 0x6d, 0x65, 0x6d,
-	0x6f, 0x72, 0x69, 0x65, 0x73, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x12, 0x71, 0x0a, 0x0a, 0x52,
-	0x65, 0x76, 0x69, 0x65, 0x77, 0x57, 0x6f, 0x72, 0x64, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x57, 0x6f, 0x72, 0x64, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76,
-	0x31, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x57, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x3a, 0x01, 0x2a, 0x22,
-	0x1d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e,
-	0x67, 0x2f, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x2f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x7e,
-	0x0a, 0x0d, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x12,
-	0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65,
-	0x77, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65,
-	0x77, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x3a, 0x01, 0x2a, 0x22, 0x21, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x2f, 0x68, 0x61,
-	0x6e, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x73, 0x2f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x93,
-	0x01, 0x0a, 0x13, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72,
-	0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x24, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76,
-	0x31, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x52,
-	0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x48, 0x61,
-	0x6e, 0x43, 0x68, 0x61, 0x72, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x2f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29, 0x3a, 0x01, 0x2a, 0x22, 0x24,
-	0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x2f, 0x68, 0x61, 0x6e,
-	0x5f, 0x63, 0x68, 0x61, 0x72, 0x73, 0x2f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x2f, 0x73, 0x75,
-	0x62, 0x6d, 0x69, 0x74, 0x12, 0x78, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x48, 0x61, 0x6e, 0x43, 0x68,
-	0x61, 0x72, 0x54, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76,
-	0x31, 0x2e, 0x47, 0x65, 0x74, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x54, 0x65, 0x73, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x54, 0x65, 0x73,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x1d, 0x12, 0x1b, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x2f,
-	0x68, 0x61, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x73, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x12, 0x9d,
-	0x01, 0x0a, 0x17, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72,
-	0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x28, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x48, 0x61, 0x6e, 0x43,
-	0x68, 0x61, 0x72, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e,
-	0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x54, 0x65, 0x73,
-	0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x3a, 0x01, 0x2a, 0x22, 0x22, 0x2f, 0x76, 0x31, 0x2f,
+	0x6d, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x2f, 0x7b, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x75,
+	0x6e, 0x69, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x73, 0x65, 0x74, 0x5f, 0x6c, 0x65,
+	0x65, 0x63, 0x68, 0x12, 0x81, 0x01, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x61, 0x72, 0x64,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x61, 0x72, 0x64, 0x43,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x12, 0x1e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
+	0x2f, 0x6c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x2f, 0x68, 0x61, 0x72, 0x64, 0x5f, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x7c, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x74,
+	0x75, 0x64, 0x79, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x12, 0x21, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x79, 0x41,
+	0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75,
+	0x64, 0x79, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x2f, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x69, 0x74, 0x79, 0x12, 0x93, 0x01, 0x0a, 0x0f, 0x52, 0x65, 0x73, 0x65, 0x74, 0x4d,
+	0x65, 0x6d, 0x6f, 0x72, 0x79, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
+	0x55, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x6f,
+	0x72, 0x79, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3b,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x35, 0x3a, 0x01, 0x2a, 0x22, 0x30, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x76, 0x31, 0x2f, 0x6c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x2f, 0x6d, 0x65, 0x6d, 0x6f,
+	0x72, 0x69, 0x65, 0x73, 0x2f, 0x7b, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x75, 0x6e, 0x69,
+	0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x73, 0x65, 0x74, 0x12, 0x8f, 0x01, 0x0a, 0x0e,
+	0x42, 0x75, 0x72, 0x79, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x1f,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x72, 0x79, 0x4d, 0x65,
+	0x6d, 0x6f, 0x72, 0x79, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x72, 0x79, 0x4d,
+	0x65, 0x6d, 0x6f, 0x72, 0x79, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x3a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x34, 0x3a, 0x01, 0x2a, 0x22, 0x2f, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x2f, 0x6d,
+	0x65, 0x6d, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x2f, 0x7b, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f,
+	0x75, 0x6e, 0x69, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x62, 0x75, 0x72, 0x79, 0x12, 0xa7, 0x01,
+	0x0a, 0x14, 0x52, 0x65, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4d, 0x65, 0x6d, 0x6f,
+	0x72, 0x79, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x25, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4d, 0x65, 0x6d, 0x6f,
+	0x72, 0x79, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x40, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3a, 0x3a, 0x01, 0x2a,
+	0x22, 0x35, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x65, 0x61, 0x72, 0x6e, 0x69,
+	0x6e, 0x67, 0x2f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x2f, 0x7b, 0x6d, 0x65, 0x6d,
+	0x6f, 0x72, 0x79, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x95, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x44, 0x75, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73,
+	0x12, 0x25, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x44, 0x75, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x44, 0x75, 0x65,
+	0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28, 0x3a, 0x01, 0x2a, 0x22, 0x23, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x2f, 0x72, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x73, 0x2f, 0x72, 0x65, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12,
+	0x85, 0x01, 0x0a, 0x0c, 0x46, 0x6f, 0x72, 0x67, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6f, 0x72, 0x67,
+	0x65, 0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6f, 0x72, 0x67, 0x65,
+	0x74, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x36, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x30, 0x3a, 0x01, 0x2a, 0x22, 0x2b, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x2f, 0x63, 0x6f, 0x75,
+	0x72, 0x73, 0x65, 0x73, 0x2f, 0x7b, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x7d,
+	0x2f, 0x66, 0x6f, 0x72, 0x67, 0x65, 0x74, 0x12, 0x93, 0x01, 0x0a, 0x13, 0x53, 0x75, 0x62, 0x6d,
+	0x69, 0x74, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12,
+	0x24, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69,
+	0x74, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x52, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x29, 0x3a, 0x01, 0x2a, 0x22, 0x24, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x65, 0x61,
+	0x72, 0x6e, 0x69, 0x6e, 0x67, 0x2f, 0x68, 0x61, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x73, 0x2f,
+	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x2f, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x12, 0x78, 0x0a,
+	0x0e, 0x47, 0x65, 0x74, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x54, 0x65, 0x73, 0x74, 0x12,
+	0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x48, 0x61,
+	0x6e, 0x43, 0x68, 0x61, 0x72, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x48,
+	0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x76, 0x31, 0x2f,
 	0x6c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x2f, 0x68, 0x61, 0x6e, 0x5f, 0x63, 0x68, 0x61,
-	0x72, 0x73, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x2f, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x12, 0x8c,
-	0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x77, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72,
-	0x4c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x26, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x77, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61,
-	0x72, 0x4c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x27, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4e,
-	0x65, 0x77, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x4c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e,
-	0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x1c, 0x12, 0x1a, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x2f,
-	0x68, 0x61, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x73, 0x2f, 0x6e, 0x65, 0x77, 0x12, 0xba, 0x01,
-	0x0a, 0x1e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x4e, 0x65, 0x77, 0x48, 0x61, 0x6e, 0x43, 0x68,
-	0x61, 0x72, 0x4c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x12, 0x2f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x6d,
-	0x69, 0x74, 0x4e, 0x65, 0x77, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x4c, 0x65, 0x61, 0x72,
-	0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x30, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62,
-	0x6d, 0x69, 0x74, 0x4e, 0x65, 0x77, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x4c, 0x65, 0x61,
-	0x72, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x35, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x3a, 0x01, 0x2a, 0x22, 0x2a,
-	0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x2f, 0x68, 0x61, 0x6e,
-	0x5f, 0x63, 0x68, 0x61, 0x72, 0x73, 0x2f, 0x6e, 0x65, 0x77, 0x2f, 0x6c, 0x65, 0x61, 0x72, 0x6e,
-	0x69, 0x6e, 0x67, 0x2f, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x42, 0x31, 0x5a, 0x28, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x61, 0x7a, 0x79, 0x6a, 0x65, 0x61,
-	0x6e, 0x2f, 0x73, 0x6c, 0x61, 0x32, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2f, 0x76, 0x31, 0x3b, 0x70, 0x62, 0xba, 0x02, 0x04, 0x53, 0x4c, 0x41, 0x32, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x72, 0x73, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x12, 0x9d, 0x01, 0x0a, 0x17, 0x53, 0x75, 0x62, 0x6d,
+	0x69, 0x74, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x12, 0x28, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x75, 0x62, 0x6d, 0x69, 0x74, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x54, 0x65, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x48,
+	0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27,
+	0x3a, 0x01, 0x2a, 0x22, 0x22, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e,
+	0x67, 0x2f, 0x68, 0x61, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x73, 0x2f, 0x74, 0x65, 0x73, 0x74,
+	0x2f, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x12, 0xa7, 0x01, 0x0a, 0x18, 0x53, 0x75, 0x62, 0x6d,
+	0x69, 0x74, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x48, 0x61, 0x6e, 0x64, 0x77, 0x72, 0x69,
+	0x74, 0x69, 0x6e, 0x67, 0x12, 0x29, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x48, 0x61, 0x6e,
+	0x64, 0x77, 0x72, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69,
+	0x74, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x48, 0x61, 0x6e, 0x64, 0x77, 0x72, 0x69, 0x74,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x2e, 0x3a, 0x01, 0x2a, 0x22, 0x29, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x65, 0x61, 0x72,
+	0x6e, 0x69, 0x6e, 0x67, 0x2f, 0x68, 0x61, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x73, 0x2f, 0x68,
+	0x61, 0x6e, 0x64, 0x77, 0x72, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x2f, 0x73, 0x75, 0x62, 0x6d, 0x69,
+	0x74, 0x12, 0x8c, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x77, 0x48, 0x61, 0x6e, 0x43,
+	0x68, 0x61, 0x72, 0x4c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x26, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x77, 0x48, 0x61, 0x6e,
+	0x43, 0x68, 0x61, 0x72, 0x4c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x4e, 0x65, 0x77, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x4c, 0x65, 0x61, 0x72,
+	0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x65, 0x61, 0x72, 0x6e, 0x69,
+	0x6e, 0x67, 0x2f, 0x68, 0x61, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x73, 0x2f, 0x6e, 0x65, 0x77,
+	0x12, 0xba, 0x01, 0x0a, 0x1e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x4e, 0x65, 0x77, 0x48, 0x61,
+	0x6e, 0x43, 0x68, 0x61, 0x72, 0x4c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x12, 0x2f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x75, 0x62, 0x6d, 0x69, 0x74, 0x4e, 0x65, 0x77, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x4c,
+	0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x4e, 0x65, 0x77, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72,
+	0x4c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x35, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x3a, 0x01,
+	0x2a, 0x22, 0x2a, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x2f,
+	0x68, 0x61, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x73, 0x2f, 0x6e, 0x65, 0x77, 0x2f, 0x6c, 0x65,
+	0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x2f, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x42, 0x31, 0x5a,
+	0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x61, 0x7a, 0x79,
+	0x6a, 0x65, 0x61, 0x6e, 0x2f, 0x73, 0x6c, 0x61, 0x32, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2f, 0x76, 0x31, 0x3b, 0x70, 0x62, 0xba, 0x02, 0x04, 0x53, 0x4c, 0x41, 0x32,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 })
 
 var (
@@ -2828,116 +6513,252 @@ func file_proto_v1_learning_proto_rawDescGZIP() []byte {
 	return file_proto_v1_learning_proto_rawDescData
 }
 
-var file_proto_v1_learning_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_proto_v1_learning_proto_msgTypes = make([]protoimpl.MessageInfo, 39)
+var file_proto_v1_learning_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_proto_v1_learning_proto_msgTypes = make([]protoimpl.MessageInfo, 85)
 var file_proto_v1_learning_proto_goTypes = []any{
 	(MemoryUnitType)(0),                               // 0: proto.v1.MemoryUnitType
 	(MasteryLevel)(0),                                 // 1: proto.v1.MasteryLevel
 	(ReviewResult)(0),                                 // 2: proto.v1.ReviewResult
-	(*MemoryUnit)(nil),                                // 3: proto.v1.MemoryUnit
-	(*ReviewInterval)(nil),                            // 4: proto.v1.ReviewInterval
-	(*LearningProgress)(nil),                          // 5: proto.v1.LearningProgress
-	(*LearningServiceGetCourseProgressRequest)(nil),   // 6: proto.v1.LearningServiceGetCourseProgressRequest
-	(*LearningServiceGetCourseProgressResponse)(nil),  // 7: proto.v1.LearningServiceGetCourseProgressResponse
-	(*LearningServiceGetSectionProgressRequest)(nil),  // 8: proto.v1.LearningServiceGetSectionProgressRequest
-	(*LearningServiceGetSectionProgressResponse)(nil), // 9: proto.v1.LearningServiceGetSectionProgressResponse
-	(*LearningServiceGetUnitProgressRequest)(nil),     // 10: proto.v1.LearningServiceGetUnitProgressRequest
-	(*LearningServiceUpdateUnitProgressRequest)(nil),  // 11: proto.v1.LearningServiceUpdateUnitProgressRequest
-	(*LearningServiceUpdateUnitProgressResponse)(nil), // 12: proto.v1.LearningServiceUpdateUnitProgressResponse
-	(*GetMemoryStatusRequest)(nil),                    // 13: proto.v1.GetMemoryStatusRequest
-	(*GetMemoryStatusResponse)(nil),                   // 14: proto.v1.GetMemoryStatusResponse
-	(*UpdateMemoryStatusRequest)(nil),                 // 15: proto.v1.UpdateMemoryStatusRequest
-	(*UpdateMemoryStatusResponse)(nil),                // 16: proto.v1.UpdateMemoryStatusResponse
-	(*ListMemoriesForReviewRequest)(nil),              // 17: proto.v1.ListMemoriesForReviewRequest
-	(*ListMemoriesForReviewResponse)(nil),             // 18: proto.v1.ListMemoriesForReviewResponse
-	(*GetMemoryStatsRequest)(nil),                     // 19: proto.v1.GetMemoryStatsRequest
-	(*GetMemoryStatsResponse)(nil),                    // 20: proto.v1.GetMemoryStatsResponse
-	(*ReviewWordRequest)(nil),                         // 21: proto.v1.ReviewWordRequest
-	(*ReviewWordResponse)(nil),                        // 22: proto.v1.ReviewWordResponse
-	(*ReviewHanCharRequest)(nil),                      // 23: proto.v1.ReviewHanCharRequest
-	(*ReviewHanCharResponse)(nil),                     // 24: proto.v1.ReviewHanCharResponse
-	(*MemoryReview)(nil),                              // 25: proto.v1.MemoryReview
-	(*SubmitHanCharReviewRequest)(nil),                // 26: proto.v1.SubmitHanCharReviewRequest
-	(*SubmitHanCharReviewResponse)(nil),               // 27: proto.v1.SubmitHanCharReviewResponse
-	(*GetHanCharTestRequest)(nil),                     // 28: proto.v1.GetHanCharTestRequest
-	(*GetHanCharTestResponse)(nil),                    // 29: proto.v1.GetHanCharTestResponse
-	(*SubmitHanCharTestResultRequest)(nil),            // 30: proto.v1.SubmitHanCharTestResultRequest
-	(*HanCharTestResult)(nil),                         // 31: proto.v1.HanCharTestResult
-	(*SubmitHanCharTestResultResponse)(nil),           // 32: proto.v1.SubmitHanCharTestResultResponse
-	(*GetNewHanCharLearningRequest)(nil),              // 33: proto.v1.GetNewHanCharLearningRequest
-	(*GetNewHanCharLearningResponse)(nil),             // 34: proto.v1.GetNewHanCharLearningResponse
-	(*HanCharLearningContent)(nil),                    // 35: proto.v1.HanCharLearningContent
-	(*SubmitNewHanCharLearningResultRequest)(nil),     // 36: proto.v1.SubmitNewHanCharLearningResultRequest
-	(*HanCharLearningResultItem)(nil),                 // 37: proto.v1.HanCharLearningResultItem
-	(*SubmitNewHanCharLearningResultResponse)(nil),    // 38: proto.v1.SubmitNewHanCharLearningResultResponse
-	(*HanCharLearningResult)(nil),                     // 39: proto.v1.HanCharLearningResult
-	nil,                                               // 40: proto.v1.GetMemoryStatsResponse.LevelStatsEntry
-	nil,                                               // 41: proto.v1.GetMemoryStatsResponse.RetentionRatesEntry
-	(*timestamppb.Timestamp)(nil),                     // 42: google.protobuf.Timestamp
-	(*HanChar)(nil),                                   // 43: proto.v1.HanChar
+	(ReviewGrade)(0),                                  // 3: proto.v1.ReviewGrade
+	(ReviewAction)(0),                                 // 4: proto.v1.ReviewAction
+	(BatchReviewStatus)(0),                            // 5: proto.v1.BatchReviewStatus
+	(HanCharQuestionType)(0),                          // 6: proto.v1.HanCharQuestionType
+	(*MemoryUnit)(nil),                                // 7: proto.v1.MemoryUnit
+	(*ReviewInterval)(nil),                            // 8: proto.v1.ReviewInterval
+	(*LearningProgress)(nil),                          // 9: proto.v1.LearningProgress
+	(*LearningServiceGetCourseProgressRequest)(nil),   // 10: proto.v1.LearningServiceGetCourseProgressRequest
+	(*LearningServiceGetCourseProgressResponse)(nil),  // 11: proto.v1.LearningServiceGetCourseProgressResponse
+	(*LearningServiceGetSectionProgressRequest)(nil),  // 12: proto.v1.LearningServiceGetSectionProgressRequest
+	(*LearningServiceGetSectionProgressResponse)(nil), // 13: proto.v1.LearningServiceGetSectionProgressResponse
+	(*LearningServiceGetUnitProgressRequest)(nil),     // 14: proto.v1.LearningServiceGetUnitProgressRequest
+	(*LearningServiceUpdateUnitProgressRequest)(nil),  // 15: proto.v1.LearningServiceUpdateUnitProgressRequest
+	(*LearningServiceUpdateUnitProgressResponse)(nil), // 16: proto.v1.LearningServiceUpdateUnitProgressResponse
+	(*GetMemoryStatusRequest)(nil),                    // 17: proto.v1.GetMemoryStatusRequest
+	(*GetMemoryStatusResponse)(nil),                   // 18: proto.v1.GetMemoryStatusResponse
+	(*UpdateMemoryStatusRequest)(nil),                 // 19: proto.v1.UpdateMemoryStatusRequest
+	(*UpdateMemoryStatusResponse)(nil),                // 20: proto.v1.UpdateMemoryStatusResponse
+	(*ListMemoriesForReviewRequest)(nil),              // 21: proto.v1.ListMemoriesForReviewRequest
+	(*ListMemoriesForReviewResponse)(nil),             // 22: proto.v1.ListMemoriesForReviewResponse
+	(*GetMemoryStatsRequest)(nil),                     // 23: proto.v1.GetMemoryStatsRequest
+	(*RetentionWindow)(nil),                           // 24: proto.v1.RetentionWindow
+	(*GetMemoryStatsResponse)(nil),                    // 25: proto.v1.GetMemoryStatsResponse
+	(*ReviewWordRequest)(nil),                         // 26: proto.v1.ReviewWordRequest
+	(*ReviewWordResponse)(nil),                        // 27: proto.v1.ReviewWordResponse
+	(*ReviewHanCharRequest)(nil),                      // 28: proto.v1.ReviewHanCharRequest
+	(*ReviewHanCharResponse)(nil),                     // 29: proto.v1.ReviewHanCharResponse
+	(*ReviewRequest)(nil),                             // 30: proto.v1.ReviewRequest
+	(*ReviewResponse)(nil),                            // 31: proto.v1.ReviewResponse
+	(*MemoryReview)(nil),                              // 32: proto.v1.MemoryReview
+	(*ListMemoryReviewsRequest)(nil),                  // 33: proto.v1.ListMemoryReviewsRequest
+	(*ListMemoryReviewsResponse)(nil),                 // 34: proto.v1.ListMemoryReviewsResponse
+	(*StudyItem)(nil),                                 // 35: proto.v1.StudyItem
+	(*StartStudySessionRequest)(nil),                  // 36: proto.v1.StartStudySessionRequest
+	(*StartStudySessionResponse)(nil),                 // 37: proto.v1.StartStudySessionResponse
+	(*BatchReviewItem)(nil),                           // 38: proto.v1.BatchReviewItem
+	(*BatchReviewItemResult)(nil),                     // 39: proto.v1.BatchReviewItemResult
+	(*SubmitReviewBatchRequest)(nil),                  // 40: proto.v1.SubmitReviewBatchRequest
+	(*SubmitReviewBatchResponse)(nil),                 // 41: proto.v1.SubmitReviewBatchResponse
+	(*ForecastReviewsRequest)(nil),                    // 42: proto.v1.ForecastReviewsRequest
+	(*MemoryUnitTypeCount)(nil),                       // 43: proto.v1.MemoryUnitTypeCount
+	(*ReviewForecastDay)(nil),                         // 44: proto.v1.ReviewForecastDay
+	(*ForecastReviewsResponse)(nil),                   // 45: proto.v1.ForecastReviewsResponse
+	(*ListLeechesRequest)(nil),                        // 46: proto.v1.ListLeechesRequest
+	(*ListLeechesResponse)(nil),                       // 47: proto.v1.ListLeechesResponse
+	(*UnsuspendMemoryUnitRequest)(nil),                // 48: proto.v1.UnsuspendMemoryUnitRequest
+	(*UnsuspendMemoryUnitResponse)(nil),               // 49: proto.v1.UnsuspendMemoryUnitResponse
+	(*ResetLeechRequest)(nil),                         // 50: proto.v1.ResetLeechRequest
+	(*ResetLeechResponse)(nil),                        // 51: proto.v1.ResetLeechResponse
+	(*HardContent)(nil),                               // 52: proto.v1.HardContent
+	(*ListHardContentsRequest)(nil),                   // 53: proto.v1.ListHardContentsRequest
+	(*ListHardContentsResponse)(nil),                  // 54: proto.v1.ListHardContentsResponse
+	(*GetStudyActivityRequest)(nil),                   // 55: proto.v1.GetStudyActivityRequest
+	(*StudyStreak)(nil),                               // 56: proto.v1.StudyStreak
+	(*StudyActivityDay)(nil),                          // 57: proto.v1.StudyActivityDay
+	(*GetStudyActivityResponse)(nil),                  // 58: proto.v1.GetStudyActivityResponse
+	(*ResetMemoryUnitRequest)(nil),                    // 59: proto.v1.ResetMemoryUnitRequest
+	(*ResetMemoryUnitResponse)(nil),                   // 60: proto.v1.ResetMemoryUnitResponse
+	(*BuryMemoryUnitRequest)(nil),                     // 61: proto.v1.BuryMemoryUnitRequest
+	(*BuryMemoryUnitResponse)(nil),                    // 62: proto.v1.BuryMemoryUnitResponse
+	(*RescheduleMemoryUnitRequest)(nil),               // 63: proto.v1.RescheduleMemoryUnitRequest
+	(*RescheduleMemoryUnitResponse)(nil),              // 64: proto.v1.RescheduleMemoryUnitResponse
+	(*RescheduleDueReviewsRequest)(nil),               // 65: proto.v1.RescheduleDueReviewsRequest
+	(*RescheduleDueReviewsResponse)(nil),              // 66: proto.v1.RescheduleDueReviewsResponse
+	(*ForgetCourseRequest)(nil),                       // 67: proto.v1.ForgetCourseRequest
+	(*ForgetCourseResponse)(nil),                      // 68: proto.v1.ForgetCourseResponse
+	(*SubmitHanCharReviewRequest)(nil),                // 69: proto.v1.SubmitHanCharReviewRequest
+	(*SubmitHanCharReviewResponse)(nil),               // 70: proto.v1.SubmitHanCharReviewResponse
+	(*SubmitHanCharHandwritingRequest)(nil),           // 71: proto.v1.SubmitHanCharHandwritingRequest
+	(*SubmitHanCharHandwritingResponse)(nil),          // 72: proto.v1.SubmitHanCharHandwritingResponse
+	(*GetHanCharTestRequest)(nil),                     // 73: proto.v1.GetHanCharTestRequest
+	(*GetHanCharTestResponse)(nil),                    // 74: proto.v1.GetHanCharTestResponse
+	(*HanCharQuestion)(nil),                           // 75: proto.v1.HanCharQuestion
+	(*SubmitHanCharTestResultRequest)(nil),            // 76: proto.v1.SubmitHanCharTestResultRequest
+	(*HanCharTestResult)(nil),                         // 77: proto.v1.HanCharTestResult
+	(*HanCharTestAnswer)(nil),                         // 78: proto.v1.HanCharTestAnswer
+	(*HanCharTestGrade)(nil),                          // 79: proto.v1.HanCharTestGrade
+	(*SubmitHanCharTestResultResponse)(nil),           // 80: proto.v1.SubmitHanCharTestResultResponse
+	(*GetNewHanCharLearningRequest)(nil),              // 81: proto.v1.GetNewHanCharLearningRequest
+	(*GetNewHanCharLearningResponse)(nil),             // 82: proto.v1.GetNewHanCharLearningResponse
+	(*HanCharLearningContent)(nil),                    // 83: proto.v1.HanCharLearningContent
+	(*SubmitNewHanCharLearningResultRequest)(nil),     // 84: proto.v1.SubmitNewHanCharLearningResultRequest
+	(*HanCharLearningResultItem)(nil),                 // 85: proto.v1.HanCharLearningResultItem
+	(*SubmitNewHanCharLearningResultResponse)(nil),    // 86: proto.v1.SubmitNewHanCharLearningResultResponse
+	(*HanCharLearningResult)(nil),                     // 87: proto.v1.HanCharLearningResult
+	nil,                                               // 88: proto.v1.RetentionWindow.RateByTypeEntry
+	nil,                                               // 89: proto.v1.GetMemoryStatsResponse.LevelStatsEntry
+	nil,                                               // 90: proto.v1.GetMemoryStatsResponse.RetentionRatesEntry
+	nil,                                               // 91: proto.v1.GetMemoryStatsResponse.TypeStatsEntry
+	(*timestamppb.Timestamp)(nil),                     // 92: google.protobuf.Timestamp
+	(WordDifficultyLevel)(0),                          // 93: proto.v1.WordDifficultyLevel
+	(*StrokePolyline)(nil),                            // 94: proto.v1.StrokePolyline
+	(*HanChar)(nil),                                   // 95: proto.v1.HanChar
 }
 var file_proto_v1_learning_proto_depIdxs = []int32{
-	0,  // 0: proto.v1.MemoryUnit.type:type_name -> proto.v1.MemoryUnitType
-	42, // 1: proto.v1.MemoryUnit.created_at:type_name -> google.protobuf.Timestamp
-	42, // 2: proto.v1.MemoryUnit.updated_at:type_name -> google.protobuf.Timestamp
-	1,  // 3: proto.v1.MemoryUnit.mastery_level:type_name -> proto.v1.MasteryLevel
-	42, // 4: proto.v1.MemoryUnit.next_review_at:type_name -> google.protobuf.Timestamp
-	42, // 5: proto.v1.MemoryUnit.last_review_at:type_name -> google.protobuf.Timestamp
-	5,  // 6: proto.v1.LearningServiceGetCourseProgressResponse.progress:type_name -> proto.v1.LearningProgress
-	5,  // 7: proto.v1.LearningServiceGetSectionProgressResponse.progress:type_name -> proto.v1.LearningProgress
-	3,  // 8: proto.v1.GetMemoryStatusResponse.status:type_name -> proto.v1.MemoryUnit
-	1,  // 9: proto.v1.UpdateMemoryStatusRequest.mastery_level:type_name -> proto.v1.MasteryLevel
-	0,  // 10: proto.v1.ListMemoriesForReviewRequest.types:type_name -> proto.v1.MemoryUnitType
-	3,  // 11: proto.v1.ListMemoriesForReviewResponse.statuses:type_name -> proto.v1.MemoryUnit
-	0,  // 12: proto.v1.GetMemoryStatsRequest.type:type_name -> proto.v1.MemoryUnitType
-	40, // 13: proto.v1.GetMemoryStatsResponse.level_stats:type_name -> proto.v1.GetMemoryStatsResponse.LevelStatsEntry
-	41, // 14: proto.v1.GetMemoryStatsResponse.retention_rates:type_name -> proto.v1.GetMemoryStatsResponse.RetentionRatesEntry
-	2,  // 15: proto.v1.ReviewWordRequest.result:type_name -> proto.v1.ReviewResult
-	2,  // 16: proto.v1.ReviewHanCharRequest.result:type_name -> proto.v1.ReviewResult
-	2,  // 17: proto.v1.MemoryReview.result:type_name -> proto.v1.ReviewResult
-	42, // 18: proto.v1.MemoryReview.review_time:type_name -> google.protobuf.Timestamp
-	42, // 19: proto.v1.MemoryReview.created_at:type_name -> google.protobuf.Timestamp
-	42, // 20: proto.v1.SubmitHanCharReviewRequest.review_time:type_name -> google.protobuf.Timestamp
-	42, // 21: proto.v1.SubmitHanCharReviewResponse.next_review_time:type_name -> google.protobuf.Timestamp
-	43, // 22: proto.v1.GetHanCharTestResponse.han_chars:type_name -> proto.v1.HanChar
-	31, // 23: proto.v1.SubmitHanCharTestResultRequest.results:type_name -> proto.v1.HanCharTestResult
-	35, // 24: proto.v1.GetNewHanCharLearningResponse.contents:type_name -> proto.v1.HanCharLearningContent
-	42, // 25: proto.v1.SubmitNewHanCharLearningResultRequest.learning_time:type_name -> google.protobuf.Timestamp
-	37, // 26: proto.v1.SubmitNewHanCharLearningResultRequest.results:type_name -> proto.v1.HanCharLearningResultItem
-	39, // 27: proto.v1.HanCharLearningResultItem.result:type_name -> proto.v1.HanCharLearningResult
-	6,  // 28: proto.v1.LearningService.GetCourseProgress:input_type -> proto.v1.LearningServiceGetCourseProgressRequest
-	8,  // 29: proto.v1.LearningService.GetSectionProgress:input_type -> proto.v1.LearningServiceGetSectionProgressRequest
-	11, // 30: proto.v1.LearningService.UpdateUnitProgress:input_type -> proto.v1.LearningServiceUpdateUnitProgressRequest
-	13, // 31: proto.v1.LearningService.GetMemoryStatus:input_type -> proto.v1.GetMemoryStatusRequest
-	15, // 32: proto.v1.LearningService.UpdateMemoryStatus:input_type -> proto.v1.UpdateMemoryStatusRequest
-	17, // 33: proto.v1.LearningService.ListMemoriesForReview:input_type -> proto.v1.ListMemoriesForReviewRequest
-	19, // 34: proto.v1.LearningService.GetMemoryStats:input_type -> proto.v1.GetMemoryStatsRequest
-	21, // 35: proto.v1.LearningService.ReviewWord:input_type -> proto.v1.ReviewWordRequest
-	23, // 36: proto.v1.LearningService.ReviewHanChar:input_type -> proto.v1.ReviewHanCharRequest
-	26, // 37: proto.v1.LearningService.SubmitHanCharReview:input_type -> proto.v1.SubmitHanCharReviewRequest
-	28, // 38: proto.v1.LearningService.GetHanCharTest:input_type -> proto.v1.GetHanCharTestRequest
-	30, // 39: proto.v1.LearningService.SubmitHanCharTestResult:input_type -> proto.v1.SubmitHanCharTestResultRequest
-	33, // 40: proto.v1.LearningService.GetNewHanCharLearning:input_type -> proto.v1.GetNewHanCharLearningRequest
-	36, // 41: proto.v1.LearningService.SubmitNewHanCharLearningResult:input_type -> proto.v1.SubmitNewHanCharLearningResultRequest
-	7,  // 42: proto.v1.LearningService.GetCourseProgress:output_type -> proto.v1.LearningServiceGetCourseProgressResponse
-	9,  // 43: proto.v1.LearningService.GetSectionProgress:output_type -> proto.v1.LearningServiceGetSectionProgressResponse
-	12, // 44: proto.v1.LearningService.UpdateUnitProgress:output_type -> proto.v1.LearningServiceUpdateUnitProgressResponse
-	14, // 45: proto.v1.LearningService.GetMemoryStatus:output_type -> proto.v1.GetMemoryStatusResponse
-	16, // 46: proto.v1.LearningService.UpdateMemoryStatus:output_type -> proto.v1.UpdateMemoryStatusResponse
-	18, // 47: proto.v1.LearningService.ListMemoriesForReview:output_type -> proto.v1.ListMemoriesForReviewResponse
-	20, // 48: proto.v1.LearningService.GetMemoryStats:output_type -> proto.v1.GetMemoryStatsResponse
-	22, // 49: proto.v1.LearningService.ReviewWord:output_type -> proto.v1.ReviewWordResponse
-	24, // 50: proto.v1.LearningService.ReviewHanChar:output_type -> proto.v1.ReviewHanCharResponse
-	27, // 51: proto.v1.LearningService.SubmitHanCharReview:output_type -> proto.v1.SubmitHanCharReviewResponse
-	29, // 52: proto.v1.LearningService.GetHanCharTest:output_type -> proto.v1.GetHanCharTestResponse
-	32, // 53: proto.v1.LearningService.SubmitHanCharTestResult:output_type -> proto.v1.SubmitHanCharTestResultResponse
-	34, // 54: proto.v1.LearningService.GetNewHanCharLearning:output_type -> proto.v1.GetNewHanCharLearningResponse
-	38, // 55: proto.v1.LearningService.SubmitNewHanCharLearningResult:output_type -> proto.v1.SubmitNewHanCharLearningResultResponse
-	42, // [42:56] is the sub-list for method output_type
-	28, // [28:42] is the sub-list for method input_type
-	28, // [28:28] is the sub-list for extension type_name
-	28, // [28:28] is the sub-list for extension extendee
-	0,  // [0:28] is the sub-list for field type_name
+	0,   // 0: proto.v1.MemoryUnit.type:type_name -> proto.v1.MemoryUnitType
+	92,  // 1: proto.v1.MemoryUnit.created_at:type_name -> google.protobuf.Timestamp
+	92,  // 2: proto.v1.MemoryUnit.updated_at:type_name -> google.protobuf.Timestamp
+	1,   // 3: proto.v1.MemoryUnit.mastery_level:type_name -> proto.v1.MasteryLevel
+	92,  // 4: proto.v1.MemoryUnit.next_review_at:type_name -> google.protobuf.Timestamp
+	92,  // 5: proto.v1.MemoryUnit.last_review_at:type_name -> google.protobuf.Timestamp
+	9,   // 6: proto.v1.LearningServiceGetCourseProgressResponse.progress:type_name -> proto.v1.LearningProgress
+	9,   // 7: proto.v1.LearningServiceGetSectionProgressResponse.progress:type_name -> proto.v1.LearningProgress
+	7,   // 8: proto.v1.GetMemoryStatusResponse.status:type_name -> proto.v1.MemoryUnit
+	1,   // 9: proto.v1.UpdateMemoryStatusRequest.mastery_level:type_name -> proto.v1.MasteryLevel
+	0,   // 10: proto.v1.ListMemoriesForReviewRequest.types:type_name -> proto.v1.MemoryUnitType
+	7,   // 11: proto.v1.ListMemoriesForReviewResponse.statuses:type_name -> proto.v1.MemoryUnit
+	0,   // 12: proto.v1.GetMemoryStatsRequest.type:type_name -> proto.v1.MemoryUnitType
+	88,  // 13: proto.v1.RetentionWindow.rate_by_type:type_name -> proto.v1.RetentionWindow.RateByTypeEntry
+	89,  // 14: proto.v1.GetMemoryStatsResponse.level_stats:type_name -> proto.v1.GetMemoryStatsResponse.LevelStatsEntry
+	90,  // 15: proto.v1.GetMemoryStatsResponse.retention_rates:type_name -> proto.v1.GetMemoryStatsResponse.RetentionRatesEntry
+	24,  // 16: proto.v1.GetMemoryStatsResponse.retention:type_name -> proto.v1.RetentionWindow
+	91,  // 17: proto.v1.GetMemoryStatsResponse.type_stats:type_name -> proto.v1.GetMemoryStatsResponse.TypeStatsEntry
+	2,   // 18: proto.v1.ReviewWordRequest.result:type_name -> proto.v1.ReviewResult
+	3,   // 19: proto.v1.ReviewWordRequest.grade:type_name -> proto.v1.ReviewGrade
+	2,   // 20: proto.v1.ReviewHanCharRequest.result:type_name -> proto.v1.ReviewResult
+	3,   // 21: proto.v1.ReviewHanCharRequest.grade:type_name -> proto.v1.ReviewGrade
+	0,   // 22: proto.v1.ReviewRequest.type:type_name -> proto.v1.MemoryUnitType
+	2,   // 23: proto.v1.ReviewRequest.result:type_name -> proto.v1.ReviewResult
+	3,   // 24: proto.v1.ReviewRequest.grade:type_name -> proto.v1.ReviewGrade
+	2,   // 25: proto.v1.MemoryReview.result:type_name -> proto.v1.ReviewResult
+	92,  // 26: proto.v1.MemoryReview.review_time:type_name -> google.protobuf.Timestamp
+	92,  // 27: proto.v1.MemoryReview.created_at:type_name -> google.protobuf.Timestamp
+	3,   // 28: proto.v1.MemoryReview.grade:type_name -> proto.v1.ReviewGrade
+	92,  // 29: proto.v1.MemoryReview.scheduled_review_time:type_name -> google.protobuf.Timestamp
+	1,   // 30: proto.v1.MemoryReview.mastery_before:type_name -> proto.v1.MasteryLevel
+	1,   // 31: proto.v1.MemoryReview.mastery_after:type_name -> proto.v1.MasteryLevel
+	4,   // 32: proto.v1.MemoryReview.action:type_name -> proto.v1.ReviewAction
+	32,  // 33: proto.v1.ListMemoryReviewsResponse.reviews:type_name -> proto.v1.MemoryReview
+	0,   // 34: proto.v1.StudyItem.type:type_name -> proto.v1.MemoryUnitType
+	0,   // 35: proto.v1.StartStudySessionRequest.types:type_name -> proto.v1.MemoryUnitType
+	93,  // 36: proto.v1.StartStudySessionRequest.level:type_name -> proto.v1.WordDifficultyLevel
+	35,  // 37: proto.v1.StartStudySessionResponse.items:type_name -> proto.v1.StudyItem
+	0,   // 38: proto.v1.BatchReviewItem.type:type_name -> proto.v1.MemoryUnitType
+	2,   // 39: proto.v1.BatchReviewItem.result:type_name -> proto.v1.ReviewResult
+	3,   // 40: proto.v1.BatchReviewItem.grade:type_name -> proto.v1.ReviewGrade
+	92,  // 41: proto.v1.BatchReviewItem.reviewed_at:type_name -> google.protobuf.Timestamp
+	5,   // 42: proto.v1.BatchReviewItemResult.status:type_name -> proto.v1.BatchReviewStatus
+	38,  // 43: proto.v1.SubmitReviewBatchRequest.reviews:type_name -> proto.v1.BatchReviewItem
+	39,  // 44: proto.v1.SubmitReviewBatchResponse.results:type_name -> proto.v1.BatchReviewItemResult
+	0,   // 45: proto.v1.ForecastReviewsRequest.types:type_name -> proto.v1.MemoryUnitType
+	0,   // 46: proto.v1.MemoryUnitTypeCount.type:type_name -> proto.v1.MemoryUnitType
+	43,  // 47: proto.v1.ReviewForecastDay.due_by_type:type_name -> proto.v1.MemoryUnitTypeCount
+	44,  // 48: proto.v1.ForecastReviewsResponse.days:type_name -> proto.v1.ReviewForecastDay
+	0,   // 49: proto.v1.ListLeechesRequest.types:type_name -> proto.v1.MemoryUnitType
+	7,   // 50: proto.v1.ListLeechesResponse.leeches:type_name -> proto.v1.MemoryUnit
+	7,   // 51: proto.v1.UnsuspendMemoryUnitResponse.status:type_name -> proto.v1.MemoryUnit
+	7,   // 52: proto.v1.ResetLeechResponse.status:type_name -> proto.v1.MemoryUnit
+	0,   // 53: proto.v1.HardContent.type:type_name -> proto.v1.MemoryUnitType
+	0,   // 54: proto.v1.ListHardContentsRequest.types:type_name -> proto.v1.MemoryUnitType
+	52,  // 55: proto.v1.ListHardContentsResponse.contents:type_name -> proto.v1.HardContent
+	56,  // 56: proto.v1.GetStudyActivityResponse.streak:type_name -> proto.v1.StudyStreak
+	57,  // 57: proto.v1.GetStudyActivityResponse.days:type_name -> proto.v1.StudyActivityDay
+	7,   // 58: proto.v1.ResetMemoryUnitResponse.status:type_name -> proto.v1.MemoryUnit
+	7,   // 59: proto.v1.BuryMemoryUnitResponse.status:type_name -> proto.v1.MemoryUnit
+	7,   // 60: proto.v1.RescheduleMemoryUnitResponse.status:type_name -> proto.v1.MemoryUnit
+	0,   // 61: proto.v1.RescheduleDueReviewsRequest.types:type_name -> proto.v1.MemoryUnitType
+	92,  // 62: proto.v1.SubmitHanCharReviewRequest.review_time:type_name -> google.protobuf.Timestamp
+	92,  // 63: proto.v1.SubmitHanCharReviewResponse.next_review_time:type_name -> google.protobuf.Timestamp
+	94,  // 64: proto.v1.SubmitHanCharHandwritingRequest.strokes:type_name -> proto.v1.StrokePolyline
+	6,   // 65: proto.v1.GetHanCharTestRequest.types:type_name -> proto.v1.HanCharQuestionType
+	95,  // 66: proto.v1.GetHanCharTestResponse.han_chars:type_name -> proto.v1.HanChar
+	75,  // 67: proto.v1.GetHanCharTestResponse.questions:type_name -> proto.v1.HanCharQuestion
+	6,   // 68: proto.v1.HanCharQuestion.type:type_name -> proto.v1.HanCharQuestionType
+	77,  // 69: proto.v1.SubmitHanCharTestResultRequest.results:type_name -> proto.v1.HanCharTestResult
+	78,  // 70: proto.v1.SubmitHanCharTestResultRequest.answers:type_name -> proto.v1.HanCharTestAnswer
+	92,  // 71: proto.v1.SubmitHanCharTestResultRequest.test_time:type_name -> google.protobuf.Timestamp
+	6,   // 72: proto.v1.HanCharTestAnswer.type:type_name -> proto.v1.HanCharQuestionType
+	6,   // 73: proto.v1.HanCharTestGrade.type:type_name -> proto.v1.HanCharQuestionType
+	79,  // 74: proto.v1.SubmitHanCharTestResultResponse.grades:type_name -> proto.v1.HanCharTestGrade
+	93,  // 75: proto.v1.GetNewHanCharLearningRequest.level:type_name -> proto.v1.WordDifficultyLevel
+	83,  // 76: proto.v1.GetNewHanCharLearningResponse.contents:type_name -> proto.v1.HanCharLearningContent
+	92,  // 77: proto.v1.SubmitNewHanCharLearningResultRequest.learning_time:type_name -> google.protobuf.Timestamp
+	85,  // 78: proto.v1.SubmitNewHanCharLearningResultRequest.results:type_name -> proto.v1.HanCharLearningResultItem
+	87,  // 79: proto.v1.HanCharLearningResultItem.result:type_name -> proto.v1.HanCharLearningResult
+	10,  // 80: proto.v1.LearningService.GetCourseProgress:input_type -> proto.v1.LearningServiceGetCourseProgressRequest
+	12,  // 81: proto.v1.LearningService.GetSectionProgress:input_type -> proto.v1.LearningServiceGetSectionProgressRequest
+	15,  // 82: proto.v1.LearningService.UpdateUnitProgress:input_type -> proto.v1.LearningServiceUpdateUnitProgressRequest
+	17,  // 83: proto.v1.LearningService.GetMemoryStatus:input_type -> proto.v1.GetMemoryStatusRequest
+	19,  // 84: proto.v1.LearningService.UpdateMemoryStatus:input_type -> proto.v1.UpdateMemoryStatusRequest
+	21,  // 85: proto.v1.LearningService.ListMemoriesForReview:input_type -> proto.v1.ListMemoriesForReviewRequest
+	23,  // 86: proto.v1.LearningService.GetMemoryStats:input_type -> proto.v1.GetMemoryStatsRequest
+	26,  // 87: proto.v1.LearningService.ReviewWord:input_type -> proto.v1.ReviewWordRequest
+	28,  // 88: proto.v1.LearningService.ReviewHanChar:input_type -> proto.v1.ReviewHanCharRequest
+	30,  // 89: proto.v1.LearningService.Review:input_type -> proto.v1.ReviewRequest
+	33,  // 90: proto.v1.LearningService.ListMemoryReviews:input_type -> proto.v1.ListMemoryReviewsRequest
+	36,  // 91: proto.v1.LearningService.StartStudySession:input_type -> proto.v1.StartStudySessionRequest
+	40,  // 92: proto.v1.LearningService.SubmitReviewBatch:input_type -> proto.v1.SubmitReviewBatchRequest
+	42,  // 93: proto.v1.LearningService.ForecastReviews:input_type -> proto.v1.ForecastReviewsRequest
+	46,  // 94: proto.v1.LearningService.ListLeeches:input_type -> proto.v1.ListLeechesRequest
+	48,  // 95: proto.v1.LearningService.UnsuspendMemoryUnit:input_type -> proto.v1.UnsuspendMemoryUnitRequest
+	50,  // 96: proto.v1.LearningService.ResetLeech:input_type -> proto.v1.ResetLeechRequest
+	53,  // 97: proto.v1.LearningService.ListHardContents:input_type -> proto.v1.ListHardContentsRequest
+	55,  // 98: proto.v1.LearningService.GetStudyActivity:input_type -> proto.v1.GetStudyActivityRequest
+	59,  // 99: proto.v1.LearningService.ResetMemoryUnit:input_type -> proto.v1.ResetMemoryUnitRequest
+	61,  // 100: proto.v1.LearningService.BuryMemoryUnit:input_type -> proto.v1.BuryMemoryUnitRequest
+	63,  // 101: proto.v1.LearningService.RescheduleMemoryUnit:input_type -> proto.v1.RescheduleMemoryUnitRequest
+	65,  // 102: proto.v1.LearningService.RescheduleDueReviews:input_type -> proto.v1.RescheduleDueReviewsRequest
+	67,  // 103: proto.v1.LearningService.ForgetCourse:input_type -> proto.v1.ForgetCourseRequest
+	69,  // 104: proto.v1.LearningService.SubmitHanCharReview:input_type -> proto.v1.SubmitHanCharReviewRequest
+	73,  // 105: proto.v1.LearningService.GetHanCharTest:input_type -> proto.v1.GetHanCharTestRequest
+	76,  // 106: proto.v1.LearningService.SubmitHanCharTestResult:input_type -> proto.v1.SubmitHanCharTestResultRequest
+	71,  // 107: proto.v1.LearningService.SubmitHanCharHandwriting:input_type -> proto.v1.SubmitHanCharHandwritingRequest
+	81,  // 108: proto.v1.LearningService.GetNewHanCharLearning:input_type -> proto.v1.GetNewHanCharLearningRequest
+	84,  // 109: proto.v1.LearningService.SubmitNewHanCharLearningResult:input_type -> proto.v1.SubmitNewHanCharLearningResultRequest
+	11,  // 110: proto.v1.LearningService.GetCourseProgress:output_type -> proto.v1.LearningServiceGetCourseProgressResponse
+	13,  // 111: proto.v1.LearningService.GetSectionProgress:output_type -> proto.v1.LearningServiceGetSectionProgressResponse
+	16,  // 112: proto.v1.LearningService.UpdateUnitProgress:output_type -> proto.v1.LearningServiceUpdateUnitProgressResponse
+	18,  // 113: proto.v1.LearningService.GetMemoryStatus:output_type -> proto.v1.GetMemoryStatusResponse
+	20,  // 114: proto.v1.LearningService.UpdateMemoryStatus:output_type -> proto.v1.UpdateMemoryStatusResponse
+	22,  // 115: proto.v1.LearningService.ListMemoriesForReview:output_type -> proto.v1.ListMemoriesForReviewResponse
+	25,  // 116: proto.v1.LearningService.GetMemoryStats:output_type -> proto.v1.GetMemoryStatsResponse
+	27,  // 117: proto.v1.LearningService.ReviewWord:output_type -> proto.v1.ReviewWordResponse
+	29,  // 118: proto.v1.LearningService.ReviewHanChar:output_type -> proto.v1.ReviewHanCharResponse
+	31,  // 119: proto.v1.LearningService.Review:output_type -> proto.v1.ReviewResponse
+	34,  // 120: proto.v1.LearningService.ListMemoryReviews:output_type -> proto.v1.ListMemoryReviewsResponse
+	37,  // 121: proto.v1.LearningService.StartStudySession:output_type -> proto.v1.StartStudySessionResponse
+	41,  // 122: proto.v1.LearningService.SubmitReviewBatch:output_type -> proto.v1.SubmitReviewBatchResponse
+	45,  // 123: proto.v1.LearningService.ForecastReviews:output_type -> proto.v1.ForecastReviewsResponse
+	47,  // 124: proto.v1.LearningService.ListLeeches:output_type -> proto.v1.ListLeechesResponse
+	49,  // 125: proto.v1.LearningService.UnsuspendMemoryUnit:output_type -> proto.v1.UnsuspendMemoryUnitResponse
+	51,  // 126: proto.v1.LearningService.ResetLeech:output_type -> proto.v1.ResetLeechResponse
+	54,  // 127: proto.v1.LearningService.ListHardContents:output_type -> proto.v1.ListHardContentsResponse
+	58,  // 128: proto.v1.LearningService.GetStudyActivity:output_type -> proto.v1.GetStudyActivityResponse
+	60,  // 129: proto.v1.LearningService.ResetMemoryUnit:output_type -> proto.v1.ResetMemoryUnitResponse
+	62,  // 130: proto.v1.LearningService.BuryMemoryUnit:output_type -> proto.v1.BuryMemoryUnitResponse
+	64,  // 131: proto.v1.LearningService.RescheduleMemoryUnit:output_type -> proto.v1.RescheduleMemoryUnitResponse
+	66,  // 132: proto.v1.LearningService.RescheduleDueReviews:output_type -> proto.v1.RescheduleDueReviewsResponse
+	68,  // 133: proto.v1.LearningService.ForgetCourse:output_type -> proto.v1.ForgetCourseResponse
+	70,  // 134: proto.v1.LearningService.SubmitHanCharReview:output_type -> proto.v1.SubmitHanCharReviewResponse
+	74,  // 135: proto.v1.LearningService.GetHanCharTest:output_type -> proto.v1.GetHanCharTestResponse
+	80,  // 136: proto.v1.LearningService.SubmitHanCharTestResult:output_type -> proto.v1.SubmitHanCharTestResultResponse
+	72,  // 137: proto.v1.LearningService.SubmitHanCharHandwriting:output_type -> proto.v1.SubmitHanCharHandwritingResponse
+	82,  // 138: proto.v1.LearningService.GetNewHanCharLearning:output_type -> proto.v1.GetNewHanCharLearningResponse
+	86,  // 139: proto.v1.LearningService.SubmitNewHanCharLearningResult:output_type -> proto.v1.SubmitNewHanCharLearningResultResponse
+	110, // [110:140] is the sub-list for method output_type
+	80,  // [80:110] is the sub-list for method input_type
+	80,  // [80:80] is the sub-list for extension type_name
+	80,  // [80:80] is the sub-list for extension extendee
+	0,   // [0:80] is the sub-list for field type_name
 }
 
 func init() { file_proto_v1_learning_proto_init() }
@@ -2947,13 +6768,14 @@ func file_proto_v1_learning_proto_init() {
 	}
 	file_proto_v1_vocabulary_proto_init()
 	file_proto_v1_learning_proto_msgTypes[16].OneofWrappers = []any{}
+	file_proto_v1_learning_proto_msgTypes[29].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_v1_learning_proto_rawDesc), len(file_proto_v1_learning_proto_rawDesc)),
-			NumEnums:      3,
-			NumMessages:   39,
+			NumEnums:      7,
+			NumMessages:   85,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
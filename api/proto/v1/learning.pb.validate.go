@@ -193,6 +193,12 @@ func (m *MemoryUnit) validate(all bool) error {
 
 	// no validation rules for ConsecutiveWrong
 
+	// no validation rules for Lapses
+
+	// no validation rules for IsLeech
+
+	// no validation rules for Suspended
+
 	if len(errors) > 0 {
 		return MemoryUnitMultiError(errors)
 	}
@@ -2098,6 +2104,115 @@ var _ interface {
 	ErrorName() string
 } = GetMemoryStatsRequestValidationError{}
 
+// Validate checks the field values on RetentionWindow with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RetentionWindow) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RetentionWindow with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RetentionWindowMultiError, or nil if none found.
+func (m *RetentionWindow) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RetentionWindow) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Days
+
+	// no validation rules for ReviewCount
+
+	// no validation rules for CorrectCount
+
+	// no validation rules for Rate
+
+	// no validation rules for RateByType
+
+	if len(errors) > 0 {
+		return RetentionWindowMultiError(errors)
+	}
+
+	return nil
+}
+
+// RetentionWindowMultiError is an error wrapping multiple validation errors
+// returned by RetentionWindow.ValidateAll() if the designated constraints aren't met.
+type RetentionWindowMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RetentionWindowMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RetentionWindowMultiError) AllErrors() []error { return m }
+
+// RetentionWindowValidationError is the validation error returned by
+// RetentionWindow.Validate if the designated constraints aren't met.
+type RetentionWindowValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RetentionWindowValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RetentionWindowValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RetentionWindowValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RetentionWindowValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RetentionWindowValidationError) ErrorName() string { return "RetentionWindowValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RetentionWindowValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRetentionWindow.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RetentionWindowValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RetentionWindowValidationError{}
+
 // Validate checks the field values on GetMemoryStatsResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -2132,6 +2247,42 @@ func (m *GetMemoryStatsResponse) validate(all bool) error {
 
 	// no validation rules for RetentionRates
 
+	for idx, item := range m.GetRetention() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetMemoryStatsResponseValidationError{
+						field:  fmt.Sprintf("Retention[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetMemoryStatsResponseValidationError{
+						field:  fmt.Sprintf("Retention[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetMemoryStatsResponseValidationError{
+					field:  fmt.Sprintf("Retention[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TypeStats
+
 	if len(errors) > 0 {
 		return GetMemoryStatsResponseMultiError(errors)
 	}
@@ -2240,6 +2391,10 @@ func (m *ReviewWordRequest) validate(all bool) error {
 
 	// no validation rules for ResponseTime
 
+	// no validation rules for Grade
+
+	// no validation rules for SessionId
+
 	if len(errors) > 0 {
 		return ReviewWordRequestMultiError(errors)
 	}
@@ -2450,6 +2605,10 @@ func (m *ReviewHanCharRequest) validate(all bool) error {
 
 	// no validation rules for ResponseTime
 
+	// no validation rules for Grade
+
+	// no validation rules for SessionId
+
 	if len(errors) > 0 {
 		return ReviewHanCharRequestMultiError(errors)
 	}
@@ -2632,109 +2791,53 @@ var _ interface {
 	ErrorName() string
 } = ReviewHanCharResponseValidationError{}
 
-// Validate checks the field values on MemoryReview with the rules defined in
+// Validate checks the field values on ReviewRequest with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *MemoryReview) Validate() error {
+func (m *ReviewRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MemoryReview with the rules defined
+// ValidateAll checks the field values on ReviewRequest with the rules defined
 // in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in MemoryReviewMultiError, or
+// result is a list of violation errors wrapped in ReviewRequestMultiError, or
 // nil if none found.
-func (m *MemoryReview) ValidateAll() error {
+func (m *ReviewRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MemoryReview) validate(all bool) error {
+func (m *ReviewRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	// no validation rules for MemoryUnitId
+	// no validation rules for Type
 
-	// no validation rules for UserId
+	// no validation rules for ContentId
 
 	// no validation rules for Result
 
-	// no validation rules for ResponseTime
+	// no validation rules for Grade
 
-	if all {
-		switch v := interface{}(m.GetReviewTime()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, MemoryReviewValidationError{
-					field:  "ReviewTime",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, MemoryReviewValidationError{
-					field:  "ReviewTime",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetReviewTime()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return MemoryReviewValidationError{
-				field:  "ReviewTime",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for ResponseTime
 
-	if all {
-		switch v := interface{}(m.GetCreatedAt()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, MemoryReviewValidationError{
-					field:  "CreatedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, MemoryReviewValidationError{
-					field:  "CreatedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return MemoryReviewValidationError{
-				field:  "CreatedAt",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for SessionId
 
 	if len(errors) > 0 {
-		return MemoryReviewMultiError(errors)
+		return ReviewRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// MemoryReviewMultiError is an error wrapping multiple validation errors
-// returned by MemoryReview.ValidateAll() if the designated constraints aren't met.
-type MemoryReviewMultiError []error
+// ReviewRequestMultiError is an error wrapping multiple validation errors
+// returned by ReviewRequest.ValidateAll() if the designated constraints aren't met.
+type ReviewRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MemoryReviewMultiError) Error() string {
+func (m ReviewRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2743,11 +2846,11 @@ func (m MemoryReviewMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MemoryReviewMultiError) AllErrors() []error { return m }
+func (m ReviewRequestMultiError) AllErrors() []error { return m }
 
-// MemoryReviewValidationError is the validation error returned by
-// MemoryReview.Validate if the designated constraints aren't met.
-type MemoryReviewValidationError struct {
+// ReviewRequestValidationError is the validation error returned by
+// ReviewRequest.Validate if the designated constraints aren't met.
+type ReviewRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2755,22 +2858,22 @@ type MemoryReviewValidationError struct {
 }
 
 // Field function returns field value.
-func (e MemoryReviewValidationError) Field() string { return e.field }
+func (e ReviewRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MemoryReviewValidationError) Reason() string { return e.reason }
+func (e ReviewRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MemoryReviewValidationError) Cause() error { return e.cause }
+func (e ReviewRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MemoryReviewValidationError) Key() bool { return e.key }
+func (e ReviewRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MemoryReviewValidationError) ErrorName() string { return "MemoryReviewValidationError" }
+func (e ReviewRequestValidationError) ErrorName() string { return "ReviewRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e MemoryReviewValidationError) Error() string {
+func (e ReviewRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2782,14 +2885,14 @@ func (e MemoryReviewValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMemoryReview.%s: %s%s",
+		"invalid %sReviewRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MemoryReviewValidationError{}
+var _ error = ReviewRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2797,77 +2900,4960 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MemoryReviewValidationError{}
+} = ReviewRequestValidationError{}
 
-// Validate checks the field values on SubmitHanCharReviewRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SubmitHanCharReviewRequest) Validate() error {
+// Validate checks the field values on ReviewResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ReviewResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SubmitHanCharReviewRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// SubmitHanCharReviewRequestMultiError, or nil if none found.
-func (m *SubmitHanCharReviewRequest) ValidateAll() error {
+// ValidateAll checks the field values on ReviewResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ReviewResponseMultiError,
+// or nil if none found.
+func (m *ReviewResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SubmitHanCharReviewRequest) validate(all bool) error {
+func (m *ReviewResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for HanCharId
-
-	// no validation rules for IsRecognized
-
-	if all {
-		switch v := interface{}(m.GetReviewTime()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SubmitHanCharReviewRequestValidationError{
-					field:  "ReviewTime",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, SubmitHanCharReviewRequestValidationError{
-					field:  "ReviewTime",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetReviewTime()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return SubmitHanCharReviewRequestValidationError{
-				field:  "ReviewTime",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
 	if len(errors) > 0 {
-		return SubmitHanCharReviewRequestMultiError(errors)
+		return ReviewResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// SubmitHanCharReviewRequestMultiError is an error wrapping multiple
-// validation errors returned by SubmitHanCharReviewRequest.ValidateAll() if
+// ReviewResponseMultiError is an error wrapping multiple validation errors
+// returned by ReviewResponse.ValidateAll() if the designated constraints aren't met.
+type ReviewResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReviewResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReviewResponseMultiError) AllErrors() []error { return m }
+
+// ReviewResponseValidationError is the validation error returned by
+// ReviewResponse.Validate if the designated constraints aren't met.
+type ReviewResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReviewResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReviewResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReviewResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReviewResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReviewResponseValidationError) ErrorName() string { return "ReviewResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReviewResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReviewResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReviewResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReviewResponseValidationError{}
+
+// Validate checks the field values on MemoryReview with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MemoryReview) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MemoryReview with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MemoryReviewMultiError, or
+// nil if none found.
+func (m *MemoryReview) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MemoryReview) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for MemoryUnitId
+
+	// no validation rules for UserId
+
+	// no validation rules for Result
+
+	// no validation rules for ResponseTime
+
+	if all {
+		switch v := interface{}(m.GetReviewTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MemoryReviewValidationError{
+					field:  "ReviewTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MemoryReviewValidationError{
+					field:  "ReviewTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReviewTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MemoryReviewValidationError{
+				field:  "ReviewTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MemoryReviewValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MemoryReviewValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MemoryReviewValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Grade
+
+	if all {
+		switch v := interface{}(m.GetScheduledReviewTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MemoryReviewValidationError{
+					field:  "ScheduledReviewTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MemoryReviewValidationError{
+					field:  "ScheduledReviewTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetScheduledReviewTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MemoryReviewValidationError{
+				field:  "ScheduledReviewTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for MasteryBefore
+
+	// no validation rules for MasteryAfter
+
+	// no validation rules for IntervalBefore
+
+	// no validation rules for IntervalAfter
+
+	// no validation rules for Action
+
+	if len(errors) > 0 {
+		return MemoryReviewMultiError(errors)
+	}
+
+	return nil
+}
+
+// MemoryReviewMultiError is an error wrapping multiple validation errors
+// returned by MemoryReview.ValidateAll() if the designated constraints aren't met.
+type MemoryReviewMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MemoryReviewMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MemoryReviewMultiError) AllErrors() []error { return m }
+
+// MemoryReviewValidationError is the validation error returned by
+// MemoryReview.Validate if the designated constraints aren't met.
+type MemoryReviewValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MemoryReviewValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MemoryReviewValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MemoryReviewValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MemoryReviewValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MemoryReviewValidationError) ErrorName() string { return "MemoryReviewValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MemoryReviewValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMemoryReview.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MemoryReviewValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MemoryReviewValidationError{}
+
+// Validate checks the field values on ListMemoryReviewsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListMemoryReviewsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListMemoryReviewsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListMemoryReviewsRequestMultiError, or nil if none found.
+func (m *ListMemoryReviewsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListMemoryReviewsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MemoryUnitId
+
+	// no validation rules for Page
+
+	// no validation rules for PageSize
+
+	if len(errors) > 0 {
+		return ListMemoryReviewsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListMemoryReviewsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListMemoryReviewsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListMemoryReviewsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListMemoryReviewsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListMemoryReviewsRequestMultiError) AllErrors() []error { return m }
+
+// ListMemoryReviewsRequestValidationError is the validation error returned by
+// ListMemoryReviewsRequest.Validate if the designated constraints aren't met.
+type ListMemoryReviewsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListMemoryReviewsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListMemoryReviewsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListMemoryReviewsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListMemoryReviewsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListMemoryReviewsRequestValidationError) ErrorName() string {
+	return "ListMemoryReviewsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListMemoryReviewsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListMemoryReviewsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListMemoryReviewsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListMemoryReviewsRequestValidationError{}
+
+// Validate checks the field values on ListMemoryReviewsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListMemoryReviewsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListMemoryReviewsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListMemoryReviewsResponseMultiError, or nil if none found.
+func (m *ListMemoryReviewsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListMemoryReviewsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetReviews() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListMemoryReviewsResponseValidationError{
+						field:  fmt.Sprintf("Reviews[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListMemoryReviewsResponseValidationError{
+						field:  fmt.Sprintf("Reviews[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListMemoryReviewsResponseValidationError{
+					field:  fmt.Sprintf("Reviews[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	if len(errors) > 0 {
+		return ListMemoryReviewsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListMemoryReviewsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListMemoryReviewsResponse.ValidateAll() if the
+// designated constraints aren't met.
+type ListMemoryReviewsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListMemoryReviewsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListMemoryReviewsResponseMultiError) AllErrors() []error { return m }
+
+// ListMemoryReviewsResponseValidationError is the validation error returned by
+// ListMemoryReviewsResponse.Validate if the designated constraints aren't met.
+type ListMemoryReviewsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListMemoryReviewsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListMemoryReviewsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListMemoryReviewsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListMemoryReviewsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListMemoryReviewsResponseValidationError) ErrorName() string {
+	return "ListMemoryReviewsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListMemoryReviewsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListMemoryReviewsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListMemoryReviewsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListMemoryReviewsResponseValidationError{}
+
+// Validate checks the field values on StudyItem with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *StudyItem) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StudyItem with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in StudyItemMultiError, or nil
+// if none found.
+func (m *StudyItem) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StudyItem) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Type
+
+	// no validation rules for ContentId
+
+	// no validation rules for MemoryUnitId
+
+	// no validation rules for IsNew
+
+	// no validation rules for Text
+
+	if len(errors) > 0 {
+		return StudyItemMultiError(errors)
+	}
+
+	return nil
+}
+
+// StudyItemMultiError is an error wrapping multiple validation errors returned
+// by StudyItem.ValidateAll() if the designated constraints aren't met.
+type StudyItemMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StudyItemMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StudyItemMultiError) AllErrors() []error { return m }
+
+// StudyItemValidationError is the validation error returned by
+// StudyItem.Validate if the designated constraints aren't met.
+type StudyItemValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StudyItemValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StudyItemValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StudyItemValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StudyItemValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StudyItemValidationError) ErrorName() string { return "StudyItemValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StudyItemValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStudyItem.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StudyItemValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StudyItemValidationError{}
+
+// Validate checks the field values on StartStudySessionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StartStudySessionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StartStudySessionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StartStudySessionRequestMultiError, or nil if none found.
+func (m *StartStudySessionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StartStudySessionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Level
+
+	// no validation rules for CourseId
+
+	// no validation rules for NotebookId
+
+	if m.NewCount != nil {
+		// no validation rules for NewCount
+	}
+
+	if len(errors) > 0 {
+		return StartStudySessionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// StartStudySessionRequestMultiError is an error wrapping multiple validation
+// errors returned by StartStudySessionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type StartStudySessionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StartStudySessionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StartStudySessionRequestMultiError) AllErrors() []error { return m }
+
+// StartStudySessionRequestValidationError is the validation error returned by
+// StartStudySessionRequest.Validate if the designated constraints aren't met.
+type StartStudySessionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StartStudySessionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StartStudySessionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StartStudySessionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StartStudySessionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StartStudySessionRequestValidationError) ErrorName() string {
+	return "StartStudySessionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StartStudySessionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStartStudySessionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StartStudySessionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StartStudySessionRequestValidationError{}
+
+// Validate checks the field values on StartStudySessionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StartStudySessionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StartStudySessionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StartStudySessionResponseMultiError, or nil if none found.
+func (m *StartStudySessionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StartStudySessionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SessionId
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, StartStudySessionResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, StartStudySessionResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StartStudySessionResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for ReviewCount
+
+	// no validation rules for NewCount
+
+	if len(errors) > 0 {
+		return StartStudySessionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// StartStudySessionResponseMultiError is an error wrapping multiple validation
+// errors returned by StartStudySessionResponse.ValidateAll() if the
+// designated constraints aren't met.
+type StartStudySessionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StartStudySessionResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StartStudySessionResponseMultiError) AllErrors() []error { return m }
+
+// StartStudySessionResponseValidationError is the validation error returned by
+// StartStudySessionResponse.Validate if the designated constraints aren't met.
+type StartStudySessionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StartStudySessionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StartStudySessionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StartStudySessionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StartStudySessionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StartStudySessionResponseValidationError) ErrorName() string {
+	return "StartStudySessionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StartStudySessionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStartStudySessionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StartStudySessionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StartStudySessionResponseValidationError{}
+
+// Validate checks the field values on BatchReviewItem with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *BatchReviewItem) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchReviewItem with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchReviewItemMultiError, or nil if none found.
+func (m *BatchReviewItem) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchReviewItem) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for IdempotencyKey
+
+	// no validation rules for Type
+
+	// no validation rules for ContentId
+
+	// no validation rules for Result
+
+	// no validation rules for Grade
+
+	// no validation rules for ResponseTime
+
+	if all {
+		switch v := interface{}(m.GetReviewedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BatchReviewItemValidationError{
+					field:  "ReviewedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BatchReviewItemValidationError{
+					field:  "ReviewedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReviewedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BatchReviewItemValidationError{
+				field:  "ReviewedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for SessionId
+
+	if len(errors) > 0 {
+		return BatchReviewItemMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchReviewItemMultiError is an error wrapping multiple validation errors
+// returned by BatchReviewItem.ValidateAll() if the designated constraints aren't met.
+type BatchReviewItemMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchReviewItemMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchReviewItemMultiError) AllErrors() []error { return m }
+
+// BatchReviewItemValidationError is the validation error returned by
+// BatchReviewItem.Validate if the designated constraints aren't met.
+type BatchReviewItemValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchReviewItemValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchReviewItemValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchReviewItemValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchReviewItemValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchReviewItemValidationError) ErrorName() string { return "BatchReviewItemValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BatchReviewItemValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchReviewItem.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchReviewItemValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchReviewItemValidationError{}
+
+// Validate checks the field values on BatchReviewItemResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchReviewItemResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchReviewItemResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchReviewItemResultMultiError, or nil if none found.
+func (m *BatchReviewItemResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchReviewItemResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for IdempotencyKey
+
+	// no validation rules for Status
+
+	// no validation rules for MemoryUnitId
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return BatchReviewItemResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchReviewItemResultMultiError is an error wrapping multiple validation
+// errors returned by BatchReviewItemResult.ValidateAll() if the designated
+// constraints aren't met.
+type BatchReviewItemResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchReviewItemResultMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchReviewItemResultMultiError) AllErrors() []error { return m }
+
+// BatchReviewItemResultValidationError is the validation error returned by
+// BatchReviewItemResult.Validate if the designated constraints aren't met.
+type BatchReviewItemResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchReviewItemResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchReviewItemResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchReviewItemResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchReviewItemResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchReviewItemResultValidationError) ErrorName() string {
+	return "BatchReviewItemResultValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchReviewItemResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchReviewItemResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchReviewItemResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchReviewItemResultValidationError{}
+
+// Validate checks the field values on SubmitReviewBatchRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SubmitReviewBatchRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SubmitReviewBatchRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SubmitReviewBatchRequestMultiError, or nil if none found.
+func (m *SubmitReviewBatchRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SubmitReviewBatchRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetReviews() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SubmitReviewBatchRequestValidationError{
+						field:  fmt.Sprintf("Reviews[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SubmitReviewBatchRequestValidationError{
+						field:  fmt.Sprintf("Reviews[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SubmitReviewBatchRequestValidationError{
+					field:  fmt.Sprintf("Reviews[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SubmitReviewBatchRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SubmitReviewBatchRequestMultiError is an error wrapping multiple validation
+// errors returned by SubmitReviewBatchRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SubmitReviewBatchRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SubmitReviewBatchRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SubmitReviewBatchRequestMultiError) AllErrors() []error { return m }
+
+// SubmitReviewBatchRequestValidationError is the validation error returned by
+// SubmitReviewBatchRequest.Validate if the designated constraints aren't met.
+type SubmitReviewBatchRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SubmitReviewBatchRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SubmitReviewBatchRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SubmitReviewBatchRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SubmitReviewBatchRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SubmitReviewBatchRequestValidationError) ErrorName() string {
+	return "SubmitReviewBatchRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SubmitReviewBatchRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSubmitReviewBatchRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SubmitReviewBatchRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SubmitReviewBatchRequestValidationError{}
+
+// Validate checks the field values on SubmitReviewBatchResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SubmitReviewBatchResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SubmitReviewBatchResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SubmitReviewBatchResponseMultiError, or nil if none found.
+func (m *SubmitReviewBatchResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SubmitReviewBatchResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SubmitReviewBatchResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SubmitReviewBatchResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SubmitReviewBatchResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SubmitReviewBatchResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SubmitReviewBatchResponseMultiError is an error wrapping multiple validation
+// errors returned by SubmitReviewBatchResponse.ValidateAll() if the
+// designated constraints aren't met.
+type SubmitReviewBatchResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SubmitReviewBatchResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SubmitReviewBatchResponseMultiError) AllErrors() []error { return m }
+
+// SubmitReviewBatchResponseValidationError is the validation error returned by
+// SubmitReviewBatchResponse.Validate if the designated constraints aren't met.
+type SubmitReviewBatchResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SubmitReviewBatchResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SubmitReviewBatchResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SubmitReviewBatchResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SubmitReviewBatchResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SubmitReviewBatchResponseValidationError) ErrorName() string {
+	return "SubmitReviewBatchResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SubmitReviewBatchResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSubmitReviewBatchResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SubmitReviewBatchResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SubmitReviewBatchResponseValidationError{}
+
+// Validate checks the field values on ForecastReviewsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ForecastReviewsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ForecastReviewsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ForecastReviewsRequestMultiError, or nil if none found.
+func (m *ForecastReviewsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ForecastReviewsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Days
+
+	// no validation rules for NewPerDay
+
+	if len(errors) > 0 {
+		return ForecastReviewsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ForecastReviewsRequestMultiError is an error wrapping multiple validation
+// errors returned by ForecastReviewsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ForecastReviewsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ForecastReviewsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ForecastReviewsRequestMultiError) AllErrors() []error { return m }
+
+// ForecastReviewsRequestValidationError is the validation error returned by
+// ForecastReviewsRequest.Validate if the designated constraints aren't met.
+type ForecastReviewsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ForecastReviewsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ForecastReviewsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ForecastReviewsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ForecastReviewsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ForecastReviewsRequestValidationError) ErrorName() string {
+	return "ForecastReviewsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ForecastReviewsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sForecastReviewsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ForecastReviewsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ForecastReviewsRequestValidationError{}
+
+// Validate checks the field values on MemoryUnitTypeCount with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MemoryUnitTypeCount) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MemoryUnitTypeCount with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MemoryUnitTypeCountMultiError, or nil if none found.
+func (m *MemoryUnitTypeCount) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MemoryUnitTypeCount) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Type
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return MemoryUnitTypeCountMultiError(errors)
+	}
+
+	return nil
+}
+
+// MemoryUnitTypeCountMultiError is an error wrapping multiple validation
+// errors returned by MemoryUnitTypeCount.ValidateAll() if the designated
+// constraints aren't met.
+type MemoryUnitTypeCountMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MemoryUnitTypeCountMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MemoryUnitTypeCountMultiError) AllErrors() []error { return m }
+
+// MemoryUnitTypeCountValidationError is the validation error returned by
+// MemoryUnitTypeCount.Validate if the designated constraints aren't met.
+type MemoryUnitTypeCountValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MemoryUnitTypeCountValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MemoryUnitTypeCountValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MemoryUnitTypeCountValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MemoryUnitTypeCountValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MemoryUnitTypeCountValidationError) ErrorName() string {
+	return "MemoryUnitTypeCountValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MemoryUnitTypeCountValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMemoryUnitTypeCount.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MemoryUnitTypeCountValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MemoryUnitTypeCountValidationError{}
+
+// Validate checks the field values on ReviewForecastDay with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ReviewForecastDay) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReviewForecastDay with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReviewForecastDayMultiError, or nil if none found.
+func (m *ReviewForecastDay) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReviewForecastDay) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Date
+
+	// no validation rules for DueCount
+
+	for idx, item := range m.GetDueByType() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ReviewForecastDayValidationError{
+						field:  fmt.Sprintf("DueByType[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ReviewForecastDayValidationError{
+						field:  fmt.Sprintf("DueByType[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ReviewForecastDayValidationError{
+					field:  fmt.Sprintf("DueByType[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NewCount
+
+	// no validation rules for SimulatedCount
+
+	// no validation rules for TotalCount
+
+	if len(errors) > 0 {
+		return ReviewForecastDayMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReviewForecastDayMultiError is an error wrapping multiple validation errors
+// returned by ReviewForecastDay.ValidateAll() if the designated constraints aren't met.
+type ReviewForecastDayMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReviewForecastDayMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReviewForecastDayMultiError) AllErrors() []error { return m }
+
+// ReviewForecastDayValidationError is the validation error returned by
+// ReviewForecastDay.Validate if the designated constraints aren't met.
+type ReviewForecastDayValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReviewForecastDayValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReviewForecastDayValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReviewForecastDayValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReviewForecastDayValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReviewForecastDayValidationError) ErrorName() string {
+	return "ReviewForecastDayValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReviewForecastDayValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReviewForecastDay.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReviewForecastDayValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReviewForecastDayValidationError{}
+
+// Validate checks the field values on ForecastReviewsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ForecastReviewsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ForecastReviewsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ForecastReviewsResponseMultiError, or nil if none found.
+func (m *ForecastReviewsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ForecastReviewsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetDays() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ForecastReviewsResponseValidationError{
+						field:  fmt.Sprintf("Days[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ForecastReviewsResponseValidationError{
+						field:  fmt.Sprintf("Days[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ForecastReviewsResponseValidationError{
+					field:  fmt.Sprintf("Days[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for OverdueCount
+
+	if len(errors) > 0 {
+		return ForecastReviewsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ForecastReviewsResponseMultiError is an error wrapping multiple validation
+// errors returned by ForecastReviewsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ForecastReviewsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ForecastReviewsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ForecastReviewsResponseMultiError) AllErrors() []error { return m }
+
+// ForecastReviewsResponseValidationError is the validation error returned by
+// ForecastReviewsResponse.Validate if the designated constraints aren't met.
+type ForecastReviewsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ForecastReviewsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ForecastReviewsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ForecastReviewsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ForecastReviewsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ForecastReviewsResponseValidationError) ErrorName() string {
+	return "ForecastReviewsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ForecastReviewsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sForecastReviewsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ForecastReviewsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ForecastReviewsResponseValidationError{}
+
+// Validate checks the field values on ListLeechesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListLeechesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListLeechesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListLeechesRequestMultiError, or nil if none found.
+func (m *ListLeechesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListLeechesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Page
+
+	// no validation rules for PageSize
+
+	if len(errors) > 0 {
+		return ListLeechesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListLeechesRequestMultiError is an error wrapping multiple validation errors
+// returned by ListLeechesRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListLeechesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListLeechesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListLeechesRequestMultiError) AllErrors() []error { return m }
+
+// ListLeechesRequestValidationError is the validation error returned by
+// ListLeechesRequest.Validate if the designated constraints aren't met.
+type ListLeechesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListLeechesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListLeechesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListLeechesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListLeechesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListLeechesRequestValidationError) ErrorName() string {
+	return "ListLeechesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListLeechesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListLeechesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListLeechesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListLeechesRequestValidationError{}
+
+// Validate checks the field values on ListLeechesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListLeechesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListLeechesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListLeechesResponseMultiError, or nil if none found.
+func (m *ListLeechesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListLeechesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetLeeches() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListLeechesResponseValidationError{
+						field:  fmt.Sprintf("Leeches[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListLeechesResponseValidationError{
+						field:  fmt.Sprintf("Leeches[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListLeechesResponseValidationError{
+					field:  fmt.Sprintf("Leeches[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	if len(errors) > 0 {
+		return ListLeechesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListLeechesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListLeechesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListLeechesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListLeechesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListLeechesResponseMultiError) AllErrors() []error { return m }
+
+// ListLeechesResponseValidationError is the validation error returned by
+// ListLeechesResponse.Validate if the designated constraints aren't met.
+type ListLeechesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListLeechesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListLeechesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListLeechesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListLeechesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListLeechesResponseValidationError) ErrorName() string {
+	return "ListLeechesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListLeechesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListLeechesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListLeechesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListLeechesResponseValidationError{}
+
+// Validate checks the field values on UnsuspendMemoryUnitRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UnsuspendMemoryUnitRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnsuspendMemoryUnitRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UnsuspendMemoryUnitRequestMultiError, or nil if none found.
+func (m *UnsuspendMemoryUnitRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnsuspendMemoryUnitRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MemoryUnitId
+
+	if len(errors) > 0 {
+		return UnsuspendMemoryUnitRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnsuspendMemoryUnitRequestMultiError is an error wrapping multiple
+// validation errors returned by UnsuspendMemoryUnitRequest.ValidateAll() if
+// the designated constraints aren't met.
+type UnsuspendMemoryUnitRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnsuspendMemoryUnitRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnsuspendMemoryUnitRequestMultiError) AllErrors() []error { return m }
+
+// UnsuspendMemoryUnitRequestValidationError is the validation error returned
+// by UnsuspendMemoryUnitRequest.Validate if the designated constraints aren't met.
+type UnsuspendMemoryUnitRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnsuspendMemoryUnitRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnsuspendMemoryUnitRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnsuspendMemoryUnitRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnsuspendMemoryUnitRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnsuspendMemoryUnitRequestValidationError) ErrorName() string {
+	return "UnsuspendMemoryUnitRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UnsuspendMemoryUnitRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnsuspendMemoryUnitRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnsuspendMemoryUnitRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnsuspendMemoryUnitRequestValidationError{}
+
+// Validate checks the field values on UnsuspendMemoryUnitResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UnsuspendMemoryUnitResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnsuspendMemoryUnitResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UnsuspendMemoryUnitResponseMultiError, or nil if none found.
+func (m *UnsuspendMemoryUnitResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnsuspendMemoryUnitResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetStatus()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UnsuspendMemoryUnitResponseValidationError{
+					field:  "Status",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UnsuspendMemoryUnitResponseValidationError{
+					field:  "Status",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStatus()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UnsuspendMemoryUnitResponseValidationError{
+				field:  "Status",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UnsuspendMemoryUnitResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnsuspendMemoryUnitResponseMultiError is an error wrapping multiple
+// validation errors returned by UnsuspendMemoryUnitResponse.ValidateAll() if
+// the designated constraints aren't met.
+type UnsuspendMemoryUnitResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnsuspendMemoryUnitResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnsuspendMemoryUnitResponseMultiError) AllErrors() []error { return m }
+
+// UnsuspendMemoryUnitResponseValidationError is the validation error returned
+// by UnsuspendMemoryUnitResponse.Validate if the designated constraints aren't met.
+type UnsuspendMemoryUnitResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnsuspendMemoryUnitResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnsuspendMemoryUnitResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnsuspendMemoryUnitResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnsuspendMemoryUnitResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnsuspendMemoryUnitResponseValidationError) ErrorName() string {
+	return "UnsuspendMemoryUnitResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UnsuspendMemoryUnitResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnsuspendMemoryUnitResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnsuspendMemoryUnitResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnsuspendMemoryUnitResponseValidationError{}
+
+// Validate checks the field values on ResetLeechRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ResetLeechRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResetLeechRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResetLeechRequestMultiError, or nil if none found.
+func (m *ResetLeechRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResetLeechRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MemoryUnitId
+
+	if len(errors) > 0 {
+		return ResetLeechRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResetLeechRequestMultiError is an error wrapping multiple validation errors
+// returned by ResetLeechRequest.ValidateAll() if the designated constraints aren't met.
+type ResetLeechRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResetLeechRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResetLeechRequestMultiError) AllErrors() []error { return m }
+
+// ResetLeechRequestValidationError is the validation error returned by
+// ResetLeechRequest.Validate if the designated constraints aren't met.
+type ResetLeechRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResetLeechRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResetLeechRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResetLeechRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResetLeechRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResetLeechRequestValidationError) ErrorName() string {
+	return "ResetLeechRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResetLeechRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResetLeechRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResetLeechRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResetLeechRequestValidationError{}
+
+// Validate checks the field values on ResetLeechResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ResetLeechResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResetLeechResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResetLeechResponseMultiError, or nil if none found.
+func (m *ResetLeechResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResetLeechResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetStatus()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ResetLeechResponseValidationError{
+					field:  "Status",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ResetLeechResponseValidationError{
+					field:  "Status",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStatus()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ResetLeechResponseValidationError{
+				field:  "Status",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ResetLeechResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResetLeechResponseMultiError is an error wrapping multiple validation errors
+// returned by ResetLeechResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ResetLeechResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResetLeechResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResetLeechResponseMultiError) AllErrors() []error { return m }
+
+// ResetLeechResponseValidationError is the validation error returned by
+// ResetLeechResponse.Validate if the designated constraints aren't met.
+type ResetLeechResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResetLeechResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResetLeechResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResetLeechResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResetLeechResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResetLeechResponseValidationError) ErrorName() string {
+	return "ResetLeechResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResetLeechResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResetLeechResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResetLeechResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResetLeechResponseValidationError{}
+
+// Validate checks the field values on HardContent with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *HardContent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on HardContent with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in HardContentMultiError, or
+// nil if none found.
+func (m *HardContent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *HardContent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Type
+
+	// no validation rules for ContentId
+
+	// no validation rules for Text
+
+	// no validation rules for LeechCount
+
+	// no validation rules for TotalLapses
+
+	if len(errors) > 0 {
+		return HardContentMultiError(errors)
+	}
+
+	return nil
+}
+
+// HardContentMultiError is an error wrapping multiple validation errors
+// returned by HardContent.ValidateAll() if the designated constraints aren't met.
+type HardContentMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m HardContentMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m HardContentMultiError) AllErrors() []error { return m }
+
+// HardContentValidationError is the validation error returned by
+// HardContent.Validate if the designated constraints aren't met.
+type HardContentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HardContentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HardContentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HardContentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HardContentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HardContentValidationError) ErrorName() string { return "HardContentValidationError" }
+
+// Error satisfies the builtin error interface
+func (e HardContentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHardContent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HardContentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HardContentValidationError{}
+
+// Validate checks the field values on ListHardContentsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListHardContentsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListHardContentsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListHardContentsRequestMultiError, or nil if none found.
+func (m *ListHardContentsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListHardContentsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Limit
+
+	if len(errors) > 0 {
+		return ListHardContentsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListHardContentsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListHardContentsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListHardContentsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListHardContentsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListHardContentsRequestMultiError) AllErrors() []error { return m }
+
+// ListHardContentsRequestValidationError is the validation error returned by
+// ListHardContentsRequest.Validate if the designated constraints aren't met.
+type ListHardContentsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListHardContentsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListHardContentsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListHardContentsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListHardContentsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListHardContentsRequestValidationError) ErrorName() string {
+	return "ListHardContentsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListHardContentsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListHardContentsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListHardContentsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListHardContentsRequestValidationError{}
+
+// Validate checks the field values on ListHardContentsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListHardContentsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListHardContentsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListHardContentsResponseMultiError, or nil if none found.
+func (m *ListHardContentsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListHardContentsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetContents() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListHardContentsResponseValidationError{
+						field:  fmt.Sprintf("Contents[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListHardContentsResponseValidationError{
+						field:  fmt.Sprintf("Contents[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListHardContentsResponseValidationError{
+					field:  fmt.Sprintf("Contents[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListHardContentsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListHardContentsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListHardContentsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListHardContentsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListHardContentsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListHardContentsResponseMultiError) AllErrors() []error { return m }
+
+// ListHardContentsResponseValidationError is the validation error returned by
+// ListHardContentsResponse.Validate if the designated constraints aren't met.
+type ListHardContentsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListHardContentsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListHardContentsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListHardContentsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListHardContentsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListHardContentsResponseValidationError) ErrorName() string {
+	return "ListHardContentsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListHardContentsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListHardContentsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListHardContentsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListHardContentsResponseValidationError{}
+
+// Validate checks the field values on GetStudyActivityRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetStudyActivityRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetStudyActivityRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetStudyActivityRequestMultiError, or nil if none found.
+func (m *GetStudyActivityRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetStudyActivityRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for StartDate
+
+	// no validation rules for EndDate
+
+	if len(errors) > 0 {
+		return GetStudyActivityRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetStudyActivityRequestMultiError is an error wrapping multiple validation
+// errors returned by GetStudyActivityRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetStudyActivityRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetStudyActivityRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetStudyActivityRequestMultiError) AllErrors() []error { return m }
+
+// GetStudyActivityRequestValidationError is the validation error returned by
+// GetStudyActivityRequest.Validate if the designated constraints aren't met.
+type GetStudyActivityRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetStudyActivityRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetStudyActivityRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetStudyActivityRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetStudyActivityRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetStudyActivityRequestValidationError) ErrorName() string {
+	return "GetStudyActivityRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetStudyActivityRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetStudyActivityRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetStudyActivityRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetStudyActivityRequestValidationError{}
+
+// Validate checks the field values on StudyStreak with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *StudyStreak) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StudyStreak with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in StudyStreakMultiError, or
+// nil if none found.
+func (m *StudyStreak) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StudyStreak) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Current
+
+	// no validation rules for Longest
+
+	// no validation rules for FreezeDaysUsed
+
+	// no validation rules for FreezeDays
+
+	// no validation rules for StudiedToday
+
+	// no validation rules for LastStudyDate
+
+	if len(errors) > 0 {
+		return StudyStreakMultiError(errors)
+	}
+
+	return nil
+}
+
+// StudyStreakMultiError is an error wrapping multiple validation errors
+// returned by StudyStreak.ValidateAll() if the designated constraints aren't met.
+type StudyStreakMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StudyStreakMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StudyStreakMultiError) AllErrors() []error { return m }
+
+// StudyStreakValidationError is the validation error returned by
+// StudyStreak.Validate if the designated constraints aren't met.
+type StudyStreakValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StudyStreakValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StudyStreakValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StudyStreakValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StudyStreakValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StudyStreakValidationError) ErrorName() string { return "StudyStreakValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StudyStreakValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStudyStreak.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StudyStreakValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StudyStreakValidationError{}
+
+// Validate checks the field values on StudyActivityDay with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *StudyActivityDay) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StudyActivityDay with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StudyActivityDayMultiError, or nil if none found.
+func (m *StudyActivityDay) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StudyActivityDay) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Date
+
+	// no validation rules for ReviewCount
+
+	// no validation rules for NewCount
+
+	// no validation rules for StudyMinutes
+
+	if len(errors) > 0 {
+		return StudyActivityDayMultiError(errors)
+	}
+
+	return nil
+}
+
+// StudyActivityDayMultiError is an error wrapping multiple validation errors
+// returned by StudyActivityDay.ValidateAll() if the designated constraints aren't met.
+type StudyActivityDayMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StudyActivityDayMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StudyActivityDayMultiError) AllErrors() []error { return m }
+
+// StudyActivityDayValidationError is the validation error returned by
+// StudyActivityDay.Validate if the designated constraints aren't met.
+type StudyActivityDayValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StudyActivityDayValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StudyActivityDayValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StudyActivityDayValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StudyActivityDayValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StudyActivityDayValidationError) ErrorName() string { return "StudyActivityDayValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StudyActivityDayValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStudyActivityDay.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StudyActivityDayValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StudyActivityDayValidationError{}
+
+// Validate checks the field values on GetStudyActivityResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetStudyActivityResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetStudyActivityResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetStudyActivityResponseMultiError, or nil if none found.
+func (m *GetStudyActivityResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetStudyActivityResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetStreak()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetStudyActivityResponseValidationError{
+					field:  "Streak",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetStudyActivityResponseValidationError{
+					field:  "Streak",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStreak()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetStudyActivityResponseValidationError{
+				field:  "Streak",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetDays() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetStudyActivityResponseValidationError{
+						field:  fmt.Sprintf("Days[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetStudyActivityResponseValidationError{
+						field:  fmt.Sprintf("Days[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetStudyActivityResponseValidationError{
+					field:  fmt.Sprintf("Days[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetStudyActivityResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetStudyActivityResponseMultiError is an error wrapping multiple validation
+// errors returned by GetStudyActivityResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetStudyActivityResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetStudyActivityResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetStudyActivityResponseMultiError) AllErrors() []error { return m }
+
+// GetStudyActivityResponseValidationError is the validation error returned by
+// GetStudyActivityResponse.Validate if the designated constraints aren't met.
+type GetStudyActivityResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetStudyActivityResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetStudyActivityResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetStudyActivityResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetStudyActivityResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetStudyActivityResponseValidationError) ErrorName() string {
+	return "GetStudyActivityResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetStudyActivityResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetStudyActivityResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetStudyActivityResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetStudyActivityResponseValidationError{}
+
+// Validate checks the field values on ResetMemoryUnitRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ResetMemoryUnitRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResetMemoryUnitRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResetMemoryUnitRequestMultiError, or nil if none found.
+func (m *ResetMemoryUnitRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResetMemoryUnitRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MemoryUnitId
+
+	if len(errors) > 0 {
+		return ResetMemoryUnitRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResetMemoryUnitRequestMultiError is an error wrapping multiple validation
+// errors returned by ResetMemoryUnitRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ResetMemoryUnitRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResetMemoryUnitRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResetMemoryUnitRequestMultiError) AllErrors() []error { return m }
+
+// ResetMemoryUnitRequestValidationError is the validation error returned by
+// ResetMemoryUnitRequest.Validate if the designated constraints aren't met.
+type ResetMemoryUnitRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResetMemoryUnitRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResetMemoryUnitRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResetMemoryUnitRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResetMemoryUnitRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResetMemoryUnitRequestValidationError) ErrorName() string {
+	return "ResetMemoryUnitRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResetMemoryUnitRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResetMemoryUnitRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResetMemoryUnitRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResetMemoryUnitRequestValidationError{}
+
+// Validate checks the field values on ResetMemoryUnitResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ResetMemoryUnitResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResetMemoryUnitResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResetMemoryUnitResponseMultiError, or nil if none found.
+func (m *ResetMemoryUnitResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResetMemoryUnitResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetStatus()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ResetMemoryUnitResponseValidationError{
+					field:  "Status",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ResetMemoryUnitResponseValidationError{
+					field:  "Status",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStatus()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ResetMemoryUnitResponseValidationError{
+				field:  "Status",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ResetMemoryUnitResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResetMemoryUnitResponseMultiError is an error wrapping multiple validation
+// errors returned by ResetMemoryUnitResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ResetMemoryUnitResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResetMemoryUnitResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResetMemoryUnitResponseMultiError) AllErrors() []error { return m }
+
+// ResetMemoryUnitResponseValidationError is the validation error returned by
+// ResetMemoryUnitResponse.Validate if the designated constraints aren't met.
+type ResetMemoryUnitResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResetMemoryUnitResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResetMemoryUnitResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResetMemoryUnitResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResetMemoryUnitResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResetMemoryUnitResponseValidationError) ErrorName() string {
+	return "ResetMemoryUnitResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResetMemoryUnitResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResetMemoryUnitResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResetMemoryUnitResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResetMemoryUnitResponseValidationError{}
+
+// Validate checks the field values on BuryMemoryUnitRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BuryMemoryUnitRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BuryMemoryUnitRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BuryMemoryUnitRequestMultiError, or nil if none found.
+func (m *BuryMemoryUnitRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BuryMemoryUnitRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MemoryUnitId
+
+	if len(errors) > 0 {
+		return BuryMemoryUnitRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BuryMemoryUnitRequestMultiError is an error wrapping multiple validation
+// errors returned by BuryMemoryUnitRequest.ValidateAll() if the designated
+// constraints aren't met.
+type BuryMemoryUnitRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BuryMemoryUnitRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BuryMemoryUnitRequestMultiError) AllErrors() []error { return m }
+
+// BuryMemoryUnitRequestValidationError is the validation error returned by
+// BuryMemoryUnitRequest.Validate if the designated constraints aren't met.
+type BuryMemoryUnitRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BuryMemoryUnitRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BuryMemoryUnitRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BuryMemoryUnitRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BuryMemoryUnitRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BuryMemoryUnitRequestValidationError) ErrorName() string {
+	return "BuryMemoryUnitRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BuryMemoryUnitRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBuryMemoryUnitRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BuryMemoryUnitRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BuryMemoryUnitRequestValidationError{}
+
+// Validate checks the field values on BuryMemoryUnitResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BuryMemoryUnitResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BuryMemoryUnitResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BuryMemoryUnitResponseMultiError, or nil if none found.
+func (m *BuryMemoryUnitResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BuryMemoryUnitResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetStatus()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BuryMemoryUnitResponseValidationError{
+					field:  "Status",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BuryMemoryUnitResponseValidationError{
+					field:  "Status",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStatus()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BuryMemoryUnitResponseValidationError{
+				field:  "Status",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return BuryMemoryUnitResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BuryMemoryUnitResponseMultiError is an error wrapping multiple validation
+// errors returned by BuryMemoryUnitResponse.ValidateAll() if the designated
+// constraints aren't met.
+type BuryMemoryUnitResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BuryMemoryUnitResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BuryMemoryUnitResponseMultiError) AllErrors() []error { return m }
+
+// BuryMemoryUnitResponseValidationError is the validation error returned by
+// BuryMemoryUnitResponse.Validate if the designated constraints aren't met.
+type BuryMemoryUnitResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BuryMemoryUnitResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BuryMemoryUnitResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BuryMemoryUnitResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BuryMemoryUnitResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BuryMemoryUnitResponseValidationError) ErrorName() string {
+	return "BuryMemoryUnitResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BuryMemoryUnitResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBuryMemoryUnitResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BuryMemoryUnitResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BuryMemoryUnitResponseValidationError{}
+
+// Validate checks the field values on RescheduleMemoryUnitRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RescheduleMemoryUnitRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RescheduleMemoryUnitRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RescheduleMemoryUnitRequestMultiError, or nil if none found.
+func (m *RescheduleMemoryUnitRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RescheduleMemoryUnitRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MemoryUnitId
+
+	// no validation rules for Date
+
+	if len(errors) > 0 {
+		return RescheduleMemoryUnitRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RescheduleMemoryUnitRequestMultiError is an error wrapping multiple
+// validation errors returned by RescheduleMemoryUnitRequest.ValidateAll() if
+// the designated constraints aren't met.
+type RescheduleMemoryUnitRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RescheduleMemoryUnitRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RescheduleMemoryUnitRequestMultiError) AllErrors() []error { return m }
+
+// RescheduleMemoryUnitRequestValidationError is the validation error returned
+// by RescheduleMemoryUnitRequest.Validate if the designated constraints aren't met.
+type RescheduleMemoryUnitRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RescheduleMemoryUnitRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RescheduleMemoryUnitRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RescheduleMemoryUnitRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RescheduleMemoryUnitRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RescheduleMemoryUnitRequestValidationError) ErrorName() string {
+	return "RescheduleMemoryUnitRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RescheduleMemoryUnitRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRescheduleMemoryUnitRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RescheduleMemoryUnitRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RescheduleMemoryUnitRequestValidationError{}
+
+// Validate checks the field values on RescheduleMemoryUnitResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RescheduleMemoryUnitResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RescheduleMemoryUnitResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RescheduleMemoryUnitResponseMultiError, or nil if none found.
+func (m *RescheduleMemoryUnitResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RescheduleMemoryUnitResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetStatus()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RescheduleMemoryUnitResponseValidationError{
+					field:  "Status",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RescheduleMemoryUnitResponseValidationError{
+					field:  "Status",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStatus()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RescheduleMemoryUnitResponseValidationError{
+				field:  "Status",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RescheduleMemoryUnitResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RescheduleMemoryUnitResponseMultiError is an error wrapping multiple
+// validation errors returned by RescheduleMemoryUnitResponse.ValidateAll() if
+// the designated constraints aren't met.
+type RescheduleMemoryUnitResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RescheduleMemoryUnitResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RescheduleMemoryUnitResponseMultiError) AllErrors() []error { return m }
+
+// RescheduleMemoryUnitResponseValidationError is the validation error returned
+// by RescheduleMemoryUnitResponse.Validate if the designated constraints aren't met.
+type RescheduleMemoryUnitResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RescheduleMemoryUnitResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RescheduleMemoryUnitResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RescheduleMemoryUnitResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RescheduleMemoryUnitResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RescheduleMemoryUnitResponseValidationError) ErrorName() string {
+	return "RescheduleMemoryUnitResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RescheduleMemoryUnitResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRescheduleMemoryUnitResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RescheduleMemoryUnitResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RescheduleMemoryUnitResponseValidationError{}
+
+// Validate checks the field values on RescheduleDueReviewsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RescheduleDueReviewsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RescheduleDueReviewsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RescheduleDueReviewsRequestMultiError, or nil if none found.
+func (m *RescheduleDueReviewsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RescheduleDueReviewsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Days
+
+	if len(errors) > 0 {
+		return RescheduleDueReviewsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RescheduleDueReviewsRequestMultiError is an error wrapping multiple
+// validation errors returned by RescheduleDueReviewsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type RescheduleDueReviewsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RescheduleDueReviewsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RescheduleDueReviewsRequestMultiError) AllErrors() []error { return m }
+
+// RescheduleDueReviewsRequestValidationError is the validation error returned
+// by RescheduleDueReviewsRequest.Validate if the designated constraints aren't met.
+type RescheduleDueReviewsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RescheduleDueReviewsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RescheduleDueReviewsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RescheduleDueReviewsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RescheduleDueReviewsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RescheduleDueReviewsRequestValidationError) ErrorName() string {
+	return "RescheduleDueReviewsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RescheduleDueReviewsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRescheduleDueReviewsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RescheduleDueReviewsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RescheduleDueReviewsRequestValidationError{}
+
+// Validate checks the field values on RescheduleDueReviewsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RescheduleDueReviewsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RescheduleDueReviewsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RescheduleDueReviewsResponseMultiError, or nil if none found.
+func (m *RescheduleDueReviewsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RescheduleDueReviewsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RescheduledCount
+
+	if len(errors) > 0 {
+		return RescheduleDueReviewsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RescheduleDueReviewsResponseMultiError is an error wrapping multiple
+// validation errors returned by RescheduleDueReviewsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type RescheduleDueReviewsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RescheduleDueReviewsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RescheduleDueReviewsResponseMultiError) AllErrors() []error { return m }
+
+// RescheduleDueReviewsResponseValidationError is the validation error returned
+// by RescheduleDueReviewsResponse.Validate if the designated constraints aren't met.
+type RescheduleDueReviewsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RescheduleDueReviewsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RescheduleDueReviewsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RescheduleDueReviewsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RescheduleDueReviewsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RescheduleDueReviewsResponseValidationError) ErrorName() string {
+	return "RescheduleDueReviewsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RescheduleDueReviewsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRescheduleDueReviewsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RescheduleDueReviewsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RescheduleDueReviewsResponseValidationError{}
+
+// Validate checks the field values on ForgetCourseRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ForgetCourseRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ForgetCourseRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ForgetCourseRequestMultiError, or nil if none found.
+func (m *ForgetCourseRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ForgetCourseRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CourseId
+
+	if len(errors) > 0 {
+		return ForgetCourseRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ForgetCourseRequestMultiError is an error wrapping multiple validation
+// errors returned by ForgetCourseRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ForgetCourseRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ForgetCourseRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ForgetCourseRequestMultiError) AllErrors() []error { return m }
+
+// ForgetCourseRequestValidationError is the validation error returned by
+// ForgetCourseRequest.Validate if the designated constraints aren't met.
+type ForgetCourseRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ForgetCourseRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ForgetCourseRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ForgetCourseRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ForgetCourseRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ForgetCourseRequestValidationError) ErrorName() string {
+	return "ForgetCourseRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ForgetCourseRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sForgetCourseRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ForgetCourseRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ForgetCourseRequestValidationError{}
+
+// Validate checks the field values on ForgetCourseResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ForgetCourseResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ForgetCourseResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ForgetCourseResponseMultiError, or nil if none found.
+func (m *ForgetCourseResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ForgetCourseResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ResetCount
+
+	if len(errors) > 0 {
+		return ForgetCourseResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ForgetCourseResponseMultiError is an error wrapping multiple validation
+// errors returned by ForgetCourseResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ForgetCourseResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ForgetCourseResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ForgetCourseResponseMultiError) AllErrors() []error { return m }
+
+// ForgetCourseResponseValidationError is the validation error returned by
+// ForgetCourseResponse.Validate if the designated constraints aren't met.
+type ForgetCourseResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ForgetCourseResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ForgetCourseResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ForgetCourseResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ForgetCourseResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ForgetCourseResponseValidationError) ErrorName() string {
+	return "ForgetCourseResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ForgetCourseResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sForgetCourseResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ForgetCourseResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ForgetCourseResponseValidationError{}
+
+// Validate checks the field values on SubmitHanCharReviewRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SubmitHanCharReviewRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SubmitHanCharReviewRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SubmitHanCharReviewRequestMultiError, or nil if none found.
+func (m *SubmitHanCharReviewRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SubmitHanCharReviewRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for HanCharId
+
+	// no validation rules for IsRecognized
+
+	if all {
+		switch v := interface{}(m.GetReviewTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SubmitHanCharReviewRequestValidationError{
+					field:  "ReviewTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SubmitHanCharReviewRequestValidationError{
+					field:  "ReviewTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReviewTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SubmitHanCharReviewRequestValidationError{
+				field:  "ReviewTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SubmitHanCharReviewRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SubmitHanCharReviewRequestMultiError is an error wrapping multiple
+// validation errors returned by SubmitHanCharReviewRequest.ValidateAll() if
 // the designated constraints aren't met.
 type SubmitHanCharReviewRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SubmitHanCharReviewRequestMultiError) Error() string {
+func (m SubmitHanCharReviewRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SubmitHanCharReviewRequestMultiError) AllErrors() []error { return m }
+
+// SubmitHanCharReviewRequestValidationError is the validation error returned
+// by SubmitHanCharReviewRequest.Validate if the designated constraints aren't met.
+type SubmitHanCharReviewRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SubmitHanCharReviewRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SubmitHanCharReviewRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SubmitHanCharReviewRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SubmitHanCharReviewRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SubmitHanCharReviewRequestValidationError) ErrorName() string {
+	return "SubmitHanCharReviewRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SubmitHanCharReviewRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSubmitHanCharReviewRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SubmitHanCharReviewRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SubmitHanCharReviewRequestValidationError{}
+
+// Validate checks the field values on SubmitHanCharReviewResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SubmitHanCharReviewResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SubmitHanCharReviewResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SubmitHanCharReviewResponseMultiError, or nil if none found.
+func (m *SubmitHanCharReviewResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SubmitHanCharReviewResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetNextReviewTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SubmitHanCharReviewResponseValidationError{
+					field:  "NextReviewTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SubmitHanCharReviewResponseValidationError{
+					field:  "NextReviewTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNextReviewTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SubmitHanCharReviewResponseValidationError{
+				field:  "NextReviewTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SubmitHanCharReviewResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SubmitHanCharReviewResponseMultiError is an error wrapping multiple
+// validation errors returned by SubmitHanCharReviewResponse.ValidateAll() if
+// the designated constraints aren't met.
+type SubmitHanCharReviewResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SubmitHanCharReviewResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SubmitHanCharReviewResponseMultiError) AllErrors() []error { return m }
+
+// SubmitHanCharReviewResponseValidationError is the validation error returned
+// by SubmitHanCharReviewResponse.Validate if the designated constraints
+// aren't met.
+type SubmitHanCharReviewResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SubmitHanCharReviewResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SubmitHanCharReviewResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SubmitHanCharReviewResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SubmitHanCharReviewResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SubmitHanCharReviewResponseValidationError) ErrorName() string {
+	return "SubmitHanCharReviewResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SubmitHanCharReviewResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSubmitHanCharReviewResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SubmitHanCharReviewResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SubmitHanCharReviewResponseValidationError{}
+
+// Validate checks the field values on SubmitHanCharHandwritingRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SubmitHanCharHandwritingRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SubmitHanCharHandwritingRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// SubmitHanCharHandwritingRequestMultiError, or nil if none found.
+func (m *SubmitHanCharHandwritingRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SubmitHanCharHandwritingRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for HanCharId
+
+	// no validation rules for Attempt
+
+	for idx, item := range m.GetStrokes() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SubmitHanCharHandwritingRequestValidationError{
+						field:  fmt.Sprintf("Strokes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SubmitHanCharHandwritingRequestValidationError{
+						field:  fmt.Sprintf("Strokes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SubmitHanCharHandwritingRequestValidationError{
+					field:  fmt.Sprintf("Strokes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for StudyDuration
+
+	if len(errors) > 0 {
+		return SubmitHanCharHandwritingRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SubmitHanCharHandwritingRequestMultiError is an error wrapping multiple
+// validation errors returned by SubmitHanCharHandwritingRequest.ValidateAll()
+// if the designated constraints aren't met.
+type SubmitHanCharHandwritingRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SubmitHanCharHandwritingRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2876,11 +7862,12 @@ func (m SubmitHanCharReviewRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SubmitHanCharReviewRequestMultiError) AllErrors() []error { return m }
+func (m SubmitHanCharHandwritingRequestMultiError) AllErrors() []error { return m }
 
-// SubmitHanCharReviewRequestValidationError is the validation error returned
-// by SubmitHanCharReviewRequest.Validate if the designated constraints aren't met.
-type SubmitHanCharReviewRequestValidationError struct {
+// SubmitHanCharHandwritingRequestValidationError is the validation error
+// returned by SubmitHanCharHandwritingRequest.Validate if the designated
+// constraints aren't met.
+type SubmitHanCharHandwritingRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2888,24 +7875,24 @@ type SubmitHanCharReviewRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e SubmitHanCharReviewRequestValidationError) Field() string { return e.field }
+func (e SubmitHanCharHandwritingRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SubmitHanCharReviewRequestValidationError) Reason() string { return e.reason }
+func (e SubmitHanCharHandwritingRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SubmitHanCharReviewRequestValidationError) Cause() error { return e.cause }
+func (e SubmitHanCharHandwritingRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SubmitHanCharReviewRequestValidationError) Key() bool { return e.key }
+func (e SubmitHanCharHandwritingRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SubmitHanCharReviewRequestValidationError) ErrorName() string {
-	return "SubmitHanCharReviewRequestValidationError"
+func (e SubmitHanCharHandwritingRequestValidationError) ErrorName() string {
+	return "SubmitHanCharHandwritingRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SubmitHanCharReviewRequestValidationError) Error() string {
+func (e SubmitHanCharHandwritingRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2917,14 +7904,14 @@ func (e SubmitHanCharReviewRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSubmitHanCharReviewRequest.%s: %s%s",
+		"invalid %sSubmitHanCharHandwritingRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SubmitHanCharReviewRequestValidationError{}
+var _ error = SubmitHanCharHandwritingRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2932,73 +7919,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SubmitHanCharReviewRequestValidationError{}
+} = SubmitHanCharHandwritingRequestValidationError{}
 
-// Validate checks the field values on SubmitHanCharReviewResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SubmitHanCharReviewResponse) Validate() error {
+// Validate checks the field values on SubmitHanCharHandwritingResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *SubmitHanCharHandwritingResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SubmitHanCharReviewResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// SubmitHanCharReviewResponseMultiError, or nil if none found.
-func (m *SubmitHanCharReviewResponse) ValidateAll() error {
+// ValidateAll checks the field values on SubmitHanCharHandwritingResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// SubmitHanCharHandwritingResponseMultiError, or nil if none found.
+func (m *SubmitHanCharHandwritingResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SubmitHanCharReviewResponse) validate(all bool) error {
+func (m *SubmitHanCharHandwritingResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetNextReviewTime()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SubmitHanCharReviewResponseValidationError{
-					field:  "NextReviewTime",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, SubmitHanCharReviewResponseValidationError{
-					field:  "NextReviewTime",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetNextReviewTime()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return SubmitHanCharReviewResponseValidationError{
-				field:  "NextReviewTime",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Correct
+
+	// no validation rules for ExtraStrokes
 
 	if len(errors) > 0 {
-		return SubmitHanCharReviewResponseMultiError(errors)
+		return SubmitHanCharHandwritingResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// SubmitHanCharReviewResponseMultiError is an error wrapping multiple
-// validation errors returned by SubmitHanCharReviewResponse.ValidateAll() if
-// the designated constraints aren't met.
-type SubmitHanCharReviewResponseMultiError []error
+// SubmitHanCharHandwritingResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// SubmitHanCharHandwritingResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SubmitHanCharHandwritingResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SubmitHanCharReviewResponseMultiError) Error() string {
+func (m SubmitHanCharHandwritingResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3007,12 +7971,12 @@ func (m SubmitHanCharReviewResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SubmitHanCharReviewResponseMultiError) AllErrors() []error { return m }
+func (m SubmitHanCharHandwritingResponseMultiError) AllErrors() []error { return m }
 
-// SubmitHanCharReviewResponseValidationError is the validation error returned
-// by SubmitHanCharReviewResponse.Validate if the designated constraints
-// aren't met.
-type SubmitHanCharReviewResponseValidationError struct {
+// SubmitHanCharHandwritingResponseValidationError is the validation error
+// returned by SubmitHanCharHandwritingResponse.Validate if the designated
+// constraints aren't met.
+type SubmitHanCharHandwritingResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3020,24 +7984,24 @@ type SubmitHanCharReviewResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e SubmitHanCharReviewResponseValidationError) Field() string { return e.field }
+func (e SubmitHanCharHandwritingResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SubmitHanCharReviewResponseValidationError) Reason() string { return e.reason }
+func (e SubmitHanCharHandwritingResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SubmitHanCharReviewResponseValidationError) Cause() error { return e.cause }
+func (e SubmitHanCharHandwritingResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SubmitHanCharReviewResponseValidationError) Key() bool { return e.key }
+func (e SubmitHanCharHandwritingResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SubmitHanCharReviewResponseValidationError) ErrorName() string {
-	return "SubmitHanCharReviewResponseValidationError"
+func (e SubmitHanCharHandwritingResponseValidationError) ErrorName() string {
+	return "SubmitHanCharHandwritingResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SubmitHanCharReviewResponseValidationError) Error() string {
+func (e SubmitHanCharHandwritingResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3049,14 +8013,14 @@ func (e SubmitHanCharReviewResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSubmitHanCharReviewResponse.%s: %s%s",
+		"invalid %sSubmitHanCharHandwritingResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SubmitHanCharReviewResponseValidationError{}
+var _ error = SubmitHanCharHandwritingResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3064,7 +8028,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SubmitHanCharReviewResponseValidationError{}
+} = SubmitHanCharHandwritingResponseValidationError{}
 
 // Validate checks the field values on GetHanCharTestRequest with the rules
 // defined in the proto definition for this message. If any rules are
@@ -3092,6 +8056,8 @@ func (m *GetHanCharTestRequest) validate(all bool) error {
 
 	// no validation rules for DifficultyLevel
 
+	// no validation rules for Seed
+
 	if len(errors) > 0 {
 		return GetHanCharTestRequestMultiError(errors)
 	}
@@ -3228,6 +8194,42 @@ func (m *GetHanCharTestResponse) validate(all bool) error {
 
 	}
 
+	// no validation rules for Seed
+
+	for idx, item := range m.GetQuestions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetHanCharTestResponseValidationError{
+						field:  fmt.Sprintf("Questions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetHanCharTestResponseValidationError{
+						field:  fmt.Sprintf("Questions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetHanCharTestResponseValidationError{
+					field:  fmt.Sprintf("Questions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return GetHanCharTestResponseMultiError(errors)
 	}
@@ -3262,24 +8264,129 @@ type GetHanCharTestResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetHanCharTestResponseValidationError) Field() string { return e.field }
+func (e GetHanCharTestResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetHanCharTestResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetHanCharTestResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetHanCharTestResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetHanCharTestResponseValidationError) ErrorName() string {
+	return "GetHanCharTestResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetHanCharTestResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetHanCharTestResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetHanCharTestResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetHanCharTestResponseValidationError{}
+
+// Validate checks the field values on HanCharQuestion with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *HanCharQuestion) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on HanCharQuestion with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// HanCharQuestionMultiError, or nil if none found.
+func (m *HanCharQuestion) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *HanCharQuestion) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for HanCharId
+
+	// no validation rules for Type
+
+	// no validation rules for Prompt
+
+	if len(errors) > 0 {
+		return HanCharQuestionMultiError(errors)
+	}
+
+	return nil
+}
+
+// HanCharQuestionMultiError is an error wrapping multiple validation errors
+// returned by HanCharQuestion.ValidateAll() if the designated constraints aren't met.
+type HanCharQuestionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m HanCharQuestionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m HanCharQuestionMultiError) AllErrors() []error { return m }
+
+// HanCharQuestionValidationError is the validation error returned by
+// HanCharQuestion.Validate if the designated constraints aren't met.
+type HanCharQuestionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HanCharQuestionValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetHanCharTestResponseValidationError) Reason() string { return e.reason }
+func (e HanCharQuestionValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetHanCharTestResponseValidationError) Cause() error { return e.cause }
+func (e HanCharQuestionValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetHanCharTestResponseValidationError) Key() bool { return e.key }
+func (e HanCharQuestionValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetHanCharTestResponseValidationError) ErrorName() string {
-	return "GetHanCharTestResponseValidationError"
-}
+func (e HanCharQuestionValidationError) ErrorName() string { return "HanCharQuestionValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetHanCharTestResponseValidationError) Error() string {
+func (e HanCharQuestionValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3291,14 +8398,14 @@ func (e GetHanCharTestResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetHanCharTestResponse.%s: %s%s",
+		"invalid %sHanCharQuestion.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetHanCharTestResponseValidationError{}
+var _ error = HanCharQuestionValidationError{}
 
 var _ interface {
 	Field() string
@@ -3306,7 +8413,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetHanCharTestResponseValidationError{}
+} = HanCharQuestionValidationError{}
 
 // Validate checks the field values on SubmitHanCharTestResultRequest with the
 // rules defined in the proto definition for this message. If any rules are
@@ -3364,6 +8471,71 @@ func (m *SubmitHanCharTestResultRequest) validate(all bool) error {
 
 	}
 
+	// no validation rules for Seed
+
+	for idx, item := range m.GetAnswers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SubmitHanCharTestResultRequestValidationError{
+						field:  fmt.Sprintf("Answers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SubmitHanCharTestResultRequestValidationError{
+						field:  fmt.Sprintf("Answers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SubmitHanCharTestResultRequestValidationError{
+					field:  fmt.Sprintf("Answers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetTestTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SubmitHanCharTestResultRequestValidationError{
+					field:  "TestTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SubmitHanCharTestResultRequestValidationError{
+					field:  "TestTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTestTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SubmitHanCharTestResultRequestValidationError{
+				field:  "TestTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return SubmitHanCharTestResultRequestMultiError(errors)
 	}
@@ -3551,6 +8723,220 @@ var _ interface {
 	ErrorName() string
 } = HanCharTestResultValidationError{}
 
+// Validate checks the field values on HanCharTestAnswer with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *HanCharTestAnswer) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on HanCharTestAnswer with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// HanCharTestAnswerMultiError, or nil if none found.
+func (m *HanCharTestAnswer) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *HanCharTestAnswer) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for HanCharId
+
+	// no validation rules for Type
+
+	// no validation rules for SelectedOption
+
+	if len(errors) > 0 {
+		return HanCharTestAnswerMultiError(errors)
+	}
+
+	return nil
+}
+
+// HanCharTestAnswerMultiError is an error wrapping multiple validation errors
+// returned by HanCharTestAnswer.ValidateAll() if the designated constraints aren't met.
+type HanCharTestAnswerMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m HanCharTestAnswerMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m HanCharTestAnswerMultiError) AllErrors() []error { return m }
+
+// HanCharTestAnswerValidationError is the validation error returned by
+// HanCharTestAnswer.Validate if the designated constraints aren't met.
+type HanCharTestAnswerValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HanCharTestAnswerValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HanCharTestAnswerValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HanCharTestAnswerValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HanCharTestAnswerValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HanCharTestAnswerValidationError) ErrorName() string {
+	return "HanCharTestAnswerValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e HanCharTestAnswerValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHanCharTestAnswer.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HanCharTestAnswerValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HanCharTestAnswerValidationError{}
+
+// Validate checks the field values on HanCharTestGrade with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *HanCharTestGrade) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on HanCharTestGrade with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// HanCharTestGradeMultiError, or nil if none found.
+func (m *HanCharTestGrade) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *HanCharTestGrade) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for HanCharId
+
+	// no validation rules for Type
+
+	// no validation rules for Correct
+
+	// no validation rules for Answer
+
+	if len(errors) > 0 {
+		return HanCharTestGradeMultiError(errors)
+	}
+
+	return nil
+}
+
+// HanCharTestGradeMultiError is an error wrapping multiple validation errors
+// returned by HanCharTestGrade.ValidateAll() if the designated constraints aren't met.
+type HanCharTestGradeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m HanCharTestGradeMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m HanCharTestGradeMultiError) AllErrors() []error { return m }
+
+// HanCharTestGradeValidationError is the validation error returned by
+// HanCharTestGrade.Validate if the designated constraints aren't met.
+type HanCharTestGradeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HanCharTestGradeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HanCharTestGradeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HanCharTestGradeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HanCharTestGradeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HanCharTestGradeValidationError) ErrorName() string { return "HanCharTestGradeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e HanCharTestGradeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHanCharTestGrade.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HanCharTestGradeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HanCharTestGradeValidationError{}
+
 // Validate checks the field values on SubmitHanCharTestResultResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -3573,6 +8959,40 @@ func (m *SubmitHanCharTestResultResponse) validate(all bool) error {
 
 	var errors []error
 
+	for idx, item := range m.GetGrades() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SubmitHanCharTestResultResponseValidationError{
+						field:  fmt.Sprintf("Grades[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SubmitHanCharTestResultResponseValidationError{
+						field:  fmt.Sprintf("Grades[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SubmitHanCharTestResultResponseValidationError{
+					field:  fmt.Sprintf("Grades[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return SubmitHanCharTestResultResponseMultiError(errors)
 	}
@@ -3678,6 +9098,10 @@ func (m *GetNewHanCharLearningRequest) validate(all bool) error {
 
 	// no validation rules for Count
 
+	// no validation rules for Level
+
+	// no validation rules for Category
+
 	if len(errors) > 0 {
 		return GetNewHanCharLearningRequestMultiError(errors)
 	}
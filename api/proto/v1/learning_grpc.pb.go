@@ -28,9 +28,25 @@ const (
 	LearningService_GetMemoryStats_FullMethodName                 = "/proto.v1.LearningService/GetMemoryStats"
 	LearningService_ReviewWord_FullMethodName                     = "/proto.v1.LearningService/ReviewWord"
 	LearningService_ReviewHanChar_FullMethodName                  = "/proto.v1.LearningService/ReviewHanChar"
+	LearningService_Review_FullMethodName                         = "/proto.v1.LearningService/Review"
+	LearningService_ListMemoryReviews_FullMethodName              = "/proto.v1.LearningService/ListMemoryReviews"
+	LearningService_StartStudySession_FullMethodName              = "/proto.v1.LearningService/StartStudySession"
+	LearningService_SubmitReviewBatch_FullMethodName              = "/proto.v1.LearningService/SubmitReviewBatch"
+	LearningService_ForecastReviews_FullMethodName                = "/proto.v1.LearningService/ForecastReviews"
+	LearningService_ListLeeches_FullMethodName                    = "/proto.v1.LearningService/ListLeeches"
+	LearningService_UnsuspendMemoryUnit_FullMethodName            = "/proto.v1.LearningService/UnsuspendMemoryUnit"
+	LearningService_ResetLeech_FullMethodName                     = "/proto.v1.LearningService/ResetLeech"
+	LearningService_ListHardContents_FullMethodName               = "/proto.v1.LearningService/ListHardContents"
+	LearningService_GetStudyActivity_FullMethodName               = "/proto.v1.LearningService/GetStudyActivity"
+	LearningService_ResetMemoryUnit_FullMethodName                = "/proto.v1.LearningService/ResetMemoryUnit"
+	LearningService_BuryMemoryUnit_FullMethodName                 = "/proto.v1.LearningService/BuryMemoryUnit"
+	LearningService_RescheduleMemoryUnit_FullMethodName           = "/proto.v1.LearningService/RescheduleMemoryUnit"
+	LearningService_RescheduleDueReviews_FullMethodName           = "/proto.v1.LearningService/RescheduleDueReviews"
+	LearningService_ForgetCourse_FullMethodName                   = "/proto.v1.LearningService/ForgetCourse"
 	LearningService_SubmitHanCharReview_FullMethodName            = "/proto.v1.LearningService/SubmitHanCharReview"
 	LearningService_GetHanCharTest_FullMethodName                 = "/proto.v1.LearningService/GetHanCharTest"
 	LearningService_SubmitHanCharTestResult_FullMethodName        = "/proto.v1.LearningService/SubmitHanCharTestResult"
+	LearningService_SubmitHanCharHandwriting_FullMethodName       = "/proto.v1.LearningService/SubmitHanCharHandwriting"
 	LearningService_GetNewHanCharLearning_FullMethodName          = "/proto.v1.LearningService/GetNewHanCharLearning"
 	LearningService_SubmitNewHanCharLearningResult_FullMethodName = "/proto.v1.LearningService/SubmitNewHanCharLearningResult"
 )
@@ -49,7 +65,7 @@ type LearningServiceClient interface {
 	UpdateUnitProgress(ctx context.Context, in *LearningServiceUpdateUnitProgressRequest, opts ...grpc.CallOption) (*LearningServiceUpdateUnitProgressResponse, error)
 	// 获取记忆单元状态
 	GetMemoryStatus(ctx context.Context, in *GetMemoryStatusRequest, opts ...grpc.CallOption) (*GetMemoryStatusResponse, error)
-	// 更新记忆单元状态
+	// 手动设置记忆单元的掌握程度（1-5）并累加学习时长，记录到复习日志
 	UpdateMemoryStatus(ctx context.Context, in *UpdateMemoryStatusRequest, opts ...grpc.CallOption) (*UpdateMemoryStatusResponse, error)
 	// 获取需要复习的记忆单元列表
 	ListMemoriesForReview(ctx context.Context, in *ListMemoriesForReviewRequest, opts ...grpc.CallOption) (*ListMemoriesForReviewResponse, error)
@@ -59,12 +75,44 @@ type LearningServiceClient interface {
 	ReviewWord(ctx context.Context, in *ReviewWordRequest, opts ...grpc.CallOption) (*ReviewWordResponse, error)
 	// 待复习汉字
 	ReviewHanChar(ctx context.Context, in *ReviewHanCharRequest, opts ...grpc.CallOption) (*ReviewHanCharResponse, error)
+	// 复习任意类型的学习内容
+	Review(ctx context.Context, in *ReviewRequest, opts ...grpc.CallOption) (*ReviewResponse, error)
+	// 获取记忆单元的复习记录
+	ListMemoryReviews(ctx context.Context, in *ListMemoryReviewsRequest, opts ...grpc.CallOption) (*ListMemoryReviewsResponse, error)
+	// 开始学习会话，组装待复习项和新学习项的有序队列
+	StartStudySession(ctx context.Context, in *StartStudySessionRequest, opts ...grpc.CallOption) (*StartStudySessionResponse, error)
+	// 批量提交复习，供离线客户端同步，按复习时间依次应用并在同一事务中保存
+	SubmitReviewBatch(ctx context.Context, in *SubmitReviewBatchRequest, opts ...grpc.CallOption) (*SubmitReviewBatchResponse, error)
+	// 预测未来每天的复习量，可模拟每天学习新内容的影响
+	ForecastReviews(ctx context.Context, in *ForecastReviewsRequest, opts ...grpc.CallOption) (*ForecastReviewsResponse, error)
+	// 获取我的顽固项（反复遗忘的记忆单元）
+	ListLeeches(ctx context.Context, in *ListLeechesRequest, opts ...grpc.CallOption) (*ListLeechesResponse, error)
+	// 恢复已暂停的记忆单元的复习
+	UnsuspendMemoryUnit(ctx context.Context, in *UnsuspendMemoryUnitRequest, opts ...grpc.CallOption) (*UnsuspendMemoryUnitResponse, error)
+	// 清除记忆单元的顽固项状态，遗忘次数重新累计
+	ResetLeech(ctx context.Context, in *ResetLeechRequest, opts ...grpc.CallOption) (*ResetLeechResponse, error)
+	// 获取被最多用户标记为顽固项的学习内容，供教师查看
+	ListHardContents(ctx context.Context, in *ListHardContentsRequest, opts ...grpc.CallOption) (*ListHardContentsResponse, error)
+	// 获取连续学习天数和学习热力图
+	GetStudyActivity(ctx context.Context, in *GetStudyActivityRequest, opts ...grpc.CallOption) (*GetStudyActivityResponse, error)
+	// 将记忆单元重置为新内容
+	ResetMemoryUnit(ctx context.Context, in *ResetMemoryUnitRequest, opts ...grpc.CallOption) (*ResetMemoryUnitResponse, error)
+	// 搁置记忆单元到下一个学习日
+	BuryMemoryUnit(ctx context.Context, in *BuryMemoryUnitRequest, opts ...grpc.CallOption) (*BuryMemoryUnitResponse, error)
+	// 将记忆单元的下次复习调整到指定日期
+	RescheduleMemoryUnit(ctx context.Context, in *RescheduleMemoryUnitRequest, opts ...grpc.CallOption) (*RescheduleMemoryUnitResponse, error)
+	// 将到期的复习分散到之后的若干个学习日，用于假期后积压的复习
+	RescheduleDueReviews(ctx context.Context, in *RescheduleDueReviewsRequest, opts ...grpc.CallOption) (*RescheduleDueReviewsResponse, error)
+	// 将课程内容对应的记忆单元全部重置为新内容
+	ForgetCourse(ctx context.Context, in *ForgetCourseRequest, opts ...grpc.CallOption) (*ForgetCourseResponse, error)
 	// 提交复习结果
 	SubmitHanCharReview(ctx context.Context, in *SubmitHanCharReviewRequest, opts ...grpc.CallOption) (*SubmitHanCharReviewResponse, error)
 	// 获取汉字测试
 	GetHanCharTest(ctx context.Context, in *GetHanCharTestRequest, opts ...grpc.CallOption) (*GetHanCharTestResponse, error)
 	// 提交测试结果
 	SubmitHanCharTestResult(ctx context.Context, in *SubmitHanCharTestResultRequest, opts ...grpc.CallOption) (*SubmitHanCharTestResultResponse, error)
+	// 提交汉字书写练习, 按笔顺逐笔评判
+	SubmitHanCharHandwriting(ctx context.Context, in *SubmitHanCharHandwritingRequest, opts ...grpc.CallOption) (*SubmitHanCharHandwritingResponse, error)
 	// 获取生字学习内容
 	GetNewHanCharLearning(ctx context.Context, in *GetNewHanCharLearningRequest, opts ...grpc.CallOption) (*GetNewHanCharLearningResponse, error)
 	// 提交生字学习结果
@@ -169,6 +217,156 @@ func (c *learningServiceClient) ReviewHanChar(ctx context.Context, in *ReviewHan
 	return out, nil
 }
 
+func (c *learningServiceClient) Review(ctx context.Context, in *ReviewRequest, opts ...grpc.CallOption) (*ReviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReviewResponse)
+	err := c.cc.Invoke(ctx, LearningService_Review_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *learningServiceClient) ListMemoryReviews(ctx context.Context, in *ListMemoryReviewsRequest, opts ...grpc.CallOption) (*ListMemoryReviewsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMemoryReviewsResponse)
+	err := c.cc.Invoke(ctx, LearningService_ListMemoryReviews_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *learningServiceClient) StartStudySession(ctx context.Context, in *StartStudySessionRequest, opts ...grpc.CallOption) (*StartStudySessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StartStudySessionResponse)
+	err := c.cc.Invoke(ctx, LearningService_StartStudySession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *learningServiceClient) SubmitReviewBatch(ctx context.Context, in *SubmitReviewBatchRequest, opts ...grpc.CallOption) (*SubmitReviewBatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubmitReviewBatchResponse)
+	err := c.cc.Invoke(ctx, LearningService_SubmitReviewBatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *learningServiceClient) ForecastReviews(ctx context.Context, in *ForecastReviewsRequest, opts ...grpc.CallOption) (*ForecastReviewsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ForecastReviewsResponse)
+	err := c.cc.Invoke(ctx, LearningService_ForecastReviews_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *learningServiceClient) ListLeeches(ctx context.Context, in *ListLeechesRequest, opts ...grpc.CallOption) (*ListLeechesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLeechesResponse)
+	err := c.cc.Invoke(ctx, LearningService_ListLeeches_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *learningServiceClient) UnsuspendMemoryUnit(ctx context.Context, in *UnsuspendMemoryUnitRequest, opts ...grpc.CallOption) (*UnsuspendMemoryUnitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnsuspendMemoryUnitResponse)
+	err := c.cc.Invoke(ctx, LearningService_UnsuspendMemoryUnit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *learningServiceClient) ResetLeech(ctx context.Context, in *ResetLeechRequest, opts ...grpc.CallOption) (*ResetLeechResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResetLeechResponse)
+	err := c.cc.Invoke(ctx, LearningService_ResetLeech_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *learningServiceClient) ListHardContents(ctx context.Context, in *ListHardContentsRequest, opts ...grpc.CallOption) (*ListHardContentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListHardContentsResponse)
+	err := c.cc.Invoke(ctx, LearningService_ListHardContents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *learningServiceClient) GetStudyActivity(ctx context.Context, in *GetStudyActivityRequest, opts ...grpc.CallOption) (*GetStudyActivityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetStudyActivityResponse)
+	err := c.cc.Invoke(ctx, LearningService_GetStudyActivity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *learningServiceClient) ResetMemoryUnit(ctx context.Context, in *ResetMemoryUnitRequest, opts ...grpc.CallOption) (*ResetMemoryUnitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResetMemoryUnitResponse)
+	err := c.cc.Invoke(ctx, LearningService_ResetMemoryUnit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *learningServiceClient) BuryMemoryUnit(ctx context.Context, in *BuryMemoryUnitRequest, opts ...grpc.CallOption) (*BuryMemoryUnitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BuryMemoryUnitResponse)
+	err := c.cc.Invoke(ctx, LearningService_BuryMemoryUnit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *learningServiceClient) RescheduleMemoryUnit(ctx context.Context, in *RescheduleMemoryUnitRequest, opts ...grpc.CallOption) (*RescheduleMemoryUnitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RescheduleMemoryUnitResponse)
+	err := c.cc.Invoke(ctx, LearningService_RescheduleMemoryUnit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *learningServiceClient) RescheduleDueReviews(ctx context.Context, in *RescheduleDueReviewsRequest, opts ...grpc.CallOption) (*RescheduleDueReviewsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RescheduleDueReviewsResponse)
+	err := c.cc.Invoke(ctx, LearningService_RescheduleDueReviews_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *learningServiceClient) ForgetCourse(ctx context.Context, in *ForgetCourseRequest, opts ...grpc.CallOption) (*ForgetCourseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ForgetCourseResponse)
+	err := c.cc.Invoke(ctx, LearningService_ForgetCourse_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *learningServiceClient) SubmitHanCharReview(ctx context.Context, in *SubmitHanCharReviewRequest, opts ...grpc.CallOption) (*SubmitHanCharReviewResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(SubmitHanCharReviewResponse)
@@ -199,6 +397,16 @@ func (c *learningServiceClient) SubmitHanCharTestResult(ctx context.Context, in
 	return out, nil
 }
 
+func (c *learningServiceClient) SubmitHanCharHandwriting(ctx context.Context, in *SubmitHanCharHandwritingRequest, opts ...grpc.CallOption) (*SubmitHanCharHandwritingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubmitHanCharHandwritingResponse)
+	err := c.cc.Invoke(ctx, LearningService_SubmitHanCharHandwriting_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *learningServiceClient) GetNewHanCharLearning(ctx context.Context, in *GetNewHanCharLearningRequest, opts ...grpc.CallOption) (*GetNewHanCharLearningResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetNewHanCharLearningResponse)
@@ -233,7 +441,7 @@ type LearningServiceServer interface {
 	UpdateUnitProgress(context.Context, *LearningServiceUpdateUnitProgressRequest) (*LearningServiceUpdateUnitProgressResponse, error)
 	// 获取记忆单元状态
 	GetMemoryStatus(context.Context, *GetMemoryStatusRequest) (*GetMemoryStatusResponse, error)
-	// 更新记忆单元状态
+	// 手动设置记忆单元的掌握程度（1-5）并累加学习时长，记录到复习日志
 	UpdateMemoryStatus(context.Context, *UpdateMemoryStatusRequest) (*UpdateMemoryStatusResponse, error)
 	// 获取需要复习的记忆单元列表
 	ListMemoriesForReview(context.Context, *ListMemoriesForReviewRequest) (*ListMemoriesForReviewResponse, error)
@@ -243,12 +451,44 @@ type LearningServiceServer interface {
 	ReviewWord(context.Context, *ReviewWordRequest) (*ReviewWordResponse, error)
 	// 待复习汉字
 	ReviewHanChar(context.Context, *ReviewHanCharRequest) (*ReviewHanCharResponse, error)
+	// 复习任意类型的学习内容
+	Review(context.Context, *ReviewRequest) (*ReviewResponse, error)
+	// 获取记忆单元的复习记录
+	ListMemoryReviews(context.Context, *ListMemoryReviewsRequest) (*ListMemoryReviewsResponse, error)
+	// 开始学习会话，组装待复习项和新学习项的有序队列
+	StartStudySession(context.Context, *StartStudySessionRequest) (*StartStudySessionResponse, error)
+	// 批量提交复习，供离线客户端同步，按复习时间依次应用并在同一事务中保存
+	SubmitReviewBatch(context.Context, *SubmitReviewBatchRequest) (*SubmitReviewBatchResponse, error)
+	// 预测未来每天的复习量，可模拟每天学习新内容的影响
+	ForecastReviews(context.Context, *ForecastReviewsRequest) (*ForecastReviewsResponse, error)
+	// 获取我的顽固项（反复遗忘的记忆单元）
+	ListLeeches(context.Context, *ListLeechesRequest) (*ListLeechesResponse, error)
+	// 恢复已暂停的记忆单元的复习
+	UnsuspendMemoryUnit(context.Context, *UnsuspendMemoryUnitRequest) (*UnsuspendMemoryUnitResponse, error)
+	// 清除记忆单元的顽固项状态，遗忘次数重新累计
+	ResetLeech(context.Context, *ResetLeechRequest) (*ResetLeechResponse, error)
+	// 获取被最多用户标记为顽固项的学习内容，供教师查看
+	ListHardContents(context.Context, *ListHardContentsRequest) (*ListHardContentsResponse, error)
+	// 获取连续学习天数和学习热力图
+	GetStudyActivity(context.Context, *GetStudyActivityRequest) (*GetStudyActivityResponse, error)
+	// 将记忆单元重置为新内容
+	ResetMemoryUnit(context.Context, *ResetMemoryUnitRequest) (*ResetMemoryUnitResponse, error)
+	// 搁置记忆单元到下一个学习日
+	BuryMemoryUnit(context.Context, *BuryMemoryUnitRequest) (*BuryMemoryUnitResponse, error)
+	// 将记忆单元的下次复习调整到指定日期
+	RescheduleMemoryUnit(context.Context, *RescheduleMemoryUnitRequest) (*RescheduleMemoryUnitResponse, error)
+	// 将到期的复习分散到之后的若干个学习日，用于假期后积压的复习
+	RescheduleDueReviews(context.Context, *RescheduleDueReviewsRequest) (*RescheduleDueReviewsResponse, error)
+	// 将课程内容对应的记忆单元全部重置为新内容
+	ForgetCourse(context.Context, *ForgetCourseRequest) (*ForgetCourseResponse, error)
 	// 提交复习结果
 	SubmitHanCharReview(context.Context, *SubmitHanCharReviewRequest) (*SubmitHanCharReviewResponse, error)
 	// 获取汉字测试
 	GetHanCharTest(context.Context, *GetHanCharTestRequest) (*GetHanCharTestResponse, error)
 	// 提交测试结果
 	SubmitHanCharTestResult(context.Context, *SubmitHanCharTestResultRequest) (*SubmitHanCharTestResultResponse, error)
+	// 提交汉字书写练习, 按笔顺逐笔评判
+	SubmitHanCharHandwriting(context.Context, *SubmitHanCharHandwritingRequest) (*SubmitHanCharHandwritingResponse, error)
 	// 获取生字学习内容
 	GetNewHanCharLearning(context.Context, *GetNewHanCharLearningRequest) (*GetNewHanCharLearningResponse, error)
 	// 提交生字学习结果
@@ -290,6 +530,51 @@ func (UnimplementedLearningServiceServer) ReviewWord(context.Context, *ReviewWor
 func (UnimplementedLearningServiceServer) ReviewHanChar(context.Context, *ReviewHanCharRequest) (*ReviewHanCharResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReviewHanChar not implemented")
 }
+func (UnimplementedLearningServiceServer) Review(context.Context, *ReviewRequest) (*ReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Review not implemented")
+}
+func (UnimplementedLearningServiceServer) ListMemoryReviews(context.Context, *ListMemoryReviewsRequest) (*ListMemoryReviewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMemoryReviews not implemented")
+}
+func (UnimplementedLearningServiceServer) StartStudySession(context.Context, *StartStudySessionRequest) (*StartStudySessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartStudySession not implemented")
+}
+func (UnimplementedLearningServiceServer) SubmitReviewBatch(context.Context, *SubmitReviewBatchRequest) (*SubmitReviewBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitReviewBatch not implemented")
+}
+func (UnimplementedLearningServiceServer) ForecastReviews(context.Context, *ForecastReviewsRequest) (*ForecastReviewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForecastReviews not implemented")
+}
+func (UnimplementedLearningServiceServer) ListLeeches(context.Context, *ListLeechesRequest) (*ListLeechesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLeeches not implemented")
+}
+func (UnimplementedLearningServiceServer) UnsuspendMemoryUnit(context.Context, *UnsuspendMemoryUnitRequest) (*UnsuspendMemoryUnitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnsuspendMemoryUnit not implemented")
+}
+func (UnimplementedLearningServiceServer) ResetLeech(context.Context, *ResetLeechRequest) (*ResetLeechResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetLeech not implemented")
+}
+func (UnimplementedLearningServiceServer) ListHardContents(context.Context, *ListHardContentsRequest) (*ListHardContentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListHardContents not implemented")
+}
+func (UnimplementedLearningServiceServer) GetStudyActivity(context.Context, *GetStudyActivityRequest) (*GetStudyActivityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStudyActivity not implemented")
+}
+func (UnimplementedLearningServiceServer) ResetMemoryUnit(context.Context, *ResetMemoryUnitRequest) (*ResetMemoryUnitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetMemoryUnit not implemented")
+}
+func (UnimplementedLearningServiceServer) BuryMemoryUnit(context.Context, *BuryMemoryUnitRequest) (*BuryMemoryUnitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BuryMemoryUnit not implemented")
+}
+func (UnimplementedLearningServiceServer) RescheduleMemoryUnit(context.Context, *RescheduleMemoryUnitRequest) (*RescheduleMemoryUnitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RescheduleMemoryUnit not implemented")
+}
+func (UnimplementedLearningServiceServer) RescheduleDueReviews(context.Context, *RescheduleDueReviewsRequest) (*RescheduleDueReviewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RescheduleDueReviews not implemented")
+}
+func (UnimplementedLearningServiceServer) ForgetCourse(context.Context, *ForgetCourseRequest) (*ForgetCourseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForgetCourse not implemented")
+}
 func (UnimplementedLearningServiceServer) SubmitHanCharReview(context.Context, *SubmitHanCharReviewRequest) (*SubmitHanCharReviewResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubmitHanCharReview not implemented")
 }
@@ -299,6 +584,9 @@ func (UnimplementedLearningServiceServer) GetHanCharTest(context.Context, *GetHa
 func (UnimplementedLearningServiceServer) SubmitHanCharTestResult(context.Context, *SubmitHanCharTestResultRequest) (*SubmitHanCharTestResultResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubmitHanCharTestResult not implemented")
 }
+func (UnimplementedLearningServiceServer) SubmitHanCharHandwriting(context.Context, *SubmitHanCharHandwritingRequest) (*SubmitHanCharHandwritingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitHanCharHandwriting not implemented")
+}
 func (UnimplementedLearningServiceServer) GetNewHanCharLearning(context.Context, *GetNewHanCharLearningRequest) (*GetNewHanCharLearningResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetNewHanCharLearning not implemented")
 }
@@ -488,6 +776,276 @@ func _LearningService_ReviewHanChar_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LearningService_Review_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LearningServiceServer).Review(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LearningService_Review_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LearningServiceServer).Review(ctx, req.(*ReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LearningService_ListMemoryReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMemoryReviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LearningServiceServer).ListMemoryReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LearningService_ListMemoryReviews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LearningServiceServer).ListMemoryReviews(ctx, req.(*ListMemoryReviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LearningService_StartStudySession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartStudySessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LearningServiceServer).StartStudySession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LearningService_StartStudySession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LearningServiceServer).StartStudySession(ctx, req.(*StartStudySessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LearningService_SubmitReviewBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitReviewBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LearningServiceServer).SubmitReviewBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LearningService_SubmitReviewBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LearningServiceServer).SubmitReviewBatch(ctx, req.(*SubmitReviewBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LearningService_ForecastReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForecastReviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LearningServiceServer).ForecastReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LearningService_ForecastReviews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LearningServiceServer).ForecastReviews(ctx, req.(*ForecastReviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LearningService_ListLeeches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLeechesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LearningServiceServer).ListLeeches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LearningService_ListLeeches_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LearningServiceServer).ListLeeches(ctx, req.(*ListLeechesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LearningService_UnsuspendMemoryUnit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsuspendMemoryUnitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LearningServiceServer).UnsuspendMemoryUnit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LearningService_UnsuspendMemoryUnit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LearningServiceServer).UnsuspendMemoryUnit(ctx, req.(*UnsuspendMemoryUnitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LearningService_ResetLeech_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetLeechRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LearningServiceServer).ResetLeech(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LearningService_ResetLeech_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LearningServiceServer).ResetLeech(ctx, req.(*ResetLeechRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LearningService_ListHardContents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListHardContentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LearningServiceServer).ListHardContents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LearningService_ListHardContents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LearningServiceServer).ListHardContents(ctx, req.(*ListHardContentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LearningService_GetStudyActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStudyActivityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LearningServiceServer).GetStudyActivity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LearningService_GetStudyActivity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LearningServiceServer).GetStudyActivity(ctx, req.(*GetStudyActivityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LearningService_ResetMemoryUnit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetMemoryUnitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LearningServiceServer).ResetMemoryUnit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LearningService_ResetMemoryUnit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LearningServiceServer).ResetMemoryUnit(ctx, req.(*ResetMemoryUnitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LearningService_BuryMemoryUnit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BuryMemoryUnitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LearningServiceServer).BuryMemoryUnit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LearningService_BuryMemoryUnit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LearningServiceServer).BuryMemoryUnit(ctx, req.(*BuryMemoryUnitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LearningService_RescheduleMemoryUnit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RescheduleMemoryUnitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LearningServiceServer).RescheduleMemoryUnit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LearningService_RescheduleMemoryUnit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LearningServiceServer).RescheduleMemoryUnit(ctx, req.(*RescheduleMemoryUnitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LearningService_RescheduleDueReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RescheduleDueReviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LearningServiceServer).RescheduleDueReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LearningService_RescheduleDueReviews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LearningServiceServer).RescheduleDueReviews(ctx, req.(*RescheduleDueReviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LearningService_ForgetCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForgetCourseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LearningServiceServer).ForgetCourse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LearningService_ForgetCourse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LearningServiceServer).ForgetCourse(ctx, req.(*ForgetCourseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _LearningService_SubmitHanCharReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SubmitHanCharReviewRequest)
 	if err := dec(in); err != nil {
@@ -542,6 +1100,24 @@ func _LearningService_SubmitHanCharTestResult_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LearningService_SubmitHanCharHandwriting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitHanCharHandwritingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LearningServiceServer).SubmitHanCharHandwriting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LearningService_SubmitHanCharHandwriting_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LearningServiceServer).SubmitHanCharHandwriting(ctx, req.(*SubmitHanCharHandwritingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _LearningService_GetNewHanCharLearning_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetNewHanCharLearningRequest)
 	if err := dec(in); err != nil {
@@ -621,6 +1197,66 @@ var LearningService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ReviewHanChar",
 			Handler:    _LearningService_ReviewHanChar_Handler,
 		},
+		{
+			MethodName: "Review",
+			Handler:    _LearningService_Review_Handler,
+		},
+		{
+			MethodName: "ListMemoryReviews",
+			Handler:    _LearningService_ListMemoryReviews_Handler,
+		},
+		{
+			MethodName: "StartStudySession",
+			Handler:    _LearningService_StartStudySession_Handler,
+		},
+		{
+			MethodName: "SubmitReviewBatch",
+			Handler:    _LearningService_SubmitReviewBatch_Handler,
+		},
+		{
+			MethodName: "ForecastReviews",
+			Handler:    _LearningService_ForecastReviews_Handler,
+		},
+		{
+			MethodName: "ListLeeches",
+			Handler:    _LearningService_ListLeeches_Handler,
+		},
+		{
+			MethodName: "UnsuspendMemoryUnit",
+			Handler:    _LearningService_UnsuspendMemoryUnit_Handler,
+		},
+		{
+			MethodName: "ResetLeech",
+			Handler:    _LearningService_ResetLeech_Handler,
+		},
+		{
+			MethodName: "ListHardContents",
+			Handler:    _LearningService_ListHardContents_Handler,
+		},
+		{
+			MethodName: "GetStudyActivity",
+			Handler:    _LearningService_GetStudyActivity_Handler,
+		},
+		{
+			MethodName: "ResetMemoryUnit",
+			Handler:    _LearningService_ResetMemoryUnit_Handler,
+		},
+		{
+			MethodName: "BuryMemoryUnit",
+			Handler:    _LearningService_BuryMemoryUnit_Handler,
+		},
+		{
+			MethodName: "RescheduleMemoryUnit",
+			Handler:    _LearningService_RescheduleMemoryUnit_Handler,
+		},
+		{
+			MethodName: "RescheduleDueReviews",
+			Handler:    _LearningService_RescheduleDueReviews_Handler,
+		},
+		{
+			MethodName: "ForgetCourse",
+			Handler:    _LearningService_ForgetCourse_Handler,
+		},
 		{
 			MethodName: "SubmitHanCharReview",
 			Handler:    _LearningService_SubmitHanCharReview_Handler,
@@ -633,6 +1269,10 @@ var LearningService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SubmitHanCharTestResult",
 			Handler:    _LearningService_SubmitHanCharTestResult_Handler,
 		},
+		{
+			MethodName: "SubmitHanCharHandwriting",
+			Handler:    _LearningService_SubmitHanCharHandwriting_Handler,
+		},
 		{
 			MethodName: "GetNewHanCharLearning",
 			Handler:    _LearningService_GetNewHanCharLearning_Handler,
@@ -184,6 +184,133 @@ func (WordPartOfSpeech) EnumDescriptor() ([]byte, []int) {
 	return file_proto_v1_vocabulary_proto_rawDescGZIP(), []int{1}
 }
 
+// WordFormKind 词形变化类型
+type WordFormKind int32
+
+const (
+	WordFormKind_WORD_FORM_KIND_UNSPECIFIED           WordFormKind = 0 // 未指定, 如从不区分变化类型的词形表导入
+	WordFormKind_WORD_FORM_KIND_PLURAL                WordFormKind = 1 // 名词复数
+	WordFormKind_WORD_FORM_KIND_PAST                  WordFormKind = 2 // 动词过去式
+	WordFormKind_WORD_FORM_KIND_PAST_PARTICIPLE       WordFormKind = 3 // 动词过去分词
+	WordFormKind_WORD_FORM_KIND_PRESENT_PARTICIPLE    WordFormKind = 4 // 动词现在分词
+	WordFormKind_WORD_FORM_KIND_THIRD_PERSON_SINGULAR WordFormKind = 5 // 动词第三人称单数
+	WordFormKind_WORD_FORM_KIND_COMPARATIVE           WordFormKind = 6 // 形容词、副词比较级
+	WordFormKind_WORD_FORM_KIND_SUPERLATIVE           WordFormKind = 7 // 形容词、副词最高级
+)
+
+// Enum value maps for WordFormKind.
+var (
+	WordFormKind_name = map[int32]string{
+		0: "WORD_FORM_KIND_UNSPECIFIED",
+		1: "WORD_FORM_KIND_PLURAL",
+		2: "WORD_FORM_KIND_PAST",
+		3: "WORD_FORM_KIND_PAST_PARTICIPLE",
+		4: "WORD_FORM_KIND_PRESENT_PARTICIPLE",
+		5: "WORD_FORM_KIND_THIRD_PERSON_SINGULAR",
+		6: "WORD_FORM_KIND_COMPARATIVE",
+		7: "WORD_FORM_KIND_SUPERLATIVE",
+	}
+	WordFormKind_value = map[string]int32{
+		"WORD_FORM_KIND_UNSPECIFIED":           0,
+		"WORD_FORM_KIND_PLURAL":                1,
+		"WORD_FORM_KIND_PAST":                  2,
+		"WORD_FORM_KIND_PAST_PARTICIPLE":       3,
+		"WORD_FORM_KIND_PRESENT_PARTICIPLE":    4,
+		"WORD_FORM_KIND_THIRD_PERSON_SINGULAR": 5,
+		"WORD_FORM_KIND_COMPARATIVE":           6,
+		"WORD_FORM_KIND_SUPERLATIVE":           7,
+	}
+)
+
+func (x WordFormKind) Enum() *WordFormKind {
+	p := new(WordFormKind)
+	*p = x
+	return p
+}
+
+func (x WordFormKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WordFormKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_v1_vocabulary_proto_enumTypes[2].Descriptor()
+}
+
+func (WordFormKind) Type() protoreflect.EnumType {
+	return &file_proto_v1_vocabulary_proto_enumTypes[2]
+}
+
+func (x WordFormKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WordFormKind.Descriptor instead.
+func (WordFormKind) EnumDescriptor() ([]byte, []int) {
+	return file_proto_v1_vocabulary_proto_rawDescGZIP(), []int{2}
+}
+
+// LexicalRelationType 单词之间或汉字之间的关联类型
+type LexicalRelationType int32
+
+const (
+	LexicalRelationType_LEXICAL_RELATION_TYPE_UNSPECIFIED  LexicalRelationType = 0 // 未指定
+	LexicalRelationType_LEXICAL_RELATION_TYPE_SYNONYM      LexicalRelationType = 1 // 同义
+	LexicalRelationType_LEXICAL_RELATION_TYPE_ANTONYM      LexicalRelationType = 2 // 反义
+	LexicalRelationType_LEXICAL_RELATION_TYPE_DERIVED_FROM LexicalRelationType = 3 // 派生, 源派生自目标, 如 happiness 派生自 happy
+	LexicalRelationType_LEXICAL_RELATION_TYPE_WORD_FAMILY  LexicalRelationType = 4 // 同族词
+	LexicalRelationType_LEXICAL_RELATION_TYPE_COLLOCATION  LexicalRelationType = 5 // 搭配
+	LexicalRelationType_LEXICAL_RELATION_TYPE_CONFUSABLE   LexicalRelationType = 6 // 易混淆, 适合作为测试的干扰项
+)
+
+// Enum value maps for LexicalRelationType.
+var (
+	LexicalRelationType_name = map[int32]string{
+		0: "LEXICAL_RELATION_TYPE_UNSPECIFIED",
+		1: "LEXICAL_RELATION_TYPE_SYNONYM",
+		2: "LEXICAL_RELATION_TYPE_ANTONYM",
+		3: "LEXICAL_RELATION_TYPE_DERIVED_FROM",
+		4: "LEXICAL_RELATION_TYPE_WORD_FAMILY",
+		5: "LEXICAL_RELATION_TYPE_COLLOCATION",
+		6: "LEXICAL_RELATION_TYPE_CONFUSABLE",
+	}
+	LexicalRelationType_value = map[string]int32{
+		"LEXICAL_RELATION_TYPE_UNSPECIFIED":  0,
+		"LEXICAL_RELATION_TYPE_SYNONYM":      1,
+		"LEXICAL_RELATION_TYPE_ANTONYM":      2,
+		"LEXICAL_RELATION_TYPE_DERIVED_FROM": 3,
+		"LEXICAL_RELATION_TYPE_WORD_FAMILY":  4,
+		"LEXICAL_RELATION_TYPE_COLLOCATION":  5,
+		"LEXICAL_RELATION_TYPE_CONFUSABLE":   6,
+	}
+)
+
+func (x LexicalRelationType) Enum() *LexicalRelationType {
+	p := new(LexicalRelationType)
+	*p = x
+	return p
+}
+
+func (x LexicalRelationType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LexicalRelationType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_v1_vocabulary_proto_enumTypes[3].Descriptor()
+}
+
+func (LexicalRelationType) Type() protoreflect.EnumType {
+	return &file_proto_v1_vocabulary_proto_enumTypes[3]
+}
+
+func (x LexicalRelationType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LexicalRelationType.Descriptor instead.
+func (LexicalRelationType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_v1_vocabulary_proto_rawDescGZIP(), []int{3}
+}
+
 // WordDefinition 定义单词释义
 type WordDefinition struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -404,16 +531,24 @@ func (x *Word) GetCategories() []string {
 
 // HanChar 汉字信息
 type HanChar struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Character     string                 `protobuf:"bytes,2,opt,name=character,proto3" json:"character,omitempty"`
-	Pinyin        string                 `protobuf:"bytes,3,opt,name=pinyin,proto3" json:"pinyin,omitempty"`
-	Tags          []string               `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`                                      // 标签, 如: 基础1000字, 水果, 厨房 ...
-	Categories    []string               `protobuf:"bytes,5,rep,name=categories,proto3" json:"categories,omitempty"`                          // 释义分类, 如: 食物, 颜色, 动物 ...
-	Examples      []string               `protobuf:"bytes,6,rep,name=examples,proto3" json:"examples,omitempty"`                              // 例句
-	Level         WordDifficultyLevel    `protobuf:"varint,7,opt,name=level,proto3,enum=proto.v1.WordDifficultyLevel" json:"level,omitempty"` // 难度等级
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Character      string                 `protobuf:"bytes,2,opt,name=character,proto3" json:"character,omitempty"`
+	Pinyin         string                 `protobuf:"bytes,3,opt,name=pinyin,proto3" json:"pinyin,omitempty"`
+	Tags           []string               `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`                                           // 标签, 如: 基础1000字, 水果, 厨房 ...
+	Categories     []string               `protobuf:"bytes,5,rep,name=categories,proto3" json:"categories,omitempty"`                               // 释义分类, 如: 食物, 颜色, 动物 ...
+	Examples       []string               `protobuf:"bytes,6,rep,name=examples,proto3" json:"examples,omitempty"`                                   // 例句
+	Level          WordDifficultyLevel    `protobuf:"varint,7,opt,name=level,proto3,enum=proto.v1.WordDifficultyLevel" json:"level,omitempty"`      // 难度等级
+	PinyinNumbered string                 `protobuf:"bytes,8,opt,name=pinyin_numbered,json=pinyinNumbered,proto3" json:"pinyin_numbered,omitempty"` // 数字标调的拼音, 如: shan1
+	Radical        string                 `protobuf:"bytes,9,opt,name=radical,proto3" json:"radical,omitempty"`                                     // 部首
+	StrokeCount    uint32                 `protobuf:"varint,10,opt,name=stroke_count,json=strokeCount,proto3" json:"stroke_count,omitempty"`        // 笔画数, 0 表示未知
+	Components     []string               `protobuf:"bytes,11,rep,name=components,proto3" json:"components,omitempty"`                              // 部件拆分, 如: 明 -> 日, 月
+	Traditional    string                 `protobuf:"bytes,12,opt,name=traditional,proto3" json:"traditional,omitempty"`                            // 繁体写法, 与字符相同或没有繁体时为空
+	Simplified     string                 `protobuf:"bytes,13,opt,name=simplified,proto3" json:"simplified,omitempty"`                              // 简体写法, 字符本身为简体时为空
+	Meanings       []string               `protobuf:"bytes,14,rep,name=meanings,proto3" json:"meanings,omitempty"`                                  // 释义
+	StrokeOrder    *HanCharStrokeOrder    `protobuf:"bytes,15,opt,name=stroke_order,json=strokeOrder,proto3" json:"stroke_order,omitempty"`         // 笔顺数据, 只在查询单个汉字时返回, 没有笔顺数据时为空
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *HanChar) Reset() {
@@ -495,27 +630,85 @@ func (x *HanChar) GetLevel() WordDifficultyLevel {
 	return WordDifficultyLevel_WORD_DIFFICULTY_LEVEL_UNSPECIFIED
 }
 
-type VocabularyServiceGetRequest struct {
+func (x *HanChar) GetPinyinNumbered() string {
+	if x != nil {
+		return x.PinyinNumbered
+	}
+	return ""
+}
+
+func (x *HanChar) GetRadical() string {
+	if x != nil {
+		return x.Radical
+	}
+	return ""
+}
+
+func (x *HanChar) GetStrokeCount() uint32 {
+	if x != nil {
+		return x.StrokeCount
+	}
+	return 0
+}
+
+func (x *HanChar) GetComponents() []string {
+	if x != nil {
+		return x.Components
+	}
+	return nil
+}
+
+func (x *HanChar) GetTraditional() string {
+	if x != nil {
+		return x.Traditional
+	}
+	return ""
+}
+
+func (x *HanChar) GetSimplified() string {
+	if x != nil {
+		return x.Simplified
+	}
+	return ""
+}
+
+func (x *HanChar) GetMeanings() []string {
+	if x != nil {
+		return x.Meanings
+	}
+	return nil
+}
+
+func (x *HanChar) GetStrokeOrder() *HanCharStrokeOrder {
+	if x != nil {
+		return x.StrokeOrder
+	}
+	return nil
+}
+
+// StrokePoint 笔画上的点, 坐标为 Make Me a Hanzi 的 1024×1024 坐标系
+type StrokePoint struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	X             float64                `protobuf:"fixed64,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y             float64                `protobuf:"fixed64,2,opt,name=y,proto3" json:"y,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *VocabularyServiceGetRequest) Reset() {
-	*x = VocabularyServiceGetRequest{}
+func (x *StrokePoint) Reset() {
+	*x = StrokePoint{}
 	mi := &file_proto_v1_vocabulary_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VocabularyServiceGetRequest) String() string {
+func (x *StrokePoint) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VocabularyServiceGetRequest) ProtoMessage() {}
+func (*StrokePoint) ProtoMessage() {}
 
-func (x *VocabularyServiceGetRequest) ProtoReflect() protoreflect.Message {
+func (x *StrokePoint) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_v1_vocabulary_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -527,39 +720,47 @@ func (x *VocabularyServiceGetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VocabularyServiceGetRequest.ProtoReflect.Descriptor instead.
-func (*VocabularyServiceGetRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use StrokePoint.ProtoReflect.Descriptor instead.
+func (*StrokePoint) Descriptor() ([]byte, []int) {
 	return file_proto_v1_vocabulary_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *VocabularyServiceGetRequest) GetId() uint32 {
+func (x *StrokePoint) GetX() float64 {
 	if x != nil {
-		return x.Id
+		return x.X
 	}
 	return 0
 }
 
-type VocabularyServiceGetResponse struct {
+func (x *StrokePoint) GetY() float64 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+// StrokePolyline 一笔的折线, 按书写方向排列
+type StrokePolyline struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Word          *Word                  `protobuf:"bytes,1,opt,name=word,proto3" json:"word,omitempty"`
+	Points        []*StrokePoint         `protobuf:"bytes,1,rep,name=points,proto3" json:"points,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *VocabularyServiceGetResponse) Reset() {
-	*x = VocabularyServiceGetResponse{}
+func (x *StrokePolyline) Reset() {
+	*x = StrokePolyline{}
 	mi := &file_proto_v1_vocabulary_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VocabularyServiceGetResponse) String() string {
+func (x *StrokePolyline) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VocabularyServiceGetResponse) ProtoMessage() {}
+func (*StrokePolyline) ProtoMessage() {}
 
-func (x *VocabularyServiceGetResponse) ProtoReflect() protoreflect.Message {
+func (x *StrokePolyline) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_v1_vocabulary_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -571,43 +772,41 @@ func (x *VocabularyServiceGetResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VocabularyServiceGetResponse.ProtoReflect.Descriptor instead.
-func (*VocabularyServiceGetResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use StrokePolyline.ProtoReflect.Descriptor instead.
+func (*StrokePolyline) Descriptor() ([]byte, []int) {
 	return file_proto_v1_vocabulary_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *VocabularyServiceGetResponse) GetWord() *Word {
+func (x *StrokePolyline) GetPoints() []*StrokePoint {
 	if x != nil {
-		return x.Word
+		return x.Points
 	}
 	return nil
 }
 
-type VocabularyServiceListRequest struct {
+// HanCharStrokeOrder 汉字笔顺数据, 格式与 Make Me a Hanzi 的 graphics.txt 一致
+type HanCharStrokeOrder struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          uint32                 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      uint32                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	Level         WordDifficultyLevel    `protobuf:"varint,3,opt,name=level,proto3,enum=proto.v1.WordDifficultyLevel" json:"level,omitempty"`
-	Tags          []string               `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
-	Categories    []string               `protobuf:"bytes,5,rep,name=categories,proto3" json:"categories,omitempty"`
+	Strokes       []string               `protobuf:"bytes,1,rep,name=strokes,proto3" json:"strokes,omitempty"` // 按笔顺排列的每一笔轮廓的 SVG 路径
+	Medians       []*StrokePolyline      `protobuf:"bytes,2,rep,name=medians,proto3" json:"medians,omitempty"` // 按笔顺排列的每一笔中线
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *VocabularyServiceListRequest) Reset() {
-	*x = VocabularyServiceListRequest{}
+func (x *HanCharStrokeOrder) Reset() {
+	*x = HanCharStrokeOrder{}
 	mi := &file_proto_v1_vocabulary_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VocabularyServiceListRequest) String() string {
+func (x *HanCharStrokeOrder) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VocabularyServiceListRequest) ProtoMessage() {}
+func (*HanCharStrokeOrder) ProtoMessage() {}
 
-func (x *VocabularyServiceListRequest) ProtoReflect() protoreflect.Message {
+func (x *HanCharStrokeOrder) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_v1_vocabulary_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -619,68 +818,46 @@ func (x *VocabularyServiceListRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VocabularyServiceListRequest.ProtoReflect.Descriptor instead.
-func (*VocabularyServiceListRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use HanCharStrokeOrder.ProtoReflect.Descriptor instead.
+func (*HanCharStrokeOrder) Descriptor() ([]byte, []int) {
 	return file_proto_v1_vocabulary_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *VocabularyServiceListRequest) GetPage() uint32 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
-}
-
-func (x *VocabularyServiceListRequest) GetPageSize() uint32 {
-	if x != nil {
-		return x.PageSize
-	}
-	return 0
-}
-
-func (x *VocabularyServiceListRequest) GetLevel() WordDifficultyLevel {
-	if x != nil {
-		return x.Level
-	}
-	return WordDifficultyLevel_WORD_DIFFICULTY_LEVEL_UNSPECIFIED
-}
-
-func (x *VocabularyServiceListRequest) GetTags() []string {
+func (x *HanCharStrokeOrder) GetStrokes() []string {
 	if x != nil {
-		return x.Tags
+		return x.Strokes
 	}
 	return nil
 }
 
-func (x *VocabularyServiceListRequest) GetCategories() []string {
+func (x *HanCharStrokeOrder) GetMedians() []*StrokePolyline {
 	if x != nil {
-		return x.Categories
+		return x.Medians
 	}
 	return nil
 }
 
-type VocabularyServiceListResponse struct {
+type VocabularyServiceGetRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Words         []*Word                `protobuf:"bytes,1,rep,name=words,proto3" json:"words,omitempty"`
-	Total         uint32                 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *VocabularyServiceListResponse) Reset() {
-	*x = VocabularyServiceListResponse{}
+func (x *VocabularyServiceGetRequest) Reset() {
+	*x = VocabularyServiceGetRequest{}
 	mi := &file_proto_v1_vocabulary_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VocabularyServiceListResponse) String() string {
+func (x *VocabularyServiceGetRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VocabularyServiceListResponse) ProtoMessage() {}
+func (*VocabularyServiceGetRequest) ProtoMessage() {}
 
-func (x *VocabularyServiceListResponse) ProtoReflect() protoreflect.Message {
+func (x *VocabularyServiceGetRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_v1_vocabulary_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -692,46 +869,41 @@ func (x *VocabularyServiceListResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VocabularyServiceListResponse.ProtoReflect.Descriptor instead.
-func (*VocabularyServiceListResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use VocabularyServiceGetRequest.ProtoReflect.Descriptor instead.
+func (*VocabularyServiceGetRequest) Descriptor() ([]byte, []int) {
 	return file_proto_v1_vocabulary_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *VocabularyServiceListResponse) GetWords() []*Word {
-	if x != nil {
-		return x.Words
-	}
-	return nil
-}
-
-func (x *VocabularyServiceListResponse) GetTotal() uint32 {
+func (x *VocabularyServiceGetRequest) GetId() uint32 {
 	if x != nil {
-		return x.Total
+		return x.Id
 	}
 	return 0
 }
 
-// 汇总当前库中已有的标签, 方便请求时过滤
-type VocabularyServiceAllTagsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+type VocabularyServiceGetResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Word          *Word                    `protobuf:"bytes,1,opt,name=word,proto3" json:"word,omitempty"`
+	Forms         []*WordForm              `protobuf:"bytes,2,rep,name=forms,proto3" json:"forms,omitempty"`             // 单词的屈折变化形式
+	Inflections   []*WordExampleInflection `protobuf:"bytes,3,rep,name=inflections,proto3" json:"inflections,omitempty"` // 例句中出现的原形和词形
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *VocabularyServiceAllTagsRequest) Reset() {
-	*x = VocabularyServiceAllTagsRequest{}
+func (x *VocabularyServiceGetResponse) Reset() {
+	*x = VocabularyServiceGetResponse{}
 	mi := &file_proto_v1_vocabulary_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VocabularyServiceAllTagsRequest) String() string {
+func (x *VocabularyServiceGetResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VocabularyServiceAllTagsRequest) ProtoMessage() {}
+func (*VocabularyServiceGetResponse) ProtoMessage() {}
 
-func (x *VocabularyServiceAllTagsRequest) ProtoReflect() protoreflect.Message {
+func (x *VocabularyServiceGetResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_v1_vocabulary_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -743,32 +915,55 @@ func (x *VocabularyServiceAllTagsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VocabularyServiceAllTagsRequest.ProtoReflect.Descriptor instead.
-func (*VocabularyServiceAllTagsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use VocabularyServiceGetResponse.ProtoReflect.Descriptor instead.
+func (*VocabularyServiceGetResponse) Descriptor() ([]byte, []int) {
 	return file_proto_v1_vocabulary_proto_rawDescGZIP(), []int{7}
 }
 
-type VocabularyServiceAllTagsResponse struct {
+func (x *VocabularyServiceGetResponse) GetWord() *Word {
+	if x != nil {
+		return x.Word
+	}
+	return nil
+}
+
+func (x *VocabularyServiceGetResponse) GetForms() []*WordForm {
+	if x != nil {
+		return x.Forms
+	}
+	return nil
+}
+
+func (x *VocabularyServiceGetResponse) GetInflections() []*WordExampleInflection {
+	if x != nil {
+		return x.Inflections
+	}
+	return nil
+}
+
+// WordForm 单词的屈折变化形式
+type WordForm struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Tags          []string               `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	Form          string                 `protobuf:"bytes,1,opt,name=form,proto3" json:"form,omitempty"` // 词形, 小写
+	Kind          WordFormKind           `protobuf:"varint,2,opt,name=kind,proto3,enum=proto.v1.WordFormKind" json:"kind,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *VocabularyServiceAllTagsResponse) Reset() {
-	*x = VocabularyServiceAllTagsResponse{}
+func (x *WordForm) Reset() {
+	*x = WordForm{}
 	mi := &file_proto_v1_vocabulary_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VocabularyServiceAllTagsResponse) String() string {
+func (x *WordForm) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VocabularyServiceAllTagsResponse) ProtoMessage() {}
+func (*WordForm) ProtoMessage() {}
 
-func (x *VocabularyServiceAllTagsResponse) ProtoReflect() protoreflect.Message {
+func (x *WordForm) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_v1_vocabulary_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -780,43 +975,52 @@ func (x *VocabularyServiceAllTagsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VocabularyServiceAllTagsResponse.ProtoReflect.Descriptor instead.
-func (*VocabularyServiceAllTagsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use WordForm.ProtoReflect.Descriptor instead.
+func (*WordForm) Descriptor() ([]byte, []int) {
 	return file_proto_v1_vocabulary_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *VocabularyServiceAllTagsResponse) GetTags() []string {
+func (x *WordForm) GetForm() string {
 	if x != nil {
-		return x.Tags
+		return x.Form
 	}
-	return nil
+	return ""
 }
 
-type VocabularyServiceListHanCharRequest struct {
+func (x *WordForm) GetKind() WordFormKind {
+	if x != nil {
+		return x.Kind
+	}
+	return WordFormKind_WORD_FORM_KIND_UNSPECIFIED
+}
+
+// WordExampleInflection 例句中出现的单词原形或词形, 用于在例句中标出单词
+type WordExampleInflection struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          uint32                 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      uint32                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	Level         WordDifficultyLevel    `protobuf:"varint,3,opt,name=level,proto3,enum=proto.v1.WordDifficultyLevel" json:"level,omitempty"`
-	Tags          []string               `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
-	Categories    []string               `protobuf:"bytes,5,rep,name=categories,proto3" json:"categories,omitempty"`
+	Example       string                 `protobuf:"bytes,1,opt,name=example,proto3" json:"example,omitempty"`                       // 例句
+	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`                             // 例句中出现的原文
+	Kind          WordFormKind           `protobuf:"varint,3,opt,name=kind,proto3,enum=proto.v1.WordFormKind" json:"kind,omitempty"` // 变化类型, 原形为未指定
+	Inflected     bool                   `protobuf:"varint,4,opt,name=inflected,proto3" json:"inflected,omitempty"`                  // 是否为屈折变化形式, false 表示原形
+	Start         uint32                 `protobuf:"varint,5,opt,name=start,proto3" json:"start,omitempty"`                          // 在例句中的起始位置, 按 Unicode 字符计
+	End           uint32                 `protobuf:"varint,6,opt,name=end,proto3" json:"end,omitempty"`                              // 在例句中的结束位置(不含), 按 Unicode 字符计
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *VocabularyServiceListHanCharRequest) Reset() {
-	*x = VocabularyServiceListHanCharRequest{}
+func (x *WordExampleInflection) Reset() {
+	*x = WordExampleInflection{}
 	mi := &file_proto_v1_vocabulary_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VocabularyServiceListHanCharRequest) String() string {
+func (x *WordExampleInflection) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VocabularyServiceListHanCharRequest) ProtoMessage() {}
+func (*WordExampleInflection) ProtoMessage() {}
 
-func (x *VocabularyServiceListHanCharRequest) ProtoReflect() protoreflect.Message {
+func (x *WordExampleInflection) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_v1_vocabulary_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -828,11 +1032,421 @@ func (x *VocabularyServiceListHanCharRequest) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VocabularyServiceListHanCharRequest.ProtoReflect.Descriptor instead.
-func (*VocabularyServiceListHanCharRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use WordExampleInflection.ProtoReflect.Descriptor instead.
+func (*WordExampleInflection) Descriptor() ([]byte, []int) {
 	return file_proto_v1_vocabulary_proto_rawDescGZIP(), []int{9}
 }
 
+func (x *WordExampleInflection) GetExample() string {
+	if x != nil {
+		return x.Example
+	}
+	return ""
+}
+
+func (x *WordExampleInflection) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *WordExampleInflection) GetKind() WordFormKind {
+	if x != nil {
+		return x.Kind
+	}
+	return WordFormKind_WORD_FORM_KIND_UNSPECIFIED
+}
+
+func (x *WordExampleInflection) GetInflected() bool {
+	if x != nil {
+		return x.Inflected
+	}
+	return false
+}
+
+func (x *WordExampleInflection) GetStart() uint32 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *WordExampleInflection) GetEnd() uint32 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+type VocabularyServiceLookupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"` // 单词或其屈折变化形式, 如 running、ran、children
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VocabularyServiceLookupRequest) Reset() {
+	*x = VocabularyServiceLookupRequest{}
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VocabularyServiceLookupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VocabularyServiceLookupRequest) ProtoMessage() {}
+
+func (x *VocabularyServiceLookupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VocabularyServiceLookupRequest.ProtoReflect.Descriptor instead.
+func (*VocabularyServiceLookupRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vocabulary_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *VocabularyServiceLookupRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type VocabularyServiceLookupResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Word          *Word                    `protobuf:"bytes,1,opt,name=word,proto3" json:"word,omitempty"`                                  // 原形单词
+	MatchedForm   *WordForm                `protobuf:"bytes,2,opt,name=matched_form,json=matchedForm,proto3" json:"matched_form,omitempty"` // 按词形找到时为该词形, 按原形找到时为空
+	Forms         []*WordForm              `protobuf:"bytes,3,rep,name=forms,proto3" json:"forms,omitempty"`                                // 单词的屈折变化形式
+	Inflections   []*WordExampleInflection `protobuf:"bytes,4,rep,name=inflections,proto3" json:"inflections,omitempty"`                    // 例句中出现的原形和词形
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VocabularyServiceLookupResponse) Reset() {
+	*x = VocabularyServiceLookupResponse{}
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VocabularyServiceLookupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VocabularyServiceLookupResponse) ProtoMessage() {}
+
+func (x *VocabularyServiceLookupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VocabularyServiceLookupResponse.ProtoReflect.Descriptor instead.
+func (*VocabularyServiceLookupResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vocabulary_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *VocabularyServiceLookupResponse) GetWord() *Word {
+	if x != nil {
+		return x.Word
+	}
+	return nil
+}
+
+func (x *VocabularyServiceLookupResponse) GetMatchedForm() *WordForm {
+	if x != nil {
+		return x.MatchedForm
+	}
+	return nil
+}
+
+func (x *VocabularyServiceLookupResponse) GetForms() []*WordForm {
+	if x != nil {
+		return x.Forms
+	}
+	return nil
+}
+
+func (x *VocabularyServiceLookupResponse) GetInflections() []*WordExampleInflection {
+	if x != nil {
+		return x.Inflections
+	}
+	return nil
+}
+
+type VocabularyServiceListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          uint32                 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      uint32                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Level         WordDifficultyLevel    `protobuf:"varint,3,opt,name=level,proto3,enum=proto.v1.WordDifficultyLevel" json:"level,omitempty"`
+	Tags          []string               `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	Categories    []string               `protobuf:"bytes,5,rep,name=categories,proto3" json:"categories,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VocabularyServiceListRequest) Reset() {
+	*x = VocabularyServiceListRequest{}
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VocabularyServiceListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VocabularyServiceListRequest) ProtoMessage() {}
+
+func (x *VocabularyServiceListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VocabularyServiceListRequest.ProtoReflect.Descriptor instead.
+func (*VocabularyServiceListRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vocabulary_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *VocabularyServiceListRequest) GetPage() uint32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *VocabularyServiceListRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *VocabularyServiceListRequest) GetLevel() WordDifficultyLevel {
+	if x != nil {
+		return x.Level
+	}
+	return WordDifficultyLevel_WORD_DIFFICULTY_LEVEL_UNSPECIFIED
+}
+
+func (x *VocabularyServiceListRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *VocabularyServiceListRequest) GetCategories() []string {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+type VocabularyServiceListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Words         []*Word                `protobuf:"bytes,1,rep,name=words,proto3" json:"words,omitempty"`
+	Total         uint32                 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VocabularyServiceListResponse) Reset() {
+	*x = VocabularyServiceListResponse{}
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VocabularyServiceListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VocabularyServiceListResponse) ProtoMessage() {}
+
+func (x *VocabularyServiceListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VocabularyServiceListResponse.ProtoReflect.Descriptor instead.
+func (*VocabularyServiceListResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vocabulary_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *VocabularyServiceListResponse) GetWords() []*Word {
+	if x != nil {
+		return x.Words
+	}
+	return nil
+}
+
+func (x *VocabularyServiceListResponse) GetTotal() uint32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// 汇总当前库中已有的标签, 方便请求时过滤
+type VocabularyServiceAllTagsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VocabularyServiceAllTagsRequest) Reset() {
+	*x = VocabularyServiceAllTagsRequest{}
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VocabularyServiceAllTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VocabularyServiceAllTagsRequest) ProtoMessage() {}
+
+func (x *VocabularyServiceAllTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VocabularyServiceAllTagsRequest.ProtoReflect.Descriptor instead.
+func (*VocabularyServiceAllTagsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vocabulary_proto_rawDescGZIP(), []int{14}
+}
+
+type VocabularyServiceAllTagsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tags          []string               `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VocabularyServiceAllTagsResponse) Reset() {
+	*x = VocabularyServiceAllTagsResponse{}
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VocabularyServiceAllTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VocabularyServiceAllTagsResponse) ProtoMessage() {}
+
+func (x *VocabularyServiceAllTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VocabularyServiceAllTagsResponse.ProtoReflect.Descriptor instead.
+func (*VocabularyServiceAllTagsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vocabulary_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *VocabularyServiceAllTagsResponse) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type VocabularyServiceListHanCharRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          uint32                 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      uint32                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Level         WordDifficultyLevel    `protobuf:"varint,3,opt,name=level,proto3,enum=proto.v1.WordDifficultyLevel" json:"level,omitempty"`
+	Tags          []string               `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	Categories    []string               `protobuf:"bytes,5,rep,name=categories,proto3" json:"categories,omitempty"`
+	Radical       string                 `protobuf:"bytes,6,opt,name=radical,proto3" json:"radical,omitempty"`                           // 部首, 为空时不过滤
+	MinStrokes    uint32                 `protobuf:"varint,7,opt,name=min_strokes,json=minStrokes,proto3" json:"min_strokes,omitempty"`  // 最少笔画数, 0 表示不限
+	MaxStrokes    uint32                 `protobuf:"varint,8,opt,name=max_strokes,json=maxStrokes,proto3" json:"max_strokes,omitempty"`  // 最多笔画数, 0 表示不限
+	Component     string                 `protobuf:"bytes,9,opt,name=component,proto3" json:"component,omitempty"`                       // 包含的部件, 为空时不过滤
+	Pinyin        string                 `protobuf:"bytes,10,opt,name=pinyin,proto3" json:"pinyin,omitempty"`                            // 拼音, 可以是 mǎ、ma3、ma 等任意写法, v 可代替 ü, 为空时不过滤
+	IgnoreTone    bool                   `protobuf:"varint,11,opt,name=ignore_tone,json=ignoreTone,proto3" json:"ignore_tone,omitempty"` // 是否忽略声调匹配拼音, 拼音不标调时总是忽略
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VocabularyServiceListHanCharRequest) Reset() {
+	*x = VocabularyServiceListHanCharRequest{}
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VocabularyServiceListHanCharRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VocabularyServiceListHanCharRequest) ProtoMessage() {}
+
+func (x *VocabularyServiceListHanCharRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VocabularyServiceListHanCharRequest.ProtoReflect.Descriptor instead.
+func (*VocabularyServiceListHanCharRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vocabulary_proto_rawDescGZIP(), []int{16}
+}
+
 func (x *VocabularyServiceListHanCharRequest) GetPage() uint32 {
 	if x != nil {
 		return x.Page
@@ -840,57 +1454,2266 @@ func (x *VocabularyServiceListHanCharRequest) GetPage() uint32 {
 	return 0
 }
 
-func (x *VocabularyServiceListHanCharRequest) GetPageSize() uint32 {
+func (x *VocabularyServiceListHanCharRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *VocabularyServiceListHanCharRequest) GetLevel() WordDifficultyLevel {
+	if x != nil {
+		return x.Level
+	}
+	return WordDifficultyLevel_WORD_DIFFICULTY_LEVEL_UNSPECIFIED
+}
+
+func (x *VocabularyServiceListHanCharRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *VocabularyServiceListHanCharRequest) GetCategories() []string {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *VocabularyServiceListHanCharRequest) GetRadical() string {
+	if x != nil {
+		return x.Radical
+	}
+	return ""
+}
+
+func (x *VocabularyServiceListHanCharRequest) GetMinStrokes() uint32 {
+	if x != nil {
+		return x.MinStrokes
+	}
+	return 0
+}
+
+func (x *VocabularyServiceListHanCharRequest) GetMaxStrokes() uint32 {
+	if x != nil {
+		return x.MaxStrokes
+	}
+	return 0
+}
+
+func (x *VocabularyServiceListHanCharRequest) GetComponent() string {
+	if x != nil {
+		return x.Component
+	}
+	return ""
+}
+
+func (x *VocabularyServiceListHanCharRequest) GetPinyin() string {
+	if x != nil {
+		return x.Pinyin
+	}
+	return ""
+}
+
+func (x *VocabularyServiceListHanCharRequest) GetIgnoreTone() bool {
+	if x != nil {
+		return x.IgnoreTone
+	}
+	return false
+}
+
+type VocabularyServiceListHanCharResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HanChars      []*HanChar             `protobuf:"bytes,1,rep,name=han_chars,json=hanChars,proto3" json:"han_chars,omitempty"`
+	Total         uint32                 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VocabularyServiceListHanCharResponse) Reset() {
+	*x = VocabularyServiceListHanCharResponse{}
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VocabularyServiceListHanCharResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VocabularyServiceListHanCharResponse) ProtoMessage() {}
+
+func (x *VocabularyServiceListHanCharResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VocabularyServiceListHanCharResponse.ProtoReflect.Descriptor instead.
+func (*VocabularyServiceListHanCharResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vocabulary_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *VocabularyServiceListHanCharResponse) GetHanChars() []*HanChar {
+	if x != nil {
+		return x.HanChars
+	}
+	return nil
+}
+
+func (x *VocabularyServiceListHanCharResponse) GetTotal() uint32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type VocabularyServiceAllCategoriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VocabularyServiceAllCategoriesRequest) Reset() {
+	*x = VocabularyServiceAllCategoriesRequest{}
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VocabularyServiceAllCategoriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VocabularyServiceAllCategoriesRequest) ProtoMessage() {}
+
+func (x *VocabularyServiceAllCategoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VocabularyServiceAllCategoriesRequest.ProtoReflect.Descriptor instead.
+func (*VocabularyServiceAllCategoriesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vocabulary_proto_rawDescGZIP(), []int{18}
+}
+
+type VocabularyServiceAllCategoriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Categories    []string               `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VocabularyServiceAllCategoriesResponse) Reset() {
+	*x = VocabularyServiceAllCategoriesResponse{}
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VocabularyServiceAllCategoriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VocabularyServiceAllCategoriesResponse) ProtoMessage() {}
+
+func (x *VocabularyServiceAllCategoriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VocabularyServiceAllCategoriesResponse.ProtoReflect.Descriptor instead.
+func (*VocabularyServiceAllCategoriesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vocabulary_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *VocabularyServiceAllCategoriesResponse) GetCategories() []string {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+type VocabularyServiceGetAllMetadataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VocabularyServiceGetAllMetadataRequest) Reset() {
+	*x = VocabularyServiceGetAllMetadataRequest{}
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VocabularyServiceGetAllMetadataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VocabularyServiceGetAllMetadataRequest) ProtoMessage() {}
+
+func (x *VocabularyServiceGetAllMetadataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VocabularyServiceGetAllMetadataRequest.ProtoReflect.Descriptor instead.
+func (*VocabularyServiceGetAllMetadataRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vocabulary_proto_rawDescGZIP(), []int{20}
+}
+
+type VocabularyServiceGetAllMetadataResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tags          []string               `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	Categories    []string               `protobuf:"bytes,2,rep,name=categories,proto3" json:"categories,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VocabularyServiceGetAllMetadataResponse) Reset() {
+	*x = VocabularyServiceGetAllMetadataResponse{}
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VocabularyServiceGetAllMetadataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VocabularyServiceGetAllMetadataResponse) ProtoMessage() {}
+
+func (x *VocabularyServiceGetAllMetadataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VocabularyServiceGetAllMetadataResponse.ProtoReflect.Descriptor instead.
+func (*VocabularyServiceGetAllMetadataResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vocabulary_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *VocabularyServiceGetAllMetadataResponse) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *VocabularyServiceGetAllMetadataResponse) GetCategories() []string {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+type VocabularyServiceBatchCreateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Words         []*Word                `protobuf:"bytes,1,rep,name=words,proto3" json:"words,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VocabularyServiceBatchCreateRequest) Reset() {
+	*x = VocabularyServiceBatchCreateRequest{}
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VocabularyServiceBatchCreateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VocabularyServiceBatchCreateRequest) ProtoMessage() {}
+
+func (x *VocabularyServiceBatchCreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VocabularyServiceBatchCreateRequest.ProtoReflect.Descriptor instead.
+func (*VocabularyServiceBatchCreateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vocabulary_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *VocabularyServiceBatchCreateRequest) GetWords() []*Word {
+	if x != nil {
+		return x.Words
+	}
+	return nil
+}
+
+type VocabularyServiceBatchCreateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []uint32               `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"` // 创建成功的单词ID列表
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VocabularyServiceBatchCreateResponse) Reset() {
+	*x = VocabularyServiceBatchCreateResponse{}
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VocabularyServiceBatchCreateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VocabularyServiceBatchCreateResponse) ProtoMessage() {}
+
+func (x *VocabularyServiceBatchCreateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VocabularyServiceBatchCreateResponse.ProtoReflect.Descriptor instead.
+func (*VocabularyServiceBatchCreateResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vocabulary_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *VocabularyServiceBatchCreateResponse) GetIds() []uint32 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type VocabularyServiceBatchCreateHanCharRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HanChars      []*HanChar             `protobuf:"bytes,1,rep,name=han_chars,json=hanChars,proto3" json:"han_chars,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VocabularyServiceBatchCreateHanCharRequest) Reset() {
+	*x = VocabularyServiceBatchCreateHanCharRequest{}
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VocabularyServiceBatchCreateHanCharRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VocabularyServiceBatchCreateHanCharRequest) ProtoMessage() {}
+
+func (x *VocabularyServiceBatchCreateHanCharRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VocabularyServiceBatchCreateHanCharRequest.ProtoReflect.Descriptor instead.
+func (*VocabularyServiceBatchCreateHanCharRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vocabulary_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *VocabularyServiceBatchCreateHanCharRequest) GetHanChars() []*HanChar {
+	if x != nil {
+		return x.HanChars
+	}
+	return nil
+}
+
+type VocabularyServiceBatchCreateHanCharResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []uint32               `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"` // 创建成功的汉字ID列表
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VocabularyServiceBatchCreateHanCharResponse) Reset() {
+	*x = VocabularyServiceBatchCreateHanCharResponse{}
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VocabularyServiceBatchCreateHanCharResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VocabularyServiceBatchCreateHanCharResponse) ProtoMessage() {}
+
+func (x *VocabularyServiceBatchCreateHanCharResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VocabularyServiceBatchCreateHanCharResponse.ProtoReflect.Descriptor instead.
+func (*VocabularyServiceBatchCreateHanCharResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vocabulary_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *VocabularyServiceBatchCreateHanCharResponse) GetIds() []uint32 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type VocabularyServiceGetHanCharRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`              // 汉字ID, 不为 0 时按ID查找
+	Character     string                 `protobuf:"bytes,2,opt,name=character,proto3" json:"character,omitempty"` // 汉字字符, ID 为 0 时按字符查找
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VocabularyServiceGetHanCharRequest) Reset() {
+	*x = VocabularyServiceGetHanCharRequest{}
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VocabularyServiceGetHanCharRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VocabularyServiceGetHanCharRequest) ProtoMessage() {}
+
+func (x *VocabularyServiceGetHanCharRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VocabularyServiceGetHanCharRequest.ProtoReflect.Descriptor instead.
+func (*VocabularyServiceGetHanCharRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vocabulary_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *VocabularyServiceGetHanCharRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *VocabularyServiceGetHanCharRequest) GetCharacter() string {
+	if x != nil {
+		return x.Character
+	}
+	return ""
+}
+
+type VocabularyServiceGetHanCharResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HanChar       *HanChar               `protobuf:"bytes,1,opt,name=han_char,json=hanChar,proto3" json:"han_char,omitempty"` // 包含笔顺数据
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VocabularyServiceGetHanCharResponse) Reset() {
+	*x = VocabularyServiceGetHanCharResponse{}
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VocabularyServiceGetHanCharResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VocabularyServiceGetHanCharResponse) ProtoMessage() {}
+
+func (x *VocabularyServiceGetHanCharResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VocabularyServiceGetHanCharResponse.ProtoReflect.Descriptor instead.
+func (*VocabularyServiceGetHanCharResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vocabulary_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *VocabularyServiceGetHanCharResponse) GetHanChar() *HanChar {
+	if x != nil {
+		return x.HanChar
+	}
+	return nil
+}
+
+// HanCharStrokeData 一个汉字的笔顺数据
+type HanCharStrokeData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Character     string                 `protobuf:"bytes,1,opt,name=character,proto3" json:"character,omitempty"`
+	StrokeOrder   *HanCharStrokeOrder    `protobuf:"bytes,2,opt,name=stroke_order,json=strokeOrder,proto3" json:"stroke_order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HanCharStrokeData) Reset() {
+	*x = HanCharStrokeData{}
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HanCharStrokeData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HanCharStrokeData) ProtoMessage() {}
+
+func (x *HanCharStrokeData) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HanCharStrokeData.ProtoReflect.Descriptor instead.
+func (*HanCharStrokeData) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vocabulary_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *HanCharStrokeData) GetCharacter() string {
+	if x != nil {
+		return x.Character
+	}
+	return ""
+}
+
+func (x *HanCharStrokeData) GetStrokeOrder() *HanCharStrokeOrder {
+	if x != nil {
+		return x.StrokeOrder
+	}
+	return nil
+}
+
+type VocabularyServiceBatchImportHanCharStrokesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HanChars      []*HanCharStrokeData   `protobuf:"bytes,1,rep,name=han_chars,json=hanChars,proto3" json:"han_chars,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VocabularyServiceBatchImportHanCharStrokesRequest) Reset() {
+	*x = VocabularyServiceBatchImportHanCharStrokesRequest{}
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VocabularyServiceBatchImportHanCharStrokesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VocabularyServiceBatchImportHanCharStrokesRequest) ProtoMessage() {}
+
+func (x *VocabularyServiceBatchImportHanCharStrokesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VocabularyServiceBatchImportHanCharStrokesRequest.ProtoReflect.Descriptor instead.
+func (*VocabularyServiceBatchImportHanCharStrokesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vocabulary_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *VocabularyServiceBatchImportHanCharStrokesRequest) GetHanChars() []*HanCharStrokeData {
+	if x != nil {
+		return x.HanChars
+	}
+	return nil
+}
+
+type VocabularyServiceBatchImportHanCharStrokesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Imported      uint32                 `protobuf:"varint,1,opt,name=imported,proto3" json:"imported,omitempty"` // 导入的汉字数量
+	Missing       []string               `protobuf:"bytes,2,rep,name=missing,proto3" json:"missing,omitempty"`    // 汉字库中不存在而跳过的汉字
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VocabularyServiceBatchImportHanCharStrokesResponse) Reset() {
+	*x = VocabularyServiceBatchImportHanCharStrokesResponse{}
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VocabularyServiceBatchImportHanCharStrokesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VocabularyServiceBatchImportHanCharStrokesResponse) ProtoMessage() {}
+
+func (x *VocabularyServiceBatchImportHanCharStrokesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VocabularyServiceBatchImportHanCharStrokesResponse.ProtoReflect.Descriptor instead.
+func (*VocabularyServiceBatchImportHanCharStrokesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vocabulary_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *VocabularyServiceBatchImportHanCharStrokesResponse) GetImported() uint32 {
+	if x != nil {
+		return x.Imported
+	}
+	return 0
+}
+
+func (x *VocabularyServiceBatchImportHanCharStrokesResponse) GetMissing() []string {
+	if x != nil {
+		return x.Missing
+	}
+	return nil
+}
+
+// WordFormsData 一个原形单词的屈折变化形式
+type WordFormsData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lemma         string                 `protobuf:"bytes,1,opt,name=lemma,proto3" json:"lemma,omitempty"` // 原形, 需与单词库中的单词完全相同
+	Forms         []*WordForm            `protobuf:"bytes,2,rep,name=forms,proto3" json:"forms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WordFormsData) Reset() {
+	*x = WordFormsData{}
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WordFormsData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WordFormsData) ProtoMessage() {}
+
+func (x *WordFormsData) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WordFormsData.ProtoReflect.Descriptor instead.
+func (*WordFormsData) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vocabulary_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *WordFormsData) GetLemma() string {
+	if x != nil {
+		return x.Lemma
+	}
+	return ""
+}
+
+func (x *WordFormsData) GetForms() []*WordForm {
+	if x != nil {
+		return x.Forms
+	}
+	return nil
+}
+
+type VocabularyServiceBatchImportWordFormsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Words []*WordFormsData       `protobuf:"bytes,1,rep,name=words,proto3" json:"words,omitempty"`
+	// 开放词形表文本, 每行 "原形<TAB>词形", 如 lemmatization-lists 的 lemmatization-en.txt, 与 words 按原形合并
+	FormList      string `protobuf:"bytes,2,opt,name=form_list,json=formList,proto3" json:"form_list,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VocabularyServiceBatchImportWordFormsRequest) Reset() {
+	*x = VocabularyServiceBatchImportWordFormsRequest{}
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VocabularyServiceBatchImportWordFormsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VocabularyServiceBatchImportWordFormsRequest) ProtoMessage() {}
+
+func (x *VocabularyServiceBatchImportWordFormsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VocabularyServiceBatchImportWordFormsRequest.ProtoReflect.Descriptor instead.
+func (*VocabularyServiceBatchImportWordFormsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vocabulary_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *VocabularyServiceBatchImportWordFormsRequest) GetWords() []*WordFormsData {
+	if x != nil {
+		return x.Words
+	}
+	return nil
+}
+
+func (x *VocabularyServiceBatchImportWordFormsRequest) GetFormList() string {
+	if x != nil {
+		return x.FormList
+	}
+	return ""
+}
+
+type VocabularyServiceBatchImportWordFormsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Imported      uint32                 `protobuf:"varint,1,opt,name=imported,proto3" json:"imported,omitempty"` // 导入词形的单词数量
+	Missing       []string               `protobuf:"bytes,2,rep,name=missing,proto3" json:"missing,omitempty"`    // 单词库中不存在而跳过的原形
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VocabularyServiceBatchImportWordFormsResponse) Reset() {
+	*x = VocabularyServiceBatchImportWordFormsResponse{}
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VocabularyServiceBatchImportWordFormsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VocabularyServiceBatchImportWordFormsResponse) ProtoMessage() {}
+
+func (x *VocabularyServiceBatchImportWordFormsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VocabularyServiceBatchImportWordFormsResponse.ProtoReflect.Descriptor instead.
+func (*VocabularyServiceBatchImportWordFormsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vocabulary_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *VocabularyServiceBatchImportWordFormsResponse) GetImported() uint32 {
+	if x != nil {
+		return x.Imported
+	}
+	return 0
+}
+
+func (x *VocabularyServiceBatchImportWordFormsResponse) GetMissing() []string {
+	if x != nil {
+		return x.Missing
+	}
+	return nil
+}
+
+// WordRelation 与单词相关联的单词
+type WordRelation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // 关联ID, 用于删除关联
+	Type          LexicalRelationType    `protobuf:"varint,2,opt,name=type,proto3,enum=proto.v1.LexicalRelationType" json:"type,omitempty"`
+	Inverse       bool                   `protobuf:"varint,3,opt,name=inverse,proto3" json:"inverse,omitempty"` // 是否为反向的派生关联, 即 word 派生自查询的单词
+	Note          string                 `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`        // 说明, 如搭配的短语
+	Word          *Word                  `protobuf:"bytes,5,opt,name=word,proto3" json:"word,omitempty"`        // 关联的单词
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WordRelation) Reset() {
+	*x = WordRelation{}
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WordRelation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WordRelation) ProtoMessage() {}
+
+func (x *WordRelation) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WordRelation.ProtoReflect.Descriptor instead.
+func (*WordRelation) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vocabulary_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *WordRelation) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *WordRelation) GetType() LexicalRelationType {
+	if x != nil {
+		return x.Type
+	}
+	return LexicalRelationType_LEXICAL_RELATION_TYPE_UNSPECIFIED
+}
+
+func (x *WordRelation) GetInverse() bool {
+	if x != nil {
+		return x.Inverse
+	}
+	return false
+}
+
+func (x *WordRelation) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *WordRelation) GetWord() *Word {
+	if x != nil {
+		return x.Word
+	}
+	return nil
+}
+
+// HanCharRelation 与汉字相关联的汉字
+type HanCharRelation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // 关联ID, 用于删除关联
+	Type          LexicalRelationType    `protobuf:"varint,2,opt,name=type,proto3,enum=proto.v1.LexicalRelationType" json:"type,omitempty"`
+	Inverse       bool                   `protobuf:"varint,3,opt,name=inverse,proto3" json:"inverse,omitempty"`               // 是否为反向的派生关联, 即 han_char 派生自查询的汉字
+	Note          string                 `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`                      // 说明
+	HanChar       *HanChar               `protobuf:"bytes,5,opt,name=han_char,json=hanChar,proto3" json:"han_char,omitempty"` // 关联的汉字
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HanCharRelation) Reset() {
+	*x = HanCharRelation{}
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HanCharRelation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HanCharRelation) ProtoMessage() {}
+
+func (x *HanCharRelation) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HanCharRelation.ProtoReflect.Descriptor instead.
+func (*HanCharRelation) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vocabulary_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *HanCharRelation) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *HanCharRelation) GetType() LexicalRelationType {
+	if x != nil {
+		return x.Type
+	}
+	return LexicalRelationType_LEXICAL_RELATION_TYPE_UNSPECIFIED
+}
+
+func (x *HanCharRelation) GetInverse() bool {
+	if x != nil {
+		return x.Inverse
+	}
+	return false
+}
+
+func (x *HanCharRelation) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *HanCharRelation) GetHanChar() *HanChar {
+	if x != nil {
+		return x.HanChar
+	}
+	return nil
+}
+
+type VocabularyServiceGetRelationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                                // 单词ID
+	Types         []LexicalRelationType  `protobuf:"varint,2,rep,packed,name=types,proto3,enum=proto.v1.LexicalRelationType" json:"types,omitempty"` // 只返回这些类型的关联, 为空时返回全部
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VocabularyServiceGetRelationsRequest) Reset() {
+	*x = VocabularyServiceGetRelationsRequest{}
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VocabularyServiceGetRelationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VocabularyServiceGetRelationsRequest) ProtoMessage() {}
+
+func (x *VocabularyServiceGetRelationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VocabularyServiceGetRelationsRequest.ProtoReflect.Descriptor instead.
+func (*VocabularyServiceGetRelationsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vocabulary_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *VocabularyServiceGetRelationsRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *VocabularyServiceGetRelationsRequest) GetTypes() []LexicalRelationType {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+type VocabularyServiceGetRelationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Word          *Word                  `protobuf:"bytes,1,opt,name=word,proto3" json:"word,omitempty"`
+	Relations     []*WordRelation        `protobuf:"bytes,2,rep,name=relations,proto3" json:"relations,omitempty"` // 按关联类型排序
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VocabularyServiceGetRelationsResponse) Reset() {
+	*x = VocabularyServiceGetRelationsResponse{}
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VocabularyServiceGetRelationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VocabularyServiceGetRelationsResponse) ProtoMessage() {}
+
+func (x *VocabularyServiceGetRelationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VocabularyServiceGetRelationsResponse.ProtoReflect.Descriptor instead.
+func (*VocabularyServiceGetRelationsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vocabulary_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *VocabularyServiceGetRelationsResponse) GetWord() *Word {
+	if x != nil {
+		return x.Word
+	}
+	return nil
+}
+
+func (x *VocabularyServiceGetRelationsResponse) GetRelations() []*WordRelation {
+	if x != nil {
+		return x.Relations
+	}
+	return nil
+}
+
+type VocabularyServiceCreateRelationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SourceId      uint32                 `protobuf:"varint,1,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"` // 源单词ID
+	TargetId      uint32                 `protobuf:"varint,2,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"` // 目标单词ID
+	Type          LexicalRelationType    `protobuf:"varint,3,opt,name=type,proto3,enum=proto.v1.LexicalRelationType" json:"type,omitempty"`
+	Note          string                 `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"` // 说明, 不超过200个字符
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VocabularyServiceCreateRelationRequest) Reset() {
+	*x = VocabularyServiceCreateRelationRequest{}
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VocabularyServiceCreateRelationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VocabularyServiceCreateRelationRequest) ProtoMessage() {}
+
+func (x *VocabularyServiceCreateRelationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VocabularyServiceCreateRelationRequest.ProtoReflect.Descriptor instead.
+func (*VocabularyServiceCreateRelationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vocabulary_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *VocabularyServiceCreateRelationRequest) GetSourceId() uint32 {
+	if x != nil {
+		return x.SourceId
+	}
+	return 0
+}
+
+func (x *VocabularyServiceCreateRelationRequest) GetTargetId() uint32 {
+	if x != nil {
+		return x.TargetId
+	}
+	return 0
+}
+
+func (x *VocabularyServiceCreateRelationRequest) GetType() LexicalRelationType {
+	if x != nil {
+		return x.Type
+	}
+	return LexicalRelationType_LEXICAL_RELATION_TYPE_UNSPECIFIED
+}
+
+func (x *VocabularyServiceCreateRelationRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type VocabularyServiceCreateRelationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Relation      *WordRelation          `protobuf:"bytes,1,opt,name=relation,proto3" json:"relation,omitempty"` // 从源单词看到的关联
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VocabularyServiceCreateRelationResponse) Reset() {
+	*x = VocabularyServiceCreateRelationResponse{}
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VocabularyServiceCreateRelationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VocabularyServiceCreateRelationResponse) ProtoMessage() {}
+
+func (x *VocabularyServiceCreateRelationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VocabularyServiceCreateRelationResponse.ProtoReflect.Descriptor instead.
+func (*VocabularyServiceCreateRelationResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vocabulary_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *VocabularyServiceCreateRelationResponse) GetRelation() *WordRelation {
+	if x != nil {
+		return x.Relation
+	}
+	return nil
+}
+
+type VocabularyServiceDeleteRelationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VocabularyServiceDeleteRelationRequest) Reset() {
+	*x = VocabularyServiceDeleteRelationRequest{}
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VocabularyServiceDeleteRelationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VocabularyServiceDeleteRelationRequest) ProtoMessage() {}
+
+func (x *VocabularyServiceDeleteRelationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VocabularyServiceDeleteRelationRequest.ProtoReflect.Descriptor instead.
+func (*VocabularyServiceDeleteRelationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vocabulary_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *VocabularyServiceDeleteRelationRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type VocabularyServiceDeleteRelationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VocabularyServiceDeleteRelationResponse) Reset() {
+	*x = VocabularyServiceDeleteRelationResponse{}
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VocabularyServiceDeleteRelationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VocabularyServiceDeleteRelationResponse) ProtoMessage() {}
+
+func (x *VocabularyServiceDeleteRelationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VocabularyServiceDeleteRelationResponse.ProtoReflect.Descriptor instead.
+func (*VocabularyServiceDeleteRelationResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vocabulary_proto_rawDescGZIP(), []int{41}
+}
+
+type VocabularyServiceGetHanCharRelationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                                // 汉字ID
+	Types         []LexicalRelationType  `protobuf:"varint,2,rep,packed,name=types,proto3,enum=proto.v1.LexicalRelationType" json:"types,omitempty"` // 只返回这些类型的关联, 为空时返回全部
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VocabularyServiceGetHanCharRelationsRequest) Reset() {
+	*x = VocabularyServiceGetHanCharRelationsRequest{}
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VocabularyServiceGetHanCharRelationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VocabularyServiceGetHanCharRelationsRequest) ProtoMessage() {}
+
+func (x *VocabularyServiceGetHanCharRelationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VocabularyServiceGetHanCharRelationsRequest.ProtoReflect.Descriptor instead.
+func (*VocabularyServiceGetHanCharRelationsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vocabulary_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *VocabularyServiceGetHanCharRelationsRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *VocabularyServiceGetHanCharRelationsRequest) GetTypes() []LexicalRelationType {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+type VocabularyServiceGetHanCharRelationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HanChar       *HanChar               `protobuf:"bytes,1,opt,name=han_char,json=hanChar,proto3" json:"han_char,omitempty"`
+	Relations     []*HanCharRelation     `protobuf:"bytes,2,rep,name=relations,proto3" json:"relations,omitempty"` // 按关联类型排序
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VocabularyServiceGetHanCharRelationsResponse) Reset() {
+	*x = VocabularyServiceGetHanCharRelationsResponse{}
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VocabularyServiceGetHanCharRelationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VocabularyServiceGetHanCharRelationsResponse) ProtoMessage() {}
+
+func (x *VocabularyServiceGetHanCharRelationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VocabularyServiceGetHanCharRelationsResponse.ProtoReflect.Descriptor instead.
+func (*VocabularyServiceGetHanCharRelationsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vocabulary_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *VocabularyServiceGetHanCharRelationsResponse) GetHanChar() *HanChar {
+	if x != nil {
+		return x.HanChar
+	}
+	return nil
+}
+
+func (x *VocabularyServiceGetHanCharRelationsResponse) GetRelations() []*HanCharRelation {
+	if x != nil {
+		return x.Relations
+	}
+	return nil
+}
+
+type VocabularyServiceCreateHanCharRelationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SourceId      uint32                 `protobuf:"varint,1,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"` // 源汉字ID
+	TargetId      uint32                 `protobuf:"varint,2,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"` // 目标汉字ID
+	Type          LexicalRelationType    `protobuf:"varint,3,opt,name=type,proto3,enum=proto.v1.LexicalRelationType" json:"type,omitempty"`
+	Note          string                 `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"` // 说明, 不超过200个字符
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VocabularyServiceCreateHanCharRelationRequest) Reset() {
+	*x = VocabularyServiceCreateHanCharRelationRequest{}
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VocabularyServiceCreateHanCharRelationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VocabularyServiceCreateHanCharRelationRequest) ProtoMessage() {}
+
+func (x *VocabularyServiceCreateHanCharRelationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VocabularyServiceCreateHanCharRelationRequest.ProtoReflect.Descriptor instead.
+func (*VocabularyServiceCreateHanCharRelationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vocabulary_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *VocabularyServiceCreateHanCharRelationRequest) GetSourceId() uint32 {
+	if x != nil {
+		return x.SourceId
+	}
+	return 0
+}
+
+func (x *VocabularyServiceCreateHanCharRelationRequest) GetTargetId() uint32 {
+	if x != nil {
+		return x.TargetId
+	}
+	return 0
+}
+
+func (x *VocabularyServiceCreateHanCharRelationRequest) GetType() LexicalRelationType {
+	if x != nil {
+		return x.Type
+	}
+	return LexicalRelationType_LEXICAL_RELATION_TYPE_UNSPECIFIED
+}
+
+func (x *VocabularyServiceCreateHanCharRelationRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type VocabularyServiceCreateHanCharRelationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Relation      *HanCharRelation       `protobuf:"bytes,1,opt,name=relation,proto3" json:"relation,omitempty"` // 从源汉字看到的关联
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VocabularyServiceCreateHanCharRelationResponse) Reset() {
+	*x = VocabularyServiceCreateHanCharRelationResponse{}
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VocabularyServiceCreateHanCharRelationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VocabularyServiceCreateHanCharRelationResponse) ProtoMessage() {}
+
+func (x *VocabularyServiceCreateHanCharRelationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VocabularyServiceCreateHanCharRelationResponse.ProtoReflect.Descriptor instead.
+func (*VocabularyServiceCreateHanCharRelationResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vocabulary_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *VocabularyServiceCreateHanCharRelationResponse) GetRelation() *HanCharRelation {
+	if x != nil {
+		return x.Relation
+	}
+	return nil
+}
+
+type VocabularyServiceDeleteHanCharRelationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VocabularyServiceDeleteHanCharRelationRequest) Reset() {
+	*x = VocabularyServiceDeleteHanCharRelationRequest{}
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VocabularyServiceDeleteHanCharRelationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VocabularyServiceDeleteHanCharRelationRequest) ProtoMessage() {}
+
+func (x *VocabularyServiceDeleteHanCharRelationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VocabularyServiceDeleteHanCharRelationRequest.ProtoReflect.Descriptor instead.
+func (*VocabularyServiceDeleteHanCharRelationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vocabulary_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *VocabularyServiceDeleteHanCharRelationRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type VocabularyServiceDeleteHanCharRelationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VocabularyServiceDeleteHanCharRelationResponse) Reset() {
+	*x = VocabularyServiceDeleteHanCharRelationResponse{}
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VocabularyServiceDeleteHanCharRelationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VocabularyServiceDeleteHanCharRelationResponse) ProtoMessage() {}
+
+func (x *VocabularyServiceDeleteHanCharRelationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VocabularyServiceDeleteHanCharRelationResponse.ProtoReflect.Descriptor instead.
+func (*VocabularyServiceDeleteHanCharRelationResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vocabulary_proto_rawDescGZIP(), []int{47}
+}
+
+// RelationData 按文本导入的一条关联
+type RelationData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Source        string                 `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"` // 源单词或汉字, 需与单词库或汉字库中的完全相同
+	Target        string                 `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"` // 目标单词或汉字
+	Type          LexicalRelationType    `protobuf:"varint,3,opt,name=type,proto3,enum=proto.v1.LexicalRelationType" json:"type,omitempty"`
+	Note          string                 `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RelationData) Reset() {
+	*x = RelationData{}
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RelationData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RelationData) ProtoMessage() {}
+
+func (x *RelationData) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RelationData.ProtoReflect.Descriptor instead.
+func (*RelationData) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vocabulary_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *RelationData) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *RelationData) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *RelationData) GetType() LexicalRelationType {
+	if x != nil {
+		return x.Type
+	}
+	return LexicalRelationType_LEXICAL_RELATION_TYPE_UNSPECIFIED
+}
+
+func (x *RelationData) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type VocabularyServiceBatchImportRelationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Words         []*RelationData        `protobuf:"bytes,1,rep,name=words,proto3" json:"words,omitempty"`                       // 单词之间的关联
+	HanChars      []*RelationData        `protobuf:"bytes,2,rep,name=han_chars,json=hanChars,proto3" json:"han_chars,omitempty"` // 汉字之间的关联
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VocabularyServiceBatchImportRelationsRequest) Reset() {
+	*x = VocabularyServiceBatchImportRelationsRequest{}
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VocabularyServiceBatchImportRelationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VocabularyServiceBatchImportRelationsRequest) ProtoMessage() {}
+
+func (x *VocabularyServiceBatchImportRelationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VocabularyServiceBatchImportRelationsRequest.ProtoReflect.Descriptor instead.
+func (*VocabularyServiceBatchImportRelationsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vocabulary_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *VocabularyServiceBatchImportRelationsRequest) GetWords() []*RelationData {
+	if x != nil {
+		return x.Words
+	}
+	return nil
+}
+
+func (x *VocabularyServiceBatchImportRelationsRequest) GetHanChars() []*RelationData {
+	if x != nil {
+		return x.HanChars
+	}
+	return nil
+}
+
+type VocabularyServiceBatchImportRelationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Created       uint32                 `protobuf:"varint,1,opt,name=created,proto3" json:"created,omitempty"` // 新建的关联数量
+	Skipped       uint32                 `protobuf:"varint,2,opt,name=skipped,proto3" json:"skipped,omitempty"` // 已存在而跳过的关联数量
+	Missing       []string               `protobuf:"bytes,3,rep,name=missing,proto3" json:"missing,omitempty"`  // 单词库或汉字库中不存在而跳过的单词和汉字
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VocabularyServiceBatchImportRelationsResponse) Reset() {
+	*x = VocabularyServiceBatchImportRelationsResponse{}
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VocabularyServiceBatchImportRelationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VocabularyServiceBatchImportRelationsResponse) ProtoMessage() {}
+
+func (x *VocabularyServiceBatchImportRelationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VocabularyServiceBatchImportRelationsResponse.ProtoReflect.Descriptor instead.
+func (*VocabularyServiceBatchImportRelationsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vocabulary_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *VocabularyServiceBatchImportRelationsResponse) GetCreated() uint32 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *VocabularyServiceBatchImportRelationsResponse) GetSkipped() uint32 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+func (x *VocabularyServiceBatchImportRelationsResponse) GetMissing() []string {
+	if x != nil {
+		return x.Missing
+	}
+	return nil
+}
+
+type VocabularyServiceSearchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keyword       string                 `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword,omitempty"` // 搜索关键词, 在单词、释义、例句和同义词中检索, 每个词按前缀匹配
+	Page          uint32                 `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      uint32                 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`             // 默认 20, 最多 100
+	Level         WordDifficultyLevel    `protobuf:"varint,4,opt,name=level,proto3,enum=proto.v1.WordDifficultyLevel" json:"level,omitempty"` // 难度等级, 未指定时不过滤
+	Tags          []string               `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`                                      // 需全部包含的标签
+	Fuzzy         bool                   `protobuf:"varint,6,opt,name=fuzzy,proto3" json:"fuzzy,omitempty"`                                   // 是否容忍拼写错误
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VocabularyServiceSearchRequest) Reset() {
+	*x = VocabularyServiceSearchRequest{}
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VocabularyServiceSearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VocabularyServiceSearchRequest) ProtoMessage() {}
+
+func (x *VocabularyServiceSearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VocabularyServiceSearchRequest.ProtoReflect.Descriptor instead.
+func (*VocabularyServiceSearchRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vocabulary_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *VocabularyServiceSearchRequest) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *VocabularyServiceSearchRequest) GetPage() uint32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *VocabularyServiceSearchRequest) GetPageSize() uint32 {
 	if x != nil {
 		return x.PageSize
 	}
 	return 0
 }
 
-func (x *VocabularyServiceListHanCharRequest) GetLevel() WordDifficultyLevel {
+func (x *VocabularyServiceSearchRequest) GetLevel() WordDifficultyLevel {
 	if x != nil {
 		return x.Level
 	}
 	return WordDifficultyLevel_WORD_DIFFICULTY_LEVEL_UNSPECIFIED
 }
 
-func (x *VocabularyServiceListHanCharRequest) GetTags() []string {
+func (x *VocabularyServiceSearchRequest) GetTags() []string {
 	if x != nil {
 		return x.Tags
 	}
 	return nil
 }
 
-func (x *VocabularyServiceListHanCharRequest) GetCategories() []string {
+func (x *VocabularyServiceSearchRequest) GetFuzzy() bool {
+	if x != nil {
+		return x.Fuzzy
+	}
+	return false
+}
+
+// WordSearchHighlight 与关键词匹配的片段
+type WordSearchHighlight struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`       // 匹配的字段: text, meaning, synonym, example
+	Fragment      string                 `protobuf:"bytes,2,opt,name=fragment,proto3" json:"fragment,omitempty"` // 用 <em></em> 包围匹配部分的片段，其余文本已做 HTML 转义
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WordSearchHighlight) Reset() {
+	*x = WordSearchHighlight{}
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WordSearchHighlight) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WordSearchHighlight) ProtoMessage() {}
+
+func (x *WordSearchHighlight) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WordSearchHighlight.ProtoReflect.Descriptor instead.
+func (*WordSearchHighlight) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vocabulary_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *WordSearchHighlight) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *WordSearchHighlight) GetFragment() string {
+	if x != nil {
+		return x.Fragment
+	}
+	return ""
+}
+
+// WordSearchResult 单词检索结果
+type WordSearchResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Word          *Word                  `protobuf:"bytes,1,opt,name=word,proto3" json:"word,omitempty"`
+	Rank          float64                `protobuf:"fixed64,2,opt,name=rank,proto3" json:"rank,omitempty"`                                // 相关度, 越大越相关
+	Highlights    []*WordSearchHighlight `protobuf:"bytes,3,rep,name=highlights,proto3" json:"highlights,omitempty"`                      // 只因拼写相近而命中时为空
+	MatchedForm   *WordForm              `protobuf:"bytes,4,opt,name=matched_form,json=matchedForm,proto3" json:"matched_form,omitempty"` // 因关键词是单词的词形而命中时为该词形
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WordSearchResult) Reset() {
+	*x = WordSearchResult{}
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WordSearchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WordSearchResult) ProtoMessage() {}
+
+func (x *WordSearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WordSearchResult.ProtoReflect.Descriptor instead.
+func (*WordSearchResult) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vocabulary_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *WordSearchResult) GetWord() *Word {
+	if x != nil {
+		return x.Word
+	}
+	return nil
+}
+
+func (x *WordSearchResult) GetRank() float64 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *WordSearchResult) GetHighlights() []*WordSearchHighlight {
+	if x != nil {
+		return x.Highlights
+	}
+	return nil
+}
+
+func (x *WordSearchResult) GetMatchedForm() *WordForm {
+	if x != nil {
+		return x.MatchedForm
+	}
+	return nil
+}
+
+type VocabularyServiceSearchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*WordSearchResult    `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"` // 按相关度降序
+	Total         uint32                 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VocabularyServiceSearchResponse) Reset() {
+	*x = VocabularyServiceSearchResponse{}
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VocabularyServiceSearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VocabularyServiceSearchResponse) ProtoMessage() {}
+
+func (x *VocabularyServiceSearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VocabularyServiceSearchResponse.ProtoReflect.Descriptor instead.
+func (*VocabularyServiceSearchResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vocabulary_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *VocabularyServiceSearchResponse) GetResults() []*WordSearchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *VocabularyServiceSearchResponse) GetTotal() uint32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type VocabularyServiceCreateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Word          *Word                  `protobuf:"bytes,1,opt,name=word,proto3" json:"word,omitempty"` // 忽略 id
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VocabularyServiceCreateRequest) Reset() {
+	*x = VocabularyServiceCreateRequest{}
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VocabularyServiceCreateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VocabularyServiceCreateRequest) ProtoMessage() {}
+
+func (x *VocabularyServiceCreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VocabularyServiceCreateRequest.ProtoReflect.Descriptor instead.
+func (*VocabularyServiceCreateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vocabulary_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *VocabularyServiceCreateRequest) GetWord() *Word {
+	if x != nil {
+		return x.Word
+	}
+	return nil
+}
+
+type VocabularyServiceCreateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Word          *Word                  `protobuf:"bytes,1,opt,name=word,proto3" json:"word,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VocabularyServiceCreateResponse) Reset() {
+	*x = VocabularyServiceCreateResponse{}
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VocabularyServiceCreateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VocabularyServiceCreateResponse) ProtoMessage() {}
+
+func (x *VocabularyServiceCreateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VocabularyServiceCreateResponse.ProtoReflect.Descriptor instead.
+func (*VocabularyServiceCreateResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vocabulary_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *VocabularyServiceCreateResponse) GetWord() *Word {
+	if x != nil {
+		return x.Word
+	}
+	return nil
+}
+
+type VocabularyServiceUpdateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Word          *Word                  `protobuf:"bytes,2,opt,name=word,proto3" json:"word,omitempty"` // 忽略 id, 未提供的字段会被清空
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VocabularyServiceUpdateRequest) Reset() {
+	*x = VocabularyServiceUpdateRequest{}
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VocabularyServiceUpdateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VocabularyServiceUpdateRequest) ProtoMessage() {}
+
+func (x *VocabularyServiceUpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VocabularyServiceUpdateRequest.ProtoReflect.Descriptor instead.
+func (*VocabularyServiceUpdateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vocabulary_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *VocabularyServiceUpdateRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *VocabularyServiceUpdateRequest) GetWord() *Word {
+	if x != nil {
+		return x.Word
+	}
+	return nil
+}
+
+type VocabularyServiceUpdateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Word          *Word                  `protobuf:"bytes,1,opt,name=word,proto3" json:"word,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VocabularyServiceUpdateResponse) Reset() {
+	*x = VocabularyServiceUpdateResponse{}
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VocabularyServiceUpdateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VocabularyServiceUpdateResponse) ProtoMessage() {}
+
+func (x *VocabularyServiceUpdateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VocabularyServiceUpdateResponse.ProtoReflect.Descriptor instead.
+func (*VocabularyServiceUpdateResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vocabulary_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *VocabularyServiceUpdateResponse) GetWord() *Word {
 	if x != nil {
-		return x.Categories
+		return x.Word
 	}
 	return nil
 }
 
-type VocabularyServiceListHanCharResponse struct {
+type VocabularyServiceDeleteRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	HanChars      []*HanChar             `protobuf:"bytes,1,rep,name=han_chars,json=hanChars,proto3" json:"han_chars,omitempty"`
-	Total         uint32                 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *VocabularyServiceListHanCharResponse) Reset() {
-	*x = VocabularyServiceListHanCharResponse{}
-	mi := &file_proto_v1_vocabulary_proto_msgTypes[10]
+func (x *VocabularyServiceDeleteRequest) Reset() {
+	*x = VocabularyServiceDeleteRequest{}
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VocabularyServiceListHanCharResponse) String() string {
+func (x *VocabularyServiceDeleteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VocabularyServiceListHanCharResponse) ProtoMessage() {}
+func (*VocabularyServiceDeleteRequest) ProtoMessage() {}
 
-func (x *VocabularyServiceListHanCharResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_vocabulary_proto_msgTypes[10]
+func (x *VocabularyServiceDeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -901,46 +3724,39 @@ func (x *VocabularyServiceListHanCharResponse) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VocabularyServiceListHanCharResponse.ProtoReflect.Descriptor instead.
-func (*VocabularyServiceListHanCharResponse) Descriptor() ([]byte, []int) {
-	return file_proto_v1_vocabulary_proto_rawDescGZIP(), []int{10}
-}
-
-func (x *VocabularyServiceListHanCharResponse) GetHanChars() []*HanChar {
-	if x != nil {
-		return x.HanChars
-	}
-	return nil
+// Deprecated: Use VocabularyServiceDeleteRequest.ProtoReflect.Descriptor instead.
+func (*VocabularyServiceDeleteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vocabulary_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *VocabularyServiceListHanCharResponse) GetTotal() uint32 {
+func (x *VocabularyServiceDeleteRequest) GetId() uint32 {
 	if x != nil {
-		return x.Total
+		return x.Id
 	}
 	return 0
 }
 
-type VocabularyServiceAllCategoriesRequest struct {
+type VocabularyServiceDeleteResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *VocabularyServiceAllCategoriesRequest) Reset() {
-	*x = VocabularyServiceAllCategoriesRequest{}
-	mi := &file_proto_v1_vocabulary_proto_msgTypes[11]
+func (x *VocabularyServiceDeleteResponse) Reset() {
+	*x = VocabularyServiceDeleteResponse{}
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VocabularyServiceAllCategoriesRequest) String() string {
+func (x *VocabularyServiceDeleteResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VocabularyServiceAllCategoriesRequest) ProtoMessage() {}
+func (*VocabularyServiceDeleteResponse) ProtoMessage() {}
 
-func (x *VocabularyServiceAllCategoriesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_vocabulary_proto_msgTypes[11]
+func (x *VocabularyServiceDeleteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -951,33 +3767,33 @@ func (x *VocabularyServiceAllCategoriesRequest) ProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VocabularyServiceAllCategoriesRequest.ProtoReflect.Descriptor instead.
-func (*VocabularyServiceAllCategoriesRequest) Descriptor() ([]byte, []int) {
-	return file_proto_v1_vocabulary_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use VocabularyServiceDeleteResponse.ProtoReflect.Descriptor instead.
+func (*VocabularyServiceDeleteResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vocabulary_proto_rawDescGZIP(), []int{60}
 }
 
-type VocabularyServiceAllCategoriesResponse struct {
+type VocabularyServiceCreateHanCharRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Categories    []string               `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	HanChar       *HanChar               `protobuf:"bytes,1,opt,name=han_char,json=hanChar,proto3" json:"han_char,omitempty"` // 忽略 id 和笔顺数据
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *VocabularyServiceAllCategoriesResponse) Reset() {
-	*x = VocabularyServiceAllCategoriesResponse{}
-	mi := &file_proto_v1_vocabulary_proto_msgTypes[12]
+func (x *VocabularyServiceCreateHanCharRequest) Reset() {
+	*x = VocabularyServiceCreateHanCharRequest{}
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VocabularyServiceAllCategoriesResponse) String() string {
+func (x *VocabularyServiceCreateHanCharRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VocabularyServiceAllCategoriesResponse) ProtoMessage() {}
+func (*VocabularyServiceCreateHanCharRequest) ProtoMessage() {}
 
-func (x *VocabularyServiceAllCategoriesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_vocabulary_proto_msgTypes[12]
+func (x *VocabularyServiceCreateHanCharRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -988,39 +3804,40 @@ func (x *VocabularyServiceAllCategoriesResponse) ProtoReflect() protoreflect.Mes
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VocabularyServiceAllCategoriesResponse.ProtoReflect.Descriptor instead.
-func (*VocabularyServiceAllCategoriesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_v1_vocabulary_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use VocabularyServiceCreateHanCharRequest.ProtoReflect.Descriptor instead.
+func (*VocabularyServiceCreateHanCharRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vocabulary_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *VocabularyServiceAllCategoriesResponse) GetCategories() []string {
+func (x *VocabularyServiceCreateHanCharRequest) GetHanChar() *HanChar {
 	if x != nil {
-		return x.Categories
+		return x.HanChar
 	}
 	return nil
 }
 
-type VocabularyServiceGetAllMetadataRequest struct {
+type VocabularyServiceCreateHanCharResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	HanChar       *HanChar               `protobuf:"bytes,1,opt,name=han_char,json=hanChar,proto3" json:"han_char,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *VocabularyServiceGetAllMetadataRequest) Reset() {
-	*x = VocabularyServiceGetAllMetadataRequest{}
-	mi := &file_proto_v1_vocabulary_proto_msgTypes[13]
+func (x *VocabularyServiceCreateHanCharResponse) Reset() {
+	*x = VocabularyServiceCreateHanCharResponse{}
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VocabularyServiceGetAllMetadataRequest) String() string {
+func (x *VocabularyServiceCreateHanCharResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VocabularyServiceGetAllMetadataRequest) ProtoMessage() {}
+func (*VocabularyServiceCreateHanCharResponse) ProtoMessage() {}
 
-func (x *VocabularyServiceGetAllMetadataRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_vocabulary_proto_msgTypes[13]
+func (x *VocabularyServiceCreateHanCharResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1031,34 +3848,41 @@ func (x *VocabularyServiceGetAllMetadataRequest) ProtoReflect() protoreflect.Mes
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VocabularyServiceGetAllMetadataRequest.ProtoReflect.Descriptor instead.
-func (*VocabularyServiceGetAllMetadataRequest) Descriptor() ([]byte, []int) {
-	return file_proto_v1_vocabulary_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use VocabularyServiceCreateHanCharResponse.ProtoReflect.Descriptor instead.
+func (*VocabularyServiceCreateHanCharResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vocabulary_proto_rawDescGZIP(), []int{62}
 }
 
-type VocabularyServiceGetAllMetadataResponse struct {
+func (x *VocabularyServiceCreateHanCharResponse) GetHanChar() *HanChar {
+	if x != nil {
+		return x.HanChar
+	}
+	return nil
+}
+
+type VocabularyServiceUpdateHanCharRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Tags          []string               `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
-	Categories    []string               `protobuf:"bytes,2,rep,name=categories,proto3" json:"categories,omitempty"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	HanChar       *HanChar               `protobuf:"bytes,2,opt,name=han_char,json=hanChar,proto3" json:"han_char,omitempty"` // 忽略 id 和笔顺数据, 未提供的字段会被清空
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *VocabularyServiceGetAllMetadataResponse) Reset() {
-	*x = VocabularyServiceGetAllMetadataResponse{}
-	mi := &file_proto_v1_vocabulary_proto_msgTypes[14]
+func (x *VocabularyServiceUpdateHanCharRequest) Reset() {
+	*x = VocabularyServiceUpdateHanCharRequest{}
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VocabularyServiceGetAllMetadataResponse) String() string {
+func (x *VocabularyServiceUpdateHanCharRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VocabularyServiceGetAllMetadataResponse) ProtoMessage() {}
+func (*VocabularyServiceUpdateHanCharRequest) ProtoMessage() {}
 
-func (x *VocabularyServiceGetAllMetadataResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_vocabulary_proto_msgTypes[14]
+func (x *VocabularyServiceUpdateHanCharRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1069,47 +3893,47 @@ func (x *VocabularyServiceGetAllMetadataResponse) ProtoReflect() protoreflect.Me
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VocabularyServiceGetAllMetadataResponse.ProtoReflect.Descriptor instead.
-func (*VocabularyServiceGetAllMetadataResponse) Descriptor() ([]byte, []int) {
-	return file_proto_v1_vocabulary_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use VocabularyServiceUpdateHanCharRequest.ProtoReflect.Descriptor instead.
+func (*VocabularyServiceUpdateHanCharRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vocabulary_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *VocabularyServiceGetAllMetadataResponse) GetTags() []string {
+func (x *VocabularyServiceUpdateHanCharRequest) GetId() uint32 {
 	if x != nil {
-		return x.Tags
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-func (x *VocabularyServiceGetAllMetadataResponse) GetCategories() []string {
+func (x *VocabularyServiceUpdateHanCharRequest) GetHanChar() *HanChar {
 	if x != nil {
-		return x.Categories
+		return x.HanChar
 	}
 	return nil
 }
 
-type VocabularyServiceBatchCreateRequest struct {
+type VocabularyServiceUpdateHanCharResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Words         []*Word                `protobuf:"bytes,1,rep,name=words,proto3" json:"words,omitempty"`
+	HanChar       *HanChar               `protobuf:"bytes,1,opt,name=han_char,json=hanChar,proto3" json:"han_char,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *VocabularyServiceBatchCreateRequest) Reset() {
-	*x = VocabularyServiceBatchCreateRequest{}
-	mi := &file_proto_v1_vocabulary_proto_msgTypes[15]
+func (x *VocabularyServiceUpdateHanCharResponse) Reset() {
+	*x = VocabularyServiceUpdateHanCharResponse{}
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VocabularyServiceBatchCreateRequest) String() string {
+func (x *VocabularyServiceUpdateHanCharResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VocabularyServiceBatchCreateRequest) ProtoMessage() {}
+func (*VocabularyServiceUpdateHanCharResponse) ProtoMessage() {}
 
-func (x *VocabularyServiceBatchCreateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_vocabulary_proto_msgTypes[15]
+func (x *VocabularyServiceUpdateHanCharResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1120,40 +3944,40 @@ func (x *VocabularyServiceBatchCreateRequest) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VocabularyServiceBatchCreateRequest.ProtoReflect.Descriptor instead.
-func (*VocabularyServiceBatchCreateRequest) Descriptor() ([]byte, []int) {
-	return file_proto_v1_vocabulary_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use VocabularyServiceUpdateHanCharResponse.ProtoReflect.Descriptor instead.
+func (*VocabularyServiceUpdateHanCharResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vocabulary_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *VocabularyServiceBatchCreateRequest) GetWords() []*Word {
+func (x *VocabularyServiceUpdateHanCharResponse) GetHanChar() *HanChar {
 	if x != nil {
-		return x.Words
+		return x.HanChar
 	}
 	return nil
 }
 
-type VocabularyServiceBatchCreateResponse struct {
+type VocabularyServiceDeleteHanCharRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Ids           []uint32               `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"` // 创建成功的单词ID列表
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *VocabularyServiceBatchCreateResponse) Reset() {
-	*x = VocabularyServiceBatchCreateResponse{}
-	mi := &file_proto_v1_vocabulary_proto_msgTypes[16]
+func (x *VocabularyServiceDeleteHanCharRequest) Reset() {
+	*x = VocabularyServiceDeleteHanCharRequest{}
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VocabularyServiceBatchCreateResponse) String() string {
+func (x *VocabularyServiceDeleteHanCharRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VocabularyServiceBatchCreateResponse) ProtoMessage() {}
+func (*VocabularyServiceDeleteHanCharRequest) ProtoMessage() {}
 
-func (x *VocabularyServiceBatchCreateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_vocabulary_proto_msgTypes[16]
+func (x *VocabularyServiceDeleteHanCharRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1164,40 +3988,39 @@ func (x *VocabularyServiceBatchCreateResponse) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VocabularyServiceBatchCreateResponse.ProtoReflect.Descriptor instead.
-func (*VocabularyServiceBatchCreateResponse) Descriptor() ([]byte, []int) {
-	return file_proto_v1_vocabulary_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use VocabularyServiceDeleteHanCharRequest.ProtoReflect.Descriptor instead.
+func (*VocabularyServiceDeleteHanCharRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vocabulary_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *VocabularyServiceBatchCreateResponse) GetIds() []uint32 {
+func (x *VocabularyServiceDeleteHanCharRequest) GetId() uint32 {
 	if x != nil {
-		return x.Ids
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-type VocabularyServiceBatchCreateHanCharRequest struct {
+type VocabularyServiceDeleteHanCharResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	HanChars      []*HanChar             `protobuf:"bytes,1,rep,name=han_chars,json=hanChars,proto3" json:"han_chars,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *VocabularyServiceBatchCreateHanCharRequest) Reset() {
-	*x = VocabularyServiceBatchCreateHanCharRequest{}
-	mi := &file_proto_v1_vocabulary_proto_msgTypes[17]
+func (x *VocabularyServiceDeleteHanCharResponse) Reset() {
+	*x = VocabularyServiceDeleteHanCharResponse{}
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VocabularyServiceBatchCreateHanCharRequest) String() string {
+func (x *VocabularyServiceDeleteHanCharResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VocabularyServiceBatchCreateHanCharRequest) ProtoMessage() {}
+func (*VocabularyServiceDeleteHanCharResponse) ProtoMessage() {}
 
-func (x *VocabularyServiceBatchCreateHanCharRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_vocabulary_proto_msgTypes[17]
+func (x *VocabularyServiceDeleteHanCharResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1208,40 +4031,118 @@ func (x *VocabularyServiceBatchCreateHanCharRequest) ProtoReflect() protoreflect
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VocabularyServiceBatchCreateHanCharRequest.ProtoReflect.Descriptor instead.
-func (*VocabularyServiceBatchCreateHanCharRequest) Descriptor() ([]byte, []int) {
-	return file_proto_v1_vocabulary_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use VocabularyServiceDeleteHanCharResponse.ProtoReflect.Descriptor instead.
+func (*VocabularyServiceDeleteHanCharResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vocabulary_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *VocabularyServiceBatchCreateHanCharRequest) GetHanChars() []*HanChar {
+type VocabularyServiceSearchHanCharRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keyword       string                 `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword,omitempty"` // 搜索关键词, 匹配汉字或拼音, 拼音可以是 mǎ、ma3、ma 等任意写法
+	Page          uint32                 `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      uint32                 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Level         WordDifficultyLevel    `protobuf:"varint,4,opt,name=level,proto3,enum=proto.v1.WordDifficultyLevel" json:"level,omitempty"` // 难度等级, 未指定时不过滤
+	Tags          []string               `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`
+	IgnoreTone    bool                   `protobuf:"varint,6,opt,name=ignore_tone,json=ignoreTone,proto3" json:"ignore_tone,omitempty"` // 是否忽略声调匹配拼音, 拼音不标调时总是忽略
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VocabularyServiceSearchHanCharRequest) Reset() {
+	*x = VocabularyServiceSearchHanCharRequest{}
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VocabularyServiceSearchHanCharRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VocabularyServiceSearchHanCharRequest) ProtoMessage() {}
+
+func (x *VocabularyServiceSearchHanCharRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[67]
 	if x != nil {
-		return x.HanChars
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VocabularyServiceSearchHanCharRequest.ProtoReflect.Descriptor instead.
+func (*VocabularyServiceSearchHanCharRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vocabulary_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *VocabularyServiceSearchHanCharRequest) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *VocabularyServiceSearchHanCharRequest) GetPage() uint32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *VocabularyServiceSearchHanCharRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *VocabularyServiceSearchHanCharRequest) GetLevel() WordDifficultyLevel {
+	if x != nil {
+		return x.Level
+	}
+	return WordDifficultyLevel_WORD_DIFFICULTY_LEVEL_UNSPECIFIED
+}
+
+func (x *VocabularyServiceSearchHanCharRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
 	}
 	return nil
 }
 
-type VocabularyServiceBatchCreateHanCharResponse struct {
+func (x *VocabularyServiceSearchHanCharRequest) GetIgnoreTone() bool {
+	if x != nil {
+		return x.IgnoreTone
+	}
+	return false
+}
+
+type VocabularyServiceSearchHanCharResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Ids           []uint32               `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"` // 创建成功的汉字ID列表
+	HanChars      []*HanChar             `protobuf:"bytes,1,rep,name=han_chars,json=hanChars,proto3" json:"han_chars,omitempty"`
+	Total         uint32                 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *VocabularyServiceBatchCreateHanCharResponse) Reset() {
-	*x = VocabularyServiceBatchCreateHanCharResponse{}
-	mi := &file_proto_v1_vocabulary_proto_msgTypes[18]
+func (x *VocabularyServiceSearchHanCharResponse) Reset() {
+	*x = VocabularyServiceSearchHanCharResponse{}
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VocabularyServiceBatchCreateHanCharResponse) String() string {
+func (x *VocabularyServiceSearchHanCharResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VocabularyServiceBatchCreateHanCharResponse) ProtoMessage() {}
+func (*VocabularyServiceSearchHanCharResponse) ProtoMessage() {}
 
-func (x *VocabularyServiceBatchCreateHanCharResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_vocabulary_proto_msgTypes[18]
+func (x *VocabularyServiceSearchHanCharResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vocabulary_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1252,18 +4153,25 @@ func (x *VocabularyServiceBatchCreateHanCharResponse) ProtoReflect() protoreflec
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VocabularyServiceBatchCreateHanCharResponse.ProtoReflect.Descriptor instead.
-func (*VocabularyServiceBatchCreateHanCharResponse) Descriptor() ([]byte, []int) {
-	return file_proto_v1_vocabulary_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use VocabularyServiceSearchHanCharResponse.ProtoReflect.Descriptor instead.
+func (*VocabularyServiceSearchHanCharResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vocabulary_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *VocabularyServiceBatchCreateHanCharResponse) GetIds() []uint32 {
+func (x *VocabularyServiceSearchHanCharResponse) GetHanChars() []*HanChar {
 	if x != nil {
-		return x.Ids
+		return x.HanChars
 	}
 	return nil
 }
 
+func (x *VocabularyServiceSearchHanCharResponse) GetTotal() uint32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
 var File_proto_v1_vocabulary_proto protoreflect.FileDescriptor
 
 var file_proto_v1_vocabulary_proto_rawDesc = string([]byte{
@@ -1314,7 +4222,7 @@ var file_proto_v1_vocabulary_proto_rawDesc = string([]byte{
 	0x70, 0x6c, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x09, 0x20, 0x03,
 	0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x61, 0x74, 0x65,
 	0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61,
-	0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x22, 0xd4, 0x01, 0x0a, 0x07, 0x48, 0x61, 0x6e,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x22, 0xf9, 0x03, 0x0a, 0x07, 0x48, 0x61, 0x6e,
 	0x43, 0x68, 0x61, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
 	0x52, 0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65,
 	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74,
@@ -1327,206 +4235,810 @@ var file_proto_v1_vocabulary_proto_rawDesc = string([]byte{
 	0x52, 0x08, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x12, 0x33, 0x0a, 0x05, 0x6c, 0x65,
 	0x76, 0x65, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x64, 0x44, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75,
-	0x6c, 0x74, 0x79, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22,
-	0x2d, 0x0a, 0x1b, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x22, 0x42,
+	0x6c, 0x74, 0x79, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12,
+	0x27, 0x0a, 0x0f, 0x70, 0x69, 0x6e, 0x79, 0x69, 0x6e, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x69, 0x6e, 0x79, 0x69, 0x6e,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x61, 0x64, 0x69,
+	0x63, 0x61, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x61, 0x64, 0x69, 0x63,
+	0x61, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x72, 0x6f, 0x6b, 0x65, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x73, 0x74, 0x72, 0x6f, 0x6b, 0x65,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65,
+	0x6e, 0x74, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x6f,
+	0x6e, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x64, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x61, 0x6c, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x64,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x69, 0x6d, 0x70, 0x6c,
+	0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x69, 0x6d,
+	0x70, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x61, 0x6e, 0x69,
+	0x6e, 0x67, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x61, 0x6e, 0x69,
+	0x6e, 0x67, 0x73, 0x12, 0x3f, 0x0a, 0x0c, 0x73, 0x74, 0x72, 0x6f, 0x6b, 0x65, 0x5f, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x53, 0x74, 0x72, 0x6f,
+	0x6b, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x0b, 0x73, 0x74, 0x72, 0x6f, 0x6b, 0x65, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x22, 0x29, 0x0a, 0x0b, 0x53, 0x74, 0x72, 0x6f, 0x6b, 0x65, 0x50, 0x6f,
+	0x69, 0x6e, 0x74, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x01,
+	0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x01, 0x79, 0x22,
+	0x3f, 0x0a, 0x0e, 0x53, 0x74, 0x72, 0x6f, 0x6b, 0x65, 0x50, 0x6f, 0x6c, 0x79, 0x6c, 0x69, 0x6e,
+	0x65, 0x12, 0x2d, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72,
+	0x6f, 0x6b, 0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
+	0x22, 0x62, 0x0a, 0x12, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x53, 0x74, 0x72, 0x6f, 0x6b,
+	0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x74, 0x72, 0x6f, 0x6b, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x73, 0x74, 0x72, 0x6f, 0x6b, 0x65, 0x73,
+	0x12, 0x32, 0x0a, 0x07, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72,
+	0x6f, 0x6b, 0x65, 0x50, 0x6f, 0x6c, 0x79, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x07, 0x6d, 0x65, 0x64,
+	0x69, 0x61, 0x6e, 0x73, 0x22, 0x2d, 0x0a, 0x1b, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61,
+	0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x02, 0x69, 0x64, 0x22, 0xaf, 0x01, 0x0a, 0x1c, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61,
+	0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x04, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f,
+	0x72, 0x64, 0x52, 0x04, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x28, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x6d,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x64, 0x46, 0x6f, 0x72, 0x6d, 0x52, 0x05, 0x66, 0x6f, 0x72,
+	0x6d, 0x73, 0x12, 0x41, 0x0a, 0x0b, 0x69, 0x6e, 0x66, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x64, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x49, 0x6e,
+	0x66, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x69, 0x6e, 0x66, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x4a, 0x0a, 0x08, 0x57, 0x6f, 0x72, 0x64, 0x46, 0x6f, 0x72,
+	0x6d, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x2a, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x57,
+	0x6f, 0x72, 0x64, 0x46, 0x6f, 0x72, 0x6d, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e,
+	0x64, 0x22, 0xb7, 0x01, 0x0a, 0x15, 0x57, 0x6f, 0x72, 0x64, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x49, 0x6e, 0x66, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x65,
+	0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x78,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x2a, 0x0a, 0x04, 0x6b, 0x69, 0x6e,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x64, 0x46, 0x6f, 0x72, 0x6d, 0x4b, 0x69, 0x6e, 0x64, 0x52,
+	0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x66, 0x6c, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x6e, 0x66, 0x6c, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x22, 0x34, 0x0a, 0x1e, 0x56,
+	0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78,
+	0x74, 0x22, 0xe9, 0x01, 0x0a, 0x1f, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x04, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x57,
+	0x6f, 0x72, 0x64, 0x52, 0x04, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x35, 0x0a, 0x0c, 0x6d, 0x61, 0x74,
+	0x63, 0x68, 0x65, 0x64, 0x5f, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x64, 0x46,
+	0x6f, 0x72, 0x6d, 0x52, 0x0b, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x46, 0x6f, 0x72, 0x6d,
+	0x12, 0x28, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x64, 0x46,
+	0x6f, 0x72, 0x6d, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x6d, 0x73, 0x12, 0x41, 0x0a, 0x0b, 0x69, 0x6e,
+	0x66, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x64, 0x45,
+	0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0b, 0x69, 0x6e, 0x66, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xb8, 0x01,
 	0x0a, 0x1c, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22,
-	0x0a, 0x04, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x64, 0x52, 0x04, 0x77, 0x6f,
-	0x72, 0x64, 0x22, 0xb8, 0x01, 0x0a, 0x1c, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72,
-	0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f,
-	0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65,
-	0x53, 0x69, 0x7a, 0x65, 0x12, 0x33, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x57,
-	0x6f, 0x72, 0x64, 0x44, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x4c, 0x65, 0x76,
-	0x65, 0x6c, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67,
-	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x1e, 0x0a,
-	0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x22, 0x5b, 0x0a,
-	0x1d, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24,
-	0x0a, 0x05, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x64, 0x52, 0x05, 0x77,
-	0x6f, 0x72, 0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x21, 0x0a, 0x1f, 0x56, 0x6f,
-	0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41,
-	0x6c, 0x6c, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x36, 0x0a,
-	0x20, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x41, 0x6c, 0x6c, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x04, 0x74, 0x61, 0x67, 0x73, 0x22, 0xbf, 0x01, 0x0a, 0x23, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75,
-	0x6c, 0x61, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x48,
-	0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
-	0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x61, 0x67,
-	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02,
+	0x69, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x61,
+	0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x33, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x64, 0x44, 0x69,
+	0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x05, 0x6c,
+	0x65, 0x76, 0x65, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x22, 0x5b, 0x0a, 0x1d, 0x56, 0x6f, 0x63, 0x61,
+	0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x05, 0x77, 0x6f, 0x72,
+	0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x64, 0x52, 0x05, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x21, 0x0a, 0x1f, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c,
+	0x61, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x6c, 0x6c, 0x54, 0x61, 0x67,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x36, 0x0a, 0x20, 0x56, 0x6f, 0x63, 0x61,
+	0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x6c, 0x6c,
+	0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73,
+	0x22, 0xf2, 0x02, 0x0a, 0x23, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x33, 0x0a, 0x05, 0x6c, 0x65, 0x76,
+	0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x64, 0x44, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c,
+	0x74, 0x79, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61,
+	0x67, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69,
+	0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x61, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x61, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x12, 0x1f, 0x0a, 0x0b,
+	0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x74, 0x72, 0x6f, 0x6b, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0a, 0x6d, 0x69, 0x6e, 0x53, 0x74, 0x72, 0x6f, 0x6b, 0x65, 0x73, 0x12, 0x1f, 0x0a,
+	0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x74, 0x72, 0x6f, 0x6b, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x53, 0x74, 0x72, 0x6f, 0x6b, 0x65, 0x73, 0x12, 0x1c,
+	0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x70, 0x69, 0x6e, 0x79, 0x69, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x69,
+	0x6e, 0x79, 0x69, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x5f, 0x74,
+	0x6f, 0x6e, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x67, 0x6e, 0x6f, 0x72,
+	0x65, 0x54, 0x6f, 0x6e, 0x65, 0x22, 0x6c, 0x0a, 0x24, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c,
+	0x61, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x61,
+	0x6e, 0x43, 0x68, 0x61, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a,
+	0x09, 0x68, 0x61, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x61, 0x6e, 0x43,
+	0x68, 0x61, 0x72, 0x52, 0x08, 0x68, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x22, 0x27, 0x0a, 0x25, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72,
+	0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x6c, 0x6c, 0x43, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x48, 0x0a, 0x26,
+	0x56, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x41, 0x6c, 0x6c, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x22, 0x28, 0x0a, 0x26, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75,
+	0x6c, 0x61, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x47, 0x65, 0x74, 0x41, 0x6c,
+	0x6c, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0x5d, 0x0a, 0x27, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12,
+	0x1e, 0x0a, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x22,
+	0x4b, 0x0a, 0x23, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x05, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31,
+	0x2e, 0x57, 0x6f, 0x72, 0x64, 0x52, 0x05, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x22, 0x38, 0x0a, 0x24,
+	0x56, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0d, 0x52, 0x03, 0x69, 0x64, 0x73, 0x22, 0x5c, 0x0a, 0x2a, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75,
+	0x6c, 0x61, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x09, 0x68, 0x61, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x72,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x76, 0x31, 0x2e, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x52, 0x08, 0x68, 0x61, 0x6e, 0x43,
+	0x68, 0x61, 0x72, 0x73, 0x22, 0x3f, 0x0a, 0x2b, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61,
+	0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0d,
+	0x52, 0x03, 0x69, 0x64, 0x73, 0x22, 0x52, 0x0a, 0x22, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c,
+	0x61, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x47, 0x65, 0x74, 0x48, 0x61, 0x6e,
+	0x43, 0x68, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63,
+	0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x22, 0x53, 0x0a, 0x23, 0x56, 0x6f, 0x63,
+	0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x47, 0x65,
+	0x74, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2c, 0x0a, 0x08, 0x68, 0x61, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x61,
+	0x6e, 0x43, 0x68, 0x61, 0x72, 0x52, 0x07, 0x68, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x22, 0x72,
+	0x0a, 0x11, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x53, 0x74, 0x72, 0x6f, 0x6b, 0x65, 0x44,
+	0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65,
+	0x72, 0x12, 0x3f, 0x0a, 0x0c, 0x73, 0x74, 0x72, 0x6f, 0x6b, 0x65, 0x5f, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x76, 0x31, 0x2e, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x53, 0x74, 0x72, 0x6f, 0x6b, 0x65,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x0b, 0x73, 0x74, 0x72, 0x6f, 0x6b, 0x65, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x22, 0x6d, 0x0a, 0x31, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x53, 0x74, 0x72, 0x6f, 0x6b, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x68, 0x61, 0x6e, 0x5f, 0x63,
+	0x68, 0x61, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x53, 0x74, 0x72,
+	0x6f, 0x6b, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x08, 0x68, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72,
+	0x73, 0x22, 0x6a, 0x0a, 0x32, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x53, 0x74, 0x72, 0x6f, 0x6b, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x69, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x22, 0x4f, 0x0a,
+	0x0d, 0x57, 0x6f, 0x72, 0x64, 0x46, 0x6f, 0x72, 0x6d, 0x73, 0x44, 0x61, 0x74, 0x61, 0x12, 0x14,
+	0x0a, 0x05, 0x6c, 0x65, 0x6d, 0x6d, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c,
+	0x65, 0x6d, 0x6d, 0x61, 0x12, 0x28, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x6d, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x57,
+	0x6f, 0x72, 0x64, 0x46, 0x6f, 0x72, 0x6d, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x6d, 0x73, 0x22, 0x7a,
+	0x0a, 0x2c, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x57, 0x6f,
+	0x72, 0x64, 0x46, 0x6f, 0x72, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d,
+	0x0a, 0x05, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x64, 0x46, 0x6f, 0x72,
+	0x6d, 0x73, 0x44, 0x61, 0x74, 0x61, 0x52, 0x05, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x1b, 0x0a,
+	0x09, 0x66, 0x6f, 0x72, 0x6d, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x66, 0x6f, 0x72, 0x6d, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x65, 0x0a, 0x2d, 0x56, 0x6f,
+	0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x57, 0x6f, 0x72, 0x64, 0x46, 0x6f,
+	0x72, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x69,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x69,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6e, 0x67, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e,
+	0x67, 0x22, 0xa3, 0x01, 0x0a, 0x0c, 0x57, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x31, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x78, 0x69,
+	0x63, 0x61, 0x6c, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x6f, 0x74, 0x65, 0x12, 0x22, 0x0a, 0x04, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72,
+	0x64, 0x52, 0x04, 0x77, 0x6f, 0x72, 0x64, 0x22, 0xb0, 0x01, 0x0a, 0x0f, 0x48, 0x61, 0x6e, 0x43,
+	0x68, 0x61, 0x72, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x31, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x78, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x69, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x69, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x12, 0x2c, 0x0a, 0x08,
+	0x68, 0x61, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61,
+	0x72, 0x52, 0x07, 0x68, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x22, 0x6b, 0x0a, 0x24, 0x56, 0x6f,
+	0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x47,
+	0x65, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x33, 0x0a, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0e, 0x32, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x78,
+	0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x22, 0x81, 0x01, 0x0a, 0x25, 0x56, 0x6f, 0x63, 0x61,
+	0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x47, 0x65, 0x74,
+	0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x22, 0x0a, 0x04, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x64, 0x52,
+	0x04, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x34, 0x0a, 0x09, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x09, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xa9, 0x01, 0x0a, 0x26,
+	0x56, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x49, 0x64,
+	0x12, 0x31, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x78, 0x69, 0x63, 0x61,
+	0x6c, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x22, 0x5d, 0x0a, 0x27, 0x56, 0x6f, 0x63, 0x61, 0x62,
+	0x75, 0x6c, 0x61, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x32, 0x0a, 0x08, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e,
+	0x57, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x72, 0x65,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x38, 0x0a, 0x26, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75,
+	0x6c, 0x61, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64,
+	0x22, 0x29, 0x0a, 0x27, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x72, 0x0a, 0x2b, 0x56,
+	0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x47, 0x65, 0x74, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x33, 0x0a, 0x05, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x78, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x22,
+	0x95, 0x01, 0x0a, 0x2c, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x47, 0x65, 0x74, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x52,
+	0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2c, 0x0a, 0x08, 0x68, 0x61, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x61,
+	0x6e, 0x43, 0x68, 0x61, 0x72, 0x52, 0x07, 0x68, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x12, 0x37,
+	0x0a, 0x09, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x61, 0x6e,
+	0x43, 0x68, 0x61, 0x72, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x72, 0x65,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xb0, 0x01, 0x0a, 0x2d, 0x56, 0x6f, 0x63, 0x61,
+	0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x78,
+	0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x22, 0x67, 0x0a, 0x2e, 0x56, 0x6f,
+	0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x52, 0x65, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x08,
+	0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61,
+	0x72, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x72, 0x65, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0x3f, 0x0a, 0x2d, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72,
+	0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x48, 0x61,
+	0x6e, 0x43, 0x68, 0x61, 0x72, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x02, 0x69, 0x64, 0x22, 0x30, 0x0a, 0x2e, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61,
+	0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x48,
+	0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x85, 0x01, 0x0a, 0x0c, 0x52, 0x65, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x31, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x65, 0x78, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f,
+	0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x22, 0x91,
+	0x01, 0x0a, 0x2c, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52,
+	0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x2c, 0x0a, 0x05, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x05, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x33, 0x0a,
+	0x09, 0x68, 0x61, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x08, 0x68, 0x61, 0x6e, 0x43, 0x68, 0x61,
+	0x72, 0x73, 0x22, 0x7d, 0x0a, 0x2d, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07,
+	0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6e, 0x67, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e,
+	0x67, 0x22, 0xca, 0x01, 0x0a, 0x1e, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x61,
+	0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x33, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x64, 0x44, 0x69,
+	0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x05, 0x6c,
+	0x65, 0x76, 0x65, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x75, 0x7a, 0x7a,
+	0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x75, 0x7a, 0x7a, 0x79, 0x22, 0x47,
+	0x0a, 0x13, 0x57, 0x6f, 0x72, 0x64, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x48, 0x69, 0x67, 0x68,
+	0x6c, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x66,
+	0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66,
+	0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0xc0, 0x01, 0x0a, 0x10, 0x57, 0x6f, 0x72, 0x64,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x22, 0x0a, 0x04,
+	0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x64, 0x52, 0x04, 0x77, 0x6f, 0x72, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04,
+	0x72, 0x61, 0x6e, 0x6b, 0x12, 0x3d, 0x0a, 0x0a, 0x68, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68,
+	0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x64, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x48, 0x69,
+	0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x52, 0x0a, 0x68, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67,
+	0x68, 0x74, 0x73, 0x12, 0x35, 0x0a, 0x0c, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x66,
+	0x6f, 0x72, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x64, 0x46, 0x6f, 0x72, 0x6d, 0x52, 0x0b, 0x6d,
+	0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x46, 0x6f, 0x72, 0x6d, 0x22, 0x6d, 0x0a, 0x1f, 0x56, 0x6f,
+	0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a,
+	0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x64, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x44, 0x0a, 0x1e, 0x56, 0x6f, 0x63,
+	0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x04, 0x77,
+	0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x64, 0x52, 0x04, 0x77, 0x6f, 0x72, 0x64, 0x22,
+	0x45, 0x0a, 0x1f, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x22, 0x0a, 0x04, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x64,
+	0x52, 0x04, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x54, 0x0a, 0x1e, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75,
+	0x6c, 0x61, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x22, 0x0a, 0x04, 0x77, 0x6f, 0x72, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76,
+	0x31, 0x2e, 0x57, 0x6f, 0x72, 0x64, 0x52, 0x04, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x45, 0x0a, 0x1f,
+	0x56, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x22, 0x0a, 0x04, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x64, 0x52, 0x04, 0x77,
+	0x6f, 0x72, 0x64, 0x22, 0x30, 0x0a, 0x1e, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72,
+	0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x02, 0x69, 0x64, 0x22, 0x21, 0x0a, 0x1f, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c,
+	0x61, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x55, 0x0a, 0x25, 0x56, 0x6f, 0x63, 0x61,
+	0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x2c, 0x0a, 0x08, 0x68, 0x61, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48,
+	0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x52, 0x07, 0x68, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x22,
+	0x56, 0x0a, 0x26, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x08, 0x68, 0x61, 0x6e,
+	0x5f, 0x63, 0x68, 0x61, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x52, 0x07,
+	0x68, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x22, 0x65, 0x0a, 0x25, 0x56, 0x6f, 0x63, 0x61, 0x62,
+	0x75, 0x6c, 0x61, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x2c, 0x0a, 0x08, 0x68, 0x61, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x61,
+	0x6e, 0x43, 0x68, 0x61, 0x72, 0x52, 0x07, 0x68, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x22, 0x56,
+	0x0a, 0x26, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x08, 0x68, 0x61, 0x6e, 0x5f,
+	0x63, 0x68, 0x61, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x52, 0x07, 0x68,
+	0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x22, 0x37, 0x0a, 0x25, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75,
+	0x6c, 0x61, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x22,
+	0x28, 0x0a, 0x26, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xdc, 0x01, 0x0a, 0x25, 0x56, 0x6f,
+	0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x61, 0x67,
+	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03,
 	0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x33,
-	0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e,
+	0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x64, 0x44, 0x69, 0x66,
 	0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x05, 0x6c, 0x65,
-	0x76, 0x65, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67,
-	0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x74,
-	0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x22, 0x6c, 0x0a, 0x24, 0x56, 0x6f, 0x63, 0x61, 0x62,
-	0x75, 0x6c, 0x61, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74,
-	0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x2e, 0x0a, 0x09, 0x68, 0x61, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x61,
-	0x6e, 0x43, 0x68, 0x61, 0x72, 0x52, 0x08, 0x68, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x73, 0x12,
-	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05,
-	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x27, 0x0a, 0x25, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c,
-	0x61, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x6c, 0x6c, 0x43, 0x61, 0x74,
-	0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x48,
-	0x0a, 0x26, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x41, 0x6c, 0x6c, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x61, 0x74, 0x65,
-	0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61,
-	0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x22, 0x28, 0x0a, 0x26, 0x56, 0x6f, 0x63, 0x61,
-	0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x47, 0x65, 0x74,
-	0x41, 0x6c, 0x6c, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x22, 0x5d, 0x0a, 0x27, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x4d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a,
-	0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67,
-	0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65,
-	0x73, 0x22, 0x4b, 0x0a, 0x23, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x05, 0x77, 0x6f, 0x72, 0x64,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x64, 0x52, 0x05, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x22, 0x38,
-	0x0a, 0x24, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0d, 0x52, 0x03, 0x69, 0x64, 0x73, 0x22, 0x5c, 0x0a, 0x2a, 0x56, 0x6f, 0x63, 0x61,
-	0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x42, 0x61, 0x74,
-	0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x09, 0x68, 0x61, 0x6e, 0x5f, 0x63, 0x68,
-	0x61, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x52, 0x08, 0x68, 0x61,
-	0x6e, 0x43, 0x68, 0x61, 0x72, 0x73, 0x22, 0x3f, 0x0a, 0x2b, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75,
-	0x6c, 0x61, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0d, 0x52, 0x03, 0x69, 0x64, 0x73, 0x2a, 0xb0, 0x03, 0x0a, 0x13, 0x57, 0x6f, 0x72, 0x64,
-	0x44, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12,
-	0x25, 0x0a, 0x21, 0x57, 0x4f, 0x52, 0x44, 0x5f, 0x44, 0x49, 0x46, 0x46, 0x49, 0x43, 0x55, 0x4c,
-	0x54, 0x59, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
-	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x57, 0x4f, 0x52, 0x44, 0x5f, 0x44,
-	0x49, 0x46, 0x46, 0x49, 0x43, 0x55, 0x4c, 0x54, 0x59, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f,
-	0x41, 0x31, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x57, 0x4f, 0x52, 0x44, 0x5f, 0x44, 0x49, 0x46,
-	0x46, 0x49, 0x43, 0x55, 0x4c, 0x54, 0x59, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x41, 0x32,
-	0x10, 0x02, 0x12, 0x1c, 0x0a, 0x18, 0x57, 0x4f, 0x52, 0x44, 0x5f, 0x44, 0x49, 0x46, 0x46, 0x49,
-	0x43, 0x55, 0x4c, 0x54, 0x59, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x42, 0x31, 0x10, 0x03,
-	0x12, 0x1c, 0x0a, 0x18, 0x57, 0x4f, 0x52, 0x44, 0x5f, 0x44, 0x49, 0x46, 0x46, 0x49, 0x43, 0x55,
-	0x4c, 0x54, 0x59, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x42, 0x32, 0x10, 0x04, 0x12, 0x1c,
-	0x0a, 0x18, 0x57, 0x4f, 0x52, 0x44, 0x5f, 0x44, 0x49, 0x46, 0x46, 0x49, 0x43, 0x55, 0x4c, 0x54,
-	0x59, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x43, 0x31, 0x10, 0x05, 0x12, 0x1c, 0x0a, 0x18,
-	0x57, 0x4f, 0x52, 0x44, 0x5f, 0x44, 0x49, 0x46, 0x46, 0x49, 0x43, 0x55, 0x4c, 0x54, 0x59, 0x5f,
-	0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x43, 0x32, 0x10, 0x06, 0x12, 0x1e, 0x0a, 0x1a, 0x57, 0x4f,
-	0x52, 0x44, 0x5f, 0x44, 0x49, 0x46, 0x46, 0x49, 0x43, 0x55, 0x4c, 0x54, 0x59, 0x5f, 0x4c, 0x45,
-	0x56, 0x45, 0x4c, 0x5f, 0x48, 0x53, 0x4b, 0x31, 0x10, 0x0b, 0x12, 0x1e, 0x0a, 0x1a, 0x57, 0x4f,
-	0x52, 0x44, 0x5f, 0x44, 0x49, 0x46, 0x46, 0x49, 0x43, 0x55, 0x4c, 0x54, 0x59, 0x5f, 0x4c, 0x45,
-	0x56, 0x45, 0x4c, 0x5f, 0x48, 0x53, 0x4b, 0x32, 0x10, 0x0c, 0x12, 0x1e, 0x0a, 0x1a, 0x57, 0x4f,
-	0x52, 0x44, 0x5f, 0x44, 0x49, 0x46, 0x46, 0x49, 0x43, 0x55, 0x4c, 0x54, 0x59, 0x5f, 0x4c, 0x45,
-	0x56, 0x45, 0x4c, 0x5f, 0x48, 0x53, 0x4b, 0x33, 0x10, 0x0d, 0x12, 0x1e, 0x0a, 0x1a, 0x57, 0x4f,
-	0x52, 0x44, 0x5f, 0x44, 0x49, 0x46, 0x46, 0x49, 0x43, 0x55, 0x4c, 0x54, 0x59, 0x5f, 0x4c, 0x45,
-	0x56, 0x45, 0x4c, 0x5f, 0x48, 0x53, 0x4b, 0x34, 0x10, 0x0e, 0x12, 0x1e, 0x0a, 0x1a, 0x57, 0x4f,
-	0x52, 0x44, 0x5f, 0x44, 0x49, 0x46, 0x46, 0x49, 0x43, 0x55, 0x4c, 0x54, 0x59, 0x5f, 0x4c, 0x45,
-	0x56, 0x45, 0x4c, 0x5f, 0x48, 0x53, 0x4b, 0x35, 0x10, 0x0f, 0x12, 0x1e, 0x0a, 0x1a, 0x57, 0x4f,
-	0x52, 0x44, 0x5f, 0x44, 0x49, 0x46, 0x46, 0x49, 0x43, 0x55, 0x4c, 0x54, 0x59, 0x5f, 0x4c, 0x45,
-	0x56, 0x45, 0x4c, 0x5f, 0x48, 0x53, 0x4b, 0x36, 0x10, 0x10, 0x2a, 0xad, 0x03, 0x0a, 0x10, 0x57,
-	0x6f, 0x72, 0x64, 0x50, 0x61, 0x72, 0x74, 0x4f, 0x66, 0x53, 0x70, 0x65, 0x65, 0x63, 0x68, 0x12,
-	0x23, 0x0a, 0x1f, 0x57, 0x4f, 0x52, 0x44, 0x5f, 0x50, 0x41, 0x52, 0x54, 0x5f, 0x4f, 0x46, 0x5f,
-	0x53, 0x50, 0x45, 0x45, 0x43, 0x48, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
-	0x45, 0x44, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x57, 0x4f, 0x52, 0x44, 0x5f, 0x50, 0x41, 0x52,
-	0x54, 0x5f, 0x4f, 0x46, 0x5f, 0x53, 0x50, 0x45, 0x45, 0x43, 0x48, 0x5f, 0x4e, 0x4f, 0x55, 0x4e,
-	0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x57, 0x4f, 0x52, 0x44, 0x5f, 0x50, 0x41, 0x52, 0x54, 0x5f,
-	0x4f, 0x46, 0x5f, 0x53, 0x50, 0x45, 0x45, 0x43, 0x48, 0x5f, 0x56, 0x45, 0x52, 0x42, 0x10, 0x02,
-	0x12, 0x21, 0x0a, 0x1d, 0x57, 0x4f, 0x52, 0x44, 0x5f, 0x50, 0x41, 0x52, 0x54, 0x5f, 0x4f, 0x46,
-	0x5f, 0x53, 0x50, 0x45, 0x45, 0x43, 0x48, 0x5f, 0x41, 0x44, 0x4a, 0x45, 0x43, 0x54, 0x49, 0x56,
-	0x45, 0x10, 0x03, 0x12, 0x1e, 0x0a, 0x1a, 0x57, 0x4f, 0x52, 0x44, 0x5f, 0x50, 0x41, 0x52, 0x54,
-	0x5f, 0x4f, 0x46, 0x5f, 0x53, 0x50, 0x45, 0x45, 0x43, 0x48, 0x5f, 0x41, 0x44, 0x56, 0x45, 0x52,
-	0x42, 0x10, 0x04, 0x12, 0x1f, 0x0a, 0x1b, 0x57, 0x4f, 0x52, 0x44, 0x5f, 0x50, 0x41, 0x52, 0x54,
-	0x5f, 0x4f, 0x46, 0x5f, 0x53, 0x50, 0x45, 0x45, 0x43, 0x48, 0x5f, 0x50, 0x52, 0x4f, 0x4e, 0x4f,
-	0x55, 0x4e, 0x10, 0x05, 0x12, 0x23, 0x0a, 0x1f, 0x57, 0x4f, 0x52, 0x44, 0x5f, 0x50, 0x41, 0x52,
-	0x54, 0x5f, 0x4f, 0x46, 0x5f, 0x53, 0x50, 0x45, 0x45, 0x43, 0x48, 0x5f, 0x50, 0x52, 0x45, 0x50,
-	0x4f, 0x53, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x06, 0x12, 0x23, 0x0a, 0x1f, 0x57, 0x4f, 0x52,
-	0x44, 0x5f, 0x50, 0x41, 0x52, 0x54, 0x5f, 0x4f, 0x46, 0x5f, 0x53, 0x50, 0x45, 0x45, 0x43, 0x48,
-	0x5f, 0x43, 0x4f, 0x4e, 0x4a, 0x55, 0x4e, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x07, 0x12, 0x24,
-	0x0a, 0x20, 0x57, 0x4f, 0x52, 0x44, 0x5f, 0x50, 0x41, 0x52, 0x54, 0x5f, 0x4f, 0x46, 0x5f, 0x53,
-	0x50, 0x45, 0x45, 0x43, 0x48, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4a, 0x45, 0x43, 0x54, 0x49,
-	0x4f, 0x4e, 0x10, 0x08, 0x12, 0x1f, 0x0a, 0x1b, 0x57, 0x4f, 0x52, 0x44, 0x5f, 0x50, 0x41, 0x52,
-	0x54, 0x5f, 0x4f, 0x46, 0x5f, 0x53, 0x50, 0x45, 0x45, 0x43, 0x48, 0x5f, 0x41, 0x52, 0x54, 0x49,
-	0x43, 0x4c, 0x45, 0x10, 0x09, 0x12, 0x22, 0x0a, 0x1e, 0x57, 0x4f, 0x52, 0x44, 0x5f, 0x50, 0x41,
-	0x52, 0x54, 0x5f, 0x4f, 0x46, 0x5f, 0x53, 0x50, 0x45, 0x45, 0x43, 0x48, 0x5f, 0x44, 0x45, 0x54,
-	0x45, 0x52, 0x4d, 0x49, 0x4e, 0x45, 0x52, 0x10, 0x0a, 0x12, 0x1f, 0x0a, 0x1b, 0x57, 0x4f, 0x52,
-	0x44, 0x5f, 0x50, 0x41, 0x52, 0x54, 0x5f, 0x4f, 0x46, 0x5f, 0x53, 0x50, 0x45, 0x45, 0x43, 0x48,
-	0x5f, 0x4e, 0x55, 0x4d, 0x45, 0x52, 0x41, 0x4c, 0x10, 0x0b, 0x32, 0x91, 0x07, 0x0a, 0x11, 0x56,
+	0x76, 0x65, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x67, 0x6e, 0x6f, 0x72,
+	0x65, 0x5f, 0x74, 0x6f, 0x6e, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x67,
+	0x6e, 0x6f, 0x72, 0x65, 0x54, 0x6f, 0x6e, 0x65, 0x22, 0x6e, 0x0a, 0x26, 0x56, 0x6f, 0x63, 0x61,
+	0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2e, 0x0a, 0x09, 0x68, 0x61, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31,
+	0x2e, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x52, 0x08, 0x68, 0x61, 0x6e, 0x43, 0x68, 0x61,
+	0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x2a, 0xb0, 0x03, 0x0a, 0x13, 0x57, 0x6f, 0x72,
+	0x64, 0x44, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x4c, 0x65, 0x76, 0x65, 0x6c,
+	0x12, 0x25, 0x0a, 0x21, 0x57, 0x4f, 0x52, 0x44, 0x5f, 0x44, 0x49, 0x46, 0x46, 0x49, 0x43, 0x55,
+	0x4c, 0x54, 0x59, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x57, 0x4f, 0x52, 0x44, 0x5f,
+	0x44, 0x49, 0x46, 0x46, 0x49, 0x43, 0x55, 0x4c, 0x54, 0x59, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c,
+	0x5f, 0x41, 0x31, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x57, 0x4f, 0x52, 0x44, 0x5f, 0x44, 0x49,
+	0x46, 0x46, 0x49, 0x43, 0x55, 0x4c, 0x54, 0x59, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x41,
+	0x32, 0x10, 0x02, 0x12, 0x1c, 0x0a, 0x18, 0x57, 0x4f, 0x52, 0x44, 0x5f, 0x44, 0x49, 0x46, 0x46,
+	0x49, 0x43, 0x55, 0x4c, 0x54, 0x59, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x42, 0x31, 0x10,
+	0x03, 0x12, 0x1c, 0x0a, 0x18, 0x57, 0x4f, 0x52, 0x44, 0x5f, 0x44, 0x49, 0x46, 0x46, 0x49, 0x43,
+	0x55, 0x4c, 0x54, 0x59, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x42, 0x32, 0x10, 0x04, 0x12,
+	0x1c, 0x0a, 0x18, 0x57, 0x4f, 0x52, 0x44, 0x5f, 0x44, 0x49, 0x46, 0x46, 0x49, 0x43, 0x55, 0x4c,
+	0x54, 0x59, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x43, 0x31, 0x10, 0x05, 0x12, 0x1c, 0x0a,
+	0x18, 0x57, 0x4f, 0x52, 0x44, 0x5f, 0x44, 0x49, 0x46, 0x46, 0x49, 0x43, 0x55, 0x4c, 0x54, 0x59,
+	0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x43, 0x32, 0x10, 0x06, 0x12, 0x1e, 0x0a, 0x1a, 0x57,
+	0x4f, 0x52, 0x44, 0x5f, 0x44, 0x49, 0x46, 0x46, 0x49, 0x43, 0x55, 0x4c, 0x54, 0x59, 0x5f, 0x4c,
+	0x45, 0x56, 0x45, 0x4c, 0x5f, 0x48, 0x53, 0x4b, 0x31, 0x10, 0x0b, 0x12, 0x1e, 0x0a, 0x1a, 0x57,
+	0x4f, 0x52, 0x44, 0x5f, 0x44, 0x49, 0x46, 0x46, 0x49, 0x43, 0x55, 0x4c, 0x54, 0x59, 0x5f, 0x4c,
+	0x45, 0x56, 0x45, 0x4c, 0x5f, 0x48, 0x53, 0x4b, 0x32, 0x10, 0x0c, 0x12, 0x1e, 0x0a, 0x1a, 0x57,
+	0x4f, 0x52, 0x44, 0x5f, 0x44, 0x49, 0x46, 0x46, 0x49, 0x43, 0x55, 0x4c, 0x54, 0x59, 0x5f, 0x4c,
+	0x45, 0x56, 0x45, 0x4c, 0x5f, 0x48, 0x53, 0x4b, 0x33, 0x10, 0x0d, 0x12, 0x1e, 0x0a, 0x1a, 0x57,
+	0x4f, 0x52, 0x44, 0x5f, 0x44, 0x49, 0x46, 0x46, 0x49, 0x43, 0x55, 0x4c, 0x54, 0x59, 0x5f, 0x4c,
+	0x45, 0x56, 0x45, 0x4c, 0x5f, 0x48, 0x53, 0x4b, 0x34, 0x10, 0x0e, 0x12, 0x1e, 0x0a, 0x1a, 0x57,
+	0x4f, 0x52, 0x44, 0x5f, 0x44, 0x49, 0x46, 0x46, 0x49, 0x43, 0x55, 0x4c, 0x54, 0x59, 0x5f, 0x4c,
+	0x45, 0x56, 0x45, 0x4c, 0x5f, 0x48, 0x53, 0x4b, 0x35, 0x10, 0x0f, 0x12, 0x1e, 0x0a, 0x1a, 0x57,
+	0x4f, 0x52, 0x44, 0x5f, 0x44, 0x49, 0x46, 0x46, 0x49, 0x43, 0x55, 0x4c, 0x54, 0x59, 0x5f, 0x4c,
+	0x45, 0x56, 0x45, 0x4c, 0x5f, 0x48, 0x53, 0x4b, 0x36, 0x10, 0x10, 0x2a, 0xad, 0x03, 0x0a, 0x10,
+	0x57, 0x6f, 0x72, 0x64, 0x50, 0x61, 0x72, 0x74, 0x4f, 0x66, 0x53, 0x70, 0x65, 0x65, 0x63, 0x68,
+	0x12, 0x23, 0x0a, 0x1f, 0x57, 0x4f, 0x52, 0x44, 0x5f, 0x50, 0x41, 0x52, 0x54, 0x5f, 0x4f, 0x46,
+	0x5f, 0x53, 0x50, 0x45, 0x45, 0x43, 0x48, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x57, 0x4f, 0x52, 0x44, 0x5f, 0x50, 0x41,
+	0x52, 0x54, 0x5f, 0x4f, 0x46, 0x5f, 0x53, 0x50, 0x45, 0x45, 0x43, 0x48, 0x5f, 0x4e, 0x4f, 0x55,
+	0x4e, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x57, 0x4f, 0x52, 0x44, 0x5f, 0x50, 0x41, 0x52, 0x54,
+	0x5f, 0x4f, 0x46, 0x5f, 0x53, 0x50, 0x45, 0x45, 0x43, 0x48, 0x5f, 0x56, 0x45, 0x52, 0x42, 0x10,
+	0x02, 0x12, 0x21, 0x0a, 0x1d, 0x57, 0x4f, 0x52, 0x44, 0x5f, 0x50, 0x41, 0x52, 0x54, 0x5f, 0x4f,
+	0x46, 0x5f, 0x53, 0x50, 0x45, 0x45, 0x43, 0x48, 0x5f, 0x41, 0x44, 0x4a, 0x45, 0x43, 0x54, 0x49,
+	0x56, 0x45, 0x10, 0x03, 0x12, 0x1e, 0x0a, 0x1a, 0x57, 0x4f, 0x52, 0x44, 0x5f, 0x50, 0x41, 0x52,
+	0x54, 0x5f, 0x4f, 0x46, 0x5f, 0x53, 0x50, 0x45, 0x45, 0x43, 0x48, 0x5f, 0x41, 0x44, 0x56, 0x45,
+	0x52, 0x42, 0x10, 0x04, 0x12, 0x1f, 0x0a, 0x1b, 0x57, 0x4f, 0x52, 0x44, 0x5f, 0x50, 0x41, 0x52,
+	0x54, 0x5f, 0x4f, 0x46, 0x5f, 0x53, 0x50, 0x45, 0x45, 0x43, 0x48, 0x5f, 0x50, 0x52, 0x4f, 0x4e,
+	0x4f, 0x55, 0x4e, 0x10, 0x05, 0x12, 0x23, 0x0a, 0x1f, 0x57, 0x4f, 0x52, 0x44, 0x5f, 0x50, 0x41,
+	0x52, 0x54, 0x5f, 0x4f, 0x46, 0x5f, 0x53, 0x50, 0x45, 0x45, 0x43, 0x48, 0x5f, 0x50, 0x52, 0x45,
+	0x50, 0x4f, 0x53, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x06, 0x12, 0x23, 0x0a, 0x1f, 0x57, 0x4f,
+	0x52, 0x44, 0x5f, 0x50, 0x41, 0x52, 0x54, 0x5f, 0x4f, 0x46, 0x5f, 0x53, 0x50, 0x45, 0x45, 0x43,
+	0x48, 0x5f, 0x43, 0x4f, 0x4e, 0x4a, 0x55, 0x4e, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x07, 0x12,
+	0x24, 0x0a, 0x20, 0x57, 0x4f, 0x52, 0x44, 0x5f, 0x50, 0x41, 0x52, 0x54, 0x5f, 0x4f, 0x46, 0x5f,
+	0x53, 0x50, 0x45, 0x45, 0x43, 0x48, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4a, 0x45, 0x43, 0x54,
+	0x49, 0x4f, 0x4e, 0x10, 0x08, 0x12, 0x1f, 0x0a, 0x1b, 0x57, 0x4f, 0x52, 0x44, 0x5f, 0x50, 0x41,
+	0x52, 0x54, 0x5f, 0x4f, 0x46, 0x5f, 0x53, 0x50, 0x45, 0x45, 0x43, 0x48, 0x5f, 0x41, 0x52, 0x54,
+	0x49, 0x43, 0x4c, 0x45, 0x10, 0x09, 0x12, 0x22, 0x0a, 0x1e, 0x57, 0x4f, 0x52, 0x44, 0x5f, 0x50,
+	0x41, 0x52, 0x54, 0x5f, 0x4f, 0x46, 0x5f, 0x53, 0x50, 0x45, 0x45, 0x43, 0x48, 0x5f, 0x44, 0x45,
+	0x54, 0x45, 0x52, 0x4d, 0x49, 0x4e, 0x45, 0x52, 0x10, 0x0a, 0x12, 0x1f, 0x0a, 0x1b, 0x57, 0x4f,
+	0x52, 0x44, 0x5f, 0x50, 0x41, 0x52, 0x54, 0x5f, 0x4f, 0x46, 0x5f, 0x53, 0x50, 0x45, 0x45, 0x43,
+	0x48, 0x5f, 0x4e, 0x55, 0x4d, 0x45, 0x52, 0x41, 0x4c, 0x10, 0x0b, 0x2a, 0x97, 0x02, 0x0a, 0x0c,
+	0x57, 0x6f, 0x72, 0x64, 0x46, 0x6f, 0x72, 0x6d, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x1e, 0x0a, 0x1a,
+	0x57, 0x4f, 0x52, 0x44, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15,
+	0x57, 0x4f, 0x52, 0x44, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x50,
+	0x4c, 0x55, 0x52, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x57, 0x4f, 0x52, 0x44, 0x5f,
+	0x46, 0x4f, 0x52, 0x4d, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x50, 0x41, 0x53, 0x54, 0x10, 0x02,
+	0x12, 0x22, 0x0a, 0x1e, 0x57, 0x4f, 0x52, 0x44, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x5f, 0x4b, 0x49,
+	0x4e, 0x44, 0x5f, 0x50, 0x41, 0x53, 0x54, 0x5f, 0x50, 0x41, 0x52, 0x54, 0x49, 0x43, 0x49, 0x50,
+	0x4c, 0x45, 0x10, 0x03, 0x12, 0x25, 0x0a, 0x21, 0x57, 0x4f, 0x52, 0x44, 0x5f, 0x46, 0x4f, 0x52,
+	0x4d, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x50, 0x52, 0x45, 0x53, 0x45, 0x4e, 0x54, 0x5f, 0x50,
+	0x41, 0x52, 0x54, 0x49, 0x43, 0x49, 0x50, 0x4c, 0x45, 0x10, 0x04, 0x12, 0x28, 0x0a, 0x24, 0x57,
+	0x4f, 0x52, 0x44, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x54, 0x48,
+	0x49, 0x52, 0x44, 0x5f, 0x50, 0x45, 0x52, 0x53, 0x4f, 0x4e, 0x5f, 0x53, 0x49, 0x4e, 0x47, 0x55,
+	0x4c, 0x41, 0x52, 0x10, 0x05, 0x12, 0x1e, 0x0a, 0x1a, 0x57, 0x4f, 0x52, 0x44, 0x5f, 0x46, 0x4f,
+	0x52, 0x4d, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x41, 0x52, 0x41, 0x54,
+	0x49, 0x56, 0x45, 0x10, 0x06, 0x12, 0x1e, 0x0a, 0x1a, 0x57, 0x4f, 0x52, 0x44, 0x5f, 0x46, 0x4f,
+	0x52, 0x4d, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x53, 0x55, 0x50, 0x45, 0x52, 0x4c, 0x41, 0x54,
+	0x49, 0x56, 0x45, 0x10, 0x07, 0x2a, 0x9e, 0x02, 0x0a, 0x13, 0x4c, 0x65, 0x78, 0x69, 0x63, 0x61,
+	0x6c, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x25, 0x0a,
+	0x21, 0x4c, 0x45, 0x58, 0x49, 0x43, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x4c, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x21, 0x0a, 0x1d, 0x4c, 0x45, 0x58, 0x49, 0x43, 0x41, 0x4c, 0x5f,
+	0x52, 0x45, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x59,
+	0x4e, 0x4f, 0x4e, 0x59, 0x4d, 0x10, 0x01, 0x12, 0x21, 0x0a, 0x1d, 0x4c, 0x45, 0x58, 0x49, 0x43,
+	0x41, 0x4c, 0x5f, 0x52, 0x45, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x41, 0x4e, 0x54, 0x4f, 0x4e, 0x59, 0x4d, 0x10, 0x02, 0x12, 0x26, 0x0a, 0x22, 0x4c, 0x45,
+	0x58, 0x49, 0x43, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x44, 0x45, 0x52, 0x49, 0x56, 0x45, 0x44, 0x5f, 0x46, 0x52, 0x4f, 0x4d,
+	0x10, 0x03, 0x12, 0x25, 0x0a, 0x21, 0x4c, 0x45, 0x58, 0x49, 0x43, 0x41, 0x4c, 0x5f, 0x52, 0x45,
+	0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x57, 0x4f, 0x52, 0x44,
+	0x5f, 0x46, 0x41, 0x4d, 0x49, 0x4c, 0x59, 0x10, 0x04, 0x12, 0x25, 0x0a, 0x21, 0x4c, 0x45, 0x58,
+	0x49, 0x43, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x43, 0x4f, 0x4c, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x05,
+	0x12, 0x24, 0x0a, 0x20, 0x4c, 0x45, 0x58, 0x49, 0x43, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x4c, 0x41,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x55, 0x53,
+	0x41, 0x42, 0x4c, 0x45, 0x10, 0x06, 0x32, 0xd2, 0x1f, 0x0a, 0x11, 0x56, 0x6f, 0x63, 0x61, 0x62,
+	0x75, 0x6c, 0x61, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x77, 0x0a, 0x03,
+	0x47, 0x65, 0x74, 0x12, 0x25, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x56,
 	0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x12, 0x77, 0x0a, 0x03, 0x47, 0x65, 0x74, 0x12, 0x25, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x76, 0x31, 0x2e, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75,
-	0x6c, 0x61, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x47, 0x65, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61,
-	0x72, 0x69, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x75, 0x0a, 0x04, 0x4c, 0x69, 0x73,
-	0x74, 0x12, 0x26, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f, 0x63,
-	0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x69,
-	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x61, 0x70, 0x69,
+	0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x61, 0x70, 0x69,
 	0x2f, 0x76, 0x31, 0x2f, 0x76, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x65, 0x73,
-	0x12, 0x9c, 0x01, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x4d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0x12, 0x30, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x56,
-	0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31,
+	0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x75, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x26, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c,
+	0x61, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31,
 	0x2e, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f,
-	0x12, 0x1d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x6f, 0x63, 0x61, 0x62, 0x75,
-	0x6c, 0x61, 0x72, 0x69, 0x65, 0x73, 0x2f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12,
-	0x94, 0x01, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x12,
-	0x2d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f, 0x63, 0x61, 0x62,
-	0x75, 0x6c, 0x61, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74,
-	0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e,
+	0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
+	0x76, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12, 0x82, 0x01, 0x0a,
+	0x06, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x28, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x76, 0x31, 0x2e, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x29, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f, 0x63,
+	0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x6f,
+	0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x65, 0x73, 0x2f, 0x73, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x12, 0x82, 0x01, 0x0a, 0x06, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x12, 0x28, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61,
+	0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76,
+	0x31, 0x2e, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x76, 0x31, 0x2f, 0x76, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x65, 0x73, 0x2f,
+	0x6c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x12, 0x7e, 0x0a, 0x06, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x12, 0x28, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f, 0x63, 0x61,
+	0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x3a, 0x01, 0x2a,
+	0x22, 0x14, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x6f, 0x63, 0x61, 0x62, 0x75,
+	0x6c, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12, 0x83, 0x01, 0x0a, 0x06, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x12, 0x28, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f, 0x63,
+	0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72,
+	0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x3a, 0x01,
+	0x2a, 0x1a, 0x19, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x6f, 0x63, 0x61, 0x62,
+	0x75, 0x6c, 0x61, 0x72, 0x69, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x80, 0x01, 0x0a,
+	0x06, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x28, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x76, 0x31, 0x2e, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x29, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f, 0x63,
+	0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1b, 0x2a, 0x19, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x6f,
+	0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12,
+	0x9c, 0x01, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x12, 0x30, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f,
+	0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x47,
+	0x65, 0x74, 0x41, 0x6c, 0x6c, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e,
+	0x56, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12,
+	0x1d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c,
+	0x61, 0x72, 0x69, 0x65, 0x73, 0x2f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x94,
+	0x01, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x12, 0x2d,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75,
 	0x6c, 0x61, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x48,
-	0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x12, 0x1e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
-	0x76, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x65, 0x73, 0x2f, 0x68, 0x61, 0x6e,
-	0x2d, 0x63, 0x68, 0x61, 0x72, 0x73, 0x12, 0x9a, 0x01, 0x0a, 0x0b, 0x42, 0x61, 0x74, 0x63, 0x68,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x2d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76,
+	0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c,
+	0x61, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x61,
+	0x6e, 0x43, 0x68, 0x61, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x20, 0x12, 0x1e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x76,
+	0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x65, 0x73, 0x2f, 0x68, 0x61, 0x6e, 0x2d,
+	0x63, 0x68, 0x61, 0x72, 0x73, 0x12, 0x98, 0x01, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x48, 0x61, 0x6e,
+	0x43, 0x68, 0x61, 0x72, 0x12, 0x2c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e,
+	0x56, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x47, 0x65, 0x74, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f,
+	0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x47,
+	0x65, 0x74, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x12, 0x25, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x76, 0x31, 0x2f, 0x76, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x65, 0x73, 0x2f,
+	0x68, 0x61, 0x6e, 0x2d, 0x63, 0x68, 0x61, 0x72, 0x73, 0x2f, 0x6c, 0x6f, 0x6f, 0x6b, 0x75, 0x70,
+	0x12, 0xa1, 0x01, 0x0a, 0x0d, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x48, 0x61, 0x6e, 0x43, 0x68,
+	0x61, 0x72, 0x12, 0x2f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f,
+	0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x56,
+	0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x12, 0x25, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72,
+	0x69, 0x65, 0x73, 0x2f, 0x68, 0x61, 0x6e, 0x2d, 0x63, 0x68, 0x61, 0x72, 0x73, 0x2f, 0x73, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x12, 0x9d, 0x01, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x48,
+	0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x12, 0x2f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76,
 	0x31, 0x2e, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31,
-	0x2e, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x3a, 0x01, 0x2a,
-	0x22, 0x21, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x6f, 0x63, 0x61, 0x62, 0x75,
-	0x6c, 0x61, 0x72, 0x69, 0x65, 0x73, 0x2f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x2d, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x12, 0xb8, 0x01, 0x0a, 0x12, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x12, 0x34, 0x2e, 0x70, 0x72, 0x6f,
+	0x69, 0x63, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x76, 0x31, 0x2e, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x23, 0x3a, 0x01, 0x2a, 0x22, 0x1e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x6f,
+	0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x65, 0x73, 0x2f, 0x68, 0x61, 0x6e, 0x2d, 0x63,
+	0x68, 0x61, 0x72, 0x73, 0x12, 0xa2, 0x01, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48,
+	0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x12, 0x2f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76,
+	0x31, 0x2e, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x76, 0x31, 0x2e, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x28, 0x3a, 0x01, 0x2a, 0x1a, 0x23, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x6f,
+	0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x65, 0x73, 0x2f, 0x68, 0x61, 0x6e, 0x2d, 0x63,
+	0x68, 0x61, 0x72, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x9f, 0x01, 0x0a, 0x0d, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x12, 0x2f, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72,
+	0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x48, 0x61,
+	0x6e, 0x43, 0x68, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61,
+	0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x48,
+	0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x2a, 0x23, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
+	0x76, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x65, 0x73, 0x2f, 0x68, 0x61, 0x6e,
+	0x2d, 0x63, 0x68, 0x61, 0x72, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x9a, 0x01, 0x0a, 0x0b,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x2d, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72,
+	0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79,
 	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x35, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f, 0x63, 0x61,
-	0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x42, 0x61, 0x74,
-	0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x35, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x3a,
-	0x01, 0x2a, 0x22, 0x2a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x6f, 0x63, 0x61,
-	0x62, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x65, 0x73, 0x2f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x2d, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x2d, 0x68, 0x61, 0x6e, 0x2d, 0x63, 0x68, 0x61, 0x72, 0x42, 0x31,
-	0x5a, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x61, 0x7a,
-	0x79, 0x6a, 0x65, 0x61, 0x6e, 0x2f, 0x73, 0x6c, 0x61, 0x32, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x76, 0x31, 0x3b, 0x70, 0x62, 0xba, 0x02, 0x04, 0x53, 0x4c, 0x41,
-	0x32, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x26, 0x3a, 0x01, 0x2a, 0x22, 0x21, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x76,
+	0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x65, 0x73, 0x2f, 0x62, 0x61, 0x74, 0x63,
+	0x68, 0x2d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0xb8, 0x01, 0x0a, 0x12, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x12,
+	0x34, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f, 0x63, 0x61, 0x62,
+	0x75, 0x6c, 0x61, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31,
+	0x2e, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x48, 0x61, 0x6e,
+	0x43, 0x68, 0x61, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x35, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x2f, 0x3a, 0x01, 0x2a, 0x22, 0x2a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
+	0x2f, 0x76, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x65, 0x73, 0x2f, 0x62, 0x61,
+	0x74, 0x63, 0x68, 0x2d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x2d, 0x68, 0x61, 0x6e, 0x2d, 0x63,
+	0x68, 0x61, 0x72, 0x12, 0xd5, 0x01, 0x0a, 0x19, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x53, 0x74, 0x72, 0x6f, 0x6b, 0x65,
+	0x73, 0x12, 0x3b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f, 0x63,
+	0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72,
+	0x53, 0x74, 0x72, 0x6f, 0x6b, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3c,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75,
+	0x6c, 0x61, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x53, 0x74, 0x72,
+	0x6f, 0x6b, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3d, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x37, 0x3a, 0x01, 0x2a, 0x22, 0x32, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
+	0x2f, 0x76, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x65, 0x73, 0x2f, 0x62, 0x61,
+	0x74, 0x63, 0x68, 0x2d, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x2d, 0x68, 0x61, 0x6e, 0x2d, 0x63,
+	0x68, 0x61, 0x72, 0x2d, 0x73, 0x74, 0x72, 0x6f, 0x6b, 0x65, 0x73, 0x12, 0xc0, 0x01, 0x0a, 0x14,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x57, 0x6f, 0x72, 0x64, 0x46,
+	0x6f, 0x72, 0x6d, 0x73, 0x12, 0x36, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e,
+	0x56, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x57, 0x6f, 0x72, 0x64,
+	0x46, 0x6f, 0x72, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61,
+	0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x57, 0x6f, 0x72, 0x64, 0x46, 0x6f, 0x72, 0x6d, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x37, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x31, 0x3a, 0x01, 0x2a,
+	0x22, 0x2c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x6f, 0x63, 0x61, 0x62, 0x75,
+	0x6c, 0x61, 0x72, 0x69, 0x65, 0x73, 0x2f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x2d, 0x69, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x2d, 0x77, 0x6f, 0x72, 0x64, 0x2d, 0x66, 0x6f, 0x72, 0x6d, 0x73, 0x12, 0x9c,
+	0x01, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x2e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f, 0x63, 0x61, 0x62,
+	0x75, 0x6c, 0x61, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x47, 0x65, 0x74, 0x52,
+	0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f, 0x63, 0x61, 0x62,
+	0x75, 0x6c, 0x61, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x47, 0x65, 0x74, 0x52,
+	0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x12, 0x23, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x31, 0x2f, 0x76, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x65, 0x73, 0x2f, 0x7b,
+	0x69, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0xa0, 0x01,
+	0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x30, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f, 0x63, 0x61,
+	0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x31, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f,
+	0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x3a, 0x01, 0x2a,
+	0x22, 0x1e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x6f, 0x63, 0x61, 0x62, 0x75,
+	0x6c, 0x61, 0x72, 0x69, 0x65, 0x73, 0x2f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0xa2, 0x01, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x30, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x56,
+	0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31,
+	0x2e, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25,
+	0x2a, 0x23, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x6f, 0x63, 0x61, 0x62, 0x75,
+	0x6c, 0x61, 0x72, 0x69, 0x65, 0x73, 0x2f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0xbb, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x48, 0x61, 0x6e,
+	0x43, 0x68, 0x61, 0x72, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x35, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c,
+	0x61, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x47, 0x65, 0x74, 0x48, 0x61, 0x6e,
+	0x43, 0x68, 0x61, 0x72, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e,
+	0x56, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x47, 0x65, 0x74, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x52, 0x65, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x35, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x2f, 0x12, 0x2d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x6f,
+	0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x65, 0x73, 0x2f, 0x68, 0x61, 0x6e, 0x2d, 0x63,
+	0x68, 0x61, 0x72, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0xbf, 0x01, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x48, 0x61,
+	0x6e, 0x43, 0x68, 0x61, 0x72, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x37, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c,
+	0x61, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x38, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76,
+	0x31, 0x2e, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72,
+	0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x33, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2d, 0x3a, 0x01, 0x2a, 0x22, 0x28, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x65,
+	0x73, 0x2f, 0x68, 0x61, 0x6e, 0x2d, 0x63, 0x68, 0x61, 0x72, 0x73, 0x2f, 0x72, 0x65, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0xc1, 0x01, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x37, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f, 0x63, 0x61, 0x62,
+	0x75, 0x6c, 0x61, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x48, 0x61, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x38, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x48, 0x61, 0x6e, 0x43, 0x68,
+	0x61, 0x72, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x35, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x2a, 0x2d, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x76, 0x31, 0x2f, 0x76, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x65, 0x73,
+	0x2f, 0x68, 0x61, 0x6e, 0x2d, 0x63, 0x68, 0x61, 0x72, 0x73, 0x2f, 0x72, 0x65, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0xbf, 0x01, 0x0a, 0x14, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x36, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f,
+	0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61, 0x72, 0x79,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x36, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x30, 0x3a, 0x01, 0x2a, 0x22, 0x2b,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x6f, 0x63, 0x61, 0x62, 0x75, 0x6c, 0x61,
+	0x72, 0x69, 0x65, 0x73, 0x2f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x2d, 0x69, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x2d, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x31, 0x5a, 0x28, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x61, 0x7a, 0x79, 0x6a, 0x65,
+	0x61, 0x6e, 0x2f, 0x73, 0x6c, 0x61, 0x32, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2f, 0x76, 0x31, 0x3b, 0x70, 0x62, 0xba, 0x02, 0x04, 0x53, 0x4c, 0x41, 0x32, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 })
 
 var (
@@ -1541,63 +5053,201 @@ func file_proto_v1_vocabulary_proto_rawDescGZIP() []byte {
 	return file_proto_v1_vocabulary_proto_rawDescData
 }
 
-var file_proto_v1_vocabulary_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_proto_v1_vocabulary_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_proto_v1_vocabulary_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_proto_v1_vocabulary_proto_msgTypes = make([]protoimpl.MessageInfo, 69)
 var file_proto_v1_vocabulary_proto_goTypes = []any{
-	(WordDifficultyLevel)(0),                            // 0: proto.v1.WordDifficultyLevel
-	(WordPartOfSpeech)(0),                               // 1: proto.v1.WordPartOfSpeech
-	(*WordDefinition)(nil),                              // 2: proto.v1.WordDefinition
-	(*Word)(nil),                                        // 3: proto.v1.Word
-	(*HanChar)(nil),                                     // 4: proto.v1.HanChar
-	(*VocabularyServiceGetRequest)(nil),                 // 5: proto.v1.VocabularyServiceGetRequest
-	(*VocabularyServiceGetResponse)(nil),                // 6: proto.v1.VocabularyServiceGetResponse
-	(*VocabularyServiceListRequest)(nil),                // 7: proto.v1.VocabularyServiceListRequest
-	(*VocabularyServiceListResponse)(nil),               // 8: proto.v1.VocabularyServiceListResponse
-	(*VocabularyServiceAllTagsRequest)(nil),             // 9: proto.v1.VocabularyServiceAllTagsRequest
-	(*VocabularyServiceAllTagsResponse)(nil),            // 10: proto.v1.VocabularyServiceAllTagsResponse
-	(*VocabularyServiceListHanCharRequest)(nil),         // 11: proto.v1.VocabularyServiceListHanCharRequest
-	(*VocabularyServiceListHanCharResponse)(nil),        // 12: proto.v1.VocabularyServiceListHanCharResponse
-	(*VocabularyServiceAllCategoriesRequest)(nil),       // 13: proto.v1.VocabularyServiceAllCategoriesRequest
-	(*VocabularyServiceAllCategoriesResponse)(nil),      // 14: proto.v1.VocabularyServiceAllCategoriesResponse
-	(*VocabularyServiceGetAllMetadataRequest)(nil),      // 15: proto.v1.VocabularyServiceGetAllMetadataRequest
-	(*VocabularyServiceGetAllMetadataResponse)(nil),     // 16: proto.v1.VocabularyServiceGetAllMetadataResponse
-	(*VocabularyServiceBatchCreateRequest)(nil),         // 17: proto.v1.VocabularyServiceBatchCreateRequest
-	(*VocabularyServiceBatchCreateResponse)(nil),        // 18: proto.v1.VocabularyServiceBatchCreateResponse
-	(*VocabularyServiceBatchCreateHanCharRequest)(nil),  // 19: proto.v1.VocabularyServiceBatchCreateHanCharRequest
-	(*VocabularyServiceBatchCreateHanCharResponse)(nil), // 20: proto.v1.VocabularyServiceBatchCreateHanCharResponse
-	(*timestamppb.Timestamp)(nil),                       // 21: google.protobuf.Timestamp
+	(WordDifficultyLevel)(0),                                   // 0: proto.v1.WordDifficultyLevel
+	(WordPartOfSpeech)(0),                                      // 1: proto.v1.WordPartOfSpeech
+	(WordFormKind)(0),                                          // 2: proto.v1.WordFormKind
+	(LexicalRelationType)(0),                                   // 3: proto.v1.LexicalRelationType
+	(*WordDefinition)(nil),                                     // 4: proto.v1.WordDefinition
+	(*Word)(nil),                                               // 5: proto.v1.Word
+	(*HanChar)(nil),                                            // 6: proto.v1.HanChar
+	(*StrokePoint)(nil),                                        // 7: proto.v1.StrokePoint
+	(*StrokePolyline)(nil),                                     // 8: proto.v1.StrokePolyline
+	(*HanCharStrokeOrder)(nil),                                 // 9: proto.v1.HanCharStrokeOrder
+	(*VocabularyServiceGetRequest)(nil),                        // 10: proto.v1.VocabularyServiceGetRequest
+	(*VocabularyServiceGetResponse)(nil),                       // 11: proto.v1.VocabularyServiceGetResponse
+	(*WordForm)(nil),                                           // 12: proto.v1.WordForm
+	(*WordExampleInflection)(nil),                              // 13: proto.v1.WordExampleInflection
+	(*VocabularyServiceLookupRequest)(nil),                     // 14: proto.v1.VocabularyServiceLookupRequest
+	(*VocabularyServiceLookupResponse)(nil),                    // 15: proto.v1.VocabularyServiceLookupResponse
+	(*VocabularyServiceListRequest)(nil),                       // 16: proto.v1.VocabularyServiceListRequest
+	(*VocabularyServiceListResponse)(nil),                      // 17: proto.v1.VocabularyServiceListResponse
+	(*VocabularyServiceAllTagsRequest)(nil),                    // 18: proto.v1.VocabularyServiceAllTagsRequest
+	(*VocabularyServiceAllTagsResponse)(nil),                   // 19: proto.v1.VocabularyServiceAllTagsResponse
+	(*VocabularyServiceListHanCharRequest)(nil),                // 20: proto.v1.VocabularyServiceListHanCharRequest
+	(*VocabularyServiceListHanCharResponse)(nil),               // 21: proto.v1.VocabularyServiceListHanCharResponse
+	(*VocabularyServiceAllCategoriesRequest)(nil),              // 22: proto.v1.VocabularyServiceAllCategoriesRequest
+	(*VocabularyServiceAllCategoriesResponse)(nil),             // 23: proto.v1.VocabularyServiceAllCategoriesResponse
+	(*VocabularyServiceGetAllMetadataRequest)(nil),             // 24: proto.v1.VocabularyServiceGetAllMetadataRequest
+	(*VocabularyServiceGetAllMetadataResponse)(nil),            // 25: proto.v1.VocabularyServiceGetAllMetadataResponse
+	(*VocabularyServiceBatchCreateRequest)(nil),                // 26: proto.v1.VocabularyServiceBatchCreateRequest
+	(*VocabularyServiceBatchCreateResponse)(nil),               // 27: proto.v1.VocabularyServiceBatchCreateResponse
+	(*VocabularyServiceBatchCreateHanCharRequest)(nil),         // 28: proto.v1.VocabularyServiceBatchCreateHanCharRequest
+	(*VocabularyServiceBatchCreateHanCharResponse)(nil),        // 29: proto.v1.VocabularyServiceBatchCreateHanCharResponse
+	(*VocabularyServiceGetHanCharRequest)(nil),                 // 30: proto.v1.VocabularyServiceGetHanCharRequest
+	(*VocabularyServiceGetHanCharResponse)(nil),                // 31: proto.v1.VocabularyServiceGetHanCharResponse
+	(*HanCharStrokeData)(nil),                                  // 32: proto.v1.HanCharStrokeData
+	(*VocabularyServiceBatchImportHanCharStrokesRequest)(nil),  // 33: proto.v1.VocabularyServiceBatchImportHanCharStrokesRequest
+	(*VocabularyServiceBatchImportHanCharStrokesResponse)(nil), // 34: proto.v1.VocabularyServiceBatchImportHanCharStrokesResponse
+	(*WordFormsData)(nil),                                      // 35: proto.v1.WordFormsData
+	(*VocabularyServiceBatchImportWordFormsRequest)(nil),       // 36: proto.v1.VocabularyServiceBatchImportWordFormsRequest
+	(*VocabularyServiceBatchImportWordFormsResponse)(nil),      // 37: proto.v1.VocabularyServiceBatchImportWordFormsResponse
+	(*WordRelation)(nil),                                       // 38: proto.v1.WordRelation
+	(*HanCharRelation)(nil),                                    // 39: proto.v1.HanCharRelation
+	(*VocabularyServiceGetRelationsRequest)(nil),               // 40: proto.v1.VocabularyServiceGetRelationsRequest
+	(*VocabularyServiceGetRelationsResponse)(nil),              // 41: proto.v1.VocabularyServiceGetRelationsResponse
+	(*VocabularyServiceCreateRelationRequest)(nil),             // 42: proto.v1.VocabularyServiceCreateRelationRequest
+	(*VocabularyServiceCreateRelationResponse)(nil),            // 43: proto.v1.VocabularyServiceCreateRelationResponse
+	(*VocabularyServiceDeleteRelationRequest)(nil),             // 44: proto.v1.VocabularyServiceDeleteRelationRequest
+	(*VocabularyServiceDeleteRelationResponse)(nil),            // 45: proto.v1.VocabularyServiceDeleteRelationResponse
+	(*VocabularyServiceGetHanCharRelationsRequest)(nil),        // 46: proto.v1.VocabularyServiceGetHanCharRelationsRequest
+	(*VocabularyServiceGetHanCharRelationsResponse)(nil),       // 47: proto.v1.VocabularyServiceGetHanCharRelationsResponse
+	(*VocabularyServiceCreateHanCharRelationRequest)(nil),      // 48: proto.v1.VocabularyServiceCreateHanCharRelationRequest
+	(*VocabularyServiceCreateHanCharRelationResponse)(nil),     // 49: proto.v1.VocabularyServiceCreateHanCharRelationResponse
+	(*VocabularyServiceDeleteHanCharRelationRequest)(nil),      // 50: proto.v1.VocabularyServiceDeleteHanCharRelationRequest
+	(*VocabularyServiceDeleteHanCharRelationResponse)(nil),     // 51: proto.v1.VocabularyServiceDeleteHanCharRelationResponse
+	(*RelationData)(nil),                                       // 52: proto.v1.RelationData
+	(*VocabularyServiceBatchImportRelationsRequest)(nil),       // 53: proto.v1.VocabularyServiceBatchImportRelationsRequest
+	(*VocabularyServiceBatchImportRelationsResponse)(nil),      // 54: proto.v1.VocabularyServiceBatchImportRelationsResponse
+	(*VocabularyServiceSearchRequest)(nil),                     // 55: proto.v1.VocabularyServiceSearchRequest
+	(*WordSearchHighlight)(nil),                                // 56: proto.v1.WordSearchHighlight
+	(*WordSearchResult)(nil),                                   // 57: proto.v1.WordSearchResult
+	(*VocabularyServiceSearchResponse)(nil),                    // 58: proto.v1.VocabularyServiceSearchResponse
+	(*VocabularyServiceCreateRequest)(nil),                     // 59: proto.v1.VocabularyServiceCreateRequest
+	(*VocabularyServiceCreateResponse)(nil),                    // 60: proto.v1.VocabularyServiceCreateResponse
+	(*VocabularyServiceUpdateRequest)(nil),                     // 61: proto.v1.VocabularyServiceUpdateRequest
+	(*VocabularyServiceUpdateResponse)(nil),                    // 62: proto.v1.VocabularyServiceUpdateResponse
+	(*VocabularyServiceDeleteRequest)(nil),                     // 63: proto.v1.VocabularyServiceDeleteRequest
+	(*VocabularyServiceDeleteResponse)(nil),                    // 64: proto.v1.VocabularyServiceDeleteResponse
+	(*VocabularyServiceCreateHanCharRequest)(nil),              // 65: proto.v1.VocabularyServiceCreateHanCharRequest
+	(*VocabularyServiceCreateHanCharResponse)(nil),             // 66: proto.v1.VocabularyServiceCreateHanCharResponse
+	(*VocabularyServiceUpdateHanCharRequest)(nil),              // 67: proto.v1.VocabularyServiceUpdateHanCharRequest
+	(*VocabularyServiceUpdateHanCharResponse)(nil),             // 68: proto.v1.VocabularyServiceUpdateHanCharResponse
+	(*VocabularyServiceDeleteHanCharRequest)(nil),              // 69: proto.v1.VocabularyServiceDeleteHanCharRequest
+	(*VocabularyServiceDeleteHanCharResponse)(nil),             // 70: proto.v1.VocabularyServiceDeleteHanCharResponse
+	(*VocabularyServiceSearchHanCharRequest)(nil),              // 71: proto.v1.VocabularyServiceSearchHanCharRequest
+	(*VocabularyServiceSearchHanCharResponse)(nil),             // 72: proto.v1.VocabularyServiceSearchHanCharResponse
+	(*timestamppb.Timestamp)(nil),                              // 73: google.protobuf.Timestamp
 }
 var file_proto_v1_vocabulary_proto_depIdxs = []int32{
 	1,  // 0: proto.v1.WordDefinition.part_of_speech:type_name -> proto.v1.WordPartOfSpeech
-	21, // 1: proto.v1.WordDefinition.created_at:type_name -> google.protobuf.Timestamp
-	21, // 2: proto.v1.WordDefinition.updated_at:type_name -> google.protobuf.Timestamp
+	73, // 1: proto.v1.WordDefinition.created_at:type_name -> google.protobuf.Timestamp
+	73, // 2: proto.v1.WordDefinition.updated_at:type_name -> google.protobuf.Timestamp
 	0,  // 3: proto.v1.Word.level:type_name -> proto.v1.WordDifficultyLevel
-	2,  // 4: proto.v1.Word.definitions:type_name -> proto.v1.WordDefinition
+	4,  // 4: proto.v1.Word.definitions:type_name -> proto.v1.WordDefinition
 	0,  // 5: proto.v1.HanChar.level:type_name -> proto.v1.WordDifficultyLevel
-	3,  // 6: proto.v1.VocabularyServiceGetResponse.word:type_name -> proto.v1.Word
-	0,  // 7: proto.v1.VocabularyServiceListRequest.level:type_name -> proto.v1.WordDifficultyLevel
-	3,  // 8: proto.v1.VocabularyServiceListResponse.words:type_name -> proto.v1.Word
-	0,  // 9: proto.v1.VocabularyServiceListHanCharRequest.level:type_name -> proto.v1.WordDifficultyLevel
-	4,  // 10: proto.v1.VocabularyServiceListHanCharResponse.han_chars:type_name -> proto.v1.HanChar
-	3,  // 11: proto.v1.VocabularyServiceBatchCreateRequest.words:type_name -> proto.v1.Word
-	4,  // 12: proto.v1.VocabularyServiceBatchCreateHanCharRequest.han_chars:type_name -> proto.v1.HanChar
-	5,  // 13: proto.v1.VocabularyService.Get:input_type -> proto.v1.VocabularyServiceGetRequest
-	7,  // 14: proto.v1.VocabularyService.List:input_type -> proto.v1.VocabularyServiceListRequest
-	15, // 15: proto.v1.VocabularyService.GetAllMetadata:input_type -> proto.v1.VocabularyServiceGetAllMetadataRequest
-	11, // 16: proto.v1.VocabularyService.ListHanChar:input_type -> proto.v1.VocabularyServiceListHanCharRequest
-	17, // 17: proto.v1.VocabularyService.BatchCreate:input_type -> proto.v1.VocabularyServiceBatchCreateRequest
-	19, // 18: proto.v1.VocabularyService.BatchCreateHanChar:input_type -> proto.v1.VocabularyServiceBatchCreateHanCharRequest
-	6,  // 19: proto.v1.VocabularyService.Get:output_type -> proto.v1.VocabularyServiceGetResponse
-	8,  // 20: proto.v1.VocabularyService.List:output_type -> proto.v1.VocabularyServiceListResponse
-	16, // 21: proto.v1.VocabularyService.GetAllMetadata:output_type -> proto.v1.VocabularyServiceGetAllMetadataResponse
-	12, // 22: proto.v1.VocabularyService.ListHanChar:output_type -> proto.v1.VocabularyServiceListHanCharResponse
-	18, // 23: proto.v1.VocabularyService.BatchCreate:output_type -> proto.v1.VocabularyServiceBatchCreateResponse
-	20, // 24: proto.v1.VocabularyService.BatchCreateHanChar:output_type -> proto.v1.VocabularyServiceBatchCreateHanCharResponse
-	19, // [19:25] is the sub-list for method output_type
-	13, // [13:19] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	9,  // 6: proto.v1.HanChar.stroke_order:type_name -> proto.v1.HanCharStrokeOrder
+	7,  // 7: proto.v1.StrokePolyline.points:type_name -> proto.v1.StrokePoint
+	8,  // 8: proto.v1.HanCharStrokeOrder.medians:type_name -> proto.v1.StrokePolyline
+	5,  // 9: proto.v1.VocabularyServiceGetResponse.word:type_name -> proto.v1.Word
+	12, // 10: proto.v1.VocabularyServiceGetResponse.forms:type_name -> proto.v1.WordForm
+	13, // 11: proto.v1.VocabularyServiceGetResponse.inflections:type_name -> proto.v1.WordExampleInflection
+	2,  // 12: proto.v1.WordForm.kind:type_name -> proto.v1.WordFormKind
+	2,  // 13: proto.v1.WordExampleInflection.kind:type_name -> proto.v1.WordFormKind
+	5,  // 14: proto.v1.VocabularyServiceLookupResponse.word:type_name -> proto.v1.Word
+	12, // 15: proto.v1.VocabularyServiceLookupResponse.matched_form:type_name -> proto.v1.WordForm
+	12, // 16: proto.v1.VocabularyServiceLookupResponse.forms:type_name -> proto.v1.WordForm
+	13, // 17: proto.v1.VocabularyServiceLookupResponse.inflections:type_name -> proto.v1.WordExampleInflection
+	0,  // 18: proto.v1.VocabularyServiceListRequest.level:type_name -> proto.v1.WordDifficultyLevel
+	5,  // 19: proto.v1.VocabularyServiceListResponse.words:type_name -> proto.v1.Word
+	0,  // 20: proto.v1.VocabularyServiceListHanCharRequest.level:type_name -> proto.v1.WordDifficultyLevel
+	6,  // 21: proto.v1.VocabularyServiceListHanCharResponse.han_chars:type_name -> proto.v1.HanChar
+	5,  // 22: proto.v1.VocabularyServiceBatchCreateRequest.words:type_name -> proto.v1.Word
+	6,  // 23: proto.v1.VocabularyServiceBatchCreateHanCharRequest.han_chars:type_name -> proto.v1.HanChar
+	6,  // 24: proto.v1.VocabularyServiceGetHanCharResponse.han_char:type_name -> proto.v1.HanChar
+	9,  // 25: proto.v1.HanCharStrokeData.stroke_order:type_name -> proto.v1.HanCharStrokeOrder
+	32, // 26: proto.v1.VocabularyServiceBatchImportHanCharStrokesRequest.han_chars:type_name -> proto.v1.HanCharStrokeData
+	12, // 27: proto.v1.WordFormsData.forms:type_name -> proto.v1.WordForm
+	35, // 28: proto.v1.VocabularyServiceBatchImportWordFormsRequest.words:type_name -> proto.v1.WordFormsData
+	3,  // 29: proto.v1.WordRelation.type:type_name -> proto.v1.LexicalRelationType
+	5,  // 30: proto.v1.WordRelation.word:type_name -> proto.v1.Word
+	3,  // 31: proto.v1.HanCharRelation.type:type_name -> proto.v1.LexicalRelationType
+	6,  // 32: proto.v1.HanCharRelation.han_char:type_name -> proto.v1.HanChar
+	3,  // 33: proto.v1.VocabularyServiceGetRelationsRequest.types:type_name -> proto.v1.LexicalRelationType
+	5,  // 34: proto.v1.VocabularyServiceGetRelationsResponse.word:type_name -> proto.v1.Word
+	38, // 35: proto.v1.VocabularyServiceGetRelationsResponse.relations:type_name -> proto.v1.WordRelation
+	3,  // 36: proto.v1.VocabularyServiceCreateRelationRequest.type:type_name -> proto.v1.LexicalRelationType
+	38, // 37: proto.v1.VocabularyServiceCreateRelationResponse.relation:type_name -> proto.v1.WordRelation
+	3,  // 38: proto.v1.VocabularyServiceGetHanCharRelationsRequest.types:type_name -> proto.v1.LexicalRelationType
+	6,  // 39: proto.v1.VocabularyServiceGetHanCharRelationsResponse.han_char:type_name -> proto.v1.HanChar
+	39, // 40: proto.v1.VocabularyServiceGetHanCharRelationsResponse.relations:type_name -> proto.v1.HanCharRelation
+	3,  // 41: proto.v1.VocabularyServiceCreateHanCharRelationRequest.type:type_name -> proto.v1.LexicalRelationType
+	39, // 42: proto.v1.VocabularyServiceCreateHanCharRelationResponse.relation:type_name -> proto.v1.HanCharRelation
+	3,  // 43: proto.v1.RelationData.type:type_name -> proto.v1.LexicalRelationType
+	52, // 44: proto.v1.VocabularyServiceBatchImportRelationsRequest.words:type_name -> proto.v1.RelationData
+	52, // 45: proto.v1.VocabularyServiceBatchImportRelationsRequest.han_chars:type_name -> proto.v1.RelationData
+	0,  // 46: proto.v1.VocabularyServiceSearchRequest.level:type_name -> proto.v1.WordDifficultyLevel
+	5,  // 47: proto.v1.WordSearchResult.word:type_name -> proto.v1.Word
+	56, // 48: proto.v1.WordSearchResult.highlights:type_name -> proto.v1.WordSearchHighlight
+	12, // 49: proto.v1.WordSearchResult.matched_form:type_name -> proto.v1.WordForm
+	57, // 50: proto.v1.VocabularyServiceSearchResponse.results:type_name -> proto.v1.WordSearchResult
+	5,  // 51: proto.v1.VocabularyServiceCreateRequest.word:type_name -> proto.v1.Word
+	5,  // 52: proto.v1.VocabularyServiceCreateResponse.word:type_name -> proto.v1.Word
+	5,  // 53: proto.v1.VocabularyServiceUpdateRequest.word:type_name -> proto.v1.Word
+	5,  // 54: proto.v1.VocabularyServiceUpdateResponse.word:type_name -> proto.v1.Word
+	6,  // 55: proto.v1.VocabularyServiceCreateHanCharRequest.han_char:type_name -> proto.v1.HanChar
+	6,  // 56: proto.v1.VocabularyServiceCreateHanCharResponse.han_char:type_name -> proto.v1.HanChar
+	6,  // 57: proto.v1.VocabularyServiceUpdateHanCharRequest.han_char:type_name -> proto.v1.HanChar
+	6,  // 58: proto.v1.VocabularyServiceUpdateHanCharResponse.han_char:type_name -> proto.v1.HanChar
+	0,  // 59: proto.v1.VocabularyServiceSearchHanCharRequest.level:type_name -> proto.v1.WordDifficultyLevel
+	6,  // 60: proto.v1.VocabularyServiceSearchHanCharResponse.han_chars:type_name -> proto.v1.HanChar
+	10, // 61: proto.v1.VocabularyService.Get:input_type -> proto.v1.VocabularyServiceGetRequest
+	16, // 62: proto.v1.VocabularyService.List:input_type -> proto.v1.VocabularyServiceListRequest
+	55, // 63: proto.v1.VocabularyService.Search:input_type -> proto.v1.VocabularyServiceSearchRequest
+	14, // 64: proto.v1.VocabularyService.Lookup:input_type -> proto.v1.VocabularyServiceLookupRequest
+	59, // 65: proto.v1.VocabularyService.Create:input_type -> proto.v1.VocabularyServiceCreateRequest
+	61, // 66: proto.v1.VocabularyService.Update:input_type -> proto.v1.VocabularyServiceUpdateRequest
+	63, // 67: proto.v1.VocabularyService.Delete:input_type -> proto.v1.VocabularyServiceDeleteRequest
+	24, // 68: proto.v1.VocabularyService.GetAllMetadata:input_type -> proto.v1.VocabularyServiceGetAllMetadataRequest
+	20, // 69: proto.v1.VocabularyService.ListHanChar:input_type -> proto.v1.VocabularyServiceListHanCharRequest
+	30, // 70: proto.v1.VocabularyService.GetHanChar:input_type -> proto.v1.VocabularyServiceGetHanCharRequest
+	71, // 71: proto.v1.VocabularyService.SearchHanChar:input_type -> proto.v1.VocabularyServiceSearchHanCharRequest
+	65, // 72: proto.v1.VocabularyService.CreateHanChar:input_type -> proto.v1.VocabularyServiceCreateHanCharRequest
+	67, // 73: proto.v1.VocabularyService.UpdateHanChar:input_type -> proto.v1.VocabularyServiceUpdateHanCharRequest
+	69, // 74: proto.v1.VocabularyService.DeleteHanChar:input_type -> proto.v1.VocabularyServiceDeleteHanCharRequest
+	26, // 75: proto.v1.VocabularyService.BatchCreate:input_type -> proto.v1.VocabularyServiceBatchCreateRequest
+	28, // 76: proto.v1.VocabularyService.BatchCreateHanChar:input_type -> proto.v1.VocabularyServiceBatchCreateHanCharRequest
+	33, // 77: proto.v1.VocabularyService.BatchImportHanCharStrokes:input_type -> proto.v1.VocabularyServiceBatchImportHanCharStrokesRequest
+	36, // 78: proto.v1.VocabularyService.BatchImportWordForms:input_type -> proto.v1.VocabularyServiceBatchImportWordFormsRequest
+	40, // 79: proto.v1.VocabularyService.GetRelations:input_type -> proto.v1.VocabularyServiceGetRelationsRequest
+	42, // 80: proto.v1.VocabularyService.CreateRelation:input_type -> proto.v1.VocabularyServiceCreateRelationRequest
+	44, // 81: proto.v1.VocabularyService.DeleteRelation:input_type -> proto.v1.VocabularyServiceDeleteRelationRequest
+	46, // 82: proto.v1.VocabularyService.GetHanCharRelations:input_type -> proto.v1.VocabularyServiceGetHanCharRelationsRequest
+	48, // 83: proto.v1.VocabularyService.CreateHanCharRelation:input_type -> proto.v1.VocabularyServiceCreateHanCharRelationRequest
+	50, // 84: proto.v1.VocabularyService.DeleteHanCharRelation:input_type -> proto.v1.VocabularyServiceDeleteHanCharRelationRequest
+	53, // 85: proto.v1.VocabularyService.BatchImportRelations:input_type -> proto.v1.VocabularyServiceBatchImportRelationsRequest
+	11, // 86: proto.v1.VocabularyService.Get:output_type -> proto.v1.VocabularyServiceGetResponse
+	17, // 87: proto.v1.VocabularyService.List:output_type -> proto.v1.VocabularyServiceListResponse
+	58, // 88: proto.v1.VocabularyService.Search:output_type -> proto.v1.VocabularyServiceSearchResponse
+	15, // 89: proto.v1.VocabularyService.Lookup:output_type -> proto.v1.VocabularyServiceLookupResponse
+	60, // 90: proto.v1.VocabularyService.Create:output_type -> proto.v1.VocabularyServiceCreateResponse
+	62, // 91: proto.v1.VocabularyService.Update:output_type -> proto.v1.VocabularyServiceUpdateResponse
+	64, // 92: proto.v1.VocabularyService.Delete:output_type -> proto.v1.VocabularyServiceDeleteResponse
+	25, // 93: proto.v1.VocabularyService.GetAllMetadata:output_type -> proto.v1.VocabularyServiceGetAllMetadataResponse
+	21, // 94: proto.v1.VocabularyService.ListHanChar:output_type -> proto.v1.VocabularyServiceListHanCharResponse
+	31, // 95: proto.v1.VocabularyService.GetHanChar:output_type -> proto.v1.VocabularyServiceGetHanCharResponse
+	72, // 96: proto.v1.VocabularyService.SearchHanChar:output_type -> proto.v1.VocabularyServiceSearchHanCharResponse
+	66, // 97: proto.v1.VocabularyService.CreateHanChar:output_type -> proto.v1.VocabularyServiceCreateHanCharResponse
+	68, // 98: proto.v1.VocabularyService.UpdateHanChar:output_type -> proto.v1.VocabularyServiceUpdateHanCharResponse
+	70, // 99: proto.v1.VocabularyService.DeleteHanChar:output_type -> proto.v1.VocabularyServiceDeleteHanCharResponse
+	27, // 100: proto.v1.VocabularyService.BatchCreate:output_type -> proto.v1.VocabularyServiceBatchCreateResponse
+	29, // 101: proto.v1.VocabularyService.BatchCreateHanChar:output_type -> proto.v1.VocabularyServiceBatchCreateHanCharResponse
+	34, // 102: proto.v1.VocabularyService.BatchImportHanCharStrokes:output_type -> proto.v1.VocabularyServiceBatchImportHanCharStrokesResponse
+	37, // 103: proto.v1.VocabularyService.BatchImportWordForms:output_type -> proto.v1.VocabularyServiceBatchImportWordFormsResponse
+	41, // 104: proto.v1.VocabularyService.GetRelations:output_type -> proto.v1.VocabularyServiceGetRelationsResponse
+	43, // 105: proto.v1.VocabularyService.CreateRelation:output_type -> proto.v1.VocabularyServiceCreateRelationResponse
+	45, // 106: proto.v1.VocabularyService.DeleteRelation:output_type -> proto.v1.VocabularyServiceDeleteRelationResponse
+	47, // 107: proto.v1.VocabularyService.GetHanCharRelations:output_type -> proto.v1.VocabularyServiceGetHanCharRelationsResponse
+	49, // 108: proto.v1.VocabularyService.CreateHanCharRelation:output_type -> proto.v1.VocabularyServiceCreateHanCharRelationResponse
+	51, // 109: proto.v1.VocabularyService.DeleteHanCharRelation:output_type -> proto.v1.VocabularyServiceDeleteHanCharRelationResponse
+	54, // 110: proto.v1.VocabularyService.BatchImportRelations:output_type -> proto.v1.VocabularyServiceBatchImportRelationsResponse
+	86, // [86:111] is the sub-list for method output_type
+	61, // [61:86] is the sub-list for method input_type
+	61, // [61:61] is the sub-list for extension type_name
+	61, // [61:61] is the sub-list for extension extendee
+	0,  // [0:61] is the sub-list for field type_name
 }
 
 func init() { file_proto_v1_vocabulary_proto_init() }
@@ -1610,8 +5260,8 @@ func file_proto_v1_vocabulary_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_v1_vocabulary_proto_rawDesc), len(file_proto_v1_vocabulary_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   19,
+			NumEnums:      4,
+			NumMessages:   69,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -374,6 +374,45 @@ func (m *HanChar) validate(all bool) error {
 
 	// no validation rules for Level
 
+	// no validation rules for PinyinNumbered
+
+	// no validation rules for Radical
+
+	// no validation rules for StrokeCount
+
+	// no validation rules for Traditional
+
+	// no validation rules for Simplified
+
+	if all {
+		switch v := interface{}(m.GetStrokeOrder()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, HanCharValidationError{
+					field:  "StrokeOrder",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, HanCharValidationError{
+					field:  "StrokeOrder",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStrokeOrder()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return HanCharValidationError{
+				field:  "StrokeOrder",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return HanCharMultiError(errors)
 	}
@@ -451,44 +490,45 @@ var _ interface {
 	ErrorName() string
 } = HanCharValidationError{}
 
-// Validate checks the field values on VocabularyServiceGetRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *VocabularyServiceGetRequest) Validate() error {
+// Validate checks the field values on StrokePoint with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *StrokePoint) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on VocabularyServiceGetRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// VocabularyServiceGetRequestMultiError, or nil if none found.
-func (m *VocabularyServiceGetRequest) ValidateAll() error {
+// ValidateAll checks the field values on StrokePoint with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in StrokePointMultiError, or
+// nil if none found.
+func (m *StrokePoint) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *VocabularyServiceGetRequest) validate(all bool) error {
+func (m *StrokePoint) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	// no validation rules for X
+
+	// no validation rules for Y
 
 	if len(errors) > 0 {
-		return VocabularyServiceGetRequestMultiError(errors)
+		return StrokePointMultiError(errors)
 	}
 
 	return nil
 }
 
-// VocabularyServiceGetRequestMultiError is an error wrapping multiple
-// validation errors returned by VocabularyServiceGetRequest.ValidateAll() if
-// the designated constraints aren't met.
-type VocabularyServiceGetRequestMultiError []error
+// StrokePointMultiError is an error wrapping multiple validation errors
+// returned by StrokePoint.ValidateAll() if the designated constraints aren't met.
+type StrokePointMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m VocabularyServiceGetRequestMultiError) Error() string {
+func (m StrokePointMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -497,12 +537,11 @@ func (m VocabularyServiceGetRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m VocabularyServiceGetRequestMultiError) AllErrors() []error { return m }
+func (m StrokePointMultiError) AllErrors() []error { return m }
 
-// VocabularyServiceGetRequestValidationError is the validation error returned
-// by VocabularyServiceGetRequest.Validate if the designated constraints
-// aren't met.
-type VocabularyServiceGetRequestValidationError struct {
+// StrokePointValidationError is the validation error returned by
+// StrokePoint.Validate if the designated constraints aren't met.
+type StrokePointValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -510,24 +549,155 @@ type VocabularyServiceGetRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e VocabularyServiceGetRequestValidationError) Field() string { return e.field }
+func (e StrokePointValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e VocabularyServiceGetRequestValidationError) Reason() string { return e.reason }
+func (e StrokePointValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e VocabularyServiceGetRequestValidationError) Cause() error { return e.cause }
+func (e StrokePointValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e VocabularyServiceGetRequestValidationError) Key() bool { return e.key }
+func (e StrokePointValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e VocabularyServiceGetRequestValidationError) ErrorName() string {
-	return "VocabularyServiceGetRequestValidationError"
+func (e StrokePointValidationError) ErrorName() string { return "StrokePointValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StrokePointValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStrokePoint.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StrokePointValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StrokePointValidationError{}
+
+// Validate checks the field values on StrokePolyline with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *StrokePolyline) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StrokePolyline with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in StrokePolylineMultiError,
+// or nil if none found.
+func (m *StrokePolyline) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StrokePolyline) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetPoints() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, StrokePolylineValidationError{
+						field:  fmt.Sprintf("Points[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, StrokePolylineValidationError{
+						field:  fmt.Sprintf("Points[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StrokePolylineValidationError{
+					field:  fmt.Sprintf("Points[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return StrokePolylineMultiError(errors)
+	}
+
+	return nil
+}
+
+// StrokePolylineMultiError is an error wrapping multiple validation errors
+// returned by StrokePolyline.ValidateAll() if the designated constraints aren't met.
+type StrokePolylineMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StrokePolylineMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StrokePolylineMultiError) AllErrors() []error { return m }
+
+// StrokePolylineValidationError is the validation error returned by
+// StrokePolyline.Validate if the designated constraints aren't met.
+type StrokePolylineValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
 }
 
+// Field function returns field value.
+func (e StrokePolylineValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StrokePolylineValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StrokePolylineValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StrokePolylineValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StrokePolylineValidationError) ErrorName() string { return "StrokePolylineValidationError" }
+
 // Error satisfies the builtin error interface
-func (e VocabularyServiceGetRequestValidationError) Error() string {
+func (e StrokePolylineValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -539,14 +709,14 @@ func (e VocabularyServiceGetRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sVocabularyServiceGetRequest.%s: %s%s",
+		"invalid %sStrokePolyline.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = VocabularyServiceGetRequestValidationError{}
+var _ error = StrokePolylineValidationError{}
 
 var _ interface {
 	Field() string
@@ -554,73 +724,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = VocabularyServiceGetRequestValidationError{}
+} = StrokePolylineValidationError{}
 
-// Validate checks the field values on VocabularyServiceGetResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on HanCharStrokeOrder with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *VocabularyServiceGetResponse) Validate() error {
+func (m *HanCharStrokeOrder) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on VocabularyServiceGetResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on HanCharStrokeOrder with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// VocabularyServiceGetResponseMultiError, or nil if none found.
-func (m *VocabularyServiceGetResponse) ValidateAll() error {
+// HanCharStrokeOrderMultiError, or nil if none found.
+func (m *HanCharStrokeOrder) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *VocabularyServiceGetResponse) validate(all bool) error {
+func (m *HanCharStrokeOrder) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetWord()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, VocabularyServiceGetResponseValidationError{
-					field:  "Word",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	for idx, item := range m.GetMedians() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, HanCharStrokeOrderValidationError{
+						field:  fmt.Sprintf("Medians[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, HanCharStrokeOrderValidationError{
+						field:  fmt.Sprintf("Medians[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, VocabularyServiceGetResponseValidationError{
-					field:  "Word",
+				return HanCharStrokeOrderValidationError{
+					field:  fmt.Sprintf("Medians[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetWord()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return VocabularyServiceGetResponseValidationError{
-				field:  "Word",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
 	if len(errors) > 0 {
-		return VocabularyServiceGetResponseMultiError(errors)
+		return HanCharStrokeOrderMultiError(errors)
 	}
 
 	return nil
 }
 
-// VocabularyServiceGetResponseMultiError is an error wrapping multiple
-// validation errors returned by VocabularyServiceGetResponse.ValidateAll() if
-// the designated constraints aren't met.
-type VocabularyServiceGetResponseMultiError []error
+// HanCharStrokeOrderMultiError is an error wrapping multiple validation errors
+// returned by HanCharStrokeOrder.ValidateAll() if the designated constraints
+// aren't met.
+type HanCharStrokeOrderMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m VocabularyServiceGetResponseMultiError) Error() string {
+func (m HanCharStrokeOrderMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -629,12 +804,11 @@ func (m VocabularyServiceGetResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m VocabularyServiceGetResponseMultiError) AllErrors() []error { return m }
+func (m HanCharStrokeOrderMultiError) AllErrors() []error { return m }
 
-// VocabularyServiceGetResponseValidationError is the validation error returned
-// by VocabularyServiceGetResponse.Validate if the designated constraints
-// aren't met.
-type VocabularyServiceGetResponseValidationError struct {
+// HanCharStrokeOrderValidationError is the validation error returned by
+// HanCharStrokeOrder.Validate if the designated constraints aren't met.
+type HanCharStrokeOrderValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -642,24 +816,24 @@ type VocabularyServiceGetResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e VocabularyServiceGetResponseValidationError) Field() string { return e.field }
+func (e HanCharStrokeOrderValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e VocabularyServiceGetResponseValidationError) Reason() string { return e.reason }
+func (e HanCharStrokeOrderValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e VocabularyServiceGetResponseValidationError) Cause() error { return e.cause }
+func (e HanCharStrokeOrderValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e VocabularyServiceGetResponseValidationError) Key() bool { return e.key }
+func (e HanCharStrokeOrderValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e VocabularyServiceGetResponseValidationError) ErrorName() string {
-	return "VocabularyServiceGetResponseValidationError"
+func (e HanCharStrokeOrderValidationError) ErrorName() string {
+	return "HanCharStrokeOrderValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e VocabularyServiceGetResponseValidationError) Error() string {
+func (e HanCharStrokeOrderValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -671,14 +845,14 @@ func (e VocabularyServiceGetResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sVocabularyServiceGetResponse.%s: %s%s",
+		"invalid %sHanCharStrokeOrder.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = VocabularyServiceGetResponseValidationError{}
+var _ error = HanCharStrokeOrderValidationError{}
 
 var _ interface {
 	Field() string
@@ -686,50 +860,6297 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = VocabularyServiceGetResponseValidationError{}
+} = HanCharStrokeOrderValidationError{}
 
-// Validate checks the field values on VocabularyServiceListRequest with the
+// Validate checks the field values on VocabularyServiceGetRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *VocabularyServiceListRequest) Validate() error {
+func (m *VocabularyServiceGetRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on VocabularyServiceListRequest with the
+// ValidateAll checks the field values on VocabularyServiceGetRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// VocabularyServiceListRequestMultiError, or nil if none found.
-func (m *VocabularyServiceListRequest) ValidateAll() error {
+// VocabularyServiceGetRequestMultiError, or nil if none found.
+func (m *VocabularyServiceGetRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *VocabularyServiceListRequest) validate(all bool) error {
+func (m *VocabularyServiceGetRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
-	var errors []error
-
-	// no validation rules for Page
-
-	// no validation rules for PageSize
-
-	// no validation rules for Level
-
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return VocabularyServiceGetRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// VocabularyServiceGetRequestMultiError is an error wrapping multiple
+// validation errors returned by VocabularyServiceGetRequest.ValidateAll() if
+// the designated constraints aren't met.
+type VocabularyServiceGetRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VocabularyServiceGetRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VocabularyServiceGetRequestMultiError) AllErrors() []error { return m }
+
+// VocabularyServiceGetRequestValidationError is the validation error returned
+// by VocabularyServiceGetRequest.Validate if the designated constraints
+// aren't met.
+type VocabularyServiceGetRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VocabularyServiceGetRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VocabularyServiceGetRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VocabularyServiceGetRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VocabularyServiceGetRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VocabularyServiceGetRequestValidationError) ErrorName() string {
+	return "VocabularyServiceGetRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VocabularyServiceGetRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVocabularyServiceGetRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VocabularyServiceGetRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VocabularyServiceGetRequestValidationError{}
+
+// Validate checks the field values on VocabularyServiceGetResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *VocabularyServiceGetResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VocabularyServiceGetResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// VocabularyServiceGetResponseMultiError, or nil if none found.
+func (m *VocabularyServiceGetResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VocabularyServiceGetResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetWord()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, VocabularyServiceGetResponseValidationError{
+					field:  "Word",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, VocabularyServiceGetResponseValidationError{
+					field:  "Word",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWord()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return VocabularyServiceGetResponseValidationError{
+				field:  "Word",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetForms() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, VocabularyServiceGetResponseValidationError{
+						field:  fmt.Sprintf("Forms[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, VocabularyServiceGetResponseValidationError{
+						field:  fmt.Sprintf("Forms[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return VocabularyServiceGetResponseValidationError{
+					field:  fmt.Sprintf("Forms[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetInflections() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, VocabularyServiceGetResponseValidationError{
+						field:  fmt.Sprintf("Inflections[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, VocabularyServiceGetResponseValidationError{
+						field:  fmt.Sprintf("Inflections[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return VocabularyServiceGetResponseValidationError{
+					field:  fmt.Sprintf("Inflections[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return VocabularyServiceGetResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// VocabularyServiceGetResponseMultiError is an error wrapping multiple
+// validation errors returned by VocabularyServiceGetResponse.ValidateAll() if
+// the designated constraints aren't met.
+type VocabularyServiceGetResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VocabularyServiceGetResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VocabularyServiceGetResponseMultiError) AllErrors() []error { return m }
+
+// VocabularyServiceGetResponseValidationError is the validation error returned
+// by VocabularyServiceGetResponse.Validate if the designated constraints
+// aren't met.
+type VocabularyServiceGetResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VocabularyServiceGetResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VocabularyServiceGetResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VocabularyServiceGetResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VocabularyServiceGetResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VocabularyServiceGetResponseValidationError) ErrorName() string {
+	return "VocabularyServiceGetResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VocabularyServiceGetResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVocabularyServiceGetResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VocabularyServiceGetResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VocabularyServiceGetResponseValidationError{}
+
+// Validate checks the field values on WordForm with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *WordForm) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WordForm with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in WordFormMultiError, or nil
+// if none found.
+func (m *WordForm) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WordForm) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Form
+
+	// no validation rules for Kind
+
+	if len(errors) > 0 {
+		return WordFormMultiError(errors)
+	}
+
+	return nil
+}
+
+// WordFormMultiError is an error wrapping multiple validation errors returned
+// by WordForm.ValidateAll() if the designated constraints aren't met.
+type WordFormMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WordFormMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WordFormMultiError) AllErrors() []error { return m }
+
+// WordFormValidationError is the validation error returned by
+// WordForm.Validate if the designated constraints aren't met.
+type WordFormValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WordFormValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WordFormValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WordFormValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WordFormValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WordFormValidationError) ErrorName() string { return "WordFormValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WordFormValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWordForm.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WordFormValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WordFormValidationError{}
+
+// Validate checks the field values on WordExampleInflection with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WordExampleInflection) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WordExampleInflection with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WordExampleInflectionMultiError, or nil if none found.
+func (m *WordExampleInflection) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WordExampleInflection) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Example
+
+	// no validation rules for Text
+
+	// no validation rules for Kind
+
+	// no validation rules for Inflected
+
+	// no validation rules for Start
+
+	// no validation rules for End
+
+	if len(errors) > 0 {
+		return WordExampleInflectionMultiError(errors)
+	}
+
+	return nil
+}
+
+// WordExampleInflectionMultiError is an error wrapping multiple validation
+// errors returned by WordExampleInflection.ValidateAll() if the designated
+// constraints aren't met.
+type WordExampleInflectionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WordExampleInflectionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WordExampleInflectionMultiError) AllErrors() []error { return m }
+
+// WordExampleInflectionValidationError is the validation error returned by
+// WordExampleInflection.Validate if the designated constraints aren't met.
+type WordExampleInflectionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WordExampleInflectionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WordExampleInflectionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WordExampleInflectionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WordExampleInflectionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WordExampleInflectionValidationError) ErrorName() string {
+	return "WordExampleInflectionValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WordExampleInflectionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWordExampleInflection.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WordExampleInflectionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WordExampleInflectionValidationError{}
+
+// Validate checks the field values on VocabularyServiceLookupRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *VocabularyServiceLookupRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VocabularyServiceLookupRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// VocabularyServiceLookupRequestMultiError, or nil if none found.
+func (m *VocabularyServiceLookupRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VocabularyServiceLookupRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Text
+
+	if len(errors) > 0 {
+		return VocabularyServiceLookupRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// VocabularyServiceLookupRequestMultiError is an error wrapping multiple
+// validation errors returned by VocabularyServiceLookupRequest.ValidateAll()
+// if the designated constraints aren't met.
+type VocabularyServiceLookupRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VocabularyServiceLookupRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VocabularyServiceLookupRequestMultiError) AllErrors() []error { return m }
+
+// VocabularyServiceLookupRequestValidationError is the validation error
+// returned by VocabularyServiceLookupRequest.Validate if the designated
+// constraints aren't met.
+type VocabularyServiceLookupRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VocabularyServiceLookupRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VocabularyServiceLookupRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VocabularyServiceLookupRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VocabularyServiceLookupRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VocabularyServiceLookupRequestValidationError) ErrorName() string {
+	return "VocabularyServiceLookupRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VocabularyServiceLookupRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVocabularyServiceLookupRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VocabularyServiceLookupRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VocabularyServiceLookupRequestValidationError{}
+
+// Validate checks the field values on VocabularyServiceLookupResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *VocabularyServiceLookupResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VocabularyServiceLookupResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// VocabularyServiceLookupResponseMultiError, or nil if none found.
+func (m *VocabularyServiceLookupResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VocabularyServiceLookupResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetWord()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, VocabularyServiceLookupResponseValidationError{
+					field:  "Word",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, VocabularyServiceLookupResponseValidationError{
+					field:  "Word",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWord()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return VocabularyServiceLookupResponseValidationError{
+				field:  "Word",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetMatchedForm()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, VocabularyServiceLookupResponseValidationError{
+					field:  "MatchedForm",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, VocabularyServiceLookupResponseValidationError{
+					field:  "MatchedForm",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMatchedForm()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return VocabularyServiceLookupResponseValidationError{
+				field:  "MatchedForm",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetForms() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, VocabularyServiceLookupResponseValidationError{
+						field:  fmt.Sprintf("Forms[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, VocabularyServiceLookupResponseValidationError{
+						field:  fmt.Sprintf("Forms[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return VocabularyServiceLookupResponseValidationError{
+					field:  fmt.Sprintf("Forms[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetInflections() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, VocabularyServiceLookupResponseValidationError{
+						field:  fmt.Sprintf("Inflections[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, VocabularyServiceLookupResponseValidationError{
+						field:  fmt.Sprintf("Inflections[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return VocabularyServiceLookupResponseValidationError{
+					field:  fmt.Sprintf("Inflections[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return VocabularyServiceLookupResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// VocabularyServiceLookupResponseMultiError is an error wrapping multiple
+// validation errors returned by VocabularyServiceLookupResponse.ValidateAll()
+// if the designated constraints aren't met.
+type VocabularyServiceLookupResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VocabularyServiceLookupResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VocabularyServiceLookupResponseMultiError) AllErrors() []error { return m }
+
+// VocabularyServiceLookupResponseValidationError is the validation error
+// returned by VocabularyServiceLookupResponse.Validate if the designated
+// constraints aren't met.
+type VocabularyServiceLookupResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VocabularyServiceLookupResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VocabularyServiceLookupResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VocabularyServiceLookupResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VocabularyServiceLookupResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VocabularyServiceLookupResponseValidationError) ErrorName() string {
+	return "VocabularyServiceLookupResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VocabularyServiceLookupResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVocabularyServiceLookupResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VocabularyServiceLookupResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VocabularyServiceLookupResponseValidationError{}
+
+// Validate checks the field values on VocabularyServiceListRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *VocabularyServiceListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VocabularyServiceListRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// VocabularyServiceListRequestMultiError, or nil if none found.
+func (m *VocabularyServiceListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VocabularyServiceListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Page
+
+	// no validation rules for PageSize
+
+	// no validation rules for Level
+
+	if len(errors) > 0 {
+		return VocabularyServiceListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// VocabularyServiceListRequestMultiError is an error wrapping multiple
+// validation errors returned by VocabularyServiceListRequest.ValidateAll() if
+// the designated constraints aren't met.
+type VocabularyServiceListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VocabularyServiceListRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VocabularyServiceListRequestMultiError) AllErrors() []error { return m }
+
+// VocabularyServiceListRequestValidationError is the validation error returned
+// by VocabularyServiceListRequest.Validate if the designated constraints
+// aren't met.
+type VocabularyServiceListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VocabularyServiceListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VocabularyServiceListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VocabularyServiceListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VocabularyServiceListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VocabularyServiceListRequestValidationError) ErrorName() string {
+	return "VocabularyServiceListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VocabularyServiceListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVocabularyServiceListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VocabularyServiceListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VocabularyServiceListRequestValidationError{}
+
+// Validate checks the field values on VocabularyServiceListResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *VocabularyServiceListResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VocabularyServiceListResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// VocabularyServiceListResponseMultiError, or nil if none found.
+func (m *VocabularyServiceListResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VocabularyServiceListResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetWords() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, VocabularyServiceListResponseValidationError{
+						field:  fmt.Sprintf("Words[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, VocabularyServiceListResponseValidationError{
+						field:  fmt.Sprintf("Words[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return VocabularyServiceListResponseValidationError{
+					field:  fmt.Sprintf("Words[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	if len(errors) > 0 {
+		return VocabularyServiceListResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// VocabularyServiceListResponseMultiError is an error wrapping multiple
+// validation errors returned by VocabularyServiceListResponse.ValidateAll()
+// if the designated constraints aren't met.
+type VocabularyServiceListResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VocabularyServiceListResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VocabularyServiceListResponseMultiError) AllErrors() []error { return m }
+
+// VocabularyServiceListResponseValidationError is the validation error
+// returned by VocabularyServiceListResponse.Validate if the designated
+// constraints aren't met.
+type VocabularyServiceListResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VocabularyServiceListResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VocabularyServiceListResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VocabularyServiceListResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VocabularyServiceListResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VocabularyServiceListResponseValidationError) ErrorName() string {
+	return "VocabularyServiceListResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VocabularyServiceListResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVocabularyServiceListResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VocabularyServiceListResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VocabularyServiceListResponseValidationError{}
+
+// Validate checks the field values on VocabularyServiceAllTagsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *VocabularyServiceAllTagsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VocabularyServiceAllTagsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// VocabularyServiceAllTagsRequestMultiError, or nil if none found.
+func (m *VocabularyServiceAllTagsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VocabularyServiceAllTagsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return VocabularyServiceAllTagsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// VocabularyServiceAllTagsRequestMultiError is an error wrapping multiple
+// validation errors returned by VocabularyServiceAllTagsRequest.ValidateAll()
+// if the designated constraints aren't met.
+type VocabularyServiceAllTagsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VocabularyServiceAllTagsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VocabularyServiceAllTagsRequestMultiError) AllErrors() []error { return m }
+
+// VocabularyServiceAllTagsRequestValidationError is the validation error
+// returned by VocabularyServiceAllTagsRequest.Validate if the designated
+// constraints aren't met.
+type VocabularyServiceAllTagsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VocabularyServiceAllTagsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VocabularyServiceAllTagsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VocabularyServiceAllTagsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VocabularyServiceAllTagsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VocabularyServiceAllTagsRequestValidationError) ErrorName() string {
+	return "VocabularyServiceAllTagsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VocabularyServiceAllTagsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVocabularyServiceAllTagsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VocabularyServiceAllTagsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VocabularyServiceAllTagsRequestValidationError{}
+
+// Validate checks the field values on VocabularyServiceAllTagsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *VocabularyServiceAllTagsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VocabularyServiceAllTagsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// VocabularyServiceAllTagsResponseMultiError, or nil if none found.
+func (m *VocabularyServiceAllTagsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VocabularyServiceAllTagsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return VocabularyServiceAllTagsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// VocabularyServiceAllTagsResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// VocabularyServiceAllTagsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type VocabularyServiceAllTagsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VocabularyServiceAllTagsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VocabularyServiceAllTagsResponseMultiError) AllErrors() []error { return m }
+
+// VocabularyServiceAllTagsResponseValidationError is the validation error
+// returned by VocabularyServiceAllTagsResponse.Validate if the designated
+// constraints aren't met.
+type VocabularyServiceAllTagsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VocabularyServiceAllTagsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VocabularyServiceAllTagsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VocabularyServiceAllTagsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VocabularyServiceAllTagsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VocabularyServiceAllTagsResponseValidationError) ErrorName() string {
+	return "VocabularyServiceAllTagsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VocabularyServiceAllTagsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVocabularyServiceAllTagsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VocabularyServiceAllTagsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VocabularyServiceAllTagsResponseValidationError{}
+
+// Validate checks the field values on VocabularyServiceListHanCharRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *VocabularyServiceListHanCharRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VocabularyServiceListHanCharRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// VocabularyServiceListHanCharRequestMultiError, or nil if none found.
+func (m *VocabularyServiceListHanCharRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VocabularyServiceListHanCharRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Page
+
+	// no validation rules for PageSize
+
+	// no validation rules for Level
+
+	// no validation rules for Radical
+
+	// no validation rules for MinStrokes
+
+	// no validation rules for MaxStrokes
+
+	// no validation rules for Component
+
+	// no validation rules for Pinyin
+
+	// no validation rules for IgnoreTone
+
+	if len(errors) > 0 {
+		return VocabularyServiceListHanCharRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// VocabularyServiceListHanCharRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// VocabularyServiceListHanCharRequest.ValidateAll() if the designated
+// constraints aren't met.
+type VocabularyServiceListHanCharRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VocabularyServiceListHanCharRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VocabularyServiceListHanCharRequestMultiError) AllErrors() []error { return m }
+
+// VocabularyServiceListHanCharRequestValidationError is the validation error
+// returned by VocabularyServiceListHanCharRequest.Validate if the designated
+// constraints aren't met.
+type VocabularyServiceListHanCharRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VocabularyServiceListHanCharRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VocabularyServiceListHanCharRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VocabularyServiceListHanCharRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VocabularyServiceListHanCharRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VocabularyServiceListHanCharRequestValidationError) ErrorName() string {
+	return "VocabularyServiceListHanCharRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VocabularyServiceListHanCharRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVocabularyServiceListHanCharRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VocabularyServiceListHanCharRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VocabularyServiceListHanCharRequestValidationError{}
+
+// Validate checks the field values on VocabularyServiceListHanCharResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *VocabularyServiceListHanCharResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VocabularyServiceListHanCharResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// VocabularyServiceListHanCharResponseMultiError, or nil if none found.
+func (m *VocabularyServiceListHanCharResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VocabularyServiceListHanCharResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetHanChars() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, VocabularyServiceListHanCharResponseValidationError{
+						field:  fmt.Sprintf("HanChars[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, VocabularyServiceListHanCharResponseValidationError{
+						field:  fmt.Sprintf("HanChars[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return VocabularyServiceListHanCharResponseValidationError{
+					field:  fmt.Sprintf("HanChars[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	if len(errors) > 0 {
+		return VocabularyServiceListHanCharResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// VocabularyServiceListHanCharResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// VocabularyServiceListHanCharResponse.ValidateAll() if the designated
+// constraints aren't met.
+type VocabularyServiceListHanCharResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VocabularyServiceListHanCharResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VocabularyServiceListHanCharResponseMultiError) AllErrors() []error { return m }
+
+// VocabularyServiceListHanCharResponseValidationError is the validation error
+// returned by VocabularyServiceListHanCharResponse.Validate if the designated
+// constraints aren't met.
+type VocabularyServiceListHanCharResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VocabularyServiceListHanCharResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VocabularyServiceListHanCharResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VocabularyServiceListHanCharResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VocabularyServiceListHanCharResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VocabularyServiceListHanCharResponseValidationError) ErrorName() string {
+	return "VocabularyServiceListHanCharResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VocabularyServiceListHanCharResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVocabularyServiceListHanCharResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VocabularyServiceListHanCharResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VocabularyServiceListHanCharResponseValidationError{}
+
+// Validate checks the field values on VocabularyServiceAllCategoriesRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *VocabularyServiceAllCategoriesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VocabularyServiceAllCategoriesRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// VocabularyServiceAllCategoriesRequestMultiError, or nil if none found.
+func (m *VocabularyServiceAllCategoriesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VocabularyServiceAllCategoriesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return VocabularyServiceAllCategoriesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// VocabularyServiceAllCategoriesRequestMultiError is an error wrapping
+// multiple validation errors returned by
+// VocabularyServiceAllCategoriesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type VocabularyServiceAllCategoriesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VocabularyServiceAllCategoriesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VocabularyServiceAllCategoriesRequestMultiError) AllErrors() []error { return m }
+
+// VocabularyServiceAllCategoriesRequestValidationError is the validation error
+// returned by VocabularyServiceAllCategoriesRequest.Validate if the
+// designated constraints aren't met.
+type VocabularyServiceAllCategoriesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VocabularyServiceAllCategoriesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VocabularyServiceAllCategoriesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VocabularyServiceAllCategoriesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VocabularyServiceAllCategoriesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VocabularyServiceAllCategoriesRequestValidationError) ErrorName() string {
+	return "VocabularyServiceAllCategoriesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VocabularyServiceAllCategoriesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVocabularyServiceAllCategoriesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VocabularyServiceAllCategoriesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VocabularyServiceAllCategoriesRequestValidationError{}
+
+// Validate checks the field values on VocabularyServiceAllCategoriesResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *VocabularyServiceAllCategoriesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// VocabularyServiceAllCategoriesResponse with the rules defined in the proto
+// definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in
+// VocabularyServiceAllCategoriesResponseMultiError, or nil if none found.
+func (m *VocabularyServiceAllCategoriesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VocabularyServiceAllCategoriesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return VocabularyServiceAllCategoriesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// VocabularyServiceAllCategoriesResponseMultiError is an error wrapping
+// multiple validation errors returned by
+// VocabularyServiceAllCategoriesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type VocabularyServiceAllCategoriesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VocabularyServiceAllCategoriesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VocabularyServiceAllCategoriesResponseMultiError) AllErrors() []error { return m }
+
+// VocabularyServiceAllCategoriesResponseValidationError is the validation
+// error returned by VocabularyServiceAllCategoriesResponse.Validate if the
+// designated constraints aren't met.
+type VocabularyServiceAllCategoriesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VocabularyServiceAllCategoriesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VocabularyServiceAllCategoriesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VocabularyServiceAllCategoriesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VocabularyServiceAllCategoriesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VocabularyServiceAllCategoriesResponseValidationError) ErrorName() string {
+	return "VocabularyServiceAllCategoriesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VocabularyServiceAllCategoriesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVocabularyServiceAllCategoriesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VocabularyServiceAllCategoriesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VocabularyServiceAllCategoriesResponseValidationError{}
+
+// Validate checks the field values on VocabularyServiceGetAllMetadataRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *VocabularyServiceGetAllMetadataRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// VocabularyServiceGetAllMetadataRequest with the rules defined in the proto
+// definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in
+// VocabularyServiceGetAllMetadataRequestMultiError, or nil if none found.
+func (m *VocabularyServiceGetAllMetadataRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VocabularyServiceGetAllMetadataRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return VocabularyServiceGetAllMetadataRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// VocabularyServiceGetAllMetadataRequestMultiError is an error wrapping
+// multiple validation errors returned by
+// VocabularyServiceGetAllMetadataRequest.ValidateAll() if the designated
+// constraints aren't met.
+type VocabularyServiceGetAllMetadataRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VocabularyServiceGetAllMetadataRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VocabularyServiceGetAllMetadataRequestMultiError) AllErrors() []error { return m }
+
+// VocabularyServiceGetAllMetadataRequestValidationError is the validation
+// error returned by VocabularyServiceGetAllMetadataRequest.Validate if the
+// designated constraints aren't met.
+type VocabularyServiceGetAllMetadataRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VocabularyServiceGetAllMetadataRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VocabularyServiceGetAllMetadataRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VocabularyServiceGetAllMetadataRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VocabularyServiceGetAllMetadataRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VocabularyServiceGetAllMetadataRequestValidationError) ErrorName() string {
+	return "VocabularyServiceGetAllMetadataRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VocabularyServiceGetAllMetadataRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVocabularyServiceGetAllMetadataRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VocabularyServiceGetAllMetadataRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VocabularyServiceGetAllMetadataRequestValidationError{}
+
+// Validate checks the field values on VocabularyServiceGetAllMetadataResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *VocabularyServiceGetAllMetadataResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// VocabularyServiceGetAllMetadataResponse with the rules defined in the proto
+// definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in
+// VocabularyServiceGetAllMetadataResponseMultiError, or nil if none found.
+func (m *VocabularyServiceGetAllMetadataResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VocabularyServiceGetAllMetadataResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return VocabularyServiceGetAllMetadataResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// VocabularyServiceGetAllMetadataResponseMultiError is an error wrapping
+// multiple validation errors returned by
+// VocabularyServiceGetAllMetadataResponse.ValidateAll() if the designated
+// constraints aren't met.
+type VocabularyServiceGetAllMetadataResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VocabularyServiceGetAllMetadataResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VocabularyServiceGetAllMetadataResponseMultiError) AllErrors() []error { return m }
+
+// VocabularyServiceGetAllMetadataResponseValidationError is the validation
+// error returned by VocabularyServiceGetAllMetadataResponse.Validate if the
+// designated constraints aren't met.
+type VocabularyServiceGetAllMetadataResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VocabularyServiceGetAllMetadataResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VocabularyServiceGetAllMetadataResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VocabularyServiceGetAllMetadataResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VocabularyServiceGetAllMetadataResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VocabularyServiceGetAllMetadataResponseValidationError) ErrorName() string {
+	return "VocabularyServiceGetAllMetadataResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VocabularyServiceGetAllMetadataResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVocabularyServiceGetAllMetadataResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VocabularyServiceGetAllMetadataResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VocabularyServiceGetAllMetadataResponseValidationError{}
+
+// Validate checks the field values on VocabularyServiceBatchCreateRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *VocabularyServiceBatchCreateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VocabularyServiceBatchCreateRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// VocabularyServiceBatchCreateRequestMultiError, or nil if none found.
+func (m *VocabularyServiceBatchCreateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VocabularyServiceBatchCreateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetWords() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, VocabularyServiceBatchCreateRequestValidationError{
+						field:  fmt.Sprintf("Words[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, VocabularyServiceBatchCreateRequestValidationError{
+						field:  fmt.Sprintf("Words[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return VocabularyServiceBatchCreateRequestValidationError{
+					field:  fmt.Sprintf("Words[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return VocabularyServiceBatchCreateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// VocabularyServiceBatchCreateRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// VocabularyServiceBatchCreateRequest.ValidateAll() if the designated
+// constraints aren't met.
+type VocabularyServiceBatchCreateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VocabularyServiceBatchCreateRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VocabularyServiceBatchCreateRequestMultiError) AllErrors() []error { return m }
+
+// VocabularyServiceBatchCreateRequestValidationError is the validation error
+// returned by VocabularyServiceBatchCreateRequest.Validate if the designated
+// constraints aren't met.
+type VocabularyServiceBatchCreateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VocabularyServiceBatchCreateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VocabularyServiceBatchCreateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VocabularyServiceBatchCreateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VocabularyServiceBatchCreateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VocabularyServiceBatchCreateRequestValidationError) ErrorName() string {
+	return "VocabularyServiceBatchCreateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VocabularyServiceBatchCreateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVocabularyServiceBatchCreateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VocabularyServiceBatchCreateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VocabularyServiceBatchCreateRequestValidationError{}
+
+// Validate checks the field values on VocabularyServiceBatchCreateResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *VocabularyServiceBatchCreateResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VocabularyServiceBatchCreateResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// VocabularyServiceBatchCreateResponseMultiError, or nil if none found.
+func (m *VocabularyServiceBatchCreateResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VocabularyServiceBatchCreateResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return VocabularyServiceBatchCreateResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// VocabularyServiceBatchCreateResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// VocabularyServiceBatchCreateResponse.ValidateAll() if the designated
+// constraints aren't met.
+type VocabularyServiceBatchCreateResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VocabularyServiceBatchCreateResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VocabularyServiceBatchCreateResponseMultiError) AllErrors() []error { return m }
+
+// VocabularyServiceBatchCreateResponseValidationError is the validation error
+// returned by VocabularyServiceBatchCreateResponse.Validate if the designated
+// constraints aren't met.
+type VocabularyServiceBatchCreateResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VocabularyServiceBatchCreateResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VocabularyServiceBatchCreateResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VocabularyServiceBatchCreateResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VocabularyServiceBatchCreateResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VocabularyServiceBatchCreateResponseValidationError) ErrorName() string {
+	return "VocabularyServiceBatchCreateResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VocabularyServiceBatchCreateResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVocabularyServiceBatchCreateResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VocabularyServiceBatchCreateResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VocabularyServiceBatchCreateResponseValidationError{}
+
+// Validate checks the field values on
+// VocabularyServiceBatchCreateHanCharRequest with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *VocabularyServiceBatchCreateHanCharRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// VocabularyServiceBatchCreateHanCharRequest with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in
+// VocabularyServiceBatchCreateHanCharRequestMultiError, or nil if none found.
+func (m *VocabularyServiceBatchCreateHanCharRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VocabularyServiceBatchCreateHanCharRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetHanChars() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, VocabularyServiceBatchCreateHanCharRequestValidationError{
+						field:  fmt.Sprintf("HanChars[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, VocabularyServiceBatchCreateHanCharRequestValidationError{
+						field:  fmt.Sprintf("HanChars[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return VocabularyServiceBatchCreateHanCharRequestValidationError{
+					field:  fmt.Sprintf("HanChars[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return VocabularyServiceBatchCreateHanCharRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// VocabularyServiceBatchCreateHanCharRequestMultiError is an error wrapping
+// multiple validation errors returned by
+// VocabularyServiceBatchCreateHanCharRequest.ValidateAll() if the designated
+// constraints aren't met.
+type VocabularyServiceBatchCreateHanCharRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VocabularyServiceBatchCreateHanCharRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VocabularyServiceBatchCreateHanCharRequestMultiError) AllErrors() []error { return m }
+
+// VocabularyServiceBatchCreateHanCharRequestValidationError is the validation
+// error returned by VocabularyServiceBatchCreateHanCharRequest.Validate if
+// the designated constraints aren't met.
+type VocabularyServiceBatchCreateHanCharRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VocabularyServiceBatchCreateHanCharRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VocabularyServiceBatchCreateHanCharRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VocabularyServiceBatchCreateHanCharRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VocabularyServiceBatchCreateHanCharRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VocabularyServiceBatchCreateHanCharRequestValidationError) ErrorName() string {
+	return "VocabularyServiceBatchCreateHanCharRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VocabularyServiceBatchCreateHanCharRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVocabularyServiceBatchCreateHanCharRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VocabularyServiceBatchCreateHanCharRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VocabularyServiceBatchCreateHanCharRequestValidationError{}
+
+// Validate checks the field values on
+// VocabularyServiceBatchCreateHanCharResponse with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *VocabularyServiceBatchCreateHanCharResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// VocabularyServiceBatchCreateHanCharResponse with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in
+// VocabularyServiceBatchCreateHanCharResponseMultiError, or nil if none found.
+func (m *VocabularyServiceBatchCreateHanCharResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VocabularyServiceBatchCreateHanCharResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return VocabularyServiceBatchCreateHanCharResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// VocabularyServiceBatchCreateHanCharResponseMultiError is an error wrapping
+// multiple validation errors returned by
+// VocabularyServiceBatchCreateHanCharResponse.ValidateAll() if the designated
+// constraints aren't met.
+type VocabularyServiceBatchCreateHanCharResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VocabularyServiceBatchCreateHanCharResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VocabularyServiceBatchCreateHanCharResponseMultiError) AllErrors() []error { return m }
+
+// VocabularyServiceBatchCreateHanCharResponseValidationError is the validation
+// error returned by VocabularyServiceBatchCreateHanCharResponse.Validate if
+// the designated constraints aren't met.
+type VocabularyServiceBatchCreateHanCharResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VocabularyServiceBatchCreateHanCharResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VocabularyServiceBatchCreateHanCharResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VocabularyServiceBatchCreateHanCharResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VocabularyServiceBatchCreateHanCharResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VocabularyServiceBatchCreateHanCharResponseValidationError) ErrorName() string {
+	return "VocabularyServiceBatchCreateHanCharResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VocabularyServiceBatchCreateHanCharResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVocabularyServiceBatchCreateHanCharResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VocabularyServiceBatchCreateHanCharResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VocabularyServiceBatchCreateHanCharResponseValidationError{}
+
+// Validate checks the field values on VocabularyServiceGetHanCharRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *VocabularyServiceGetHanCharRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VocabularyServiceGetHanCharRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// VocabularyServiceGetHanCharRequestMultiError, or nil if none found.
+func (m *VocabularyServiceGetHanCharRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VocabularyServiceGetHanCharRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Character
+
+	if len(errors) > 0 {
+		return VocabularyServiceGetHanCharRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// VocabularyServiceGetHanCharRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// VocabularyServiceGetHanCharRequest.ValidateAll() if the designated
+// constraints aren't met.
+type VocabularyServiceGetHanCharRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VocabularyServiceGetHanCharRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VocabularyServiceGetHanCharRequestMultiError) AllErrors() []error { return m }
+
+// VocabularyServiceGetHanCharRequestValidationError is the validation error
+// returned by VocabularyServiceGetHanCharRequest.Validate if the designated
+// constraints aren't met.
+type VocabularyServiceGetHanCharRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VocabularyServiceGetHanCharRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VocabularyServiceGetHanCharRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VocabularyServiceGetHanCharRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VocabularyServiceGetHanCharRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VocabularyServiceGetHanCharRequestValidationError) ErrorName() string {
+	return "VocabularyServiceGetHanCharRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VocabularyServiceGetHanCharRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVocabularyServiceGetHanCharRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VocabularyServiceGetHanCharRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VocabularyServiceGetHanCharRequestValidationError{}
+
+// Validate checks the field values on VocabularyServiceGetHanCharResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *VocabularyServiceGetHanCharResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VocabularyServiceGetHanCharResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// VocabularyServiceGetHanCharResponseMultiError, or nil if none found.
+func (m *VocabularyServiceGetHanCharResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VocabularyServiceGetHanCharResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetHanChar()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, VocabularyServiceGetHanCharResponseValidationError{
+					field:  "HanChar",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, VocabularyServiceGetHanCharResponseValidationError{
+					field:  "HanChar",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetHanChar()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return VocabularyServiceGetHanCharResponseValidationError{
+				field:  "HanChar",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return VocabularyServiceGetHanCharResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// VocabularyServiceGetHanCharResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// VocabularyServiceGetHanCharResponse.ValidateAll() if the designated
+// constraints aren't met.
+type VocabularyServiceGetHanCharResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VocabularyServiceGetHanCharResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VocabularyServiceGetHanCharResponseMultiError) AllErrors() []error { return m }
+
+// VocabularyServiceGetHanCharResponseValidationError is the validation error
+// returned by VocabularyServiceGetHanCharResponse.Validate if the designated
+// constraints aren't met.
+type VocabularyServiceGetHanCharResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VocabularyServiceGetHanCharResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VocabularyServiceGetHanCharResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VocabularyServiceGetHanCharResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VocabularyServiceGetHanCharResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VocabularyServiceGetHanCharResponseValidationError) ErrorName() string {
+	return "VocabularyServiceGetHanCharResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VocabularyServiceGetHanCharResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVocabularyServiceGetHanCharResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VocabularyServiceGetHanCharResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VocabularyServiceGetHanCharResponseValidationError{}
+
+// Validate checks the field values on HanCharStrokeData with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *HanCharStrokeData) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on HanCharStrokeData with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// HanCharStrokeDataMultiError, or nil if none found.
+func (m *HanCharStrokeData) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *HanCharStrokeData) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Character
+
+	if all {
+		switch v := interface{}(m.GetStrokeOrder()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, HanCharStrokeDataValidationError{
+					field:  "StrokeOrder",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, HanCharStrokeDataValidationError{
+					field:  "StrokeOrder",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStrokeOrder()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return HanCharStrokeDataValidationError{
+				field:  "StrokeOrder",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return HanCharStrokeDataMultiError(errors)
+	}
+
+	return nil
+}
+
+// HanCharStrokeDataMultiError is an error wrapping multiple validation errors
+// returned by HanCharStrokeData.ValidateAll() if the designated constraints aren't met.
+type HanCharStrokeDataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m HanCharStrokeDataMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m HanCharStrokeDataMultiError) AllErrors() []error { return m }
+
+// HanCharStrokeDataValidationError is the validation error returned by
+// HanCharStrokeData.Validate if the designated constraints aren't met.
+type HanCharStrokeDataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HanCharStrokeDataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HanCharStrokeDataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HanCharStrokeDataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HanCharStrokeDataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HanCharStrokeDataValidationError) ErrorName() string {
+	return "HanCharStrokeDataValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e HanCharStrokeDataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHanCharStrokeData.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HanCharStrokeDataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HanCharStrokeDataValidationError{}
+
+// Validate checks the field values on
+// VocabularyServiceBatchImportHanCharStrokesRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *VocabularyServiceBatchImportHanCharStrokesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// VocabularyServiceBatchImportHanCharStrokesRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in
+// VocabularyServiceBatchImportHanCharStrokesRequestMultiError, or nil if none found.
+func (m *VocabularyServiceBatchImportHanCharStrokesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VocabularyServiceBatchImportHanCharStrokesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetHanChars() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, VocabularyServiceBatchImportHanCharStrokesRequestValidationError{
+						field:  fmt.Sprintf("HanChars[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, VocabularyServiceBatchImportHanCharStrokesRequestValidationError{
+						field:  fmt.Sprintf("HanChars[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return VocabularyServiceBatchImportHanCharStrokesRequestValidationError{
+					field:  fmt.Sprintf("HanChars[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return VocabularyServiceBatchImportHanCharStrokesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// VocabularyServiceBatchImportHanCharStrokesRequestMultiError is an error
+// wrapping multiple validation errors returned by
+// VocabularyServiceBatchImportHanCharStrokesRequest.ValidateAll() if the
+// designated constraints aren't met.
+type VocabularyServiceBatchImportHanCharStrokesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VocabularyServiceBatchImportHanCharStrokesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VocabularyServiceBatchImportHanCharStrokesRequestMultiError) AllErrors() []error { return m }
+
+// VocabularyServiceBatchImportHanCharStrokesRequestValidationError is the
+// validation error returned by
+// VocabularyServiceBatchImportHanCharStrokesRequest.Validate if the
+// designated constraints aren't met.
+type VocabularyServiceBatchImportHanCharStrokesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VocabularyServiceBatchImportHanCharStrokesRequestValidationError) Field() string {
+	return e.field
+}
+
+// Reason function returns reason value.
+func (e VocabularyServiceBatchImportHanCharStrokesRequestValidationError) Reason() string {
+	return e.reason
+}
+
+// Cause function returns cause value.
+func (e VocabularyServiceBatchImportHanCharStrokesRequestValidationError) Cause() error {
+	return e.cause
+}
+
+// Key function returns key value.
+func (e VocabularyServiceBatchImportHanCharStrokesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VocabularyServiceBatchImportHanCharStrokesRequestValidationError) ErrorName() string {
+	return "VocabularyServiceBatchImportHanCharStrokesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VocabularyServiceBatchImportHanCharStrokesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVocabularyServiceBatchImportHanCharStrokesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VocabularyServiceBatchImportHanCharStrokesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VocabularyServiceBatchImportHanCharStrokesRequestValidationError{}
+
+// Validate checks the field values on
+// VocabularyServiceBatchImportHanCharStrokesResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *VocabularyServiceBatchImportHanCharStrokesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// VocabularyServiceBatchImportHanCharStrokesResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in
+// VocabularyServiceBatchImportHanCharStrokesResponseMultiError, or nil if none found.
+func (m *VocabularyServiceBatchImportHanCharStrokesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VocabularyServiceBatchImportHanCharStrokesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Imported
+
+	if len(errors) > 0 {
+		return VocabularyServiceBatchImportHanCharStrokesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// VocabularyServiceBatchImportHanCharStrokesResponseMultiError is an error
+// wrapping multiple validation errors returned by
+// VocabularyServiceBatchImportHanCharStrokesResponse.ValidateAll() if the
+// designated constraints aren't met.
+type VocabularyServiceBatchImportHanCharStrokesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VocabularyServiceBatchImportHanCharStrokesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VocabularyServiceBatchImportHanCharStrokesResponseMultiError) AllErrors() []error { return m }
+
+// VocabularyServiceBatchImportHanCharStrokesResponseValidationError is the
+// validation error returned by
+// VocabularyServiceBatchImportHanCharStrokesResponse.Validate if the
+// designated constraints aren't met.
+type VocabularyServiceBatchImportHanCharStrokesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VocabularyServiceBatchImportHanCharStrokesResponseValidationError) Field() string {
+	return e.field
+}
+
+// Reason function returns reason value.
+func (e VocabularyServiceBatchImportHanCharStrokesResponseValidationError) Reason() string {
+	return e.reason
+}
+
+// Cause function returns cause value.
+func (e VocabularyServiceBatchImportHanCharStrokesResponseValidationError) Cause() error {
+	return e.cause
+}
+
+// Key function returns key value.
+func (e VocabularyServiceBatchImportHanCharStrokesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VocabularyServiceBatchImportHanCharStrokesResponseValidationError) ErrorName() string {
+	return "VocabularyServiceBatchImportHanCharStrokesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VocabularyServiceBatchImportHanCharStrokesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVocabularyServiceBatchImportHanCharStrokesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VocabularyServiceBatchImportHanCharStrokesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VocabularyServiceBatchImportHanCharStrokesResponseValidationError{}
+
+// Validate checks the field values on WordFormsData with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *WordFormsData) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WordFormsData with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in WordFormsDataMultiError, or
+// nil if none found.
+func (m *WordFormsData) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WordFormsData) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Lemma
+
+	for idx, item := range m.GetForms() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, WordFormsDataValidationError{
+						field:  fmt.Sprintf("Forms[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, WordFormsDataValidationError{
+						field:  fmt.Sprintf("Forms[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return WordFormsDataValidationError{
+					field:  fmt.Sprintf("Forms[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return WordFormsDataMultiError(errors)
+	}
+
+	return nil
+}
+
+// WordFormsDataMultiError is an error wrapping multiple validation errors
+// returned by WordFormsData.ValidateAll() if the designated constraints aren't met.
+type WordFormsDataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WordFormsDataMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WordFormsDataMultiError) AllErrors() []error { return m }
+
+// WordFormsDataValidationError is the validation error returned by
+// WordFormsData.Validate if the designated constraints aren't met.
+type WordFormsDataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WordFormsDataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WordFormsDataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WordFormsDataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WordFormsDataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WordFormsDataValidationError) ErrorName() string { return "WordFormsDataValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WordFormsDataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWordFormsData.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WordFormsDataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WordFormsDataValidationError{}
+
+// Validate checks the field values on
+// VocabularyServiceBatchImportWordFormsRequest with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *VocabularyServiceBatchImportWordFormsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// VocabularyServiceBatchImportWordFormsRequest with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in
+// VocabularyServiceBatchImportWordFormsRequestMultiError, or nil if none found.
+func (m *VocabularyServiceBatchImportWordFormsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VocabularyServiceBatchImportWordFormsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetWords() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, VocabularyServiceBatchImportWordFormsRequestValidationError{
+						field:  fmt.Sprintf("Words[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, VocabularyServiceBatchImportWordFormsRequestValidationError{
+						field:  fmt.Sprintf("Words[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return VocabularyServiceBatchImportWordFormsRequestValidationError{
+					field:  fmt.Sprintf("Words[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for FormList
+
+	if len(errors) > 0 {
+		return VocabularyServiceBatchImportWordFormsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// VocabularyServiceBatchImportWordFormsRequestMultiError is an error wrapping
+// multiple validation errors returned by
+// VocabularyServiceBatchImportWordFormsRequest.ValidateAll() if the
+// designated constraints aren't met.
+type VocabularyServiceBatchImportWordFormsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VocabularyServiceBatchImportWordFormsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VocabularyServiceBatchImportWordFormsRequestMultiError) AllErrors() []error { return m }
+
+// VocabularyServiceBatchImportWordFormsRequestValidationError is the
+// validation error returned by
+// VocabularyServiceBatchImportWordFormsRequest.Validate if the designated
+// constraints aren't met.
+type VocabularyServiceBatchImportWordFormsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VocabularyServiceBatchImportWordFormsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VocabularyServiceBatchImportWordFormsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VocabularyServiceBatchImportWordFormsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VocabularyServiceBatchImportWordFormsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VocabularyServiceBatchImportWordFormsRequestValidationError) ErrorName() string {
+	return "VocabularyServiceBatchImportWordFormsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VocabularyServiceBatchImportWordFormsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVocabularyServiceBatchImportWordFormsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VocabularyServiceBatchImportWordFormsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VocabularyServiceBatchImportWordFormsRequestValidationError{}
+
+// Validate checks the field values on
+// VocabularyServiceBatchImportWordFormsResponse with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *VocabularyServiceBatchImportWordFormsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// VocabularyServiceBatchImportWordFormsResponse with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in
+// VocabularyServiceBatchImportWordFormsResponseMultiError, or nil if none found.
+func (m *VocabularyServiceBatchImportWordFormsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VocabularyServiceBatchImportWordFormsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Imported
+
+	if len(errors) > 0 {
+		return VocabularyServiceBatchImportWordFormsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// VocabularyServiceBatchImportWordFormsResponseMultiError is an error wrapping
+// multiple validation errors returned by
+// VocabularyServiceBatchImportWordFormsResponse.ValidateAll() if the
+// designated constraints aren't met.
+type VocabularyServiceBatchImportWordFormsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VocabularyServiceBatchImportWordFormsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VocabularyServiceBatchImportWordFormsResponseMultiError) AllErrors() []error { return m }
+
+// VocabularyServiceBatchImportWordFormsResponseValidationError is the
+// validation error returned by
+// VocabularyServiceBatchImportWordFormsResponse.Validate if the designated
+// constraints aren't met.
+type VocabularyServiceBatchImportWordFormsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VocabularyServiceBatchImportWordFormsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VocabularyServiceBatchImportWordFormsResponseValidationError) Reason() string {
+	return e.reason
+}
+
+// Cause function returns cause value.
+func (e VocabularyServiceBatchImportWordFormsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VocabularyServiceBatchImportWordFormsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VocabularyServiceBatchImportWordFormsResponseValidationError) ErrorName() string {
+	return "VocabularyServiceBatchImportWordFormsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VocabularyServiceBatchImportWordFormsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVocabularyServiceBatchImportWordFormsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VocabularyServiceBatchImportWordFormsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VocabularyServiceBatchImportWordFormsResponseValidationError{}
+
+// Validate checks the field values on WordRelation with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *WordRelation) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WordRelation with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in WordRelationMultiError, or
+// nil if none found.
+func (m *WordRelation) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WordRelation) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Type
+
+	// no validation rules for Inverse
+
+	// no validation rules for Note
+
+	if all {
+		switch v := interface{}(m.GetWord()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WordRelationValidationError{
+					field:  "Word",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WordRelationValidationError{
+					field:  "Word",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWord()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WordRelationValidationError{
+				field:  "Word",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return WordRelationMultiError(errors)
+	}
+
+	return nil
+}
+
+// WordRelationMultiError is an error wrapping multiple validation errors
+// returned by WordRelation.ValidateAll() if the designated constraints aren't met.
+type WordRelationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WordRelationMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WordRelationMultiError) AllErrors() []error { return m }
+
+// WordRelationValidationError is the validation error returned by
+// WordRelation.Validate if the designated constraints aren't met.
+type WordRelationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WordRelationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WordRelationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WordRelationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WordRelationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WordRelationValidationError) ErrorName() string { return "WordRelationValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WordRelationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWordRelation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WordRelationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WordRelationValidationError{}
+
+// Validate checks the field values on HanCharRelation with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *HanCharRelation) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on HanCharRelation with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// HanCharRelationMultiError, or nil if none found.
+func (m *HanCharRelation) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *HanCharRelation) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Type
+
+	// no validation rules for Inverse
+
+	// no validation rules for Note
+
+	if all {
+		switch v := interface{}(m.GetHanChar()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, HanCharRelationValidationError{
+					field:  "HanChar",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, HanCharRelationValidationError{
+					field:  "HanChar",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetHanChar()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return HanCharRelationValidationError{
+				field:  "HanChar",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return HanCharRelationMultiError(errors)
+	}
+
+	return nil
+}
+
+// HanCharRelationMultiError is an error wrapping multiple validation errors
+// returned by HanCharRelation.ValidateAll() if the designated constraints aren't met.
+type HanCharRelationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m HanCharRelationMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m HanCharRelationMultiError) AllErrors() []error { return m }
+
+// HanCharRelationValidationError is the validation error returned by
+// HanCharRelation.Validate if the designated constraints aren't met.
+type HanCharRelationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HanCharRelationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HanCharRelationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HanCharRelationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HanCharRelationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HanCharRelationValidationError) ErrorName() string { return "HanCharRelationValidationError" }
+
+// Error satisfies the builtin error interface
+func (e HanCharRelationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHanCharRelation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HanCharRelationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HanCharRelationValidationError{}
+
+// Validate checks the field values on VocabularyServiceGetRelationsRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *VocabularyServiceGetRelationsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VocabularyServiceGetRelationsRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// VocabularyServiceGetRelationsRequestMultiError, or nil if none found.
+func (m *VocabularyServiceGetRelationsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VocabularyServiceGetRelationsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return VocabularyServiceGetRelationsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// VocabularyServiceGetRelationsRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// VocabularyServiceGetRelationsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type VocabularyServiceGetRelationsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VocabularyServiceGetRelationsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VocabularyServiceGetRelationsRequestMultiError) AllErrors() []error { return m }
+
+// VocabularyServiceGetRelationsRequestValidationError is the validation error
+// returned by VocabularyServiceGetRelationsRequest.Validate if the designated
+// constraints aren't met.
+type VocabularyServiceGetRelationsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VocabularyServiceGetRelationsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VocabularyServiceGetRelationsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VocabularyServiceGetRelationsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VocabularyServiceGetRelationsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VocabularyServiceGetRelationsRequestValidationError) ErrorName() string {
+	return "VocabularyServiceGetRelationsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VocabularyServiceGetRelationsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVocabularyServiceGetRelationsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VocabularyServiceGetRelationsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VocabularyServiceGetRelationsRequestValidationError{}
+
+// Validate checks the field values on VocabularyServiceGetRelationsResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *VocabularyServiceGetRelationsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VocabularyServiceGetRelationsResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// VocabularyServiceGetRelationsResponseMultiError, or nil if none found.
+func (m *VocabularyServiceGetRelationsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VocabularyServiceGetRelationsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetWord()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, VocabularyServiceGetRelationsResponseValidationError{
+					field:  "Word",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, VocabularyServiceGetRelationsResponseValidationError{
+					field:  "Word",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWord()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return VocabularyServiceGetRelationsResponseValidationError{
+				field:  "Word",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetRelations() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, VocabularyServiceGetRelationsResponseValidationError{
+						field:  fmt.Sprintf("Relations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, VocabularyServiceGetRelationsResponseValidationError{
+						field:  fmt.Sprintf("Relations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return VocabularyServiceGetRelationsResponseValidationError{
+					field:  fmt.Sprintf("Relations[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return VocabularyServiceGetRelationsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// VocabularyServiceGetRelationsResponseMultiError is an error wrapping
+// multiple validation errors returned by
+// VocabularyServiceGetRelationsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type VocabularyServiceGetRelationsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VocabularyServiceGetRelationsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VocabularyServiceGetRelationsResponseMultiError) AllErrors() []error { return m }
+
+// VocabularyServiceGetRelationsResponseValidationError is the validation error
+// returned by VocabularyServiceGetRelationsResponse.Validate if the
+// designated constraints aren't met.
+type VocabularyServiceGetRelationsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VocabularyServiceGetRelationsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VocabularyServiceGetRelationsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VocabularyServiceGetRelationsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VocabularyServiceGetRelationsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VocabularyServiceGetRelationsResponseValidationError) ErrorName() string {
+	return "VocabularyServiceGetRelationsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VocabularyServiceGetRelationsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVocabularyServiceGetRelationsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VocabularyServiceGetRelationsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VocabularyServiceGetRelationsResponseValidationError{}
+
+// Validate checks the field values on VocabularyServiceCreateRelationRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *VocabularyServiceCreateRelationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// VocabularyServiceCreateRelationRequest with the rules defined in the proto
+// definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in
+// VocabularyServiceCreateRelationRequestMultiError, or nil if none found.
+func (m *VocabularyServiceCreateRelationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VocabularyServiceCreateRelationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SourceId
+
+	// no validation rules for TargetId
+
+	// no validation rules for Type
+
+	// no validation rules for Note
+
+	if len(errors) > 0 {
+		return VocabularyServiceCreateRelationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// VocabularyServiceCreateRelationRequestMultiError is an error wrapping
+// multiple validation errors returned by
+// VocabularyServiceCreateRelationRequest.ValidateAll() if the designated
+// constraints aren't met.
+type VocabularyServiceCreateRelationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VocabularyServiceCreateRelationRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VocabularyServiceCreateRelationRequestMultiError) AllErrors() []error { return m }
+
+// VocabularyServiceCreateRelationRequestValidationError is the validation
+// error returned by VocabularyServiceCreateRelationRequest.Validate if the
+// designated constraints aren't met.
+type VocabularyServiceCreateRelationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VocabularyServiceCreateRelationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VocabularyServiceCreateRelationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VocabularyServiceCreateRelationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VocabularyServiceCreateRelationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VocabularyServiceCreateRelationRequestValidationError) ErrorName() string {
+	return "VocabularyServiceCreateRelationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VocabularyServiceCreateRelationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVocabularyServiceCreateRelationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VocabularyServiceCreateRelationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VocabularyServiceCreateRelationRequestValidationError{}
+
+// Validate checks the field values on VocabularyServiceCreateRelationResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *VocabularyServiceCreateRelationResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// VocabularyServiceCreateRelationResponse with the rules defined in the proto
+// definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in
+// VocabularyServiceCreateRelationResponseMultiError, or nil if none found.
+func (m *VocabularyServiceCreateRelationResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VocabularyServiceCreateRelationResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRelation()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, VocabularyServiceCreateRelationResponseValidationError{
+					field:  "Relation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, VocabularyServiceCreateRelationResponseValidationError{
+					field:  "Relation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRelation()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return VocabularyServiceCreateRelationResponseValidationError{
+				field:  "Relation",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return VocabularyServiceCreateRelationResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// VocabularyServiceCreateRelationResponseMultiError is an error wrapping
+// multiple validation errors returned by
+// VocabularyServiceCreateRelationResponse.ValidateAll() if the designated
+// constraints aren't met.
+type VocabularyServiceCreateRelationResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VocabularyServiceCreateRelationResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VocabularyServiceCreateRelationResponseMultiError) AllErrors() []error { return m }
+
+// VocabularyServiceCreateRelationResponseValidationError is the validation
+// error returned by VocabularyServiceCreateRelationResponse.Validate if the
+// designated constraints aren't met.
+type VocabularyServiceCreateRelationResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VocabularyServiceCreateRelationResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VocabularyServiceCreateRelationResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VocabularyServiceCreateRelationResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VocabularyServiceCreateRelationResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VocabularyServiceCreateRelationResponseValidationError) ErrorName() string {
+	return "VocabularyServiceCreateRelationResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VocabularyServiceCreateRelationResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVocabularyServiceCreateRelationResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VocabularyServiceCreateRelationResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VocabularyServiceCreateRelationResponseValidationError{}
+
+// Validate checks the field values on VocabularyServiceDeleteRelationRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *VocabularyServiceDeleteRelationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// VocabularyServiceDeleteRelationRequest with the rules defined in the proto
+// definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in
+// VocabularyServiceDeleteRelationRequestMultiError, or nil if none found.
+func (m *VocabularyServiceDeleteRelationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VocabularyServiceDeleteRelationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return VocabularyServiceDeleteRelationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// VocabularyServiceDeleteRelationRequestMultiError is an error wrapping
+// multiple validation errors returned by
+// VocabularyServiceDeleteRelationRequest.ValidateAll() if the designated
+// constraints aren't met.
+type VocabularyServiceDeleteRelationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VocabularyServiceDeleteRelationRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VocabularyServiceDeleteRelationRequestMultiError) AllErrors() []error { return m }
+
+// VocabularyServiceDeleteRelationRequestValidationError is the validation
+// error returned by VocabularyServiceDeleteRelationRequest.Validate if the
+// designated constraints aren't met.
+type VocabularyServiceDeleteRelationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VocabularyServiceDeleteRelationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VocabularyServiceDeleteRelationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VocabularyServiceDeleteRelationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VocabularyServiceDeleteRelationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VocabularyServiceDeleteRelationRequestValidationError) ErrorName() string {
+	return "VocabularyServiceDeleteRelationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VocabularyServiceDeleteRelationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVocabularyServiceDeleteRelationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VocabularyServiceDeleteRelationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VocabularyServiceDeleteRelationRequestValidationError{}
+
+// Validate checks the field values on VocabularyServiceDeleteRelationResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *VocabularyServiceDeleteRelationResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// VocabularyServiceDeleteRelationResponse with the rules defined in the proto
+// definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in
+// VocabularyServiceDeleteRelationResponseMultiError, or nil if none found.
+func (m *VocabularyServiceDeleteRelationResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VocabularyServiceDeleteRelationResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return VocabularyServiceDeleteRelationResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// VocabularyServiceDeleteRelationResponseMultiError is an error wrapping
+// multiple validation errors returned by
+// VocabularyServiceDeleteRelationResponse.ValidateAll() if the designated
+// constraints aren't met.
+type VocabularyServiceDeleteRelationResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VocabularyServiceDeleteRelationResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VocabularyServiceDeleteRelationResponseMultiError) AllErrors() []error { return m }
+
+// VocabularyServiceDeleteRelationResponseValidationError is the validation
+// error returned by VocabularyServiceDeleteRelationResponse.Validate if the
+// designated constraints aren't met.
+type VocabularyServiceDeleteRelationResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VocabularyServiceDeleteRelationResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VocabularyServiceDeleteRelationResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VocabularyServiceDeleteRelationResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VocabularyServiceDeleteRelationResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VocabularyServiceDeleteRelationResponseValidationError) ErrorName() string {
+	return "VocabularyServiceDeleteRelationResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VocabularyServiceDeleteRelationResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVocabularyServiceDeleteRelationResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VocabularyServiceDeleteRelationResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VocabularyServiceDeleteRelationResponseValidationError{}
+
+// Validate checks the field values on
+// VocabularyServiceGetHanCharRelationsRequest with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *VocabularyServiceGetHanCharRelationsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// VocabularyServiceGetHanCharRelationsRequest with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in
+// VocabularyServiceGetHanCharRelationsRequestMultiError, or nil if none found.
+func (m *VocabularyServiceGetHanCharRelationsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VocabularyServiceGetHanCharRelationsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return VocabularyServiceGetHanCharRelationsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// VocabularyServiceGetHanCharRelationsRequestMultiError is an error wrapping
+// multiple validation errors returned by
+// VocabularyServiceGetHanCharRelationsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type VocabularyServiceGetHanCharRelationsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VocabularyServiceGetHanCharRelationsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VocabularyServiceGetHanCharRelationsRequestMultiError) AllErrors() []error { return m }
+
+// VocabularyServiceGetHanCharRelationsRequestValidationError is the validation
+// error returned by VocabularyServiceGetHanCharRelationsRequest.Validate if
+// the designated constraints aren't met.
+type VocabularyServiceGetHanCharRelationsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VocabularyServiceGetHanCharRelationsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VocabularyServiceGetHanCharRelationsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VocabularyServiceGetHanCharRelationsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VocabularyServiceGetHanCharRelationsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VocabularyServiceGetHanCharRelationsRequestValidationError) ErrorName() string {
+	return "VocabularyServiceGetHanCharRelationsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VocabularyServiceGetHanCharRelationsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVocabularyServiceGetHanCharRelationsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VocabularyServiceGetHanCharRelationsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VocabularyServiceGetHanCharRelationsRequestValidationError{}
+
+// Validate checks the field values on
+// VocabularyServiceGetHanCharRelationsResponse with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *VocabularyServiceGetHanCharRelationsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// VocabularyServiceGetHanCharRelationsResponse with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in
+// VocabularyServiceGetHanCharRelationsResponseMultiError, or nil if none found.
+func (m *VocabularyServiceGetHanCharRelationsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VocabularyServiceGetHanCharRelationsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetHanChar()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, VocabularyServiceGetHanCharRelationsResponseValidationError{
+					field:  "HanChar",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, VocabularyServiceGetHanCharRelationsResponseValidationError{
+					field:  "HanChar",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetHanChar()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return VocabularyServiceGetHanCharRelationsResponseValidationError{
+				field:  "HanChar",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetRelations() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, VocabularyServiceGetHanCharRelationsResponseValidationError{
+						field:  fmt.Sprintf("Relations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, VocabularyServiceGetHanCharRelationsResponseValidationError{
+						field:  fmt.Sprintf("Relations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return VocabularyServiceGetHanCharRelationsResponseValidationError{
+					field:  fmt.Sprintf("Relations[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return VocabularyServiceGetHanCharRelationsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// VocabularyServiceGetHanCharRelationsResponseMultiError is an error wrapping
+// multiple validation errors returned by
+// VocabularyServiceGetHanCharRelationsResponse.ValidateAll() if the
+// designated constraints aren't met.
+type VocabularyServiceGetHanCharRelationsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VocabularyServiceGetHanCharRelationsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VocabularyServiceGetHanCharRelationsResponseMultiError) AllErrors() []error { return m }
+
+// VocabularyServiceGetHanCharRelationsResponseValidationError is the
+// validation error returned by
+// VocabularyServiceGetHanCharRelationsResponse.Validate if the designated
+// constraints aren't met.
+type VocabularyServiceGetHanCharRelationsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VocabularyServiceGetHanCharRelationsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VocabularyServiceGetHanCharRelationsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VocabularyServiceGetHanCharRelationsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VocabularyServiceGetHanCharRelationsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VocabularyServiceGetHanCharRelationsResponseValidationError) ErrorName() string {
+	return "VocabularyServiceGetHanCharRelationsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VocabularyServiceGetHanCharRelationsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVocabularyServiceGetHanCharRelationsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VocabularyServiceGetHanCharRelationsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VocabularyServiceGetHanCharRelationsResponseValidationError{}
+
+// Validate checks the field values on
+// VocabularyServiceCreateHanCharRelationRequest with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *VocabularyServiceCreateHanCharRelationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// VocabularyServiceCreateHanCharRelationRequest with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in
+// VocabularyServiceCreateHanCharRelationRequestMultiError, or nil if none found.
+func (m *VocabularyServiceCreateHanCharRelationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VocabularyServiceCreateHanCharRelationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SourceId
+
+	// no validation rules for TargetId
+
+	// no validation rules for Type
+
+	// no validation rules for Note
+
+	if len(errors) > 0 {
+		return VocabularyServiceCreateHanCharRelationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// VocabularyServiceCreateHanCharRelationRequestMultiError is an error wrapping
+// multiple validation errors returned by
+// VocabularyServiceCreateHanCharRelationRequest.ValidateAll() if the
+// designated constraints aren't met.
+type VocabularyServiceCreateHanCharRelationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VocabularyServiceCreateHanCharRelationRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VocabularyServiceCreateHanCharRelationRequestMultiError) AllErrors() []error { return m }
+
+// VocabularyServiceCreateHanCharRelationRequestValidationError is the
+// validation error returned by
+// VocabularyServiceCreateHanCharRelationRequest.Validate if the designated
+// constraints aren't met.
+type VocabularyServiceCreateHanCharRelationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VocabularyServiceCreateHanCharRelationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VocabularyServiceCreateHanCharRelationRequestValidationError) Reason() string {
+	return e.reason
+}
+
+// Cause function returns cause value.
+func (e VocabularyServiceCreateHanCharRelationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VocabularyServiceCreateHanCharRelationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VocabularyServiceCreateHanCharRelationRequestValidationError) ErrorName() string {
+	return "VocabularyServiceCreateHanCharRelationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VocabularyServiceCreateHanCharRelationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVocabularyServiceCreateHanCharRelationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VocabularyServiceCreateHanCharRelationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VocabularyServiceCreateHanCharRelationRequestValidationError{}
+
+// Validate checks the field values on
+// VocabularyServiceCreateHanCharRelationResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *VocabularyServiceCreateHanCharRelationResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// VocabularyServiceCreateHanCharRelationResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in
+// VocabularyServiceCreateHanCharRelationResponseMultiError, or nil if none found.
+func (m *VocabularyServiceCreateHanCharRelationResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VocabularyServiceCreateHanCharRelationResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRelation()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, VocabularyServiceCreateHanCharRelationResponseValidationError{
+					field:  "Relation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, VocabularyServiceCreateHanCharRelationResponseValidationError{
+					field:  "Relation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRelation()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return VocabularyServiceCreateHanCharRelationResponseValidationError{
+				field:  "Relation",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return VocabularyServiceCreateHanCharRelationResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// VocabularyServiceCreateHanCharRelationResponseMultiError is an error
+// wrapping multiple validation errors returned by
+// VocabularyServiceCreateHanCharRelationResponse.ValidateAll() if the
+// designated constraints aren't met.
+type VocabularyServiceCreateHanCharRelationResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VocabularyServiceCreateHanCharRelationResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VocabularyServiceCreateHanCharRelationResponseMultiError) AllErrors() []error { return m }
+
+// VocabularyServiceCreateHanCharRelationResponseValidationError is the
+// validation error returned by
+// VocabularyServiceCreateHanCharRelationResponse.Validate if the designated
+// constraints aren't met.
+type VocabularyServiceCreateHanCharRelationResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VocabularyServiceCreateHanCharRelationResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VocabularyServiceCreateHanCharRelationResponseValidationError) Reason() string {
+	return e.reason
+}
+
+// Cause function returns cause value.
+func (e VocabularyServiceCreateHanCharRelationResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VocabularyServiceCreateHanCharRelationResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VocabularyServiceCreateHanCharRelationResponseValidationError) ErrorName() string {
+	return "VocabularyServiceCreateHanCharRelationResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VocabularyServiceCreateHanCharRelationResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVocabularyServiceCreateHanCharRelationResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VocabularyServiceCreateHanCharRelationResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VocabularyServiceCreateHanCharRelationResponseValidationError{}
+
+// Validate checks the field values on
+// VocabularyServiceDeleteHanCharRelationRequest with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *VocabularyServiceDeleteHanCharRelationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// VocabularyServiceDeleteHanCharRelationRequest with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in
+// VocabularyServiceDeleteHanCharRelationRequestMultiError, or nil if none found.
+func (m *VocabularyServiceDeleteHanCharRelationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VocabularyServiceDeleteHanCharRelationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return VocabularyServiceDeleteHanCharRelationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// VocabularyServiceDeleteHanCharRelationRequestMultiError is an error wrapping
+// multiple validation errors returned by
+// VocabularyServiceDeleteHanCharRelationRequest.ValidateAll() if the
+// designated constraints aren't met.
+type VocabularyServiceDeleteHanCharRelationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VocabularyServiceDeleteHanCharRelationRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VocabularyServiceDeleteHanCharRelationRequestMultiError) AllErrors() []error { return m }
+
+// VocabularyServiceDeleteHanCharRelationRequestValidationError is the
+// validation error returned by
+// VocabularyServiceDeleteHanCharRelationRequest.Validate if the designated
+// constraints aren't met.
+type VocabularyServiceDeleteHanCharRelationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VocabularyServiceDeleteHanCharRelationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VocabularyServiceDeleteHanCharRelationRequestValidationError) Reason() string {
+	return e.reason
+}
+
+// Cause function returns cause value.
+func (e VocabularyServiceDeleteHanCharRelationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VocabularyServiceDeleteHanCharRelationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VocabularyServiceDeleteHanCharRelationRequestValidationError) ErrorName() string {
+	return "VocabularyServiceDeleteHanCharRelationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VocabularyServiceDeleteHanCharRelationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVocabularyServiceDeleteHanCharRelationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VocabularyServiceDeleteHanCharRelationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VocabularyServiceDeleteHanCharRelationRequestValidationError{}
+
+// Validate checks the field values on
+// VocabularyServiceDeleteHanCharRelationResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *VocabularyServiceDeleteHanCharRelationResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// VocabularyServiceDeleteHanCharRelationResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in
+// VocabularyServiceDeleteHanCharRelationResponseMultiError, or nil if none found.
+func (m *VocabularyServiceDeleteHanCharRelationResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VocabularyServiceDeleteHanCharRelationResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return VocabularyServiceDeleteHanCharRelationResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// VocabularyServiceDeleteHanCharRelationResponseMultiError is an error
+// wrapping multiple validation errors returned by
+// VocabularyServiceDeleteHanCharRelationResponse.ValidateAll() if the
+// designated constraints aren't met.
+type VocabularyServiceDeleteHanCharRelationResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VocabularyServiceDeleteHanCharRelationResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VocabularyServiceDeleteHanCharRelationResponseMultiError) AllErrors() []error { return m }
+
+// VocabularyServiceDeleteHanCharRelationResponseValidationError is the
+// validation error returned by
+// VocabularyServiceDeleteHanCharRelationResponse.Validate if the designated
+// constraints aren't met.
+type VocabularyServiceDeleteHanCharRelationResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VocabularyServiceDeleteHanCharRelationResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VocabularyServiceDeleteHanCharRelationResponseValidationError) Reason() string {
+	return e.reason
+}
+
+// Cause function returns cause value.
+func (e VocabularyServiceDeleteHanCharRelationResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VocabularyServiceDeleteHanCharRelationResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VocabularyServiceDeleteHanCharRelationResponseValidationError) ErrorName() string {
+	return "VocabularyServiceDeleteHanCharRelationResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VocabularyServiceDeleteHanCharRelationResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVocabularyServiceDeleteHanCharRelationResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VocabularyServiceDeleteHanCharRelationResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VocabularyServiceDeleteHanCharRelationResponseValidationError{}
+
+// Validate checks the field values on RelationData with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RelationData) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RelationData with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RelationDataMultiError, or
+// nil if none found.
+func (m *RelationData) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RelationData) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Source
+
+	// no validation rules for Target
+
+	// no validation rules for Type
+
+	// no validation rules for Note
+
+	if len(errors) > 0 {
+		return RelationDataMultiError(errors)
+	}
+
+	return nil
+}
+
+// RelationDataMultiError is an error wrapping multiple validation errors
+// returned by RelationData.ValidateAll() if the designated constraints aren't met.
+type RelationDataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RelationDataMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RelationDataMultiError) AllErrors() []error { return m }
+
+// RelationDataValidationError is the validation error returned by
+// RelationData.Validate if the designated constraints aren't met.
+type RelationDataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RelationDataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RelationDataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RelationDataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RelationDataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RelationDataValidationError) ErrorName() string { return "RelationDataValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RelationDataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRelationData.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RelationDataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RelationDataValidationError{}
+
+// Validate checks the field values on
+// VocabularyServiceBatchImportRelationsRequest with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *VocabularyServiceBatchImportRelationsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// VocabularyServiceBatchImportRelationsRequest with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in
+// VocabularyServiceBatchImportRelationsRequestMultiError, or nil if none found.
+func (m *VocabularyServiceBatchImportRelationsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VocabularyServiceBatchImportRelationsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetWords() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, VocabularyServiceBatchImportRelationsRequestValidationError{
+						field:  fmt.Sprintf("Words[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, VocabularyServiceBatchImportRelationsRequestValidationError{
+						field:  fmt.Sprintf("Words[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return VocabularyServiceBatchImportRelationsRequestValidationError{
+					field:  fmt.Sprintf("Words[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetHanChars() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, VocabularyServiceBatchImportRelationsRequestValidationError{
+						field:  fmt.Sprintf("HanChars[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, VocabularyServiceBatchImportRelationsRequestValidationError{
+						field:  fmt.Sprintf("HanChars[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return VocabularyServiceBatchImportRelationsRequestValidationError{
+					field:  fmt.Sprintf("HanChars[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return VocabularyServiceBatchImportRelationsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// VocabularyServiceBatchImportRelationsRequestMultiError is an error wrapping
+// multiple validation errors returned by
+// VocabularyServiceBatchImportRelationsRequest.ValidateAll() if the
+// designated constraints aren't met.
+type VocabularyServiceBatchImportRelationsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VocabularyServiceBatchImportRelationsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VocabularyServiceBatchImportRelationsRequestMultiError) AllErrors() []error { return m }
+
+// VocabularyServiceBatchImportRelationsRequestValidationError is the
+// validation error returned by
+// VocabularyServiceBatchImportRelationsRequest.Validate if the designated
+// constraints aren't met.
+type VocabularyServiceBatchImportRelationsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VocabularyServiceBatchImportRelationsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VocabularyServiceBatchImportRelationsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VocabularyServiceBatchImportRelationsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VocabularyServiceBatchImportRelationsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VocabularyServiceBatchImportRelationsRequestValidationError) ErrorName() string {
+	return "VocabularyServiceBatchImportRelationsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VocabularyServiceBatchImportRelationsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVocabularyServiceBatchImportRelationsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VocabularyServiceBatchImportRelationsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VocabularyServiceBatchImportRelationsRequestValidationError{}
+
+// Validate checks the field values on
+// VocabularyServiceBatchImportRelationsResponse with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *VocabularyServiceBatchImportRelationsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// VocabularyServiceBatchImportRelationsResponse with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in
+// VocabularyServiceBatchImportRelationsResponseMultiError, or nil if none found.
+func (m *VocabularyServiceBatchImportRelationsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VocabularyServiceBatchImportRelationsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Created
+
+	// no validation rules for Skipped
+
+	if len(errors) > 0 {
+		return VocabularyServiceBatchImportRelationsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// VocabularyServiceBatchImportRelationsResponseMultiError is an error wrapping
+// multiple validation errors returned by
+// VocabularyServiceBatchImportRelationsResponse.ValidateAll() if the
+// designated constraints aren't met.
+type VocabularyServiceBatchImportRelationsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VocabularyServiceBatchImportRelationsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VocabularyServiceBatchImportRelationsResponseMultiError) AllErrors() []error { return m }
+
+// VocabularyServiceBatchImportRelationsResponseValidationError is the
+// validation error returned by
+// VocabularyServiceBatchImportRelationsResponse.Validate if the designated
+// constraints aren't met.
+type VocabularyServiceBatchImportRelationsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VocabularyServiceBatchImportRelationsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VocabularyServiceBatchImportRelationsResponseValidationError) Reason() string {
+	return e.reason
+}
+
+// Cause function returns cause value.
+func (e VocabularyServiceBatchImportRelationsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VocabularyServiceBatchImportRelationsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VocabularyServiceBatchImportRelationsResponseValidationError) ErrorName() string {
+	return "VocabularyServiceBatchImportRelationsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VocabularyServiceBatchImportRelationsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVocabularyServiceBatchImportRelationsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VocabularyServiceBatchImportRelationsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VocabularyServiceBatchImportRelationsResponseValidationError{}
+
+// Validate checks the field values on VocabularyServiceSearchRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *VocabularyServiceSearchRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VocabularyServiceSearchRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// VocabularyServiceSearchRequestMultiError, or nil if none found.
+func (m *VocabularyServiceSearchRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VocabularyServiceSearchRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Keyword
+
+	// no validation rules for Page
+
+	// no validation rules for PageSize
+
+	// no validation rules for Level
+
+	// no validation rules for Fuzzy
+
+	if len(errors) > 0 {
+		return VocabularyServiceSearchRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// VocabularyServiceSearchRequestMultiError is an error wrapping multiple
+// validation errors returned by VocabularyServiceSearchRequest.ValidateAll()
+// if the designated constraints aren't met.
+type VocabularyServiceSearchRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VocabularyServiceSearchRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VocabularyServiceSearchRequestMultiError) AllErrors() []error { return m }
+
+// VocabularyServiceSearchRequestValidationError is the validation error
+// returned by VocabularyServiceSearchRequest.Validate if the designated
+// constraints aren't met.
+type VocabularyServiceSearchRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VocabularyServiceSearchRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VocabularyServiceSearchRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VocabularyServiceSearchRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VocabularyServiceSearchRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VocabularyServiceSearchRequestValidationError) ErrorName() string {
+	return "VocabularyServiceSearchRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VocabularyServiceSearchRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVocabularyServiceSearchRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VocabularyServiceSearchRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VocabularyServiceSearchRequestValidationError{}
+
+// Validate checks the field values on WordSearchHighlight with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WordSearchHighlight) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WordSearchHighlight with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WordSearchHighlightMultiError, or nil if none found.
+func (m *WordSearchHighlight) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WordSearchHighlight) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Field
+
+	// no validation rules for Fragment
+
+	if len(errors) > 0 {
+		return WordSearchHighlightMultiError(errors)
+	}
+
+	return nil
+}
+
+// WordSearchHighlightMultiError is an error wrapping multiple validation
+// errors returned by WordSearchHighlight.ValidateAll() if the designated
+// constraints aren't met.
+type WordSearchHighlightMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WordSearchHighlightMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WordSearchHighlightMultiError) AllErrors() []error { return m }
+
+// WordSearchHighlightValidationError is the validation error returned by
+// WordSearchHighlight.Validate if the designated constraints aren't met.
+type WordSearchHighlightValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WordSearchHighlightValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WordSearchHighlightValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WordSearchHighlightValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WordSearchHighlightValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WordSearchHighlightValidationError) ErrorName() string {
+	return "WordSearchHighlightValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WordSearchHighlightValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWordSearchHighlight.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WordSearchHighlightValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WordSearchHighlightValidationError{}
+
+// Validate checks the field values on WordSearchResult with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *WordSearchResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WordSearchResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WordSearchResultMultiError, or nil if none found.
+func (m *WordSearchResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WordSearchResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetWord()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WordSearchResultValidationError{
+					field:  "Word",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WordSearchResultValidationError{
+					field:  "Word",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWord()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WordSearchResultValidationError{
+				field:  "Word",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Rank
+
+	for idx, item := range m.GetHighlights() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, WordSearchResultValidationError{
+						field:  fmt.Sprintf("Highlights[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, WordSearchResultValidationError{
+						field:  fmt.Sprintf("Highlights[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return WordSearchResultValidationError{
+					field:  fmt.Sprintf("Highlights[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetMatchedForm()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WordSearchResultValidationError{
+					field:  "MatchedForm",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WordSearchResultValidationError{
+					field:  "MatchedForm",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMatchedForm()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WordSearchResultValidationError{
+				field:  "MatchedForm",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return WordSearchResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// WordSearchResultMultiError is an error wrapping multiple validation errors
+// returned by WordSearchResult.ValidateAll() if the designated constraints aren't met.
+type WordSearchResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WordSearchResultMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WordSearchResultMultiError) AllErrors() []error { return m }
+
+// WordSearchResultValidationError is the validation error returned by
+// WordSearchResult.Validate if the designated constraints aren't met.
+type WordSearchResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WordSearchResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WordSearchResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WordSearchResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WordSearchResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WordSearchResultValidationError) ErrorName() string { return "WordSearchResultValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WordSearchResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWordSearchResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WordSearchResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WordSearchResultValidationError{}
+
+// Validate checks the field values on VocabularyServiceSearchResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *VocabularyServiceSearchResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VocabularyServiceSearchResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// VocabularyServiceSearchResponseMultiError, or nil if none found.
+func (m *VocabularyServiceSearchResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VocabularyServiceSearchResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, VocabularyServiceSearchResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, VocabularyServiceSearchResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return VocabularyServiceSearchResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	if len(errors) > 0 {
+		return VocabularyServiceSearchResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// VocabularyServiceSearchResponseMultiError is an error wrapping multiple
+// validation errors returned by VocabularyServiceSearchResponse.ValidateAll()
+// if the designated constraints aren't met.
+type VocabularyServiceSearchResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VocabularyServiceSearchResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VocabularyServiceSearchResponseMultiError) AllErrors() []error { return m }
+
+// VocabularyServiceSearchResponseValidationError is the validation error
+// returned by VocabularyServiceSearchResponse.Validate if the designated
+// constraints aren't met.
+type VocabularyServiceSearchResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VocabularyServiceSearchResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VocabularyServiceSearchResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VocabularyServiceSearchResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VocabularyServiceSearchResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VocabularyServiceSearchResponseValidationError) ErrorName() string {
+	return "VocabularyServiceSearchResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VocabularyServiceSearchResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVocabularyServiceSearchResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VocabularyServiceSearchResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VocabularyServiceSearchResponseValidationError{}
+
+// Validate checks the field values on VocabularyServiceCreateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *VocabularyServiceCreateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VocabularyServiceCreateRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// VocabularyServiceCreateRequestMultiError, or nil if none found.
+func (m *VocabularyServiceCreateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VocabularyServiceCreateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetWord()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, VocabularyServiceCreateRequestValidationError{
+					field:  "Word",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, VocabularyServiceCreateRequestValidationError{
+					field:  "Word",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWord()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return VocabularyServiceCreateRequestValidationError{
+				field:  "Word",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return VocabularyServiceListRequestMultiError(errors)
+		return VocabularyServiceCreateRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// VocabularyServiceListRequestMultiError is an error wrapping multiple
-// validation errors returned by VocabularyServiceListRequest.ValidateAll() if
-// the designated constraints aren't met.
-type VocabularyServiceListRequestMultiError []error
+// VocabularyServiceCreateRequestMultiError is an error wrapping multiple
+// validation errors returned by VocabularyServiceCreateRequest.ValidateAll()
+// if the designated constraints aren't met.
+type VocabularyServiceCreateRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m VocabularyServiceListRequestMultiError) Error() string {
+func (m VocabularyServiceCreateRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -738,12 +7159,12 @@ func (m VocabularyServiceListRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m VocabularyServiceListRequestMultiError) AllErrors() []error { return m }
+func (m VocabularyServiceCreateRequestMultiError) AllErrors() []error { return m }
 
-// VocabularyServiceListRequestValidationError is the validation error returned
-// by VocabularyServiceListRequest.Validate if the designated constraints
-// aren't met.
-type VocabularyServiceListRequestValidationError struct {
+// VocabularyServiceCreateRequestValidationError is the validation error
+// returned by VocabularyServiceCreateRequest.Validate if the designated
+// constraints aren't met.
+type VocabularyServiceCreateRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -751,24 +7172,24 @@ type VocabularyServiceListRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e VocabularyServiceListRequestValidationError) Field() string { return e.field }
+func (e VocabularyServiceCreateRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e VocabularyServiceListRequestValidationError) Reason() string { return e.reason }
+func (e VocabularyServiceCreateRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e VocabularyServiceListRequestValidationError) Cause() error { return e.cause }
+func (e VocabularyServiceCreateRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e VocabularyServiceListRequestValidationError) Key() bool { return e.key }
+func (e VocabularyServiceCreateRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e VocabularyServiceListRequestValidationError) ErrorName() string {
-	return "VocabularyServiceListRequestValidationError"
+func (e VocabularyServiceCreateRequestValidationError) ErrorName() string {
+	return "VocabularyServiceCreateRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e VocabularyServiceListRequestValidationError) Error() string {
+func (e VocabularyServiceCreateRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -780,14 +7201,14 @@ func (e VocabularyServiceListRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sVocabularyServiceListRequest.%s: %s%s",
+		"invalid %sVocabularyServiceCreateRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = VocabularyServiceListRequestValidationError{}
+var _ error = VocabularyServiceCreateRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -795,80 +7216,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = VocabularyServiceListRequestValidationError{}
+} = VocabularyServiceCreateRequestValidationError{}
 
-// Validate checks the field values on VocabularyServiceListResponse with the
+// Validate checks the field values on VocabularyServiceCreateResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *VocabularyServiceListResponse) Validate() error {
+func (m *VocabularyServiceCreateResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on VocabularyServiceListResponse with
+// ValidateAll checks the field values on VocabularyServiceCreateResponse with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, the result is a list of violation errors wrapped in
-// VocabularyServiceListResponseMultiError, or nil if none found.
-func (m *VocabularyServiceListResponse) ValidateAll() error {
+// VocabularyServiceCreateResponseMultiError, or nil if none found.
+func (m *VocabularyServiceCreateResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *VocabularyServiceListResponse) validate(all bool) error {
+func (m *VocabularyServiceCreateResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetWords() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, VocabularyServiceListResponseValidationError{
-						field:  fmt.Sprintf("Words[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, VocabularyServiceListResponseValidationError{
-						field:  fmt.Sprintf("Words[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetWord()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, VocabularyServiceCreateResponseValidationError{
+					field:  "Word",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return VocabularyServiceListResponseValidationError{
-					field:  fmt.Sprintf("Words[%v]", idx),
+				errors = append(errors, VocabularyServiceCreateResponseValidationError{
+					field:  "Word",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWord()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return VocabularyServiceCreateResponseValidationError{
+				field:  "Word",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
 	}
 
-	// no validation rules for Total
-
 	if len(errors) > 0 {
-		return VocabularyServiceListResponseMultiError(errors)
+		return VocabularyServiceCreateResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// VocabularyServiceListResponseMultiError is an error wrapping multiple
-// validation errors returned by VocabularyServiceListResponse.ValidateAll()
+// VocabularyServiceCreateResponseMultiError is an error wrapping multiple
+// validation errors returned by VocabularyServiceCreateResponse.ValidateAll()
 // if the designated constraints aren't met.
-type VocabularyServiceListResponseMultiError []error
+type VocabularyServiceCreateResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m VocabularyServiceListResponseMultiError) Error() string {
+func (m VocabularyServiceCreateResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -877,12 +7291,12 @@ func (m VocabularyServiceListResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m VocabularyServiceListResponseMultiError) AllErrors() []error { return m }
+func (m VocabularyServiceCreateResponseMultiError) AllErrors() []error { return m }
 
-// VocabularyServiceListResponseValidationError is the validation error
-// returned by VocabularyServiceListResponse.Validate if the designated
+// VocabularyServiceCreateResponseValidationError is the validation error
+// returned by VocabularyServiceCreateResponse.Validate if the designated
 // constraints aren't met.
-type VocabularyServiceListResponseValidationError struct {
+type VocabularyServiceCreateResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -890,24 +7304,24 @@ type VocabularyServiceListResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e VocabularyServiceListResponseValidationError) Field() string { return e.field }
+func (e VocabularyServiceCreateResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e VocabularyServiceListResponseValidationError) Reason() string { return e.reason }
+func (e VocabularyServiceCreateResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e VocabularyServiceListResponseValidationError) Cause() error { return e.cause }
+func (e VocabularyServiceCreateResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e VocabularyServiceListResponseValidationError) Key() bool { return e.key }
+func (e VocabularyServiceCreateResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e VocabularyServiceListResponseValidationError) ErrorName() string {
-	return "VocabularyServiceListResponseValidationError"
+func (e VocabularyServiceCreateResponseValidationError) ErrorName() string {
+	return "VocabularyServiceCreateResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e VocabularyServiceListResponseValidationError) Error() string {
+func (e VocabularyServiceCreateResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -919,14 +7333,14 @@ func (e VocabularyServiceListResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sVocabularyServiceListResponse.%s: %s%s",
+		"invalid %sVocabularyServiceCreateResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = VocabularyServiceListResponseValidationError{}
+var _ error = VocabularyServiceCreateResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -934,44 +7348,75 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = VocabularyServiceListResponseValidationError{}
+} = VocabularyServiceCreateResponseValidationError{}
 
-// Validate checks the field values on VocabularyServiceAllTagsRequest with the
+// Validate checks the field values on VocabularyServiceUpdateRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *VocabularyServiceAllTagsRequest) Validate() error {
+func (m *VocabularyServiceUpdateRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on VocabularyServiceAllTagsRequest with
+// ValidateAll checks the field values on VocabularyServiceUpdateRequest with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, the result is a list of violation errors wrapped in
-// VocabularyServiceAllTagsRequestMultiError, or nil if none found.
-func (m *VocabularyServiceAllTagsRequest) ValidateAll() error {
+// VocabularyServiceUpdateRequestMultiError, or nil if none found.
+func (m *VocabularyServiceUpdateRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *VocabularyServiceAllTagsRequest) validate(all bool) error {
+func (m *VocabularyServiceUpdateRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Id
+
+	if all {
+		switch v := interface{}(m.GetWord()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, VocabularyServiceUpdateRequestValidationError{
+					field:  "Word",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, VocabularyServiceUpdateRequestValidationError{
+					field:  "Word",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWord()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return VocabularyServiceUpdateRequestValidationError{
+				field:  "Word",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return VocabularyServiceAllTagsRequestMultiError(errors)
+		return VocabularyServiceUpdateRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// VocabularyServiceAllTagsRequestMultiError is an error wrapping multiple
-// validation errors returned by VocabularyServiceAllTagsRequest.ValidateAll()
+// VocabularyServiceUpdateRequestMultiError is an error wrapping multiple
+// validation errors returned by VocabularyServiceUpdateRequest.ValidateAll()
 // if the designated constraints aren't met.
-type VocabularyServiceAllTagsRequestMultiError []error
+type VocabularyServiceUpdateRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m VocabularyServiceAllTagsRequestMultiError) Error() string {
+func (m VocabularyServiceUpdateRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -980,12 +7425,12 @@ func (m VocabularyServiceAllTagsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m VocabularyServiceAllTagsRequestMultiError) AllErrors() []error { return m }
+func (m VocabularyServiceUpdateRequestMultiError) AllErrors() []error { return m }
 
-// VocabularyServiceAllTagsRequestValidationError is the validation error
-// returned by VocabularyServiceAllTagsRequest.Validate if the designated
+// VocabularyServiceUpdateRequestValidationError is the validation error
+// returned by VocabularyServiceUpdateRequest.Validate if the designated
 // constraints aren't met.
-type VocabularyServiceAllTagsRequestValidationError struct {
+type VocabularyServiceUpdateRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -993,24 +7438,24 @@ type VocabularyServiceAllTagsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e VocabularyServiceAllTagsRequestValidationError) Field() string { return e.field }
+func (e VocabularyServiceUpdateRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e VocabularyServiceAllTagsRequestValidationError) Reason() string { return e.reason }
+func (e VocabularyServiceUpdateRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e VocabularyServiceAllTagsRequestValidationError) Cause() error { return e.cause }
+func (e VocabularyServiceUpdateRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e VocabularyServiceAllTagsRequestValidationError) Key() bool { return e.key }
+func (e VocabularyServiceUpdateRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e VocabularyServiceAllTagsRequestValidationError) ErrorName() string {
-	return "VocabularyServiceAllTagsRequestValidationError"
+func (e VocabularyServiceUpdateRequestValidationError) ErrorName() string {
+	return "VocabularyServiceUpdateRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e VocabularyServiceAllTagsRequestValidationError) Error() string {
+func (e VocabularyServiceUpdateRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1022,14 +7467,14 @@ func (e VocabularyServiceAllTagsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sVocabularyServiceAllTagsRequest.%s: %s%s",
+		"invalid %sVocabularyServiceUpdateRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = VocabularyServiceAllTagsRequestValidationError{}
+var _ error = VocabularyServiceUpdateRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1037,46 +7482,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = VocabularyServiceAllTagsRequestValidationError{}
+} = VocabularyServiceUpdateRequestValidationError{}
 
-// Validate checks the field values on VocabularyServiceAllTagsResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *VocabularyServiceAllTagsResponse) Validate() error {
+// Validate checks the field values on VocabularyServiceUpdateResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *VocabularyServiceUpdateResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on VocabularyServiceAllTagsResponse with
+// ValidateAll checks the field values on VocabularyServiceUpdateResponse with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, the result is a list of violation errors wrapped in
-// VocabularyServiceAllTagsResponseMultiError, or nil if none found.
-func (m *VocabularyServiceAllTagsResponse) ValidateAll() error {
+// VocabularyServiceUpdateResponseMultiError, or nil if none found.
+func (m *VocabularyServiceUpdateResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *VocabularyServiceAllTagsResponse) validate(all bool) error {
+func (m *VocabularyServiceUpdateResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if all {
+		switch v := interface{}(m.GetWord()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, VocabularyServiceUpdateResponseValidationError{
+					field:  "Word",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, VocabularyServiceUpdateResponseValidationError{
+					field:  "Word",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWord()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return VocabularyServiceUpdateResponseValidationError{
+				field:  "Word",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return VocabularyServiceAllTagsResponseMultiError(errors)
+		return VocabularyServiceUpdateResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// VocabularyServiceAllTagsResponseMultiError is an error wrapping multiple
-// validation errors returned by
-// VocabularyServiceAllTagsResponse.ValidateAll() if the designated
-// constraints aren't met.
-type VocabularyServiceAllTagsResponseMultiError []error
+// VocabularyServiceUpdateResponseMultiError is an error wrapping multiple
+// validation errors returned by VocabularyServiceUpdateResponse.ValidateAll()
+// if the designated constraints aren't met.
+type VocabularyServiceUpdateResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m VocabularyServiceAllTagsResponseMultiError) Error() string {
+func (m VocabularyServiceUpdateResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1085,12 +7557,12 @@ func (m VocabularyServiceAllTagsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m VocabularyServiceAllTagsResponseMultiError) AllErrors() []error { return m }
+func (m VocabularyServiceUpdateResponseMultiError) AllErrors() []error { return m }
 
-// VocabularyServiceAllTagsResponseValidationError is the validation error
-// returned by VocabularyServiceAllTagsResponse.Validate if the designated
+// VocabularyServiceUpdateResponseValidationError is the validation error
+// returned by VocabularyServiceUpdateResponse.Validate if the designated
 // constraints aren't met.
-type VocabularyServiceAllTagsResponseValidationError struct {
+type VocabularyServiceUpdateResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1098,24 +7570,24 @@ type VocabularyServiceAllTagsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e VocabularyServiceAllTagsResponseValidationError) Field() string { return e.field }
+func (e VocabularyServiceUpdateResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e VocabularyServiceAllTagsResponseValidationError) Reason() string { return e.reason }
+func (e VocabularyServiceUpdateResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e VocabularyServiceAllTagsResponseValidationError) Cause() error { return e.cause }
+func (e VocabularyServiceUpdateResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e VocabularyServiceAllTagsResponseValidationError) Key() bool { return e.key }
+func (e VocabularyServiceUpdateResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e VocabularyServiceAllTagsResponseValidationError) ErrorName() string {
-	return "VocabularyServiceAllTagsResponseValidationError"
+func (e VocabularyServiceUpdateResponseValidationError) ErrorName() string {
+	return "VocabularyServiceUpdateResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e VocabularyServiceAllTagsResponseValidationError) Error() string {
+func (e VocabularyServiceUpdateResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1127,14 +7599,14 @@ func (e VocabularyServiceAllTagsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sVocabularyServiceAllTagsResponse.%s: %s%s",
+		"invalid %sVocabularyServiceUpdateResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = VocabularyServiceAllTagsResponseValidationError{}
+var _ error = VocabularyServiceUpdateResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1142,52 +7614,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = VocabularyServiceAllTagsResponseValidationError{}
+} = VocabularyServiceUpdateResponseValidationError{}
 
-// Validate checks the field values on VocabularyServiceListHanCharRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *VocabularyServiceListHanCharRequest) Validate() error {
+// Validate checks the field values on VocabularyServiceDeleteRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *VocabularyServiceDeleteRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on VocabularyServiceListHanCharRequest
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// VocabularyServiceListHanCharRequestMultiError, or nil if none found.
-func (m *VocabularyServiceListHanCharRequest) ValidateAll() error {
+// ValidateAll checks the field values on VocabularyServiceDeleteRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// VocabularyServiceDeleteRequestMultiError, or nil if none found.
+func (m *VocabularyServiceDeleteRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *VocabularyServiceListHanCharRequest) validate(all bool) error {
+func (m *VocabularyServiceDeleteRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Page
-
-	// no validation rules for PageSize
-
-	// no validation rules for Level
+	// no validation rules for Id
 
 	if len(errors) > 0 {
-		return VocabularyServiceListHanCharRequestMultiError(errors)
+		return VocabularyServiceDeleteRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// VocabularyServiceListHanCharRequestMultiError is an error wrapping multiple
-// validation errors returned by
-// VocabularyServiceListHanCharRequest.ValidateAll() if the designated
-// constraints aren't met.
-type VocabularyServiceListHanCharRequestMultiError []error
+// VocabularyServiceDeleteRequestMultiError is an error wrapping multiple
+// validation errors returned by VocabularyServiceDeleteRequest.ValidateAll()
+// if the designated constraints aren't met.
+type VocabularyServiceDeleteRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m VocabularyServiceListHanCharRequestMultiError) Error() string {
+func (m VocabularyServiceDeleteRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1196,12 +7662,12 @@ func (m VocabularyServiceListHanCharRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m VocabularyServiceListHanCharRequestMultiError) AllErrors() []error { return m }
+func (m VocabularyServiceDeleteRequestMultiError) AllErrors() []error { return m }
 
-// VocabularyServiceListHanCharRequestValidationError is the validation error
-// returned by VocabularyServiceListHanCharRequest.Validate if the designated
+// VocabularyServiceDeleteRequestValidationError is the validation error
+// returned by VocabularyServiceDeleteRequest.Validate if the designated
 // constraints aren't met.
-type VocabularyServiceListHanCharRequestValidationError struct {
+type VocabularyServiceDeleteRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1209,24 +7675,24 @@ type VocabularyServiceListHanCharRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e VocabularyServiceListHanCharRequestValidationError) Field() string { return e.field }
+func (e VocabularyServiceDeleteRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e VocabularyServiceListHanCharRequestValidationError) Reason() string { return e.reason }
+func (e VocabularyServiceDeleteRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e VocabularyServiceListHanCharRequestValidationError) Cause() error { return e.cause }
+func (e VocabularyServiceDeleteRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e VocabularyServiceListHanCharRequestValidationError) Key() bool { return e.key }
+func (e VocabularyServiceDeleteRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e VocabularyServiceListHanCharRequestValidationError) ErrorName() string {
-	return "VocabularyServiceListHanCharRequestValidationError"
+func (e VocabularyServiceDeleteRequestValidationError) ErrorName() string {
+	return "VocabularyServiceDeleteRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e VocabularyServiceListHanCharRequestValidationError) Error() string {
+func (e VocabularyServiceDeleteRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1238,14 +7704,14 @@ func (e VocabularyServiceListHanCharRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sVocabularyServiceListHanCharRequest.%s: %s%s",
+		"invalid %sVocabularyServiceDeleteRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = VocabularyServiceListHanCharRequestValidationError{}
+var _ error = VocabularyServiceDeleteRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1253,82 +7719,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = VocabularyServiceListHanCharRequestValidationError{}
+} = VocabularyServiceDeleteRequestValidationError{}
 
-// Validate checks the field values on VocabularyServiceListHanCharResponse
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *VocabularyServiceListHanCharResponse) Validate() error {
+// Validate checks the field values on VocabularyServiceDeleteResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *VocabularyServiceDeleteResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on VocabularyServiceListHanCharResponse
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// VocabularyServiceListHanCharResponseMultiError, or nil if none found.
-func (m *VocabularyServiceListHanCharResponse) ValidateAll() error {
+// ValidateAll checks the field values on VocabularyServiceDeleteResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// VocabularyServiceDeleteResponseMultiError, or nil if none found.
+func (m *VocabularyServiceDeleteResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *VocabularyServiceListHanCharResponse) validate(all bool) error {
+func (m *VocabularyServiceDeleteResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetHanChars() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, VocabularyServiceListHanCharResponseValidationError{
-						field:  fmt.Sprintf("HanChars[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, VocabularyServiceListHanCharResponseValidationError{
-						field:  fmt.Sprintf("HanChars[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return VocabularyServiceListHanCharResponseValidationError{
-					field:  fmt.Sprintf("HanChars[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
-	// no validation rules for Total
-
 	if len(errors) > 0 {
-		return VocabularyServiceListHanCharResponseMultiError(errors)
+		return VocabularyServiceDeleteResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// VocabularyServiceListHanCharResponseMultiError is an error wrapping multiple
-// validation errors returned by
-// VocabularyServiceListHanCharResponse.ValidateAll() if the designated
-// constraints aren't met.
-type VocabularyServiceListHanCharResponseMultiError []error
+// VocabularyServiceDeleteResponseMultiError is an error wrapping multiple
+// validation errors returned by VocabularyServiceDeleteResponse.ValidateAll()
+// if the designated constraints aren't met.
+type VocabularyServiceDeleteResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m VocabularyServiceListHanCharResponseMultiError) Error() string {
+func (m VocabularyServiceDeleteResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1337,12 +7765,12 @@ func (m VocabularyServiceListHanCharResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m VocabularyServiceListHanCharResponseMultiError) AllErrors() []error { return m }
+func (m VocabularyServiceDeleteResponseMultiError) AllErrors() []error { return m }
 
-// VocabularyServiceListHanCharResponseValidationError is the validation error
-// returned by VocabularyServiceListHanCharResponse.Validate if the designated
+// VocabularyServiceDeleteResponseValidationError is the validation error
+// returned by VocabularyServiceDeleteResponse.Validate if the designated
 // constraints aren't met.
-type VocabularyServiceListHanCharResponseValidationError struct {
+type VocabularyServiceDeleteResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1350,24 +7778,24 @@ type VocabularyServiceListHanCharResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e VocabularyServiceListHanCharResponseValidationError) Field() string { return e.field }
+func (e VocabularyServiceDeleteResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e VocabularyServiceListHanCharResponseValidationError) Reason() string { return e.reason }
+func (e VocabularyServiceDeleteResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e VocabularyServiceListHanCharResponseValidationError) Cause() error { return e.cause }
+func (e VocabularyServiceDeleteResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e VocabularyServiceListHanCharResponseValidationError) Key() bool { return e.key }
+func (e VocabularyServiceDeleteResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e VocabularyServiceListHanCharResponseValidationError) ErrorName() string {
-	return "VocabularyServiceListHanCharResponseValidationError"
+func (e VocabularyServiceDeleteResponseValidationError) ErrorName() string {
+	return "VocabularyServiceDeleteResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e VocabularyServiceListHanCharResponseValidationError) Error() string {
+func (e VocabularyServiceDeleteResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1379,14 +7807,14 @@ func (e VocabularyServiceListHanCharResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sVocabularyServiceListHanCharResponse.%s: %s%s",
+		"invalid %sVocabularyServiceDeleteResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = VocabularyServiceListHanCharResponseValidationError{}
+var _ error = VocabularyServiceDeleteResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1394,46 +7822,75 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = VocabularyServiceListHanCharResponseValidationError{}
+} = VocabularyServiceDeleteResponseValidationError{}
 
-// Validate checks the field values on VocabularyServiceAllCategoriesRequest
+// Validate checks the field values on VocabularyServiceCreateHanCharRequest
 // with the rules defined in the proto definition for this message. If any
 // rules are violated, the first error encountered is returned, or nil if
 // there are no violations.
-func (m *VocabularyServiceAllCategoriesRequest) Validate() error {
+func (m *VocabularyServiceCreateHanCharRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on VocabularyServiceAllCategoriesRequest
+// ValidateAll checks the field values on VocabularyServiceCreateHanCharRequest
 // with the rules defined in the proto definition for this message. If any
 // rules are violated, the result is a list of violation errors wrapped in
-// VocabularyServiceAllCategoriesRequestMultiError, or nil if none found.
-func (m *VocabularyServiceAllCategoriesRequest) ValidateAll() error {
+// VocabularyServiceCreateHanCharRequestMultiError, or nil if none found.
+func (m *VocabularyServiceCreateHanCharRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *VocabularyServiceAllCategoriesRequest) validate(all bool) error {
+func (m *VocabularyServiceCreateHanCharRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if all {
+		switch v := interface{}(m.GetHanChar()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, VocabularyServiceCreateHanCharRequestValidationError{
+					field:  "HanChar",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, VocabularyServiceCreateHanCharRequestValidationError{
+					field:  "HanChar",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetHanChar()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return VocabularyServiceCreateHanCharRequestValidationError{
+				field:  "HanChar",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return VocabularyServiceAllCategoriesRequestMultiError(errors)
+		return VocabularyServiceCreateHanCharRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// VocabularyServiceAllCategoriesRequestMultiError is an error wrapping
+// VocabularyServiceCreateHanCharRequestMultiError is an error wrapping
 // multiple validation errors returned by
-// VocabularyServiceAllCategoriesRequest.ValidateAll() if the designated
+// VocabularyServiceCreateHanCharRequest.ValidateAll() if the designated
 // constraints aren't met.
-type VocabularyServiceAllCategoriesRequestMultiError []error
+type VocabularyServiceCreateHanCharRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m VocabularyServiceAllCategoriesRequestMultiError) Error() string {
+func (m VocabularyServiceCreateHanCharRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1442,12 +7899,12 @@ func (m VocabularyServiceAllCategoriesRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m VocabularyServiceAllCategoriesRequestMultiError) AllErrors() []error { return m }
+func (m VocabularyServiceCreateHanCharRequestMultiError) AllErrors() []error { return m }
 
-// VocabularyServiceAllCategoriesRequestValidationError is the validation error
-// returned by VocabularyServiceAllCategoriesRequest.Validate if the
+// VocabularyServiceCreateHanCharRequestValidationError is the validation error
+// returned by VocabularyServiceCreateHanCharRequest.Validate if the
 // designated constraints aren't met.
-type VocabularyServiceAllCategoriesRequestValidationError struct {
+type VocabularyServiceCreateHanCharRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1455,24 +7912,24 @@ type VocabularyServiceAllCategoriesRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e VocabularyServiceAllCategoriesRequestValidationError) Field() string { return e.field }
+func (e VocabularyServiceCreateHanCharRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e VocabularyServiceAllCategoriesRequestValidationError) Reason() string { return e.reason }
+func (e VocabularyServiceCreateHanCharRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e VocabularyServiceAllCategoriesRequestValidationError) Cause() error { return e.cause }
+func (e VocabularyServiceCreateHanCharRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e VocabularyServiceAllCategoriesRequestValidationError) Key() bool { return e.key }
+func (e VocabularyServiceCreateHanCharRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e VocabularyServiceAllCategoriesRequestValidationError) ErrorName() string {
-	return "VocabularyServiceAllCategoriesRequestValidationError"
+func (e VocabularyServiceCreateHanCharRequestValidationError) ErrorName() string {
+	return "VocabularyServiceCreateHanCharRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e VocabularyServiceAllCategoriesRequestValidationError) Error() string {
+func (e VocabularyServiceCreateHanCharRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1484,14 +7941,14 @@ func (e VocabularyServiceAllCategoriesRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sVocabularyServiceAllCategoriesRequest.%s: %s%s",
+		"invalid %sVocabularyServiceCreateHanCharRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = VocabularyServiceAllCategoriesRequestValidationError{}
+var _ error = VocabularyServiceCreateHanCharRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1499,47 +7956,76 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = VocabularyServiceAllCategoriesRequestValidationError{}
+} = VocabularyServiceCreateHanCharRequestValidationError{}
 
-// Validate checks the field values on VocabularyServiceAllCategoriesResponse
+// Validate checks the field values on VocabularyServiceCreateHanCharResponse
 // with the rules defined in the proto definition for this message. If any
 // rules are violated, the first error encountered is returned, or nil if
 // there are no violations.
-func (m *VocabularyServiceAllCategoriesResponse) Validate() error {
+func (m *VocabularyServiceCreateHanCharResponse) Validate() error {
 	return m.validate(false)
 }
 
 // ValidateAll checks the field values on
-// VocabularyServiceAllCategoriesResponse with the rules defined in the proto
+// VocabularyServiceCreateHanCharResponse with the rules defined in the proto
 // definition for this message. If any rules are violated, the result is a
 // list of violation errors wrapped in
-// VocabularyServiceAllCategoriesResponseMultiError, or nil if none found.
-func (m *VocabularyServiceAllCategoriesResponse) ValidateAll() error {
+// VocabularyServiceCreateHanCharResponseMultiError, or nil if none found.
+func (m *VocabularyServiceCreateHanCharResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *VocabularyServiceAllCategoriesResponse) validate(all bool) error {
+func (m *VocabularyServiceCreateHanCharResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if all {
+		switch v := interface{}(m.GetHanChar()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, VocabularyServiceCreateHanCharResponseValidationError{
+					field:  "HanChar",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, VocabularyServiceCreateHanCharResponseValidationError{
+					field:  "HanChar",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetHanChar()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return VocabularyServiceCreateHanCharResponseValidationError{
+				field:  "HanChar",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return VocabularyServiceAllCategoriesResponseMultiError(errors)
+		return VocabularyServiceCreateHanCharResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// VocabularyServiceAllCategoriesResponseMultiError is an error wrapping
+// VocabularyServiceCreateHanCharResponseMultiError is an error wrapping
 // multiple validation errors returned by
-// VocabularyServiceAllCategoriesResponse.ValidateAll() if the designated
+// VocabularyServiceCreateHanCharResponse.ValidateAll() if the designated
 // constraints aren't met.
-type VocabularyServiceAllCategoriesResponseMultiError []error
+type VocabularyServiceCreateHanCharResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m VocabularyServiceAllCategoriesResponseMultiError) Error() string {
+func (m VocabularyServiceCreateHanCharResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1548,12 +8034,12 @@ func (m VocabularyServiceAllCategoriesResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m VocabularyServiceAllCategoriesResponseMultiError) AllErrors() []error { return m }
+func (m VocabularyServiceCreateHanCharResponseMultiError) AllErrors() []error { return m }
 
-// VocabularyServiceAllCategoriesResponseValidationError is the validation
-// error returned by VocabularyServiceAllCategoriesResponse.Validate if the
+// VocabularyServiceCreateHanCharResponseValidationError is the validation
+// error returned by VocabularyServiceCreateHanCharResponse.Validate if the
 // designated constraints aren't met.
-type VocabularyServiceAllCategoriesResponseValidationError struct {
+type VocabularyServiceCreateHanCharResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1561,24 +8047,24 @@ type VocabularyServiceAllCategoriesResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e VocabularyServiceAllCategoriesResponseValidationError) Field() string { return e.field }
+func (e VocabularyServiceCreateHanCharResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e VocabularyServiceAllCategoriesResponseValidationError) Reason() string { return e.reason }
+func (e VocabularyServiceCreateHanCharResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e VocabularyServiceAllCategoriesResponseValidationError) Cause() error { return e.cause }
+func (e VocabularyServiceCreateHanCharResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e VocabularyServiceAllCategoriesResponseValidationError) Key() bool { return e.key }
+func (e VocabularyServiceCreateHanCharResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e VocabularyServiceAllCategoriesResponseValidationError) ErrorName() string {
-	return "VocabularyServiceAllCategoriesResponseValidationError"
+func (e VocabularyServiceCreateHanCharResponseValidationError) ErrorName() string {
+	return "VocabularyServiceCreateHanCharResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e VocabularyServiceAllCategoriesResponseValidationError) Error() string {
+func (e VocabularyServiceCreateHanCharResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1590,14 +8076,14 @@ func (e VocabularyServiceAllCategoriesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sVocabularyServiceAllCategoriesResponse.%s: %s%s",
+		"invalid %sVocabularyServiceCreateHanCharResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = VocabularyServiceAllCategoriesResponseValidationError{}
+var _ error = VocabularyServiceCreateHanCharResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1605,47 +8091,77 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = VocabularyServiceAllCategoriesResponseValidationError{}
+} = VocabularyServiceCreateHanCharResponseValidationError{}
 
-// Validate checks the field values on VocabularyServiceGetAllMetadataRequest
+// Validate checks the field values on VocabularyServiceUpdateHanCharRequest
 // with the rules defined in the proto definition for this message. If any
 // rules are violated, the first error encountered is returned, or nil if
 // there are no violations.
-func (m *VocabularyServiceGetAllMetadataRequest) Validate() error {
+func (m *VocabularyServiceUpdateHanCharRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on
-// VocabularyServiceGetAllMetadataRequest with the rules defined in the proto
-// definition for this message. If any rules are violated, the result is a
-// list of violation errors wrapped in
-// VocabularyServiceGetAllMetadataRequestMultiError, or nil if none found.
-func (m *VocabularyServiceGetAllMetadataRequest) ValidateAll() error {
+// ValidateAll checks the field values on VocabularyServiceUpdateHanCharRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// VocabularyServiceUpdateHanCharRequestMultiError, or nil if none found.
+func (m *VocabularyServiceUpdateHanCharRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *VocabularyServiceGetAllMetadataRequest) validate(all bool) error {
+func (m *VocabularyServiceUpdateHanCharRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Id
+
+	if all {
+		switch v := interface{}(m.GetHanChar()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, VocabularyServiceUpdateHanCharRequestValidationError{
+					field:  "HanChar",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, VocabularyServiceUpdateHanCharRequestValidationError{
+					field:  "HanChar",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetHanChar()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return VocabularyServiceUpdateHanCharRequestValidationError{
+				field:  "HanChar",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return VocabularyServiceGetAllMetadataRequestMultiError(errors)
+		return VocabularyServiceUpdateHanCharRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// VocabularyServiceGetAllMetadataRequestMultiError is an error wrapping
+// VocabularyServiceUpdateHanCharRequestMultiError is an error wrapping
 // multiple validation errors returned by
-// VocabularyServiceGetAllMetadataRequest.ValidateAll() if the designated
+// VocabularyServiceUpdateHanCharRequest.ValidateAll() if the designated
 // constraints aren't met.
-type VocabularyServiceGetAllMetadataRequestMultiError []error
+type VocabularyServiceUpdateHanCharRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m VocabularyServiceGetAllMetadataRequestMultiError) Error() string {
+func (m VocabularyServiceUpdateHanCharRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1654,12 +8170,12 @@ func (m VocabularyServiceGetAllMetadataRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m VocabularyServiceGetAllMetadataRequestMultiError) AllErrors() []error { return m }
+func (m VocabularyServiceUpdateHanCharRequestMultiError) AllErrors() []error { return m }
 
-// VocabularyServiceGetAllMetadataRequestValidationError is the validation
-// error returned by VocabularyServiceGetAllMetadataRequest.Validate if the
+// VocabularyServiceUpdateHanCharRequestValidationError is the validation error
+// returned by VocabularyServiceUpdateHanCharRequest.Validate if the
 // designated constraints aren't met.
-type VocabularyServiceGetAllMetadataRequestValidationError struct {
+type VocabularyServiceUpdateHanCharRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1667,24 +8183,24 @@ type VocabularyServiceGetAllMetadataRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e VocabularyServiceGetAllMetadataRequestValidationError) Field() string { return e.field }
+func (e VocabularyServiceUpdateHanCharRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e VocabularyServiceGetAllMetadataRequestValidationError) Reason() string { return e.reason }
+func (e VocabularyServiceUpdateHanCharRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e VocabularyServiceGetAllMetadataRequestValidationError) Cause() error { return e.cause }
+func (e VocabularyServiceUpdateHanCharRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e VocabularyServiceGetAllMetadataRequestValidationError) Key() bool { return e.key }
+func (e VocabularyServiceUpdateHanCharRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e VocabularyServiceGetAllMetadataRequestValidationError) ErrorName() string {
-	return "VocabularyServiceGetAllMetadataRequestValidationError"
+func (e VocabularyServiceUpdateHanCharRequestValidationError) ErrorName() string {
+	return "VocabularyServiceUpdateHanCharRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e VocabularyServiceGetAllMetadataRequestValidationError) Error() string {
+func (e VocabularyServiceUpdateHanCharRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1696,14 +8212,14 @@ func (e VocabularyServiceGetAllMetadataRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sVocabularyServiceGetAllMetadataRequest.%s: %s%s",
+		"invalid %sVocabularyServiceUpdateHanCharRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = VocabularyServiceGetAllMetadataRequestValidationError{}
+var _ error = VocabularyServiceUpdateHanCharRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1711,47 +8227,76 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = VocabularyServiceGetAllMetadataRequestValidationError{}
+} = VocabularyServiceUpdateHanCharRequestValidationError{}
 
-// Validate checks the field values on VocabularyServiceGetAllMetadataResponse
+// Validate checks the field values on VocabularyServiceUpdateHanCharResponse
 // with the rules defined in the proto definition for this message. If any
 // rules are violated, the first error encountered is returned, or nil if
 // there are no violations.
-func (m *VocabularyServiceGetAllMetadataResponse) Validate() error {
+func (m *VocabularyServiceUpdateHanCharResponse) Validate() error {
 	return m.validate(false)
 }
 
 // ValidateAll checks the field values on
-// VocabularyServiceGetAllMetadataResponse with the rules defined in the proto
+// VocabularyServiceUpdateHanCharResponse with the rules defined in the proto
 // definition for this message. If any rules are violated, the result is a
 // list of violation errors wrapped in
-// VocabularyServiceGetAllMetadataResponseMultiError, or nil if none found.
-func (m *VocabularyServiceGetAllMetadataResponse) ValidateAll() error {
+// VocabularyServiceUpdateHanCharResponseMultiError, or nil if none found.
+func (m *VocabularyServiceUpdateHanCharResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *VocabularyServiceGetAllMetadataResponse) validate(all bool) error {
+func (m *VocabularyServiceUpdateHanCharResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if all {
+		switch v := interface{}(m.GetHanChar()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, VocabularyServiceUpdateHanCharResponseValidationError{
+					field:  "HanChar",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, VocabularyServiceUpdateHanCharResponseValidationError{
+					field:  "HanChar",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetHanChar()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return VocabularyServiceUpdateHanCharResponseValidationError{
+				field:  "HanChar",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return VocabularyServiceGetAllMetadataResponseMultiError(errors)
+		return VocabularyServiceUpdateHanCharResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// VocabularyServiceGetAllMetadataResponseMultiError is an error wrapping
+// VocabularyServiceUpdateHanCharResponseMultiError is an error wrapping
 // multiple validation errors returned by
-// VocabularyServiceGetAllMetadataResponse.ValidateAll() if the designated
+// VocabularyServiceUpdateHanCharResponse.ValidateAll() if the designated
 // constraints aren't met.
-type VocabularyServiceGetAllMetadataResponseMultiError []error
+type VocabularyServiceUpdateHanCharResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m VocabularyServiceGetAllMetadataResponseMultiError) Error() string {
+func (m VocabularyServiceUpdateHanCharResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1760,12 +8305,12 @@ func (m VocabularyServiceGetAllMetadataResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m VocabularyServiceGetAllMetadataResponseMultiError) AllErrors() []error { return m }
+func (m VocabularyServiceUpdateHanCharResponseMultiError) AllErrors() []error { return m }
 
-// VocabularyServiceGetAllMetadataResponseValidationError is the validation
-// error returned by VocabularyServiceGetAllMetadataResponse.Validate if the
+// VocabularyServiceUpdateHanCharResponseValidationError is the validation
+// error returned by VocabularyServiceUpdateHanCharResponse.Validate if the
 // designated constraints aren't met.
-type VocabularyServiceGetAllMetadataResponseValidationError struct {
+type VocabularyServiceUpdateHanCharResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1773,24 +8318,24 @@ type VocabularyServiceGetAllMetadataResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e VocabularyServiceGetAllMetadataResponseValidationError) Field() string { return e.field }
+func (e VocabularyServiceUpdateHanCharResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e VocabularyServiceGetAllMetadataResponseValidationError) Reason() string { return e.reason }
+func (e VocabularyServiceUpdateHanCharResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e VocabularyServiceGetAllMetadataResponseValidationError) Cause() error { return e.cause }
+func (e VocabularyServiceUpdateHanCharResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e VocabularyServiceGetAllMetadataResponseValidationError) Key() bool { return e.key }
+func (e VocabularyServiceUpdateHanCharResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e VocabularyServiceGetAllMetadataResponseValidationError) ErrorName() string {
-	return "VocabularyServiceGetAllMetadataResponseValidationError"
+func (e VocabularyServiceUpdateHanCharResponseValidationError) ErrorName() string {
+	return "VocabularyServiceUpdateHanCharResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e VocabularyServiceGetAllMetadataResponseValidationError) Error() string {
+func (e VocabularyServiceUpdateHanCharResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1802,14 +8347,14 @@ func (e VocabularyServiceGetAllMetadataResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sVocabularyServiceGetAllMetadataResponse.%s: %s%s",
+		"invalid %sVocabularyServiceUpdateHanCharResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = VocabularyServiceGetAllMetadataResponseValidationError{}
+var _ error = VocabularyServiceUpdateHanCharResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1817,80 +8362,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = VocabularyServiceGetAllMetadataResponseValidationError{}
+} = VocabularyServiceUpdateHanCharResponseValidationError{}
 
-// Validate checks the field values on VocabularyServiceBatchCreateRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *VocabularyServiceBatchCreateRequest) Validate() error {
+// Validate checks the field values on VocabularyServiceDeleteHanCharRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *VocabularyServiceDeleteHanCharRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on VocabularyServiceBatchCreateRequest
+// ValidateAll checks the field values on VocabularyServiceDeleteHanCharRequest
 // with the rules defined in the proto definition for this message. If any
 // rules are violated, the result is a list of violation errors wrapped in
-// VocabularyServiceBatchCreateRequestMultiError, or nil if none found.
-func (m *VocabularyServiceBatchCreateRequest) ValidateAll() error {
+// VocabularyServiceDeleteHanCharRequestMultiError, or nil if none found.
+func (m *VocabularyServiceDeleteHanCharRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *VocabularyServiceBatchCreateRequest) validate(all bool) error {
+func (m *VocabularyServiceDeleteHanCharRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetWords() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, VocabularyServiceBatchCreateRequestValidationError{
-						field:  fmt.Sprintf("Words[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, VocabularyServiceBatchCreateRequestValidationError{
-						field:  fmt.Sprintf("Words[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return VocabularyServiceBatchCreateRequestValidationError{
-					field:  fmt.Sprintf("Words[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
+	// no validation rules for Id
 
 	if len(errors) > 0 {
-		return VocabularyServiceBatchCreateRequestMultiError(errors)
+		return VocabularyServiceDeleteHanCharRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// VocabularyServiceBatchCreateRequestMultiError is an error wrapping multiple
-// validation errors returned by
-// VocabularyServiceBatchCreateRequest.ValidateAll() if the designated
+// VocabularyServiceDeleteHanCharRequestMultiError is an error wrapping
+// multiple validation errors returned by
+// VocabularyServiceDeleteHanCharRequest.ValidateAll() if the designated
 // constraints aren't met.
-type VocabularyServiceBatchCreateRequestMultiError []error
+type VocabularyServiceDeleteHanCharRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m VocabularyServiceBatchCreateRequestMultiError) Error() string {
+func (m VocabularyServiceDeleteHanCharRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1899,12 +8412,12 @@ func (m VocabularyServiceBatchCreateRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m VocabularyServiceBatchCreateRequestMultiError) AllErrors() []error { return m }
+func (m VocabularyServiceDeleteHanCharRequestMultiError) AllErrors() []error { return m }
 
-// VocabularyServiceBatchCreateRequestValidationError is the validation error
-// returned by VocabularyServiceBatchCreateRequest.Validate if the designated
-// constraints aren't met.
-type VocabularyServiceBatchCreateRequestValidationError struct {
+// VocabularyServiceDeleteHanCharRequestValidationError is the validation error
+// returned by VocabularyServiceDeleteHanCharRequest.Validate if the
+// designated constraints aren't met.
+type VocabularyServiceDeleteHanCharRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1912,24 +8425,24 @@ type VocabularyServiceBatchCreateRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e VocabularyServiceBatchCreateRequestValidationError) Field() string { return e.field }
+func (e VocabularyServiceDeleteHanCharRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e VocabularyServiceBatchCreateRequestValidationError) Reason() string { return e.reason }
+func (e VocabularyServiceDeleteHanCharRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e VocabularyServiceBatchCreateRequestValidationError) Cause() error { return e.cause }
+func (e VocabularyServiceDeleteHanCharRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e VocabularyServiceBatchCreateRequestValidationError) Key() bool { return e.key }
+func (e VocabularyServiceDeleteHanCharRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e VocabularyServiceBatchCreateRequestValidationError) ErrorName() string {
-	return "VocabularyServiceBatchCreateRequestValidationError"
+func (e VocabularyServiceDeleteHanCharRequestValidationError) ErrorName() string {
+	return "VocabularyServiceDeleteHanCharRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e VocabularyServiceBatchCreateRequestValidationError) Error() string {
+func (e VocabularyServiceDeleteHanCharRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1941,14 +8454,14 @@ func (e VocabularyServiceBatchCreateRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sVocabularyServiceBatchCreateRequest.%s: %s%s",
+		"invalid %sVocabularyServiceDeleteHanCharRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = VocabularyServiceBatchCreateRequestValidationError{}
+var _ error = VocabularyServiceDeleteHanCharRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1956,25 +8469,26 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = VocabularyServiceBatchCreateRequestValidationError{}
+} = VocabularyServiceDeleteHanCharRequestValidationError{}
 
-// Validate checks the field values on VocabularyServiceBatchCreateResponse
+// Validate checks the field values on VocabularyServiceDeleteHanCharResponse
 // with the rules defined in the proto definition for this message. If any
 // rules are violated, the first error encountered is returned, or nil if
 // there are no violations.
-func (m *VocabularyServiceBatchCreateResponse) Validate() error {
+func (m *VocabularyServiceDeleteHanCharResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on VocabularyServiceBatchCreateResponse
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// VocabularyServiceBatchCreateResponseMultiError, or nil if none found.
-func (m *VocabularyServiceBatchCreateResponse) ValidateAll() error {
+// ValidateAll checks the field values on
+// VocabularyServiceDeleteHanCharResponse with the rules defined in the proto
+// definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in
+// VocabularyServiceDeleteHanCharResponseMultiError, or nil if none found.
+func (m *VocabularyServiceDeleteHanCharResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *VocabularyServiceBatchCreateResponse) validate(all bool) error {
+func (m *VocabularyServiceDeleteHanCharResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1982,20 +8496,20 @@ func (m *VocabularyServiceBatchCreateResponse) validate(all bool) error {
 	var errors []error
 
 	if len(errors) > 0 {
-		return VocabularyServiceBatchCreateResponseMultiError(errors)
+		return VocabularyServiceDeleteHanCharResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// VocabularyServiceBatchCreateResponseMultiError is an error wrapping multiple
-// validation errors returned by
-// VocabularyServiceBatchCreateResponse.ValidateAll() if the designated
+// VocabularyServiceDeleteHanCharResponseMultiError is an error wrapping
+// multiple validation errors returned by
+// VocabularyServiceDeleteHanCharResponse.ValidateAll() if the designated
 // constraints aren't met.
-type VocabularyServiceBatchCreateResponseMultiError []error
+type VocabularyServiceDeleteHanCharResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m VocabularyServiceBatchCreateResponseMultiError) Error() string {
+func (m VocabularyServiceDeleteHanCharResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2004,12 +8518,12 @@ func (m VocabularyServiceBatchCreateResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m VocabularyServiceBatchCreateResponseMultiError) AllErrors() []error { return m }
+func (m VocabularyServiceDeleteHanCharResponseMultiError) AllErrors() []error { return m }
 
-// VocabularyServiceBatchCreateResponseValidationError is the validation error
-// returned by VocabularyServiceBatchCreateResponse.Validate if the designated
-// constraints aren't met.
-type VocabularyServiceBatchCreateResponseValidationError struct {
+// VocabularyServiceDeleteHanCharResponseValidationError is the validation
+// error returned by VocabularyServiceDeleteHanCharResponse.Validate if the
+// designated constraints aren't met.
+type VocabularyServiceDeleteHanCharResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2017,24 +8531,24 @@ type VocabularyServiceBatchCreateResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e VocabularyServiceBatchCreateResponseValidationError) Field() string { return e.field }
+func (e VocabularyServiceDeleteHanCharResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e VocabularyServiceBatchCreateResponseValidationError) Reason() string { return e.reason }
+func (e VocabularyServiceDeleteHanCharResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e VocabularyServiceBatchCreateResponseValidationError) Cause() error { return e.cause }
+func (e VocabularyServiceDeleteHanCharResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e VocabularyServiceBatchCreateResponseValidationError) Key() bool { return e.key }
+func (e VocabularyServiceDeleteHanCharResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e VocabularyServiceBatchCreateResponseValidationError) ErrorName() string {
-	return "VocabularyServiceBatchCreateResponseValidationError"
+func (e VocabularyServiceDeleteHanCharResponseValidationError) ErrorName() string {
+	return "VocabularyServiceDeleteHanCharResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e VocabularyServiceBatchCreateResponseValidationError) Error() string {
+func (e VocabularyServiceDeleteHanCharResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2046,14 +8560,14 @@ func (e VocabularyServiceBatchCreateResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sVocabularyServiceBatchCreateResponse.%s: %s%s",
+		"invalid %sVocabularyServiceDeleteHanCharResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = VocabularyServiceBatchCreateResponseValidationError{}
+var _ error = VocabularyServiceDeleteHanCharResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2061,81 +8575,56 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = VocabularyServiceBatchCreateResponseValidationError{}
+} = VocabularyServiceDeleteHanCharResponseValidationError{}
 
-// Validate checks the field values on
-// VocabularyServiceBatchCreateHanCharRequest with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *VocabularyServiceBatchCreateHanCharRequest) Validate() error {
+// Validate checks the field values on VocabularyServiceSearchHanCharRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *VocabularyServiceSearchHanCharRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on
-// VocabularyServiceBatchCreateHanCharRequest with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in
-// VocabularyServiceBatchCreateHanCharRequestMultiError, or nil if none found.
-func (m *VocabularyServiceBatchCreateHanCharRequest) ValidateAll() error {
+// ValidateAll checks the field values on VocabularyServiceSearchHanCharRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// VocabularyServiceSearchHanCharRequestMultiError, or nil if none found.
+func (m *VocabularyServiceSearchHanCharRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *VocabularyServiceBatchCreateHanCharRequest) validate(all bool) error {
+func (m *VocabularyServiceSearchHanCharRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetHanChars() {
-		_, _ = idx, item
+	// no validation rules for Keyword
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, VocabularyServiceBatchCreateHanCharRequestValidationError{
-						field:  fmt.Sprintf("HanChars[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, VocabularyServiceBatchCreateHanCharRequestValidationError{
-						field:  fmt.Sprintf("HanChars[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return VocabularyServiceBatchCreateHanCharRequestValidationError{
-					field:  fmt.Sprintf("HanChars[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for Page
 
-	}
+	// no validation rules for PageSize
+
+	// no validation rules for Level
+
+	// no validation rules for IgnoreTone
 
 	if len(errors) > 0 {
-		return VocabularyServiceBatchCreateHanCharRequestMultiError(errors)
+		return VocabularyServiceSearchHanCharRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// VocabularyServiceBatchCreateHanCharRequestMultiError is an error wrapping
+// VocabularyServiceSearchHanCharRequestMultiError is an error wrapping
 // multiple validation errors returned by
-// VocabularyServiceBatchCreateHanCharRequest.ValidateAll() if the designated
+// VocabularyServiceSearchHanCharRequest.ValidateAll() if the designated
 // constraints aren't met.
-type VocabularyServiceBatchCreateHanCharRequestMultiError []error
+type VocabularyServiceSearchHanCharRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m VocabularyServiceBatchCreateHanCharRequestMultiError) Error() string {
+func (m VocabularyServiceSearchHanCharRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2144,12 +8633,12 @@ func (m VocabularyServiceBatchCreateHanCharRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m VocabularyServiceBatchCreateHanCharRequestMultiError) AllErrors() []error { return m }
+func (m VocabularyServiceSearchHanCharRequestMultiError) AllErrors() []error { return m }
 
-// VocabularyServiceBatchCreateHanCharRequestValidationError is the validation
-// error returned by VocabularyServiceBatchCreateHanCharRequest.Validate if
-// the designated constraints aren't met.
-type VocabularyServiceBatchCreateHanCharRequestValidationError struct {
+// VocabularyServiceSearchHanCharRequestValidationError is the validation error
+// returned by VocabularyServiceSearchHanCharRequest.Validate if the
+// designated constraints aren't met.
+type VocabularyServiceSearchHanCharRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2157,24 +8646,24 @@ type VocabularyServiceBatchCreateHanCharRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e VocabularyServiceBatchCreateHanCharRequestValidationError) Field() string { return e.field }
+func (e VocabularyServiceSearchHanCharRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e VocabularyServiceBatchCreateHanCharRequestValidationError) Reason() string { return e.reason }
+func (e VocabularyServiceSearchHanCharRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e VocabularyServiceBatchCreateHanCharRequestValidationError) Cause() error { return e.cause }
+func (e VocabularyServiceSearchHanCharRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e VocabularyServiceBatchCreateHanCharRequestValidationError) Key() bool { return e.key }
+func (e VocabularyServiceSearchHanCharRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e VocabularyServiceBatchCreateHanCharRequestValidationError) ErrorName() string {
-	return "VocabularyServiceBatchCreateHanCharRequestValidationError"
+func (e VocabularyServiceSearchHanCharRequestValidationError) ErrorName() string {
+	return "VocabularyServiceSearchHanCharRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e VocabularyServiceBatchCreateHanCharRequestValidationError) Error() string {
+func (e VocabularyServiceSearchHanCharRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2186,14 +8675,14 @@ func (e VocabularyServiceBatchCreateHanCharRequestValidationError) Error() strin
 	}
 
 	return fmt.Sprintf(
-		"invalid %sVocabularyServiceBatchCreateHanCharRequest.%s: %s%s",
+		"invalid %sVocabularyServiceSearchHanCharRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = VocabularyServiceBatchCreateHanCharRequestValidationError{}
+var _ error = VocabularyServiceSearchHanCharRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2201,47 +8690,83 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = VocabularyServiceBatchCreateHanCharRequestValidationError{}
+} = VocabularyServiceSearchHanCharRequestValidationError{}
 
-// Validate checks the field values on
-// VocabularyServiceBatchCreateHanCharResponse with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *VocabularyServiceBatchCreateHanCharResponse) Validate() error {
+// Validate checks the field values on VocabularyServiceSearchHanCharResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *VocabularyServiceSearchHanCharResponse) Validate() error {
 	return m.validate(false)
 }
 
 // ValidateAll checks the field values on
-// VocabularyServiceBatchCreateHanCharResponse with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in
-// VocabularyServiceBatchCreateHanCharResponseMultiError, or nil if none found.
-func (m *VocabularyServiceBatchCreateHanCharResponse) ValidateAll() error {
+// VocabularyServiceSearchHanCharResponse with the rules defined in the proto
+// definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in
+// VocabularyServiceSearchHanCharResponseMultiError, or nil if none found.
+func (m *VocabularyServiceSearchHanCharResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *VocabularyServiceBatchCreateHanCharResponse) validate(all bool) error {
+func (m *VocabularyServiceSearchHanCharResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	for idx, item := range m.GetHanChars() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, VocabularyServiceSearchHanCharResponseValidationError{
+						field:  fmt.Sprintf("HanChars[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, VocabularyServiceSearchHanCharResponseValidationError{
+						field:  fmt.Sprintf("HanChars[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return VocabularyServiceSearchHanCharResponseValidationError{
+					field:  fmt.Sprintf("HanChars[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
 	if len(errors) > 0 {
-		return VocabularyServiceBatchCreateHanCharResponseMultiError(errors)
+		return VocabularyServiceSearchHanCharResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// VocabularyServiceBatchCreateHanCharResponseMultiError is an error wrapping
+// VocabularyServiceSearchHanCharResponseMultiError is an error wrapping
 // multiple validation errors returned by
-// VocabularyServiceBatchCreateHanCharResponse.ValidateAll() if the designated
+// VocabularyServiceSearchHanCharResponse.ValidateAll() if the designated
 // constraints aren't met.
-type VocabularyServiceBatchCreateHanCharResponseMultiError []error
+type VocabularyServiceSearchHanCharResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m VocabularyServiceBatchCreateHanCharResponseMultiError) Error() string {
+func (m VocabularyServiceSearchHanCharResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2250,12 +8775,12 @@ func (m VocabularyServiceBatchCreateHanCharResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m VocabularyServiceBatchCreateHanCharResponseMultiError) AllErrors() []error { return m }
+func (m VocabularyServiceSearchHanCharResponseMultiError) AllErrors() []error { return m }
 
-// VocabularyServiceBatchCreateHanCharResponseValidationError is the validation
-// error returned by VocabularyServiceBatchCreateHanCharResponse.Validate if
-// the designated constraints aren't met.
-type VocabularyServiceBatchCreateHanCharResponseValidationError struct {
+// VocabularyServiceSearchHanCharResponseValidationError is the validation
+// error returned by VocabularyServiceSearchHanCharResponse.Validate if the
+// designated constraints aren't met.
+type VocabularyServiceSearchHanCharResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2263,24 +8788,24 @@ type VocabularyServiceBatchCreateHanCharResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e VocabularyServiceBatchCreateHanCharResponseValidationError) Field() string { return e.field }
+func (e VocabularyServiceSearchHanCharResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e VocabularyServiceBatchCreateHanCharResponseValidationError) Reason() string { return e.reason }
+func (e VocabularyServiceSearchHanCharResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e VocabularyServiceBatchCreateHanCharResponseValidationError) Cause() error { return e.cause }
+func (e VocabularyServiceSearchHanCharResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e VocabularyServiceBatchCreateHanCharResponseValidationError) Key() bool { return e.key }
+func (e VocabularyServiceSearchHanCharResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e VocabularyServiceBatchCreateHanCharResponseValidationError) ErrorName() string {
-	return "VocabularyServiceBatchCreateHanCharResponseValidationError"
+func (e VocabularyServiceSearchHanCharResponseValidationError) ErrorName() string {
+	return "VocabularyServiceSearchHanCharResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e VocabularyServiceBatchCreateHanCharResponseValidationError) Error() string {
+func (e VocabularyServiceSearchHanCharResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2292,14 +8817,14 @@ func (e VocabularyServiceBatchCreateHanCharResponseValidationError) Error() stri
 	}
 
 	return fmt.Sprintf(
-		"invalid %sVocabularyServiceBatchCreateHanCharResponse.%s: %s%s",
+		"invalid %sVocabularyServiceSearchHanCharResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = VocabularyServiceBatchCreateHanCharResponseValidationError{}
+var _ error = VocabularyServiceSearchHanCharResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2307,4 +8832,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = VocabularyServiceBatchCreateHanCharResponseValidationError{}
+} = VocabularyServiceSearchHanCharResponseValidationError{}
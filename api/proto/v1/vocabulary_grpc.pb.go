@@ -19,12 +19,31 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	VocabularyService_Get_FullMethodName                = "/proto.v1.VocabularyService/Get"
-	VocabularyService_List_FullMethodName               = "/proto.v1.VocabularyService/List"
-	VocabularyService_GetAllMetadata_FullMethodName     = "/proto.v1.VocabularyService/GetAllMetadata"
-	VocabularyService_ListHanChar_FullMethodName        = "/proto.v1.VocabularyService/ListHanChar"
-	VocabularyService_BatchCreate_FullMethodName        = "/proto.v1.VocabularyService/BatchCreate"
-	VocabularyService_BatchCreateHanChar_FullMethodName = "/proto.v1.VocabularyService/BatchCreateHanChar"
+	VocabularyService_Get_FullMethodName                       = "/proto.v1.VocabularyService/Get"
+	VocabularyService_List_FullMethodName                      = "/proto.v1.VocabularyService/List"
+	VocabularyService_Search_FullMethodName                    = "/proto.v1.VocabularyService/Search"
+	VocabularyService_Lookup_FullMethodName                    = "/proto.v1.VocabularyService/Lookup"
+	VocabularyService_Create_FullMethodName                    = "/proto.v1.VocabularyService/Create"
+	VocabularyService_Update_FullMethodName                    = "/proto.v1.VocabularyService/Update"
+	VocabularyService_Delete_FullMethodName                    = "/proto.v1.VocabularyService/Delete"
+	VocabularyService_GetAllMetadata_FullMethodName            = "/proto.v1.VocabularyService/GetAllMetadata"
+	VocabularyService_ListHanChar_FullMethodName               = "/proto.v1.VocabularyService/ListHanChar"
+	VocabularyService_GetHanChar_FullMethodName                = "/proto.v1.VocabularyService/GetHanChar"
+	VocabularyService_SearchHanChar_FullMethodName             = "/proto.v1.VocabularyService/SearchHanChar"
+	VocabularyService_CreateHanChar_FullMethodName             = "/proto.v1.VocabularyService/CreateHanChar"
+	VocabularyService_UpdateHanChar_FullMethodName             = "/proto.v1.VocabularyService/UpdateHanChar"
+	VocabularyService_DeleteHanChar_FullMethodName             = "/proto.v1.VocabularyService/DeleteHanChar"
+	VocabularyService_BatchCreate_FullMethodName               = "/proto.v1.VocabularyService/BatchCreate"
+	VocabularyService_BatchCreateHanChar_FullMethodName        = "/proto.v1.VocabularyService/BatchCreateHanChar"
+	VocabularyService_BatchImportHanCharStrokes_FullMethodName = "/proto.v1.VocabularyService/BatchImportHanCharStrokes"
+	VocabularyService_BatchImportWordForms_FullMethodName      = "/proto.v1.VocabularyService/BatchImportWordForms"
+	VocabularyService_GetRelations_FullMethodName              = "/proto.v1.VocabularyService/GetRelations"
+	VocabularyService_CreateRelation_FullMethodName            = "/proto.v1.VocabularyService/CreateRelation"
+	VocabularyService_DeleteRelation_FullMethodName            = "/proto.v1.VocabularyService/DeleteRelation"
+	VocabularyService_GetHanCharRelations_FullMethodName       = "/proto.v1.VocabularyService/GetHanCharRelations"
+	VocabularyService_CreateHanCharRelation_FullMethodName     = "/proto.v1.VocabularyService/CreateHanCharRelation"
+	VocabularyService_DeleteHanCharRelation_FullMethodName     = "/proto.v1.VocabularyService/DeleteHanCharRelation"
+	VocabularyService_BatchImportRelations_FullMethodName      = "/proto.v1.VocabularyService/BatchImportRelations"
 )
 
 // VocabularyServiceClient is the client API for VocabularyService service.
@@ -32,19 +51,58 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 //
 // VocabularyService 提供单词相关的服务
+// 查询接口对所有登录用户开放, 创建、更新和删除只允许管理员和内容管理员调用
 type VocabularyServiceClient interface {
 	// Get 获取单词详情
 	Get(ctx context.Context, in *VocabularyServiceGetRequest, opts ...grpc.CallOption) (*VocabularyServiceGetResponse, error)
 	// List 获取单词列表
 	List(ctx context.Context, in *VocabularyServiceListRequest, opts ...grpc.CallOption) (*VocabularyServiceListResponse, error)
+	// Search 全文检索单词, 支持前缀匹配和容忍拼写错误, 按相关度排序并高亮匹配的片段
+	Search(ctx context.Context, in *VocabularyServiceSearchRequest, opts ...grpc.CallOption) (*VocabularyServiceSearchResponse, error)
+	// Lookup 按单词或其屈折变化形式查找原形单词, 如 ran 找到 run
+	Lookup(ctx context.Context, in *VocabularyServiceLookupRequest, opts ...grpc.CallOption) (*VocabularyServiceLookupResponse, error)
+	// Create 创建单词
+	Create(ctx context.Context, in *VocabularyServiceCreateRequest, opts ...grpc.CallOption) (*VocabularyServiceCreateResponse, error)
+	// Update 更新单词
+	Update(ctx context.Context, in *VocabularyServiceUpdateRequest, opts ...grpc.CallOption) (*VocabularyServiceUpdateResponse, error)
+	// Delete 删除单词
+	Delete(ctx context.Context, in *VocabularyServiceDeleteRequest, opts ...grpc.CallOption) (*VocabularyServiceDeleteResponse, error)
 	// GetAllMetadata 获取所有标签和分类信息
 	GetAllMetadata(ctx context.Context, in *VocabularyServiceGetAllMetadataRequest, opts ...grpc.CallOption) (*VocabularyServiceGetAllMetadataResponse, error)
 	// ListHanChar 获取汉字列表
 	ListHanChar(ctx context.Context, in *VocabularyServiceListHanCharRequest, opts ...grpc.CallOption) (*VocabularyServiceListHanCharResponse, error)
+	// GetHanChar 按ID或字符查询汉字详情, 包含笔顺数据
+	GetHanChar(ctx context.Context, in *VocabularyServiceGetHanCharRequest, opts ...grpc.CallOption) (*VocabularyServiceGetHanCharResponse, error)
+	// SearchHanChar 按汉字或拼音搜索汉字
+	SearchHanChar(ctx context.Context, in *VocabularyServiceSearchHanCharRequest, opts ...grpc.CallOption) (*VocabularyServiceSearchHanCharResponse, error)
+	// CreateHanChar 创建汉字
+	CreateHanChar(ctx context.Context, in *VocabularyServiceCreateHanCharRequest, opts ...grpc.CallOption) (*VocabularyServiceCreateHanCharResponse, error)
+	// UpdateHanChar 更新汉字
+	UpdateHanChar(ctx context.Context, in *VocabularyServiceUpdateHanCharRequest, opts ...grpc.CallOption) (*VocabularyServiceUpdateHanCharResponse, error)
+	// DeleteHanChar 删除汉字
+	DeleteHanChar(ctx context.Context, in *VocabularyServiceDeleteHanCharRequest, opts ...grpc.CallOption) (*VocabularyServiceDeleteHanCharResponse, error)
 	// 批量英文单词
 	BatchCreate(ctx context.Context, in *VocabularyServiceBatchCreateRequest, opts ...grpc.CallOption) (*VocabularyServiceBatchCreateResponse, error)
 	// 批量汉字
 	BatchCreateHanChar(ctx context.Context, in *VocabularyServiceBatchCreateHanCharRequest, opts ...grpc.CallOption) (*VocabularyServiceBatchCreateHanCharResponse, error)
+	// 批量导入汉字笔顺数据, 已有的笔顺数据会被覆盖
+	BatchImportHanCharStrokes(ctx context.Context, in *VocabularyServiceBatchImportHanCharStrokesRequest, opts ...grpc.CallOption) (*VocabularyServiceBatchImportHanCharStrokesResponse, error)
+	// 批量导入单词的屈折变化形式, 已有的词形会被覆盖
+	BatchImportWordForms(ctx context.Context, in *VocabularyServiceBatchImportWordFormsRequest, opts ...grpc.CallOption) (*VocabularyServiceBatchImportWordFormsResponse, error)
+	// 获取单词的关联单词, 如同义词、反义词和同族词
+	GetRelations(ctx context.Context, in *VocabularyServiceGetRelationsRequest, opts ...grpc.CallOption) (*VocabularyServiceGetRelationsResponse, error)
+	// 创建两个单词之间的关联
+	CreateRelation(ctx context.Context, in *VocabularyServiceCreateRelationRequest, opts ...grpc.CallOption) (*VocabularyServiceCreateRelationResponse, error)
+	// 删除单词关联
+	DeleteRelation(ctx context.Context, in *VocabularyServiceDeleteRelationRequest, opts ...grpc.CallOption) (*VocabularyServiceDeleteRelationResponse, error)
+	// 获取汉字的关联汉字
+	GetHanCharRelations(ctx context.Context, in *VocabularyServiceGetHanCharRelationsRequest, opts ...grpc.CallOption) (*VocabularyServiceGetHanCharRelationsResponse, error)
+	// 创建两个汉字之间的关联
+	CreateHanCharRelation(ctx context.Context, in *VocabularyServiceCreateHanCharRelationRequest, opts ...grpc.CallOption) (*VocabularyServiceCreateHanCharRelationResponse, error)
+	// 删除汉字关联
+	DeleteHanCharRelation(ctx context.Context, in *VocabularyServiceDeleteHanCharRelationRequest, opts ...grpc.CallOption) (*VocabularyServiceDeleteHanCharRelationResponse, error)
+	// 批量导入单词关联和汉字关联, 已存在的关联跳过
+	BatchImportRelations(ctx context.Context, in *VocabularyServiceBatchImportRelationsRequest, opts ...grpc.CallOption) (*VocabularyServiceBatchImportRelationsResponse, error)
 }
 
 type vocabularyServiceClient struct {
@@ -75,6 +133,56 @@ func (c *vocabularyServiceClient) List(ctx context.Context, in *VocabularyServic
 	return out, nil
 }
 
+func (c *vocabularyServiceClient) Search(ctx context.Context, in *VocabularyServiceSearchRequest, opts ...grpc.CallOption) (*VocabularyServiceSearchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VocabularyServiceSearchResponse)
+	err := c.cc.Invoke(ctx, VocabularyService_Search_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vocabularyServiceClient) Lookup(ctx context.Context, in *VocabularyServiceLookupRequest, opts ...grpc.CallOption) (*VocabularyServiceLookupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VocabularyServiceLookupResponse)
+	err := c.cc.Invoke(ctx, VocabularyService_Lookup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vocabularyServiceClient) Create(ctx context.Context, in *VocabularyServiceCreateRequest, opts ...grpc.CallOption) (*VocabularyServiceCreateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VocabularyServiceCreateResponse)
+	err := c.cc.Invoke(ctx, VocabularyService_Create_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vocabularyServiceClient) Update(ctx context.Context, in *VocabularyServiceUpdateRequest, opts ...grpc.CallOption) (*VocabularyServiceUpdateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VocabularyServiceUpdateResponse)
+	err := c.cc.Invoke(ctx, VocabularyService_Update_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vocabularyServiceClient) Delete(ctx context.Context, in *VocabularyServiceDeleteRequest, opts ...grpc.CallOption) (*VocabularyServiceDeleteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VocabularyServiceDeleteResponse)
+	err := c.cc.Invoke(ctx, VocabularyService_Delete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *vocabularyServiceClient) GetAllMetadata(ctx context.Context, in *VocabularyServiceGetAllMetadataRequest, opts ...grpc.CallOption) (*VocabularyServiceGetAllMetadataResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(VocabularyServiceGetAllMetadataResponse)
@@ -95,6 +203,56 @@ func (c *vocabularyServiceClient) ListHanChar(ctx context.Context, in *Vocabular
 	return out, nil
 }
 
+func (c *vocabularyServiceClient) GetHanChar(ctx context.Context, in *VocabularyServiceGetHanCharRequest, opts ...grpc.CallOption) (*VocabularyServiceGetHanCharResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VocabularyServiceGetHanCharResponse)
+	err := c.cc.Invoke(ctx, VocabularyService_GetHanChar_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vocabularyServiceClient) SearchHanChar(ctx context.Context, in *VocabularyServiceSearchHanCharRequest, opts ...grpc.CallOption) (*VocabularyServiceSearchHanCharResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VocabularyServiceSearchHanCharResponse)
+	err := c.cc.Invoke(ctx, VocabularyService_SearchHanChar_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vocabularyServiceClient) CreateHanChar(ctx context.Context, in *VocabularyServiceCreateHanCharRequest, opts ...grpc.CallOption) (*VocabularyServiceCreateHanCharResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VocabularyServiceCreateHanCharResponse)
+	err := c.cc.Invoke(ctx, VocabularyService_CreateHanChar_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vocabularyServiceClient) UpdateHanChar(ctx context.Context, in *VocabularyServiceUpdateHanCharRequest, opts ...grpc.CallOption) (*VocabularyServiceUpdateHanCharResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VocabularyServiceUpdateHanCharResponse)
+	err := c.cc.Invoke(ctx, VocabularyService_UpdateHanChar_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vocabularyServiceClient) DeleteHanChar(ctx context.Context, in *VocabularyServiceDeleteHanCharRequest, opts ...grpc.CallOption) (*VocabularyServiceDeleteHanCharResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VocabularyServiceDeleteHanCharResponse)
+	err := c.cc.Invoke(ctx, VocabularyService_DeleteHanChar_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *vocabularyServiceClient) BatchCreate(ctx context.Context, in *VocabularyServiceBatchCreateRequest, opts ...grpc.CallOption) (*VocabularyServiceBatchCreateResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(VocabularyServiceBatchCreateResponse)
@@ -115,24 +273,153 @@ func (c *vocabularyServiceClient) BatchCreateHanChar(ctx context.Context, in *Vo
 	return out, nil
 }
 
+func (c *vocabularyServiceClient) BatchImportHanCharStrokes(ctx context.Context, in *VocabularyServiceBatchImportHanCharStrokesRequest, opts ...grpc.CallOption) (*VocabularyServiceBatchImportHanCharStrokesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VocabularyServiceBatchImportHanCharStrokesResponse)
+	err := c.cc.Invoke(ctx, VocabularyService_BatchImportHanCharStrokes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vocabularyServiceClient) BatchImportWordForms(ctx context.Context, in *VocabularyServiceBatchImportWordFormsRequest, opts ...grpc.CallOption) (*VocabularyServiceBatchImportWordFormsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VocabularyServiceBatchImportWordFormsResponse)
+	err := c.cc.Invoke(ctx, VocabularyService_BatchImportWordForms_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vocabularyServiceClient) GetRelations(ctx context.Context, in *VocabularyServiceGetRelationsRequest, opts ...grpc.CallOption) (*VocabularyServiceGetRelationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VocabularyServiceGetRelationsResponse)
+	err := c.cc.Invoke(ctx, VocabularyService_GetRelations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vocabularyServiceClient) CreateRelation(ctx context.Context, in *VocabularyServiceCreateRelationRequest, opts ...grpc.CallOption) (*VocabularyServiceCreateRelationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VocabularyServiceCreateRelationResponse)
+	err := c.cc.Invoke(ctx, VocabularyService_CreateRelation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vocabularyServiceClient) DeleteRelation(ctx context.Context, in *VocabularyServiceDeleteRelationRequest, opts ...grpc.CallOption) (*VocabularyServiceDeleteRelationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VocabularyServiceDeleteRelationResponse)
+	err := c.cc.Invoke(ctx, VocabularyService_DeleteRelation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vocabularyServiceClient) GetHanCharRelations(ctx context.Context, in *VocabularyServiceGetHanCharRelationsRequest, opts ...grpc.CallOption) (*VocabularyServiceGetHanCharRelationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VocabularyServiceGetHanCharRelationsResponse)
+	err := c.cc.Invoke(ctx, VocabularyService_GetHanCharRelations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vocabularyServiceClient) CreateHanCharRelation(ctx context.Context, in *VocabularyServiceCreateHanCharRelationRequest, opts ...grpc.CallOption) (*VocabularyServiceCreateHanCharRelationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VocabularyServiceCreateHanCharRelationResponse)
+	err := c.cc.Invoke(ctx, VocabularyService_CreateHanCharRelation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vocabularyServiceClient) DeleteHanCharRelation(ctx context.Context, in *VocabularyServiceDeleteHanCharRelationRequest, opts ...grpc.CallOption) (*VocabularyServiceDeleteHanCharRelationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VocabularyServiceDeleteHanCharRelationResponse)
+	err := c.cc.Invoke(ctx, VocabularyService_DeleteHanCharRelation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vocabularyServiceClient) BatchImportRelations(ctx context.Context, in *VocabularyServiceBatchImportRelationsRequest, opts ...grpc.CallOption) (*VocabularyServiceBatchImportRelationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VocabularyServiceBatchImportRelationsResponse)
+	err := c.cc.Invoke(ctx, VocabularyService_BatchImportRelations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // VocabularyServiceServer is the server API for VocabularyService service.
 // All implementations must embed UnimplementedVocabularyServiceServer
 // for forward compatibility.
 //
 // VocabularyService 提供单词相关的服务
+// 查询接口对所有登录用户开放, 创建、更新和删除只允许管理员和内容管理员调用
 type VocabularyServiceServer interface {
 	// Get 获取单词详情
 	Get(context.Context, *VocabularyServiceGetRequest) (*VocabularyServiceGetResponse, error)
 	// List 获取单词列表
 	List(context.Context, *VocabularyServiceListRequest) (*VocabularyServiceListResponse, error)
+	// Search 全文检索单词, 支持前缀匹配和容忍拼写错误, 按相关度排序并高亮匹配的片段
+	Search(context.Context, *VocabularyServiceSearchRequest) (*VocabularyServiceSearchResponse, error)
+	// Lookup 按单词或其屈折变化形式查找原形单词, 如 ran 找到 run
+	Lookup(context.Context, *VocabularyServiceLookupRequest) (*VocabularyServiceLookupResponse, error)
+	// Create 创建单词
+	Create(context.Context, *VocabularyServiceCreateRequest) (*VocabularyServiceCreateResponse, error)
+	// Update 更新单词
+	Update(context.Context, *VocabularyServiceUpdateRequest) (*VocabularyServiceUpdateResponse, error)
+	// Delete 删除单词
+	Delete(context.Context, *VocabularyServiceDeleteRequest) (*VocabularyServiceDeleteResponse, error)
 	// GetAllMetadata 获取所有标签和分类信息
 	GetAllMetadata(context.Context, *VocabularyServiceGetAllMetadataRequest) (*VocabularyServiceGetAllMetadataResponse, error)
 	// ListHanChar 获取汉字列表
 	ListHanChar(context.Context, *VocabularyServiceListHanCharRequest) (*VocabularyServiceListHanCharResponse, error)
+	// GetHanChar 按ID或字符查询汉字详情, 包含笔顺数据
+	GetHanChar(context.Context, *VocabularyServiceGetHanCharRequest) (*VocabularyServiceGetHanCharResponse, error)
+	// SearchHanChar 按汉字或拼音搜索汉字
+	SearchHanChar(context.Context, *VocabularyServiceSearchHanCharRequest) (*VocabularyServiceSearchHanCharResponse, error)
+	// CreateHanChar 创建汉字
+	CreateHanChar(context.Context, *VocabularyServiceCreateHanCharRequest) (*VocabularyServiceCreateHanCharResponse, error)
+	// UpdateHanChar 更新汉字
+	UpdateHanChar(context.Context, *VocabularyServiceUpdateHanCharRequest) (*VocabularyServiceUpdateHanCharResponse, error)
+	// DeleteHanChar 删除汉字
+	DeleteHanChar(context.Context, *VocabularyServiceDeleteHanCharRequest) (*VocabularyServiceDeleteHanCharResponse, error)
 	// 批量英文单词
 	BatchCreate(context.Context, *VocabularyServiceBatchCreateRequest) (*VocabularyServiceBatchCreateResponse, error)
 	// 批量汉字
 	BatchCreateHanChar(context.Context, *VocabularyServiceBatchCreateHanCharRequest) (*VocabularyServiceBatchCreateHanCharResponse, error)
+	// 批量导入汉字笔顺数据, 已有的笔顺数据会被覆盖
+	BatchImportHanCharStrokes(context.Context, *VocabularyServiceBatchImportHanCharStrokesRequest) (*VocabularyServiceBatchImportHanCharStrokesResponse, error)
+	// 批量导入单词的屈折变化形式, 已有的词形会被覆盖
+	BatchImportWordForms(context.Context, *VocabularyServiceBatchImportWordFormsRequest) (*VocabularyServiceBatchImportWordFormsResponse, error)
+	// 获取单词的关联单词, 如同义词、反义词和同族词
+	GetRelations(context.Context, *VocabularyServiceGetRelationsRequest) (*VocabularyServiceGetRelationsResponse, error)
+	// 创建两个单词之间的关联
+	CreateRelation(context.Context, *VocabularyServiceCreateRelationRequest) (*VocabularyServiceCreateRelationResponse, error)
+	// 删除单词关联
+	DeleteRelation(context.Context, *VocabularyServiceDeleteRelationRequest) (*VocabularyServiceDeleteRelationResponse, error)
+	// 获取汉字的关联汉字
+	GetHanCharRelations(context.Context, *VocabularyServiceGetHanCharRelationsRequest) (*VocabularyServiceGetHanCharRelationsResponse, error)
+	// 创建两个汉字之间的关联
+	CreateHanCharRelation(context.Context, *VocabularyServiceCreateHanCharRelationRequest) (*VocabularyServiceCreateHanCharRelationResponse, error)
+	// 删除汉字关联
+	DeleteHanCharRelation(context.Context, *VocabularyServiceDeleteHanCharRelationRequest) (*VocabularyServiceDeleteHanCharRelationResponse, error)
+	// 批量导入单词关联和汉字关联, 已存在的关联跳过
+	BatchImportRelations(context.Context, *VocabularyServiceBatchImportRelationsRequest) (*VocabularyServiceBatchImportRelationsResponse, error)
 	mustEmbedUnimplementedVocabularyServiceServer()
 }
 
@@ -149,18 +436,75 @@ func (UnimplementedVocabularyServiceServer) Get(context.Context, *VocabularyServ
 func (UnimplementedVocabularyServiceServer) List(context.Context, *VocabularyServiceListRequest) (*VocabularyServiceListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
 }
+func (UnimplementedVocabularyServiceServer) Search(context.Context, *VocabularyServiceSearchRequest) (*VocabularyServiceSearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
+}
+func (UnimplementedVocabularyServiceServer) Lookup(context.Context, *VocabularyServiceLookupRequest) (*VocabularyServiceLookupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Lookup not implemented")
+}
+func (UnimplementedVocabularyServiceServer) Create(context.Context, *VocabularyServiceCreateRequest) (*VocabularyServiceCreateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedVocabularyServiceServer) Update(context.Context, *VocabularyServiceUpdateRequest) (*VocabularyServiceUpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedVocabularyServiceServer) Delete(context.Context, *VocabularyServiceDeleteRequest) (*VocabularyServiceDeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
 func (UnimplementedVocabularyServiceServer) GetAllMetadata(context.Context, *VocabularyServiceGetAllMetadataRequest) (*VocabularyServiceGetAllMetadataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAllMetadata not implemented")
 }
 func (UnimplementedVocabularyServiceServer) ListHanChar(context.Context, *VocabularyServiceListHanCharRequest) (*VocabularyServiceListHanCharResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListHanChar not implemented")
 }
+func (UnimplementedVocabularyServiceServer) GetHanChar(context.Context, *VocabularyServiceGetHanCharRequest) (*VocabularyServiceGetHanCharResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHanChar not implemented")
+}
+func (UnimplementedVocabularyServiceServer) SearchHanChar(context.Context, *VocabularyServiceSearchHanCharRequest) (*VocabularyServiceSearchHanCharResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchHanChar not implemented")
+}
+func (UnimplementedVocabularyServiceServer) CreateHanChar(context.Context, *VocabularyServiceCreateHanCharRequest) (*VocabularyServiceCreateHanCharResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateHanChar not implemented")
+}
+func (UnimplementedVocabularyServiceServer) UpdateHanChar(context.Context, *VocabularyServiceUpdateHanCharRequest) (*VocabularyServiceUpdateHanCharResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateHanChar not implemented")
+}
+func (UnimplementedVocabularyServiceServer) DeleteHanChar(context.Context, *VocabularyServiceDeleteHanCharRequest) (*VocabularyServiceDeleteHanCharResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteHanChar not implemented")
+}
 func (UnimplementedVocabularyServiceServer) BatchCreate(context.Context, *VocabularyServiceBatchCreateRequest) (*VocabularyServiceBatchCreateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BatchCreate not implemented")
 }
 func (UnimplementedVocabularyServiceServer) BatchCreateHanChar(context.Context, *VocabularyServiceBatchCreateHanCharRequest) (*VocabularyServiceBatchCreateHanCharResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BatchCreateHanChar not implemented")
 }
+func (UnimplementedVocabularyServiceServer) BatchImportHanCharStrokes(context.Context, *VocabularyServiceBatchImportHanCharStrokesRequest) (*VocabularyServiceBatchImportHanCharStrokesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchImportHanCharStrokes not implemented")
+}
+func (UnimplementedVocabularyServiceServer) BatchImportWordForms(context.Context, *VocabularyServiceBatchImportWordFormsRequest) (*VocabularyServiceBatchImportWordFormsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchImportWordForms not implemented")
+}
+func (UnimplementedVocabularyServiceServer) GetRelations(context.Context, *VocabularyServiceGetRelationsRequest) (*VocabularyServiceGetRelationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRelations not implemented")
+}
+func (UnimplementedVocabularyServiceServer) CreateRelation(context.Context, *VocabularyServiceCreateRelationRequest) (*VocabularyServiceCreateRelationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRelation not implemented")
+}
+func (UnimplementedVocabularyServiceServer) DeleteRelation(context.Context, *VocabularyServiceDeleteRelationRequest) (*VocabularyServiceDeleteRelationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRelation not implemented")
+}
+func (UnimplementedVocabularyServiceServer) GetHanCharRelations(context.Context, *VocabularyServiceGetHanCharRelationsRequest) (*VocabularyServiceGetHanCharRelationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHanCharRelations not implemented")
+}
+func (UnimplementedVocabularyServiceServer) CreateHanCharRelation(context.Context, *VocabularyServiceCreateHanCharRelationRequest) (*VocabularyServiceCreateHanCharRelationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateHanCharRelation not implemented")
+}
+func (UnimplementedVocabularyServiceServer) DeleteHanCharRelation(context.Context, *VocabularyServiceDeleteHanCharRelationRequest) (*VocabularyServiceDeleteHanCharRelationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteHanCharRelation not implemented")
+}
+func (UnimplementedVocabularyServiceServer) BatchImportRelations(context.Context, *VocabularyServiceBatchImportRelationsRequest) (*VocabularyServiceBatchImportRelationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchImportRelations not implemented")
+}
 func (UnimplementedVocabularyServiceServer) mustEmbedUnimplementedVocabularyServiceServer() {}
 func (UnimplementedVocabularyServiceServer) testEmbeddedByValue()                           {}
 
@@ -218,6 +562,96 @@ func _VocabularyService_List_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VocabularyService_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VocabularyServiceSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VocabularyServiceServer).Search(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VocabularyService_Search_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VocabularyServiceServer).Search(ctx, req.(*VocabularyServiceSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VocabularyService_Lookup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VocabularyServiceLookupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VocabularyServiceServer).Lookup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VocabularyService_Lookup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VocabularyServiceServer).Lookup(ctx, req.(*VocabularyServiceLookupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VocabularyService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VocabularyServiceCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VocabularyServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VocabularyService_Create_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VocabularyServiceServer).Create(ctx, req.(*VocabularyServiceCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VocabularyService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VocabularyServiceUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VocabularyServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VocabularyService_Update_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VocabularyServiceServer).Update(ctx, req.(*VocabularyServiceUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VocabularyService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VocabularyServiceDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VocabularyServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VocabularyService_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VocabularyServiceServer).Delete(ctx, req.(*VocabularyServiceDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _VocabularyService_GetAllMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(VocabularyServiceGetAllMetadataRequest)
 	if err := dec(in); err != nil {
@@ -254,6 +688,96 @@ func _VocabularyService_ListHanChar_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VocabularyService_GetHanChar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VocabularyServiceGetHanCharRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VocabularyServiceServer).GetHanChar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VocabularyService_GetHanChar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VocabularyServiceServer).GetHanChar(ctx, req.(*VocabularyServiceGetHanCharRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VocabularyService_SearchHanChar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VocabularyServiceSearchHanCharRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VocabularyServiceServer).SearchHanChar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VocabularyService_SearchHanChar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VocabularyServiceServer).SearchHanChar(ctx, req.(*VocabularyServiceSearchHanCharRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VocabularyService_CreateHanChar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VocabularyServiceCreateHanCharRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VocabularyServiceServer).CreateHanChar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VocabularyService_CreateHanChar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VocabularyServiceServer).CreateHanChar(ctx, req.(*VocabularyServiceCreateHanCharRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VocabularyService_UpdateHanChar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VocabularyServiceUpdateHanCharRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VocabularyServiceServer).UpdateHanChar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VocabularyService_UpdateHanChar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VocabularyServiceServer).UpdateHanChar(ctx, req.(*VocabularyServiceUpdateHanCharRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VocabularyService_DeleteHanChar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VocabularyServiceDeleteHanCharRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VocabularyServiceServer).DeleteHanChar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VocabularyService_DeleteHanChar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VocabularyServiceServer).DeleteHanChar(ctx, req.(*VocabularyServiceDeleteHanCharRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _VocabularyService_BatchCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(VocabularyServiceBatchCreateRequest)
 	if err := dec(in); err != nil {
@@ -290,6 +814,168 @@ func _VocabularyService_BatchCreateHanChar_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VocabularyService_BatchImportHanCharStrokes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VocabularyServiceBatchImportHanCharStrokesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VocabularyServiceServer).BatchImportHanCharStrokes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VocabularyService_BatchImportHanCharStrokes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VocabularyServiceServer).BatchImportHanCharStrokes(ctx, req.(*VocabularyServiceBatchImportHanCharStrokesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VocabularyService_BatchImportWordForms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VocabularyServiceBatchImportWordFormsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VocabularyServiceServer).BatchImportWordForms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VocabularyService_BatchImportWordForms_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VocabularyServiceServer).BatchImportWordForms(ctx, req.(*VocabularyServiceBatchImportWordFormsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VocabularyService_GetRelations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VocabularyServiceGetRelationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VocabularyServiceServer).GetRelations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VocabularyService_GetRelations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VocabularyServiceServer).GetRelations(ctx, req.(*VocabularyServiceGetRelationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VocabularyService_CreateRelation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VocabularyServiceCreateRelationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VocabularyServiceServer).CreateRelation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VocabularyService_CreateRelation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VocabularyServiceServer).CreateRelation(ctx, req.(*VocabularyServiceCreateRelationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VocabularyService_DeleteRelation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VocabularyServiceDeleteRelationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VocabularyServiceServer).DeleteRelation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VocabularyService_DeleteRelation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VocabularyServiceServer).DeleteRelation(ctx, req.(*VocabularyServiceDeleteRelationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VocabularyService_GetHanCharRelations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VocabularyServiceGetHanCharRelationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VocabularyServiceServer).GetHanCharRelations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VocabularyService_GetHanCharRelations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VocabularyServiceServer).GetHanCharRelations(ctx, req.(*VocabularyServiceGetHanCharRelationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VocabularyService_CreateHanCharRelation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VocabularyServiceCreateHanCharRelationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VocabularyServiceServer).CreateHanCharRelation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VocabularyService_CreateHanCharRelation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VocabularyServiceServer).CreateHanCharRelation(ctx, req.(*VocabularyServiceCreateHanCharRelationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VocabularyService_DeleteHanCharRelation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VocabularyServiceDeleteHanCharRelationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VocabularyServiceServer).DeleteHanCharRelation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VocabularyService_DeleteHanCharRelation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VocabularyServiceServer).DeleteHanCharRelation(ctx, req.(*VocabularyServiceDeleteHanCharRelationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VocabularyService_BatchImportRelations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VocabularyServiceBatchImportRelationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VocabularyServiceServer).BatchImportRelations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VocabularyService_BatchImportRelations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VocabularyServiceServer).BatchImportRelations(ctx, req.(*VocabularyServiceBatchImportRelationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // VocabularyService_ServiceDesc is the grpc.ServiceDesc for VocabularyService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -305,6 +991,26 @@ var VocabularyService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "List",
 			Handler:    _VocabularyService_List_Handler,
 		},
+		{
+			MethodName: "Search",
+			Handler:    _VocabularyService_Search_Handler,
+		},
+		{
+			MethodName: "Lookup",
+			Handler:    _VocabularyService_Lookup_Handler,
+		},
+		{
+			MethodName: "Create",
+			Handler:    _VocabularyService_Create_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _VocabularyService_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _VocabularyService_Delete_Handler,
+		},
 		{
 			MethodName: "GetAllMetadata",
 			Handler:    _VocabularyService_GetAllMetadata_Handler,
@@ -313,6 +1019,26 @@ var VocabularyService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListHanChar",
 			Handler:    _VocabularyService_ListHanChar_Handler,
 		},
+		{
+			MethodName: "GetHanChar",
+			Handler:    _VocabularyService_GetHanChar_Handler,
+		},
+		{
+			MethodName: "SearchHanChar",
+			Handler:    _VocabularyService_SearchHanChar_Handler,
+		},
+		{
+			MethodName: "CreateHanChar",
+			Handler:    _VocabularyService_CreateHanChar_Handler,
+		},
+		{
+			MethodName: "UpdateHanChar",
+			Handler:    _VocabularyService_UpdateHanChar_Handler,
+		},
+		{
+			MethodName: "DeleteHanChar",
+			Handler:    _VocabularyService_DeleteHanChar_Handler,
+		},
 		{
 			MethodName: "BatchCreate",
 			Handler:    _VocabularyService_BatchCreate_Handler,
@@ -321,6 +1047,42 @@ var VocabularyService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BatchCreateHanChar",
 			Handler:    _VocabularyService_BatchCreateHanChar_Handler,
 		},
+		{
+			MethodName: "BatchImportHanCharStrokes",
+			Handler:    _VocabularyService_BatchImportHanCharStrokes_Handler,
+		},
+		{
+			MethodName: "BatchImportWordForms",
+			Handler:    _VocabularyService_BatchImportWordForms_Handler,
+		},
+		{
+			MethodName: "GetRelations",
+			Handler:    _VocabularyService_GetRelations_Handler,
+		},
+		{
+			MethodName: "CreateRelation",
+			Handler:    _VocabularyService_CreateRelation_Handler,
+		},
+		{
+			MethodName: "DeleteRelation",
+			Handler:    _VocabularyService_DeleteRelation_Handler,
+		},
+		{
+			MethodName: "GetHanCharRelations",
+			Handler:    _VocabularyService_GetHanCharRelations_Handler,
+		},
+		{
+			MethodName: "CreateHanCharRelation",
+			Handler:    _VocabularyService_CreateHanCharRelation_Handler,
+		},
+		{
+			MethodName: "DeleteHanCharRelation",
+			Handler:    _VocabularyService_DeleteHanCharRelation_Handler,
+		},
+		{
+			MethodName: "BatchImportRelations",
+			Handler:    _VocabularyService_BatchImportRelations_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/v1/vocabulary.proto",
@@ -18,15 +18,16 @@ var configFS embed.FS
 
 // Config 配置结构体
 type Config struct {
-	Server   ServerConfig   `mapstructure:"server"`
-	GRPC     GRPCConfig     `mapstructure:"grpc"`
-	Database DatabaseConfig `mapstructure:"database"`
-	Redis    RedisConfig    `mapstructure:"redis"`
-	Log      LogConfig      `mapstructure:"log"`
-	JWT      JWTConfig      `mapstructure:"jwt"`
-	Apple    AppleConfig    `mapstructure:"apple"`
-	Swagger  SwaggerConfig  `mapstructure:"swagger"`
-	RBAC     RBACConfig     `mapstructure:"rbac"`
+	Server    ServerConfig    `mapstructure:"server"`
+	GRPC      GRPCConfig      `mapstructure:"grpc"`
+	Database  DatabaseConfig  `mapstructure:"database"`
+	Redis     RedisConfig     `mapstructure:"redis"`
+	Log       LogConfig       `mapstructure:"log"`
+	JWT       JWTConfig       `mapstructure:"jwt"`
+	Apple     AppleConfig     `mapstructure:"apple"`
+	Swagger   SwaggerConfig   `mapstructure:"swagger"`
+	RBAC      RBACConfig      `mapstructure:"rbac"`
+	Scheduler SchedulerConfig `mapstructure:"scheduler"`
 }
 
 type ServerConfig struct {
@@ -98,6 +99,14 @@ type RBACConfig struct {
 	ConfigDir string `mapstructure:"config_dir"` // RBAC配置文件目录
 }
 
+// SchedulerConfig 复习调度配置
+type SchedulerConfig struct {
+	Algorithm        string    `mapstructure:"algorithm"`         // 默认调度算法：heuristic / fsrs，用户可单独选择
+	DesiredRetention float64   `mapstructure:"desired_retention"` // 期望记忆保持率（0-1），FSRS 使用
+	MaximumInterval  uint32    `mapstructure:"maximum_interval"`  // 最大复习间隔（天）
+	Weights          []float64 `mapstructure:"weights"`           // FSRS 模型权重，为空时使用默认权重
+}
+
 var globalConfig *Config
 
 func InitConfig() error {
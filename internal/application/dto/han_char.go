@@ -1,6 +1,9 @@
 package dto
 
-import "github.com/lazyjean/sla2/internal/domain/valueobject"
+import (
+	"github.com/lazyjean/sla2/internal/domain/entity"
+	"github.com/lazyjean/sla2/internal/domain/valueobject"
+)
 
 // ListHanCharsDTO 获取汉字列表请求
 type ListHanCharsDTO struct {
@@ -9,4 +12,40 @@ type ListHanCharsDTO struct {
 	Level      valueobject.WordDifficultyLevel
 	Tags       []string
 	Categories []string
+	Radical    string // 部首，为空时不过滤
+	MinStrokes uint32 // 最少笔画数，0 表示不限
+	MaxStrokes uint32 // 最多笔画数，0 表示不限
+	Component  string // 包含的部件，为空时不过滤
+	Pinyin     string // 拼音，可以是声调符号、数字标调或不标调的任意写法，为空时不过滤
+	IgnoreTone bool   // 是否忽略声调匹配拼音，拼音不标调时总是忽略
+}
+
+// HanCharRequest 创建或更新汉字请求
+type HanCharRequest struct {
+	Character      string
+	Pinyin         string // 带声调符号的拼音
+	PinyinNumbered string // 数字标调的拼音
+	Level          valueobject.WordDifficultyLevel
+	Tags           []string
+	Categories     []string
+	Examples       []string
+	Radical        string
+	StrokeCount    uint32
+	Components     []string
+	Traditional    string
+	Simplified     string
+	Meanings       []string
+}
+
+// HanCharStrokesRequest 导入汉字笔顺数据请求，格式与 Make Me a Hanzi 的 graphics.txt 一致
+type HanCharStrokesRequest struct {
+	Character string
+	Strokes   []string                // 按笔顺排列的每一笔轮廓的 SVG 路径
+	Medians   []entity.StrokePolyline // 按笔顺排列的每一笔中线
+}
+
+// HanCharStrokesImportResult 导入汉字笔顺数据的结果
+type HanCharStrokesImportResult struct {
+	Imported int      // 导入的汉字数量
+	Missing  []string // 汉字库中不存在而跳过的汉字
 }
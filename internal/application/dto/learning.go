@@ -4,6 +4,8 @@ import (
 	"time"
 
 	"github.com/lazyjean/sla2/internal/domain/entity"
+	"github.com/lazyjean/sla2/internal/domain/repository"
+	"github.com/lazyjean/sla2/internal/domain/valueobject"
 )
 
 // CourseProgressDTO 课程进度DTO
@@ -82,3 +84,165 @@ func UnitProgressToDTO(progress *entity.CourseSectionUnitProgress) *UnitProgress
 	}
 	return dto
 }
+
+// StartStudySessionRequest 开始学习会话请求
+type StartStudySessionRequest struct {
+	Types      []entity.MemoryUnitType         // 学习内容类型，为空时包含全部类型
+	NewCount   *uint32                         // 新学习项数量，为空时使用今日剩余的新内容目标
+	Level      valueobject.WordDifficultyLevel // 新学习项的难度等级
+	Tags       []string                        // 新学习项的标签
+	CourseID   uint                            // 新学习项所属课程，按课程标签选取，与 Tags 同时满足；课程未设置标签时返回错误
+	NotebookID entity.WordNotebookID           // 只学习该生词本中的单词和自定义词条，为 0 时不限定
+}
+
+// BatchReviewStatus 批量复习中单条复习的处理结果
+type BatchReviewStatus int
+
+const (
+	BatchReviewStatusUnspecified BatchReviewStatus = iota
+	BatchReviewStatusApplied                       // 已应用
+	BatchReviewStatusDuplicate                     // 幂等键已提交过，忽略
+	BatchReviewStatusConflict                      // 早于服务端已应用的最近一次复习，忽略
+	BatchReviewStatusNotFound                      // 复习内容不存在
+	BatchReviewStatusInvalid                       // 复习参数无效
+)
+
+// BatchReviewItem 批量提交中的单条复习，通常来自离线学习
+type BatchReviewItem struct {
+	IdempotencyKey string                // 客户端生成的幂等键，重复提交同一个键不会重复应用
+	Type           entity.MemoryUnitType // 复习内容类型
+	ContentID      uint32                // 内容ID（单词ID或汉字ID）
+	Grade          entity.ReviewGrade    // 复习评分
+	ResponseTime   uint32                // 响应时间（毫秒）
+	ReviewedAt     time.Time             // 客户端记录的复习时间
+	SessionID      entity.StudySessionID // 所属学习会话，0 表示不在学习会话中
+}
+
+// BatchReviewResult 批量提交中单条复习的处理结果
+type BatchReviewResult struct {
+	IdempotencyKey string
+	Status         BatchReviewStatus
+	MemoryUnitID   uint32 // 复习对应的记忆单元，内容不存在、参数无效或重复提交时为 0
+	Message        string // 未应用的原因
+}
+
+// ReviewForecastRequest 复习量预测请求
+type ReviewForecastRequest struct {
+	Days      uint32                  // 预测天数（含今天），0 表示使用默认天数
+	Types     []entity.MemoryUnitType // 记忆单元类型，为空时包含全部类型
+	NewPerDay uint32                  // 模拟每天新学习的内容数量，0 表示不模拟
+}
+
+// RescheduleDueRequest 批量调整到期复习请求
+type RescheduleDueRequest struct {
+	Days  uint32                  // 分散的学习日天数（含今天）
+	Types []entity.MemoryUnitType // 记忆单元类型，为空时包含全部类型
+}
+
+// ReviewForecastDay 一个学习日的预测复习量
+type ReviewForecastDay struct {
+	Date           time.Time                     // 学习日的开始时间（用户时区）
+	DueCount       int                           // 已安排的复习数量，今天包含已逾期的复习
+	DueByType      map[entity.MemoryUnitType]int // 已安排的复习数量按类型分布
+	NewCount       int                           // 模拟新学习的内容数量
+	SimulatedCount int                           // 模拟新学习的内容产生的复习数量
+}
+
+// ReviewForecast 复习量预测
+type ReviewForecast struct {
+	Days         []*ReviewForecastDay
+	OverdueCount int // 今天之前已到期但尚未复习的数量，已计入今天
+}
+
+// MemoryStatsRequest 记忆统计请求
+type MemoryStatsRequest struct {
+	Types            []entity.MemoryUnitType // 记忆单元类型，为空时汇总全部类型
+	RetentionWindows []uint32                // 实际记忆保持率的统计窗口（天），为空时使用默认窗口
+}
+
+// RetentionWindow 一个统计窗口内的实际记忆保持率
+// 保持率为到期复习中成功回忆的比例，没有到期复习时为 0
+type RetentionWindow struct {
+	Days         uint32                            // 统计最近多少天
+	ReviewCount  int                               // 到期复习次数
+	CorrectCount int                               // 成功回忆的次数
+	Rate         float64                           // 实际记忆保持率
+	RateByType   map[entity.MemoryUnitType]float64 // 各类型的实际记忆保持率
+}
+
+// MemoryStats 记忆统计
+type MemoryStats struct {
+	repository.MemoryUnitStats
+	NeedReviewCount int                // 当前到期需要复习的数量，不含已暂停的
+	Retention       []*RetentionWindow // 与请求的统计窗口一一对应
+}
+
+// StudyActivityRequest 学习活动请求，日期为用户时区的学习日，格式 YYYY-MM-DD，为空时使用默认范围
+type StudyActivityRequest struct {
+	StartDate string
+	EndDate   string
+}
+
+// StudyActivity 连续学习天数和学习热力图
+type StudyActivity struct {
+	Streak     *entity.StudyStreak
+	FreezeDays uint32              // 用户设置的断签保护天数
+	Days       []*entity.DailyStat // 日期范围内有学习记录的学习日，按日期升序
+}
+
+// NewHanCharLearningRequest 提交生字学习结果请求
+type NewHanCharLearningRequest struct {
+	LearnedAt     time.Time              // 学习时间
+	StudyDuration uint32                 // 本次学习总时长（秒），平均分摊到每个汉字
+	Results       []*HanCharLearningItem // 每个汉字的学习结果
+}
+
+// HanCharLearningItem 单个生字的学习结果
+type HanCharLearningItem struct {
+	HanCharID entity.HanCharID
+	Result    entity.HanCharLearningResult
+}
+
+// HanCharTestResult 单个汉字的测试结果
+type HanCharTestResult struct {
+	HanCharID  entity.HanCharID
+	Recognized bool // 是否认识
+}
+
+// HanCharTestRequest 汉字测试请求
+type HanCharTestRequest struct {
+	Count int                             // 题目数量，0 表示使用默认数量
+	Level valueobject.WordDifficultyLevel // 难度等级，未指定时不过滤
+	Types []entity.HanCharQuestionType    // 可用的题型，为空时使用全部题型
+	Seed  int64                           // 随机种子，0 表示由服务端生成
+}
+
+// HanCharTest 汉字测试
+type HanCharTest struct {
+	Seed      int64                     // 随机种子，评分时需要原样提交
+	HanChars  []*entity.HanChar         // 测试的汉字
+	Questions []*entity.HanCharQuestion // 选择题，不适用任何题型的汉字没有对应的题目
+}
+
+// HanCharTestAnswer 汉字选择题的作答
+type HanCharTestAnswer struct {
+	HanCharID entity.HanCharID
+	Type      entity.HanCharQuestionType
+	Selected  string // 所选选项的文本
+}
+
+// HanCharTestGrade 汉字选择题的评分结果
+type HanCharTestGrade struct {
+	HanCharID entity.HanCharID
+	Type      entity.HanCharQuestionType
+	Correct   bool
+	Answer    string // 正确答案的文本
+}
+
+// HanCharHandwritingRequest 提交汉字书写练习请求
+type HanCharHandwritingRequest struct {
+	HanCharID     entity.HanCharID
+	Attempt       int                     // 第几次作答（1-3），0 表示第一次
+	Strokes       []entity.StrokePolyline // 按书写顺序排列的每一笔
+	StudyDuration uint32                  // 本次书写时长（秒）
+}
@@ -0,0 +1,42 @@
+package dto
+
+import "github.com/lazyjean/sla2/internal/domain/entity"
+
+// WordNeighbor 与单词相关联的单词
+type WordNeighbor struct {
+	RelationID entity.WordRelationID
+	Type       entity.LexicalRelationType
+	Inverse    bool   // 是否为反向的派生关联，即 Word 派生自查询的单词
+	Note       string // 关联说明
+	Word       *entity.Word
+}
+
+// HanCharNeighbor 与汉字相关联的汉字
+type HanCharNeighbor struct {
+	RelationID entity.HanCharRelationID
+	Type       entity.LexicalRelationType
+	Inverse    bool   // 是否为反向的派生关联，即 HanChar 派生自查询的汉字
+	Note       string // 关联说明
+	HanChar    *entity.HanChar
+}
+
+// RelationImportItem 按文本导入的一条关联，单词按单词文本、汉字按字符匹配
+type RelationImportItem struct {
+	Source string
+	Target string
+	Type   entity.LexicalRelationType
+	Note   string
+}
+
+// ImportRelationsRequest 批量导入关联的请求
+type ImportRelationsRequest struct {
+	Words    []*RelationImportItem // 单词之间的关联
+	HanChars []*RelationImportItem // 汉字之间的关联
+}
+
+// RelationsImportResult 批量导入关联的结果
+type RelationsImportResult struct {
+	Created int      // 新建的关联数量
+	Skipped int      // 已存在而跳过的关联数量
+	Missing []string // 单词库或汉字库中不存在而跳过的单词和汉字
+}
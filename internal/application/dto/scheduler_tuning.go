@@ -0,0 +1,19 @@
+package dto
+
+import (
+	domainService "github.com/lazyjean/sla2/internal/domain/service"
+)
+
+// SchedulerEvaluation 一种调度算法的回放评估结果
+type SchedulerEvaluation struct {
+	Name    string
+	Metrics domainService.ReplayMetrics
+}
+
+// SchedulerFit 按用户复习记录拟合的 FSRS 模型权重
+type SchedulerFit struct {
+	UserID  uint32
+	Weights []float64
+	Before  domainService.ReplayMetrics // 部署配置的 FSRS 参数的回放指标
+	After   domainService.ReplayMetrics // 拟合权重的回放指标
+}
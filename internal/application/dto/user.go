@@ -55,13 +55,28 @@ type LoginResponse struct {
 
 // UpdateUserRequest 更新用户信息请求
 type UpdateUserRequest struct {
-	Nickname string `json:"nickname,omitempty"`
-	Avatar   string `json:"avatar,omitempty"`
+	Nickname  string  `json:"nickname,omitempty"`
+	Avatar    string  `json:"avatar,omitempty"`
+	Scheduler *string `json:"scheduler,omitempty"` // 复习调度算法，nil 表示不修改
 }
 
 type UpdateUserResponse struct {
 }
 
+// UpdateUserSettingsRequest 更新用户学习偏好请求，整体替换已有偏好
+type UpdateUserSettingsRequest struct {
+	DailyReviewGoal    uint32                  `json:"daily_review_goal"`
+	DailyNewGoal       uint32                  `json:"daily_new_goal"`
+	DesiredRetention   float64                 `json:"desired_retention"` // 0 表示使用系统默认
+	MaximumInterval    uint32                  `json:"maximum_interval"`  // 天，0 表示使用系统默认
+	DayStartHour       uint32                  `json:"day_start_hour"`
+	TimeZone           string                  `json:"time_zone"` // IANA 时区，为空时使用服务器时区
+	PreferredUnitTypes []entity.MemoryUnitType `json:"preferred_unit_types"`
+	LeechThreshold     uint32                  `json:"leech_threshold"` // 遗忘次数，0 表示使用系统默认
+	LeechAction        entity.LeechAction      `json:"leech_action"`
+	StreakFreezeDays   uint32                  `json:"streak_freeze_days"` // 一次连续学习中允许中断的天数
+}
+
 // ChangePasswordRequest 修改密码请求
 type ChangePasswordRequest struct {
 	OldPassword string `json:"old_password"`
@@ -105,6 +120,7 @@ type UserDTO struct {
 	Nickname      string     `json:"nickname,omitempty"`
 	Avatar        string     `json:"avatar,omitempty"`
 	Status        string     `json:"status"`
+	Scheduler     string     `json:"scheduler,omitempty"`
 	CreatedAt     time.Time  `json:"created_at"`
 	UpdatedAt     time.Time  `json:"updated_at"`
 }
@@ -2,10 +2,11 @@ package dto
 
 import (
 	"github.com/lazyjean/sla2/internal/domain/entity"
+	domainService "github.com/lazyjean/sla2/internal/domain/service"
 	"github.com/lazyjean/sla2/internal/domain/valueobject"
 )
 
-// CreateWordRequest 创建单词的请求数据
+// CreateWordRequest 创建或更新单词的请求数据
 type CreateWordRequest struct {
 	Text        string
 	Phonetic    string
@@ -15,28 +16,6 @@ type CreateWordRequest struct {
 	Level       valueobject.WordDifficultyLevel
 }
 
-// WordCreateDTO 创建单词的请求数据
-type WordCreateDTO struct {
-	Text        string
-	Definitions []entity.Definition
-	Phonetic    string
-	Examples    []string
-	Tags        []string
-	Level       valueobject.WordDifficultyLevel
-}
-
-// WordResponseDTO 单词响应的数据传输对象
-type WordResponseDTO struct {
-	ID          uint32
-	Text        string
-	Definitions []entity.Definition
-	Phonetic    string
-	Examples    []string
-	Tags        []string
-	CreatedAt   string
-	UpdatedAt   string
-}
-
 // BatchCreateWordRequest 批量创建单词请求
 type BatchCreateWordRequest struct {
 	Word        string
@@ -52,40 +31,52 @@ type BatchCreateWordRequest struct {
 	Examples []string
 }
 
-// ToEntity 将DTO转换为领域实体
-func (dto *WordCreateDTO) ToEntity() *entity.Word {
-	// 转换释义
-	var definitions []entity.Definition
-	for _, def := range dto.Definitions {
-		definitions = append(definitions, entity.Definition{
-			PartOfSpeech: def.PartOfSpeech,
-			Meaning:      def.Meaning,
-			Example:      def.Example,
-			Synonyms:     def.Synonyms,
-			Antonyms:     def.Antonyms,
-		})
-	}
+// SearchWordsRequest 全文检索单词请求
+type SearchWordsRequest struct {
+	Keyword  string
+	Page     int
+	PageSize int
+	Level    valueobject.WordDifficultyLevel // 难度等级，未指定时不过滤
+	Tags     []string                        // 需全部包含的标签
+	Fuzzy    bool                            // 是否容忍拼写错误
+}
 
-	return entity.NewWord(
-		dto.Text,
-		dto.Phonetic,
-		definitions,
-		dto.Examples,
-		dto.Tags,
-		dto.Level,
-	)
+// WordSearchResult 单词全文检索结果
+type WordSearchResult struct {
+	Word        *entity.Word
+	Rank        float64                       // 相关度，越大越相关
+	Highlights  []domainService.WordHighlight // 与关键词匹配的片段
+	MatchedForm *entity.WordForm              // 与关键词或其中的词相同的词形，按原形命中时为 nil
 }
 
-// FromEntity 从领域实体转换为DTO
-func WordResponseDTOFromEntity(word *entity.Word) *WordResponseDTO {
-	return &WordResponseDTO{
-		ID:          uint32(word.ID),
-		Text:        word.Text,
-		Definitions: word.Definitions,
-		Phonetic:    word.Phonetic,
-		Examples:    word.Examples,
-		Tags:        word.Tags,
-		CreatedAt:   word.CreatedAt.Format("2006-01-02 15:04:05"),
-		UpdatedAt:   word.UpdatedAt.Format("2006-01-02 15:04:05"),
-	}
+// WordLookupResult 按单词或词形查找单词的结果
+type WordLookupResult struct {
+	Word        *entity.Word
+	Forms       []*entity.WordForm                // 单词的所有词形
+	MatchedForm *entity.WordForm                  // 查找的文本是该词形时不为 nil，按原形找到时为 nil
+	Inflections []domainService.ExampleInflection // 例句中出现的原形和词形
+}
+
+// WordFormItem 单词的一个词形
+type WordFormItem struct {
+	Form string
+	Kind entity.WordFormKind
+}
+
+// WordFormsRequest 一个原形单词的词形
+type WordFormsRequest struct {
+	Lemma string // 原形，需与单词库中的单词完全相同
+	Forms []WordFormItem
+}
+
+// ImportWordFormsRequest 导入单词词形的请求，结构化的词形和词形表文本可以同时提供
+type ImportWordFormsRequest struct {
+	Words    []*WordFormsRequest
+	FormList string // “原形<TAB>词形”格式的词形表文本
+}
+
+// WordFormsImportResult 导入单词词形的结果
+type WordFormsImportResult struct {
+	Imported int      // 导入词形的单词数量
+	Missing  []string // 单词库中不存在而跳过的原形
 }
@@ -0,0 +1,45 @@
+package dto
+
+import (
+	"github.com/lazyjean/sla2/internal/domain/entity"
+	"github.com/lazyjean/sla2/internal/domain/repository"
+	"github.com/lazyjean/sla2/internal/domain/valueobject"
+)
+
+// WordNotebookSummary 生词本及其词条数量
+type WordNotebookSummary struct {
+	Notebook   *entity.WordNotebook
+	EntryCount int64
+}
+
+// AddNotebookEntryRequest 向生词本添加词条的请求
+// WordID 不为 0 时添加词典单词，否则添加由 Text 和 Meaning 组成的自定义词条
+type AddNotebookEntryRequest struct {
+	NotebookID entity.WordNotebookID
+	WordID     entity.WordID
+	Text       string
+	Meaning    string
+	Note       string
+	Examples   []string
+}
+
+// UpdateNotebookEntryRequest 修改生词本词条的请求，Text 和 Meaning 只对自定义词条生效
+type UpdateNotebookEntryRequest struct {
+	NotebookID entity.WordNotebookID
+	EntryID    entity.WordNotebookEntryID
+	Text       string
+	Meaning    string
+	Note       string
+	Examples   []string
+}
+
+// ListNotebookEntriesRequest 分页查询生词本词条的请求
+type ListNotebookEntriesRequest struct {
+	NotebookID entity.WordNotebookID
+	Page       int
+	PageSize   int
+	Keyword    string                          // 按单词前缀或个人笔记过滤
+	Level      valueobject.WordDifficultyLevel // 难度等级，未指定时不过滤
+	Tags       []string                        // 需全部包含的标签
+	Sort       repository.WordNotebookEntrySort
+}
@@ -0,0 +1,415 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lazyjean/sla2/internal/application/dto"
+	"github.com/lazyjean/sla2/internal/domain/entity"
+	domainErrors "github.com/lazyjean/sla2/internal/domain/errors"
+	"github.com/lazyjean/sla2/internal/domain/repository"
+	domainService "github.com/lazyjean/sla2/internal/domain/service"
+	"github.com/lazyjean/sla2/internal/domain/valueobject"
+	"github.com/lazyjean/sla2/pkg/logger"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+const (
+	DefaultHanCharLearningCount = 10  // 默认每次学习或测试的汉字数量
+	MaxHanCharLearningCount     = 100 // 每次学习、测试或提交的汉字数量上限
+	HanCharDistractorCandidates = 50  // 每个汉字用于选取干扰项的候选汉字数量
+)
+
+// HanCharLearningService 汉字学习服务
+// 负责生字学习、汉字复习和汉字测试的流程，评分统一通过记忆服务的批量复习应用到记忆单元，
+// 幂等键由汉字ID和客户端记录的时间生成，重复提交同一次学习不会重复计入
+type HanCharLearningService struct {
+	hanCharRepo   repository.HanCharRepository
+	learningRepo  repository.HanCharLearningRepository
+	memoryRepo    repository.MemoryUnitRepository
+	strokesRepo   repository.HanCharStrokesRepository
+	contents      repository.ContentRepositories
+	memoryService MemoryService
+}
+
+// NewHanCharLearningService 创建汉字学习服务实例
+func NewHanCharLearningService(
+	hanCharRepo repository.HanCharRepository,
+	learningRepo repository.HanCharLearningRepository,
+	memoryRepo repository.MemoryUnitRepository,
+	strokesRepo repository.HanCharStrokesRepository,
+	contents repository.ContentRepositories,
+	memoryService MemoryService,
+) *HanCharLearningService {
+	return &HanCharLearningService{
+		hanCharRepo:   hanCharRepo,
+		learningRepo:  learningRepo,
+		memoryRepo:    memoryRepo,
+		strokesRepo:   strokesRepo,
+		contents:      contents,
+		memoryService: memoryService,
+	}
+}
+
+// GetNewHanChars 获取当前用户尚未学习的汉字，可按难度等级和分类过滤
+func (s *HanCharLearningService) GetNewHanChars(ctx context.Context, count int, level valueobject.WordDifficultyLevel, category string) ([]*entity.HanChar, error) {
+	log := logger.GetLogger(ctx)
+
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := repository.NewContentFilter{Level: level}
+	if category != "" {
+		filter.Categories = []string{category}
+	}
+	hanChars, err := s.listNotLearned(ctx, userID, filter, hanCharLearningCount(count))
+	if err != nil {
+		log.Error("Failed to list new han chars", zap.Error(err), zap.Uint32("userID", uint32(userID)))
+		return nil, err
+	}
+	return hanChars, nil
+}
+
+// listNotLearned 通过汉字的学习内容仓储获取用户尚未学习的汉字，按ID升序
+func (s *HanCharLearningService) listNotLearned(ctx context.Context, userID entity.UID, filter repository.NewContentFilter, limit int) ([]*entity.HanChar, error) {
+	items, err := s.contents[entity.MemoryUnitTypeHanChar].ListNotLearned(ctx, userID, filter, limit)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]entity.HanCharID, len(items))
+	for i, item := range items {
+		ids[i] = entity.HanCharID(item.ContentID)
+	}
+	return s.hanCharRepo.ListByIDs(ctx, ids)
+}
+
+// SubmitNewHanCharLearning 提交生字学习结果
+// 每个汉字按三次作答的结果换算评分并创建记忆单元，同时累计到汉字学习记录中
+func (s *HanCharLearningService) SubmitNewHanCharLearning(ctx context.Context, req *dto.NewHanCharLearningRequest) error {
+	log := logger.GetLogger(ctx)
+
+	switch {
+	case len(req.Results) == 0:
+		return domainErrors.ErrEmptyHanCharResults
+	case len(req.Results) > MaxHanCharLearningCount:
+		return domainErrors.ErrHanCharBatchTooLarge
+	case req.LearnedAt.IsZero() || req.LearnedAt.After(time.Now().Add(reviewClockSkew)):
+		return domainErrors.ErrInvalidLearningTime
+	}
+
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return err
+	}
+
+	// 学习时长平均分摊到每个汉字，响应时间以毫秒计
+	duration := req.StudyDuration / uint32(len(req.Results))
+	items := make([]*dto.BatchReviewItem, len(req.Results))
+	for i, result := range req.Results {
+		items[i] = &dto.BatchReviewItem{
+			IdempotencyKey: fmt.Sprintf("hanchar-learn:%d:%d", result.HanCharID, req.LearnedAt.UnixMilli()),
+			Type:           entity.MemoryUnitTypeHanChar,
+			ContentID:      uint32(result.HanCharID),
+			Grade:          result.Result.Grade(),
+			ResponseTime:   duration * 1000,
+			ReviewedAt:     req.LearnedAt,
+		}
+	}
+	results, err := s.memoryService.SubmitReviewBatch(ctx, items)
+	if err != nil {
+		return err
+	}
+
+	// 先记录已应用的学习，再报告未应用的汉字，避免重新提交时因幂等键已存在而漏记
+	for i, result := range results {
+		// 重复提交的学习已经记录过
+		if result.Status != dto.BatchReviewStatusApplied {
+			continue
+		}
+		item := req.Results[i]
+		learning, err := s.learningRepo.GetByHanCharID(ctx, userID, item.HanCharID)
+		if err != nil {
+			log.Error("Failed to get han char learning", zap.Error(err), zap.Uint32("hanCharID", uint32(item.HanCharID)))
+			return err
+		}
+		if learning == nil {
+			learning = entity.NewHanCharLearning(userID, item.HanCharID, result.MemoryUnitID, false, false, false, false, 0, 0, 0, nil)
+		}
+		learning.MemoryUnitID = result.MemoryUnitID
+		learning.Record(item.Result, duration)
+		if err := s.learningRepo.Save(ctx, learning); err != nil {
+			log.Error("Failed to save han char learning", zap.Error(err), zap.Uint32("hanCharID", uint32(item.HanCharID)))
+			return err
+		}
+	}
+	return reviewBatchError(results)
+}
+
+// SubmitHanCharReview 提交汉字复习结果，认识记为良好，不认识记为忘记，返回下次复习时间
+func (s *HanCharLearningService) SubmitHanCharReview(ctx context.Context, hanCharID entity.HanCharID, recognized bool, reviewedAt time.Time) (time.Time, error) {
+	log := logger.GetLogger(ctx)
+
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if reviewedAt.IsZero() {
+		reviewedAt = time.Now()
+	}
+
+	item := &dto.BatchReviewItem{
+		IdempotencyKey: fmt.Sprintf("hanchar-review:%d:%d", hanCharID, reviewedAt.UnixMilli()),
+		Type:           entity.MemoryUnitTypeHanChar,
+		ContentID:      uint32(hanCharID),
+		Grade:          recognitionGrade(recognized),
+		ReviewedAt:     reviewedAt,
+	}
+	results, err := s.memoryService.SubmitReviewBatch(ctx, []*dto.BatchReviewItem{item})
+	if err != nil {
+		return time.Time{}, err
+	}
+	if err := reviewBatchError(results); err != nil {
+		return time.Time{}, err
+	}
+
+	unit, err := s.memoryRepo.GetByTypeAndContentID(ctx, userID, entity.MemoryUnitTypeHanChar, uint32(hanCharID))
+	if err != nil {
+		log.Error("Failed to get han char memory unit", zap.Error(err), zap.Uint32("hanCharID", uint32(hanCharID)))
+		return time.Time{}, err
+	}
+	if unit == nil {
+		return time.Time{}, domainErrors.ErrHanCharNotFound
+	}
+	return unit.NextReviewAt, nil
+}
+
+// GetHanCharTest 生成汉字选择题测试，优先考查最该复习的已学汉字，不足时用尚未学习的汉字补齐
+// 干扰项从同音、同标签或分类、同难度等级的汉字中选取，相同的种子总是生成相同的题目
+func (s *HanCharLearningService) GetHanCharTest(ctx context.Context, req *dto.HanCharTestRequest) (*dto.HanCharTest, error) {
+	log := logger.GetLogger(ctx)
+
+	for _, questionType := range req.Types {
+		if !questionType.IsValid() {
+			return nil, domainErrors.ErrInvalidHanCharQuestion
+		}
+	}
+
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := hanCharLearningCount(req.Count)
+	hanChars, err := s.hanCharRepo.ListLearned(ctx, userID, limit, hanCharFilters(req.Level))
+	if err != nil {
+		log.Error("Failed to list learned han chars", zap.Error(err), zap.Uint32("userID", uint32(userID)))
+		return nil, err
+	}
+	if len(hanChars) < limit {
+		fresh, err := s.listNotLearned(ctx, userID, repository.NewContentFilter{Level: req.Level}, limit-len(hanChars))
+		if err != nil {
+			log.Error("Failed to list new han chars", zap.Error(err), zap.Uint32("userID", uint32(userID)))
+			return nil, err
+		}
+		hanChars = append(hanChars, fresh...)
+	}
+
+	candidates := make(map[entity.HanCharID][]*entity.HanChar, len(hanChars))
+	for _, hanChar := range hanChars {
+		if candidates[hanChar.ID], err = s.hanCharRepo.ListDistractorCandidates(ctx, hanChar, HanCharDistractorCandidates); err != nil {
+			log.Error("Failed to list distractor candidates", zap.Error(err), zap.Uint32("hanCharID", uint32(hanChar.ID)))
+			return nil, err
+		}
+	}
+
+	seed := req.Seed
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+	return &dto.HanCharTest{
+		Seed:      seed,
+		HanChars:  hanChars,
+		Questions: domainService.NewHanCharTestGenerator(seed).Generate(hanChars, candidates, req.Types),
+	}, nil
+}
+
+// GradeHanCharTest 按种子得到每道题的正确答案，与所选选项的文本比较评分，每道题按是否答对记一次复习
+// 幂等键由种子、汉字和题型生成，重复提交同一份答卷不会重复计入
+func (s *HanCharLearningService) GradeHanCharTest(ctx context.Context, seed int64, answers []*dto.HanCharTestAnswer) ([]*dto.HanCharTestGrade, error) {
+	log := logger.GetLogger(ctx)
+
+	switch {
+	case len(answers) == 0:
+		return nil, domainErrors.ErrEmptyHanCharResults
+	case len(answers) > MaxHanCharLearningCount:
+		return nil, domainErrors.ErrHanCharBatchTooLarge
+	case seed == 0:
+		return nil, domainErrors.ErrInvalidHanCharQuestion
+	}
+
+	generator := domainService.NewHanCharTestGenerator(seed)
+	now := time.Now()
+	grades := make([]*dto.HanCharTestGrade, len(answers))
+	items := make([]*dto.BatchReviewItem, len(answers))
+	for i, answer := range answers {
+		hanChar, err := s.hanCharRepo.GetByID(ctx, answer.HanCharID)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainErrors.ErrHanCharNotFound
+		} else if err != nil {
+			log.Error("Failed to get han char", zap.Error(err), zap.Uint32("hanCharID", uint32(answer.HanCharID)))
+			return nil, err
+		}
+		// 正确答案与候选干扰项无关，按所选选项的文本评分，候选汉字在出题后变化也不影响评分
+		expected, ok := generator.Answer(hanChar, answer.Type)
+		if !ok {
+			return nil, domainErrors.ErrInvalidHanCharQuestion
+		}
+
+		correct := answer.Selected == expected
+		grades[i] = &dto.HanCharTestGrade{
+			HanCharID: answer.HanCharID,
+			Type:      answer.Type,
+			Correct:   correct,
+			Answer:    expected,
+		}
+		items[i] = &dto.BatchReviewItem{
+			IdempotencyKey: fmt.Sprintf("hanchar-test:%d:%d:%d", seed, answer.HanCharID, answer.Type),
+			Type:           entity.MemoryUnitTypeHanChar,
+			ContentID:      uint32(answer.HanCharID),
+			Grade:          recognitionGrade(correct),
+			ReviewedAt:     now,
+		}
+	}
+
+	reviews, err := s.memoryService.SubmitReviewBatch(ctx, items)
+	if err != nil {
+		return nil, err
+	}
+	if err := reviewBatchError(reviews); err != nil {
+		return nil, err
+	}
+	return grades, nil
+}
+
+// SubmitHanCharTestResults 提交自评的汉字测试结果，每个汉字按是否认识记一次复习
+// 幂等键由汉字和客户端提供的测试时间生成，重试同一次提交不会重复计入
+func (s *HanCharLearningService) SubmitHanCharTestResults(ctx context.Context, results []*dto.HanCharTestResult, testedAt time.Time) error {
+	switch {
+	case len(results) == 0:
+		return domainErrors.ErrEmptyHanCharResults
+	case len(results) > MaxHanCharLearningCount:
+		return domainErrors.ErrHanCharBatchTooLarge
+	case testedAt.IsZero() || testedAt.After(time.Now().Add(reviewClockSkew)):
+		return domainErrors.ErrInvalidLearningTime
+	}
+
+	items := make([]*dto.BatchReviewItem, len(results))
+	for i, result := range results {
+		items[i] = &dto.BatchReviewItem{
+			IdempotencyKey: fmt.Sprintf("hanchar-test:%d:%d", result.HanCharID, testedAt.UnixMilli()),
+			Type:           entity.MemoryUnitTypeHanChar,
+			ContentID:      uint32(result.HanCharID),
+			Grade:          recognitionGrade(result.Recognized),
+			ReviewedAt:     testedAt,
+		}
+	}
+	reviews, err := s.memoryService.SubmitReviewBatch(ctx, items)
+	if err != nil {
+		return err
+	}
+	return reviewBatchError(reviews)
+}
+
+// SubmitHanCharHandwriting 评判一次汉字书写练习，按标准笔顺逐笔比较手写笔画与笔顺数据，
+// 整字是否写对计入汉字学习记录的对应作答次数；书写练习不直接影响复习安排
+func (s *HanCharLearningService) SubmitHanCharHandwriting(ctx context.Context, req *dto.HanCharHandwritingRequest) (*entity.HandwritingResult, error) {
+	log := logger.GetLogger(ctx)
+
+	attempt := req.Attempt
+	if attempt == 0 {
+		attempt = 1
+	}
+	switch {
+	case attempt < 1 || attempt > 3:
+		return nil, domainErrors.ErrInvalidHandwritingTry
+	case len(req.Strokes) == 0:
+		return nil, domainErrors.ErrEmptyHandwriting
+	}
+
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	strokes, err := s.strokesRepo.GetByHanCharID(ctx, req.HanCharID)
+	if err != nil {
+		log.Error("Failed to get han char strokes", zap.Error(err), zap.Uint32("hanCharID", uint32(req.HanCharID)))
+		return nil, err
+	}
+	if strokes == nil {
+		return nil, domainErrors.ErrHanCharStrokesNotFound
+	}
+	result := domainService.GradeHandwriting(strokes, req.Strokes)
+
+	learning, err := s.learningRepo.GetByHanCharID(ctx, userID, req.HanCharID)
+	if err != nil {
+		log.Error("Failed to get han char learning", zap.Error(err), zap.Uint32("hanCharID", uint32(req.HanCharID)))
+		return nil, err
+	}
+	if learning == nil {
+		learning = entity.NewHanCharLearning(userID, req.HanCharID, 0, false, false, false, false, 0, 0, 0, nil)
+	}
+	learning.RecordAttempt(attempt, result.Correct(), req.StudyDuration)
+	if err := s.learningRepo.Save(ctx, learning); err != nil {
+		log.Error("Failed to save han char learning", zap.Error(err), zap.Uint32("hanCharID", uint32(req.HanCharID)))
+		return nil, err
+	}
+	return result, nil
+}
+
+// reviewBatchError 汉字不存在或参数无效的复习未被应用，返回对应的错误；重复提交和时间冲突视为成功
+func reviewBatchError(results []*dto.BatchReviewResult) error {
+	for _, result := range results {
+		switch result.Status {
+		case dto.BatchReviewStatusNotFound:
+			return domainErrors.ErrHanCharNotFound
+		case dto.BatchReviewStatusInvalid:
+			return domainErrors.NewError(domainErrors.CodeInvalidArgument, result.Message)
+		}
+	}
+	return nil
+}
+
+// recognitionGrade 将是否认识换算为复习评分
+func recognitionGrade(recognized bool) entity.ReviewGrade {
+	if recognized {
+		return entity.ReviewGradeGood
+	}
+	return entity.ReviewGradeAgain
+}
+
+// hanCharLearningCount 规范化每次学习或测试的汉字数量
+func hanCharLearningCount(count int) int {
+	switch {
+	case count <= 0:
+		return DefaultHanCharLearningCount
+	case count > MaxHanCharLearningCount:
+		return MaxHanCharLearningCount
+	default:
+		return count
+	}
+}
+
+// hanCharFilters 构建汉字仓储的过滤条件
+func hanCharFilters(level valueobject.WordDifficultyLevel) map[string]interface{} {
+	filters := make(map[string]interface{})
+	if level != valueobject.WORD_DIFFICULTY_LEVEL_UNSPECIFIED {
+		filters["level"] = level
+	}
+	return filters
+}
@@ -0,0 +1,84 @@
+package service
+
+import (
+	"context"
+
+	"github.com/lazyjean/sla2/internal/application/dto"
+	"github.com/lazyjean/sla2/internal/domain/entity"
+	domainErrors "github.com/lazyjean/sla2/internal/domain/errors"
+	"github.com/lazyjean/sla2/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// LookupHanChar 按ID或字符查找汉字及其笔顺数据，ID 不为 0 时按ID查找
+// 汉字没有笔顺数据时笔顺为 nil
+func (s *VocabularyService) LookupHanChar(ctx context.Context, id entity.HanCharID, character string) (*entity.HanChar, *entity.HanCharStrokes, error) {
+	var hanChar *entity.HanChar
+	var err error
+	if id != 0 {
+		hanChar, err = s.getHanChar(ctx, id)
+	} else {
+		hanChar, err = s.hanCharRepository.GetByCharacter(ctx, character)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	if hanChar == nil {
+		return nil, nil, domainErrors.ErrHanCharNotFound
+	}
+
+	strokes, err := s.strokesRepository.GetByHanCharID(ctx, hanChar.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return hanChar, strokes, nil
+}
+
+// ImportHanCharStrokes 批量导入汉字笔顺数据，已有的笔顺数据会被覆盖
+// 汉字库中不存在的汉字跳过并在结果中列出；笔画数未知的汉字同时补全笔画数
+// 所有笔顺数据在一个事务中保存，任一汉字保存失败时整批不导入
+func (s *VocabularyService) ImportHanCharStrokes(ctx context.Context, items []*dto.HanCharStrokesRequest) (*dto.HanCharStrokesImportResult, error) {
+	if err := checkVocabularyWritePermission(ctx); err != nil {
+		return nil, err
+	}
+
+	log := logger.GetLogger(ctx)
+
+	for _, item := range items {
+		if !entity.NewHanCharStrokes(0, item.Strokes, item.Medians).IsValid() {
+			return nil, domainErrors.ErrInvalidHanCharStrokes
+		}
+	}
+
+	result := &dto.HanCharStrokesImportResult{}
+	err := s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		for _, item := range items {
+			hanChar, err := s.hanCharRepository.GetByCharacter(ctx, item.Character)
+			if err != nil {
+				return err
+			}
+			if hanChar == nil {
+				result.Missing = append(result.Missing, item.Character)
+				continue
+			}
+
+			strokes := entity.NewHanCharStrokes(hanChar.ID, item.Strokes, item.Medians)
+			if err := s.strokesRepository.Save(ctx, strokes); err != nil {
+				log.Error("Failed to save han char strokes", zap.Error(err), zap.String("character", item.Character))
+				return err
+			}
+			if hanChar.StrokeCount == 0 {
+				hanChar.StrokeCount = strokes.StrokeCount()
+				if err := s.hanCharRepository.Update(ctx, hanChar); err != nil {
+					return err
+				}
+			}
+			result.Imported++
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
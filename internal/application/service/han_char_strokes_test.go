@@ -0,0 +1,71 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/lazyjean/sla2/internal/application/dto"
+	"github.com/lazyjean/sla2/internal/domain/entity"
+	"github.com/lazyjean/sla2/internal/domain/repository"
+	"github.com/lazyjean/sla2/internal/domain/security"
+	"github.com/lazyjean/sla2/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockHanCharStrokesRepository 是 HanCharStrokesRepository 的模拟实现，只实现测试用到的方法
+type MockHanCharStrokesRepository struct {
+	mock.Mock
+	repository.HanCharStrokesRepository
+}
+
+func (m *MockHanCharStrokesRepository) Save(ctx context.Context, strokes *entity.HanCharStrokes) error {
+	args := m.Called(ctx, strokes)
+	return args.Error(0)
+}
+
+func TestVocabularyService_ImportHanCharStrokes(t *testing.T) {
+	ctx := WithRoles(WithUserID(context.Background(), 1), []string{security.RoleContentManager})
+	medians := []entity.StrokePolyline{{{0, 0}, {1, 1}}, {{1, 0}, {0, 1}}}
+	items := []*dto.HanCharStrokesRequest{
+		{Character: "十", Strokes: []string{"M 0 0", "M 1 0"}, Medians: medians},
+		{Character: "〇", Strokes: []string{"M 0 0", "M 1 0"}, Medians: medians},
+	}
+
+	t.Run("在事务中保存笔顺并补全笔画数", func(t *testing.T) {
+		hanCharRepo := new(MockHanCharRepository)
+		strokesRepo := new(MockHanCharStrokesRepository)
+		service := NewVocabularyService(hanCharRepo, nil, strokesRepo, nil, nil, stubTransactionManager{})
+		shi := &entity.HanChar{ID: 1, Character: "十"}
+		hanCharRepo.On("GetByCharacter", inTransaction, "十").Return(shi, nil).Once()
+		hanCharRepo.On("GetByCharacter", inTransaction, "〇").Return(nil, nil).Once()
+		strokesRepo.On("Save", inTransaction, mock.AnythingOfType("*entity.HanCharStrokes")).Return(nil).Once()
+		hanCharRepo.On("Update", inTransaction, shi).Return(nil).Once()
+
+		result, err := service.ImportHanCharStrokes(ctx, items)
+		require.NoError(t, err)
+		assert.Equal(t, 1, result.Imported)
+		assert.Equal(t, []string{"〇"}, result.Missing)
+		assert.Equal(t, uint32(2), shi.StrokeCount)
+		hanCharRepo.AssertExpectations(t)
+		strokesRepo.AssertExpectations(t)
+	})
+
+	t.Run("保存失败时返回错误", func(t *testing.T) {
+		if logger.Log == nil {
+			logger.InitBaseLogger()
+		}
+		hanCharRepo := new(MockHanCharRepository)
+		strokesRepo := new(MockHanCharStrokesRepository)
+		service := NewVocabularyService(hanCharRepo, nil, strokesRepo, nil, nil, stubTransactionManager{})
+		saveErr := errors.New("save failed")
+		hanCharRepo.On("GetByCharacter", inTransaction, "十").Return(&entity.HanChar{ID: 1, Character: "十", StrokeCount: 2}, nil).Once()
+		strokesRepo.On("Save", inTransaction, mock.Anything).Return(saveErr).Once()
+
+		_, err := service.ImportHanCharStrokes(ctx, items)
+		assert.ErrorIs(t, err, saveErr)
+		hanCharRepo.AssertNotCalled(t, "GetByCharacter", mock.Anything, "〇")
+	})
+}
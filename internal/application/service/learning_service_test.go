@@ -5,8 +5,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/lazyjean/sla2/internal/application/dto"
 	"github.com/lazyjean/sla2/internal/domain/entity"
-	"github.com/lazyjean/sla2/internal/domain/repository"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -64,13 +64,18 @@ func (m *MockMemoryService) GetWordStats(ctx context.Context, wordID entity.Word
 	return args.Get(0).(*WordStats), args.Error(1)
 }
 
-func (m *MockMemoryService) ReviewWord(ctx context.Context, wordID entity.WordID, result bool, responseTime uint32) error {
-	args := m.Called(ctx, wordID, result, responseTime)
+func (m *MockMemoryService) ReviewWord(ctx context.Context, wordID entity.WordID, grade entity.ReviewGrade, responseTime uint32, sessionID entity.StudySessionID) error {
+	args := m.Called(ctx, wordID, grade, responseTime, sessionID)
 	return args.Error(0)
 }
 
-func (m *MockMemoryService) ReviewHanChar(ctx context.Context, hanCharID uint32, result bool, responseTime uint32) error {
-	args := m.Called(ctx, hanCharID, result, responseTime)
+func (m *MockMemoryService) ReviewHanChar(ctx context.Context, hanCharID uint32, grade entity.ReviewGrade, responseTime uint32, sessionID entity.StudySessionID) error {
+	args := m.Called(ctx, hanCharID, grade, responseTime, sessionID)
+	return args.Error(0)
+}
+
+func (m *MockMemoryService) Review(ctx context.Context, unitType entity.MemoryUnitType, contentID uint32, grade entity.ReviewGrade, responseTime uint32, sessionID entity.StudySessionID) error {
+	args := m.Called(ctx, unitType, contentID, grade, responseTime, sessionID)
 	return args.Error(0)
 }
 
@@ -86,12 +91,84 @@ func (m *MockMemoryService) ListMemoriesForReview(ctx context.Context, page, pag
 	return units, total, args.Error(2)
 }
 
-func (m *MockMemoryService) GetMemoryStats(ctx context.Context, unitType *entity.MemoryUnitType) (*repository.MemoryUnitStats, error) {
-	args := m.Called(ctx, unitType)
+func (m *MockMemoryService) GetMemoryStats(ctx context.Context, req *dto.MemoryStatsRequest) (*dto.MemoryStats, error) {
+	args := m.Called(ctx, req)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
-	return args.Get(0).(*repository.MemoryUnitStats), args.Error(1)
+	return args.Get(0).(*dto.MemoryStats), args.Error(1)
+}
+
+func (m *MockMemoryService) ListMemoryReviews(ctx context.Context, memoryUnitID uint32, page, pageSize uint32) ([]*entity.MemoryReview, int, error) {
+	args := m.Called(ctx, memoryUnitID, page, pageSize)
+	reviews, _ := args.Get(0).([]*entity.MemoryReview)
+	total, _ := args.Get(1).(int)
+	return reviews, total, args.Error(2)
+}
+
+func (m *MockMemoryService) SubmitReviewBatch(ctx context.Context, items []*dto.BatchReviewItem) ([]*dto.BatchReviewResult, error) {
+	args := m.Called(ctx, items)
+	results, _ := args.Get(0).([]*dto.BatchReviewResult)
+	return results, args.Error(1)
+}
+
+func (m *MockMemoryService) ForecastReviews(ctx context.Context, req *dto.ReviewForecastRequest) (*dto.ReviewForecast, error) {
+	args := m.Called(ctx, req)
+	forecast, _ := args.Get(0).(*dto.ReviewForecast)
+	return forecast, args.Error(1)
+}
+
+func (m *MockMemoryService) ListLeeches(ctx context.Context, page, pageSize uint32, types []entity.MemoryUnitType) ([]*entity.MemoryUnit, int, error) {
+	args := m.Called(ctx, page, pageSize, types)
+	units, _ := args.Get(0).([]*entity.MemoryUnit)
+	total, _ := args.Get(1).(int)
+	return units, total, args.Error(2)
+}
+
+func (m *MockMemoryService) UnsuspendMemoryUnit(ctx context.Context, memoryUnitID uint32) (*entity.MemoryUnit, error) {
+	args := m.Called(ctx, memoryUnitID)
+	unit, _ := args.Get(0).(*entity.MemoryUnit)
+	return unit, args.Error(1)
+}
+
+func (m *MockMemoryService) ResetLeech(ctx context.Context, memoryUnitID uint32) (*entity.MemoryUnit, error) {
+	args := m.Called(ctx, memoryUnitID)
+	unit, _ := args.Get(0).(*entity.MemoryUnit)
+	return unit, args.Error(1)
+}
+
+func (m *MockMemoryService) ListHardContents(ctx context.Context, types []entity.MemoryUnitType, limit int) ([]*HardContent, error) {
+	args := m.Called(ctx, types, limit)
+	contents, _ := args.Get(0).([]*HardContent)
+	return contents, args.Error(1)
+}
+
+func (m *MockMemoryService) ResetMemoryUnit(ctx context.Context, memoryUnitID uint32) (*entity.MemoryUnit, error) {
+	args := m.Called(ctx, memoryUnitID)
+	unit, _ := args.Get(0).(*entity.MemoryUnit)
+	return unit, args.Error(1)
+}
+
+func (m *MockMemoryService) BuryMemoryUnit(ctx context.Context, memoryUnitID uint32) (*entity.MemoryUnit, error) {
+	args := m.Called(ctx, memoryUnitID)
+	unit, _ := args.Get(0).(*entity.MemoryUnit)
+	return unit, args.Error(1)
+}
+
+func (m *MockMemoryService) RescheduleMemoryUnit(ctx context.Context, memoryUnitID uint32, date string) (*entity.MemoryUnit, error) {
+	args := m.Called(ctx, memoryUnitID, date)
+	unit, _ := args.Get(0).(*entity.MemoryUnit)
+	return unit, args.Error(1)
+}
+
+func (m *MockMemoryService) RescheduleDueReviews(ctx context.Context, req *dto.RescheduleDueRequest) (int, error) {
+	args := m.Called(ctx, req)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockMemoryService) ForgetCourse(ctx context.Context, courseID uint) (int, error) {
+	args := m.Called(ctx, courseID)
+	return args.Int(0), args.Error(1)
 }
 
 func (m *MockLearningRepository) SaveCourseProgress(ctx context.Context, progress *entity.CourseLearningProgress) error {
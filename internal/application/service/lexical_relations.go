@@ -0,0 +1,286 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/lazyjean/sla2/internal/application/dto"
+	"github.com/lazyjean/sla2/internal/domain/entity"
+	"github.com/lazyjean/sla2/internal/domain/errors"
+	"github.com/lazyjean/sla2/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// GetWordRelations 获取单词的关联单词，如同义词、反义词、同族词，可用于展示词族和生成测试干扰项
+// types 为空时返回全部类型的关联
+func (s *VocabularyService) GetWordRelations(ctx context.Context, wordID entity.WordID, types []entity.LexicalRelationType) (*entity.Word, []*dto.WordNeighbor, error) {
+	if err := validateRelationTypes(types); err != nil {
+		return nil, nil, err
+	}
+	word, err := s.wordRepository.GetByID(ctx, wordID)
+	if err != nil {
+		return nil, nil, err
+	}
+	relations, err := s.relationRepository.ListWordRelations(ctx, wordID, types)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	neighbors := make([]*dto.WordNeighbor, 0, len(relations))
+	for _, relation := range relations {
+		// 另一端已删除的关联不返回
+		if neighbor := toWordNeighbor(relation, wordID); neighbor.Word != nil {
+			neighbors = append(neighbors, neighbor)
+		}
+	}
+	return word, neighbors, nil
+}
+
+// CreateWordRelation 创建两个单词之间的关联，返回从源单词看到的关联
+func (s *VocabularyService) CreateWordRelation(ctx context.Context, sourceID, targetID entity.WordID, relationType entity.LexicalRelationType, note string) (*dto.WordNeighbor, error) {
+	if err := checkVocabularyWritePermission(ctx); err != nil {
+		return nil, err
+	}
+
+	relation, err := entity.NewWordRelation(sourceID, targetID, relationType, note)
+	if err != nil {
+		return nil, err
+	}
+	if relation.Source, err = s.wordRepository.GetByID(ctx, relation.SourceID); err != nil {
+		return nil, err
+	}
+	if relation.Target, err = s.wordRepository.GetByID(ctx, relation.TargetID); err != nil {
+		return nil, err
+	}
+	if err := s.relationRepository.CreateWordRelation(ctx, relation); err != nil {
+		return nil, err
+	}
+	return toWordNeighbor(relation, sourceID), nil
+}
+
+// DeleteWordRelation 删除单词关联
+func (s *VocabularyService) DeleteWordRelation(ctx context.Context, id entity.WordRelationID) error {
+	if err := checkVocabularyWritePermission(ctx); err != nil {
+		return err
+	}
+	return s.relationRepository.DeleteWordRelation(ctx, id)
+}
+
+// GetHanCharRelations 获取汉字的关联汉字，types 为空时返回全部类型的关联
+func (s *VocabularyService) GetHanCharRelations(ctx context.Context, hanCharID entity.HanCharID, types []entity.LexicalRelationType) (*entity.HanChar, []*dto.HanCharNeighbor, error) {
+	if err := validateRelationTypes(types); err != nil {
+		return nil, nil, err
+	}
+	hanChar, err := s.getHanChar(ctx, hanCharID)
+	if err != nil {
+		return nil, nil, err
+	}
+	relations, err := s.relationRepository.ListHanCharRelations(ctx, hanCharID, types)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	neighbors := make([]*dto.HanCharNeighbor, 0, len(relations))
+	for _, relation := range relations {
+		// 另一端已删除的关联不返回，软删除的汉字不会被加载
+		if neighbor := toHanCharNeighbor(relation, hanCharID); neighbor.HanChar != nil {
+			neighbors = append(neighbors, neighbor)
+		}
+	}
+	return hanChar, neighbors, nil
+}
+
+// CreateHanCharRelation 创建两个汉字之间的关联，返回从源汉字看到的关联
+func (s *VocabularyService) CreateHanCharRelation(ctx context.Context, sourceID, targetID entity.HanCharID, relationType entity.LexicalRelationType, note string) (*dto.HanCharNeighbor, error) {
+	if err := checkVocabularyWritePermission(ctx); err != nil {
+		return nil, err
+	}
+
+	relation, err := entity.NewHanCharRelation(sourceID, targetID, relationType, note)
+	if err != nil {
+		return nil, err
+	}
+	if relation.Source, err = s.getHanChar(ctx, relation.SourceID); err != nil {
+		return nil, err
+	}
+	if relation.Target, err = s.getHanChar(ctx, relation.TargetID); err != nil {
+		return nil, err
+	}
+	if err := s.relationRepository.CreateHanCharRelation(ctx, relation); err != nil {
+		return nil, err
+	}
+	return toHanCharNeighbor(relation, sourceID), nil
+}
+
+// DeleteHanCharRelation 删除汉字关联
+func (s *VocabularyService) DeleteHanCharRelation(ctx context.Context, id entity.HanCharRelationID) error {
+	if err := checkVocabularyWritePermission(ctx); err != nil {
+		return err
+	}
+	return s.relationRepository.DeleteHanCharRelation(ctx, id)
+}
+
+// ImportRelations 批量导入单词关联和汉字关联，已存在的关联跳过
+// 单词库或汉字库中不存在的单词和汉字在结果中列出，涉及它们的关联不导入
+// 单词关联和汉字关联在同一个事务中写入，其中一类写入失败时另一类也不会保留
+func (s *VocabularyService) ImportRelations(ctx context.Context, req *dto.ImportRelationsRequest) (*dto.RelationsImportResult, error) {
+	if err := checkVocabularyWritePermission(ctx); err != nil {
+		return nil, err
+	}
+
+	log := logger.GetLogger(ctx)
+
+	for _, items := range [][]*dto.RelationImportItem{req.Words, req.HanChars} {
+		for _, item := range items {
+			if err := validateRelationImportItem(item); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	result := &dto.RelationsImportResult{}
+	missing := make(map[string]struct{})
+	addMissing := func(text string) {
+		if _, ok := missing[text]; !ok {
+			missing[text] = struct{}{}
+			result.Missing = append(result.Missing, text)
+		}
+	}
+
+	wordIDs := make(map[string]entity.WordID)
+	wordID := func(text string) (entity.WordID, error) {
+		if id, ok := wordIDs[text]; ok {
+			return id, nil
+		}
+		word, err := s.wordRepository.GetByWord(ctx, text)
+		if errors.Is(err, errors.ErrWordNotFound) {
+			addMissing(text)
+			wordIDs[text] = 0
+			return 0, nil
+		} else if err != nil {
+			return 0, err
+		}
+		wordIDs[text] = word.ID
+		return word.ID, nil
+	}
+	var wordRelations []*entity.WordRelation
+	for _, item := range req.Words {
+		sourceID, err := wordID(strings.TrimSpace(item.Source))
+		if err != nil {
+			return nil, err
+		}
+		targetID, err := wordID(strings.TrimSpace(item.Target))
+		if err != nil {
+			return nil, err
+		}
+		if sourceID == 0 || targetID == 0 {
+			continue
+		}
+		relation, err := entity.NewWordRelation(sourceID, targetID, item.Type, item.Note)
+		if err != nil {
+			return nil, err
+		}
+		wordRelations = append(wordRelations, relation)
+	}
+
+	hanCharIDs := make(map[string]entity.HanCharID)
+	hanCharID := func(character string) (entity.HanCharID, error) {
+		if id, ok := hanCharIDs[character]; ok {
+			return id, nil
+		}
+		hanChar, err := s.hanCharRepository.GetByCharacter(ctx, character)
+		if err != nil {
+			return 0, err
+		}
+		if hanChar == nil {
+			addMissing(character)
+			hanCharIDs[character] = 0
+			return 0, nil
+		}
+		hanCharIDs[character] = hanChar.ID
+		return hanChar.ID, nil
+	}
+	var hanCharRelations []*entity.HanCharRelation
+	for _, item := range req.HanChars {
+		sourceID, err := hanCharID(strings.TrimSpace(item.Source))
+		if err != nil {
+			return nil, err
+		}
+		targetID, err := hanCharID(strings.TrimSpace(item.Target))
+		if err != nil {
+			return nil, err
+		}
+		if sourceID == 0 || targetID == 0 {
+			continue
+		}
+		relation, err := entity.NewHanCharRelation(sourceID, targetID, item.Type, item.Note)
+		if err != nil {
+			return nil, err
+		}
+		hanCharRelations = append(hanCharRelations, relation)
+	}
+
+	err := s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		wordCreated, err := s.relationRepository.ImportWordRelations(ctx, wordRelations)
+		if err != nil {
+			log.Error("Failed to import word relations", zap.Error(err))
+			return err
+		}
+		hanCharCreated, err := s.relationRepository.ImportHanCharRelations(ctx, hanCharRelations)
+		if err != nil {
+			log.Error("Failed to import han char relations", zap.Error(err))
+			return err
+		}
+		result.Created = wordCreated + hanCharCreated
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	result.Skipped = len(wordRelations) + len(hanCharRelations) - result.Created
+	return result, nil
+}
+
+// validateRelationTypes 校验查询的关联类型
+func validateRelationTypes(types []entity.LexicalRelationType) error {
+	for _, relationType := range types {
+		if !relationType.IsValid() {
+			return errors.ErrInvalidRelationType
+		}
+	}
+	return nil
+}
+
+// validateRelationImportItem 校验导入的一条关联
+func validateRelationImportItem(item *dto.RelationImportItem) error {
+	source, target := strings.TrimSpace(item.Source), strings.TrimSpace(item.Target)
+	if source == "" || target == "" || source == target {
+		return errors.ErrInvalidRelationTarget
+	}
+	_, err := entity.ValidateRelation(item.Type, item.Note)
+	return err
+}
+
+// toWordNeighbor 将单词关联转换为从 wordID 看到的关联单词
+func toWordNeighbor(relation *entity.WordRelation, wordID entity.WordID) *dto.WordNeighbor {
+	word, inverse := relation.Other(wordID)
+	return &dto.WordNeighbor{
+		RelationID: relation.ID,
+		Type:       relation.Type,
+		Inverse:    inverse,
+		Note:       relation.Note,
+		Word:       word,
+	}
+}
+
+// toHanCharNeighbor 将汉字关联转换为从 hanCharID 看到的关联汉字
+func toHanCharNeighbor(relation *entity.HanCharRelation, hanCharID entity.HanCharID) *dto.HanCharNeighbor {
+	hanChar, inverse := relation.Other(hanCharID)
+	return &dto.HanCharNeighbor{
+		RelationID: relation.ID,
+		Type:       relation.Type,
+		Inverse:    inverse,
+		Note:       relation.Note,
+		HanChar:    hanChar,
+	}
+}
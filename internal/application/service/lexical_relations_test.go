@@ -0,0 +1,164 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lazyjean/sla2/internal/application/dto"
+	"github.com/lazyjean/sla2/internal/domain/entity"
+	domainErrors "github.com/lazyjean/sla2/internal/domain/errors"
+	"github.com/lazyjean/sla2/internal/domain/repository"
+	"github.com/lazyjean/sla2/internal/domain/security"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockLexicalRelationRepository 是 LexicalRelationRepository 的模拟实现，只实现测试用到的方法
+type MockLexicalRelationRepository struct {
+	mock.Mock
+	repository.LexicalRelationRepository
+}
+
+func (m *MockLexicalRelationRepository) ImportWordRelations(ctx context.Context, relations []*entity.WordRelation) (int, error) {
+	args := m.Called(ctx, relations)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockLexicalRelationRepository) ImportHanCharRelations(ctx context.Context, relations []*entity.HanCharRelation) (int, error) {
+	args := m.Called(ctx, relations)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockLexicalRelationRepository) ListWordRelations(ctx context.Context, wordID entity.WordID, types []entity.LexicalRelationType) ([]*entity.WordRelation, error) {
+	args := m.Called(ctx, wordID, types)
+	return args.Get(0).([]*entity.WordRelation), args.Error(1)
+}
+
+func (m *MockLexicalRelationRepository) ListHanCharRelations(ctx context.Context, hanCharID entity.HanCharID, types []entity.LexicalRelationType) ([]*entity.HanCharRelation, error) {
+	args := m.Called(ctx, hanCharID, types)
+	return args.Get(0).([]*entity.HanCharRelation), args.Error(1)
+}
+
+// MockHanCharRepository 是 HanCharRepository 的模拟实现，只实现测试用到的方法
+type MockHanCharRepository struct {
+	mock.Mock
+	repository.HanCharRepository
+}
+
+func (m *MockHanCharRepository) GetByID(ctx context.Context, id entity.HanCharID) (*entity.HanChar, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.HanChar), args.Error(1)
+}
+
+func (m *MockHanCharRepository) GetByCharacter(ctx context.Context, character string) (*entity.HanChar, error) {
+	args := m.Called(ctx, character)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.HanChar), args.Error(1)
+}
+
+func (m *MockHanCharRepository) Update(ctx context.Context, hanChar *entity.HanChar) error {
+	args := m.Called(ctx, hanChar)
+	return args.Error(0)
+}
+
+func TestVocabularyService_GetWordRelations(t *testing.T) {
+	ctx := context.Background()
+	wordRepo := new(MockWordRepository)
+	relationRepo := new(MockLexicalRelationRepository)
+	service := NewVocabularyService(nil, wordRepo, nil, nil, relationRepo, nil)
+
+	happy := &entity.Word{ID: 1, Text: "happy"}
+	glad := &entity.Word{ID: 2, Text: "glad"}
+	happiness := &entity.Word{ID: 3, Text: "happiness"}
+	wordRepo.On("GetByID", ctx, entity.WordID(1)).Return(happy, nil).Once()
+	relationRepo.On("ListWordRelations", ctx, entity.WordID(1), []entity.LexicalRelationType(nil)).Return([]*entity.WordRelation{
+		{ID: 1, SourceID: 1, TargetID: 2, Type: entity.LexicalRelationTypeSynonym, Source: happy, Target: glad},
+		{ID: 2, SourceID: 3, TargetID: 1, Type: entity.LexicalRelationTypeDerivedFrom, Source: happiness, Target: happy},
+	}, nil).Once()
+
+	word, neighbors, err := service.GetWordRelations(ctx, 1, nil)
+	require.NoError(t, err)
+	assert.Equal(t, happy, word)
+	require.Len(t, neighbors, 2)
+	assert.Equal(t, glad, neighbors[0].Word)
+	assert.False(t, neighbors[0].Inverse)
+	// happiness 派生自 happy，从 happy 看是反向关联
+	assert.Equal(t, happiness, neighbors[1].Word)
+	assert.True(t, neighbors[1].Inverse)
+
+	_, _, err = service.GetWordRelations(ctx, 1, []entity.LexicalRelationType{0})
+	assert.ErrorIs(t, err, domainErrors.ErrInvalidRelationType)
+	wordRepo.AssertExpectations(t)
+	relationRepo.AssertExpectations(t)
+}
+
+func TestVocabularyService_GetHanCharRelations(t *testing.T) {
+	ctx := context.Background()
+	hanCharRepo := new(MockHanCharRepository)
+	relationRepo := new(MockLexicalRelationRepository)
+	service := NewVocabularyService(hanCharRepo, nil, nil, nil, relationRepo, nil)
+
+	ri := &entity.HanChar{ID: 1, Character: "日"}
+	yue := &entity.HanChar{ID: 2, Character: "曰"}
+	hanCharRepo.On("GetByID", ctx, entity.HanCharID(1)).Return(ri, nil).Once()
+	// 与已删除汉字的关联不会加载另一端
+	relationRepo.On("ListHanCharRelations", ctx, entity.HanCharID(1), []entity.LexicalRelationType(nil)).Return([]*entity.HanCharRelation{
+		{ID: 1, SourceID: 1, TargetID: 2, Type: entity.LexicalRelationTypeConfusable, Source: ri, Target: yue},
+		{ID: 2, SourceID: 1, TargetID: 3, Type: entity.LexicalRelationTypeConfusable, Source: ri},
+	}, nil).Once()
+
+	hanChar, neighbors, err := service.GetHanCharRelations(ctx, 1, nil)
+	require.NoError(t, err)
+	assert.Equal(t, ri, hanChar)
+	require.Len(t, neighbors, 1)
+	assert.Equal(t, yue, neighbors[0].HanChar)
+	hanCharRepo.AssertExpectations(t)
+	relationRepo.AssertExpectations(t)
+}
+
+func TestVocabularyService_ImportRelations(t *testing.T) {
+	ctx := WithRoles(WithUserID(context.Background(), 1), []string{security.RoleContentManager})
+
+	t.Run("跳过不存在的单词并统计已存在的关联", func(t *testing.T) {
+		wordRepo := new(MockWordRepository)
+		relationRepo := new(MockLexicalRelationRepository)
+		service := NewVocabularyService(nil, wordRepo, nil, nil, relationRepo, stubTransactionManager{})
+		wordRepo.On("GetByWord", ctx, "happy").Return(&entity.Word{ID: 2, Text: "happy"}, nil).Once()
+		wordRepo.On("GetByWord", ctx, "glad").Return(&entity.Word{ID: 1, Text: "glad"}, nil).Once()
+		wordRepo.On("GetByWord", ctx, "joyous").Return(nil, domainErrors.ErrWordNotFound).Once()
+		// 两类关联在同一个导入事务中写入
+		relationRepo.On("ImportWordRelations", inTransaction, []*entity.WordRelation{
+			{SourceID: 1, TargetID: 2, Type: entity.LexicalRelationTypeSynonym},
+			{SourceID: 2, TargetID: 1, Type: entity.LexicalRelationTypeDerivedFrom, Note: "示例"},
+		}).Return(1, nil).Once()
+		relationRepo.On("ImportHanCharRelations", inTransaction, []*entity.HanCharRelation(nil)).Return(0, nil).Once()
+
+		result, err := service.ImportRelations(ctx, &dto.ImportRelationsRequest{
+			Words: []*dto.RelationImportItem{
+				{Source: "happy", Target: "glad", Type: entity.LexicalRelationTypeSynonym},
+				{Source: "happy", Target: "joyous", Type: entity.LexicalRelationTypeSynonym},
+				{Source: " happy", Target: "glad ", Type: entity.LexicalRelationTypeDerivedFrom, Note: " 示例 "},
+			},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 1, result.Created)
+		assert.Equal(t, 1, result.Skipped)
+		assert.Equal(t, []string{"joyous"}, result.Missing)
+		wordRepo.AssertExpectations(t)
+		relationRepo.AssertExpectations(t)
+	})
+
+	t.Run("关联自身", func(t *testing.T) {
+		service := NewVocabularyService(nil, nil, nil, nil, nil, nil)
+		_, err := service.ImportRelations(ctx, &dto.ImportRelationsRequest{
+			HanChars: []*dto.RelationImportItem{{Source: "日", Target: "日", Type: entity.LexicalRelationTypeConfusable}},
+		})
+		assert.ErrorIs(t, err, domainErrors.ErrInvalidRelationTarget)
+	})
+}
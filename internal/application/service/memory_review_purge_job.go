@@ -0,0 +1,84 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/lazyjean/sla2/internal/domain/entity"
+	"github.com/lazyjean/sla2/internal/domain/repository"
+	"github.com/lazyjean/sla2/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// memoryReviewPurgeInterval 复习记录清理任务的执行间隔
+const memoryReviewPurgeInterval = 24 * time.Hour
+
+// MemoryReviewPurgeJob 复习记录清理任务
+// 定期删除超过保留时长（一年）的复习记录
+type MemoryReviewPurgeJob struct {
+	reviewRepo repository.MemoryReviewRepository
+	retention  time.Duration
+	interval   time.Duration
+
+	stopCh chan struct{}
+	wg     sync.WaitGroup
+}
+
+// NewMemoryReviewPurgeJob 创建复习记录清理任务
+func NewMemoryReviewPurgeJob(reviewRepo repository.MemoryReviewRepository) *MemoryReviewPurgeJob {
+	return &MemoryReviewPurgeJob{
+		reviewRepo: reviewRepo,
+		retention:  entity.MemoryReviewRetention,
+		interval:   memoryReviewPurgeInterval,
+	}
+}
+
+// Start 启动清理任务，启动时立即执行一次，之后按固定间隔执行
+func (j *MemoryReviewPurgeJob) Start(ctx context.Context) {
+	j.stopCh = make(chan struct{})
+	j.wg.Add(1)
+	go func() {
+		defer j.wg.Done()
+
+		ticker := time.NewTicker(j.interval)
+		defer ticker.Stop()
+
+		for {
+			j.run(ctx)
+			select {
+			case <-ctx.Done():
+				return
+			case <-j.stopCh:
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Stop 停止清理任务并等待正在执行的清理结束
+func (j *MemoryReviewPurgeJob) Stop() {
+	if j.stopCh == nil {
+		return
+	}
+	close(j.stopCh)
+	j.wg.Wait()
+	j.stopCh = nil
+}
+
+// Purge 删除截至 now 已超过保留时长的复习记录，返回删除的条数
+func (j *MemoryReviewPurgeJob) Purge(ctx context.Context, now time.Time) (int64, error) {
+	return j.reviewRepo.DeleteBefore(ctx, now.Add(-j.retention))
+}
+
+// run 执行一次清理
+func (j *MemoryReviewPurgeJob) run(ctx context.Context) {
+	log := logger.GetLogger(ctx)
+	deleted, err := j.Purge(ctx, time.Now())
+	if err != nil {
+		log.Error("Failed to purge expired memory reviews", zap.Error(err))
+		return
+	}
+	log.Info("Purged expired memory reviews", zap.Int64("deleted", deleted), zap.Duration("retention", j.retention))
+}
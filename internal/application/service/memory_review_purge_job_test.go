@@ -0,0 +1,122 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lazyjean/sla2/internal/domain/entity"
+	"github.com/lazyjean/sla2/internal/domain/repository"
+	"github.com/lazyjean/sla2/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockMemoryReviewRepository 是 MemoryReviewRepository 的模拟实现
+type MockMemoryReviewRepository struct {
+	mock.Mock
+}
+
+func (m *MockMemoryReviewRepository) Create(ctx context.Context, review *entity.MemoryReview) error {
+	args := m.Called(ctx, review)
+	return args.Error(0)
+}
+
+func (m *MockMemoryReviewRepository) ListByUserIDAndTimeRange(ctx context.Context, userID uint32, startTime, endTime time.Time) ([]*entity.MemoryReview, error) {
+	args := m.Called(ctx, userID, startTime, endTime)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.MemoryReview), args.Error(1)
+}
+
+func (m *MockMemoryReviewRepository) ListByMemoryUnitID(ctx context.Context, memoryUnitID uint32, offset, limit int) ([]*entity.MemoryReview, error) {
+	args := m.Called(ctx, memoryUnitID, offset, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.MemoryReview), args.Error(1)
+}
+
+func (m *MockMemoryReviewRepository) CountByMemoryUnitID(ctx context.Context, memoryUnitID uint32) (int64, error) {
+	args := m.Called(ctx, memoryUnitID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockMemoryReviewRepository) DeleteBefore(ctx context.Context, before time.Time) (int64, error) {
+	args := m.Called(ctx, before)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockMemoryReviewRepository) ListIdempotencyKeys(ctx context.Context, userID uint32, keys []string) ([]string, error) {
+	args := m.Called(ctx, userID, keys)
+	existing, _ := args.Get(0).([]string)
+	return existing, args.Error(1)
+}
+
+func (m *MockMemoryReviewRepository) GetRetentionStats(ctx context.Context, userID uint32, types []entity.MemoryUnitType, since time.Time) ([]*repository.RetentionStats, error) {
+	args := m.Called(ctx, userID, types, since)
+	stats, _ := args.Get(0).([]*repository.RetentionStats)
+	return stats, args.Error(1)
+}
+
+func (m *MockMemoryReviewRepository) ListReviewedUserIDs(ctx context.Context, since time.Time) ([]uint32, error) {
+	args := m.Called(ctx, since)
+	userIDs, _ := args.Get(0).([]uint32)
+	return userIDs, args.Error(1)
+}
+
+// TestMemoryReviewPurgeJob_Purge 测试清理过期复习记录
+func TestMemoryReviewPurgeJob_Purge(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("删除超过一年的记录", func(t *testing.T) {
+		mockRepo := new(MockMemoryReviewRepository)
+		job := NewMemoryReviewPurgeJob(mockRepo)
+		mockRepo.On("DeleteBefore", ctx, now.Add(-entity.MemoryReviewRetention)).Return(int64(3), nil).Once()
+
+		deleted, err := job.Purge(ctx, now)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(3), deleted)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("仓储错误", func(t *testing.T) {
+		mockRepo := new(MockMemoryReviewRepository)
+		job := NewMemoryReviewPurgeJob(mockRepo)
+		mockRepo.On("DeleteBefore", ctx, mock.Anything).Return(int64(0), errors.New("db error")).Once()
+
+		deleted, err := job.Purge(ctx, now)
+
+		assert.Error(t, err)
+		assert.Zero(t, deleted)
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+// TestMemoryReviewPurgeJob_StartStop 测试清理任务启动即执行并可停止
+func TestMemoryReviewPurgeJob_StartStop(t *testing.T) {
+	if logger.Log == nil {
+		logger.InitBaseLogger()
+	}
+
+	mockRepo := new(MockMemoryReviewRepository)
+	job := NewMemoryReviewPurgeJob(mockRepo)
+	done := make(chan struct{})
+	mockRepo.On("DeleteBefore", mock.Anything, mock.Anything).Return(int64(0), nil).Once().Run(func(mock.Arguments) {
+		close(done)
+	})
+
+	job.Start(context.Background())
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("purge job did not run on start")
+	}
+	job.Stop()
+
+	mockRepo.AssertExpectations(t)
+}
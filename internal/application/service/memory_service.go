@@ -3,11 +3,14 @@ package service
 import (
 	"context"
 	"errors"
+	"sort"
 	"time"
 
+	"github.com/lazyjean/sla2/internal/application/dto"
 	"github.com/lazyjean/sla2/internal/domain/entity"
 	domainErrors "github.com/lazyjean/sla2/internal/domain/errors" // Alias for domain errors
 	"github.com/lazyjean/sla2/internal/domain/repository"
+	domainService "github.com/lazyjean/sla2/internal/domain/service"
 	"github.com/lazyjean/sla2/pkg/logger"
 	"go.uber.org/zap"
 	"gorm.io/gorm"
@@ -15,24 +18,50 @@ import (
 
 // MemoryService 记忆服务接口
 type MemoryService interface {
-	// ReviewWord 复习单词
-	ReviewWord(ctx context.Context, wordID entity.WordID, result bool, responseTime uint32) error
-	// ReviewHanChar 复习汉字
-	ReviewHanChar(ctx context.Context, hanCharID uint32, result bool, responseTime uint32) error
+	// ReviewWord 复习单词，sessionID 为 0 表示不在学习会话中
+	ReviewWord(ctx context.Context, wordID entity.WordID, grade entity.ReviewGrade, responseTime uint32, sessionID entity.StudySessionID) error
+	// ReviewHanChar 复习汉字，sessionID 为 0 表示不在学习会话中
+	ReviewHanChar(ctx context.Context, hanCharID uint32, grade entity.ReviewGrade, responseTime uint32, sessionID entity.StudySessionID) error
+	// Review 复习任意类型的学习内容，sessionID 为 0 表示不在学习会话中
+	Review(ctx context.Context, unitType entity.MemoryUnitType, contentID uint32, grade entity.ReviewGrade, responseTime uint32, sessionID entity.StudySessionID) error
+	// SubmitReviewBatch 批量提交复习，返回与 items 一一对应的处理结果
+	SubmitReviewBatch(ctx context.Context, items []*dto.BatchReviewItem) ([]*dto.BatchReviewResult, error)
 	// GetNextReviewWords 获取下一批需要复习的单词
 	GetNextReviewWords(ctx context.Context, limit int) ([]*entity.Word, error)
 	// GetWordStats 获取单词的学习统计信息
 	GetWordStats(ctx context.Context, wordID entity.WordID) (*WordStats, error)
 	// GetLearningProgress 获取学习进度
 	GetLearningProgress(ctx context.Context) (*LearningProgress, error)
-	// UpdateMemoryStatus 更新记忆单元状态
+	// UpdateMemoryStatus 手动设置记忆单元的掌握程度并累加学习时长，记录到复习日志
 	UpdateMemoryStatus(ctx context.Context, memoryUnitID uint32, masteryLevel entity.MasteryLevel, studyDuration uint32) error
 	// GetMemoryUnit 获取记忆单元
 	GetMemoryUnit(ctx context.Context, id uint32) (*entity.MemoryUnit, error)
 	// ListMemoriesForReview 获取需要复习的记忆单元列表
 	ListMemoriesForReview(ctx context.Context, page, pageSize uint32, types []entity.MemoryUnitType) ([]*entity.MemoryUnit, int, error)
 	// GetMemoryStats 获取记忆统计信息
-	GetMemoryStats(ctx context.Context, unitType *entity.MemoryUnitType) (*repository.MemoryUnitStats, error)
+	GetMemoryStats(ctx context.Context, req *dto.MemoryStatsRequest) (*dto.MemoryStats, error)
+	// ListMemoryReviews 获取记忆单元的复习记录（按复习时间升序）
+	ListMemoryReviews(ctx context.Context, memoryUnitID uint32, page, pageSize uint32) ([]*entity.MemoryReview, int, error)
+	// ForecastReviews 预测未来每天的复习量
+	ForecastReviews(ctx context.Context, req *dto.ReviewForecastRequest) (*dto.ReviewForecast, error)
+	// ListLeeches 获取当前用户的顽固项列表
+	ListLeeches(ctx context.Context, page, pageSize uint32, types []entity.MemoryUnitType) ([]*entity.MemoryUnit, int, error)
+	// UnsuspendMemoryUnit 恢复已暂停的记忆单元的复习
+	UnsuspendMemoryUnit(ctx context.Context, memoryUnitID uint32) (*entity.MemoryUnit, error)
+	// ResetLeech 清除记忆单元的顽固项状态并恢复复习
+	ResetLeech(ctx context.Context, memoryUnitID uint32) (*entity.MemoryUnit, error)
+	// ListHardContents 获取被最多用户标记为顽固项的学习内容
+	ListHardContents(ctx context.Context, types []entity.MemoryUnitType, limit int) ([]*HardContent, error)
+	// ResetMemoryUnit 将记忆单元重置为新内容
+	ResetMemoryUnit(ctx context.Context, memoryUnitID uint32) (*entity.MemoryUnit, error)
+	// BuryMemoryUnit 搁置记忆单元到下一个学习日
+	BuryMemoryUnit(ctx context.Context, memoryUnitID uint32) (*entity.MemoryUnit, error)
+	// RescheduleMemoryUnit 将记忆单元的下次复习调整到指定日期
+	RescheduleMemoryUnit(ctx context.Context, memoryUnitID uint32, date string) (*entity.MemoryUnit, error)
+	// RescheduleDueReviews 将所有到期的复习分散到之后的若干个学习日，返回调整的记忆单元数量
+	RescheduleDueReviews(ctx context.Context, req *dto.RescheduleDueRequest) (int, error)
+	// ForgetCourse 将课程内容对应的记忆单元全部重置为新内容，返回重置的记忆单元数量
+	ForgetCourse(ctx context.Context, courseID uint) (int, error)
 }
 
 // WordStats 单词学习统计信息
@@ -60,6 +89,12 @@ type LearningProgress struct {
 	DailyNewGoal    int     `json:"daily_new_goal"`
 }
 
+// HardContent 系统性难以记住的学习内容
+type HardContent struct {
+	repository.LeechContentStats
+	Text string `json:"text"` // 内容的展示文本，内容已删除时为空
+}
+
 // ReviewInterval 复习间隔
 type ReviewInterval struct {
 	Days    uint32
@@ -69,160 +104,298 @@ type ReviewInterval struct {
 
 // MemoryServiceImpl 记忆服务实现
 type MemoryServiceImpl struct {
-	wordRepo    repository.WordRepository
-	memoryRepo  repository.MemoryUnitRepository
-	hanCharRepo repository.HanCharRepository // Add HanCharRepository
+	wordRepo      repository.WordRepository
+	memoryRepo    repository.MemoryUnitRepository
+	contents      repository.ContentRepositories
+	reviewRepo    repository.MemoryReviewRepository
+	sessionRepo   repository.StudySessionRepository
+	settingsRepo  repository.UserSettingsRepository
+	txManager     repository.TransactionManager
+	schedulers    *domainService.SchedulerProvider
+	activityCache repository.StudyActivityCache
+	courseRepo    repository.CourseRepository
 }
 
 // NewMemoryService 创建记忆服务实例
-func NewMemoryService(wordRepo repository.WordRepository, memoryRepo repository.MemoryUnitRepository, hanCharRepo repository.HanCharRepository) MemoryService { // Add hanCharRepo param
+func NewMemoryService(wordRepo repository.WordRepository, memoryRepo repository.MemoryUnitRepository, contents repository.ContentRepositories, reviewRepo repository.MemoryReviewRepository, sessionRepo repository.StudySessionRepository, settingsRepo repository.UserSettingsRepository, txManager repository.TransactionManager, schedulers *domainService.SchedulerProvider, activityCache repository.StudyActivityCache, courseRepo repository.CourseRepository) MemoryService {
 	return &MemoryServiceImpl{
-		wordRepo:    wordRepo,
-		memoryRepo:  memoryRepo,
-		hanCharRepo: hanCharRepo, // Store hanCharRepo
+		wordRepo:      wordRepo,
+		memoryRepo:    memoryRepo,
+		contents:      contents,
+		reviewRepo:    reviewRepo,
+		sessionRepo:   sessionRepo,
+		settingsRepo:  settingsRepo,
+		txManager:     txManager,
+		schedulers:    schedulers,
+		activityCache: activityCache,
+		courseRepo:    courseRepo,
 	}
 }
 
 // ReviewWord 复习单词
-func (s *MemoryServiceImpl) ReviewWord(ctx context.Context, wordID entity.WordID, result bool, responseTime uint32) error {
-	log := logger.GetLogger(ctx)
+func (s *MemoryServiceImpl) ReviewWord(ctx context.Context, wordID entity.WordID, grade entity.ReviewGrade, responseTime uint32, sessionID entity.StudySessionID) error {
+	err := s.Review(ctx, entity.MemoryUnitTypeWord, uint32(wordID), grade, responseTime, sessionID)
+	if errors.Is(err, domainErrors.ErrContentNotFound) {
+		return domainErrors.ErrWordNotFound
+	}
+	return err
+}
 
-	// Check if Word exists
-	_, err := s.wordRepo.GetByID(ctx, wordID)
-	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			log.Warn("Attempted to review non-existent word", zap.Uint32("wordID", uint32(wordID)))
-			return &domainErrors.Error{
-				Code:    domainErrors.CodeWordNotFound,
-				Message: "单词不存在",
-			}
-		}
-		log.Error("Failed to check word existence", zap.Error(err), zap.Uint32("wordID", uint32(wordID)))
-		return err // Return other DB errors
+// ReviewHanChar 复习汉字
+func (s *MemoryServiceImpl) ReviewHanChar(ctx context.Context, hanCharID uint32, grade entity.ReviewGrade, responseTime uint32, sessionID entity.StudySessionID) error {
+	return s.Review(ctx, entity.MemoryUnitTypeHanChar, hanCharID, grade, responseTime, sessionID)
+}
+
+// Review 复习学习内容
+// 与内容类型无关：按类型找到学习内容仓储校验内容存在，获取或创建记忆单元，由调度器计算下次复习时间
+func (s *MemoryServiceImpl) Review(ctx context.Context, unitType entity.MemoryUnitType, contentID uint32, grade entity.ReviewGrade, responseTime uint32, sessionID entity.StudySessionID) error {
+	log := logger.GetLogger(ctx).With(zap.Uint8("type", uint8(unitType)), zap.Uint32("contentID", contentID))
+
+	if !unitType.IsValid() {
+		return domainErrors.ErrInvalidMemoryUnitType
+	}
+	if !grade.IsValid() {
+		return domainErrors.ErrInvalidReviewGrade
 	}
 
-	// Get UserID from context - Placeholder, needs interceptor fix
 	userID, err := GetUserID(ctx)
 	if err != nil {
-		log.Error("Failed to get UserID in ReviewWord", zap.Error(err))
-		// Returning error here because creating a unit without a user doesn't make sense
+		log.Error("Failed to get UserID in Review", zap.Error(err))
 		return err
 	}
 
-	// 获取或创建记忆单元
-	memoryUnit, err := s.memoryRepo.GetByTypeAndContentID(ctx, entity.MemoryUnitTypeWord, uint32(wordID))
+	// 1. 校验学习内容存在且对用户可见
+	exists, err := s.contentExists(ctx, userID, unitType, contentID)
 	if err != nil {
-		log.Error("Failed to get memory unit by type and content ID", zap.Error(err), zap.Uint32("wordID", uint32(wordID)))
+		log.Error("Failed to check review content existence", zap.Error(err))
 		return err
 	}
+	if !exists {
+		log.Warn("Attempted to review non-existent content")
+		return domainErrors.ErrContentNotFound
+	}
 
-	if memoryUnit == nil {
-		memoryUnit = entity.NewMemoryUnit(entity.UID(userID), entity.MemoryUnitTypeWord, uint32(wordID))
-		if err := s.memoryRepo.Create(ctx, memoryUnit); err != nil {
-			log.Error("Failed to create new memory unit for word", zap.Error(err), zap.Uint32("wordID", uint32(wordID)), zap.Uint32("userID", uint32(userID)))
+	// 2. 校验学习会话
+	if err := s.checkStudySession(ctx, userID, sessionID); err != nil {
+		return err
+	}
+
+	// 3. 获取用户使用的调度器和顽固项策略，跳过不改变复习计划，不需要调度器
+	var scheduler domainService.Scheduler
+	var leech leechPolicy
+	if grade != entity.ReviewGradeSkip {
+		scheduler, err = s.schedulers.ForUser(ctx, userID)
+		if err != nil {
+			log.Error("Failed to resolve review scheduler", zap.Error(err), zap.Uint32("userID", uint32(userID)))
+			return err
+		}
+		leech, err = s.leechPolicy(ctx, userID)
+		if err != nil {
 			return err
 		}
-		log.Info("Created new memory unit for word", zap.Uint32("unitID", uint32(memoryUnit.ID)), zap.Uint32("wordID", uint32(wordID)))
 	}
 
-	// 更新记忆统计
-	memoryUnit.UpdateReviewStats(result, responseTime)
+	// 4. 在同一事务中获取或创建记忆单元、更新复习计划并记录复习，避免复习计划已更新却缺少复习记录
+	err = s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		memoryUnit, err := s.memoryRepo.GetByTypeAndContentID(ctx, userID, unitType, contentID)
+		if err != nil {
+			log.Error("Failed to get memory unit by type and content ID", zap.Error(err))
+			return err
+		}
+		if memoryUnit == nil && grade == entity.ReviewGradeSkip {
+			// 跳过尚未学习的内容不创建记忆单元，也不记录复习，避免计入今日学习和连续学习
+			log.Info("Review skipped before content was learned")
+			return nil
+		}
+		if memoryUnit == nil {
+			memoryUnit = entity.NewMemoryUnit(userID, unitType, contentID)
+			if err := s.memoryRepo.Create(ctx, memoryUnit); err != nil {
+				log.Error("Failed to create new memory unit", zap.Error(err), zap.Uint32("userID", uint32(userID)))
+				return err
+			}
+			log.Info("Created new memory unit", zap.Uint32("unitID", uint32(memoryUnit.ID)))
+		}
+
+		// 跳过不计入复习统计，也不改变复习计划，仅记录复习记录
+		if grade == entity.ReviewGradeSkip {
+			log.Info("Review skipped", zap.Uint32("unitID", uint32(memoryUnit.ID)))
+			return s.recordReview(ctx, s.skipReview(memoryUnit, responseTime, time.Now()), sessionID)
+		}
 
-	// 计算下次复习时间
-	interval := s.calculateNextReviewInterval(memoryUnit)
-	now := time.Now()
-	nextReviewAt := now.Add(time.Duration(interval.Days)*24*time.Hour +
-		time.Duration(interval.Hours)*time.Hour +
-		time.Duration(interval.Minutes)*time.Minute)
-	memoryUnit.NextReviewAt = nextReviewAt
-
-	// 添加日志
-	log.Info("[Service ReviewWord] Calculated review interval",
-		zap.Uint32("UnitID", uint32(memoryUnit.ID)),
-		zap.Any("Interval", interval),
-		zap.Time("LastReviewAt", memoryUnit.LastReviewAt),
-		zap.Time("CalculatedNextReviewAt", nextReviewAt),
-	)
+		// 5. 更新记忆统计并计算下次复习时间
+		review := s.applyReview(scheduler, memoryUnit, grade, responseTime, time.Now())
+		log.Info("Calculated review interval",
+			zap.Uint32("unitID", uint32(memoryUnit.ID)),
+			zap.String("scheduler", string(scheduler.Name())),
+			zap.Uint32("intervalSeconds", review.IntervalAfter),
+			zap.Time("nextReviewAt", memoryUnit.NextReviewAt),
+		)
+		if leech.apply(memoryUnit) {
+			log.Info("Memory unit marked as leech", zap.Uint32("unitID", uint32(memoryUnit.ID)), zap.Uint32("lapses", memoryUnit.Lapses), zap.Bool("suspended", memoryUnit.Suspended))
+		}
 
-	// 保存更新
-	if err := s.memoryRepo.Update(ctx, memoryUnit); err != nil {
-		log.Error("Failed to update memory unit after word review", zap.Error(err), zap.Uint32("unitID", uint32(memoryUnit.ID)))
+		if err := s.memoryRepo.Update(ctx, memoryUnit); err != nil {
+			log.Error("Failed to update memory unit after review", zap.Error(err), zap.Uint32("unitID", uint32(memoryUnit.ID)))
+			return err
+		}
+
+		// 6. 记录复习记录
+		return s.recordReview(ctx, review, sessionID)
+	})
+	if err != nil {
 		return err
 	}
+	s.invalidateActivity(ctx, userID)
 	return nil
 }
 
-// ReviewHanChar 复习汉字
-func (s *MemoryServiceImpl) ReviewHanChar(ctx context.Context, hanCharID uint32, result bool, responseTime uint32) error {
+// SubmitReviewBatch 批量提交复习
+// 用于离线学习后的同步：各记忆单元的复习按客户端记录的复习时间依次应用，全部复习在同一事务中保存；
+// 已提交过的幂等键、未知的内容以及早于服务端最近一次复习的记录不会被应用，原因记录在对应的结果中
+func (s *MemoryServiceImpl) SubmitReviewBatch(ctx context.Context, items []*dto.BatchReviewItem) ([]*dto.BatchReviewResult, error) {
 	log := logger.GetLogger(ctx)
 
-	// 1. Check if HanChar exists
-	_, err := s.hanCharRepo.GetByID(ctx, entity.HanCharID(hanCharID))
-	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			log.Warn("Attempted to review non-existent HanChar", zap.Uint32("hanCharID", hanCharID))
-			// Use a standard domain error or return gRPC status directly from handler
-			// For now, returning a domain error that the gRPC handler should convert.
-			return domainErrors.ErrNotFound // Return specific domain error
-		}
-		log.Error("Failed to check HanChar existence", zap.Error(err), zap.Uint32("hanCharID", hanCharID))
-		return err // Return other DB errors
+	if len(items) > MaxReviewBatchSize {
+		return nil, domainErrors.ErrReviewBatchTooLarge
 	}
 
-	// 2. Get UserID from context - Placeholder, needs interceptor fix
 	userID, err := GetUserID(ctx)
 	if err != nil {
-		log.Error("Failed to get UserID in ReviewHanChar", zap.Error(err))
-		// Returning error here because creating a unit without a user doesn't make sense
-		return err
+		return nil, err
 	}
 
-	// 3. Get or Create MemoryUnit (only if HanChar exists)
-	memoryUnit, err := s.memoryRepo.GetByTypeAndContentID(ctx, entity.MemoryUnitTypeHanChar, hanCharID)
-	if err != nil {
-		log.Error("Failed to get memory unit by type and content ID", zap.Error(err), zap.Uint32("hanCharID", hanCharID))
-		return err
+	results := make([]*dto.BatchReviewResult, len(items))
+	for i, item := range items {
+		results[i] = &dto.BatchReviewResult{IdempotencyKey: item.IdempotencyKey}
 	}
 
-	if memoryUnit == nil {
-		memoryUnit = entity.NewMemoryUnit(entity.UID(userID), entity.MemoryUnitTypeHanChar, hanCharID)
-		if err := s.memoryRepo.Create(ctx, memoryUnit); err != nil {
-			log.Error("Failed to create new memory unit for han char", zap.Error(err), zap.Uint32("hanCharID", hanCharID), zap.Uint32("userID", uint32(userID)))
-			return err
+	// 1. 校验参数，批次内重复的幂等键只保留第一条
+	now := time.Now()
+	seen := make(map[string]struct{}, len(items))
+	pending := make([]int, 0, len(items))
+	for i, item := range items {
+		if msg := validateBatchReviewItem(item, now); msg != "" {
+			results[i].Status, results[i].Message = dto.BatchReviewStatusInvalid, msg
+			continue
+		}
+		if _, ok := seen[item.IdempotencyKey]; ok {
+			results[i].Status, results[i].Message = dto.BatchReviewStatusDuplicate, "幂等键在本次提交中重复"
+			continue
 		}
-		log.Info("Created new memory unit for han char", zap.Uint32("unitID", uint32(memoryUnit.ID)), zap.Uint32("hanCharID", hanCharID))
+		seen[item.IdempotencyKey] = struct{}{}
+		pending = append(pending, i)
+	}
+	if len(pending) == 0 {
+		return results, nil
 	}
 
-	// 4. Update memory stats
-	memoryUnit.UpdateReviewStats(result, responseTime)
+	// 2. 排除已提交过的幂等键
+	keys := make([]string, len(pending))
+	for i, idx := range pending {
+		keys[i] = items[idx].IdempotencyKey
+	}
+	existing, err := s.reviewRepo.ListIdempotencyKeys(ctx, uint32(userID), keys)
+	if err != nil {
+		log.Error("Failed to list submitted idempotency keys", zap.Error(err), zap.Uint32("userID", uint32(userID)))
+		return nil, err
+	}
+	submitted := make(map[string]struct{}, len(existing))
+	for _, key := range existing {
+		submitted[key] = struct{}{}
+	}
 
-	// 5. Calculate next review time
-	interval := s.calculateNextReviewInterval(memoryUnit)
-	now := time.Now()
-	nextReviewAt := now.Add(time.Duration(interval.Days)*24*time.Hour +
-		time.Duration(interval.Hours)*time.Hour +
-		time.Duration(interval.Minutes)*time.Minute)
-	memoryUnit.NextReviewAt = nextReviewAt
-
-	log.Info("[Service ReviewHanChar] Calculated review interval",
-		zap.Uint32("UnitID", uint32(memoryUnit.ID)),
-		zap.Any("Interval", interval),
-		zap.Time("LastReviewAt", memoryUnit.LastReviewAt),
-		zap.Time("CalculatedNextReviewAt", nextReviewAt),
-	)
+	// 3. 校验学习会话和复习内容，按记忆单元分组
+	sessions := make(map[entity.StudySessionID]bool)
+	contents := make(map[batchReviewUnitKey]bool)
+	groups := make(map[batchReviewUnitKey][]int)
+	var order []batchReviewUnitKey
+	for _, idx := range pending {
+		item := items[idx]
+		if _, ok := submitted[item.IdempotencyKey]; ok {
+			results[idx].Status, results[idx].Message = dto.BatchReviewStatusDuplicate, "幂等键已提交过"
+			continue
+		}
 
-	// 6. Save updated memory unit
-	if err := s.memoryRepo.Update(ctx, memoryUnit); err != nil {
-		log.Error("Failed to update memory unit after han char review", zap.Error(err), zap.Uint32("unitID", uint32(memoryUnit.ID)))
-		return err
+		valid, ok := sessions[item.SessionID]
+		if !ok {
+			err := s.checkStudySession(ctx, userID, item.SessionID)
+			if err != nil && !errors.Is(err, domainErrors.ErrStudySessionNotFound) {
+				return nil, err
+			}
+			valid = err == nil
+			sessions[item.SessionID] = valid
+		}
+		if !valid {
+			results[idx].Status, results[idx].Message = dto.BatchReviewStatusInvalid, domainErrors.ErrStudySessionNotFound.Error()
+			continue
+		}
+
+		key := batchReviewUnitKey{unitType: item.Type, contentID: item.ContentID}
+		exists, ok := contents[key]
+		if !ok {
+			exists, err = s.contentExists(ctx, userID, item.Type, item.ContentID)
+			if err != nil {
+				log.Error("Failed to check review content existence", zap.Error(err), zap.Uint32("contentID", item.ContentID))
+				return nil, err
+			}
+			contents[key] = exists
+		}
+		if !exists {
+			results[idx].Status, results[idx].Message = dto.BatchReviewStatusNotFound, "复习内容不存在"
+			continue
+		}
+
+		if _, ok := groups[key]; !ok {
+			order = append(order, key)
+		}
+		groups[key] = append(groups[key], idx)
 	}
-	return nil
+	if len(order) == 0 {
+		return results, nil
+	}
+
+	scheduler, err := s.schedulers.ForUser(ctx, userID)
+	if err != nil {
+		log.Error("Failed to resolve review scheduler", zap.Error(err), zap.Uint32("userID", uint32(userID)))
+		return nil, err
+	}
+	leech, err := s.leechPolicy(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 4. 在同一事务中按复习时间依次应用各记忆单元的复习
+	err = s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		for _, key := range order {
+			indexes := groups[key]
+			sort.SliceStable(indexes, func(i, j int) bool {
+				return items[indexes[i]].ReviewedAt.Before(items[indexes[j]].ReviewedAt)
+			})
+			if err := s.applyReviewGroup(ctx, scheduler, leech, userID, key, items, indexes, results); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		log.Error("Failed to apply review batch", zap.Error(err), zap.Uint32("userID", uint32(userID)), zap.Int("size", len(items)))
+		return nil, err
+	}
+
+	s.invalidateActivity(ctx, userID)
+	log.Info("Applied review batch", zap.Uint32("userID", uint32(userID)), zap.Int("size", len(items)), zap.Int("units", len(order)))
+	return results, nil
 }
 
 // GetNextReviewWords 获取下一批需要复习的单词
 func (s *MemoryServiceImpl) GetNextReviewWords(ctx context.Context, limit int) ([]*entity.Word, error) {
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	// 获取需要复习的记忆单元
-	units, err := s.memoryRepo.ListNeedReview(ctx, entity.MemoryUnitTypeWord, time.Now(), limit)
+	units, err := s.memoryRepo.ListNeedReview(ctx, userID, entity.MemoryUnitTypeWord, time.Now(), limit)
 	if err != nil {
 		return nil, err
 	}
@@ -242,6 +415,11 @@ func (s *MemoryServiceImpl) GetNextReviewWords(ctx context.Context, limit int) (
 
 // GetWordStats 获取单词的学习统计信息
 func (s *MemoryServiceImpl) GetWordStats(ctx context.Context, wordID entity.WordID) (*WordStats, error) {
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	// 获取单词
 	word, err := s.wordRepo.GetByID(ctx, wordID)
 	if err != nil {
@@ -249,12 +427,12 @@ func (s *MemoryServiceImpl) GetWordStats(ctx context.Context, wordID entity.Word
 	}
 
 	// 获取记忆单元
-	unit, err := s.memoryRepo.GetByTypeAndContentID(ctx, entity.MemoryUnitTypeWord, uint32(wordID))
+	unit, err := s.memoryRepo.GetByTypeAndContentID(ctx, userID, entity.MemoryUnitTypeWord, uint32(wordID))
 	if err != nil {
 		return nil, err
 	}
 	if unit == nil {
-		unit = entity.NewMemoryUnit(0, entity.MemoryUnitTypeWord, uint32(wordID))
+		unit = entity.NewMemoryUnit(userID, entity.MemoryUnitTypeWord, uint32(wordID))
 	}
 
 	return &WordStats{
@@ -276,40 +454,51 @@ func (s *MemoryServiceImpl) GetLearningProgress(ctx context.Context) (*LearningP
 	return &LearningProgress{}, nil
 }
 
-// UpdateMemoryStatus 更新记忆单元状态
+// UpdateMemoryStatus 手动设置记忆单元的掌握程度并累加学习时长
+// 与重置、搁置等人工调整一样记录到复习日志，便于追溯掌握程度的变化；不改变复习计划
 func (s *MemoryServiceImpl) UpdateMemoryStatus(ctx context.Context, memoryUnitID uint32, masteryLevel entity.MasteryLevel, studyDuration uint32) error {
-	// 获取记忆单元
-	memoryUnit, err := s.memoryRepo.GetByID(ctx, memoryUnitID)
-	if err != nil {
-		return err
-	}
-	if memoryUnit == nil {
-		return errors.New("memory unit not found")
+	if !masteryLevel.IsValid() {
+		return domainErrors.ErrInvalidMasteryLevel
 	}
-
-	// 更新状态
-	memoryUnit.MasteryLevel = masteryLevel
-	memoryUnit.StudyDuration += studyDuration
-	memoryUnit.Update()
-
-	// 保存更新
-	return s.memoryRepo.Update(ctx, memoryUnit)
+	_, err := s.adjustMemoryUnit(ctx, memoryUnitID, entity.ReviewActionSetMastery, func(unit *entity.MemoryUnit, _ *entity.UserSettings, _ time.Time) error {
+		unit.MasteryLevel = masteryLevel
+		unit.StudyDuration += studyDuration
+		return nil
+	})
+	return err
 }
 
 // GetMemoryUnit 获取记忆单元
 func (s *MemoryServiceImpl) GetMemoryUnit(ctx context.Context, memoryUnitID uint32) (*entity.MemoryUnit, error) {
-	return s.memoryRepo.GetByID(ctx, memoryUnitID)
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	memoryUnit, err := s.memoryRepo.GetByID(ctx, userID, memoryUnitID)
+	if err != nil {
+		return nil, err
+	}
+	if memoryUnit == nil {
+		return nil, domainErrors.ErrNotFound
+	}
+	return memoryUnit, nil
 }
 
 // ListMemoriesForReview 获取需要复习的记忆单元列表
 func (s *MemoryServiceImpl) ListMemoriesForReview(ctx context.Context, page, pageSize uint32, types []entity.MemoryUnitType) ([]*entity.MemoryUnit, int, error) {
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
 	// 计算 offset
 	offset := (page - 1) * pageSize
 	limit := int(pageSize)
 	now := time.Now()
 
 	// 调用 repository 获取数据
-	units, err := s.memoryRepo.ListNeedReviewByTypes(ctx, types, now, offset, limit)
+	units, err := s.memoryRepo.ListNeedReviewByTypes(ctx, userID, types, now, offset, limit)
 	if err != nil {
 		// 考虑记录日志
 		// log.Printf("Error listing memories for review: %v", err)
@@ -317,7 +506,7 @@ func (s *MemoryServiceImpl) ListMemoriesForReview(ctx context.Context, page, pag
 	}
 
 	// 调用 repository 获取总数
-	total, err := s.memoryRepo.CountNeedReviewByTypes(ctx, types, now)
+	total, err := s.memoryRepo.CountNeedReviewByTypes(ctx, userID, types, now)
 	if err != nil {
 		// 考虑记录日志
 		// log.Printf("Error counting memories for review: %v", err)
@@ -327,79 +516,763 @@ func (s *MemoryServiceImpl) ListMemoriesForReview(ctx context.Context, page, pag
 	return units, int(total), nil
 }
 
-// calculateNextReviewInterval 计算下次复习间隔
-func (s *MemoryServiceImpl) calculateNextReviewInterval(unit *entity.MemoryUnit) *ReviewInterval {
-	// 基础间隔（小时）
-	var baseInterval float64
-	switch unit.MasteryLevel {
-	case entity.MasteryLevelUnlearned:
-		baseInterval = 1 // 1小时
-	case entity.MasteryLevelBeginner:
-		baseInterval = 4 // 4小时
-	case entity.MasteryLevelFamiliar:
-		baseInterval = 24 // 1天
-	case entity.MasteryLevelMastered:
-		baseInterval = 72 // 3天
-	case entity.MasteryLevelExpert:
-		baseInterval = 168 // 7天
+// applyReview 更新复习统计，由调度器计算下次复习时间，并生成对应的复习记录
+// reviewedAt 为实际复习时间，下次复习时间从该时间起算
+func (s *MemoryServiceImpl) applyReview(scheduler domainService.Scheduler, unit *entity.MemoryUnit, grade entity.ReviewGrade, responseTime uint32, reviewedAt time.Time) *entity.MemoryReview {
+	scheduledTime := unit.NextReviewAt
+	masteryBefore := unit.MasteryLevel
+	intervalBefore := unit.NextReviewAt.Sub(unit.LastReviewAt)
+	elapsed := reviewedAt.Sub(unit.LastReviewAt)
+
+	unit.UpdateReviewStats(grade, responseTime, reviewedAt)
+	intervalAfter := scheduler.Schedule(unit, grade, elapsed)
+	unit.NextReviewAt = reviewedAt.Add(intervalAfter)
+
+	review := entity.NewMemoryReview(uint32(unit.ID), uint32(unit.UserID), grade, responseTime, intervalBefore, intervalAfter)
+	review.ReviewTime = reviewedAt
+	review.SetTransition(scheduledTime, masteryBefore, unit.MasteryLevel)
+	return review
+}
+
+// skipReview 生成跳过的复习记录，复习计划保持不变
+func (s *MemoryServiceImpl) skipReview(unit *entity.MemoryUnit, responseTime uint32, reviewedAt time.Time) *entity.MemoryReview {
+	interval := unit.NextReviewAt.Sub(unit.LastReviewAt)
+	review := entity.NewMemoryReview(uint32(unit.ID), uint32(unit.UserID), entity.ReviewGradeSkip, responseTime, interval, interval)
+	review.ReviewTime = reviewedAt
+	review.SetTransition(unit.NextReviewAt, unit.MasteryLevel, unit.MasteryLevel)
+	return review
+}
+
+// 复习量预测的天数
+const (
+	DefaultForecastDays = 7   // 默认预测一周
+	MaxForecastDays     = 365 // 最多预测一年
+)
+
+// ForecastReviews 预测未来每天的复习量
+// 按用户时区和学习日开始时间划分学习日，统计已安排的复习在各学习日的数量，逾期的复习计入今天；
+// 指定每天新学习的内容数量时，用用户当前的调度器模拟这些内容在预测范围内产生的复习
+func (s *MemoryServiceImpl) ForecastReviews(ctx context.Context, req *dto.ReviewForecastRequest) (*dto.ReviewForecast, error) {
+	log := logger.GetLogger(ctx)
+
+	days := int(req.Days)
+	if days == 0 {
+		days = DefaultForecastDays
+	}
+	if days > MaxForecastDays {
+		return nil, domainErrors.ErrInvalidForecastDays
+	}
+	if req.NewPerDay > entity.MaxDailyGoal {
+		return nil, domainErrors.ErrInvalidDailyGoal
+	}
+	for _, t := range req.Types {
+		if !t.IsValid() {
+			return nil, domainErrors.ErrInvalidMemoryUnitType
+		}
+	}
+
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	settings, err := s.settingsRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		log.Error("Failed to get user settings", zap.Error(err), zap.Uint32("userID", uint32(userID)))
+		return nil, err
+	}
+
+	// 1. 划分学习日，bounds[i] 为第 i 天的开始时间，bounds[days] 为预测范围的结束时间
+	today := settings.StartOfDay(time.Now())
+	bounds := make([]time.Time, days+1)
+	for i := range bounds {
+		bounds[i] = today.AddDate(0, 0, i)
+	}
+	dayIndex := func(t time.Time) int {
+		return sort.Search(days, func(i int) bool { return t.Before(bounds[i+1]) })
+	}
+
+	forecast := &dto.ReviewForecast{Days: make([]*dto.ReviewForecastDay, days)}
+	for i := range forecast.Days {
+		forecast.Days[i] = &dto.ReviewForecastDay{Date: bounds[i], DueByType: make(map[entity.MemoryUnitType]int)}
+	}
+
+	// 2. 统计已安排的复习
+	schedules, err := s.memoryRepo.ListReviewSchedules(ctx, userID, req.Types, bounds[days])
+	if err != nil {
+		log.Error("Failed to list review schedules", zap.Error(err), zap.Uint32("userID", uint32(userID)))
+		return nil, err
+	}
+	for _, schedule := range schedules {
+		if schedule.NextReviewAt.Before(today) {
+			forecast.OverdueCount++
+		}
+		day := forecast.Days[dayIndex(schedule.NextReviewAt)]
+		day.DueCount++
+		day.DueByType[schedule.Type]++
+	}
+
+	// 3. 模拟每天新学习的内容产生的复习
+	if req.NewPerDay > 0 {
+		scheduler, err := s.schedulers.ForUser(ctx, userID)
+		if err != nil {
+			log.Error("Failed to resolve review scheduler", zap.Error(err), zap.Uint32("userID", uint32(userID)))
+			return nil, err
+		}
+		offsets := domainService.SimulateReviewOffsets(scheduler, bounds[days].Sub(today))
+		for i, day := range forecast.Days {
+			day.NewCount = int(req.NewPerDay)
+			for _, offset := range offsets {
+				at := bounds[i].Add(offset)
+				if at.Before(bounds[days]) {
+					forecast.Days[dayIndex(at)].SimulatedCount += int(req.NewPerDay)
+				}
+			}
+		}
+	}
+
+	return forecast, nil
+}
+
+// leechPolicy 顽固项检测策略
+type leechPolicy struct {
+	threshold uint32 // 遗忘次数阈值，0 表示不检测
+	suspend   bool   // 标记后是否暂停复习
+}
+
+// apply 遗忘次数达到阈值时标记顽固项，按策略暂停复习；返回本次是否新标记为顽固项
+func (p leechPolicy) apply(unit *entity.MemoryUnit) bool {
+	if !unit.MarkLeech(p.threshold) {
+		return false
+	}
+	if p.suspend {
+		unit.Suspend()
+	}
+	return true
+}
+
+// leechPolicy 获取用户的顽固项检测策略
+func (s *MemoryServiceImpl) leechPolicy(ctx context.Context, userID entity.UID) (leechPolicy, error) {
+	settings, err := s.settingsRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		logger.GetLogger(ctx).Error("Failed to get user settings", zap.Error(err), zap.Uint32("userID", uint32(userID)))
+		return leechPolicy{}, err
+	}
+	threshold, suspend := settings.LeechPolicy()
+	return leechPolicy{threshold: threshold, suspend: suspend}, nil
+}
+
+// MaxReviewBatchSize 单次批量提交的复习数量上限
+const MaxReviewBatchSize = 500
+
+// reviewClockSkew 允许客户端复习时间超前服务端时间的误差
+const reviewClockSkew = 5 * time.Minute
+
+// batchReviewUnitKey 批量复习中记忆单元的标识
+type batchReviewUnitKey struct {
+	unitType  entity.MemoryUnitType
+	contentID uint32
+}
+
+// validateBatchReviewItem 校验批量提交中的单条复习，返回无效的原因
+func validateBatchReviewItem(item *dto.BatchReviewItem, now time.Time) string {
+	switch {
+	case item.IdempotencyKey == "" || len(item.IdempotencyKey) > 64:
+		return "幂等键不能为空且不能超过64个字符"
+	case !item.Type.IsValid():
+		return "无效的记忆单元类型"
+	case !item.Grade.IsValid():
+		return domainErrors.ErrInvalidReviewGrade.Error()
+	case item.ReviewedAt.IsZero() || item.ReviewedAt.After(now.Add(reviewClockSkew)):
+		return "无效的复习时间"
 	default:
-		baseInterval = 1
+		return ""
 	}
+}
 
-	// 根据连续正确次数调整间隔
-	if unit.ConsecutiveCorrect > 0 {
-		baseInterval *= 1.2 * float64(unit.ConsecutiveCorrect)
+// contentExists 检查学习内容是否存在且对用户可见，未注册的内容类型视为不存在
+func (s *MemoryServiceImpl) contentExists(ctx context.Context, userID entity.UID, unitType entity.MemoryUnitType, contentID uint32) (bool, error) {
+	contents, ok := s.contents[unitType]
+	if !ok {
+		return false, nil
 	}
+	return contents.Exists(ctx, userID, contentID)
+}
 
-	// 根据连续错误次数减少间隔
-	if unit.ConsecutiveWrong > 0 {
-		baseInterval /= 2.0 * float64(unit.ConsecutiveWrong)
+// applyReviewGroup 将同一记忆单元的复习按 indexes 的顺序依次应用
+// 早于记忆单元最近一次复习的评分会打乱调度状态，标记为冲突而不应用
+func (s *MemoryServiceImpl) applyReviewGroup(ctx context.Context, scheduler domainService.Scheduler, leech leechPolicy, userID entity.UID, key batchReviewUnitKey, items []*dto.BatchReviewItem, indexes []int, results []*dto.BatchReviewResult) error {
+	unit, err := s.memoryRepo.GetByTypeAndContentID(ctx, userID, key.unitType, key.contentID)
+	if err != nil {
+		return err
+	}
+	if unit == nil {
+		// 与单条复习一致，尚未学习时的跳过不创建记忆单元，也不记录复习
+		learned := len(indexes)
+		for i, idx := range indexes {
+			if items[idx].Grade != entity.ReviewGradeSkip {
+				learned = i
+				break
+			}
+		}
+		for _, idx := range indexes[:learned] {
+			results[idx].Status = dto.BatchReviewStatusApplied
+		}
+		indexes = indexes[learned:]
+		if len(indexes) == 0 {
+			return nil
+		}
+
+		// 新的记忆单元从第一次复习时开始计时
+		first := items[indexes[0]].ReviewedAt
+		unit = entity.NewMemoryUnit(userID, key.unitType, key.contentID)
+		unit.CreatedAt, unit.LastReviewAt, unit.NextReviewAt = first, first, first
+		if err := s.memoryRepo.Create(ctx, unit); err != nil {
+			return err
+		}
+	}
+
+	updated := false
+	for _, idx := range indexes {
+		item, result := items[idx], results[idx]
+		result.MemoryUnitID = uint32(unit.ID)
+
+		var review *entity.MemoryReview
+		if item.Grade == entity.ReviewGradeSkip {
+			review = s.skipReview(unit, item.ResponseTime, item.ReviewedAt)
+		} else {
+			if unit.ReviewCount > 0 && item.ReviewedAt.Before(unit.LastReviewAt) {
+				result.Status, result.Message = dto.BatchReviewStatusConflict, "早于该内容最近一次复习的时间"
+				continue
+			}
+			review = s.applyReview(scheduler, unit, item.Grade, item.ResponseTime, item.ReviewedAt)
+			leech.apply(unit)
+			updated = true
+		}
+
+		review.IdempotencyKey = item.IdempotencyKey
+		if err := s.recordReview(ctx, review, item.SessionID); err != nil {
+			return err
+		}
+		result.Status = dto.BatchReviewStatusApplied
 	}
 
-	// 转换为天、小时、分钟
-	totalMinutes := int(baseInterval * 60)
-	days := uint32(totalMinutes / (24 * 60))
-	totalMinutes = totalMinutes % (24 * 60)
-	hours := uint32(totalMinutes / 60)
-	minutes := uint32(totalMinutes % 60)
+	if updated {
+		return s.memoryRepo.Update(ctx, unit)
+	}
+	return nil
+}
+
+// checkStudySession 校验复习归属的学习会话存在且属于当前用户
+func (s *MemoryServiceImpl) checkStudySession(ctx context.Context, userID entity.UID, sessionID entity.StudySessionID) error {
+	if sessionID == 0 {
+		return nil
+	}
+	session, err := s.sessionRepo.GetByID(ctx, userID, sessionID)
+	if err != nil {
+		logger.GetLogger(ctx).Error("Failed to get study session", zap.Error(err), zap.Uint32("sessionID", uint32(sessionID)))
+		return err
+	}
+	if session == nil {
+		return domainErrors.ErrStudySessionNotFound
+	}
+	return nil
+}
+
+// recordReview 保存复习记录
+func (s *MemoryServiceImpl) recordReview(ctx context.Context, review *entity.MemoryReview, sessionID entity.StudySessionID) error {
+	review.SessionID = sessionID
+	if err := s.reviewRepo.Create(ctx, review); err != nil {
+		logger.GetLogger(ctx).Error("Failed to record memory review", zap.Error(err), zap.Uint32("unitID", review.MemoryUnitID))
+		return err
+	}
+	return nil
+}
 
-	return &ReviewInterval{
-		Days:    days,
-		Hours:   hours,
-		Minutes: minutes,
+// invalidateActivity 用户产生新的学习记录后清除学习活动缓存，清除失败时缓存会在过期后更新
+func (s *MemoryServiceImpl) invalidateActivity(ctx context.Context, userID entity.UID) {
+	if err := s.activityCache.Delete(ctx, userID); err != nil {
+		logger.GetLogger(ctx).Warn("Failed to invalidate study activity cache", zap.Error(err), zap.Uint32("userID", uint32(userID)))
 	}
 }
 
+// DefaultRetentionWindows 默认的实际记忆保持率统计窗口（天）
+var DefaultRetentionWindows = []uint32{7, 30, 90}
+
+// maxRetentionWindow 统计窗口上限，超过一年的复习记录会被清理
+const maxRetentionWindow = uint32(entity.MemoryReviewRetention / (24 * time.Hour))
+
 // GetMemoryStats 获取记忆统计信息
-func (s *MemoryServiceImpl) GetMemoryStats(ctx context.Context, unitType *entity.MemoryUnitType) (*repository.MemoryUnitStats, error) {
+// 数量和学习时长来自记忆单元，实际记忆保持率来自各统计窗口内的复习记录
+func (s *MemoryServiceImpl) GetMemoryStats(ctx context.Context, req *dto.MemoryStatsRequest) (*dto.MemoryStats, error) {
 	log := logger.GetLogger(ctx)
 
-	// 从 context 获取 userID
-	userID, err := GetUserID(ctx) // Use the function from the same package
+	windows := req.RetentionWindows
+	if len(windows) == 0 {
+		windows = DefaultRetentionWindows
+	}
+	for _, days := range windows {
+		if days == 0 || days > maxRetentionWindow {
+			return nil, domainErrors.ErrInvalidStatsWindow
+		}
+	}
+	for _, t := range req.Types {
+		if !t.IsValid() {
+			return nil, domainErrors.ErrInvalidMemoryUnitType
+		}
+	}
+
+	userID, err := GetUserID(ctx)
 	if err != nil {
 		log.Error("Failed to get userID from context for GetMemoryStats", zap.Error(err))
-		// 根据实际错误类型返回，例如 ErrNoUserInContext 可能映射为 Unauthenticated
-		return nil, err // Or: status.Error(codes.Unauthenticated, "user not found in context")
+		return nil, err
 	}
 
-	// 如果未指定类型，我们需要决定是返回错误、所有类型的聚合，还是某种默认类型。
-	// 当前 repository.GetStats 只接受一个明确的类型。
-	// 暂时：如果 unitType 为 nil，我们返回错误或默认类型（例如 HanChar）。
-	// TODO: 明确处理 unitType == nil 的情况。
-	if unitType == nil {
-		log.Warn("GetMemoryStats called without specific unit type, using default", zap.Uint32("userID", uint32(userID)))
-		// 选项 B: 使用默认类型 (例如 HanChar)
-		defaultType := entity.MemoryUnitTypeHanChar
-		unitType = &defaultType
+	unitStats, err := s.memoryRepo.GetStats(ctx, userID, req.Types)
+	if err != nil {
+		log.Error("Failed to get stats from repository", zap.Error(err), zap.Uint32("userID", uint32(userID)))
+		return nil, err
 	}
+	stats := &dto.MemoryStats{MemoryUnitStats: *unitStats}
 
-	// 直接使用 entity.UID 类型调用 repository
-	stats, err := s.memoryRepo.GetStats(ctx, userID, *unitType)
+	now := time.Now()
+	needReview, err := s.memoryRepo.CountNeedReviewByTypes(ctx, userID, req.Types, now)
 	if err != nil {
-		log.Error("Failed to get stats from repository", zap.Error(err), zap.Uint32("userID", uint32(userID)), zap.Any("unitType", *unitType))
+		log.Error("Failed to count memories for review", zap.Error(err), zap.Uint32("userID", uint32(userID)))
 		return nil, err
 	}
+	stats.NeedReviewCount = int(needReview)
+
+	// 每日目标来自用户的学习偏好
+	settings, err := s.settingsRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		log.Error("Failed to get user settings", zap.Error(err), zap.Uint32("userID", uint32(userID)))
+		return nil, err
+	}
+	stats.DailyReviewGoal = int(settings.DailyReviewGoal)
+	stats.DailyNewGoal = int(settings.DailyNewGoal)
+
+	// 各统计窗口的实际记忆保持率
+	for _, days := range windows {
+		retention, err := s.reviewRepo.GetRetentionStats(ctx, uint32(userID), req.Types, now.AddDate(0, 0, -int(days)))
+		if err != nil {
+			log.Error("Failed to get retention stats", zap.Error(err), zap.Uint32("userID", uint32(userID)), zap.Uint32("days", days))
+			return nil, err
+		}
+		stats.Retention = append(stats.Retention, newRetentionWindow(days, retention))
+	}
 	return stats, nil
 }
 
+// newRetentionWindow 汇总各类型的到期复习回忆情况
+func newRetentionWindow(days uint32, stats []*repository.RetentionStats) *dto.RetentionWindow {
+	window := &dto.RetentionWindow{Days: days, RateByType: make(map[entity.MemoryUnitType]float64, len(stats))}
+	for _, stat := range stats {
+		window.ReviewCount += stat.ReviewCount
+		window.CorrectCount += stat.CorrectCount
+		if stat.ReviewCount > 0 {
+			window.RateByType[stat.Type] = float64(stat.CorrectCount) / float64(stat.ReviewCount)
+		}
+	}
+	if window.ReviewCount > 0 {
+		window.Rate = float64(window.CorrectCount) / float64(window.ReviewCount)
+	}
+	return window
+}
+
+const (
+	DefaultMemoryListPageSize = 20  // 复习记录和顽固项列表默认每页数量
+	MaxMemoryListPageSize     = 100 // 复习记录和顽固项列表每页数量上限
+)
+
+// memoryListPage 规范化分页参数，页码从1开始，每页数量不超过上限
+func memoryListPage(page, pageSize uint32) (uint32, uint32) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize == 0 {
+		pageSize = DefaultMemoryListPageSize
+	} else if pageSize > MaxMemoryListPageSize {
+		pageSize = MaxMemoryListPageSize
+	}
+	return page, pageSize
+}
+
+// ListMemoryReviews 获取记忆单元的复习记录（按复习时间升序）
+func (s *MemoryServiceImpl) ListMemoryReviews(ctx context.Context, memoryUnitID uint32, page, pageSize uint32) ([]*entity.MemoryReview, int, error) {
+	log := logger.GetLogger(ctx)
+
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		log.Error("Failed to get UserID in ListMemoryReviews", zap.Error(err))
+		return nil, 0, err
+	}
+
+	unit, err := s.memoryRepo.GetByID(ctx, userID, memoryUnitID)
+	if err != nil {
+		log.Error("Failed to get memory unit", zap.Error(err), zap.Uint32("unitID", memoryUnitID))
+		return nil, 0, err
+	}
+	if unit == nil {
+		return nil, 0, domainErrors.ErrNotFound
+	}
+
+	page, pageSize = memoryListPage(page, pageSize)
+	offset := int((page - 1) * pageSize)
+	reviews, err := s.reviewRepo.ListByMemoryUnitID(ctx, memoryUnitID, offset, int(pageSize))
+	if err != nil {
+		log.Error("Failed to list memory reviews", zap.Error(err), zap.Uint32("unitID", memoryUnitID))
+		return nil, 0, err
+	}
+
+	total, err := s.reviewRepo.CountByMemoryUnitID(ctx, memoryUnitID)
+	if err != nil {
+		log.Error("Failed to count memory reviews", zap.Error(err), zap.Uint32("unitID", memoryUnitID))
+		return nil, 0, err
+	}
+
+	return reviews, int(total), nil
+}
+
+// ListLeeches 获取当前用户的顽固项列表（按遗忘次数降序）
+func (s *MemoryServiceImpl) ListLeeches(ctx context.Context, page, pageSize uint32, types []entity.MemoryUnitType) ([]*entity.MemoryUnit, int, error) {
+	log := logger.GetLogger(ctx)
+
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	page, pageSize = memoryListPage(page, pageSize)
+	offset := (page - 1) * pageSize
+	units, err := s.memoryRepo.ListLeeches(ctx, userID, types, offset, int(pageSize))
+	if err != nil {
+		log.Error("Failed to list leeches", zap.Error(err), zap.Uint32("userID", uint32(userID)))
+		return nil, 0, err
+	}
+
+	total, err := s.memoryRepo.CountLeeches(ctx, userID, types)
+	if err != nil {
+		log.Error("Failed to count leeches", zap.Error(err), zap.Uint32("userID", uint32(userID)))
+		return nil, 0, err
+	}
+
+	return units, int(total), nil
+}
+
+// UnsuspendMemoryUnit 恢复已暂停的记忆单元的复习
+// 顽固项标记和遗忘次数保留，不会因为再次遗忘而重复暂停
+func (s *MemoryServiceImpl) UnsuspendMemoryUnit(ctx context.Context, memoryUnitID uint32) (*entity.MemoryUnit, error) {
+	return s.updateMemoryUnit(ctx, memoryUnitID, (*entity.MemoryUnit).Unsuspend)
+}
+
+// ResetLeech 清除记忆单元的顽固项状态并恢复复习
+// 遗忘次数清零后重新累计，再次达到阈值时会重新标记
+func (s *MemoryServiceImpl) ResetLeech(ctx context.Context, memoryUnitID uint32) (*entity.MemoryUnit, error) {
+	return s.updateMemoryUnit(ctx, memoryUnitID, (*entity.MemoryUnit).ResetLeech)
+}
+
+// updateMemoryUnit 获取当前用户的记忆单元，修改后保存
+func (s *MemoryServiceImpl) updateMemoryUnit(ctx context.Context, memoryUnitID uint32, modify func(unit *entity.MemoryUnit)) (*entity.MemoryUnit, error) {
+	log := logger.GetLogger(ctx)
+
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	unit, err := s.memoryRepo.GetByID(ctx, userID, memoryUnitID)
+	if err != nil {
+		log.Error("Failed to get memory unit", zap.Error(err), zap.Uint32("unitID", memoryUnitID))
+		return nil, err
+	}
+	if unit == nil {
+		return nil, domainErrors.ErrNotFound
+	}
+
+	modify(unit)
+	unit.Update()
+	if err := s.memoryRepo.Update(ctx, unit); err != nil {
+		log.Error("Failed to update memory unit", zap.Error(err), zap.Uint32("unitID", memoryUnitID))
+		return nil, err
+	}
+	return unit, nil
+}
+
+// MaxRescheduleDays 批量调整到期复习时最多分散的学习日天数
+const MaxRescheduleDays = 365
+
+// MaxRescheduleUnits 单次批量调整的记忆单元数量上限，超出部分按原定复习时间留待下次调整
+const MaxRescheduleUnits = 10000
+
+// ResetMemoryUnit 将记忆单元重置为新内容
+// 复习进度、调度状态和顽固项状态清除，记忆单元立即到期，调整记录在复习日志中
+func (s *MemoryServiceImpl) ResetMemoryUnit(ctx context.Context, memoryUnitID uint32) (*entity.MemoryUnit, error) {
+	return s.adjustMemoryUnit(ctx, memoryUnitID, entity.ReviewActionReset, func(unit *entity.MemoryUnit, _ *entity.UserSettings, now time.Time) error {
+		unit.Reset(now)
+		return nil
+	})
+}
+
+// BuryMemoryUnit 搁置记忆单元到下一个学习日开始时
+// 原定复习时间更晚时不调整
+func (s *MemoryServiceImpl) BuryMemoryUnit(ctx context.Context, memoryUnitID uint32) (*entity.MemoryUnit, error) {
+	return s.adjustMemoryUnit(ctx, memoryUnitID, entity.ReviewActionBury, func(unit *entity.MemoryUnit, settings *entity.UserSettings, now time.Time) error {
+		unit.Bury(settings.StartOfDay(now).AddDate(0, 0, 1))
+		return nil
+	})
+}
+
+// RescheduleMemoryUnit 将记忆单元的下次复习调整到指定学习日（用户时区的 YYYY-MM-DD）开始时
+// 日期不能早于今天，调整为今天时立即到期
+func (s *MemoryServiceImpl) RescheduleMemoryUnit(ctx context.Context, memoryUnitID uint32, date string) (*entity.MemoryUnit, error) {
+	return s.adjustMemoryUnit(ctx, memoryUnitID, entity.ReviewActionReschedule, func(unit *entity.MemoryUnit, settings *entity.UserSettings, now time.Time) error {
+		day, err := parseLearningDay(date, settings)
+		if err != nil || day.Before(settings.StartOfDay(now)) {
+			return domainErrors.ErrInvalidRescheduleDate
+		}
+		unit.Reschedule(day)
+		return nil
+	})
+}
+
+// RescheduleDueReviews 将到期的复习均匀分散到从今天开始的若干个学习日
+// 适用于假期后积压大量复习的情况，逾期最久的最先复习；分到今天的记忆单元不调整，也不记录调整
+func (s *MemoryServiceImpl) RescheduleDueReviews(ctx context.Context, req *dto.RescheduleDueRequest) (int, error) {
+	log := logger.GetLogger(ctx)
+
+	if req.Days == 0 || req.Days > MaxRescheduleDays {
+		return 0, domainErrors.ErrInvalidSpreadDays
+	}
+	for _, t := range req.Types {
+		if !t.IsValid() {
+			return 0, domainErrors.ErrInvalidMemoryUnitType
+		}
+	}
+
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return 0, err
+	}
+	settings, err := s.settingsRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		log.Error("Failed to get user settings", zap.Error(err), zap.Uint32("userID", uint32(userID)))
+		return 0, err
+	}
+
+	now := time.Now()
+	units, err := s.memoryRepo.ListNeedReviewByTypes(ctx, userID, req.Types, now, 0, MaxRescheduleUnits)
+	if err != nil {
+		log.Error("Failed to list due memory units", zap.Error(err), zap.Uint32("userID", uint32(userID)))
+		return 0, err
+	}
+
+	before := snapshotMemoryUnits(units)
+	domainService.SpreadReviews(units, settings.StartOfDay(now), int(req.Days))
+
+	var changed, previous []*entity.MemoryUnit
+	for i, unit := range units {
+		if !unit.NextReviewAt.Equal(before[i].NextReviewAt) {
+			changed = append(changed, unit)
+			previous = append(previous, before[i])
+		}
+	}
+	if err := s.saveAdjustments(ctx, entity.ReviewActionReschedule, previous, changed, now); err != nil {
+		return 0, err
+	}
+
+	log.Info("Rescheduled due reviews",
+		zap.Uint32("userID", uint32(userID)),
+		zap.Int("dueCount", len(units)),
+		zap.Int("rescheduled", len(changed)),
+		zap.Uint32("days", req.Days),
+	)
+	return len(changed), nil
+}
+
+// ForgetCourse 将课程内容对应的记忆单元全部重置为新内容
+// 课程内容按课程标签确定，与学习会话选取课程新内容的规则一致
+func (s *MemoryServiceImpl) ForgetCourse(ctx context.Context, courseID uint) (int, error) {
+	log := logger.GetLogger(ctx)
+
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	course, err := s.courseRepo.GetByID(ctx, courseID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return 0, domainErrors.ErrNotFound
+		}
+		log.Error("Failed to get course", zap.Error(err), zap.Uint("courseID", courseID))
+		return 0, err
+	}
+	if len(course.Tags) == 0 {
+		return 0, domainErrors.ErrCourseHasNoTags
+	}
+
+	filter := repository.NewContentFilter{CourseTags: course.Tags}
+	var units []*entity.MemoryUnit
+	for _, unitType := range entity.MemoryUnitTypes {
+		contents, ok := s.contents[unitType]
+		if !ok {
+			continue
+		}
+		contentIDs, err := contents.ListIDs(ctx, filter)
+		if err != nil {
+			log.Error("Failed to list course contents", zap.Error(err), zap.Uint("courseID", courseID), zap.Uint8("type", uint8(unitType)))
+			return 0, err
+		}
+		typeUnits, err := s.memoryRepo.ListByContentIDs(ctx, userID, unitType, contentIDs)
+		if err != nil {
+			log.Error("Failed to list course memory units", zap.Error(err), zap.Uint("courseID", courseID), zap.Uint8("type", uint8(unitType)))
+			return 0, err
+		}
+		units = append(units, typeUnits...)
+	}
+
+	now := time.Now()
+	before := snapshotMemoryUnits(units)
+	for _, unit := range units {
+		unit.Reset(now)
+	}
+	if err := s.saveAdjustments(ctx, entity.ReviewActionReset, before, units, now); err != nil {
+		return 0, err
+	}
+
+	log.Info("Forgot course memory units",
+		zap.Uint32("userID", uint32(userID)),
+		zap.Uint("courseID", courseID),
+		zap.Int("count", len(units)),
+	)
+	return len(units), nil
+}
+
+// adjustMemoryUnit 人工调整当前用户的记忆单元，保存调整后的记忆单元并记录到复习日志
+func (s *MemoryServiceImpl) adjustMemoryUnit(ctx context.Context, memoryUnitID uint32, action entity.ReviewAction, adjust func(unit *entity.MemoryUnit, settings *entity.UserSettings, now time.Time) error) (*entity.MemoryUnit, error) {
+	log := logger.GetLogger(ctx)
+
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	unit, err := s.memoryRepo.GetByID(ctx, userID, memoryUnitID)
+	if err != nil {
+		log.Error("Failed to get memory unit", zap.Error(err), zap.Uint32("unitID", memoryUnitID))
+		return nil, err
+	}
+	if unit == nil {
+		return nil, domainErrors.ErrNotFound
+	}
+
+	settings, err := s.settingsRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		log.Error("Failed to get user settings", zap.Error(err), zap.Uint32("userID", uint32(userID)))
+		return nil, err
+	}
+
+	now := time.Now()
+	before := snapshotMemoryUnits([]*entity.MemoryUnit{unit})
+	if err := adjust(unit, settings, now); err != nil {
+		return nil, err
+	}
+	if err := s.saveAdjustments(ctx, action, before, []*entity.MemoryUnit{unit}, now); err != nil {
+		return nil, err
+	}
+	return unit, nil
+}
+
+// saveAdjustments 在事务中保存调整后的记忆单元，并为每个记忆单元记录一条调整记录
+// before 为与 units 一一对应的调整前快照
+func (s *MemoryServiceImpl) saveAdjustments(ctx context.Context, action entity.ReviewAction, before, units []*entity.MemoryUnit, now time.Time) error {
+	if len(units) == 0 {
+		return nil
+	}
+
+	err := s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		for i, unit := range units {
+			unit.UpdatedAt = now
+			if err := s.memoryRepo.Update(ctx, unit); err != nil {
+				return err
+			}
+			if err := s.reviewRepo.Create(ctx, entity.NewMemoryAdjustment(action, before[i], unit)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		logger.GetLogger(ctx).Error("Failed to save memory unit adjustments", zap.Error(err), zap.Uint8("action", uint8(action)))
+		return err
+	}
+	return nil
+}
+
+// snapshotMemoryUnits 复制记忆单元，用于记录调整前的状态
+func snapshotMemoryUnits(units []*entity.MemoryUnit) []*entity.MemoryUnit {
+	snapshots := make([]*entity.MemoryUnit, len(units))
+	for i, unit := range units {
+		snapshot := *unit
+		snapshots[i] = &snapshot
+	}
+	return snapshots
+}
+
+// ListHardContents 获取被最多用户标记为顽固项的学习内容
+// 统计范围为全部用户，供教师发现系统性难以记住的内容；自定义词条只属于创建它的用户，不计入统计
+func (s *MemoryServiceImpl) ListHardContents(ctx context.Context, types []entity.MemoryUnitType, limit int) ([]*HardContent, error) {
+	log := logger.GetLogger(ctx)
+
+	for _, t := range types {
+		if !t.IsValid() {
+			return nil, domainErrors.ErrInvalidMemoryUnitType
+		}
+	}
+	if len(types) == 0 {
+		types = entity.MemoryUnitTypes
+	}
+	shared := make([]entity.MemoryUnitType, 0, len(types))
+	for _, t := range types {
+		if t != entity.MemoryUnitTypeCustomWord {
+			shared = append(shared, t)
+		}
+	}
+	if len(shared) == 0 {
+		return []*HardContent{}, nil
+	}
+
+	stats, err := s.memoryRepo.ListLeechContents(ctx, shared, limit)
+	if err != nil {
+		log.Error("Failed to list leech contents", zap.Error(err))
+		return nil, err
+	}
+
+	// 按类型批量查询展示文本
+	ids := make(map[entity.MemoryUnitType][]uint32)
+	for _, stat := range stats {
+		ids[stat.Type] = append(ids[stat.Type], stat.ContentID)
+	}
+	texts := make(map[entity.MemoryUnitType]map[uint32]string, len(ids))
+	for unitType, contentIDs := range ids {
+		contents, ok := s.contents[unitType]
+		if !ok {
+			continue
+		}
+		// 统计不含用户自有的内容，不限定用户
+		texts[unitType], err = contents.GetTexts(ctx, 0, contentIDs)
+		if err != nil {
+			log.Error("Failed to get content texts", zap.Error(err), zap.Uint8("type", uint8(unitType)))
+			return nil, err
+		}
+	}
+
+	contents := make([]*HardContent, len(stats))
+	for i, stat := range stats {
+		contents[i] = &HardContent{LeechContentStats: *stat, Text: texts[stat.Type][stat.ContentID]}
+	}
+	return contents, nil
+}
+
 var _ MemoryService = (*MemoryServiceImpl)(nil)
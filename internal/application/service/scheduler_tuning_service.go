@@ -0,0 +1,94 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/lazyjean/sla2/internal/application/dto"
+	"github.com/lazyjean/sla2/internal/domain/entity"
+	"github.com/lazyjean/sla2/internal/domain/repository"
+	domainService "github.com/lazyjean/sla2/internal/domain/service"
+)
+
+// SM2SchedulerName SuperMemo-2 算法仅用于回放比较，不能作为用户的调度算法
+const SM2SchedulerName = "sm2"
+
+// SchedulerTuningService 复习调度调优服务
+// 回放复习记录，比较各调度算法预测回忆的准确度和带来的复习量，并按用户拟合 FSRS 模型权重
+type SchedulerTuningService struct {
+	reviewRepo   repository.MemoryReviewRepository
+	settingsRepo repository.UserSettingsRepository
+	opts         domainService.SchedulerOptions
+}
+
+// NewSchedulerTuningService 创建复习调度调优服务实例，opts 为部署配置的调度参数
+func NewSchedulerTuningService(
+	reviewRepo repository.MemoryReviewRepository,
+	settingsRepo repository.UserSettingsRepository,
+	opts domainService.SchedulerOptions,
+) *SchedulerTuningService {
+	return &SchedulerTuningService{
+		reviewRepo:   reviewRepo,
+		settingsRepo: settingsRepo,
+		opts:         opts,
+	}
+}
+
+// ListUserIDs 获取自 since 起有复习记录的用户
+func (s *SchedulerTuningService) ListUserIDs(ctx context.Context, since time.Time) ([]uint32, error) {
+	return s.reviewRepo.ListReviewedUserIDs(ctx, since)
+}
+
+// LoadReviews 获取用户在 [since, until) 内的复习记录
+func (s *SchedulerTuningService) LoadReviews(ctx context.Context, userID uint32, since, until time.Time) ([]*entity.MemoryReview, error) {
+	return s.reviewRepo.ListByUserIDAndTimeRange(ctx, userID, since, until)
+}
+
+// Evaluate 用经验阶梯、FSRS（部署配置的参数）和 SM-2 算法回放复习记录
+func (s *SchedulerTuningService) Evaluate(reviews []*entity.MemoryReview) []*dto.SchedulerEvaluation {
+	histories := domainService.GroupReviewHistories(reviews)
+	models := []struct {
+		name    string
+		factory domainService.ReplayModelFactory
+	}{
+		{string(domainService.SchedulerHeuristic), domainService.SchedulerReplay(domainService.NewHeuristicScheduler())},
+		{string(domainService.SchedulerFSRS), domainService.SchedulerReplay(domainService.NewFSRSScheduler(s.opts))},
+		{SM2SchedulerName, domainService.SM2Replay()},
+	}
+
+	evaluations := make([]*dto.SchedulerEvaluation, len(models))
+	for i, model := range models {
+		evaluations[i] = &dto.SchedulerEvaluation{
+			Name:    model.name,
+			Metrics: domainService.Replay(histories, model.factory),
+		}
+	}
+	return evaluations
+}
+
+// FitUserWeights 按用户的复习记录拟合 FSRS 模型权重，复习记录不足时返回 ErrInsufficientReviewHistory
+func (s *SchedulerTuningService) FitUserWeights(userID uint32, reviews []*entity.MemoryReview, iterations int) (*dto.SchedulerFit, error) {
+	histories := domainService.GroupReviewHistories(reviews)
+	before := domainService.Replay(histories, domainService.SchedulerReplay(domainService.NewFSRSScheduler(s.opts)))
+
+	weights, after, err := domainService.FitFSRSWeights(histories, s.opts, iterations)
+	if err != nil {
+		return nil, err
+	}
+	return &dto.SchedulerFit{
+		UserID:  userID,
+		Weights: weights,
+		Before:  before,
+		After:   after,
+	}, nil
+}
+
+// SaveUserWeights 保存用户的 FSRS 模型权重，用户使用 FSRS 调度时生效
+func (s *SchedulerTuningService) SaveUserWeights(ctx context.Context, userID entity.UID, weights []float64) error {
+	settings, err := s.settingsRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	settings.SchedulerWeights = weights
+	return s.settingsRepo.Save(ctx, settings)
+}
@@ -0,0 +1,134 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/lazyjean/sla2/internal/application/dto"
+	"github.com/lazyjean/sla2/internal/domain/entity"
+	domainErrors "github.com/lazyjean/sla2/internal/domain/errors"
+	"github.com/lazyjean/sla2/internal/domain/repository"
+	domainService "github.com/lazyjean/sla2/internal/domain/service"
+	"github.com/lazyjean/sla2/pkg/logger"
+	"go.uber.org/zap"
+)
+
+const (
+	DefaultStudyActivityDays = 365 // 热力图默认展示最近一年
+	MaxStudyActivityDays     = 366 // 热力图日期范围上限
+)
+
+// StudyActivityService 学习活动服务
+// 基于复习记录保留期内的每日学习统计计算连续学习天数和学习热力图，每日统计按用户缓存
+type StudyActivityService struct {
+	statsService *domainService.MemoryStatsService
+	settingsRepo repository.UserSettingsRepository
+	cache        repository.StudyActivityCache
+}
+
+// NewStudyActivityService 创建学习活动服务实例
+func NewStudyActivityService(
+	statsService *domainService.MemoryStatsService,
+	settingsRepo repository.UserSettingsRepository,
+	cache repository.StudyActivityCache,
+) *StudyActivityService {
+	return &StudyActivityService{
+		statsService: statsService,
+		settingsRepo: settingsRepo,
+		cache:        cache,
+	}
+}
+
+// GetStudyActivity 获取当前用户的连续学习天数和指定日期范围的学习热力图
+// 未指定结束日期时截止到今天，未指定开始日期时展示到结束日期为止的一年
+func (s *StudyActivityService) GetStudyActivity(ctx context.Context, req *dto.StudyActivityRequest) (*dto.StudyActivity, error) {
+	log := logger.GetLogger(ctx)
+
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	settings, err := s.settingsRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		log.Error("Failed to get user settings", zap.Error(err), zap.Uint32("userID", uint32(userID)))
+		return nil, err
+	}
+
+	now := time.Now()
+	today := settings.StartOfDay(now)
+	start, end, err := activityDateRange(req, settings, today)
+	if err != nil {
+		return nil, err
+	}
+
+	activity, err := s.dailyActivity(ctx, userID, settings, now)
+	if err != nil {
+		return nil, err
+	}
+
+	days := make([]*entity.DailyStat, 0, len(activity.Days))
+	for _, day := range activity.Days {
+		if !day.Date.Before(start) && !day.Date.After(end) {
+			days = append(days, day)
+		}
+	}
+	return &dto.StudyActivity{
+		Streak:     domainService.CalculateStreak(activity.Days, today, settings.StreakFreezeDays),
+		FreezeDays: settings.StreakFreezeDays,
+		Days:       days,
+	}, nil
+}
+
+// dailyActivity 获取复习记录保留期内的每日学习统计，缓存的统计与当前时区或学习日开始时间不一致时重新计算
+func (s *StudyActivityService) dailyActivity(ctx context.Context, userID entity.UID, settings *entity.UserSettings, now time.Time) (*repository.StudyActivity, error) {
+	log := logger.GetLogger(ctx)
+
+	if cached, ok := s.cache.Get(ctx, userID); ok && cached.TimeZone == settings.TimeZone && cached.DayStartHour == settings.DayStartHour {
+		return cached, nil
+	}
+
+	days, err := s.statsService.CalculateDailyStats(ctx, uint32(userID), now.Add(-entity.MemoryReviewRetention), now)
+	if err != nil {
+		log.Error("Failed to calculate daily stats", zap.Error(err), zap.Uint32("userID", uint32(userID)))
+		return nil, err
+	}
+	activity := &repository.StudyActivity{
+		TimeZone:     settings.TimeZone,
+		DayStartHour: settings.DayStartHour,
+		Days:         days,
+	}
+	if err := s.cache.Set(ctx, userID, activity); err != nil {
+		log.Warn("Failed to cache study activity", zap.Error(err), zap.Uint32("userID", uint32(userID)))
+	}
+	return activity, nil
+}
+
+// activityDateRange 解析热力图的日期范围，返回开始和结束学习日的开始时间
+func activityDateRange(req *dto.StudyActivityRequest, settings *entity.UserSettings, today time.Time) (start, end time.Time, err error) {
+	end = today
+	if req.EndDate != "" {
+		if end, err = parseLearningDay(req.EndDate, settings); err != nil {
+			return
+		}
+	}
+	start = end.AddDate(0, 0, -(DefaultStudyActivityDays - 1))
+	if req.StartDate != "" {
+		if start, err = parseLearningDay(req.StartDate, settings); err != nil {
+			return
+		}
+	}
+	if start.After(end) || start.AddDate(0, 0, MaxStudyActivityDays-1).Before(end) {
+		err = domainErrors.ErrInvalidDateRange
+	}
+	return
+}
+
+// parseLearningDay 将用户时区的日期（YYYY-MM-DD）转换为该学习日的开始时间
+func parseLearningDay(date string, settings *entity.UserSettings) (time.Time, error) {
+	day, err := time.Parse(time.DateOnly, date)
+	if err != nil {
+		return time.Time{}, domainErrors.ErrInvalidDateRange
+	}
+	return time.Date(day.Year(), day.Month(), day.Day(), int(settings.DayStartHour), 0, 0, 0, settings.Location()), nil
+}
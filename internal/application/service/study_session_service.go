@@ -0,0 +1,334 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/lazyjean/sla2/internal/application/dto"
+	"github.com/lazyjean/sla2/internal/domain/entity"
+	domainErrors "github.com/lazyjean/sla2/internal/domain/errors"
+	"github.com/lazyjean/sla2/internal/domain/repository"
+	"github.com/lazyjean/sla2/pkg/logger"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// StudySessionService 学习会话服务
+// 按每日学习目标从到期的记忆单元和尚未学习的内容中组装学习队列
+type StudySessionService struct {
+	memoryRepo   repository.MemoryUnitRepository
+	reviewRepo   repository.MemoryReviewRepository
+	sessionRepo  repository.StudySessionRepository
+	contents     repository.ContentRepositories
+	courseRepo   repository.CourseRepository
+	settingsRepo repository.UserSettingsRepository
+	notebookRepo repository.WordNotebookRepository
+}
+
+// NewStudySessionService 创建学习会话服务实例
+func NewStudySessionService(
+	memoryRepo repository.MemoryUnitRepository,
+	reviewRepo repository.MemoryReviewRepository,
+	sessionRepo repository.StudySessionRepository,
+	contents repository.ContentRepositories,
+	courseRepo repository.CourseRepository,
+	settingsRepo repository.UserSettingsRepository,
+	notebookRepo repository.WordNotebookRepository,
+) *StudySessionService {
+	return &StudySessionService{
+		memoryRepo:   memoryRepo,
+		reviewRepo:   reviewRepo,
+		sessionRepo:  sessionRepo,
+		contents:     contents,
+		courseRepo:   courseRepo,
+		settingsRepo: settingsRepo,
+		notebookRepo: notebookRepo,
+	}
+}
+
+// StartStudySession 开始学习会话
+// 先按到期时间选取不超过今日剩余复习目标的复习项，再选取不超过今日剩余新内容目标的新学习项，
+// 新学习项均匀穿插在复习项之间；指定生词本时复习项和新学习项都只包含生词本中的单词和自定义词条
+func (s *StudySessionService) StartStudySession(ctx context.Context, req *dto.StartStudySessionRequest) (*entity.StudySession, []*entity.StudyItem, error) {
+	log := logger.GetLogger(ctx)
+
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	settings, err := s.settingsRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		log.Error("Failed to get user settings", zap.Error(err), zap.Uint32("userID", uint32(userID)))
+		return nil, nil, err
+	}
+
+	// 未指定类型时使用用户偏好的学习内容类型
+	types := req.Types
+	if len(types) == 0 {
+		types = settings.PreferredUnitTypes
+	}
+	if len(types) == 0 {
+		types = entity.MemoryUnitTypes
+	}
+
+	filter := repository.NewContentFilter{Level: req.Level, Tags: req.Tags}
+	if req.CourseID != 0 {
+		course, err := s.courseRepo.GetByID(ctx, req.CourseID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, nil, domainErrors.ErrNotFound
+			}
+			return nil, nil, err
+		}
+		if len(course.Tags) == 0 {
+			return nil, nil, domainErrors.ErrCourseHasNoTags
+		}
+		filter.CourseTags = course.Tags
+	}
+	if req.NotebookID != 0 {
+		notebook, err := s.notebookRepo.GetNotebook(ctx, userID, req.NotebookID)
+		if err != nil {
+			log.Error("Failed to get word notebook", zap.Error(err), zap.Uint32("notebookID", uint32(req.NotebookID)))
+			return nil, nil, err
+		}
+		if notebook == nil {
+			return nil, nil, domainErrors.ErrNotebookNotFound
+		}
+		filter.NotebookID = notebook.ID
+		if types, err = notebookUnitTypes(types, len(req.Types) > 0); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	now := time.Now()
+	reviewedToday, learnedToday, err := s.countToday(ctx, userID, settings.StartOfDay(now), now)
+	if err != nil {
+		log.Error("Failed to count today's study progress", zap.Error(err), zap.Uint32("userID", uint32(userID)))
+		return nil, nil, err
+	}
+
+	reviewLimit := remainingGoal(int(settings.DailyReviewGoal), reviewedToday)
+	newLimit := remainingGoal(int(settings.DailyNewGoal), learnedToday)
+	if req.NewCount != nil && int(*req.NewCount) < newLimit {
+		newLimit = int(*req.NewCount)
+	}
+
+	due, err := s.listDueItems(ctx, userID, types, filter.NotebookID, now, reviewLimit)
+	if err != nil {
+		log.Error("Failed to list due items", zap.Error(err), zap.Uint32("userID", uint32(userID)))
+		return nil, nil, err
+	}
+
+	fresh, err := s.listNewItems(ctx, userID, types, filter, newLimit)
+	if err != nil {
+		log.Error("Failed to list new items", zap.Error(err), zap.Uint32("userID", uint32(userID)))
+		return nil, nil, err
+	}
+
+	session := entity.NewStudySession(userID, uint32(len(due)), uint32(len(fresh)))
+	if err := s.sessionRepo.Create(ctx, session); err != nil {
+		log.Error("Failed to create study session", zap.Error(err), zap.Uint32("userID", uint32(userID)))
+		return nil, nil, err
+	}
+
+	log.Info("Started study session",
+		zap.Uint32("sessionID", uint32(session.ID)),
+		zap.Uint32("userID", uint32(userID)),
+		zap.Int("reviewCount", len(due)),
+		zap.Int("newCount", len(fresh)),
+	)
+
+	return session, entity.BuildStudyQueue(due, fresh), nil
+}
+
+// countToday 统计用户当前学习日已复习的记忆单元数量和新学习的记忆单元数量
+func (s *StudySessionService) countToday(ctx context.Context, userID entity.UID, startOfDay, now time.Time) (int, int, error) {
+	learned, err := s.memoryRepo.CountCreatedSince(ctx, userID, startOfDay)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	reviews, err := s.reviewRepo.ListByUserIDAndTimeRange(ctx, uint32(userID), startOfDay, now)
+	if err != nil {
+		return 0, 0, err
+	}
+	reviewedUnits := make(map[uint32]struct{})
+	for _, review := range reviews {
+		if !review.IsSkip() {
+			reviewedUnits[review.MemoryUnitID] = struct{}{}
+		}
+	}
+
+	// 新学习的记忆单元在首次复习时创建，不计入复习数量
+	reviewed := len(reviewedUnits) - int(learned)
+	if reviewed < 0 {
+		reviewed = 0
+	}
+	return reviewed, int(learned), nil
+}
+
+// listDueItems 获取到期的复习项，按到期时间升序
+// 内容已删除的记忆单元不计入数量，继续向后查询直到凑满 limit 个复习项或没有更多到期的记忆单元
+func (s *StudySessionService) listDueItems(ctx context.Context, userID entity.UID, types []entity.MemoryUnitType, notebookID entity.WordNotebookID, now time.Time, limit int) ([]*entity.StudyItem, error) {
+	if limit <= 0 {
+		return nil, nil
+	}
+
+	listUnits := func(offset, size int) ([]*entity.MemoryUnit, error) {
+		return s.memoryRepo.ListNeedReviewByTypes(ctx, userID, types, now, uint32(offset), size)
+	}
+	if notebookID != 0 {
+		due, err := s.listNotebookDueUnits(ctx, userID, types, notebookID, now)
+		if err != nil {
+			return nil, err
+		}
+		listUnits = func(offset, size int) ([]*entity.MemoryUnit, error) {
+			if offset >= len(due) {
+				return nil, nil
+			}
+			return due[offset:min(offset+size, len(due))], nil
+		}
+	}
+
+	items := make([]*entity.StudyItem, 0, limit)
+	for offset := 0; len(items) < limit; {
+		size := limit - len(items)
+		units, err := listUnits(offset, size)
+		if err != nil {
+			return nil, err
+		}
+		batch, err := s.toDueItems(ctx, userID, units)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, batch...)
+		if len(units) < size {
+			break
+		}
+		offset += len(units)
+	}
+	return items, nil
+}
+
+// toDueItems 按类型批量获取内容文本，将记忆单元转换为复习项，跳过内容已删除的记忆单元
+func (s *StudySessionService) toDueItems(ctx context.Context, userID entity.UID, units []*entity.MemoryUnit) ([]*entity.StudyItem, error) {
+	contentIDs := make(map[entity.MemoryUnitType][]uint32)
+	for _, unit := range units {
+		contentIDs[unit.Type] = append(contentIDs[unit.Type], unit.ContentID)
+	}
+	texts := make(map[entity.MemoryUnitType]map[uint32]string, len(contentIDs))
+	for unitType, ids := range contentIDs {
+		contents, ok := s.contents[unitType]
+		if !ok {
+			continue
+		}
+		var err error
+		if texts[unitType], err = contents.GetTexts(ctx, userID, ids); err != nil {
+			return nil, err
+		}
+	}
+
+	items := make([]*entity.StudyItem, 0, len(units))
+	for _, unit := range units {
+		text, ok := texts[unit.Type][unit.ContentID]
+		if !ok {
+			continue // 内容已删除
+		}
+		items = append(items, &entity.StudyItem{Type: unit.Type, ContentID: unit.ContentID, Text: text, MemoryUnit: unit})
+	}
+	return items, nil
+}
+
+// listNotebookDueUnits 获取生词本中到期且未暂停的记忆单元，按到期时间升序
+func (s *StudySessionService) listNotebookDueUnits(ctx context.Context, userID entity.UID, types []entity.MemoryUnitType, notebookID entity.WordNotebookID, now time.Time) ([]*entity.MemoryUnit, error) {
+	filter := repository.NewContentFilter{NotebookID: notebookID}
+	var due []*entity.MemoryUnit
+	for _, unitType := range types {
+		contents, ok := s.contents[unitType]
+		if !ok {
+			continue
+		}
+		contentIDs, err := contents.ListIDs(ctx, filter)
+		if err != nil {
+			return nil, err
+		}
+		units, err := s.memoryRepo.ListByContentIDs(ctx, userID, unitType, contentIDs)
+		if err != nil {
+			return nil, err
+		}
+		for _, unit := range units {
+			if !unit.Suspended && !unit.NextReviewAt.After(now) {
+				due = append(due, unit)
+			}
+		}
+	}
+
+	sort.SliceStable(due, func(i, j int) bool {
+		return due[i].NextReviewAt.Before(due[j].NextReviewAt)
+	})
+	return due, nil
+}
+
+// notebookUnitTypes 生词本中可学习的内容类型：单词和自定义词条
+// 请求指定的类型中没有这两种时返回 ErrInvalidNotebookUnitTypes，用户偏好的类型中没有时两者都包含
+func notebookUnitTypes(types []entity.MemoryUnitType, explicit bool) ([]entity.MemoryUnitType, error) {
+	var notebookTypes []entity.MemoryUnitType
+	for _, unitType := range types {
+		if unitType == entity.MemoryUnitTypeWord || unitType == entity.MemoryUnitTypeCustomWord {
+			notebookTypes = append(notebookTypes, unitType)
+		}
+	}
+	if len(notebookTypes) == 0 {
+		if explicit {
+			return nil, domainErrors.ErrInvalidNotebookUnitTypes
+		}
+		return []entity.MemoryUnitType{entity.MemoryUnitTypeWord, entity.MemoryUnitTypeCustomWord}, nil
+	}
+	return notebookTypes, nil
+}
+
+// listNewItems 获取新学习项，多种类型时交替选取
+func (s *StudySessionService) listNewItems(ctx context.Context, userID entity.UID, types []entity.MemoryUnitType, filter repository.NewContentFilter, limit int) ([]*entity.StudyItem, error) {
+	if limit <= 0 {
+		return nil, nil
+	}
+
+	var candidates [][]*entity.StudyItem
+	for _, unitType := range types {
+		contents, ok := s.contents[unitType]
+		if !ok {
+			continue
+		}
+		items, err := contents.ListNotLearned(ctx, userID, filter, limit)
+		if err != nil {
+			return nil, err
+		}
+		candidates = append(candidates, items)
+	}
+
+	fresh := make([]*entity.StudyItem, 0, limit)
+	for i := 0; len(fresh) < limit; i++ {
+		picked := false
+		for _, items := range candidates {
+			if i < len(items) && len(fresh) < limit {
+				fresh = append(fresh, items[i])
+				picked = true
+			}
+		}
+		if !picked {
+			break
+		}
+	}
+	return fresh, nil
+}
+
+// remainingGoal 计算今日剩余的学习目标
+func remainingGoal(goal, done int) int {
+	if done >= goal {
+		return 0
+	}
+	return goal - done
+}
@@ -0,0 +1,95 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lazyjean/sla2/internal/domain/entity"
+	domainErrors "github.com/lazyjean/sla2/internal/domain/errors"
+	"github.com/lazyjean/sla2/internal/domain/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockMemoryUnitRepository 是 MemoryUnitRepository 的模拟实现，只实现测试用到的方法
+type MockMemoryUnitRepository struct {
+	mock.Mock
+	repository.MemoryUnitRepository
+}
+
+func (m *MockMemoryUnitRepository) ListNeedReviewByTypes(ctx context.Context, userID entity.UID, types []entity.MemoryUnitType, before time.Time, offset uint32, limit int) ([]*entity.MemoryUnit, error) {
+	args := m.Called(ctx, userID, types, before, offset, limit)
+	return args.Get(0).([]*entity.MemoryUnit), args.Error(1)
+}
+
+// MockContentRepository 是 ContentRepository 的模拟实现，只实现测试用到的方法
+type MockContentRepository struct {
+	mock.Mock
+	repository.ContentRepository
+}
+
+func (m *MockContentRepository) GetTexts(ctx context.Context, userID entity.UID, ids []uint32) (map[uint32]string, error) {
+	args := m.Called(ctx, userID, ids)
+	return args.Get(0).(map[uint32]string), args.Error(1)
+}
+
+func TestStudySessionService_ListDueItemsSkipsDeletedContent(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	types := []entity.MemoryUnitType{entity.MemoryUnitTypeWord}
+	unit := func(id, contentID uint32) *entity.MemoryUnit {
+		return &entity.MemoryUnit{ID: entity.MemoryUnitID(id), UserID: 1, Type: entity.MemoryUnitTypeWord, ContentID: contentID}
+	}
+	memoryRepo := new(MockMemoryUnitRepository)
+	wordContents := new(MockContentRepository)
+	service := NewStudySessionService(memoryRepo, nil, nil, repository.ContentRepositories{entity.MemoryUnitTypeWord: wordContents}, nil, nil, nil)
+
+	// 第一页中单词 12 已删除，继续查询下一页补足数量
+	memoryRepo.On("ListNeedReviewByTypes", ctx, entity.UID(1), types, now, uint32(0), 3).
+		Return([]*entity.MemoryUnit{unit(1, 11), unit(2, 12), unit(3, 13)}, nil).Once()
+	wordContents.On("GetTexts", ctx, entity.UID(1), []uint32{11, 12, 13}).
+		Return(map[uint32]string{11: "apple", 13: "cherry"}, nil).Once()
+	memoryRepo.On("ListNeedReviewByTypes", ctx, entity.UID(1), types, now, uint32(3), 1).
+		Return([]*entity.MemoryUnit{unit(4, 14)}, nil).Once()
+	wordContents.On("GetTexts", ctx, entity.UID(1), []uint32{14}).
+		Return(map[uint32]string{14: "date"}, nil).Once()
+
+	items, err := service.listDueItems(ctx, 1, types, 0, now, 3)
+	require.NoError(t, err)
+	texts := make([]string, len(items))
+	for i, item := range items {
+		texts[i] = item.Text
+	}
+	assert.Equal(t, []string{"apple", "cherry", "date"}, texts)
+	memoryRepo.AssertExpectations(t)
+	wordContents.AssertExpectations(t)
+
+	// 没有更多到期的记忆单元时返回已有的复习项
+	memoryRepo.On("ListNeedReviewByTypes", ctx, entity.UID(1), types, now, uint32(0), 3).
+		Return([]*entity.MemoryUnit{unit(2, 12)}, nil).Once()
+	wordContents.On("GetTexts", ctx, entity.UID(1), []uint32{12}).
+		Return(map[uint32]string{}, nil).Once()
+	items, err = service.listDueItems(ctx, 1, types, 0, now, 3)
+	require.NoError(t, err)
+	assert.Empty(t, items)
+	memoryRepo.AssertExpectations(t)
+}
+
+func TestNotebookUnitTypes(t *testing.T) {
+	both := []entity.MemoryUnitType{entity.MemoryUnitTypeWord, entity.MemoryUnitTypeCustomWord}
+
+	types, err := notebookUnitTypes([]entity.MemoryUnitType{entity.MemoryUnitTypeHanChar, entity.MemoryUnitTypeCustomWord}, true)
+	assert.NoError(t, err)
+	assert.Equal(t, []entity.MemoryUnitType{entity.MemoryUnitTypeCustomWord}, types)
+
+	// 用户偏好中没有单词类型时生词本中的两种类型都学习
+	types, err = notebookUnitTypes([]entity.MemoryUnitType{entity.MemoryUnitTypeHanChar}, false)
+	assert.NoError(t, err)
+	assert.Equal(t, both, types)
+
+	// 请求明确指定的类型不能在生词本中学习时返回错误，而不是替换为单词类型
+	_, err = notebookUnitTypes([]entity.MemoryUnitType{entity.MemoryUnitTypeHanChar}, true)
+	assert.ErrorIs(t, err, domainErrors.ErrInvalidNotebookUnitTypes)
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/lazyjean/sla2/internal/domain/oauth"
 	"github.com/lazyjean/sla2/internal/domain/repository"
 	"github.com/lazyjean/sla2/internal/domain/security"
+	domainService "github.com/lazyjean/sla2/internal/domain/service"
 	"github.com/lazyjean/sla2/pkg/logger"
 	"github.com/lazyjean/sla2/pkg/utils"
 	"go.uber.org/zap"
@@ -205,6 +206,7 @@ func (s *UserService) GetLoginUser(ctx context.Context) (*dto.UserDTO, error) {
 		Nickname:  user.Nickname,
 		Avatar:    user.Avatar,
 		Status:    status,
+		Scheduler: user.Scheduler,
 		CreatedAt: user.CreatedAt,
 		UpdatedAt: user.UpdatedAt,
 	}, nil
@@ -225,6 +227,12 @@ func (s *UserService) UpdateUser(ctx context.Context, req *dto.UpdateUserRequest
 	// 更新用户信息
 	user.Nickname = req.Nickname
 	user.Avatar = req.Avatar
+	if req.Scheduler != nil {
+		if *req.Scheduler != "" && !domainService.IsValidSchedulerName(*req.Scheduler) {
+			return errors.NewError(errors.CodeInvalidArgument, "不支持的复习调度算法")
+		}
+		user.Scheduler = *req.Scheduler
+	}
 
 	// 保存更新
 	if err := s.userRepo.Update(ctx, user); err != nil {
@@ -0,0 +1,71 @@
+package service
+
+import (
+	"context"
+
+	"github.com/lazyjean/sla2/internal/application/dto"
+	"github.com/lazyjean/sla2/internal/domain/entity"
+	"github.com/lazyjean/sla2/internal/domain/repository"
+	"github.com/lazyjean/sla2/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// UserSettingsService 用户学习偏好服务
+type UserSettingsService struct {
+	settingsRepo repository.UserSettingsRepository
+}
+
+// NewUserSettingsService 创建用户学习偏好服务实例
+func NewUserSettingsService(settingsRepo repository.UserSettingsRepository) *UserSettingsService {
+	return &UserSettingsService{
+		settingsRepo: settingsRepo,
+	}
+}
+
+// GetSettings 获取当前用户的学习偏好，未设置时返回默认偏好
+func (s *UserSettingsService) GetSettings(ctx context.Context) (*entity.UserSettings, error) {
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.settingsRepo.GetByUserID(ctx, userID)
+}
+
+// UpdateSettings 更新当前用户的学习偏好
+func (s *UserSettingsService) UpdateSettings(ctx context.Context, req *dto.UpdateUserSettingsRequest) (*entity.UserSettings, error) {
+	log := logger.GetLogger(ctx)
+
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	settings, err := s.settingsRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		log.Error("Failed to get user settings", zap.Error(err), zap.Uint32("userID", uint32(userID)))
+		return nil, err
+	}
+
+	settings.DailyReviewGoal = req.DailyReviewGoal
+	settings.DailyNewGoal = req.DailyNewGoal
+	settings.DesiredRetention = req.DesiredRetention
+	settings.MaximumInterval = req.MaximumInterval
+	settings.DayStartHour = req.DayStartHour
+	settings.TimeZone = req.TimeZone
+	settings.LeechThreshold = req.LeechThreshold
+	settings.LeechAction = req.LeechAction
+	settings.StreakFreezeDays = req.StreakFreezeDays
+	settings.PreferredUnitTypes = req.PreferredUnitTypes
+	if settings.PreferredUnitTypes == nil {
+		settings.PreferredUnitTypes = []entity.MemoryUnitType{}
+	}
+	if err := settings.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := s.settingsRepo.Save(ctx, settings); err != nil {
+		log.Error("Failed to save user settings", zap.Error(err), zap.Uint32("userID", uint32(userID)))
+		return nil, err
+	}
+	return settings, nil
+}
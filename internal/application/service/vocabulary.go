@@ -3,32 +3,62 @@ package service
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/lazyjean/sla2/internal/application/dto"
 	"github.com/lazyjean/sla2/internal/domain/entity"
 	"github.com/lazyjean/sla2/internal/domain/errors"
 	"github.com/lazyjean/sla2/internal/domain/repository"
+	"github.com/lazyjean/sla2/internal/domain/security"
+	domainService "github.com/lazyjean/sla2/internal/domain/service"
 	"github.com/lazyjean/sla2/internal/domain/valueobject"
+	"github.com/lazyjean/sla2/pkg/pinyin"
+	"gorm.io/gorm"
+)
+
+const (
+	DefaultWordSearchPageSize = 20  // 单词检索默认每页数量
+	MaxWordSearchPageSize     = 100 // 单词检索每页数量上限
 )
 
 // VocabularyService 词汇服务
 type VocabularyService struct {
-	hanCharRepository repository.HanCharRepository
-	wordRepository    repository.WordRepository
+	hanCharRepository  repository.HanCharRepository
+	wordRepository     repository.WordRepository
+	strokesRepository  repository.HanCharStrokesRepository
+	formRepository     repository.WordFormRepository
+	relationRepository repository.LexicalRelationRepository
+	txManager          repository.TransactionManager
 }
 
 // NewVocabularyService 创建词汇服务实例
-func NewVocabularyService(hanCharRepository repository.HanCharRepository, wordRepository repository.WordRepository) *VocabularyService {
+func NewVocabularyService(hanCharRepository repository.HanCharRepository, wordRepository repository.WordRepository, strokesRepository repository.HanCharStrokesRepository, formRepository repository.WordFormRepository, relationRepository repository.LexicalRelationRepository, txManager repository.TransactionManager) *VocabularyService {
 	return &VocabularyService{
-		hanCharRepository: hanCharRepository,
-		wordRepository:    wordRepository,
+		hanCharRepository:  hanCharRepository,
+		wordRepository:     wordRepository,
+		strokesRepository:  strokesRepository,
+		formRepository:     formRepository,
+		relationRepository: relationRepository,
+		txManager:          txManager,
 	}
 }
 
+// checkVocabularyWritePermission 检查当前用户能否修改词库，只有管理员和内容管理员可以创建、更新和删除单词与汉字
+func checkVocabularyWritePermission(ctx context.Context) error {
+	if !HasAnyRole(ctx, security.RoleAdmin, security.RoleContentManager) {
+		return errors.ErrPermissionDenied
+	}
+	return nil
+}
+
 // CreateHanChar 创建汉字
-func (s *VocabularyService) CreateHanChar(ctx context.Context, character, pinyin string, level valueobject.WordDifficultyLevel, tags, categories, examples []string) (*entity.HanChar, error) {
+func (s *VocabularyService) CreateHanChar(ctx context.Context, req *dto.HanCharRequest) (*entity.HanChar, error) {
+	if err := checkVocabularyWritePermission(ctx); err != nil {
+		return nil, err
+	}
+
 	// 检查汉字是否已存在
-	existing, err := s.hanCharRepository.GetByCharacter(ctx, character)
+	existing, err := s.hanCharRepository.GetByCharacter(ctx, req.Character)
 	if err != nil {
 		return nil, err
 	}
@@ -37,10 +67,7 @@ func (s *VocabularyService) CreateHanChar(ctx context.Context, character, pinyin
 	}
 
 	// 创建新的汉字实体
-	hanChar := entity.NewHanChar(character, pinyin, level)
-	hanChar.Tags = tags
-	hanChar.Categories = categories
-	hanChar.Examples = examples
+	hanChar := newHanChar(req)
 
 	// 保存到数据库
 	id, err := s.hanCharRepository.Create(ctx, hanChar)
@@ -53,18 +80,31 @@ func (s *VocabularyService) CreateHanChar(ctx context.Context, character, pinyin
 }
 
 // UpdateHanChar 更新汉字
-func (s *VocabularyService) UpdateHanChar(ctx context.Context, id entity.HanCharID, character, pinyin string, level valueobject.WordDifficultyLevel, tags, categories, examples []string) (*entity.HanChar, error) {
+func (s *VocabularyService) UpdateHanChar(ctx context.Context, id entity.HanCharID, req *dto.HanCharRequest) (*entity.HanChar, error) {
+	if err := checkVocabularyWritePermission(ctx); err != nil {
+		return nil, err
+	}
+
 	// 获取现有汉字
-	hanChar, err := s.hanCharRepository.GetByID(ctx, id)
+	hanChar, err := s.getHanChar(ctx, id)
 	if err != nil {
 		return nil, err
 	}
 
+	// 修改字符时不能与其他汉字重复
+	if req.Character != hanChar.Character {
+		existing, err := s.hanCharRepository.GetByCharacter(ctx, req.Character)
+		if err != nil {
+			return nil, err
+		}
+		if existing != nil {
+			return nil, errors.ErrHanCharAlreadyExists
+		}
+	}
+
 	// 更新汉字信息
-	hanChar.Update(character, pinyin, level)
-	hanChar.Tags = tags
-	hanChar.Categories = categories
-	hanChar.Examples = examples
+	hanChar.Update(req.Character, req.Pinyin, req.Level)
+	applyHanCharDetails(hanChar, req)
 
 	// 保存更新
 	if err := s.hanCharRepository.Update(ctx, hanChar); err != nil {
@@ -76,9 +116,24 @@ func (s *VocabularyService) UpdateHanChar(ctx context.Context, id entity.HanChar
 
 // DeleteHanChar 删除汉字
 func (s *VocabularyService) DeleteHanChar(ctx context.Context, id entity.HanCharID) error {
+	if err := checkVocabularyWritePermission(ctx); err != nil {
+		return err
+	}
+	if _, err := s.getHanChar(ctx, id); err != nil {
+		return err
+	}
 	return s.hanCharRepository.Delete(ctx, id)
 }
 
+// getHanChar 按ID获取汉字，不存在时返回 ErrHanCharNotFound
+func (s *VocabularyService) getHanChar(ctx context.Context, id entity.HanCharID) (*entity.HanChar, error) {
+	hanChar, err := s.hanCharRepository.GetByID(ctx, id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.ErrHanCharNotFound
+	}
+	return hanChar, err
+}
+
 // GetHanChar 获取汉字详情
 func (s *VocabularyService) GetHanChar(ctx context.Context, id entity.HanCharID) (*entity.HanChar, error) {
 	return s.hanCharRepository.GetByID(ctx, id)
@@ -90,39 +145,90 @@ func (s *VocabularyService) GetHanCharByCharacter(ctx context.Context, character
 }
 
 // ListHanChars 获取汉字列表
-func (s *VocabularyService) ListHanChars(ctx context.Context, page, pageSize int, level valueobject.WordDifficultyLevel, tags, categories []string) ([]*entity.HanChar, int64, error) {
-	offset := (page - 1) * pageSize
+func (s *VocabularyService) ListHanChars(ctx context.Context, req dto.ListHanCharsDTO) ([]*entity.HanChar, int64, error) {
+	filters, err := listHanCharFilters(req)
+	if err != nil {
+		return nil, 0, err
+	}
+	return s.hanCharRepository.List(ctx, (req.Page-1)*req.PageSize, req.PageSize, filters)
+}
 
-	filters := make(map[string]interface{})
-	if level != valueobject.WORD_DIFFICULTY_LEVEL_UNSPECIFIED {
-		filters["level"] = level
+// SearchHanChars 按汉字或拼音搜索汉字
+func (s *VocabularyService) SearchHanChars(ctx context.Context, keyword string, req dto.ListHanCharsDTO) ([]*entity.HanChar, int64, error) {
+	keyword = strings.TrimSpace(keyword)
+	if keyword == "" {
+		return nil, 0, errors.ErrEmptySearchKeyword
 	}
-	if len(tags) > 0 {
-		filters["tags"] = tags
+	filters, err := listHanCharFilters(req)
+	if err != nil {
+		return nil, 0, err
 	}
-	if len(categories) > 0 {
-		filters["categories"] = categories
+	if req.IgnoreTone {
+		filters["ignore_tone"] = true
 	}
-
-	return s.hanCharRepository.List(ctx, offset, pageSize, filters)
+	return s.hanCharRepository.Search(ctx, keyword, (req.Page-1)*req.PageSize, req.PageSize, filters)
 }
 
-// SearchHanChars 搜索汉字
-func (s *VocabularyService) SearchHanChars(ctx context.Context, keyword string, page, pageSize int, level valueobject.WordDifficultyLevel, tags, categories []string) ([]*entity.HanChar, int64, error) {
-	offset := (page - 1) * pageSize
+// listHanCharFilters 构建汉字列表的过滤条件
+func listHanCharFilters(req dto.ListHanCharsDTO) (map[string]interface{}, error) {
+	if req.MinStrokes > 0 && req.MaxStrokes > 0 && req.MinStrokes > req.MaxStrokes {
+		return nil, errors.ErrInvalidStrokeRange
+	}
 
 	filters := make(map[string]interface{})
-	if level != valueobject.WORD_DIFFICULTY_LEVEL_UNSPECIFIED {
-		filters["level"] = level
+	if req.Level != valueobject.WORD_DIFFICULTY_LEVEL_UNSPECIFIED {
+		filters["level"] = req.Level
 	}
-	if len(tags) > 0 {
-		filters["tags"] = tags
+	if len(req.Tags) > 0 {
+		filters["tags"] = req.Tags
 	}
-	if len(categories) > 0 {
-		filters["categories"] = categories
+	if len(req.Categories) > 0 {
+		filters["categories"] = req.Categories
+	}
+	if req.Radical != "" {
+		filters["radical"] = req.Radical
+	}
+	if req.MinStrokes > 0 {
+		filters["min_strokes"] = req.MinStrokes
+	}
+	if req.MaxStrokes > 0 {
+		filters["max_strokes"] = req.MaxStrokes
+	}
+	if req.Component != "" {
+		filters["component"] = req.Component
 	}
+	if req.Pinyin != "" {
+		if !pinyin.IsPinyin(req.Pinyin) {
+			return nil, errors.ErrInvalidPinyin
+		}
+		if req.IgnoreTone || !pinyin.HasTone(req.Pinyin) {
+			filters["pinyin_toneless"] = pinyin.Toneless(req.Pinyin)
+		} else {
+			filters["pinyin"] = pinyin.Normalize(req.Pinyin)
+		}
+	}
+	return filters, nil
+}
 
-	return s.hanCharRepository.Search(ctx, keyword, offset, pageSize, filters)
+// newHanChar 根据请求创建汉字实体
+func newHanChar(req *dto.HanCharRequest) *entity.HanChar {
+	hanChar := entity.NewHanChar(req.Character, req.Pinyin, req.Level)
+	applyHanCharDetails(hanChar, req)
+	return hanChar
+}
+
+// applyHanCharDetails 设置汉字除字符、拼音和难度等级以外的信息
+func applyHanCharDetails(hanChar *entity.HanChar, req *dto.HanCharRequest) {
+	hanChar.Tags = req.Tags
+	hanChar.Categories = req.Categories
+	hanChar.Examples = req.Examples
+	hanChar.PinyinNumbered = req.PinyinNumbered
+	hanChar.Radical = req.Radical
+	hanChar.StrokeCount = req.StrokeCount
+	hanChar.Components = req.Components
+	hanChar.Traditional = req.Traditional
+	hanChar.Simplified = req.Simplified
+	hanChar.Meanings = req.Meanings
 }
 
 // GetWord 获取单词详情
@@ -148,6 +254,56 @@ func (s *VocabularyService) ListWords(ctx context.Context, page, pageSize int, l
 	return s.wordRepository.List(ctx, offset, pageSize, filters)
 }
 
+// SearchWords 在单词、释义、例句、同义词和词形中全文检索单词，按相关度降序，并返回与关键词匹配的高亮片段和词形
+func (s *VocabularyService) SearchWords(ctx context.Context, req dto.SearchWordsRequest) ([]*dto.WordSearchResult, int64, error) {
+	keyword := strings.TrimSpace(req.Keyword)
+	if keyword == "" {
+		return nil, 0, errors.ErrEmptySearchKeyword
+	}
+	page, pageSize := req.Page, req.PageSize
+	if page < 1 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = DefaultWordSearchPageSize
+	} else if pageSize > MaxWordSearchPageSize {
+		pageSize = MaxWordSearchPageSize
+	}
+
+	hits, total, err := s.wordRepository.FullTextSearch(ctx, &repository.WordSearchQuery{
+		Keyword: keyword,
+		Level:   req.Level,
+		Tags:    req.Tags,
+		Fuzzy:   req.Fuzzy,
+		Offset:  (page - 1) * pageSize,
+		Limit:   pageSize,
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// 加载命中单词的词形，标出因词形命中的单词
+	wordIDs := make([]entity.WordID, len(hits))
+	for i, hit := range hits {
+		wordIDs[i] = hit.Word.ID
+	}
+	forms, err := s.formRepository.ListByWordIDs(ctx, wordIDs)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	results := make([]*dto.WordSearchResult, len(hits))
+	for i, hit := range hits {
+		results[i] = &dto.WordSearchResult{
+			Word:        hit.Word,
+			Rank:        hit.Rank,
+			Highlights:  domainService.HighlightWord(hit.Word, keyword),
+			MatchedForm: matchWordForm(forms[hit.Word.ID], keyword),
+		}
+	}
+	return results, total, nil
+}
+
 // GetAllMetadata 获取所有标签和分类信息
 func (s *VocabularyService) GetAllMetadata(ctx context.Context) ([]string, []string, error) {
 	tags, err := s.wordRepository.GetAllTags(ctx)
@@ -165,6 +321,10 @@ func (s *VocabularyService) GetAllMetadata(ctx context.Context) ([]string, []str
 
 // CreateWord 创建单词
 func (s *VocabularyService) CreateWord(ctx context.Context, req dto.CreateWordRequest) (*entity.Word, error) {
+	if err := checkVocabularyWritePermission(ctx); err != nil {
+		return nil, err
+	}
+
 	// 检查单词是否已存在
 	existingWord, err := s.wordRepository.GetByWord(ctx, req.Text)
 	if err != nil && !errors.Is(err, errors.ErrWordNotFound) {
@@ -192,8 +352,49 @@ func (s *VocabularyService) CreateWord(ctx context.Context, req dto.CreateWordRe
 	return word, nil
 }
 
+// UpdateWord 更新单词
+func (s *VocabularyService) UpdateWord(ctx context.Context, id uint, req dto.CreateWordRequest) (*entity.Word, error) {
+	if err := checkVocabularyWritePermission(ctx); err != nil {
+		return nil, err
+	}
+
+	word, err := s.wordRepository.GetByID(ctx, entity.WordID(id))
+	if err != nil {
+		return nil, err
+	}
+
+	// 修改单词时不能与其他单词重复
+	if req.Text != word.Text {
+		existingWord, err := s.wordRepository.GetByWord(ctx, req.Text)
+		if err != nil && !errors.Is(err, errors.ErrWordNotFound) {
+			return nil, fmt.Errorf("failed to check word existence: %w", err)
+		}
+		if existingWord != nil {
+			return nil, errors.ErrWordAlreadyExists
+		}
+	}
+
+	word.Update(req.Text, req.Phonetic, req.Definitions, req.Examples, req.Tags, req.Level)
+	if err := s.wordRepository.Update(ctx, word); err != nil {
+		return nil, err
+	}
+	return word, nil
+}
+
+// DeleteWord 删除单词
+func (s *VocabularyService) DeleteWord(ctx context.Context, id uint) error {
+	if err := checkVocabularyWritePermission(ctx); err != nil {
+		return err
+	}
+	return s.wordRepository.Delete(ctx, entity.WordID(id))
+}
+
 // BatchCreateWords 批量创建单词
 func (s *VocabularyService) BatchCreateWords(ctx context.Context, words []dto.BatchCreateWordRequest) error {
+	if err := checkVocabularyWritePermission(ctx); err != nil {
+		return err
+	}
+
 	for _, word := range words {
 		// 解析难度等级
 		level := word.Level
@@ -228,24 +429,15 @@ func (s *VocabularyService) BatchCreateWords(ctx context.Context, words []dto.Ba
 }
 
 // BatchCreateHanChars 批量创建汉字
-func (s *VocabularyService) BatchCreateHanChars(ctx context.Context, hanChars []struct {
-	Character  string
-	Pinyin     string
-	Level      valueobject.WordDifficultyLevel
-	Tags       []string
-	Categories []string
-	Examples   []string
-}) ([]uint, error) {
+func (s *VocabularyService) BatchCreateHanChars(ctx context.Context, hanChars []*dto.HanCharRequest) ([]uint, error) {
+	if err := checkVocabularyWritePermission(ctx); err != nil {
+		return nil, err
+	}
+
 	var ids []uint
 	for _, hanChar := range hanChars {
-		// 创建新的汉字实体
-		newHanChar := entity.NewHanChar(hanChar.Character, hanChar.Pinyin, hanChar.Level)
-		newHanChar.Tags = hanChar.Tags
-		newHanChar.Categories = hanChar.Categories
-		newHanChar.Examples = hanChar.Examples
-
 		// 保存到数据库
-		id, err := s.hanCharRepository.Create(ctx, newHanChar)
+		id, err := s.hanCharRepository.Create(ctx, newHanChar(hanChar))
 		if err != nil {
 			return nil, err
 		}
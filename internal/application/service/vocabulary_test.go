@@ -0,0 +1,136 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lazyjean/sla2/internal/application/dto"
+	"github.com/lazyjean/sla2/internal/domain/entity"
+	domainErrors "github.com/lazyjean/sla2/internal/domain/errors"
+	"github.com/lazyjean/sla2/internal/domain/repository"
+	"github.com/lazyjean/sla2/internal/domain/security"
+	"github.com/lazyjean/sla2/internal/domain/valueobject"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockWordRepository 是 WordRepository 的模拟实现，只实现测试用到的方法
+type MockWordRepository struct {
+	mock.Mock
+	repository.WordRepository
+}
+
+func (m *MockWordRepository) GetByID(ctx context.Context, id entity.WordID) (*entity.Word, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Word), args.Error(1)
+}
+
+func (m *MockWordRepository) GetByWord(ctx context.Context, word string) (*entity.Word, error) {
+	args := m.Called(ctx, word)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Word), args.Error(1)
+}
+
+func (m *MockWordRepository) Update(ctx context.Context, word *entity.Word) error {
+	args := m.Called(ctx, word)
+	return args.Error(0)
+}
+
+func (m *MockWordRepository) Delete(ctx context.Context, id entity.WordID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+// stubTxKey 标记 context 处于 stubTransactionManager 开启的事务中
+type stubTxKey struct{}
+
+// stubTransactionManager 不连接数据库的事务管理器，在标记过的 context 中执行 fn
+type stubTransactionManager struct{}
+
+func (stubTransactionManager) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(context.WithValue(ctx, stubTxKey{}, true))
+}
+
+// inTransaction 匹配 stubTransactionManager 事务中的 context，用于断言写操作在事务中执行
+var inTransaction = mock.MatchedBy(func(ctx context.Context) bool {
+	return ctx.Value(stubTxKey{}) != nil
+})
+
+// TestVocabularyService_WritePermission 测试只有管理员和内容管理员可以修改词库
+func TestVocabularyService_WritePermission(t *testing.T) {
+	mockRepo := new(MockWordRepository)
+	service := NewVocabularyService(nil, mockRepo, nil, nil, nil, nil)
+	userCtx := WithRoles(WithUserID(context.Background(), 1), []string{})
+	managerCtx := WithRoles(WithUserID(context.Background(), 2), []string{security.RoleContentManager})
+
+	t.Run("普通用户无法修改词库", func(t *testing.T) {
+		_, err := service.CreateWord(userCtx, dto.CreateWordRequest{Text: "apple"})
+		assert.ErrorIs(t, err, domainErrors.ErrPermissionDenied)
+		_, err = service.UpdateWord(userCtx, 1, dto.CreateWordRequest{Text: "apple"})
+		assert.ErrorIs(t, err, domainErrors.ErrPermissionDenied)
+		assert.ErrorIs(t, service.DeleteWord(userCtx, 1), domainErrors.ErrPermissionDenied)
+		assert.ErrorIs(t, service.BatchCreateWords(userCtx, nil), domainErrors.ErrPermissionDenied)
+
+		_, err = service.CreateHanChar(userCtx, &dto.HanCharRequest{Character: "山"})
+		assert.ErrorIs(t, err, domainErrors.ErrPermissionDenied)
+		_, err = service.UpdateHanChar(userCtx, 1, &dto.HanCharRequest{Character: "山"})
+		assert.ErrorIs(t, err, domainErrors.ErrPermissionDenied)
+		assert.ErrorIs(t, service.DeleteHanChar(userCtx, 1), domainErrors.ErrPermissionDenied)
+		_, err = service.BatchCreateHanChars(userCtx, nil)
+		assert.ErrorIs(t, err, domainErrors.ErrPermissionDenied)
+		_, err = service.ImportHanCharStrokes(userCtx, nil)
+		assert.ErrorIs(t, err, domainErrors.ErrPermissionDenied)
+		_, err = service.ImportWordForms(userCtx, &dto.ImportWordFormsRequest{})
+		assert.ErrorIs(t, err, domainErrors.ErrPermissionDenied)
+		_, err = service.CreateWordRelation(userCtx, 1, 2, entity.LexicalRelationTypeSynonym, "")
+		assert.ErrorIs(t, err, domainErrors.ErrPermissionDenied)
+		assert.ErrorIs(t, service.DeleteWordRelation(userCtx, 1), domainErrors.ErrPermissionDenied)
+		_, err = service.CreateHanCharRelation(userCtx, 1, 2, entity.LexicalRelationTypeConfusable, "")
+		assert.ErrorIs(t, err, domainErrors.ErrPermissionDenied)
+		assert.ErrorIs(t, service.DeleteHanCharRelation(userCtx, 1), domainErrors.ErrPermissionDenied)
+		_, err = service.ImportRelations(userCtx, &dto.ImportRelationsRequest{})
+		assert.ErrorIs(t, err, domainErrors.ErrPermissionDenied)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("内容管理员更新单词", func(t *testing.T) {
+		word := &entity.Word{ID: 1, Text: "aple", Definitions: []entity.Definition{{Meaning: "苹果"}}}
+		mockRepo.On("GetByID", managerCtx, entity.WordID(1)).Return(word, nil).Once()
+		mockRepo.On("GetByWord", managerCtx, "apple").Return(nil, domainErrors.ErrWordNotFound).Once()
+		mockRepo.On("Update", managerCtx, word).Return(nil).Once()
+
+		updated, err := service.UpdateWord(managerCtx, 1, dto.CreateWordRequest{
+			Text:        "apple",
+			Definitions: []entity.Definition{{Meaning: "苹果"}},
+			Level:       valueobject.WORD_DIFFICULTY_LEVEL_A1,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "apple", updated.Text)
+		assert.Equal(t, valueobject.WORD_DIFFICULTY_LEVEL_A1, updated.Level)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("更新后的单词不能与其他单词重复", func(t *testing.T) {
+		word := &entity.Word{ID: 1, Text: "aple"}
+		mockRepo.On("GetByID", managerCtx, entity.WordID(1)).Return(word, nil).Once()
+		mockRepo.On("GetByWord", managerCtx, "apple").Return(&entity.Word{ID: 2, Text: "apple"}, nil).Once()
+
+		_, err := service.UpdateWord(managerCtx, 1, dto.CreateWordRequest{Text: "apple"})
+		assert.ErrorIs(t, err, domainErrors.ErrWordAlreadyExists)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("管理员删除单词", func(t *testing.T) {
+		adminCtx := WithRoles(WithUserID(context.Background(), 3), []string{security.RoleAdmin})
+		mockRepo.On("Delete", adminCtx, entity.WordID(1)).Return(nil).Once()
+
+		require.NoError(t, service.DeleteWord(adminCtx, 1))
+		mockRepo.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,188 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/lazyjean/sla2/internal/application/dto"
+	"github.com/lazyjean/sla2/internal/domain/entity"
+	"github.com/lazyjean/sla2/internal/domain/errors"
+	domainService "github.com/lazyjean/sla2/internal/domain/service"
+	"github.com/lazyjean/sla2/pkg/logger"
+	"github.com/lazyjean/sla2/pkg/morphology"
+	"go.uber.org/zap"
+)
+
+// LookupWord 按单词或其屈折变化形式查找单词，如 running、ran 都能找到 run
+// 先按原形查找（不区分大小写时先精确匹配），找不到时按词形查找原形；
+// 一个词形属于多个原形时返回ID最小的原形
+func (s *VocabularyService) LookupWord(ctx context.Context, text string) (*dto.WordLookupResult, error) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return nil, errors.ErrEmptyWordText
+	}
+
+	result := &dto.WordLookupResult{}
+	word, err := s.wordRepository.GetByWord(ctx, text)
+	if errors.Is(err, errors.ErrWordNotFound) && strings.ToLower(text) != text {
+		word, err = s.wordRepository.GetByWord(ctx, strings.ToLower(text))
+	}
+	if errors.Is(err, errors.ErrWordNotFound) {
+		forms, err := s.formRepository.ListByForm(ctx, text)
+		if err != nil {
+			return nil, err
+		}
+		if len(forms) == 0 {
+			return nil, errors.ErrWordNotFound
+		}
+		result.MatchedForm = forms[0]
+		word, err = s.wordRepository.GetByID(ctx, forms[0].WordID)
+		if err != nil {
+			return nil, err
+		}
+	} else if err != nil {
+		return nil, err
+	}
+
+	forms, err := s.getWordForms(ctx, word.ID)
+	if err != nil {
+		return nil, err
+	}
+	result.Word = word
+	result.Forms = forms
+	result.Inflections = domainService.FindExampleInflections(word, forms)
+	return result, nil
+}
+
+// GetWordForms 获取单词的所有词形，以及例句中出现的原形和词形
+func (s *VocabularyService) GetWordForms(ctx context.Context, word *entity.Word) ([]*entity.WordForm, []domainService.ExampleInflection, error) {
+	forms, err := s.getWordForms(ctx, word.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return forms, domainService.FindExampleInflections(word, forms), nil
+}
+
+// ImportWordForms 批量导入单词的屈折变化形式，已有的词形会被覆盖
+// 结构化的词形和词形表中的词形按原形合并；单词库中不存在的原形跳过并在结果中列出
+// 整批在一个事务中导入，某个单词的词形保存失败时已替换的词形也会回滚
+func (s *VocabularyService) ImportWordForms(ctx context.Context, req *dto.ImportWordFormsRequest) (*dto.WordFormsImportResult, error) {
+	if err := checkVocabularyWritePermission(ctx); err != nil {
+		return nil, err
+	}
+
+	log := logger.GetLogger(ctx)
+
+	lemmas, err := mergeWordForms(req)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, lemma := range lemmas {
+		if lemma.Lemma == "" {
+			return nil, errors.ErrEmptyWordText
+		}
+		for _, item := range lemma.Forms {
+			if _, err := entity.NewWordForm(0, item.Form, item.Kind); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	result := &dto.WordFormsImportResult{}
+	err = s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		for _, lemma := range lemmas {
+			word, err := s.wordRepository.GetByWord(ctx, lemma.Lemma)
+			if errors.Is(err, errors.ErrWordNotFound) {
+				result.Missing = append(result.Missing, lemma.Lemma)
+				continue
+			} else if err != nil {
+				return err
+			}
+
+			forms := make([]*entity.WordForm, 0, len(lemma.Forms))
+			seen := make(map[string]struct{}, len(lemma.Forms))
+			for _, item := range lemma.Forms {
+				form, _ := entity.NewWordForm(word.ID, item.Form, item.Kind)
+				if _, ok := seen[form.Form]; ok || form.Form == strings.ToLower(word.Text) {
+					continue
+				}
+				seen[form.Form] = struct{}{}
+				forms = append(forms, form)
+			}
+			if err := s.formRepository.ReplaceByWordID(ctx, word.ID, forms); err != nil {
+				log.Error("Failed to save word forms", zap.Error(err), zap.String("lemma", lemma.Lemma))
+				return err
+			}
+			result.Imported++
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// getWordForms 获取单词的所有词形
+func (s *VocabularyService) getWordForms(ctx context.Context, wordID entity.WordID) ([]*entity.WordForm, error) {
+	forms, err := s.formRepository.ListByWordIDs(ctx, []entity.WordID{wordID})
+	if err != nil {
+		return nil, err
+	}
+	return forms[wordID], nil
+}
+
+// matchWordForm 找出与关键词或其中的词相同的词形
+func matchWordForm(forms []*entity.WordForm, keyword string) *entity.WordForm {
+	keyword = entity.NormalizeWordForm(keyword)
+	terms := strings.FieldsFunc(keyword, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for _, form := range forms {
+		if form.Form == keyword {
+			return form
+		}
+	}
+	for _, form := range forms {
+		for _, term := range terms {
+			if form.Form == term {
+				return form
+			}
+		}
+	}
+	return nil
+}
+
+// mergeWordForms 将结构化的词形和词形表中的词形按原形合并，保持原形首次出现的顺序
+func mergeWordForms(req *dto.ImportWordFormsRequest) ([]*dto.WordFormsRequest, error) {
+	var lemmas []*dto.WordFormsRequest
+	index := make(map[string]*dto.WordFormsRequest)
+	add := func(lemma string, forms ...dto.WordFormItem) {
+		merged, ok := index[lemma]
+		if !ok {
+			merged = &dto.WordFormsRequest{Lemma: lemma}
+			index[lemma] = merged
+			lemmas = append(lemmas, merged)
+		}
+		merged.Forms = append(merged.Forms, forms...)
+	}
+
+	for _, word := range req.Words {
+		add(strings.TrimSpace(word.Lemma), word.Forms...)
+	}
+	if strings.TrimSpace(req.FormList) != "" {
+		parsed, err := morphology.ParseFormList(strings.NewReader(req.FormList))
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", errors.ErrInvalidWordFormList, err)
+		}
+		for _, lemma := range parsed {
+			for _, form := range lemma.Forms {
+				add(lemma.Text, dto.WordFormItem{Form: form})
+			}
+		}
+	}
+	return lemmas, nil
+}
@@ -0,0 +1,123 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lazyjean/sla2/internal/application/dto"
+	"github.com/lazyjean/sla2/internal/domain/entity"
+	domainErrors "github.com/lazyjean/sla2/internal/domain/errors"
+	"github.com/lazyjean/sla2/internal/domain/security"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockWordFormRepository 是 WordFormRepository 的模拟实现
+type MockWordFormRepository struct {
+	mock.Mock
+}
+
+func (m *MockWordFormRepository) ReplaceByWordID(ctx context.Context, wordID entity.WordID, forms []*entity.WordForm) error {
+	args := m.Called(ctx, wordID, forms)
+	return args.Error(0)
+}
+
+func (m *MockWordFormRepository) ListByWordIDs(ctx context.Context, wordIDs []entity.WordID) (map[entity.WordID][]*entity.WordForm, error) {
+	args := m.Called(ctx, wordIDs)
+	return args.Get(0).(map[entity.WordID][]*entity.WordForm), args.Error(1)
+}
+
+func (m *MockWordFormRepository) ListByForm(ctx context.Context, form string) ([]*entity.WordForm, error) {
+	args := m.Called(ctx, form)
+	return args.Get(0).([]*entity.WordForm), args.Error(1)
+}
+
+func TestVocabularyService_LookupWord(t *testing.T) {
+	ctx := context.Background()
+	run := &entity.Word{ID: 1, Text: "run", Examples: []string{"She ran to the station."}}
+	ran := &entity.WordForm{WordID: 1, Form: "ran", Kind: entity.WordFormKindPast}
+
+	t.Run("按词形找到原形", func(t *testing.T) {
+		wordRepo := new(MockWordRepository)
+		formRepo := new(MockWordFormRepository)
+		service := NewVocabularyService(nil, wordRepo, nil, formRepo, nil, nil)
+		wordRepo.On("GetByWord", ctx, "Ran").Return(nil, domainErrors.ErrWordNotFound).Once()
+		wordRepo.On("GetByWord", ctx, "ran").Return(nil, domainErrors.ErrWordNotFound).Once()
+		formRepo.On("ListByForm", ctx, "Ran").Return([]*entity.WordForm{ran}, nil).Once()
+		wordRepo.On("GetByID", ctx, entity.WordID(1)).Return(run, nil).Once()
+		formRepo.On("ListByWordIDs", ctx, []entity.WordID{1}).
+			Return(map[entity.WordID][]*entity.WordForm{1: {ran}}, nil).Once()
+
+		result, err := service.LookupWord(ctx, " Ran ")
+		require.NoError(t, err)
+		assert.Equal(t, run, result.Word)
+		assert.Equal(t, ran, result.MatchedForm)
+		assert.Equal(t, []*entity.WordForm{ran}, result.Forms)
+		require.Len(t, result.Inflections, 1)
+		assert.Equal(t, "ran", result.Inflections[0].Text)
+		assert.Equal(t, entity.WordFormKindPast, result.Inflections[0].Kind)
+		wordRepo.AssertExpectations(t)
+		formRepo.AssertExpectations(t)
+	})
+
+	t.Run("按原形找到", func(t *testing.T) {
+		wordRepo := new(MockWordRepository)
+		formRepo := new(MockWordFormRepository)
+		service := NewVocabularyService(nil, wordRepo, nil, formRepo, nil, nil)
+		wordRepo.On("GetByWord", ctx, "run").Return(run, nil).Once()
+		formRepo.On("ListByWordIDs", ctx, []entity.WordID{1}).
+			Return(map[entity.WordID][]*entity.WordForm{}, nil).Once()
+
+		result, err := service.LookupWord(ctx, "run")
+		require.NoError(t, err)
+		assert.Equal(t, run, result.Word)
+		assert.Nil(t, result.MatchedForm)
+		wordRepo.AssertExpectations(t)
+	})
+
+	t.Run("单词和词形都不存在", func(t *testing.T) {
+		wordRepo := new(MockWordRepository)
+		formRepo := new(MockWordFormRepository)
+		service := NewVocabularyService(nil, wordRepo, nil, formRepo, nil, nil)
+		wordRepo.On("GetByWord", ctx, "xyz").Return(nil, domainErrors.ErrWordNotFound).Once()
+		formRepo.On("ListByForm", ctx, "xyz").Return([]*entity.WordForm{}, nil).Once()
+
+		_, err := service.LookupWord(ctx, "xyz")
+		assert.ErrorIs(t, err, domainErrors.ErrWordNotFound)
+	})
+}
+
+func TestVocabularyService_ImportWordForms(t *testing.T) {
+	ctx := WithRoles(WithUserID(context.Background(), 1), []string{security.RoleContentManager})
+	wordRepo := new(MockWordRepository)
+	formRepo := new(MockWordFormRepository)
+	service := NewVocabularyService(nil, wordRepo, nil, formRepo, nil, stubTransactionManager{})
+
+	t.Run("合并结构化词形和词形表", func(t *testing.T) {
+		wordRepo.On("GetByWord", inTransaction, "run").Return(&entity.Word{ID: 1, Text: "run"}, nil).Once()
+		wordRepo.On("GetByWord", inTransaction, "child").Return(nil, domainErrors.ErrWordNotFound).Once()
+		// 词形在导入事务中保存
+		formRepo.On("ReplaceByWordID", inTransaction, entity.WordID(1), []*entity.WordForm{
+			{WordID: 1, Form: "ran", Kind: entity.WordFormKindPast},
+			{WordID: 1, Form: "running"},
+		}).Return(nil).Once()
+
+		result, err := service.ImportWordForms(ctx, &dto.ImportWordFormsRequest{
+			Words: []*dto.WordFormsRequest{
+				{Lemma: "run", Forms: []dto.WordFormItem{{Form: "Ran", Kind: entity.WordFormKindPast}}},
+			},
+			FormList: "run\tran\nrun\trunning\nchild\tchildren\n",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 1, result.Imported)
+		assert.Equal(t, []string{"child"}, result.Missing)
+		wordRepo.AssertExpectations(t)
+		formRepo.AssertExpectations(t)
+	})
+
+	t.Run("词形表格式错误", func(t *testing.T) {
+		_, err := service.ImportWordForms(ctx, &dto.ImportWordFormsRequest{FormList: "run ran"})
+		assert.ErrorIs(t, err, domainErrors.ErrInvalidWordFormList)
+	})
+}
@@ -0,0 +1,271 @@
+package service
+
+import (
+	"context"
+
+	"github.com/lazyjean/sla2/internal/application/dto"
+	"github.com/lazyjean/sla2/internal/domain/entity"
+	"github.com/lazyjean/sla2/internal/domain/errors"
+	"github.com/lazyjean/sla2/internal/domain/repository"
+	"github.com/lazyjean/sla2/pkg/logger"
+	"go.uber.org/zap"
+)
+
+const (
+	DefaultNotebookEntryPageSize = 20  // 生词本词条默认每页数量
+	MaxNotebookEntryPageSize     = 100 // 生词本词条每页数量上限
+)
+
+// WordNotebookService 生词本服务
+// 用户只能访问自己的生词本，词典单词的词条只引用单词而不复制单词数据
+type WordNotebookService struct {
+	notebookRepo repository.WordNotebookRepository
+	wordRepo     repository.WordRepository
+}
+
+// NewWordNotebookService 创建生词本服务实例
+func NewWordNotebookService(notebookRepo repository.WordNotebookRepository, wordRepo repository.WordRepository) *WordNotebookService {
+	return &WordNotebookService{
+		notebookRepo: notebookRepo,
+		wordRepo:     wordRepo,
+	}
+}
+
+// CreateNotebook 为当前用户创建生词本，同一用户的生词本名称不能重复
+func (s *WordNotebookService) CreateNotebook(ctx context.Context, name, description string) (*entity.WordNotebook, error) {
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	notebook, err := entity.NewWordNotebook(userID, name, description)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkNotebookName(ctx, notebook); err != nil {
+		return nil, err
+	}
+	if err := s.notebookRepo.CreateNotebook(ctx, notebook); err != nil {
+		logger.GetLogger(ctx).Error("Failed to create word notebook", zap.Error(err), zap.Uint32("userID", uint32(userID)))
+		return nil, err
+	}
+	return notebook, nil
+}
+
+// ListNotebooks 获取当前用户的所有生词本及其词条数量
+func (s *WordNotebookService) ListNotebooks(ctx context.Context) ([]*dto.WordNotebookSummary, error) {
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	notebooks, err := s.notebookRepo.ListNotebooks(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]entity.WordNotebookID, len(notebooks))
+	for i, notebook := range notebooks {
+		ids[i] = notebook.ID
+	}
+	counts, err := s.notebookRepo.CountEntries(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	summaries := make([]*dto.WordNotebookSummary, len(notebooks))
+	for i, notebook := range notebooks {
+		summaries[i] = &dto.WordNotebookSummary{Notebook: notebook, EntryCount: counts[notebook.ID]}
+	}
+	return summaries, nil
+}
+
+// UpdateNotebook 修改当前用户生词本的名称和描述
+func (s *WordNotebookService) UpdateNotebook(ctx context.Context, id entity.WordNotebookID, name, description string) (*entity.WordNotebook, error) {
+	notebook, err := s.getNotebook(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := notebook.Update(name, description); err != nil {
+		return nil, err
+	}
+	if err := s.checkNotebookName(ctx, notebook); err != nil {
+		return nil, err
+	}
+	if err := s.notebookRepo.UpdateNotebook(ctx, notebook); err != nil {
+		logger.GetLogger(ctx).Error("Failed to update word notebook", zap.Error(err), zap.Uint32("notebookID", uint32(id)))
+		return nil, err
+	}
+	return notebook, nil
+}
+
+// DeleteNotebook 删除当前用户的生词本及其中的词条，词典单词不受影响，自定义词条的学习记录一并删除
+func (s *WordNotebookService) DeleteNotebook(ctx context.Context, id entity.WordNotebookID) error {
+	if _, err := s.getNotebook(ctx, id); err != nil {
+		return err
+	}
+	if err := s.notebookRepo.DeleteNotebook(ctx, id); err != nil {
+		logger.GetLogger(ctx).Error("Failed to delete word notebook", zap.Error(err), zap.Uint32("notebookID", uint32(id)))
+		return err
+	}
+	return nil
+}
+
+// AddEntry 向当前用户的生词本添加词典单词或自定义词条，同一生词本中不能重复
+func (s *WordNotebookService) AddEntry(ctx context.Context, req *dto.AddNotebookEntryRequest) (*entity.WordNotebookEntry, error) {
+	notebook, err := s.getNotebook(ctx, req.NotebookID)
+	if err != nil {
+		return nil, err
+	}
+
+	var entry *entity.WordNotebookEntry
+	var existing *entity.WordNotebookEntry
+	if req.WordID != 0 {
+		word, err := s.wordRepo.GetByID(ctx, req.WordID)
+		if err != nil {
+			return nil, err
+		}
+		if entry, err = entity.NewWordNotebookEntry(notebook, word.ID, req.Note, req.Examples); err != nil {
+			return nil, err
+		}
+		entry.Word = word
+		existing, err = s.notebookRepo.GetEntryByWordID(ctx, notebook.ID, word.ID)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		if entry, err = entity.NewCustomWordNotebookEntry(notebook, req.Text, req.Meaning, req.Note, req.Examples); err != nil {
+			return nil, err
+		}
+		existing, err = s.notebookRepo.GetCustomEntryByText(ctx, notebook.ID, entry.Text)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if existing != nil {
+		return nil, errors.ErrNotebookEntryAlreadyExists
+	}
+
+	if err := s.notebookRepo.CreateEntry(ctx, entry); err != nil {
+		logger.GetLogger(ctx).Error("Failed to create word notebook entry", zap.Error(err), zap.Uint32("notebookID", uint32(notebook.ID)))
+		return nil, err
+	}
+	return entry, nil
+}
+
+// UpdateEntry 修改词条的个人笔记和例句，自定义词条还可以修改单词和释义
+func (s *WordNotebookService) UpdateEntry(ctx context.Context, req *dto.UpdateNotebookEntryRequest) (*entity.WordNotebookEntry, error) {
+	entry, err := s.getEntry(ctx, req.NotebookID, req.EntryID)
+	if err != nil {
+		return nil, err
+	}
+
+	if entry.IsCustom() {
+		if err := entry.UpdateCustomWord(req.Text, req.Meaning); err != nil {
+			return nil, err
+		}
+		existing, err := s.notebookRepo.GetCustomEntryByText(ctx, entry.NotebookID, entry.Text)
+		if err != nil {
+			return nil, err
+		}
+		if existing != nil && existing.ID != entry.ID {
+			return nil, errors.ErrNotebookEntryAlreadyExists
+		}
+	}
+	if err := entry.UpdateNote(req.Note, req.Examples); err != nil {
+		return nil, err
+	}
+
+	if err := s.notebookRepo.UpdateEntry(ctx, entry); err != nil {
+		logger.GetLogger(ctx).Error("Failed to update word notebook entry", zap.Error(err), zap.Uint32("entryID", uint32(entry.ID)))
+		return nil, err
+	}
+	return entry, nil
+}
+
+// RemoveEntry 从当前用户的生词本中移除词条，自定义词条的学习记录一并删除
+func (s *WordNotebookService) RemoveEntry(ctx context.Context, notebookID entity.WordNotebookID, entryID entity.WordNotebookEntryID) error {
+	entry, err := s.getEntry(ctx, notebookID, entryID)
+	if err != nil {
+		return err
+	}
+	if err := s.notebookRepo.DeleteEntry(ctx, entry.ID); err != nil {
+		logger.GetLogger(ctx).Error("Failed to delete word notebook entry", zap.Error(err), zap.Uint32("entryID", uint32(entry.ID)))
+		return err
+	}
+	return nil
+}
+
+// ListEntries 分页查询当前用户生词本中的词条，返回词条和总数
+func (s *WordNotebookService) ListEntries(ctx context.Context, req *dto.ListNotebookEntriesRequest) ([]*entity.WordNotebookEntry, int64, error) {
+	if !req.Sort.IsValid() {
+		return nil, 0, errors.ErrInvalidNotebookEntrySort
+	}
+	notebook, err := s.getNotebook(ctx, req.NotebookID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	page, pageSize := req.Page, req.PageSize
+	if page < 1 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = DefaultNotebookEntryPageSize
+	} else if pageSize > MaxNotebookEntryPageSize {
+		pageSize = MaxNotebookEntryPageSize
+	}
+
+	return s.notebookRepo.ListEntries(ctx, &repository.WordNotebookEntryQuery{
+		NotebookID: notebook.ID,
+		Keyword:    req.Keyword,
+		Level:      req.Level,
+		Tags:       req.Tags,
+		Sort:       req.Sort,
+		Offset:     (page - 1) * pageSize,
+		Limit:      pageSize,
+	})
+}
+
+// getNotebook 获取当前用户的生词本，不存在或属于其他用户时返回 ErrNotebookNotFound
+func (s *WordNotebookService) getNotebook(ctx context.Context, id entity.WordNotebookID) (*entity.WordNotebook, error) {
+	userID, err := GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	notebook, err := s.notebookRepo.GetNotebook(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	if notebook == nil {
+		return nil, errors.ErrNotebookNotFound
+	}
+	return notebook, nil
+}
+
+// getEntry 获取当前用户生词本中的词条
+func (s *WordNotebookService) getEntry(ctx context.Context, notebookID entity.WordNotebookID, entryID entity.WordNotebookEntryID) (*entity.WordNotebookEntry, error) {
+	notebook, err := s.getNotebook(ctx, notebookID)
+	if err != nil {
+		return nil, err
+	}
+	entry, err := s.notebookRepo.GetEntry(ctx, notebook.ID, entryID)
+	if err != nil {
+		return nil, err
+	}
+	if entry == nil {
+		return nil, errors.ErrNotebookEntryNotFound
+	}
+	return entry, nil
+}
+
+// checkNotebookName 检查用户的其他生词本是否已使用该名称
+func (s *WordNotebookService) checkNotebookName(ctx context.Context, notebook *entity.WordNotebook) error {
+	existing, err := s.notebookRepo.GetNotebookByName(ctx, notebook.UserID, notebook.Name)
+	if err != nil {
+		return err
+	}
+	if existing != nil && existing.ID != notebook.ID {
+		return errors.ErrNotebookAlreadyExists
+	}
+	return nil
+}
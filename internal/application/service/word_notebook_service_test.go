@@ -0,0 +1,182 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lazyjean/sla2/internal/application/dto"
+	"github.com/lazyjean/sla2/internal/domain/entity"
+	domainErrors "github.com/lazyjean/sla2/internal/domain/errors"
+	"github.com/lazyjean/sla2/internal/domain/repository"
+	"github.com/lazyjean/sla2/internal/domain/valueobject"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockWordNotebookRepository 是 WordNotebookRepository 的模拟实现，只实现测试用到的方法
+type MockWordNotebookRepository struct {
+	mock.Mock
+	repository.WordNotebookRepository
+}
+
+func (m *MockWordNotebookRepository) GetNotebook(ctx context.Context, userID entity.UID, id entity.WordNotebookID) (*entity.WordNotebook, error) {
+	args := m.Called(ctx, userID, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.WordNotebook), args.Error(1)
+}
+
+func (m *MockWordNotebookRepository) DeleteNotebook(ctx context.Context, id entity.WordNotebookID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockWordNotebookRepository) CreateEntry(ctx context.Context, entry *entity.WordNotebookEntry) error {
+	args := m.Called(ctx, entry)
+	return args.Error(0)
+}
+
+func (m *MockWordNotebookRepository) GetEntryByWordID(ctx context.Context, notebookID entity.WordNotebookID, wordID entity.WordID) (*entity.WordNotebookEntry, error) {
+	args := m.Called(ctx, notebookID, wordID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.WordNotebookEntry), args.Error(1)
+}
+
+func (m *MockWordNotebookRepository) GetCustomEntryByText(ctx context.Context, notebookID entity.WordNotebookID, text string) (*entity.WordNotebookEntry, error) {
+	args := m.Called(ctx, notebookID, text)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.WordNotebookEntry), args.Error(1)
+}
+
+func (m *MockWordNotebookRepository) ListEntries(ctx context.Context, query *repository.WordNotebookEntryQuery) ([]*entity.WordNotebookEntry, int64, error) {
+	args := m.Called(ctx, query)
+	return args.Get(0).([]*entity.WordNotebookEntry), args.Get(1).(int64), args.Error(2)
+}
+
+// TestWordNotebookService_Ownership 测试其他用户的生词本视为不存在
+func TestWordNotebookService_Ownership(t *testing.T) {
+	notebookRepo := new(MockWordNotebookRepository)
+	service := NewWordNotebookService(notebookRepo, new(MockWordRepository))
+	ctx := WithUserID(context.Background(), 2)
+
+	// 生词本 1 属于用户 1，按用户 2 查询不到
+	notebookRepo.On("GetNotebook", ctx, entity.UID(2), entity.WordNotebookID(1)).Return(nil, nil)
+
+	_, err := service.UpdateNotebook(ctx, 1, "水果", "")
+	assert.ErrorIs(t, err, domainErrors.ErrNotebookNotFound)
+	err = service.DeleteNotebook(ctx, 1)
+	assert.ErrorIs(t, err, domainErrors.ErrNotebookNotFound)
+	_, err = service.AddEntry(ctx, &dto.AddNotebookEntryRequest{NotebookID: 1, Text: "cherry"})
+	assert.ErrorIs(t, err, domainErrors.ErrNotebookNotFound)
+	err = service.RemoveEntry(ctx, 1, 1)
+	assert.ErrorIs(t, err, domainErrors.ErrNotebookNotFound)
+	_, _, err = service.ListEntries(ctx, &dto.ListNotebookEntriesRequest{NotebookID: 1})
+	assert.ErrorIs(t, err, domainErrors.ErrNotebookNotFound)
+
+	notebookRepo.AssertNotCalled(t, "DeleteNotebook", mock.Anything, mock.Anything)
+	notebookRepo.AssertNotCalled(t, "CreateEntry", mock.Anything, mock.Anything)
+	notebookRepo.AssertNotCalled(t, "ListEntries", mock.Anything, mock.Anything)
+}
+
+// TestWordNotebookService_AddEntryDuplicate 测试同一生词本中不能重复添加词典单词和自定义词条
+func TestWordNotebookService_AddEntryDuplicate(t *testing.T) {
+	ctx := WithUserID(context.Background(), 1)
+	notebook := &entity.WordNotebook{ID: 1, UserID: 1, Name: "水果"}
+	apple := &entity.Word{ID: 10, Text: "apple"}
+
+	t.Run("词典单词", func(t *testing.T) {
+		notebookRepo := new(MockWordNotebookRepository)
+		wordRepo := new(MockWordRepository)
+		service := NewWordNotebookService(notebookRepo, wordRepo)
+		notebookRepo.On("GetNotebook", ctx, entity.UID(1), notebook.ID).Return(notebook, nil)
+		wordRepo.On("GetByID", ctx, apple.ID).Return(apple, nil)
+		notebookRepo.On("GetEntryByWordID", ctx, notebook.ID, apple.ID).Return(&entity.WordNotebookEntry{ID: 5}, nil).Once()
+
+		_, err := service.AddEntry(ctx, &dto.AddNotebookEntryRequest{NotebookID: notebook.ID, WordID: apple.ID})
+		assert.ErrorIs(t, err, domainErrors.ErrNotebookEntryAlreadyExists)
+		notebookRepo.AssertNotCalled(t, "CreateEntry", mock.Anything, mock.Anything)
+
+		// 不存在时创建引用词典单词的词条
+		notebookRepo.On("GetEntryByWordID", ctx, notebook.ID, apple.ID).Return(nil, nil).Once()
+		notebookRepo.On("CreateEntry", ctx, mock.AnythingOfType("*entity.WordNotebookEntry")).Return(nil).Once()
+		entry, err := service.AddEntry(ctx, &dto.AddNotebookEntryRequest{NotebookID: notebook.ID, WordID: apple.ID, Note: "苹果"})
+		require.NoError(t, err)
+		require.NotNil(t, entry.WordID)
+		assert.Equal(t, apple.ID, *entry.WordID)
+		assert.Equal(t, apple, entry.Word)
+		notebookRepo.AssertExpectations(t)
+	})
+
+	t.Run("自定义词条", func(t *testing.T) {
+		notebookRepo := new(MockWordNotebookRepository)
+		service := NewWordNotebookService(notebookRepo, new(MockWordRepository))
+		notebookRepo.On("GetNotebook", ctx, entity.UID(1), notebook.ID).Return(notebook, nil)
+		// 按去除首尾空白后的单词检查重复
+		notebookRepo.On("GetCustomEntryByText", ctx, notebook.ID, "Cherry").Return(&entity.WordNotebookEntry{ID: 6, Text: "cherry"}, nil).Once()
+
+		_, err := service.AddEntry(ctx, &dto.AddNotebookEntryRequest{NotebookID: notebook.ID, Text: " Cherry ", Meaning: "樱桃"})
+		assert.ErrorIs(t, err, domainErrors.ErrNotebookEntryAlreadyExists)
+		notebookRepo.AssertNotCalled(t, "CreateEntry", mock.Anything, mock.Anything)
+		notebookRepo.AssertExpectations(t)
+	})
+}
+
+// TestWordNotebookService_ListEntries 测试词条列表的排序、过滤和分页参数
+func TestWordNotebookService_ListEntries(t *testing.T) {
+	ctx := WithUserID(context.Background(), 1)
+	notebook := &entity.WordNotebook{ID: 1, UserID: 1, Name: "水果"}
+	notebookRepo := new(MockWordNotebookRepository)
+	service := NewWordNotebookService(notebookRepo, new(MockWordRepository))
+	notebookRepo.On("GetNotebook", ctx, entity.UID(1), notebook.ID).Return(notebook, nil)
+
+	t.Run("无效的排序方式", func(t *testing.T) {
+		_, _, err := service.ListEntries(ctx, &dto.ListNotebookEntriesRequest{NotebookID: notebook.ID, Sort: repository.WordNotebookEntrySort(99)})
+		assert.ErrorIs(t, err, domainErrors.ErrInvalidNotebookEntrySort)
+	})
+
+	t.Run("排序和过滤条件传给仓储", func(t *testing.T) {
+		entries := []*entity.WordNotebookEntry{{ID: 1, Text: "cherry"}}
+		notebookRepo.On("ListEntries", ctx, &repository.WordNotebookEntryQuery{
+			NotebookID: notebook.ID,
+			Keyword:    "ch",
+			Level:      valueobject.WORD_DIFFICULTY_LEVEL_A1,
+			Tags:       []string{"水果"},
+			Sort:       repository.WordNotebookEntrySortTextDesc,
+			Offset:     2 * MaxNotebookEntryPageSize,
+			Limit:      MaxNotebookEntryPageSize,
+		}).Return(entries, int64(201), nil).Once()
+
+		got, total, err := service.ListEntries(ctx, &dto.ListNotebookEntriesRequest{
+			NotebookID: notebook.ID,
+			Page:       3,
+			PageSize:   MaxNotebookEntryPageSize + 1,
+			Keyword:    "ch",
+			Level:      valueobject.WORD_DIFFICULTY_LEVEL_A1,
+			Tags:       []string{"水果"},
+			Sort:       repository.WordNotebookEntrySortTextDesc,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, entries, got)
+		assert.Equal(t, int64(201), total)
+	})
+
+	t.Run("默认分页", func(t *testing.T) {
+		notebookRepo.On("ListEntries", ctx, &repository.WordNotebookEntryQuery{
+			NotebookID: notebook.ID,
+			Sort:       repository.WordNotebookEntrySortAddedDesc,
+			Limit:      DefaultNotebookEntryPageSize,
+		}).Return([]*entity.WordNotebookEntry{}, int64(0), nil).Once()
+
+		_, total, err := service.ListEntries(ctx, &dto.ListNotebookEntriesRequest{NotebookID: notebook.ID})
+		require.NoError(t, err)
+		assert.Zero(t, total)
+	})
+
+	notebookRepo.AssertExpectations(t)
+}
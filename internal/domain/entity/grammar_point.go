@@ -0,0 +1,50 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/lazyjean/sla2/internal/domain/valueobject"
+	"gorm.io/gorm"
+)
+
+// GrammarPointID 语法点ID类型
+type GrammarPointID uint32
+
+// GrammarPoint 语法点实体
+type GrammarPoint struct {
+	// ID 唯一标识符
+	ID GrammarPointID `gorm:"primaryKey;autoIncrement;comment:唯一标识符"`
+	// Title 语法点名称
+	Title string `gorm:"type:varchar(200);not null;uniqueIndex;comment:语法点名称"`
+	// Pattern 句型结构，如 "not only ... but also ..."
+	Pattern string `gorm:"type:varchar(200);not null;default:'';comment:句型结构"`
+	// Explanation 讲解
+	Explanation string `gorm:"type:text;not null;comment:讲解"`
+	// Examples 例句列表
+	Examples []string `gorm:"type:jsonb;serializer:json;not null;comment:例句列表"`
+	// Tags 标签列表
+	Tags []string `gorm:"type:jsonb;serializer:json;not null;comment:标签列表"`
+	// Level 难度等级
+	Level valueobject.WordDifficultyLevel `gorm:"type:integer;not null;comment:难度等级"`
+	// CreatedAt 创建时间
+	CreatedAt time.Time `gorm:"type:timestamp with time zone;not null;comment:创建时间"`
+	// UpdatedAt 更新时间
+	UpdatedAt time.Time `gorm:"type:timestamp with time zone;not null;comment:更新时间"`
+	// DeletedAt 删除时间
+	DeletedAt gorm.DeletedAt `gorm:"index;comment:删除时间"`
+}
+
+// NewGrammarPoint 创建新的语法点实体
+func NewGrammarPoint(title, pattern, explanation string, level valueobject.WordDifficultyLevel) *GrammarPoint {
+	now := time.Now()
+	return &GrammarPoint{
+		Title:       title,
+		Pattern:     pattern,
+		Explanation: explanation,
+		Examples:    []string{},
+		Tags:        []string{},
+		Level:       level,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+}
@@ -1,9 +1,12 @@
 package entity
 
 import (
+	"strings"
 	"time"
+	"unicode"
 
 	"github.com/lazyjean/sla2/internal/domain/valueobject"
+	"github.com/lazyjean/sla2/pkg/pinyin"
 	"gorm.io/gorm"
 )
 
@@ -16,8 +19,26 @@ type HanChar struct {
 	ID HanCharID `gorm:"primaryKey;autoIncrement;comment:唯一标识符"`
 	// Character 汉字字符
 	Character string `gorm:"type:varchar(10);not null;uniqueIndex;comment:汉字字符"`
-	// Pinyin 拼音
+	// Pinyin 带声调符号的拼音，如 shān
 	Pinyin string `gorm:"type:varchar(50);not null;comment:拼音"`
+	// PinyinNumbered 数字标调的拼音，如 shan1
+	PinyinNumbered string `gorm:"type:varchar(50);not null;default:'';comment:数字标调的拼音"`
+	// PinyinSearch 用于检索的紧凑数字标调拼音，如 ni3hao3，写入时由 NormalizePinyin 生成
+	PinyinSearch string `gorm:"type:varchar(50);not null;default:'';index;comment:检索用拼音"`
+	// PinyinToneless 用于忽略声调检索的紧凑拼音，如 nihao，写入时由 NormalizePinyin 生成
+	PinyinToneless string `gorm:"type:varchar(50);not null;default:'';index;comment:检索用无声调拼音"`
+	// Radical 部首
+	Radical string `gorm:"type:varchar(10);not null;default:'';index;comment:部首"`
+	// StrokeCount 笔画数，0 表示未知
+	StrokeCount uint32 `gorm:"not null;default:0;index;comment:笔画数"`
+	// Components 部件拆分，如 “明” 拆为 日、月
+	Components []string `gorm:"type:jsonb;serializer:json;comment:部件拆分"`
+	// Traditional 繁体写法，与字符相同或没有繁体时为空
+	Traditional string `gorm:"type:varchar(10);not null;default:'';comment:繁体写法"`
+	// Simplified 简体写法，字符本身为简体时为空
+	Simplified string `gorm:"type:varchar(10);not null;default:'';comment:简体写法"`
+	// Meanings 释义
+	Meanings []string `gorm:"type:jsonb;serializer:json;comment:释义"`
 	// Tags 标签列表
 	Tags []string `gorm:"type:jsonb;serializer:json;not null;comment:标签列表"`
 	// Categories 分类列表
@@ -113,3 +134,22 @@ func (h *HanChar) Update(character, pinyin string, level valueobject.WordDifficu
 	h.Level = level
 	h.UpdatedAt = time.Now()
 }
+
+// NormalizePinyin 统一拼音的写法并生成检索用拼音，写入前调用
+// 拼音可以是声调符号、数字标调或 v 代替 ü 的任意写法；只提供数字标调拼音时据此补全拼音
+func (h *HanChar) NormalizePinyin() {
+	if h.Pinyin == "" {
+		h.Pinyin = h.PinyinNumbered
+	}
+	if !pinyin.IsPinyin(h.Pinyin) {
+		h.PinyinSearch, h.PinyinToneless = "", ""
+		return
+	}
+	// 数字标调或 v、u: 的写法转换为声调符号，已是声调符号的保留原写法（如多音字的分隔符）
+	if strings.IndexFunc(h.Pinyin, unicode.IsDigit) >= 0 || strings.ContainsAny(h.Pinyin, "vV:") {
+		h.Pinyin = pinyin.ToMarked(h.Pinyin)
+	}
+	h.PinyinNumbered = pinyin.ToNumbered(h.Pinyin)
+	h.PinyinSearch = pinyin.Normalize(h.Pinyin)
+	h.PinyinToneless = pinyin.Toneless(h.Pinyin)
+}
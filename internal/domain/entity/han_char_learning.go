@@ -12,9 +12,9 @@ type HanCharLearning struct {
 	// ID 唯一标识符
 	ID HanCharLearningID `gorm:"primaryKey;autoIncrement;comment:唯一标识符"`
 	// UserID 用户ID
-	UserID UID `gorm:"index;comment:用户ID"`
+	UserID UID `gorm:"uniqueIndex:idx_han_char_learnings_user_char,priority:1;comment:用户ID"`
 	// HanCharID 汉字ID
-	HanCharID HanCharID `gorm:"index;comment:汉字ID"`
+	HanCharID HanCharID `gorm:"index;uniqueIndex:idx_han_char_learnings_user_char,priority:2;comment:汉字ID"`
 	// MemoryUnitID 记忆单元ID
 	MemoryUnitID uint32 `gorm:"index;comment:记忆单元ID"`
 	// FirstTryCorrect 第一次是否做对
@@ -93,6 +93,37 @@ func (h *HanCharLearning) UpdateLearningResult(
 	h.UpdatedAt = time.Now()
 }
 
+// Record 记录一次生字学习的结果，三次作答和掌握情况以最近一次为准，次数和时长累加
+func (h *HanCharLearning) Record(result HanCharLearningResult, studyDuration uint32) {
+	h.FirstTryCorrect = result.FirstTryCorrect
+	h.SecondTryCorrect = result.SecondTryCorrect
+	h.ThirdTryCorrect = result.ThirdTryCorrect
+	h.Mastered = result.Mastered
+	h.ErrorCount += result.ErrorCount
+	h.CorrectCount += result.CorrectCount
+	h.StudyDuration += studyDuration
+	h.UpdatedAt = time.Now()
+}
+
+// RecordAttempt 记录第 attempt 次（1-3）书写练习的结果，只更新该次作答，次数和时长累加
+func (h *HanCharLearning) RecordAttempt(attempt int, correct bool, studyDuration uint32) {
+	switch attempt {
+	case 1:
+		h.FirstTryCorrect = correct
+	case 2:
+		h.SecondTryCorrect = correct
+	case 3:
+		h.ThirdTryCorrect = correct
+	}
+	if correct {
+		h.CorrectCount++
+	} else {
+		h.ErrorCount++
+	}
+	h.StudyDuration += studyDuration
+	h.UpdatedAt = time.Now()
+}
+
 // IsFullyMastered 判断是否完全掌握
 func (h *HanCharLearning) IsFullyMastered() bool {
 	return h.FirstTryCorrect && h.SecondTryCorrect && h.ThirdTryCorrect && h.Mastered
@@ -113,3 +144,28 @@ func (h *HanCharLearning) GetLearningTimePerChar() float64 {
 	}
 	return float64(h.StudyDuration) / float64(h.CorrectCount+h.ErrorCount)
 }
+
+// HanCharLearningResult 一次生字学习的结果
+type HanCharLearningResult struct {
+	FirstTryCorrect  bool   // 第一次是否做对
+	SecondTryCorrect bool   // 第二次是否做对
+	ThirdTryCorrect  bool   // 第三次是否做对
+	Mastered         bool   // 最终是否掌握
+	ErrorCount       uint32 // 错误次数
+	CorrectCount     uint32 // 正确次数
+}
+
+// Grade 将学习结果换算为首次复习的评分
+// 一次做对且掌握为简单，最终掌握为良好，做对过为困难，否则为忘记
+func (r HanCharLearningResult) Grade() ReviewGrade {
+	switch {
+	case r.Mastered && r.FirstTryCorrect:
+		return ReviewGradeEasy
+	case r.Mastered:
+		return ReviewGradeGood
+	case r.FirstTryCorrect || r.SecondTryCorrect || r.ThirdTryCorrect:
+		return ReviewGradeHard
+	default:
+		return ReviewGradeAgain
+	}
+}
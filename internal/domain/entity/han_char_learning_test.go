@@ -0,0 +1,55 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHanCharLearningResultGrade(t *testing.T) {
+	tests := []struct {
+		name     string
+		result   HanCharLearningResult
+		expected ReviewGrade
+	}{
+		{"一次做对并掌握", HanCharLearningResult{FirstTryCorrect: true, Mastered: true}, ReviewGradeEasy},
+		{"多次尝试后掌握", HanCharLearningResult{ThirdTryCorrect: true, Mastered: true}, ReviewGradeGood},
+		{"做对过但未掌握", HanCharLearningResult{SecondTryCorrect: true}, ReviewGradeHard},
+		{"全部做错", HanCharLearningResult{ErrorCount: 3}, ReviewGradeAgain},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.result.Grade())
+		})
+	}
+}
+
+func TestHanCharLearningRecord(t *testing.T) {
+	learning := NewHanCharLearning(1, 2, 3, false, false, true, false, 2, 1, 30, nil)
+
+	learning.Record(HanCharLearningResult{FirstTryCorrect: true, Mastered: true, CorrectCount: 1}, 10)
+
+	assert.True(t, learning.FirstTryCorrect)
+	assert.False(t, learning.ThirdTryCorrect)
+	assert.True(t, learning.Mastered)
+	assert.Equal(t, uint32(2), learning.ErrorCount)
+	assert.Equal(t, uint32(2), learning.CorrectCount)
+	assert.Equal(t, uint32(40), learning.StudyDuration)
+}
+
+func TestHanCharLearningRecordAttempt(t *testing.T) {
+	learning := NewHanCharLearning(1, 2, 3, true, false, false, true, 0, 1, 10, nil)
+
+	learning.RecordAttempt(2, false, 5)
+	learning.RecordAttempt(3, true, 5)
+
+	// 只更新对应的作答次数，不影响其他作答和掌握情况
+	assert.True(t, learning.FirstTryCorrect)
+	assert.False(t, learning.SecondTryCorrect)
+	assert.True(t, learning.ThirdTryCorrect)
+	assert.True(t, learning.Mastered)
+	assert.Equal(t, uint32(1), learning.ErrorCount)
+	assert.Equal(t, uint32(2), learning.CorrectCount)
+	assert.Equal(t, uint32(20), learning.StudyDuration)
+}
@@ -0,0 +1,37 @@
+package entity
+
+// HanCharQuestionType 汉字测试题型
+type HanCharQuestionType int
+
+const (
+	HanCharQuestionTypeUnspecified   HanCharQuestionType = iota
+	HanCharQuestionTypeCharToPinyin                      // 看字选拼音
+	HanCharQuestionTypePinyinToChar                      // 看拼音选字
+	HanCharQuestionTypeCharToExample                     // 看字选含该字的词语
+)
+
+// HanCharQuestionTypes 全部汉字测试题型
+var HanCharQuestionTypes = []HanCharQuestionType{
+	HanCharQuestionTypeCharToPinyin,
+	HanCharQuestionTypePinyinToChar,
+	HanCharQuestionTypeCharToExample,
+}
+
+// IsValid 检查题型是否有效
+func (t HanCharQuestionType) IsValid() bool {
+	return t >= HanCharQuestionTypeCharToPinyin && t <= HanCharQuestionTypeCharToExample
+}
+
+// HanCharQuestion 汉字选择题
+type HanCharQuestion struct {
+	HanCharID HanCharID           // 考查的汉字
+	Type      HanCharQuestionType // 题型
+	Prompt    string              // 题干
+	Options   []string            // 选项
+	Answer    int                 // 正确选项的下标
+}
+
+// IsCorrect 判断所选选项的文本是否为正确答案
+func (q *HanCharQuestion) IsCorrect(selected string) bool {
+	return q.Answer >= 0 && q.Answer < len(q.Options) && q.Options[q.Answer] == selected
+}
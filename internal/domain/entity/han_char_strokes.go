@@ -0,0 +1,89 @@
+package entity
+
+import (
+	"time"
+)
+
+// StrokePoint 笔画上的点，坐标为 Make Me a Hanzi 的 1024×1024 坐标系
+type StrokePoint [2]float64
+
+// X 横坐标
+func (p StrokePoint) X() float64 {
+	return p[0]
+}
+
+// Y 纵坐标
+func (p StrokePoint) Y() float64 {
+	return p[1]
+}
+
+// StrokePolyline 一笔的折线，按书写方向排列
+type StrokePolyline []StrokePoint
+
+// HanCharStrokes 汉字笔顺数据，格式与 Make Me a Hanzi 的 graphics.txt 一致
+type HanCharStrokes struct {
+	// HanCharID 汉字ID
+	HanCharID HanCharID `gorm:"primaryKey;autoIncrement:false;comment:汉字ID"`
+	// Strokes 按笔顺排列的每一笔轮廓的 SVG 路径
+	Strokes []string `gorm:"type:jsonb;serializer:json;not null;comment:笔画轮廓"`
+	// Medians 按笔顺排列的每一笔中线，用于动画和手写评分
+	Medians []StrokePolyline `gorm:"type:jsonb;serializer:json;not null;comment:笔画中线"`
+	// CreatedAt 创建时间
+	CreatedAt time.Time `gorm:"type:timestamp with time zone;not null;comment:创建时间"`
+	// UpdatedAt 更新时间
+	UpdatedAt time.Time `gorm:"type:timestamp with time zone;not null;comment:更新时间"`
+}
+
+// NewHanCharStrokes 创建汉字笔顺数据
+func NewHanCharStrokes(hanCharID HanCharID, strokes []string, medians []StrokePolyline) *HanCharStrokes {
+	now := time.Now()
+	return &HanCharStrokes{
+		HanCharID: hanCharID,
+		Strokes:   strokes,
+		Medians:   medians,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+// IsValid 判断笔顺数据是否完整：至少一笔，轮廓与中线一一对应，每条中线至少两个点
+func (s *HanCharStrokes) IsValid() bool {
+	if len(s.Strokes) == 0 || len(s.Strokes) != len(s.Medians) {
+		return false
+	}
+	for _, median := range s.Medians {
+		if len(median) < 2 {
+			return false
+		}
+	}
+	return true
+}
+
+// StrokeCount 笔画数
+func (s *HanCharStrokes) StrokeCount() uint32 {
+	return uint32(len(s.Medians))
+}
+
+// HandwritingResult 一次手写的评分结果
+type HandwritingResult struct {
+	// StrokeCorrect 按标准笔顺每一笔是否写对，缺少的笔画记为错误
+	StrokeCorrect []bool
+	// ExtraStrokes 多写的笔画数
+	ExtraStrokes int
+}
+
+// Correct 是否整字写对：每一笔都写对且没有多写
+func (r *HandwritingResult) Correct() bool {
+	return r.ExtraStrokes == 0 && r.MistakeCount() == 0
+}
+
+// MistakeCount 写错的笔画数，含缺少和多写的笔画
+func (r *HandwritingResult) MistakeCount() int {
+	count := r.ExtraStrokes
+	for _, correct := range r.StrokeCorrect {
+		if !correct {
+			count++
+		}
+	}
+	return count
+}
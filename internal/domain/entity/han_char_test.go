@@ -0,0 +1,41 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHanCharNormalizePinyin(t *testing.T) {
+	t.Run("声调符号", func(t *testing.T) {
+		hanChar := NewHanChar("绿", "lǜ", 1)
+		hanChar.NormalizePinyin()
+		assert.Equal(t, "lǜ", hanChar.Pinyin)
+		assert.Equal(t, "lü4", hanChar.PinyinNumbered)
+		assert.Equal(t, "lü4", hanChar.PinyinSearch)
+		assert.Equal(t, "lü", hanChar.PinyinToneless)
+	})
+
+	t.Run("数字标调", func(t *testing.T) {
+		hanChar := NewHanChar("绿", "lv4", 1)
+		hanChar.NormalizePinyin()
+		assert.Equal(t, "lǜ", hanChar.Pinyin)
+		assert.Equal(t, "lü4", hanChar.PinyinNumbered)
+	})
+
+	t.Run("只有数字标调拼音", func(t *testing.T) {
+		hanChar := NewHanChar("好", "", 1)
+		hanChar.PinyinNumbered = "hao3"
+		hanChar.NormalizePinyin()
+		assert.Equal(t, "hǎo", hanChar.Pinyin)
+		assert.Equal(t, "hao", hanChar.PinyinToneless)
+	})
+
+	t.Run("多音字保留原写法", func(t *testing.T) {
+		hanChar := NewHanChar("好", "hǎo, hào", 1)
+		hanChar.NormalizePinyin()
+		assert.Equal(t, "hǎo, hào", hanChar.Pinyin)
+		assert.Equal(t, "hao3 hao4", hanChar.PinyinNumbered)
+		assert.Equal(t, "hao3hao4", hanChar.PinyinSearch)
+	})
+}
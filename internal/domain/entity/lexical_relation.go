@@ -0,0 +1,133 @@
+package entity
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/lazyjean/sla2/internal/domain/errors"
+)
+
+// MaxRelationNoteLength 关联说明的最大长度（字符）
+const MaxRelationNoteLength = 200
+
+// LexicalRelationType 单词之间或汉字之间的关联类型
+type LexicalRelationType uint8
+
+const (
+	LexicalRelationTypeSynonym     LexicalRelationType = 1 // 同义
+	LexicalRelationTypeAntonym     LexicalRelationType = 2 // 反义
+	LexicalRelationTypeDerivedFrom LexicalRelationType = 3 // 派生，源派生自目标，如 happiness 派生自 happy
+	LexicalRelationTypeWordFamily  LexicalRelationType = 4 // 同族词
+	LexicalRelationTypeCollocation LexicalRelationType = 5 // 搭配，说明中可以记录搭配的短语
+	LexicalRelationTypeConfusable  LexicalRelationType = 6 // 易混淆，适合作为测试的干扰项
+)
+
+// IsValid 检查关联类型是否有效
+func (t LexicalRelationType) IsValid() bool {
+	return t >= LexicalRelationTypeSynonym && t <= LexicalRelationTypeConfusable
+}
+
+// IsDirected 关联是否有方向，只有派生关联区分源和目标，其他关联两端对等
+func (t LexicalRelationType) IsDirected() bool {
+	return t == LexicalRelationTypeDerivedFrom
+}
+
+// WordRelationID 单词关联ID类型
+type WordRelationID uint32
+
+// WordRelation 两个单词之间的关联
+// 无方向的关联保存时源单词ID不大于目标单词ID，保证同一对单词的同类关联只有一条
+// 表名：word_relations
+// 注释：单词关联表，记录同义、反义、派生、同族、搭配、易混淆等关系
+type WordRelation struct {
+	ID        WordRelationID      `gorm:"primaryKey;comment:主键ID"`
+	SourceID  WordID              `gorm:"not null;uniqueIndex:idx_word_relation;comment:源单词ID"`
+	TargetID  WordID              `gorm:"not null;uniqueIndex:idx_word_relation;index;comment:目标单词ID"`
+	Type      LexicalRelationType `gorm:"not null;uniqueIndex:idx_word_relation;comment:关联类型，1-同义，2-反义，3-派生，4-同族，5-搭配，6-易混淆"`
+	Note      string              `gorm:"type:varchar(200);not null;default:'';comment:说明"`
+	CreatedAt time.Time           `gorm:"not null;default:CURRENT_TIMESTAMP;comment:创建时间"`
+
+	// Source、Target 关联两端的单词，删除单词时同时删除其关联
+	Source *Word `gorm:"foreignKey:SourceID;constraint:OnDelete:CASCADE" json:"-"`
+	Target *Word `gorm:"foreignKey:TargetID;constraint:OnDelete:CASCADE" json:"-"`
+}
+
+// NewWordRelation 创建单词关联
+func NewWordRelation(sourceID, targetID WordID, relationType LexicalRelationType, note string) (*WordRelation, error) {
+	note, err := validateRelation(sourceID == 0 || targetID == 0 || sourceID == targetID, relationType, note)
+	if err != nil {
+		return nil, err
+	}
+	if !relationType.IsDirected() && sourceID > targetID {
+		sourceID, targetID = targetID, sourceID
+	}
+	return &WordRelation{SourceID: sourceID, TargetID: targetID, Type: relationType, Note: note}, nil
+}
+
+// Other 返回关联中另一端的单词，inverse 表示从 wordID 看是反向的派生关联，即另一端派生自 wordID
+func (r *WordRelation) Other(wordID WordID) (word *Word, inverse bool) {
+	if r.SourceID == wordID {
+		return r.Target, false
+	}
+	return r.Source, r.Type.IsDirected()
+}
+
+// HanCharRelationID 汉字关联ID类型
+type HanCharRelationID uint32
+
+// HanCharRelation 两个汉字之间的关联，规则与单词关联相同
+// 表名：han_char_relations
+// 注释：汉字关联表，记录同义、反义、派生、同族、搭配、易混淆等关系
+type HanCharRelation struct {
+	ID        HanCharRelationID   `gorm:"primaryKey;comment:主键ID"`
+	SourceID  HanCharID           `gorm:"not null;uniqueIndex:idx_han_char_relation;comment:源汉字ID"`
+	TargetID  HanCharID           `gorm:"not null;uniqueIndex:idx_han_char_relation;index;comment:目标汉字ID"`
+	Type      LexicalRelationType `gorm:"not null;uniqueIndex:idx_han_char_relation;comment:关联类型，1-同义，2-反义，3-派生，4-同族，5-搭配，6-易混淆"`
+	Note      string              `gorm:"type:varchar(200);not null;default:'';comment:说明"`
+	CreatedAt time.Time           `gorm:"not null;default:CURRENT_TIMESTAMP;comment:创建时间"`
+
+	// Source、Target 关联两端的汉字，删除汉字时同时删除其关联
+	Source *HanChar `gorm:"foreignKey:SourceID;constraint:OnDelete:CASCADE" json:"-"`
+	Target *HanChar `gorm:"foreignKey:TargetID;constraint:OnDelete:CASCADE" json:"-"`
+}
+
+// NewHanCharRelation 创建汉字关联
+func NewHanCharRelation(sourceID, targetID HanCharID, relationType LexicalRelationType, note string) (*HanCharRelation, error) {
+	note, err := validateRelation(sourceID == 0 || targetID == 0 || sourceID == targetID, relationType, note)
+	if err != nil {
+		return nil, err
+	}
+	if !relationType.IsDirected() && sourceID > targetID {
+		sourceID, targetID = targetID, sourceID
+	}
+	return &HanCharRelation{SourceID: sourceID, TargetID: targetID, Type: relationType, Note: note}, nil
+}
+
+// Other 返回关联中另一端的汉字，inverse 表示从 hanCharID 看是反向的派生关联
+func (r *HanCharRelation) Other(hanCharID HanCharID) (hanChar *HanChar, inverse bool) {
+	if r.SourceID == hanCharID {
+		return r.Target, false
+	}
+	return r.Source, r.Type.IsDirected()
+}
+
+// ValidateRelation 校验关联类型和说明，返回去除首尾空白后的说明
+func ValidateRelation(relationType LexicalRelationType, note string) (string, error) {
+	return validateRelation(false, relationType, note)
+}
+
+// validateRelation 校验关联，invalidTarget 表示两端为空或相同
+func validateRelation(invalidTarget bool, relationType LexicalRelationType, note string) (string, error) {
+	if invalidTarget {
+		return "", errors.ErrInvalidRelationTarget
+	}
+	if !relationType.IsValid() {
+		return "", errors.ErrInvalidRelationType
+	}
+	note = strings.TrimSpace(note)
+	if utf8.RuneCountInString(note) > MaxRelationNoteLength {
+		return "", errors.ErrInvalidRelationNote
+	}
+	return note, nil
+}
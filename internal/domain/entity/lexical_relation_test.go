@@ -0,0 +1,51 @@
+package entity
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/lazyjean/sla2/internal/domain/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewWordRelation(t *testing.T) {
+	// 无方向的关联按ID排序两端
+	relation, err := NewWordRelation(5, 3, LexicalRelationTypeSynonym, " 近义 ")
+	require.NoError(t, err)
+	assert.Equal(t, WordID(3), relation.SourceID)
+	assert.Equal(t, WordID(5), relation.TargetID)
+	assert.Equal(t, "近义", relation.Note)
+
+	// 派生关联保留方向
+	relation, err = NewWordRelation(5, 3, LexicalRelationTypeDerivedFrom, "")
+	require.NoError(t, err)
+	assert.Equal(t, WordID(5), relation.SourceID)
+	assert.Equal(t, WordID(3), relation.TargetID)
+
+	_, err = NewWordRelation(3, 3, LexicalRelationTypeSynonym, "")
+	assert.ErrorIs(t, err, errors.ErrInvalidRelationTarget)
+	_, err = NewWordRelation(0, 3, LexicalRelationTypeSynonym, "")
+	assert.ErrorIs(t, err, errors.ErrInvalidRelationTarget)
+	_, err = NewWordRelation(1, 3, LexicalRelationTypeConfusable+1, "")
+	assert.ErrorIs(t, err, errors.ErrInvalidRelationType)
+	_, err = NewWordRelation(1, 3, LexicalRelationTypeCollocation, strings.Repeat("搭", MaxRelationNoteLength+1))
+	assert.ErrorIs(t, err, errors.ErrInvalidRelationNote)
+}
+
+func TestWordRelation_Other(t *testing.T) {
+	happy := &Word{ID: 1, Text: "happy"}
+	happiness := &Word{ID: 2, Text: "happiness"}
+	relation := &WordRelation{SourceID: 2, TargetID: 1, Type: LexicalRelationTypeDerivedFrom, Source: happiness, Target: happy}
+
+	word, inverse := relation.Other(2)
+	assert.Equal(t, happy, word)
+	assert.False(t, inverse)
+	word, inverse = relation.Other(1)
+	assert.Equal(t, happiness, word)
+	assert.True(t, inverse)
+
+	relation.Type = LexicalRelationTypeWordFamily
+	_, inverse = relation.Other(1)
+	assert.False(t, inverse)
+}
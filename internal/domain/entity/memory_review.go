@@ -14,40 +14,164 @@ const (
 	ReviewResultSkip        ReviewResult = 3 // 跳过
 )
 
-// MemoryReview 记忆复习记录, todo: 这个记录保留一年即可
+// Grade 将复习结果转换为复习评分
+// 正确视为良好，错误视为忘记，跳过和未指定无法转换
+func (r ReviewResult) Grade() ReviewGrade {
+	switch r {
+	case ReviewResultCorrect:
+		return ReviewGradeGood
+	case ReviewResultWrong:
+		return ReviewGradeAgain
+	case ReviewResultSkip:
+		return ReviewGradeSkip
+	default:
+		return ReviewGradeUnspecified
+	}
+}
+
+// ReviewGrade 复习评分
+// 四级评分区分忘记、勉强想起、正常想起和轻松想起
+type ReviewGrade uint8
+
+const (
+	ReviewGradeUnspecified ReviewGrade = 0 // 未指定
+	ReviewGradeAgain       ReviewGrade = 1 // 忘记
+	ReviewGradeHard        ReviewGrade = 2 // 困难，犹豫后想起
+	ReviewGradeGood        ReviewGrade = 3 // 良好
+	ReviewGradeEasy        ReviewGrade = 4 // 简单，立即想起
+	ReviewGradeSkip        ReviewGrade = 5 // 跳过，不影响复习统计和调度
+)
+
+// IsValid 判断是否为有效的评分（包括跳过）
+func (g ReviewGrade) IsValid() bool {
+	return g >= ReviewGradeAgain && g <= ReviewGradeSkip
+}
+
+// IsRecalled 判断是否成功回忆
+func (g ReviewGrade) IsRecalled() bool {
+	return g >= ReviewGradeHard && g <= ReviewGradeEasy
+}
+
+// Result 将复习评分转换为复习结果
+func (g ReviewGrade) Result() ReviewResult {
+	switch {
+	case g == ReviewGradeSkip:
+		return ReviewResultSkip
+	case g.IsRecalled():
+		return ReviewResultCorrect
+	case g == ReviewGradeAgain:
+		return ReviewResultWrong
+	default:
+		return ReviewResultUnspecified
+	}
+}
+
+// ReviewAction 复习记录的操作类型
+// 除复习外，重置、搁置和调整复习日期等人工调整也记录在复习日志中，便于追溯调度变化
+type ReviewAction uint8
+
+const (
+	ReviewActionReview     ReviewAction = 0 // 复习
+	ReviewActionReset      ReviewAction = 1 // 重置为新内容
+	ReviewActionBury       ReviewAction = 2 // 搁置到下一个学习日
+	ReviewActionReschedule ReviewAction = 3 // 调整复习日期
+	ReviewActionSetMastery ReviewAction = 4 // 手动设置掌握程度
+)
+
+// MemoryReviewRetention 复习记录保留时长，超过一年的记录会被定期清理
+const MemoryReviewRetention = 365 * 24 * time.Hour
+
+// MemoryReview 记忆复习记录
 // 用于记录用户对记忆单元的复习情况，包括复习结果、响应时间等信息
 // 表名：memory_reviews
 // 注释：记忆复习记录表，记录用户对记忆单元的复习情况
 type MemoryReview struct {
-	ID           uint32       `gorm:"primaryKey;comment:主键ID"`
-	MemoryUnitID uint32       `gorm:"not null;index;comment:记忆单元ID，关联到记忆单元表"`
-	UserID       uint32       `gorm:"not null;index;comment:用户ID，关联到用户表"`
-	Result       ReviewResult `gorm:"not null;comment:复习结果，0-未指定，1-正确，2-错误，3-跳过"`
-	ResponseTime uint32       `gorm:"not null;comment:响应时间，单位毫秒，表示用户从看到题目到做出回答的时间"`
-	ReviewTime   time.Time    `gorm:"not null;comment:实际的复习时间，表示用户进行复习的具体时间点"`
-	CreatedAt    time.Time    `gorm:"not null;comment:记录创建时间，由数据库自动维护"`
+	ID             uint32         `gorm:"primaryKey;comment:主键ID"`
+	MemoryUnitID   uint32         `gorm:"not null;index;index:idx_memory_reviews_unit_time,priority:1;comment:记忆单元ID，关联到记忆单元表"`
+	UserID         uint32         `gorm:"not null;index;index:idx_memory_reviews_user_time,priority:1;uniqueIndex:idx_memory_reviews_user_idempotency,priority:1;comment:用户ID，关联到用户表"`
+	SessionID      StudySessionID `gorm:"not null;default:0;index;comment:学习会话ID，不在会话中复习时为0"`
+	IdempotencyKey string         `gorm:"type:varchar(64);not null;default:'';uniqueIndex:idx_memory_reviews_user_idempotency,priority:2,where:idempotency_key <> '';comment:客户端生成的幂等键，用于离线复习的批量提交去重，为空表示不去重"`
+	Result         ReviewResult   `gorm:"not null;comment:复习结果，0-未指定，1-正确，2-错误，3-跳过"`
+	Grade          ReviewGrade    `gorm:"not null;default:0;comment:复习评分，0-未指定，1-忘记，2-困难，3-良好，4-简单，5-跳过"`
+	Action         ReviewAction   `gorm:"not null;default:0;comment:操作类型，0-复习，1-重置，2-搁置，3-调整复习日期，4-设置掌握程度；人工调整记录的评分和结果为未指定"`
+	ResponseTime   uint32         `gorm:"not null;comment:响应时间，单位毫秒，表示用户从看到题目到做出回答的时间"`
+	IntervalBefore uint32         `gorm:"not null;default:0;comment:复习前的计划间隔（秒），即上次复习到原定下次复习时间的间隔"`
+	IntervalAfter  uint32         `gorm:"not null;default:0;comment:复习后的新间隔（秒），即本次复习到新的下次复习时间的间隔"`
+	MasteryBefore  MasteryLevel   `gorm:"not null;default:0;comment:复习前的掌握程度"`
+	MasteryAfter   MasteryLevel   `gorm:"not null;default:0;comment:复习后的掌握程度"`
+	ScheduledTime  time.Time      `gorm:"comment:原定的复习时间，即复习前记忆单元的下次复习时间"`
+	ReviewTime     time.Time      `gorm:"not null;index;index:idx_memory_reviews_unit_time,priority:2;index:idx_memory_reviews_user_time,priority:2;comment:实际的复习时间，表示用户进行复习的具体时间点"`
+	CreatedAt      time.Time      `gorm:"not null;comment:记录创建时间，由数据库自动维护"`
 }
 
 // NewMemoryReview 创建新的复习记录
 // memoryUnitID: 记忆单元ID
 // userID: 用户ID
-// result: 复习结果
+// grade: 复习评分
 // responseTime: 响应时间（毫秒）
+// intervalBefore: 复习前的计划间隔
+// intervalAfter: 复习后的新间隔
 func NewMemoryReview(
 	memoryUnitID uint32,
 	userID uint32,
-	result ReviewResult,
+	grade ReviewGrade,
 	responseTime uint32,
+	intervalBefore time.Duration,
+	intervalAfter time.Duration,
 ) *MemoryReview {
 	now := time.Now()
 	return &MemoryReview{
-		MemoryUnitID: memoryUnitID,
-		UserID:       userID,
-		Result:       result,
-		ResponseTime: responseTime,
-		ReviewTime:   now,
-		CreatedAt:    now,
+		MemoryUnitID:   memoryUnitID,
+		UserID:         userID,
+		Result:         grade.Result(),
+		Grade:          grade,
+		ResponseTime:   responseTime,
+		IntervalBefore: durationSeconds(intervalBefore),
+		IntervalAfter:  durationSeconds(intervalAfter),
+		ReviewTime:     now,
+		CreatedAt:      now,
+	}
+}
+
+// NewMemoryAdjustment 创建人工调整记录
+// before 为调整前的记忆单元快照，after 为调整后的记忆单元；记录计划复习时间和掌握程度的变化，
+// 评分和结果为未指定，不计入复习统计
+func NewMemoryAdjustment(action ReviewAction, before, after *MemoryUnit) *MemoryReview {
+	now := time.Now()
+	review := &MemoryReview{
+		MemoryUnitID:   uint32(after.ID),
+		UserID:         uint32(after.UserID),
+		Action:         action,
+		IntervalBefore: durationSeconds(before.NextReviewAt.Sub(before.LastReviewAt)),
+		IntervalAfter:  durationSeconds(after.NextReviewAt.Sub(after.LastReviewAt)),
+		ReviewTime:     now,
+		CreatedAt:      now,
+	}
+	review.SetTransition(before.NextReviewAt, before.MasteryLevel, after.MasteryLevel)
+	return review
+}
+
+// SetTransition 记录本次复习前后的计划复习时间和掌握程度变化
+// scheduledTime: 原定的复习时间
+// masteryBefore: 复习前的掌握程度
+// masteryAfter: 复习后的掌握程度
+func (m *MemoryReview) SetTransition(scheduledTime time.Time, masteryBefore, masteryAfter MasteryLevel) {
+	m.ScheduledTime = scheduledTime
+	m.MasteryBefore = masteryBefore
+	m.MasteryAfter = masteryAfter
+}
+
+// Delay 实际复习时间相对原定复习时间的偏差，正数表示逾期复习，负数表示提前复习
+func (m *MemoryReview) Delay() time.Duration {
+	if m.ScheduledTime.IsZero() {
+		return 0
 	}
+	return m.ReviewTime.Sub(m.ScheduledTime)
+}
+
+// IsAdjustment 判断是否为人工调整记录
+func (m *MemoryReview) IsAdjustment() bool {
+	return m.Action != ReviewActionReview
 }
 
 // IsCorrect 判断是否正确
@@ -67,3 +191,11 @@ func (m *MemoryReview) IsWrong() bool {
 func (m *MemoryReview) IsSkip() bool {
 	return m.Result == ReviewResultSkip
 }
+
+// durationSeconds 将时长转换为秒，负数按0处理
+func durationSeconds(d time.Duration) uint32 {
+	if d <= 0 {
+		return 0
+	}
+	return uint32(d / time.Second)
+}
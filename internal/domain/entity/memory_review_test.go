@@ -0,0 +1,60 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewMemoryReview(t *testing.T) {
+	review := NewMemoryReview(1, 2, ReviewGradeHard, 1500, 90*time.Minute, -time.Second)
+
+	assert.Equal(t, uint32(1), review.MemoryUnitID)
+	assert.Equal(t, uint32(2), review.UserID)
+	assert.Equal(t, ReviewGradeHard, review.Grade)
+	assert.Equal(t, ReviewResultCorrect, review.Result)
+	assert.Equal(t, uint32(1500), review.ResponseTime)
+	assert.Equal(t, uint32(5400), review.IntervalBefore)
+	assert.Equal(t, uint32(0), review.IntervalAfter)
+	assert.True(t, review.ScheduledTime.IsZero())
+}
+
+func TestMemoryReviewTransition(t *testing.T) {
+	review := NewMemoryReview(1, 2, ReviewGradeGood, 1000, time.Hour, 4*time.Hour)
+	assert.Equal(t, time.Duration(0), review.Delay())
+
+	// 逾期复习
+	review.SetTransition(review.ReviewTime.Add(-2*time.Hour), MasteryLevelUnlearned, MasteryLevelBeginner)
+	assert.Equal(t, 2*time.Hour, review.Delay())
+	assert.Equal(t, MasteryLevelUnlearned, review.MasteryBefore)
+	assert.Equal(t, MasteryLevelBeginner, review.MasteryAfter)
+
+	// 提前复习
+	review.SetTransition(review.ReviewTime.Add(time.Hour), MasteryLevelBeginner, MasteryLevelBeginner)
+	assert.Equal(t, -time.Hour, review.Delay())
+}
+
+func TestNewMemoryAdjustment(t *testing.T) {
+	now := time.Now()
+	unit := NewMemoryUnit(2, MemoryUnitTypeWord, 100)
+	unit.ID = 1
+	unit.LastReviewAt = now.Add(-24 * time.Hour)
+	unit.NextReviewAt = now.Add(-time.Hour)
+	unit.MasteryLevel = MasteryLevelFamiliar
+	before := *unit
+
+	unit.Reschedule(now.Add(24 * time.Hour))
+	review := NewMemoryAdjustment(ReviewActionReschedule, &before, unit)
+
+	assert.True(t, review.IsAdjustment())
+	assert.Equal(t, uint32(1), review.MemoryUnitID)
+	assert.Equal(t, uint32(2), review.UserID)
+	assert.Equal(t, ReviewGradeUnspecified, review.Grade)
+	assert.Equal(t, ReviewResultUnspecified, review.Result)
+	assert.Equal(t, uint32(23*3600), review.IntervalBefore)
+	assert.Equal(t, uint32(48*3600), review.IntervalAfter)
+	assert.Equal(t, before.NextReviewAt, review.ScheduledTime)
+	assert.Equal(t, MasteryLevelFamiliar, review.MasteryAfter)
+	assert.False(t, NewMemoryReview(1, 2, ReviewGradeGood, 0, 0, 0).IsAdjustment())
+}
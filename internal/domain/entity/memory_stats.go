@@ -31,6 +31,16 @@ type DailyStat struct {
 	ReviewInterval uint32    `json:"review_interval" gorm:"comment:平均复习间隔（天），当天复习的记忆单元的平均间隔"`
 }
 
+// StudyStreak 连续学习天数
+// 有复习或新学习记录的学习日视为已学习，断签保护天数内的中断不会清零连续学习
+type StudyStreak struct {
+	Current        uint32    // 当前连续学习天数，今天尚未学习时不中断
+	Longest        uint32    // 最长连续学习天数
+	FreezeDaysUsed uint32    // 当前连续学习中已使用的断签保护天数
+	StudiedToday   bool      // 今天是否已学习
+	LastStudyDate  time.Time // 最近一次学习的学习日开始时间，从未学习时为零值
+}
+
 // NewMemoryStats 创建新的记忆统计
 func NewMemoryStats() *MemoryStats {
 	return &MemoryStats{
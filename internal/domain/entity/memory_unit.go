@@ -1,6 +1,7 @@
 package entity
 
 import (
+	"strconv"
 	"time"
 )
 
@@ -8,11 +9,35 @@ import (
 type MemoryUnitType uint8
 
 const (
-	MemoryUnitTypeUnspecified MemoryUnitType = 0 // 未指定
-	MemoryUnitTypeHanChar     MemoryUnitType = 1 // 汉字
-	MemoryUnitTypeWord        MemoryUnitType = 2 // 单词
+	MemoryUnitTypeUnspecified  MemoryUnitType = 0 // 未指定
+	MemoryUnitTypeHanChar      MemoryUnitType = 1 // 汉字
+	MemoryUnitTypeWord         MemoryUnitType = 2 // 单词
+	MemoryUnitTypePhrase       MemoryUnitType = 3 // 短语（含固定搭配、习语）
+	MemoryUnitTypeSentence     MemoryUnitType = 4 // 例句
+	MemoryUnitTypeGrammarPoint MemoryUnitType = 5 // 语法点
+	MemoryUnitTypeCustomWord   MemoryUnitType = 6 // 生词本中的自定义词条
 )
 
+// MemoryUnitTypes 所有可学习的记忆单元类型
+var MemoryUnitTypes = []MemoryUnitType{
+	MemoryUnitTypeHanChar,
+	MemoryUnitTypeWord,
+	MemoryUnitTypePhrase,
+	MemoryUnitTypeSentence,
+	MemoryUnitTypeGrammarPoint,
+	MemoryUnitTypeCustomWord,
+}
+
+// IsValid 检查记忆单元类型是否为可学习的类型
+func (t MemoryUnitType) IsValid() bool {
+	return t >= MemoryUnitTypeHanChar && t <= MemoryUnitTypeCustomWord
+}
+
+// MarshalJSON 按数字编码，避免 []MemoryUnitType 被编码为 base64 字符串
+func (t MemoryUnitType) MarshalJSON() ([]byte, error) {
+	return strconv.AppendUint(nil, uint64(t), 10), nil
+}
+
 // MemoryUnitID 记忆单元ID类型
 type MemoryUnitID uint32
 
@@ -28,6 +53,11 @@ const (
 	MasteryLevelExpert      MasteryLevel = 5 // 精通
 )
 
+// IsValid 判断是否为可以设置的掌握程度（不含未指定）
+func (l MasteryLevel) IsValid() bool {
+	return l >= MasteryLevelUnlearned && l <= MasteryLevelExpert
+}
+
 // MemoryUnit 记忆单元
 // 用于表示一个可记忆的学习内容，如汉字、单词等
 // 表名：memory_units
@@ -35,7 +65,7 @@ const (
 type MemoryUnit struct {
 	ID        MemoryUnitID   `gorm:"primaryKey;comment:主键ID"`
 	UserID    UID            `gorm:"not null;index:idx_user_content_type,unique;comment:用户ID"`
-	Type      MemoryUnitType `gorm:"not null;index:idx_user_content_type,unique;comment:记忆单元类型，0-未指定，1-汉字，2-单词"`
+	Type      MemoryUnitType `gorm:"not null;index:idx_user_content_type,unique;comment:记忆单元类型，0-未指定，1-汉字，2-单词，3-短语，4-例句，5-语法点，6-自定义词条"`
 	ContentID uint32         `gorm:"not null;index:idx_user_content_type,unique;comment:内容ID，关联到具体的内容表（如汉字表、单词表等）"`
 	CreatedAt time.Time      `gorm:"not null;comment:记录创建时间，由数据库自动维护"`
 	UpdatedAt time.Time      `gorm:"not null;comment:记录更新时间，由数据库自动维护"`
@@ -49,6 +79,16 @@ type MemoryUnit struct {
 	RetentionRate      float32      `gorm:"not null;default:0;comment:记忆保持率（0-1），表示记忆的牢固程度"`
 	ConsecutiveCorrect uint32       `gorm:"not null;default:0;comment:连续正确次数，用于评估记忆稳定性"`
 	ConsecutiveWrong   uint32       `gorm:"not null;default:0;comment:连续错误次数，用于评估记忆难度"`
+
+	// FSRS 调度状态
+	Stability      float64 `gorm:"not null;default:0;comment:记忆稳定性（天），可提取概率降至90%所需的天数，0表示尚未调度"`
+	Difficulty     float64 `gorm:"not null;default:0;comment:记忆难度（1-10），数值越大越难记住"`
+	Retrievability float64 `gorm:"not null;default:0;comment:可提取概率（0-1），最近一次复习时估算的回忆成功概率"`
+
+	// 顽固项状态
+	Lapses    uint32 `gorm:"not null;default:0;comment:遗忘次数，累计评分为忘记的次数"`
+	IsLeech   bool   `gorm:"not null;default:false;index;comment:是否为顽固项，遗忘次数达到阈值时标记"`
+	Suspended bool   `gorm:"not null;default:false;comment:是否暂停复习，暂停后不再出现在复习队列中"`
 }
 
 // NewMemoryUnit 创建新的记忆单元
@@ -78,28 +118,97 @@ func (m *MemoryUnit) IsDueForReview() bool {
 }
 
 // UpdateReviewStats 更新复习统计
-func (m *MemoryUnit) UpdateReviewStats(isCorrect bool, responseTime uint32) {
+// reviewedAt 为实际复习时间，离线复习时为客户端记录的时间；
+// 忘记会中断连续正确次数；困难保持连续正确次数但不再增加；良好加一；简单加二
+func (m *MemoryUnit) UpdateReviewStats(grade ReviewGrade, responseTime uint32, reviewedAt time.Time) {
 	m.ReviewCount++
-	m.LastReviewAt = time.Now()
+	m.LastReviewAt = reviewedAt
 	m.StudyDuration += responseTime / 1000 // 转换为秒
 
-	if isCorrect {
-		m.ConsecutiveCorrect++
-		m.ConsecutiveWrong = 0
-	} else {
+	switch grade {
+	case ReviewGradeAgain:
 		m.ConsecutiveWrong++
 		m.ConsecutiveCorrect = 0
+		m.Lapses++
+	case ReviewGradeHard:
+		m.ConsecutiveWrong = 0
+		if m.ConsecutiveCorrect == 0 {
+			m.ConsecutiveCorrect = 1
+		}
+	case ReviewGradeGood:
+		m.ConsecutiveWrong = 0
+		m.ConsecutiveCorrect++
+	case ReviewGradeEasy:
+		m.ConsecutiveWrong = 0
+		m.ConsecutiveCorrect += 2
 	}
 
 	// 更新记忆保持率
 	if m.ReviewCount > 0 {
-		m.RetentionRate = float32(m.ConsecutiveCorrect) / float32(m.ReviewCount)
+		m.RetentionRate = min(float32(m.ConsecutiveCorrect)/float32(m.ReviewCount), 1)
 	}
 
 	// 更新掌握程度
 	m.updateMasteryLevel()
 }
 
+// MarkLeech 遗忘次数达到阈值时将记忆单元标记为顽固项
+// threshold 为 0 表示不检测；返回本次是否新标记为顽固项，已是顽固项时不重复标记
+func (m *MemoryUnit) MarkLeech(threshold uint32) bool {
+	if threshold == 0 || m.IsLeech || m.Lapses < threshold {
+		return false
+	}
+	m.IsLeech = true
+	return true
+}
+
+// Suspend 暂停复习
+func (m *MemoryUnit) Suspend() {
+	m.Suspended = true
+}
+
+// Unsuspend 恢复复习，保留顽固项标记
+func (m *MemoryUnit) Unsuspend() {
+	m.Suspended = false
+}
+
+// ResetLeech 清除顽固项状态，遗忘次数从零开始重新累计
+func (m *MemoryUnit) ResetLeech() {
+	m.Lapses = 0
+	m.ConsecutiveWrong = 0
+	m.IsLeech = false
+	m.Suspended = false
+}
+
+// Reset 重置为新内容，清除复习进度、调度状态和顽固项状态，并立即到期
+// 累计学习时长和上次复习时间保留
+func (m *MemoryUnit) Reset(now time.Time) {
+	m.MasteryLevel = MasteryLevelUnlearned
+	m.ReviewCount = 0
+	m.NextReviewAt = now
+	m.RetentionRate = 0
+	m.ConsecutiveCorrect = 0
+	m.ConsecutiveWrong = 0
+	m.Stability = 0
+	m.Difficulty = 0
+	m.Retrievability = 0
+	m.Lapses = 0
+	m.IsLeech = false
+	m.Suspended = false
+}
+
+// Bury 搁置到 until 再复习，原定复习时间晚于 until 时不提前
+func (m *MemoryUnit) Bury(until time.Time) {
+	if m.NextReviewAt.Before(until) {
+		m.NextReviewAt = until
+	}
+}
+
+// Reschedule 将下次复习时间调整为 at，调度状态保留
+func (m *MemoryUnit) Reschedule(at time.Time) {
+	m.NextReviewAt = at
+}
+
 // updateMasteryLevel 根据复习情况更新掌握程度
 func (m *MemoryUnit) updateMasteryLevel() {
 	switch {
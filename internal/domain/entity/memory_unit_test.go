@@ -30,6 +30,14 @@ func TestNewMemoryUnit(t *testing.T) {
 	assert.True(t, unit.LastReviewAt.After(now) || unit.LastReviewAt.Equal(now))
 }
 
+func TestMemoryUnitType_IsValid(t *testing.T) {
+	for _, unitType := range MemoryUnitTypes {
+		assert.True(t, unitType.IsValid(), "type %d should be valid", unitType)
+	}
+	assert.False(t, MemoryUnitTypeUnspecified.IsValid())
+	assert.False(t, MemoryUnitType(MemoryUnitTypeCustomWord+1).IsValid())
+}
+
 func TestUpdate(t *testing.T) {
 	unit := NewMemoryUnit(1, MemoryUnitTypeHanChar, 100)
 	oldUpdatedAt := unit.UpdatedAt
@@ -55,52 +63,94 @@ func TestIsDueForReview(t *testing.T) {
 
 func TestUpdateReviewStats(t *testing.T) {
 	tests := []struct {
-		name           string
-		isCorrect      bool
-		responseTime   uint32
-		initialCorrect uint32
-		initialWrong   uint32
-		expectedLevel  MasteryLevel
+		name            string
+		grade           ReviewGrade
+		responseTime    uint32
+		initialCorrect  uint32
+		initialWrong    uint32
+		expectedCorrect uint32
+		expectedWrong   uint32
+		expectedLevel   MasteryLevel
 	}{
 		{
-			name:           "第一次正确",
-			isCorrect:      true,
-			responseTime:   5000,
-			initialCorrect: 0,
-			initialWrong:   0,
-			expectedLevel:  MasteryLevelBeginner,
+			name:            "第一次良好",
+			grade:           ReviewGradeGood,
+			responseTime:    5000,
+			initialCorrect:  0,
+			initialWrong:    0,
+			expectedCorrect: 1,
+			expectedWrong:   0,
+			expectedLevel:   MasteryLevelBeginner,
+		},
+		{
+			name:            "连续5次良好",
+			grade:           ReviewGradeGood,
+			responseTime:    5000,
+			initialCorrect:  4,
+			initialWrong:    0,
+			expectedCorrect: 5,
+			expectedWrong:   0,
+			expectedLevel:   MasteryLevelMastered,
+		},
+		{
+			name:            "连续10次良好",
+			grade:           ReviewGradeGood,
+			responseTime:    5000,
+			initialCorrect:  9,
+			initialWrong:    0,
+			expectedCorrect: 10,
+			expectedWrong:   0,
+			expectedLevel:   MasteryLevelExpert,
 		},
 		{
-			name:           "连续5次正确",
-			isCorrect:      true,
-			responseTime:   5000,
-			initialCorrect: 4,
-			initialWrong:   0,
-			expectedLevel:  MasteryLevelMastered,
+			name:            "第一次忘记",
+			grade:           ReviewGradeAgain,
+			responseTime:    5000,
+			initialCorrect:  0,
+			initialWrong:    0,
+			expectedCorrect: 0,
+			expectedWrong:   1,
+			expectedLevel:   MasteryLevelUnlearned,
 		},
 		{
-			name:           "连续10次正确",
-			isCorrect:      true,
-			responseTime:   5000,
-			initialCorrect: 9,
-			initialWrong:   0,
-			expectedLevel:  MasteryLevelExpert,
+			name:            "连续3次忘记",
+			grade:           ReviewGradeAgain,
+			responseTime:    5000,
+			initialCorrect:  0,
+			initialWrong:    2,
+			expectedCorrect: 0,
+			expectedWrong:   3,
+			expectedLevel:   MasteryLevelUnlearned,
 		},
 		{
-			name:           "第一次错误",
-			isCorrect:      false,
-			responseTime:   5000,
-			initialCorrect: 0,
-			initialWrong:   0,
-			expectedLevel:  MasteryLevelUnlearned,
+			name:            "困难保持连续正确次数",
+			grade:           ReviewGradeHard,
+			responseTime:    5000,
+			initialCorrect:  3,
+			initialWrong:    0,
+			expectedCorrect: 3,
+			expectedWrong:   0,
+			expectedLevel:   MasteryLevelFamiliar,
 		},
 		{
-			name:           "连续3次错误",
-			isCorrect:      false,
-			responseTime:   5000,
-			initialCorrect: 0,
-			initialWrong:   2,
-			expectedLevel:  MasteryLevelUnlearned,
+			name:            "第一次困难",
+			grade:           ReviewGradeHard,
+			responseTime:    5000,
+			initialCorrect:  0,
+			initialWrong:    1,
+			expectedCorrect: 1,
+			expectedWrong:   0,
+			expectedLevel:   MasteryLevelBeginner,
+		},
+		{
+			name:            "简单跳过一级",
+			grade:           ReviewGradeEasy,
+			responseTime:    5000,
+			initialCorrect:  3,
+			initialWrong:    0,
+			expectedCorrect: 5,
+			expectedWrong:   0,
+			expectedLevel:   MasteryLevelMastered,
 		},
 	}
 
@@ -112,32 +162,114 @@ func TestUpdateReviewStats(t *testing.T) {
 			oldReviewCount := unit.ReviewCount
 			oldStudyDuration := unit.StudyDuration
 
-			unit.UpdateReviewStats(tt.isCorrect, tt.responseTime)
+			reviewedAt := time.Now().Add(-time.Hour)
+			unit.UpdateReviewStats(tt.grade, tt.responseTime, reviewedAt)
 
 			// 检查基本统计
 			assert.Equal(t, oldReviewCount+1, unit.ReviewCount)
 			assert.Equal(t, oldStudyDuration+5, unit.StudyDuration) // 5000ms = 5s
-			assert.True(t, unit.LastReviewAt.After(time.Now().Add(-time.Second)))
+			assert.Equal(t, reviewedAt, unit.LastReviewAt)
 
 			// 检查连续正确/错误次数
-			if tt.isCorrect {
-				assert.Equal(t, tt.initialCorrect+1, unit.ConsecutiveCorrect)
-				assert.Equal(t, uint32(0), unit.ConsecutiveWrong)
-			} else {
-				assert.Equal(t, tt.initialWrong+1, unit.ConsecutiveWrong)
-				assert.Equal(t, uint32(0), unit.ConsecutiveCorrect)
-			}
+			assert.Equal(t, tt.expectedCorrect, unit.ConsecutiveCorrect)
+			assert.Equal(t, tt.expectedWrong, unit.ConsecutiveWrong)
 
 			// 检查掌握程度
 			assert.Equal(t, tt.expectedLevel, unit.MasteryLevel)
 
 			// 检查记忆保持率
-			expectedRetentionRate := float32(unit.ConsecutiveCorrect) / float32(unit.ReviewCount)
+			expectedRetentionRate := min(float32(unit.ConsecutiveCorrect)/float32(unit.ReviewCount), 1)
 			assert.Equal(t, expectedRetentionRate, unit.RetentionRate)
 		})
 	}
 }
 
+func TestMemoryUnitLeech(t *testing.T) {
+	unit := NewMemoryUnit(1, MemoryUnitTypeHanChar, 100)
+
+	// 只有忘记累计遗忘次数，答对不清零
+	for i := 0; i < 2; i++ {
+		unit.UpdateReviewStats(ReviewGradeAgain, 0, time.Now())
+	}
+	unit.UpdateReviewStats(ReviewGradeGood, 0, time.Now())
+	assert.Equal(t, uint32(2), unit.Lapses)
+
+	// 阈值为 0 不检测
+	assert.False(t, unit.MarkLeech(0))
+	assert.False(t, unit.MarkLeech(3))
+
+	unit.UpdateReviewStats(ReviewGradeAgain, 0, time.Now())
+	assert.True(t, unit.MarkLeech(3))
+	assert.True(t, unit.IsLeech)
+	// 已是顽固项时不重复标记
+	assert.False(t, unit.MarkLeech(3))
+
+	unit.Suspend()
+	unit.Unsuspend()
+	assert.False(t, unit.Suspended)
+	assert.True(t, unit.IsLeech)
+
+	unit.Suspend()
+	unit.ResetLeech()
+	assert.Zero(t, unit.Lapses)
+	assert.Zero(t, unit.ConsecutiveWrong)
+	assert.False(t, unit.IsLeech)
+	assert.False(t, unit.Suspended)
+}
+
+func TestMemoryUnitAdjustments(t *testing.T) {
+	now := time.Now()
+	unit := NewMemoryUnit(1, MemoryUnitTypeWord, 100)
+	for i := 0; i < 3; i++ {
+		unit.UpdateReviewStats(ReviewGradeAgain, 2000, now)
+	}
+	unit.UpdateReviewStats(ReviewGradeGood, 2000, now)
+	unit.Stability, unit.Difficulty = 3, 7
+	unit.MarkLeech(3)
+	unit.Suspend()
+
+	// 重置为新内容，学习时长保留
+	unit.Reset(now)
+	assert.Equal(t, MasteryLevelUnlearned, unit.MasteryLevel)
+	assert.Zero(t, unit.ReviewCount)
+	assert.Zero(t, unit.ConsecutiveCorrect)
+	assert.Zero(t, unit.Stability)
+	assert.Zero(t, unit.Difficulty)
+	assert.Zero(t, unit.Lapses)
+	assert.False(t, unit.IsLeech)
+	assert.False(t, unit.Suspended)
+	assert.Equal(t, now, unit.NextReviewAt)
+	assert.Equal(t, uint32(8), unit.StudyDuration)
+
+	// 搁置不会提前原定的复习时间
+	tomorrow := now.Add(24 * time.Hour)
+	unit.Bury(tomorrow)
+	assert.Equal(t, tomorrow, unit.NextReviewAt)
+	unit.Bury(now)
+	assert.Equal(t, tomorrow, unit.NextReviewAt)
+
+	unit.Reschedule(now.Add(time.Hour))
+	assert.Equal(t, now.Add(time.Hour), unit.NextReviewAt)
+}
+
+func TestReviewGrade(t *testing.T) {
+	assert.Equal(t, ReviewGradeGood, ReviewResultCorrect.Grade())
+	assert.Equal(t, ReviewGradeAgain, ReviewResultWrong.Grade())
+	assert.Equal(t, ReviewGradeSkip, ReviewResultSkip.Grade())
+	assert.Equal(t, ReviewGradeUnspecified, ReviewResultUnspecified.Grade())
+
+	assert.Equal(t, ReviewResultCorrect, ReviewGradeHard.Result())
+	assert.Equal(t, ReviewResultCorrect, ReviewGradeEasy.Result())
+	assert.Equal(t, ReviewResultWrong, ReviewGradeAgain.Result())
+	assert.Equal(t, ReviewResultSkip, ReviewGradeSkip.Result())
+
+	assert.False(t, ReviewGradeUnspecified.IsValid())
+	assert.True(t, ReviewGradeSkip.IsValid())
+	assert.False(t, ReviewGradeSkip.IsRecalled())
+	assert.False(t, ReviewGradeAgain.IsRecalled())
+	assert.True(t, ReviewGradeHard.IsRecalled())
+}
+
 func TestUpdateMasteryLevel(t *testing.T) {
 	tests := []struct {
 		name               string
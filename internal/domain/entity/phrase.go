@@ -0,0 +1,64 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/lazyjean/sla2/internal/domain/valueobject"
+	"gorm.io/gorm"
+)
+
+// PhraseID 短语ID类型
+type PhraseID uint32
+
+// PhraseKind 短语类别
+type PhraseKind uint8
+
+const (
+	PhraseKindUnspecified PhraseKind = 0 // 未指定
+	PhraseKindPhrase      PhraseKind = 1 // 短语
+	PhraseKindCollocation PhraseKind = 2 // 固定搭配
+	PhraseKindIdiom       PhraseKind = 3 // 习语/成语
+)
+
+// Phrase 短语实体
+// 短语、固定搭配和习语都作为一个整体记忆，按 Kind 区分
+type Phrase struct {
+	// ID 唯一标识符
+	ID PhraseID `gorm:"primaryKey;autoIncrement;comment:唯一标识符"`
+	// Text 短语文本
+	Text string `gorm:"type:varchar(200);not null;uniqueIndex;comment:短语文本"`
+	// Kind 短语类别
+	Kind PhraseKind `gorm:"type:smallint;not null;default:1;comment:短语类别，1-短语，2-固定搭配，3-习语"`
+	// Meaning 释义
+	Meaning string `gorm:"type:text;not null;comment:释义"`
+	// Examples 例句列表
+	Examples []string `gorm:"type:jsonb;serializer:json;not null;comment:例句列表"`
+	// Tags 标签列表
+	Tags []string `gorm:"type:jsonb;serializer:json;not null;comment:标签列表"`
+	// Level 难度等级
+	Level valueobject.WordDifficultyLevel `gorm:"type:integer;not null;comment:难度等级"`
+	// CreatedAt 创建时间
+	CreatedAt time.Time `gorm:"type:timestamp with time zone;not null;comment:创建时间"`
+	// UpdatedAt 更新时间
+	UpdatedAt time.Time `gorm:"type:timestamp with time zone;not null;comment:更新时间"`
+	// DeletedAt 删除时间
+	DeletedAt gorm.DeletedAt `gorm:"index;comment:删除时间"`
+}
+
+// NewPhrase 创建新的短语实体
+func NewPhrase(text string, kind PhraseKind, meaning string, level valueobject.WordDifficultyLevel) *Phrase {
+	if kind == PhraseKindUnspecified {
+		kind = PhraseKindPhrase
+	}
+	now := time.Now()
+	return &Phrase{
+		Text:      text,
+		Kind:      kind,
+		Meaning:   meaning,
+		Examples:  []string{},
+		Tags:      []string{},
+		Level:     level,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
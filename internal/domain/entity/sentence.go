@@ -0,0 +1,47 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/lazyjean/sla2/internal/domain/valueobject"
+	"gorm.io/gorm"
+)
+
+// SentenceID 例句ID类型
+type SentenceID uint32
+
+// Sentence 例句实体
+// 整句作为一个记忆单元学习，用于听写、翻译等整句练习
+type Sentence struct {
+	// ID 唯一标识符
+	ID SentenceID `gorm:"primaryKey;autoIncrement;comment:唯一标识符"`
+	// Text 例句文本
+	Text string `gorm:"type:text;not null;comment:例句文本"`
+	// Translation 译文
+	Translation string `gorm:"type:text;not null;comment:译文"`
+	// Source 出处
+	Source string `gorm:"type:varchar(200);not null;default:'';comment:出处"`
+	// Tags 标签列表
+	Tags []string `gorm:"type:jsonb;serializer:json;not null;comment:标签列表"`
+	// Level 难度等级
+	Level valueobject.WordDifficultyLevel `gorm:"type:integer;not null;comment:难度等级"`
+	// CreatedAt 创建时间
+	CreatedAt time.Time `gorm:"type:timestamp with time zone;not null;comment:创建时间"`
+	// UpdatedAt 更新时间
+	UpdatedAt time.Time `gorm:"type:timestamp with time zone;not null;comment:更新时间"`
+	// DeletedAt 删除时间
+	DeletedAt gorm.DeletedAt `gorm:"index;comment:删除时间"`
+}
+
+// NewSentence 创建新的例句实体
+func NewSentence(text, translation string, level valueobject.WordDifficultyLevel) *Sentence {
+	now := time.Now()
+	return &Sentence{
+		Text:        text,
+		Translation: translation,
+		Tags:        []string{},
+		Level:       level,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+}
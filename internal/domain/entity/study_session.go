@@ -0,0 +1,71 @@
+package entity
+
+import (
+	"time"
+)
+
+// StudySessionID 学习会话ID类型
+type StudySessionID uint32
+
+// StudySession 学习会话
+// 由到期复习项和新学习项组成的一次学习，会话中的复习记录归属于该会话
+// 表名：study_sessions
+// 注释：学习会话表，记录用户每次开始学习时生成的学习队列概况
+type StudySession struct {
+	ID          StudySessionID `gorm:"primaryKey;comment:主键ID"`
+	UserID      UID            `gorm:"not null;index;comment:用户ID"`
+	ReviewCount uint32         `gorm:"not null;default:0;comment:会话中的复习项数量"`
+	NewCount    uint32         `gorm:"not null;default:0;comment:会话中的新学习项数量"`
+	StartedAt   time.Time      `gorm:"not null;comment:会话开始时间"`
+	CreatedAt   time.Time      `gorm:"not null;comment:记录创建时间"`
+}
+
+// NewStudySession 创建新的学习会话
+func NewStudySession(userID UID, reviewCount, newCount uint32) *StudySession {
+	now := time.Now()
+	return &StudySession{
+		UserID:      userID,
+		ReviewCount: reviewCount,
+		NewCount:    newCount,
+		StartedAt:   now,
+		CreatedAt:   now,
+	}
+}
+
+// StudyItem 学习队列中的学习项
+// 到期复习项关联已有的记忆单元，新学习项尚未创建记忆单元
+type StudyItem struct {
+	Type       MemoryUnitType // 记忆单元类型
+	ContentID  uint32         // 内容ID（对应汉字ID、单词ID等）
+	Text       string         // 内容文本（单词、汉字、短语等）
+	MemoryUnit *MemoryUnit    // 记忆单元，新学习项为 nil
+}
+
+// IsNew 判断是否为新学习项
+func (i *StudyItem) IsNew() bool {
+	return i.MemoryUnit == nil
+}
+
+// BuildStudyQueue 将到期复习项和新学习项合并为学习队列
+// 复习项保持原有顺序，新学习项均匀穿插在复习项之间，避免集中出现在队列开头或末尾
+func BuildStudyQueue(due, fresh []*StudyItem) []*StudyItem {
+	queue := make([]*StudyItem, 0, len(due)+len(fresh))
+	if len(fresh) == 0 {
+		return append(queue, due...)
+	}
+
+	gap := len(due) / len(fresh)
+	if gap < 1 {
+		gap = 1
+	}
+
+	next := 0
+	for i, item := range due {
+		queue = append(queue, item)
+		if (i+1)%gap == 0 && next < len(fresh) {
+			queue = append(queue, fresh[next])
+			next++
+		}
+	}
+	return append(queue, fresh[next:]...)
+}
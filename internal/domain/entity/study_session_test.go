@@ -0,0 +1,49 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newStudyItems(unitType MemoryUnitType, ids ...uint32) []*StudyItem {
+	items := make([]*StudyItem, len(ids))
+	for i, id := range ids {
+		items[i] = &StudyItem{Type: unitType, ContentID: id}
+	}
+	return items
+}
+
+func studyItemIDs(items []*StudyItem) []uint32 {
+	ids := make([]uint32, len(items))
+	for i, item := range items {
+		ids[i] = item.ContentID
+	}
+	return ids
+}
+
+func TestStudyItemIsNew(t *testing.T) {
+	assert.True(t, (&StudyItem{ContentID: 1}).IsNew())
+	assert.False(t, (&StudyItem{ContentID: 1, MemoryUnit: NewMemoryUnit(1, MemoryUnitTypeWord, 1)}).IsNew())
+}
+
+func TestBuildStudyQueue(t *testing.T) {
+	tests := []struct {
+		name     string
+		due      []uint32
+		fresh    []uint32
+		expected []uint32
+	}{
+		{"仅复习项", []uint32{1, 2, 3}, nil, []uint32{1, 2, 3}},
+		{"仅新学习项", nil, []uint32{11, 12}, []uint32{11, 12}},
+		{"均匀穿插", []uint32{1, 2, 3, 4}, []uint32{11, 12}, []uint32{1, 2, 11, 3, 4, 12}},
+		{"新学习项较多", []uint32{1, 2}, []uint32{11, 12, 13}, []uint32{1, 11, 2, 12, 13}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			queue := BuildStudyQueue(newStudyItems(MemoryUnitTypeWord, tt.due...), newStudyItems(MemoryUnitTypeWord, tt.fresh...))
+			assert.Equal(t, tt.expected, studyItemIDs(queue))
+		})
+	}
+}
@@ -26,6 +26,7 @@ type User struct {
 	AppleID       string     `gorm:"type:varchar(100);uniqueIndex"` // 苹果用户ID
 	Status        UserStatus `gorm:"type:int;not null;default:1"`
 	EmailVerified bool       `gorm:"type:boolean;default:false"`
+	Scheduler     string     `gorm:"type:varchar(20);not null;default:''"` // 复习调度算法，为空时使用系统默认算法
 	CreatedAt     time.Time  `gorm:"not null;default:CURRENT_TIMESTAMP"`
 	UpdatedAt     time.Time  `gorm:"not null;default:CURRENT_TIMESTAMP"`
 }
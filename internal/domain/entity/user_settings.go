@@ -0,0 +1,139 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/lazyjean/sla2/internal/domain/errors"
+)
+
+// 默认学习偏好
+const (
+	DefaultDailyReviewGoal = 20 // 每日复习目标
+	DefaultDailyNewGoal    = 10 // 每日新内容目标
+	DefaultDayStartHour    = 4  // 学习日从凌晨4点开始，深夜的复习计入前一天
+	DefaultLeechThreshold  = 8  // 遗忘8次后标记为顽固项
+
+	MaxDailyGoal        = 1000  // 每日目标上限
+	MaxMaximumInterval  = 36500 // 最大复习间隔上限（天）
+	MinDesiredRetention = 0.7   // 期望记忆保持率下限
+	MaxDesiredRetention = 0.99  // 期望记忆保持率上限
+	MaxLeechThreshold   = 100   // 顽固项阈值上限
+	MaxStreakFreezeDays = 7     // 断签保护天数上限
+)
+
+// LeechAction 检测到顽固项后的处理方式
+type LeechAction uint8
+
+const (
+	LeechActionUnspecified LeechAction = 0 // 未设置，使用默认处理方式（标记并暂停）
+	LeechActionTag         LeechAction = 1 // 仅标记，继续参与复习
+	LeechActionSuspend     LeechAction = 2 // 标记并暂停复习
+	LeechActionDisabled    LeechAction = 3 // 不检测顽固项
+)
+
+// UserSettings 用户学习偏好
+// 表名：user_settings
+// 注释：用户学习偏好表，每个用户一条记录，未设置时使用默认偏好
+type UserSettings struct {
+	UserID             UID              `gorm:"primaryKey;autoIncrement:false;comment:用户ID"`
+	DailyReviewGoal    uint32           `gorm:"not null;comment:每日复习目标"`
+	DailyNewGoal       uint32           `gorm:"not null;comment:每日新内容目标"`
+	DesiredRetention   float64          `gorm:"not null;default:0;comment:期望记忆保持率，0表示使用系统默认"`
+	MaximumInterval    uint32           `gorm:"not null;default:0;comment:最大复习间隔（天），0表示使用系统默认"`
+	DayStartHour       uint32           `gorm:"not null;comment:学习日开始的小时（0-23）"`
+	TimeZone           string           `gorm:"type:varchar(64);not null;default:'';comment:IANA时区，为空时使用服务器时区"`
+	PreferredUnitTypes []MemoryUnitType `gorm:"type:jsonb;serializer:json;not null;default:'[]';comment:偏好的学习内容类型，为空表示全部"`
+	LeechThreshold     uint32           `gorm:"not null;default:0;comment:顽固项阈值（遗忘次数），0表示使用系统默认"`
+	LeechAction        LeechAction      `gorm:"not null;default:0;comment:顽固项处理方式，0-默认，1-仅标记，2-标记并暂停，3-不检测"`
+	StreakFreezeDays   uint32           `gorm:"not null;default:0;comment:断签保护天数，一次连续学习中允许中断而不清零的天数"`
+	SchedulerWeights   []float64        `gorm:"type:jsonb;serializer:json;not null;default:'[]';comment:按用户复习记录拟合的FSRS模型权重，为空表示使用部署配置的权重"`
+	CreatedAt          time.Time        `gorm:"not null;comment:记录创建时间"`
+	UpdatedAt          time.Time        `gorm:"not null;comment:记录更新时间"`
+}
+
+// NewUserSettings 创建默认的用户学习偏好
+func NewUserSettings(userID UID) *UserSettings {
+	now := time.Now()
+	return &UserSettings{
+		UserID:             userID,
+		DailyReviewGoal:    DefaultDailyReviewGoal,
+		DailyNewGoal:       DefaultDailyNewGoal,
+		DayStartHour:       DefaultDayStartHour,
+		PreferredUnitTypes: []MemoryUnitType{},
+		SchedulerWeights:   []float64{},
+		CreatedAt:          now,
+		UpdatedAt:          now,
+	}
+}
+
+// Validate 校验学习偏好
+func (s *UserSettings) Validate() error {
+	if s.DailyReviewGoal > MaxDailyGoal || s.DailyNewGoal > MaxDailyGoal {
+		return errors.ErrInvalidDailyGoal
+	}
+	if s.DesiredRetention != 0 && (s.DesiredRetention < MinDesiredRetention || s.DesiredRetention > MaxDesiredRetention) {
+		return errors.ErrInvalidDesiredRetention
+	}
+	if s.MaximumInterval > MaxMaximumInterval {
+		return errors.ErrInvalidMaximumInterval
+	}
+	if s.DayStartHour > 23 {
+		return errors.ErrInvalidDayStartHour
+	}
+	if s.TimeZone != "" {
+		if _, err := time.LoadLocation(s.TimeZone); err != nil {
+			return errors.ErrInvalidTimeZone
+		}
+	}
+	if s.LeechThreshold > MaxLeechThreshold {
+		return errors.ErrInvalidLeechThreshold
+	}
+	if s.LeechAction > LeechActionDisabled {
+		return errors.ErrInvalidLeechAction
+	}
+	if s.StreakFreezeDays > MaxStreakFreezeDays {
+		return errors.ErrInvalidStreakFreezeDays
+	}
+	for _, t := range s.PreferredUnitTypes {
+		if !t.IsValid() {
+			return errors.ErrInvalidMemoryUnitType
+		}
+	}
+	return nil
+}
+
+// LeechPolicy 顽固项检测策略
+// 返回遗忘次数阈值（0 表示不检测）以及标记后是否暂停复习，未设置时使用默认值
+func (s *UserSettings) LeechPolicy() (threshold uint32, suspend bool) {
+	if s.LeechAction == LeechActionDisabled {
+		return 0, false
+	}
+	threshold = s.LeechThreshold
+	if threshold == 0 {
+		threshold = DefaultLeechThreshold
+	}
+	return threshold, s.LeechAction != LeechActionTag
+}
+
+// Location 用户所在时区，未设置或无效时使用服务器时区
+func (s *UserSettings) Location() *time.Location {
+	if s.TimeZone == "" {
+		return time.Local
+	}
+	loc, err := time.LoadLocation(s.TimeZone)
+	if err != nil {
+		return time.Local
+	}
+	return loc
+}
+
+// StartOfDay 计算 t 所在学习日的开始时间
+// 学习日按用户时区从 DayStartHour 开始，早于该时刻的时间属于前一个学习日
+func (s *UserSettings) StartOfDay(t time.Time) time.Time {
+	local := t.In(s.Location())
+	start := time.Date(local.Year(), local.Month(), local.Day(), int(s.DayStartHour), 0, 0, 0, local.Location())
+	if local.Before(start) {
+		start = start.AddDate(0, 0, -1)
+	}
+	return start
+}
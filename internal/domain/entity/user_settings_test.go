@@ -0,0 +1,87 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lazyjean/sla2/internal/domain/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserSettingsValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		modify   func(s *UserSettings)
+		expected error
+	}{
+		{"默认偏好", func(s *UserSettings) {}, nil},
+		{"每日目标过大", func(s *UserSettings) { s.DailyNewGoal = MaxDailyGoal + 1 }, errors.ErrInvalidDailyGoal},
+		{"期望保持率过低", func(s *UserSettings) { s.DesiredRetention = 0.5 }, errors.ErrInvalidDesiredRetention},
+		{"最大间隔过大", func(s *UserSettings) { s.MaximumInterval = MaxMaximumInterval + 1 }, errors.ErrInvalidMaximumInterval},
+		{"学习日开始时间无效", func(s *UserSettings) { s.DayStartHour = 24 }, errors.ErrInvalidDayStartHour},
+		{"时区无效", func(s *UserSettings) { s.TimeZone = "Mars/Olympus" }, errors.ErrInvalidTimeZone},
+		{"内容类型无效", func(s *UserSettings) { s.PreferredUnitTypes = []MemoryUnitType{MemoryUnitTypeUnspecified} }, errors.ErrInvalidMemoryUnitType},
+		{"顽固项阈值过大", func(s *UserSettings) { s.LeechThreshold = MaxLeechThreshold + 1 }, errors.ErrInvalidLeechThreshold},
+		{"顽固项处理方式无效", func(s *UserSettings) { s.LeechAction = LeechActionDisabled + 1 }, errors.ErrInvalidLeechAction},
+		{"断签保护天数过多", func(s *UserSettings) { s.StreakFreezeDays = MaxStreakFreezeDays + 1 }, errors.ErrInvalidStreakFreezeDays},
+		{"有效的自定义偏好", func(s *UserSettings) {
+			s.DesiredRetention = 0.85
+			s.TimeZone = "Asia/Shanghai"
+			s.PreferredUnitTypes = []MemoryUnitType{MemoryUnitTypeWord}
+		}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			settings := NewUserSettings(1)
+			tt.modify(settings)
+			err := settings.Validate()
+			if tt.expected == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, tt.expected)
+			}
+		})
+	}
+}
+
+func TestUserSettingsLeechPolicy(t *testing.T) {
+	settings := NewUserSettings(1)
+
+	// 未设置时使用默认阈值，标记后暂停
+	threshold, suspend := settings.LeechPolicy()
+	assert.Equal(t, uint32(DefaultLeechThreshold), threshold)
+	assert.True(t, suspend)
+
+	settings.LeechThreshold = 4
+	settings.LeechAction = LeechActionTag
+	threshold, suspend = settings.LeechPolicy()
+	assert.Equal(t, uint32(4), threshold)
+	assert.False(t, suspend)
+
+	// 不检测时阈值为 0
+	settings.LeechAction = LeechActionDisabled
+	threshold, _ = settings.LeechPolicy()
+	assert.Zero(t, threshold)
+}
+
+func TestUserSettingsStartOfDay(t *testing.T) {
+	shanghai, err := time.LoadLocation("Asia/Shanghai")
+	require.NoError(t, err)
+
+	settings := NewUserSettings(1)
+	settings.TimeZone = "Asia/Shanghai"
+	settings.DayStartHour = 4
+
+	// 凌晨4点之后属于当天
+	assert.Equal(t, time.Date(2024, 3, 10, 4, 0, 0, 0, shanghai), settings.StartOfDay(time.Date(2024, 3, 10, 9, 30, 0, 0, shanghai)))
+	// 凌晨4点之前属于前一天
+	assert.Equal(t, time.Date(2024, 3, 9, 4, 0, 0, 0, shanghai), settings.StartOfDay(time.Date(2024, 3, 10, 2, 0, 0, 0, shanghai)))
+	// 按用户时区计算，UTC 20:00 为上海次日 04:00
+	assert.Equal(t, time.Date(2024, 3, 11, 4, 0, 0, 0, shanghai), settings.StartOfDay(time.Date(2024, 3, 10, 20, 0, 0, 0, time.UTC)))
+
+	// 未设置时区时使用服务器时区
+	settings.TimeZone = ""
+	assert.Equal(t, time.Local, settings.Location())
+}
@@ -1,6 +1,7 @@
 package entity
 
 import (
+	"strings"
 	"time"
 
 	"github.com/lazyjean/sla2/internal/domain/errors"
@@ -25,6 +26,8 @@ type Word struct {
 	Tags []string `gorm:"type:jsonb;serializer:json;not null;default:'[]'"`
 	// Level 难度等级
 	Level valueobject.WordDifficultyLevel `gorm:"type:integer;not null;comment:难度等级"`
+	// SearchText 用于全文检索的释义、例句和同义词，写入时由 BuildSearchText 生成
+	SearchText string `gorm:"type:text;not null;default:'';comment:检索文本" json:"-"`
 	// CreatedAt 创建时间
 	CreatedAt time.Time `gorm:"not null;default:CURRENT_TIMESTAMP"`
 	// UpdatedAt 更新时间
@@ -64,6 +67,35 @@ func NewWord(
 	}
 }
 
+// BuildSearchText 将释义、例句和同义词拼接为检索文本，写入前调用
+func (w *Word) BuildSearchText() {
+	var parts []string
+	seen := make(map[string]struct{})
+	add := func(text string) {
+		text = strings.TrimSpace(text)
+		if _, ok := seen[text]; ok || text == "" {
+			return
+		}
+		seen[text] = struct{}{}
+		parts = append(parts, text)
+	}
+	for _, definition := range w.Definitions {
+		add(definition.Meaning)
+	}
+	for _, definition := range w.Definitions {
+		for _, synonym := range definition.Synonyms {
+			add(synonym)
+		}
+	}
+	for _, definition := range w.Definitions {
+		add(definition.Example)
+	}
+	for _, example := range w.Examples {
+		add(example)
+	}
+	w.SearchText = strings.Join(parts, "\n")
+}
+
 // AddExample 添加例句
 func (w *Word) AddExample(example string) error {
 	if example == "" {
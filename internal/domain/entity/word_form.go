@@ -0,0 +1,58 @@
+package entity
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/lazyjean/sla2/internal/domain/errors"
+)
+
+// MaxWordFormLength 词形的最大长度（字符），与单词相同
+const MaxWordFormLength = 100
+
+// WordFormKind 词形变化类型
+type WordFormKind uint8
+
+const (
+	WordFormKindUnspecified         WordFormKind = 0 // 未指定，如从不区分变化类型的词形表导入
+	WordFormKindPlural              WordFormKind = 1 // 名词复数
+	WordFormKindPast                WordFormKind = 2 // 动词过去式
+	WordFormKindPastParticiple      WordFormKind = 3 // 动词过去分词
+	WordFormKindPresentParticiple   WordFormKind = 4 // 动词现在分词
+	WordFormKindThirdPersonSingular WordFormKind = 5 // 动词第三人称单数
+	WordFormKindComparative         WordFormKind = 6 // 形容词、副词比较级
+	WordFormKindSuperlative         WordFormKind = 7 // 形容词、副词最高级
+)
+
+// IsValid 检查词形变化类型是否有效，未指定也是有效的类型
+func (k WordFormKind) IsValid() bool {
+	return k <= WordFormKindSuperlative
+}
+
+// WordForm 单词的屈折变化形式，如 run 的 ran、running，child 的 children
+// 词形按小写保存，同一个词形可以属于多个原形（如 left 既是 leave 的过去式也是独立的单词 left）
+// 表名：word_forms
+// 注释：词形表，记录单词原形的各种屈折变化形式
+type WordForm struct {
+	ID     uint32       `gorm:"primaryKey;comment:主键ID"`
+	WordID WordID       `gorm:"not null;uniqueIndex:idx_word_form;comment:原形单词ID"`
+	Form   string       `gorm:"type:varchar(100);not null;uniqueIndex:idx_word_form;index;comment:词形，小写"`
+	Kind   WordFormKind `gorm:"not null;default:0;comment:变化类型，0-未指定，1-复数，2-过去式，3-过去分词，4-现在分词，5-第三人称单数，6-比较级，7-最高级"`
+
+	// Word 原形单词，删除单词时同时删除其词形
+	Word *Word `gorm:"foreignKey:WordID;constraint:OnDelete:CASCADE" json:"-"`
+}
+
+// NewWordForm 创建单词的词形
+func NewWordForm(wordID WordID, form string, kind WordFormKind) (*WordForm, error) {
+	form = NormalizeWordForm(form)
+	if form == "" || utf8.RuneCountInString(form) > MaxWordFormLength || !kind.IsValid() {
+		return nil, errors.ErrInvalidWordForm
+	}
+	return &WordForm{WordID: wordID, Form: form, Kind: kind}, nil
+}
+
+// NormalizeWordForm 将词形规范化为查询和保存使用的小写形式
+func NormalizeWordForm(form string) string {
+	return strings.ToLower(strings.TrimSpace(form))
+}
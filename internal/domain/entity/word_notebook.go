@@ -0,0 +1,152 @@
+package entity
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/lazyjean/sla2/internal/domain/errors"
+)
+
+const (
+	MaxWordNotebookNameLength = 50  // 生词本名称的最大长度（字符）
+	MaxCustomWordTextLength   = 100 // 自定义词条单词的最大长度（字符）
+	MaxNotebookExamples       = 20  // 每个词条最多的个人例句数
+)
+
+// WordNotebookID 生词本ID类型
+type WordNotebookID uint32
+
+// WordNotebook 用户的生词本
+// 一个用户可以有多个按名称区分的生词本，生词本中的词条只属于该用户
+// 表名：word_notebooks
+// 注释：生词本表，记录用户创建的单词列表
+type WordNotebook struct {
+	ID          WordNotebookID `gorm:"primaryKey;comment:主键ID"`
+	UserID      UID            `gorm:"not null;uniqueIndex:idx_word_notebook_user_name;comment:用户ID"`
+	Name        string         `gorm:"type:varchar(50);not null;uniqueIndex:idx_word_notebook_user_name;comment:生词本名称，同一用户内唯一"`
+	Description string         `gorm:"type:text;not null;default:'';comment:描述"`
+	CreatedAt   time.Time      `gorm:"not null;comment:记录创建时间"`
+	UpdatedAt   time.Time      `gorm:"not null;comment:记录更新时间"`
+}
+
+// NewWordNotebook 创建新的生词本
+func NewWordNotebook(userID UID, name, description string) (*WordNotebook, error) {
+	notebook := &WordNotebook{UserID: userID}
+	if err := notebook.Update(name, description); err != nil {
+		return nil, err
+	}
+	notebook.CreatedAt = notebook.UpdatedAt
+	return notebook, nil
+}
+
+// Update 修改生词本的名称和描述
+func (n *WordNotebook) Update(name, description string) error {
+	name = strings.TrimSpace(name)
+	if name == "" || utf8.RuneCountInString(name) > MaxWordNotebookNameLength {
+		return errors.ErrInvalidNotebookName
+	}
+	n.Name = name
+	n.Description = strings.TrimSpace(description)
+	n.UpdatedAt = time.Now()
+	return nil
+}
+
+// WordNotebookEntryID 生词本词条ID类型
+type WordNotebookEntryID uint32
+
+// WordNotebookEntry 生词本中的词条
+// 词条引用词典中的单词（WordID 不为空），或是只属于该用户的自定义词条（Text 和 Meaning）；
+// 引用词典单词时不复制单词数据，个人笔记和例句保存在词条上
+// 表名：word_notebook_entries
+// 注释：生词本词条表，记录生词本中的单词和用户的个人笔记
+type WordNotebookEntry struct {
+	ID         WordNotebookEntryID `gorm:"primaryKey;comment:主键ID"`
+	NotebookID WordNotebookID      `gorm:"not null;uniqueIndex:idx_word_notebook_entry_word;comment:生词本ID"`
+	UserID     UID                 `gorm:"not null;index;comment:用户ID"`
+	WordID     *WordID             `gorm:"uniqueIndex:idx_word_notebook_entry_word;comment:词典单词ID，自定义词条为空"`
+	Text       string              `gorm:"type:varchar(100);not null;default:'';comment:自定义词条的单词，词典单词为空"`
+	Meaning    string              `gorm:"type:text;not null;default:'';comment:自定义词条的释义"`
+	Note       string              `gorm:"type:text;not null;default:'';comment:个人笔记"`
+	Examples   []string            `gorm:"type:jsonb;serializer:json;not null;default:'[]';comment:个人例句"`
+	CreatedAt  time.Time           `gorm:"not null;index;comment:加入生词本的时间"`
+	UpdatedAt  time.Time           `gorm:"not null;comment:记录更新时间"`
+
+	// Word 引用的词典单词，自定义词条为 nil；删除词典单词时同时删除引用它的词条
+	Word *Word `gorm:"foreignKey:WordID;constraint:OnDelete:CASCADE"`
+}
+
+// NewWordNotebookEntry 创建引用词典单词的词条
+func NewWordNotebookEntry(notebook *WordNotebook, wordID WordID, note string, examples []string) (*WordNotebookEntry, error) {
+	entry := &WordNotebookEntry{
+		NotebookID: notebook.ID,
+		UserID:     notebook.UserID,
+		WordID:     &wordID,
+	}
+	if err := entry.UpdateNote(note, examples); err != nil {
+		return nil, err
+	}
+	entry.CreatedAt = entry.UpdatedAt
+	return entry, nil
+}
+
+// NewCustomWordNotebookEntry 创建自定义词条
+func NewCustomWordNotebookEntry(notebook *WordNotebook, text, meaning, note string, examples []string) (*WordNotebookEntry, error) {
+	entry := &WordNotebookEntry{
+		NotebookID: notebook.ID,
+		UserID:     notebook.UserID,
+	}
+	if err := entry.UpdateCustomWord(text, meaning); err != nil {
+		return nil, err
+	}
+	if err := entry.UpdateNote(note, examples); err != nil {
+		return nil, err
+	}
+	entry.CreatedAt = entry.UpdatedAt
+	return entry, nil
+}
+
+// IsCustom 判断是否为自定义词条
+func (e *WordNotebookEntry) IsCustom() bool {
+	return e.WordID == nil
+}
+
+// UpdateNote 修改个人笔记和例句，忽略空白的例句
+func (e *WordNotebookEntry) UpdateNote(note string, examples []string) error {
+	cleaned := make([]string, 0, len(examples))
+	for _, example := range examples {
+		if example = strings.TrimSpace(example); example != "" {
+			cleaned = append(cleaned, example)
+		}
+	}
+	if len(cleaned) > MaxNotebookExamples {
+		return errors.ErrTooManyNotebookExamples
+	}
+	e.Note = strings.TrimSpace(note)
+	e.Examples = cleaned
+	e.UpdatedAt = time.Now()
+	return nil
+}
+
+// UpdateCustomWord 修改自定义词条的单词和释义，词典单词的词条不能修改
+func (e *WordNotebookEntry) UpdateCustomWord(text, meaning string) error {
+	if !e.IsCustom() {
+		return errors.ErrNotCustomNotebookEntry
+	}
+	text = strings.TrimSpace(text)
+	if text == "" || utf8.RuneCountInString(text) > MaxCustomWordTextLength {
+		return errors.ErrInvalidCustomWordText
+	}
+	e.Text = text
+	e.Meaning = strings.TrimSpace(meaning)
+	e.UpdatedAt = time.Now()
+	return nil
+}
+
+// DisplayText 词条的单词，词典单词的词条需要预加载 Word
+func (e *WordNotebookEntry) DisplayText() string {
+	if e.Word != nil {
+		return e.Word.Text
+	}
+	return e.Text
+}
@@ -0,0 +1,69 @@
+package entity
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/lazyjean/sla2/internal/domain/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewWordNotebook(t *testing.T) {
+	notebook, err := NewWordNotebook(1, "  考研词汇 ", " 每天复习 ")
+	require.NoError(t, err)
+	assert.Equal(t, UID(1), notebook.UserID)
+	assert.Equal(t, "考研词汇", notebook.Name)
+	assert.Equal(t, "每天复习", notebook.Description)
+	assert.False(t, notebook.CreatedAt.IsZero())
+
+	_, err = NewWordNotebook(1, "   ", "")
+	assert.ErrorIs(t, err, errors.ErrInvalidNotebookName)
+
+	_, err = NewWordNotebook(1, strings.Repeat("词", MaxWordNotebookNameLength+1), "")
+	assert.ErrorIs(t, err, errors.ErrInvalidNotebookName)
+}
+
+func TestWordNotebookEntry(t *testing.T) {
+	notebook := &WordNotebook{ID: 3, UserID: 1}
+
+	t.Run("词典单词", func(t *testing.T) {
+		entry, err := NewWordNotebookEntry(notebook, 7, " 易混 ", []string{" It rains. ", "", "  "})
+		require.NoError(t, err)
+		assert.Equal(t, WordNotebookID(3), entry.NotebookID)
+		assert.Equal(t, UID(1), entry.UserID)
+		require.NotNil(t, entry.WordID)
+		assert.Equal(t, WordID(7), *entry.WordID)
+		assert.False(t, entry.IsCustom())
+		assert.Equal(t, "易混", entry.Note)
+		assert.Equal(t, []string{"It rains."}, entry.Examples)
+
+		// 词典单词的词条不能修改单词和释义
+		assert.ErrorIs(t, entry.UpdateCustomWord("rain", "雨"), errors.ErrNotCustomNotebookEntry)
+
+		entry.Word = &Word{Text: "rain"}
+		assert.Equal(t, "rain", entry.DisplayText())
+	})
+
+	t.Run("自定义词条", func(t *testing.T) {
+		entry, err := NewCustomWordNotebookEntry(notebook, " gaslight ", " 煤气灯操纵 ", "", nil)
+		require.NoError(t, err)
+		assert.True(t, entry.IsCustom())
+		assert.Equal(t, "gaslight", entry.Text)
+		assert.Equal(t, "煤气灯操纵", entry.Meaning)
+		assert.Equal(t, []string{}, entry.Examples)
+		assert.Equal(t, "gaslight", entry.DisplayText())
+
+		_, err = NewCustomWordNotebookEntry(notebook, " ", "", "", nil)
+		assert.ErrorIs(t, err, errors.ErrInvalidCustomWordText)
+	})
+
+	t.Run("例句数量上限", func(t *testing.T) {
+		examples := make([]string, MaxNotebookExamples+1)
+		for i := range examples {
+			examples[i] = "example"
+		}
+		_, err := NewWordNotebookEntry(notebook, 7, "", examples)
+		assert.ErrorIs(t, err, errors.ErrTooManyNotebookExamples)
+	})
+}
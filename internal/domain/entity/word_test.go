@@ -3,6 +3,7 @@ package entity
 import (
 	"testing"
 
+	"github.com/lazyjean/sla2/internal/domain/errors"
 	"github.com/lazyjean/sla2/internal/domain/valueobject"
 	"github.com/stretchr/testify/assert"
 )
@@ -194,3 +195,15 @@ func TestHasTag(t *testing.T) {
 	assert.True(t, word.HasTag("basic"))
 	assert.False(t, word.HasTag("nonexistent"))
 }
+
+func TestNewWordForm(t *testing.T) {
+	form, err := NewWordForm(1, "  Children ", WordFormKindPlural)
+	assert.NoError(t, err)
+	assert.Equal(t, "children", form.Form)
+	assert.Equal(t, WordFormKindPlural, form.Kind)
+
+	_, err = NewWordForm(1, " ", WordFormKindPlural)
+	assert.ErrorIs(t, err, errors.ErrInvalidWordForm)
+	_, err = NewWordForm(1, "children", WordFormKindSuperlative+1)
+	assert.ErrorIs(t, err, errors.ErrInvalidWordForm)
+}
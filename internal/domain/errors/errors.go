@@ -36,8 +36,9 @@ import "errors"
 
 // Common errors
 var (
-	ErrInvalidInput = NewError(CodeInvalidInput, "输入错误")
-	ErrNotFound     = NewError(CodeNotFound, "资源不存在")
+	ErrInvalidInput     = NewError(CodeInvalidInput, "输入错误")
+	ErrNotFound         = NewError(CodeNotFound, "资源不存在")
+	ErrPermissionDenied = NewError(CodePermissionDenied, "没有操作权限")
 )
 
 // Word related errors
@@ -46,9 +47,12 @@ var (
 	ErrWordNotFound        = NewError(CodeWordNotFound, "单词不存在")
 	ErrWordAlreadyExists   = NewError(CodeWordAlreadyExists, "单词已存在")
 	ErrInvalidDifficulty   = NewError(CodeInvalidDifficulty, "难度必须在1到5之间")
-	ErrInvalidMasteryLevel = NewError(CodeInvalidMasteryLevel, "熟练度必须在0到5之间")
+	ErrInvalidMasteryLevel = NewError(CodeInvalidMasteryLevel, "熟练度必须在1到5之间")
 	ErrDuplicateTag        = NewError(CodeDuplicateTag, "标签已存在")
 	ErrEmptyDefinition     = NewError(CodeEmptyDefinition, "释义不能为空")
+	ErrEmptySearchKeyword  = NewError(CodeInvalidArgument, "搜索关键词不能为空")
+	ErrInvalidWordForm     = NewError(CodeInvalidArgument, "词形不能为空且不能超过100个字符")
+	ErrInvalidWordFormList = NewError(CodeInvalidArgument, "词形表格式错误")
 )
 
 // Repository related errors
@@ -83,6 +87,67 @@ var (
 	ErrHanCharAlreadyExists   = NewError(CodeHanCharAlreadyExists, "汉字已存在")
 	ErrNotImplemented         = NewError(CodeNotImplemented, "功能未实现")
 	ErrInvalidDifficultyLevel = NewError(CodeInvalidDifficultyLevel, "无效的难度等级")
+	ErrHanCharNotFound        = NewError(CodeNotFound, "汉字不存在")
+	ErrInvalidLearningTime    = NewError(CodeInvalidArgument, "无效的学习时间")
+	ErrEmptyHanCharResults    = NewError(CodeInvalidArgument, "学习结果不能为空")
+	ErrHanCharBatchTooLarge   = NewError(CodeInvalidArgument, "单次提交的汉字数量不能超过100")
+	ErrInvalidHanCharQuestion = NewError(CodeInvalidArgument, "无效的汉字测试题目")
+	ErrInvalidStrokeRange     = NewError(CodeInvalidArgument, "最少笔画数不能大于最多笔画数")
+	ErrInvalidPinyin          = NewError(CodeInvalidArgument, "无效的拼音")
+	ErrHanCharStrokesNotFound = NewError(CodeNotFound, "汉字没有笔顺数据")
+	ErrInvalidHanCharStrokes  = NewError(CodeInvalidArgument, "笔顺数据的笔画轮廓与中线不匹配")
+	ErrEmptyHandwriting       = NewError(CodeInvalidArgument, "手写笔画不能为空")
+	ErrInvalidHandwritingTry  = NewError(CodeInvalidArgument, "书写练习的作答次数必须为1-3")
+)
+
+// Memory related errors
+var (
+	ErrInvalidReviewGrade    = NewError(CodeInvalidArgument, "无效的复习评分")
+	ErrStudySessionNotFound  = NewError(CodeInvalidArgument, "学习会话不存在")
+	ErrReviewBatchTooLarge   = NewError(CodeInvalidArgument, "单次提交的复习数量不能超过500")
+	ErrContentNotFound       = NewError(CodeNotFound, "学习内容不存在")
+	ErrInvalidForecastDays   = NewError(CodeInvalidArgument, "预测天数不能超过365天")
+	ErrInvalidStatsWindow    = NewError(CodeInvalidArgument, "统计窗口必须在1到365天之间")
+	ErrInvalidDateRange      = NewError(CodeInvalidArgument, "无效的日期范围")
+	ErrInvalidRescheduleDate = NewError(CodeInvalidArgument, "复习日期无效或早于今天")
+	ErrInvalidSpreadDays     = NewError(CodeInvalidArgument, "分散天数必须在1到365天之间")
+	ErrCourseHasNoTags       = NewError(CodeInvalidArgument, "课程未设置标签，无法确定课程内容")
+)
+
+// Word notebook related errors
+var (
+	ErrNotebookNotFound           = NewError(CodeNotFound, "生词本不存在")
+	ErrNotebookAlreadyExists      = NewError(CodeAlreadyExists, "同名的生词本已存在")
+	ErrInvalidNotebookName        = NewError(CodeInvalidArgument, "生词本名称不能为空且不能超过50个字符")
+	ErrNotebookEntryNotFound      = NewError(CodeNotFound, "生词本词条不存在")
+	ErrNotebookEntryAlreadyExists = NewError(CodeAlreadyExists, "生词本中已有该单词")
+	ErrInvalidCustomWordText      = NewError(CodeInvalidArgument, "自定义词条的单词不能为空且不能超过100个字符")
+	ErrNotCustomNotebookEntry     = NewError(CodeInvalidArgument, "词典单词的词条不能修改单词和释义")
+	ErrTooManyNotebookExamples    = NewError(CodeInvalidArgument, "每个词条最多20个例句")
+	ErrInvalidNotebookEntrySort   = NewError(CodeInvalidArgument, "无效的词条排序方式")
+	ErrInvalidNotebookUnitTypes   = NewError(CodeInvalidArgument, "生词本只能学习单词和自定义词条")
+)
+
+// Lexical relation related errors
+var (
+	ErrInvalidRelationType   = NewError(CodeInvalidArgument, "无效的关联类型")
+	ErrInvalidRelationNote   = NewError(CodeInvalidArgument, "关联说明不能超过200个字符")
+	ErrInvalidRelationTarget = NewError(CodeInvalidArgument, "关联的两端不能为空且不能相同")
+	ErrRelationNotFound      = NewError(CodeNotFound, "关联不存在")
+	ErrRelationAlreadyExists = NewError(CodeAlreadyExists, "关联已存在")
+)
+
+// User settings related errors
+var (
+	ErrInvalidDailyGoal        = NewError(CodeInvalidArgument, "每日目标不能超过1000")
+	ErrInvalidDesiredRetention = NewError(CodeInvalidArgument, "期望记忆保持率必须在0.7到0.99之间")
+	ErrInvalidMaximumInterval  = NewError(CodeInvalidArgument, "最大复习间隔不能超过36500天")
+	ErrInvalidDayStartHour     = NewError(CodeInvalidArgument, "学习日开始时间必须在0到23点之间")
+	ErrInvalidTimeZone         = NewError(CodeInvalidArgument, "无效的时区")
+	ErrInvalidMemoryUnitType   = NewError(CodeInvalidArgument, "无效的记忆单元类型")
+	ErrInvalidLeechThreshold   = NewError(CodeInvalidArgument, "顽固项阈值不能超过100")
+	ErrInvalidLeechAction      = NewError(CodeInvalidArgument, "无效的顽固项处理方式")
+	ErrInvalidStreakFreezeDays = NewError(CodeInvalidArgument, "断签保护天数不能超过7天")
 )
 
 // ErrInvalidWord 表示无效的单词
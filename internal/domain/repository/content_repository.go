@@ -0,0 +1,45 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/lazyjean/sla2/internal/domain/entity"
+	"github.com/lazyjean/sla2/internal/domain/valueobject"
+)
+
+// ContentRepository 学习内容仓储接口
+// 以记忆单元的视角统一访问单词、汉字、短语等各类学习内容，供复习、学习队列等与内容类型无关的流程使用
+// 用户自有的内容（如生词本自定义词条）只对其所有者可见，其他内容对所有用户可见
+type ContentRepository interface {
+	// UnitType 学习内容对应的记忆单元类型
+	UnitType() entity.MemoryUnitType
+	// Exists 检查学习内容是否存在且对用户可见
+	Exists(ctx context.Context, userID entity.UID, id uint32) (bool, error)
+	// GetTexts 获取对用户可见的学习内容的展示文本，不存在或不可见的内容不包含在结果中
+	GetTexts(ctx context.Context, userID entity.UID, ids []uint32) (map[uint32]string, error)
+	// ListNotLearned 获取用户尚未学习（没有记忆单元）的内容，按ID升序
+	ListNotLearned(ctx context.Context, userID entity.UID, filter NewContentFilter, limit int) ([]*entity.StudyItem, error)
+	// ListIDs 获取符合过滤条件的内容ID，按ID升序
+	ListIDs(ctx context.Context, filter NewContentFilter) ([]uint32, error)
+}
+
+// ContentRepositories 按记忆单元类型索引的学习内容仓储
+type ContentRepositories map[entity.MemoryUnitType]ContentRepository
+
+// NewContentRepositories 创建学习内容仓储索引
+func NewContentRepositories(repos ...ContentRepository) ContentRepositories {
+	contents := make(ContentRepositories, len(repos))
+	for _, repo := range repos {
+		contents[repo.UnitType()] = repo
+	}
+	return contents
+}
+
+// NewContentFilter 学习内容过滤条件
+type NewContentFilter struct {
+	Level      valueobject.WordDifficultyLevel // 难度等级，未指定时不过滤
+	Tags       []string                        // 标签列表，包含任一标签即可，为空时不过滤
+	CourseTags []string                        // 课程的标签列表，包含任一课程标签即可，与 Tags 同时满足，为空时不过滤
+	Categories []string                        // 分类列表，包含任一分类即可，只有汉字有分类，为空时不过滤
+	NotebookID entity.WordNotebookID           // 只包含该生词本中的单词和自定义词条，为 0 时不过滤
+}
@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/lazyjean/sla2/internal/domain/entity"
+)
+
+// GrammarPointRepository 语法点仓储接口
+type GrammarPointRepository interface {
+	// Create 创建语法点
+	Create(ctx context.Context, grammarPoint *entity.GrammarPoint) error
+	// Update 更新语法点
+	Update(ctx context.Context, grammarPoint *entity.GrammarPoint) error
+	// Delete 删除语法点
+	Delete(ctx context.Context, id entity.GrammarPointID) error
+	// GetByID 根据ID获取语法点，不存在时返回 nil
+	GetByID(ctx context.Context, id entity.GrammarPointID) (*entity.GrammarPoint, error)
+	// List 分页获取语法点列表，按ID升序
+	List(ctx context.Context, offset, limit int) ([]*entity.GrammarPoint, int64, error)
+}
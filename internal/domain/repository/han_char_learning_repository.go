@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/lazyjean/sla2/internal/domain/entity"
+)
+
+// HanCharLearningRepository 汉字学习记录仓储接口
+type HanCharLearningRepository interface {
+	// GetByHanCharID 获取用户某个汉字的学习记录，不存在时返回 nil
+	GetByHanCharID(ctx context.Context, userID entity.UID, hanCharID entity.HanCharID) (*entity.HanCharLearning, error)
+	// Save 保存汉字学习记录，不存在时创建
+	Save(ctx context.Context, learning *entity.HanCharLearning) error
+}
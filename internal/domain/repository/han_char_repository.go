@@ -22,4 +22,10 @@ type HanCharRepository interface {
 	List(ctx context.Context, offset, limit int, filters map[string]interface{}) ([]*entity.HanChar, int64, error)
 	// Search 搜索汉字
 	Search(ctx context.Context, keyword string, offset, limit int, filters map[string]interface{}) ([]*entity.HanChar, int64, error)
+	// ListByIDs 通过ID列表获取汉字，按ID升序
+	ListByIDs(ctx context.Context, ids []entity.HanCharID) ([]*entity.HanChar, error)
+	// ListLearned 获取用户已学习的汉字，最该复习的在前
+	ListLearned(ctx context.Context, userID entity.UID, limit int, filters map[string]interface{}) ([]*entity.HanChar, error)
+	// ListDistractorCandidates 获取可作为测试干扰项的汉字，与目标汉字越相关的越靠前
+	ListDistractorCandidates(ctx context.Context, hanChar *entity.HanChar, limit int) ([]*entity.HanChar, error)
 }
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/lazyjean/sla2/internal/domain/entity"
+)
+
+// HanCharStrokesRepository 汉字笔顺数据仓储接口
+type HanCharStrokesRepository interface {
+	// GetByHanCharID 获取汉字的笔顺数据，不存在时返回 nil
+	GetByHanCharID(ctx context.Context, hanCharID entity.HanCharID) (*entity.HanCharStrokes, error)
+	// Save 保存汉字的笔顺数据，已存在时覆盖
+	Save(ctx context.Context, strokes *entity.HanCharStrokes) error
+}
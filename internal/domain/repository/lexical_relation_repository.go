@@ -0,0 +1,28 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/lazyjean/sla2/internal/domain/entity"
+)
+
+// LexicalRelationRepository 单词关联和汉字关联仓储接口
+type LexicalRelationRepository interface {
+	// CreateWordRelation 创建单词关联，同一对单词已有同类关联时返回 ErrRelationAlreadyExists
+	CreateWordRelation(ctx context.Context, relation *entity.WordRelation) error
+	// ImportWordRelations 批量创建单词关联，跳过已存在的关联，返回实际创建的数量
+	ImportWordRelations(ctx context.Context, relations []*entity.WordRelation) (int, error)
+	// DeleteWordRelation 删除单词关联，不存在时返回 ErrRelationNotFound
+	DeleteWordRelation(ctx context.Context, id entity.WordRelationID) error
+	// ListWordRelations 获取单词作为源或目标的关联，并加载两端的单词；types 为空时返回全部类型
+	ListWordRelations(ctx context.Context, wordID entity.WordID, types []entity.LexicalRelationType) ([]*entity.WordRelation, error)
+
+	// CreateHanCharRelation 创建汉字关联，同一对汉字已有同类关联时返回 ErrRelationAlreadyExists
+	CreateHanCharRelation(ctx context.Context, relation *entity.HanCharRelation) error
+	// ImportHanCharRelations 批量创建汉字关联，跳过已存在的关联，返回实际创建的数量
+	ImportHanCharRelations(ctx context.Context, relations []*entity.HanCharRelation) (int, error)
+	// DeleteHanCharRelation 删除汉字关联，不存在时返回 ErrRelationNotFound
+	DeleteHanCharRelation(ctx context.Context, id entity.HanCharRelationID) error
+	// ListHanCharRelations 获取汉字作为源或目标的关联，并加载两端的汉字；types 为空时返回全部类型
+	ListHanCharRelations(ctx context.Context, hanCharID entity.HanCharID, types []entity.LexicalRelationType) ([]*entity.HanCharRelation, error)
+}
@@ -11,6 +11,26 @@ import (
 type MemoryReviewRepository interface {
 	// Create 创建复习记录
 	Create(ctx context.Context, review *entity.MemoryReview) error
-	// ListByUserIDAndTimeRange 获取用户指定时间范围内的复习记录
+	// ListByUserIDAndTimeRange 获取用户指定时间范围内的复习记录，不含人工调整记录
 	ListByUserIDAndTimeRange(ctx context.Context, userID uint32, startTime, endTime time.Time) ([]*entity.MemoryReview, error)
+	// ListByMemoryUnitID 获取记忆单元的复习记录（按复习时间升序，分页），包含人工调整记录
+	ListByMemoryUnitID(ctx context.Context, memoryUnitID uint32, offset, limit int) ([]*entity.MemoryReview, error)
+	// CountByMemoryUnitID 计算记忆单元的复习记录总数
+	CountByMemoryUnitID(ctx context.Context, memoryUnitID uint32) (int64, error)
+	// ListIdempotencyKeys 返回 keys 中用户已提交过的幂等键
+	ListIdempotencyKeys(ctx context.Context, userID uint32, keys []string) ([]string, error)
+	// GetRetentionStats 按记忆单元类型统计用户自 since 起的到期复习的回忆情况，types 为空表示全部类型
+	// 只统计复习前计划间隔不少于一天且未提前的复习，不含首次学习、当天的重学和跳过
+	GetRetentionStats(ctx context.Context, userID uint32, types []entity.MemoryUnitType, since time.Time) ([]*RetentionStats, error)
+	// ListReviewedUserIDs 获取自 since 起有复习记录（不含人工调整记录）的用户ID（升序）
+	ListReviewedUserIDs(ctx context.Context, since time.Time) ([]uint32, error)
+	// DeleteBefore 删除指定时间之前的复习记录，返回删除的条数
+	DeleteBefore(ctx context.Context, before time.Time) (int64, error)
+}
+
+// RetentionStats 一种记忆单元类型的到期复习回忆情况
+type RetentionStats struct {
+	Type         entity.MemoryUnitType `json:"type"`          // 记忆单元类型
+	ReviewCount  int                   `json:"review_count"`  // 到期复习次数
+	CorrectCount int                   `json:"correct_count"` // 成功回忆的次数
 }
@@ -8,37 +8,68 @@ import (
 )
 
 // MemoryUnitRepository 记忆单元仓储接口
+// 记忆单元归属于用户，除创建和更新外的所有查询都必须指定用户ID，只返回该用户的记忆单元
 type MemoryUnitRepository interface {
 	// Create 创建记忆单元
 	Create(ctx context.Context, unit *entity.MemoryUnit) error
 	// Update 更新记忆单元
 	Update(ctx context.Context, unit *entity.MemoryUnit) error
-	// GetByID 通过ID获取记忆单元
-	GetByID(ctx context.Context, id uint32) (*entity.MemoryUnit, error)
-	// GetByTypeAndContentID 通过类型和内容ID获取记忆单元
-	GetByTypeAndContentID(ctx context.Context, unitType entity.MemoryUnitType, contentID uint32) (*entity.MemoryUnit, error)
-	// ListNeedReview 获取需要复习的记忆单元列表 (DEPRECATED? Consider removing if ListNeedReviewByTypes covers all cases)
-	ListNeedReview(ctx context.Context, unitType entity.MemoryUnitType, before time.Time, limit int) ([]*entity.MemoryUnit, error)
-	// ListNeedReviewByTypes 根据类型列表获取需要复习的记忆单元列表（分页）
-	ListNeedReviewByTypes(ctx context.Context, types []entity.MemoryUnitType, before time.Time, offset uint32, limit int) ([]*entity.MemoryUnit, error)
-	// CountNeedReviewByTypes 根据类型列表计算需要复习的记忆单元总数
-	CountNeedReviewByTypes(ctx context.Context, types []entity.MemoryUnitType, before time.Time) (int64, error)
+	// GetByID 通过ID获取用户的记忆单元，不存在或不属于该用户时返回 nil
+	GetByID(ctx context.Context, userID entity.UID, id uint32) (*entity.MemoryUnit, error)
+	// GetByTypeAndContentID 通过类型和内容ID获取用户的记忆单元，不存在时返回 nil
+	GetByTypeAndContentID(ctx context.Context, userID entity.UID, unitType entity.MemoryUnitType, contentID uint32) (*entity.MemoryUnit, error)
+	// ListNeedReview 获取用户需要复习的记忆单元列表，不含已暂停复习的记忆单元
+	ListNeedReview(ctx context.Context, userID entity.UID, unitType entity.MemoryUnitType, before time.Time, limit int) ([]*entity.MemoryUnit, error)
+	// ListNeedReviewByTypes 根据类型列表获取用户需要复习的记忆单元列表（分页），不含已暂停复习的记忆单元
+	ListNeedReviewByTypes(ctx context.Context, userID entity.UID, types []entity.MemoryUnitType, before time.Time, offset uint32, limit int) ([]*entity.MemoryUnit, error)
+	// CountNeedReviewByTypes 根据类型列表计算用户需要复习的记忆单元总数，不含已暂停复习的记忆单元
+	CountNeedReviewByTypes(ctx context.Context, userID entity.UID, types []entity.MemoryUnitType, before time.Time) (int64, error)
+	// ListReviewSchedules 获取用户在 before 之前到期的记忆单元的复习安排，不含已暂停复习的记忆单元
+	ListReviewSchedules(ctx context.Context, userID entity.UID, types []entity.MemoryUnitType, before time.Time) ([]*ReviewSchedule, error)
+	// ListLeeches 获取用户的顽固项（分页），按遗忘次数降序，types 为空表示全部类型
+	ListLeeches(ctx context.Context, userID entity.UID, types []entity.MemoryUnitType, offset uint32, limit int) ([]*entity.MemoryUnit, error)
+	// CountLeeches 计算用户的顽固项总数
+	CountLeeches(ctx context.Context, userID entity.UID, types []entity.MemoryUnitType) (int64, error)
+	// ListLeechContents 统计所有用户中被标记为顽固项次数最多的学习内容，按标记人数降序
+	ListLeechContents(ctx context.Context, types []entity.MemoryUnitType, limit int) ([]*LeechContentStats, error)
 	// ListByUserID 获取用户的所有记忆单元
-	ListByUserID(ctx context.Context, userID uint32) ([]*entity.MemoryUnit, error)
+	ListByUserID(ctx context.Context, userID entity.UID) ([]*entity.MemoryUnit, error)
 	// ListByUserIDAndType 获取用户指定类型的记忆单元
-	ListByUserIDAndType(ctx context.Context, userID uint32, unitType entity.MemoryUnitType) ([]*entity.MemoryUnit, error)
-	// GetStats 获取指定用户的统计信息
-	GetStats(ctx context.Context, userID entity.UID, unitType entity.MemoryUnitType) (*MemoryUnitStats, error)
+	ListByUserIDAndType(ctx context.Context, userID entity.UID, unitType entity.MemoryUnitType) ([]*entity.MemoryUnit, error)
+	// ListByContentIDs 获取用户指定类型和内容ID的记忆单元，按ID升序
+	ListByContentIDs(ctx context.Context, userID entity.UID, unitType entity.MemoryUnitType, contentIDs []uint32) ([]*entity.MemoryUnit, error)
+	// CountCreatedSince 计算用户在指定时间之后新建的记忆单元数量
+	CountCreatedSince(ctx context.Context, userID entity.UID, since time.Time) (int64, error)
+	// ListCreatedTimes 获取用户在 [start, end) 内新建的记忆单元的创建时间（按时间升序）
+	ListCreatedTimes(ctx context.Context, userID entity.UID, start, end time.Time) ([]time.Time, error)
+	// GetStats 获取用户的统计信息，types 为空表示汇总全部类型
+	GetStats(ctx context.Context, userID entity.UID, types []entity.MemoryUnitType) (*MemoryUnitStats, error)
+}
+
+// ReviewSchedule 记忆单元的复习安排
+type ReviewSchedule struct {
+	Type         entity.MemoryUnitType `json:"type"`           // 记忆单元类型
+	NextReviewAt time.Time             `json:"next_review_at"` // 下次复习时间
+}
+
+// LeechContentStats 学习内容的顽固项统计信息
+type LeechContentStats struct {
+	Type        entity.MemoryUnitType `json:"type"`         // 记忆单元类型
+	ContentID   uint32                `json:"content_id"`   // 内容ID
+	LeechCount  int                   `json:"leech_count"`  // 将该内容标记为顽固项的用户数
+	TotalLapses int                   `json:"total_lapses"` // 这些用户的累计遗忘次数
 }
 
 // MemoryUnitStats 记忆单元统计信息
 type MemoryUnitStats struct {
-	TotalCount      int     `json:"total_count"`       // 总数
-	MasteredCount   int     `json:"mastered_count"`    // 已掌握数量
-	LearningCount   int     `json:"learning_count"`    // 学习中数量
-	NewCount        int     `json:"new_count"`         // 新内容数量
-	MasteryRate     float64 `json:"mastery_rate"`      // 掌握率
-	RetentionRate   float64 `json:"retention_rate"`    // 记忆保持率
-	DailyReviewGoal int     `json:"daily_review_goal"` // 每日复习目标
-	DailyNewGoal    int     `json:"daily_new_goal"`    // 每日新内容目标
+	TotalCount      int                           `json:"total_count"`       // 总数
+	MasteredCount   int                           `json:"mastered_count"`    // 已掌握数量（掌握和精通）
+	LearningCount   int                           `json:"learning_count"`    // 学习中数量（初学和熟悉）
+	NewCount        int                           `json:"new_count"`         // 新内容数量（未学习）
+	LevelCounts     map[entity.MasteryLevel]int   `json:"level_counts"`      // 各掌握程度的数量
+	TypeCounts      map[entity.MemoryUnitType]int `json:"type_counts"`       // 各类型的数量
+	TotalStudyTime  uint64                        `json:"total_study_time"`  // 总学习时长（秒）
+	MasteryRate     float64                       `json:"mastery_rate"`      // 掌握率
+	DailyReviewGoal int                           `json:"daily_review_goal"` // 每日复习目标
+	DailyNewGoal    int                           `json:"daily_new_goal"`    // 每日新内容目标
 }
@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/lazyjean/sla2/internal/domain/entity"
+)
+
+// PhraseRepository 短语仓储接口
+type PhraseRepository interface {
+	// Create 创建短语
+	Create(ctx context.Context, phrase *entity.Phrase) error
+	// Update 更新短语
+	Update(ctx context.Context, phrase *entity.Phrase) error
+	// Delete 删除短语
+	Delete(ctx context.Context, id entity.PhraseID) error
+	// GetByID 根据ID获取短语，不存在时返回 nil
+	GetByID(ctx context.Context, id entity.PhraseID) (*entity.Phrase, error)
+	// List 分页获取短语列表，按ID升序
+	List(ctx context.Context, offset, limit int) ([]*entity.Phrase, int64, error)
+}
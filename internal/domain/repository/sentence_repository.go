@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/lazyjean/sla2/internal/domain/entity"
+)
+
+// SentenceRepository 例句仓储接口
+type SentenceRepository interface {
+	// Create 创建例句
+	Create(ctx context.Context, sentence *entity.Sentence) error
+	// Update 更新例句
+	Update(ctx context.Context, sentence *entity.Sentence) error
+	// Delete 删除例句
+	Delete(ctx context.Context, id entity.SentenceID) error
+	// GetByID 根据ID获取例句，不存在时返回 nil
+	GetByID(ctx context.Context, id entity.SentenceID) (*entity.Sentence, error)
+	// List 分页获取例句列表，按ID升序
+	List(ctx context.Context, offset, limit int) ([]*entity.Sentence, int64, error)
+}
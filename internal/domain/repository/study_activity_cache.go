@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/lazyjean/sla2/internal/domain/entity"
+)
+
+// StudyActivity 用户复习记录保留期内的每日学习统计快照
+// 每日统计按计算时的时区和学习日开始时间分组，学习偏好变化后快照失效
+type StudyActivity struct {
+	TimeZone     string              `json:"time_zone"`
+	DayStartHour uint32              `json:"day_start_hour"`
+	Days         []*entity.DailyStat `json:"days"` // 有学习记录的学习日，按日期升序
+}
+
+// StudyActivityCache 学习活动缓存接口，按用户缓存每日学习统计
+type StudyActivityCache interface {
+	// Get 获取用户的每日学习统计快照，未命中时返回 false
+	Get(ctx context.Context, userID entity.UID) (*StudyActivity, bool)
+	// Set 缓存用户的每日学习统计快照
+	Set(ctx context.Context, userID entity.UID, activity *StudyActivity) error
+	// Delete 清除用户的每日学习统计快照，用户产生新的学习记录后调用
+	Delete(ctx context.Context, userID entity.UID) error
+}
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/lazyjean/sla2/internal/domain/entity"
+)
+
+// StudySessionRepository 学习会话仓储接口
+type StudySessionRepository interface {
+	// Create 创建学习会话
+	Create(ctx context.Context, session *entity.StudySession) error
+	// GetByID 通过ID获取用户的学习会话，不存在或不属于该用户时返回 nil
+	GetByID(ctx context.Context, userID entity.UID, id entity.StudySessionID) (*entity.StudySession, error)
+}
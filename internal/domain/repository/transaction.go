@@ -0,0 +1,10 @@
+package repository
+
+import "context"
+
+// TransactionManager 事务管理器
+// 在 fn 中通过传入的 ctx 调用的仓储方法共享同一个事务
+type TransactionManager interface {
+	// WithinTransaction 在事务中执行 fn，fn 返回错误时回滚事务
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
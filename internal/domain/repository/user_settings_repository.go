@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/lazyjean/sla2/internal/domain/entity"
+)
+
+// UserSettingsRepository 用户学习偏好仓储接口
+type UserSettingsRepository interface {
+	// GetByUserID 获取用户学习偏好，用户未设置时返回默认偏好
+	GetByUserID(ctx context.Context, userID entity.UID) (*entity.UserSettings, error)
+	// Save 保存用户学习偏好，不存在时创建
+	Save(ctx context.Context, settings *entity.UserSettings) error
+}
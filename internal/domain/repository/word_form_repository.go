@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/lazyjean/sla2/internal/domain/entity"
+)
+
+// WordFormRepository 单词词形仓储接口
+type WordFormRepository interface {
+	// ReplaceByWordID 用新的词形替换单词已有的全部词形
+	ReplaceByWordID(ctx context.Context, wordID entity.WordID, forms []*entity.WordForm) error
+	// ListByWordIDs 获取单词的词形，按单词ID分组，每组按ID升序
+	ListByWordIDs(ctx context.Context, wordIDs []entity.WordID) (map[entity.WordID][]*entity.WordForm, error)
+	// ListByForm 获取与词形（不区分大小写）相同的所有词形记录，按原形单词ID升序，用于从词形查找原形
+	ListByForm(ctx context.Context, form string) ([]*entity.WordForm, error)
+}
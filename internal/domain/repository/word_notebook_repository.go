@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/lazyjean/sla2/internal/domain/entity"
+	"github.com/lazyjean/sla2/internal/domain/valueobject"
+)
+
+// WordNotebookEntrySort 生词本词条的排序方式
+type WordNotebookEntrySort int
+
+const (
+	WordNotebookEntrySortAddedDesc WordNotebookEntrySort = iota // 按加入时间降序，默认
+	WordNotebookEntrySortAddedAsc                               // 按加入时间升序
+	WordNotebookEntrySortTextAsc                                // 按单词字母升序
+	WordNotebookEntrySortTextDesc                               // 按单词字母降序
+)
+
+// IsValid 检查排序方式是否有效
+func (s WordNotebookEntrySort) IsValid() bool {
+	return s >= WordNotebookEntrySortAddedDesc && s <= WordNotebookEntrySortTextDesc
+}
+
+// WordNotebookEntryQuery 生词本词条的查询条件
+type WordNotebookEntryQuery struct {
+	NotebookID entity.WordNotebookID
+	Keyword    string                          // 按单词前缀或个人笔记过滤，为空时不过滤
+	Level      valueobject.WordDifficultyLevel // 词典单词的难度等级，未指定时不过滤
+	Tags       []string                        // 词典单词需全部包含的标签，为空时不过滤
+	Sort       WordNotebookEntrySort
+	Offset     int
+	Limit      int
+}
+
+// WordNotebookRepository 生词本仓储接口
+type WordNotebookRepository interface {
+	// CreateNotebook 创建生词本
+	CreateNotebook(ctx context.Context, notebook *entity.WordNotebook) error
+	// UpdateNotebook 更新生词本
+	UpdateNotebook(ctx context.Context, notebook *entity.WordNotebook) error
+	// DeleteNotebook 删除生词本及其中的词条，以及自定义词条的记忆单元和复习记录
+	DeleteNotebook(ctx context.Context, id entity.WordNotebookID) error
+	// GetNotebook 获取用户的生词本，不存在或不属于该用户时返回 nil
+	GetNotebook(ctx context.Context, userID entity.UID, id entity.WordNotebookID) (*entity.WordNotebook, error)
+	// GetNotebookByName 按名称获取用户的生词本，不存在时返回 nil
+	GetNotebookByName(ctx context.Context, userID entity.UID, name string) (*entity.WordNotebook, error)
+	// ListNotebooks 获取用户的所有生词本，按创建时间升序
+	ListNotebooks(ctx context.Context, userID entity.UID) ([]*entity.WordNotebook, error)
+	// CountEntries 统计各生词本中的词条数量
+	CountEntries(ctx context.Context, ids []entity.WordNotebookID) (map[entity.WordNotebookID]int64, error)
+
+	// CreateEntry 创建词条
+	CreateEntry(ctx context.Context, entry *entity.WordNotebookEntry) error
+	// UpdateEntry 更新词条
+	UpdateEntry(ctx context.Context, entry *entity.WordNotebookEntry) error
+	// DeleteEntry 删除词条，以及自定义词条的记忆单元和复习记录
+	DeleteEntry(ctx context.Context, id entity.WordNotebookEntryID) error
+	// GetEntry 获取生词本中的词条并预加载词典单词，不存在时返回 nil
+	GetEntry(ctx context.Context, notebookID entity.WordNotebookID, id entity.WordNotebookEntryID) (*entity.WordNotebookEntry, error)
+	// GetEntryByWordID 获取生词本中引用该词典单词的词条，不存在时返回 nil
+	GetEntryByWordID(ctx context.Context, notebookID entity.WordNotebookID, wordID entity.WordID) (*entity.WordNotebookEntry, error)
+	// GetCustomEntryByText 获取生词本中单词相同（不区分大小写）的自定义词条，不存在时返回 nil
+	GetCustomEntryByText(ctx context.Context, notebookID entity.WordNotebookID, text string) (*entity.WordNotebookEntry, error)
+	// ListEntries 分页查询生词本中的词条并预加载词典单词，返回词条和总数
+	ListEntries(ctx context.Context, query *WordNotebookEntryQuery) ([]*entity.WordNotebookEntry, int64, error)
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/lazyjean/sla2/internal/domain/entity"
+	"github.com/lazyjean/sla2/internal/domain/valueobject"
 )
 
 // WordRepository 单词仓库接口
@@ -25,6 +26,8 @@ type WordRepository interface {
 	ListByIDs(ctx context.Context, ids []entity.WordID) ([]*entity.Word, error)
 	// Search 搜索单词
 	Search(ctx context.Context, keyword string, offset, limit int, filters map[string]interface{}) ([]*entity.Word, int64, error)
+	// FullTextSearch 在单词、释义、例句和同义词中全文检索单词，按相关度降序
+	FullTextSearch(ctx context.Context, query *WordSearchQuery) ([]*WordSearchHit, int64, error)
 	// GetAllTags 获取所有标签
 	GetAllTags(ctx context.Context) ([]string, error)
 	// GetAllCategories 获取所有分类
@@ -42,6 +45,22 @@ type CachedWordRepository interface {
 	ListByIDs(ctx context.Context, ids []entity.WordID) ([]*entity.Word, error)
 }
 
+// WordSearchQuery 单词全文检索条件
+type WordSearchQuery struct {
+	Keyword string                          // 检索关键词，支持多个词和词的前缀
+	Level   valueobject.WordDifficultyLevel // 难度等级，未指定时不过滤
+	Tags    []string                        // 需全部包含的标签
+	Fuzzy   bool                            // 是否容忍拼写错误
+	Offset  int                             // 分页偏移
+	Limit   int                             // 分页大小
+}
+
+// WordSearchHit 单词全文检索的结果
+type WordSearchHit struct {
+	Word *entity.Word
+	Rank float64 // 相关度，越大越相关
+}
+
 // WordQuery 定义查询参数
 type WordQuery struct {
 	UserID        entity.UID // 用户ID
@@ -57,6 +57,9 @@ func (pi *PermissionInitializer) initializePolicies(ctx context.Context) error {
 		// 课程管理员角色权限
 		{fmt.Sprintf("r:%s", RoleContentManager), ResourceCourse, ActionAny},
 		{fmt.Sprintf("r:%s", RoleContentManager), ResourceQuestion, ActionAny},
+		{fmt.Sprintf("r:%s", RoleContentManager), ResourceWord, ActionAny},
+		{fmt.Sprintf("r:%s", RoleContentManager), ResourceHanChar, ActionAny},
+		{fmt.Sprintf("r:%s", RoleContentManager), ResourceLearningStats, ActionList},
 	}
 
 	// 添加权限策略
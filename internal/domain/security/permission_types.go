@@ -20,6 +20,10 @@ const (
 	ResourceQuestionTag = "question_tag"
 	// ResourceWord 单词资源
 	ResourceWord = "word"
+	// ResourceHanChar 汉字资源
+	ResourceHanChar = "han_char"
+	// ResourceLearningStats 全体用户的学习统计资源
+	ResourceLearningStats = "learning_stats"
 	// ResourceAny 任意资源，通配符
 	ResourceAny = "*"
 )
@@ -0,0 +1,38 @@
+package service
+
+import (
+	"time"
+
+	"github.com/lazyjean/sla2/internal/domain/entity"
+)
+
+// maxSimulatedReviews 模拟单个内容复习的最大次数，防止调度间隔异常时无限循环
+const maxSimulatedReviews = 100
+
+// SimulateReviewOffsets 模拟一个新学习的内容在 horizon 内的后续复习
+// 假设首次学习和之后的每次复习都评为良好，按调度器给出的间隔推算，
+// 返回各次复习距离首次学习的时长（升序，不含首次学习）
+func SimulateReviewOffsets(scheduler Scheduler, horizon time.Duration) []time.Duration {
+	var offsets []time.Duration
+	start := time.Unix(0, 0)
+	unit := entity.NewMemoryUnit(0, entity.MemoryUnitTypeUnspecified, 0)
+	unit.LastReviewAt = start
+
+	reviewedAt, elapsed := start, time.Duration(0)
+	for len(offsets) < maxSimulatedReviews {
+		unit.UpdateReviewStats(entity.ReviewGradeGood, 0, reviewedAt)
+		interval := scheduler.Schedule(unit, entity.ReviewGradeGood, elapsed)
+		if interval <= 0 {
+			break
+		}
+
+		reviewedAt = reviewedAt.Add(interval)
+		offset := reviewedAt.Sub(start)
+		if offset >= horizon {
+			break
+		}
+		offsets = append(offsets, offset)
+		elapsed = interval
+	}
+	return offsets
+}
@@ -0,0 +1,77 @@
+package service
+
+import (
+	"errors"
+
+	"github.com/lazyjean/sla2/internal/domain/entity"
+)
+
+// MinFSRSFitPredictions 拟合 FSRS 权重至少需要的可评估复习次数，过少的数据拟合结果不可信
+const MinFSRSFitPredictions = 100
+
+// ErrInsufficientReviewHistory 复习记录不足以拟合调度参数
+var ErrInsufficientReviewHistory = errors.New("insufficient review history")
+
+const (
+	fsrsFitInitialStep = 0.2  // 坐标搜索的初始相对步长
+	fsrsFitMinStep     = 0.01 // 步长缩小到该值以下时停止搜索
+	fsrsMinWeight      = 0.01
+	fsrsMaxWeight      = 100.0
+)
+
+// fsrsUnitWeights 取值在 [0, 1] 之间的权重：难度均值回归系数和困难惩罚系数
+var fsrsUnitWeights = map[int]bool{7: true, 15: true}
+
+// FitFSRSWeights 以回放的对数损失为目标，用坐标搜索拟合 FSRS 模型权重
+// 从 opts 中的权重（为空时使用默认权重）出发，每轮依次尝试按当前步长增大或减小各个权重，
+// 一轮没有改进时步长减半，最多搜索 iterations 轮；返回拟合的权重和对应的回放指标
+func FitFSRSWeights(histories [][]*entity.MemoryReview, opts SchedulerOptions, iterations int) ([]float64, ReplayMetrics, error) {
+	weights := make([]float64, len(DefaultFSRSWeights))
+	copy(weights, DefaultFSRSWeights)
+	if len(opts.Weights) == len(DefaultFSRSWeights) {
+		copy(weights, opts.Weights)
+	}
+
+	replay := func(w []float64) ReplayMetrics {
+		candidate := opts
+		candidate.Weights = w
+		return Replay(histories, SchedulerReplay(NewFSRSScheduler(candidate)))
+	}
+
+	best := replay(weights)
+	if best.Predictions < MinFSRSFitPredictions {
+		return nil, best, ErrInsufficientReviewHistory
+	}
+
+	step := fsrsFitInitialStep
+	for i := 0; i < iterations && step >= fsrsFitMinStep; i++ {
+		improved := false
+		for j := range weights {
+			for _, direction := range []float64{1, -1} {
+				candidate := make([]float64, len(weights))
+				copy(candidate, weights)
+				candidate[j] = clampFSRSWeight(j, weights[j]*(1+direction*step))
+				if candidate[j] == weights[j] {
+					continue
+				}
+				if metrics := replay(candidate); metrics.LogLoss() < best.LogLoss() {
+					weights, best, improved = candidate, metrics, true
+					break
+				}
+			}
+		}
+		if !improved {
+			step /= 2
+		}
+	}
+	return weights, best, nil
+}
+
+// clampFSRSWeight 将权重限制在合理范围内
+func clampFSRSWeight(index int, weight float64) float64 {
+	upper := fsrsMaxWeight
+	if fsrsUnitWeights[index] {
+		upper = 1
+	}
+	return min(max(weight, fsrsMinWeight), upper)
+}
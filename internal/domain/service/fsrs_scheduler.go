@@ -0,0 +1,167 @@
+package service
+
+import (
+	"math"
+	"time"
+
+	"github.com/lazyjean/sla2/internal/domain/entity"
+)
+
+// DefaultFSRSWeights FSRS-4.5 默认模型权重
+var DefaultFSRSWeights = []float64{
+	0.4072, 1.1829, 3.1262, 15.4722, 7.2102, 0.5316, 1.0651, 0.0234, 1.616,
+	0.1544, 1.0824, 1.9813, 0.0953, 0.2975, 2.2042, 0.2407, 2.9466,
+}
+
+const (
+	fsrsDecay  = -0.5
+	fsrsFactor = 19.0 / 81.0 // 保证 R(S, S) = 90%
+
+	fsrsMinDifficulty = 1.0
+	fsrsMaxDifficulty = 10.0
+
+	// fsrsRelearnStep 遗忘后的重学间隔，当天再次巩固
+	fsrsRelearnStep = 10 * time.Minute
+
+	defaultDesiredRetention = 0.9
+	defaultMaximumInterval  = 36500
+)
+
+// FSRSScheduler FSRS 调度器
+// 以稳定性（S）、难度（D）和可提取概率（R）三个变量建模记忆，
+// 在可提取概率降至期望记忆保持率时安排下次复习
+type FSRSScheduler struct {
+	w                []float64
+	desiredRetention float64
+	maximumInterval  float64
+}
+
+// NewFSRSScheduler 创建 FSRS 调度器
+func NewFSRSScheduler(opts SchedulerOptions) *FSRSScheduler {
+	w := DefaultFSRSWeights
+	if len(opts.Weights) == len(DefaultFSRSWeights) {
+		w = opts.Weights
+	}
+
+	desiredRetention := opts.DesiredRetention
+	if desiredRetention <= 0 || desiredRetention >= 1 {
+		desiredRetention = defaultDesiredRetention
+	}
+
+	maximumInterval := opts.MaximumInterval
+	if maximumInterval == 0 {
+		maximumInterval = defaultMaximumInterval
+	}
+
+	return &FSRSScheduler{
+		w:                w,
+		desiredRetention: desiredRetention,
+		maximumInterval:  float64(maximumInterval),
+	}
+}
+
+// Name 调度算法名称
+func (s *FSRSScheduler) Name() SchedulerName {
+	return SchedulerFSRS
+}
+
+// Schedule 更新记忆单元的稳定性、难度和可提取概率，并计算下次复习间隔
+func (s *FSRSScheduler) Schedule(unit *entity.MemoryUnit, grade entity.ReviewGrade, elapsed time.Duration) time.Duration {
+	rating := grade
+	if !rating.IsRecalled() {
+		rating = entity.ReviewGradeAgain
+	}
+
+	if unit.Stability <= 0 {
+		// 首次复习，按评分初始化稳定性和难度
+		unit.Stability = s.initStability(rating)
+		unit.Difficulty = s.initDifficulty(rating)
+		unit.Retrievability = 0
+	} else {
+		elapsedDays := math.Max(elapsed.Hours()/24, 0)
+		r := s.retrievability(elapsedDays, unit.Stability)
+		if rating == entity.ReviewGradeAgain {
+			unit.Stability = s.nextForgetStability(unit.Difficulty, unit.Stability, r)
+		} else {
+			unit.Stability = s.nextRecallStability(unit.Difficulty, unit.Stability, r, rating)
+		}
+		unit.Difficulty = s.nextDifficulty(unit.Difficulty, rating)
+		unit.Retrievability = r
+	}
+
+	if rating == entity.ReviewGradeAgain {
+		return fsrsRelearnStep
+	}
+	return s.nextInterval(unit.Stability)
+}
+
+// Retrievability 估算记忆单元在经过 elapsed 之后的可提取概率
+func (s *FSRSScheduler) Retrievability(unit *entity.MemoryUnit, elapsed time.Duration) float64 {
+	if unit.Stability <= 0 {
+		return 0
+	}
+	return s.retrievability(math.Max(elapsed.Hours()/24, 0), unit.Stability)
+}
+
+// retrievability 遗忘曲线 R(t, S)
+func (s *FSRSScheduler) retrievability(elapsedDays, stability float64) float64 {
+	return math.Pow(1+fsrsFactor*elapsedDays/stability, fsrsDecay)
+}
+
+// nextInterval 根据稳定性计算达到期望记忆保持率的间隔
+func (s *FSRSScheduler) nextInterval(stability float64) time.Duration {
+	days := stability / fsrsFactor * (math.Pow(s.desiredRetention, 1/fsrsDecay) - 1)
+	days = math.Min(math.Max(math.Round(days), 1), s.maximumInterval)
+	return time.Duration(days) * 24 * time.Hour
+}
+
+// initStability 初始稳定性
+func (s *FSRSScheduler) initStability(rating entity.ReviewGrade) float64 {
+	return math.Max(s.w[rating-1], 0.1)
+}
+
+// initDifficulty 初始难度
+func (s *FSRSScheduler) initDifficulty(rating entity.ReviewGrade) float64 {
+	return clampDifficulty(s.w[4] - (float64(rating)-3)*s.w[5])
+}
+
+// nextDifficulty 更新难度，并向默认难度均值回归
+func (s *FSRSScheduler) nextDifficulty(difficulty float64, rating entity.ReviewGrade) float64 {
+	next := difficulty - s.w[6]*(float64(rating)-3)
+	return clampDifficulty(s.w[7]*s.initDifficulty(entity.ReviewGradeGood) + (1-s.w[7])*next)
+}
+
+// nextRecallStability 回忆成功后的稳定性
+func (s *FSRSScheduler) nextRecallStability(difficulty, stability, r float64, rating entity.ReviewGrade) float64 {
+	hardPenalty := 1.0
+	if rating == entity.ReviewGradeHard {
+		hardPenalty = s.w[15]
+	}
+	easyBonus := 1.0
+	if rating == entity.ReviewGradeEasy {
+		easyBonus = s.w[16]
+	}
+	return stability * (1 + math.Exp(s.w[8])*
+		(11-difficulty)*
+		math.Pow(stability, -s.w[9])*
+		(math.Exp((1-r)*s.w[10])-1)*
+		hardPenalty*
+		easyBonus)
+}
+
+// nextForgetStability 遗忘后的稳定性
+func (s *FSRSScheduler) nextForgetStability(difficulty, stability, r float64) float64 {
+	next := s.w[11] *
+		math.Pow(difficulty, -s.w[12]) *
+		(math.Pow(stability+1, s.w[13]) - 1) *
+		math.Exp((1-r)*s.w[14])
+	// 遗忘后的稳定性不应超过遗忘前
+	return math.Min(next, stability)
+}
+
+// clampDifficulty 将难度限制在 [1, 10]
+func clampDifficulty(difficulty float64) float64 {
+	return math.Min(math.Max(difficulty, fsrsMinDifficulty), fsrsMaxDifficulty)
+}
+
+var _ Scheduler = (*FSRSScheduler)(nil)
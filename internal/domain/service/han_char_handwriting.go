@@ -0,0 +1,111 @@
+package service
+
+import (
+	"math"
+
+	"github.com/lazyjean/sla2/internal/domain/entity"
+)
+
+const (
+	// handwritingSamplePoints 比较笔画时每一笔重新采样的点数
+	handwritingSamplePoints = 16
+	// HandwritingDistanceThreshold 手写笔画与标准中线对应点的平均距离上限（1024×1024 坐标系）
+	HandwritingDistanceThreshold = 180.0
+	// handwritingMinDirectionLength 判断书写方向的最短笔画长度，更短的笔画（如点）不判断方向
+	handwritingMinDirectionLength = 60.0
+)
+
+// GradeHandwriting 按标准笔顺逐笔比较手写笔画与笔顺数据的中线
+// 第 i 笔与标准的第 i 笔比较，位置与形状接近且书写方向一致时记为正确；
+// 少写的笔画记为错误，多写的笔画计入 ExtraStrokes
+func GradeHandwriting(strokes *entity.HanCharStrokes, written []entity.StrokePolyline) *entity.HandwritingResult {
+	result := &entity.HandwritingResult{
+		StrokeCorrect: make([]bool, len(strokes.Medians)),
+	}
+	for i, median := range strokes.Medians {
+		if i < len(written) {
+			result.StrokeCorrect[i] = strokeMatches(median, written[i])
+		}
+	}
+	if len(written) > len(strokes.Medians) {
+		result.ExtraStrokes = len(written) - len(strokes.Medians)
+	}
+	return result
+}
+
+// strokeMatches 判断手写笔画是否与标准中线吻合
+func strokeMatches(median, written entity.StrokePolyline) bool {
+	if len(written) == 0 {
+		return false
+	}
+	expected := resampleStroke(median, handwritingSamplePoints)
+	actual := resampleStroke(written, handwritingSamplePoints)
+
+	var total float64
+	for i := range expected {
+		total += pointDistance(expected[i], actual[i])
+	}
+	if total/float64(len(expected)) > HandwritingDistanceThreshold {
+		return false
+	}
+
+	// 反方向书写的笔画起止点接近时平均距离也可能很小，需要单独判断方向
+	if strokeLength(median) < handwritingMinDirectionLength {
+		return true
+	}
+	ex, ey := direction(median)
+	ax, ay := direction(written)
+	return ex*ax+ey*ay > 0
+}
+
+// resampleStroke 沿折线等距重新采样为 n 个点，单点笔画重复该点
+func resampleStroke(stroke entity.StrokePolyline, n int) entity.StrokePolyline {
+	samples := make(entity.StrokePolyline, 0, n)
+	length := strokeLength(stroke)
+	if len(stroke) == 1 || length == 0 {
+		for len(samples) < n {
+			samples = append(samples, stroke[0])
+		}
+		return samples
+	}
+
+	step := length / float64(n-1)
+	segment, walked := 0, 0.0
+	for i := 0; i < n; i++ {
+		target := step * float64(i)
+		for segment < len(stroke)-2 && walked+pointDistance(stroke[segment], stroke[segment+1]) < target {
+			walked += pointDistance(stroke[segment], stroke[segment+1])
+			segment++
+		}
+		from, to := stroke[segment], stroke[segment+1]
+		ratio := 0.0
+		if d := pointDistance(from, to); d > 0 {
+			ratio = math.Min((target-walked)/d, 1)
+		}
+		samples = append(samples, entity.StrokePoint{
+			from.X() + (to.X()-from.X())*ratio,
+			from.Y() + (to.Y()-from.Y())*ratio,
+		})
+	}
+	return samples
+}
+
+// strokeLength 折线的长度
+func strokeLength(stroke entity.StrokePolyline) float64 {
+	var length float64
+	for i := 1; i < len(stroke); i++ {
+		length += pointDistance(stroke[i-1], stroke[i])
+	}
+	return length
+}
+
+// direction 从起点指向终点的向量
+func direction(stroke entity.StrokePolyline) (float64, float64) {
+	first, last := stroke[0], stroke[len(stroke)-1]
+	return last.X() - first.X(), last.Y() - first.Y()
+}
+
+// pointDistance 两点间的距离
+func pointDistance(a, b entity.StrokePoint) float64 {
+	return math.Hypot(a.X()-b.X(), a.Y()-b.Y())
+}
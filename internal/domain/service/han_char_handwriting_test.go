@@ -0,0 +1,70 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/lazyjean/sla2/internal/domain/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testStrokes “十”的笔顺数据：先横后竖
+func testStrokes() *entity.HanCharStrokes {
+	return entity.NewHanCharStrokes(1, []string{"M 100 500", "M 500 900"}, []entity.StrokePolyline{
+		{{100, 500}, {900, 500}},
+		{{500, 900}, {500, 500}, {500, 100}},
+	})
+}
+
+func TestGradeHandwriting(t *testing.T) {
+	t.Run("笔顺和形状都正确", func(t *testing.T) {
+		result := GradeHandwriting(testStrokes(), []entity.StrokePolyline{
+			{{120, 520}, {500, 480}, {880, 510}},
+			{{510, 880}, {490, 120}},
+		})
+		assert.Equal(t, []bool{true, true}, result.StrokeCorrect)
+		assert.True(t, result.Correct())
+		assert.Equal(t, 0, result.MistakeCount())
+	})
+
+	t.Run("笔顺颠倒", func(t *testing.T) {
+		result := GradeHandwriting(testStrokes(), []entity.StrokePolyline{
+			{{500, 900}, {500, 100}},
+			{{100, 500}, {900, 500}},
+		})
+		assert.Equal(t, []bool{false, false}, result.StrokeCorrect)
+		assert.False(t, result.Correct())
+	})
+
+	t.Run("书写方向相反", func(t *testing.T) {
+		result := GradeHandwriting(testStrokes(), []entity.StrokePolyline{
+			{{900, 500}, {100, 500}},
+			{{500, 900}, {500, 100}},
+		})
+		assert.Equal(t, []bool{false, true}, result.StrokeCorrect)
+	})
+
+	t.Run("缺少和多写笔画", func(t *testing.T) {
+		missing := GradeHandwriting(testStrokes(), []entity.StrokePolyline{{{100, 500}, {900, 500}}})
+		assert.Equal(t, []bool{true, false}, missing.StrokeCorrect)
+		assert.Equal(t, 1, missing.MistakeCount())
+
+		extra := GradeHandwriting(testStrokes(), []entity.StrokePolyline{
+			{{100, 500}, {900, 500}},
+			{{500, 900}, {500, 100}},
+			{{200, 200}, {300, 300}},
+		})
+		assert.Equal(t, []bool{true, true}, extra.StrokeCorrect)
+		assert.Equal(t, 1, extra.ExtraStrokes)
+		assert.False(t, extra.Correct())
+	})
+}
+
+func TestResampleStroke(t *testing.T) {
+	samples := resampleStroke(entity.StrokePolyline{{0, 0}, {100, 0}, {100, 50}}, 4)
+	require.Len(t, samples, 4)
+	assert.Equal(t, entity.StrokePoint{0, 0}, samples[0])
+	assert.Equal(t, entity.StrokePoint{50, 0}, samples[1])
+	assert.Equal(t, entity.StrokePoint{100, 0}, samples[2])
+	assert.Equal(t, entity.StrokePoint{100, 50}, samples[3])
+}
@@ -0,0 +1,222 @@
+package service
+
+import (
+	"math/rand"
+	"sort"
+	"strings"
+
+	"github.com/lazyjean/sla2/internal/domain/entity"
+	"github.com/lazyjean/sla2/pkg/pinyin"
+)
+
+// HanCharOptionCount 汉字选择题的选项数量（含正确答案）
+const HanCharOptionCount = 4
+
+// 干扰项的相关程度，数值越小越容易混淆
+const (
+	distractorTierHomophone = iota // 同音（忽略声调）
+	distractorTierRelated          // 有相同的标签或分类
+	distractorTierSameLevel        // 相同难度等级
+	distractorTierOther
+)
+
+// HanCharTestGenerator 汉字测试生成器
+// 每道题的随机数只由种子、汉字ID和题型决定，与同一测试中的其他题无关；
+// 正确答案在选取干扰项之前确定，不受候选干扰项影响，因此评分时只需种子和作答的汉字、题型即可得到正确答案；
+// 候选干扰项相同时重新生成的题目完全一致
+type HanCharTestGenerator struct {
+	seed int64
+}
+
+// NewHanCharTestGenerator 创建汉字测试生成器
+func NewHanCharTestGenerator(seed int64) *HanCharTestGenerator {
+	return &HanCharTestGenerator{seed: seed}
+}
+
+// Seed 生成器的种子
+func (g *HanCharTestGenerator) Seed() int64 {
+	return g.seed
+}
+
+// Generate 为每个目标汉字生成一道选择题，题型从 types 中按种子选取，
+// 目标汉字不适用的题型会换成其他题型，所有题型都不适用的汉字不出题
+// candidates 为每个目标汉字的候选干扰汉字
+func (g *HanCharTestGenerator) Generate(targets []*entity.HanChar, candidates map[entity.HanCharID][]*entity.HanChar, types []entity.HanCharQuestionType) []*entity.HanCharQuestion {
+	if len(types) == 0 {
+		types = entity.HanCharQuestionTypes
+	}
+
+	questions := make([]*entity.HanCharQuestion, 0, len(targets))
+	for _, target := range targets {
+		order := make([]entity.HanCharQuestionType, len(types))
+		copy(order, types)
+		g.rand(target.ID, entity.HanCharQuestionTypeUnspecified).Shuffle(len(order), func(i, j int) {
+			order[i], order[j] = order[j], order[i]
+		})
+		for _, questionType := range order {
+			if question := g.Question(target, candidates[target.ID], questionType); question != nil {
+				questions = append(questions, question)
+				break
+			}
+		}
+	}
+	return questions
+}
+
+// Answer 目标汉字在指定题型下的正确答案，与候选干扰项无关，题型不适用时返回 false
+func (g *HanCharTestGenerator) Answer(target *entity.HanChar, questionType entity.HanCharQuestionType) (string, bool) {
+	_, answer, ok := promptAndAnswer(target, questionType, g.rand(target.ID, questionType))
+	return answer, ok
+}
+
+// Question 为目标汉字生成一道指定题型的选择题，题型不适用或干扰项不足时返回 nil
+func (g *HanCharTestGenerator) Question(target *entity.HanChar, candidates []*entity.HanChar, questionType entity.HanCharQuestionType) *entity.HanCharQuestion {
+	rng := g.rand(target.ID, questionType)
+	prompt, answer, ok := promptAndAnswer(target, questionType, rng)
+	if !ok {
+		return nil
+	}
+	ranked := rankDistractors(target, candidates, rng)
+
+	var distractors []string
+	switch questionType {
+	case entity.HanCharQuestionTypeCharToPinyin:
+		// 同一音节的其他声调最容易混淆
+		variants := pinyin.ToneVariants(target.Pinyin)
+		rng.Shuffle(len(variants), func(i, j int) { variants[i], variants[j] = variants[j], variants[i] })
+		distractors = append(distractors, variants...)
+		for _, c := range ranked {
+			distractors = append(distractors, c.Pinyin)
+		}
+	case entity.HanCharQuestionTypePinyinToChar:
+		for _, c := range ranked {
+			// 读音完全相同的字同样符合题意，不能作为干扰项
+			if c.Pinyin != target.Pinyin {
+				distractors = append(distractors, c.Character)
+			}
+		}
+	case entity.HanCharQuestionTypeCharToExample:
+		for _, c := range ranked {
+			for _, example := range c.Examples {
+				if !strings.Contains(example, target.Character) {
+					distractors = append(distractors, example)
+				}
+			}
+		}
+	}
+
+	options := pickOptions(answer, distractors)
+	if len(options) < 2 {
+		return nil
+	}
+	rng.Shuffle(len(options), func(i, j int) { options[i], options[j] = options[j], options[i] })
+	question := &entity.HanCharQuestion{
+		HanCharID: target.ID,
+		Type:      questionType,
+		Prompt:    prompt,
+		Options:   options,
+	}
+	for i, option := range options {
+		if option == answer {
+			question.Answer = i
+		}
+	}
+	return question
+}
+
+// rand 创建某个汉字某种题型专用的随机数生成器
+func (g *HanCharTestGenerator) rand(hanCharID entity.HanCharID, questionType entity.HanCharQuestionType) *rand.Rand {
+	return rand.New(rand.NewSource(g.seed*1000003 + int64(hanCharID)*31 + int64(questionType)))
+}
+
+// promptAndAnswer 确定题干和正确答案，必须在选取干扰项之前调用，保证正确答案只取决于种子
+func promptAndAnswer(target *entity.HanChar, questionType entity.HanCharQuestionType, rng *rand.Rand) (string, string, bool) {
+	switch questionType {
+	case entity.HanCharQuestionTypeCharToPinyin:
+		return target.Character, target.Pinyin, target.Pinyin != ""
+	case entity.HanCharQuestionTypePinyinToChar:
+		return target.Pinyin, target.Character, target.Pinyin != ""
+	case entity.HanCharQuestionTypeCharToExample:
+		examples := examplesContaining(target.Examples, target.Character)
+		if len(examples) == 0 {
+			return "", "", false
+		}
+		return target.Character, examples[rng.Intn(len(examples))], true
+	default:
+		return "", "", false
+	}
+}
+
+// rankDistractors 按与目标汉字的相关程度排列候选干扰汉字，同一相关程度内随机排列
+func rankDistractors(target *entity.HanChar, candidates []*entity.HanChar, rng *rand.Rand) []*entity.HanChar {
+	ranked := make([]*entity.HanChar, 0, len(candidates))
+	for _, c := range candidates {
+		if c.ID != target.ID && c.Character != target.Character {
+			ranked = append(ranked, c)
+		}
+	}
+	// 先按ID排序，保证随机排列只取决于种子而与候选的查询顺序无关
+	sort.Slice(ranked, func(i, j int) bool { return ranked[i].ID < ranked[j].ID })
+	rng.Shuffle(len(ranked), func(i, j int) { ranked[i], ranked[j] = ranked[j], ranked[i] })
+
+	tiers := make(map[entity.HanCharID]int, len(ranked))
+	for _, c := range ranked {
+		tiers[c.ID] = distractorTier(target, c)
+	}
+	sort.SliceStable(ranked, func(i, j int) bool { return tiers[ranked[i].ID] < tiers[ranked[j].ID] })
+	return ranked
+}
+
+// distractorTier 计算候选汉字与目标汉字的相关程度
+func distractorTier(target, candidate *entity.HanChar) int {
+	switch {
+	case target.Pinyin != "" && pinyin.Toneless(candidate.Pinyin) == pinyin.Toneless(target.Pinyin):
+		return distractorTierHomophone
+	case sharesAny(target.Tags, candidate.Tags) || sharesAny(target.Categories, candidate.Categories):
+		return distractorTierRelated
+	case target.Level == candidate.Level:
+		return distractorTierSameLevel
+	default:
+		return distractorTierOther
+	}
+}
+
+// pickOptions 取正确答案和去重后的前若干个干扰项
+func pickOptions(answer string, distractors []string) []string {
+	options := []string{answer}
+	seen := map[string]struct{}{answer: {}}
+	for _, d := range distractors {
+		if len(options) == HanCharOptionCount {
+			break
+		}
+		if _, ok := seen[d]; ok || d == "" {
+			continue
+		}
+		seen[d] = struct{}{}
+		options = append(options, d)
+	}
+	return options
+}
+
+// examplesContaining 筛选包含指定汉字的例词
+func examplesContaining(examples []string, character string) []string {
+	var result []string
+	for _, example := range examples {
+		if example != character && strings.Contains(example, character) {
+			result = append(result, example)
+		}
+	}
+	return result
+}
+
+// sharesAny 判断两个列表是否有相同的元素
+func sharesAny(a, b []string) bool {
+	for _, x := range a {
+		for _, y := range b {
+			if x == y {
+				return true
+			}
+		}
+	}
+	return false
+}
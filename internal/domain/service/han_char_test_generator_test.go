@@ -0,0 +1,130 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/lazyjean/sla2/internal/domain/entity"
+	"github.com/lazyjean/sla2/pkg/pinyin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestHanChar(id entity.HanCharID, character, pinyin string, categories []string, examples ...string) *entity.HanChar {
+	return &entity.HanChar{
+		ID:         id,
+		Character:  character,
+		Pinyin:     pinyin,
+		Level:      1,
+		Categories: categories,
+		Examples:   examples,
+	}
+}
+
+func testHanChars() (*entity.HanChar, []*entity.HanChar) {
+	target := newTestHanChar(1, "山", "shān", []string{"自然"}, "山水", "高山")
+	candidates := []*entity.HanChar{
+		newTestHanChar(2, "闪", "shǎn", nil, "闪电"),
+		newTestHanChar(3, "水", "shuǐ", []string{"自然"}, "河水"),
+		newTestHanChar(4, "火", "huǒ", []string{"自然"}, "火山", "火车"),
+		newTestHanChar(5, "删", "shān", nil, "删除"),
+		newTestHanChar(6, "书", "shū", nil, "读书"),
+	}
+	return target, candidates
+}
+
+func TestHanCharTestGenerator_Question(t *testing.T) {
+	target, candidates := testHanChars()
+	generator := NewHanCharTestGenerator(42)
+
+	t.Run("看字选拼音", func(t *testing.T) {
+		question := generator.Question(target, candidates, entity.HanCharQuestionTypeCharToPinyin)
+		require.NotNil(t, question)
+		assert.Equal(t, "山", question.Prompt)
+		assert.Len(t, question.Options, HanCharOptionCount)
+		assert.Equal(t, "shān", question.Options[question.Answer])
+		// 干扰项优先使用同一音节的其他声调
+		for i, option := range question.Options {
+			if i != question.Answer {
+				assert.Equal(t, "shan", pinyin.Toneless(option))
+			}
+		}
+	})
+
+	t.Run("看拼音选字", func(t *testing.T) {
+		question := generator.Question(target, candidates, entity.HanCharQuestionTypePinyinToChar)
+		require.NotNil(t, question)
+		assert.Equal(t, "shān", question.Prompt)
+		assert.Equal(t, "山", question.Options[question.Answer])
+		// 读音完全相同的“删”不能作为干扰项，同音不同调的“闪”最优先
+		assert.NotContains(t, question.Options, "删")
+		assert.Contains(t, question.Options, "闪")
+	})
+
+	t.Run("看字选词", func(t *testing.T) {
+		question := generator.Question(target, candidates, entity.HanCharQuestionTypeCharToExample)
+		require.NotNil(t, question)
+		assert.Contains(t, []string{"山水", "高山"}, question.Options[question.Answer])
+		for i, option := range question.Options {
+			if i != question.Answer {
+				assert.NotContains(t, option, "山")
+			}
+		}
+	})
+
+	t.Run("干扰项不足", func(t *testing.T) {
+		assert.Nil(t, generator.Question(target, nil, entity.HanCharQuestionTypePinyinToChar))
+		assert.Nil(t, generator.Question(newTestHanChar(7, "一", "yī", nil), candidates, entity.HanCharQuestionTypeCharToExample))
+	})
+}
+
+func TestHanCharTestGenerator_Deterministic(t *testing.T) {
+	target, candidates := testHanChars()
+	targets := []*entity.HanChar{target, candidates[2], candidates[4]}
+	pool := map[entity.HanCharID][]*entity.HanChar{
+		target.ID:        candidates,
+		candidates[2].ID: append([]*entity.HanChar{target}, candidates...),
+		candidates[4].ID: append([]*entity.HanChar{target}, candidates...),
+	}
+
+	first := NewHanCharTestGenerator(7).Generate(targets, pool, nil)
+	require.Len(t, first, 3)
+
+	// 相同种子重新生成的题目完全一致，与候选顺序无关
+	reversed := make([]*entity.HanChar, len(candidates))
+	for i, c := range candidates {
+		reversed[len(candidates)-1-i] = c
+	}
+	pool[target.ID] = reversed
+	assert.Equal(t, first, NewHanCharTestGenerator(7).Generate(targets, pool, nil))
+
+	// 单独重新生成某一道题也与测试中的结果一致
+	regenerated := NewHanCharTestGenerator(7).Question(target, candidates, first[0].Type)
+	assert.Equal(t, first[0], regenerated)
+	assert.True(t, regenerated.IsCorrect(first[0].Options[first[0].Answer]))
+}
+
+func TestHanCharTestGenerator_AnswerIgnoresCandidates(t *testing.T) {
+	target, candidates := testHanChars()
+
+	// 出题后候选干扰项变化，正确答案仍与出题时一致
+	for seed := int64(1); seed <= 20; seed++ {
+		generator := NewHanCharTestGenerator(seed)
+		for _, questionType := range entity.HanCharQuestionTypes {
+			question := generator.Question(target, candidates, questionType)
+			require.NotNil(t, question)
+			expected := question.Options[question.Answer]
+
+			answer, ok := generator.Answer(target, questionType)
+			require.True(t, ok)
+			assert.Equal(t, expected, answer, "seed %d type %d", seed, questionType)
+
+			changed := append([]*entity.HanChar{newTestHanChar(7, "衫", "shān", nil, "衬衫")}, candidates[1:3]...)
+			if regenerated := generator.Question(target, changed, questionType); regenerated != nil {
+				assert.True(t, regenerated.IsCorrect(expected), "seed %d type %d", seed, questionType)
+			}
+		}
+	}
+
+	_, ok := NewHanCharTestGenerator(1).Answer(newTestHanChar(8, "口", "", nil), entity.HanCharQuestionTypeCharToPinyin)
+	assert.False(t, ok)
+}
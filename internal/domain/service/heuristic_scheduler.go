@@ -0,0 +1,57 @@
+package service
+
+import (
+	"time"
+
+	"github.com/lazyjean/sla2/internal/domain/entity"
+)
+
+// HeuristicScheduler 经验阶梯调度器
+// 按掌握程度选取基础间隔（1小时/4小时/1天/3天/7天），再根据连续正确/错误次数放大或缩小，
+// 评分通过 UpdateReviewStats 对连续正确/错误次数的影响间接作用于间隔
+type HeuristicScheduler struct{}
+
+// NewHeuristicScheduler 创建经验阶梯调度器
+func NewHeuristicScheduler() *HeuristicScheduler {
+	return &HeuristicScheduler{}
+}
+
+// Name 调度算法名称
+func (s *HeuristicScheduler) Name() SchedulerName {
+	return SchedulerHeuristic
+}
+
+// Schedule 计算下次复习间隔
+func (s *HeuristicScheduler) Schedule(unit *entity.MemoryUnit, grade entity.ReviewGrade, elapsed time.Duration) time.Duration {
+	// 基础间隔（小时）
+	var baseInterval float64
+	switch unit.MasteryLevel {
+	case entity.MasteryLevelUnlearned:
+		baseInterval = 1 // 1小时
+	case entity.MasteryLevelBeginner:
+		baseInterval = 4 // 4小时
+	case entity.MasteryLevelFamiliar:
+		baseInterval = 24 // 1天
+	case entity.MasteryLevelMastered:
+		baseInterval = 72 // 3天
+	case entity.MasteryLevelExpert:
+		baseInterval = 168 // 7天
+	default:
+		baseInterval = 1
+	}
+
+	// 根据连续正确次数调整间隔
+	if unit.ConsecutiveCorrect > 0 {
+		baseInterval *= 1.2 * float64(unit.ConsecutiveCorrect)
+	}
+
+	// 根据连续错误次数减少间隔
+	if unit.ConsecutiveWrong > 0 {
+		baseInterval /= 2.0 * float64(unit.ConsecutiveWrong)
+	}
+
+	// 精确到分钟
+	return time.Duration(int(baseInterval*60)) * time.Minute
+}
+
+var _ Scheduler = (*HeuristicScheduler)(nil)
@@ -13,16 +13,19 @@ import (
 type MemoryStatsService struct {
 	memoryUnitRepo   repository.MemoryUnitRepository
 	memoryReviewRepo repository.MemoryReviewRepository
+	settingsRepo     repository.UserSettingsRepository
 }
 
 // NewMemoryStatsService 创建记忆统计服务
 func NewMemoryStatsService(
 	memoryUnitRepo repository.MemoryUnitRepository,
 	memoryReviewRepo repository.MemoryReviewRepository,
+	settingsRepo repository.UserSettingsRepository,
 ) *MemoryStatsService {
 	return &MemoryStatsService{
 		memoryUnitRepo:   memoryUnitRepo,
 		memoryReviewRepo: memoryReviewRepo,
+		settingsRepo:     settingsRepo,
 	}
 }
 
@@ -30,8 +33,13 @@ func NewMemoryStatsService(
 func (s *MemoryStatsService) CalculateUserStats(ctx context.Context, userID uint32) (*entity.MemoryStats, error) {
 	stats := entity.NewMemoryStats()
 
+	settings, err := s.settingsRepo.GetByUserID(ctx, entity.UID(userID))
+	if err != nil {
+		return nil, err
+	}
+
 	// 1. 获取用户的所有记忆单元
-	units, err := s.memoryUnitRepo.ListByUserID(ctx, userID)
+	units, err := s.memoryUnitRepo.ListByUserID(ctx, entity.UID(userID))
 	if err != nil {
 		return nil, err
 	}
@@ -58,13 +66,17 @@ func (s *MemoryStatsService) CalculateUserStats(ctx context.Context, userID uint
 		return nil, err
 	}
 
-	// 4. 计算每日统计
-	dailyMap := make(map[string]*entity.DailyStat)
+	// 4. 按用户的学习日计算每日统计
+	dailyMap := make(map[time.Time]*entity.DailyStat)
 	for _, review := range reviews {
-		date := review.ReviewTime.Format("2006-01-02")
+		// 跳过不计入复习数量和保持率
+		if review.IsSkip() {
+			continue
+		}
+		date := settings.StartOfDay(review.ReviewTime)
 		if _, exists := dailyMap[date]; !exists {
 			dailyMap[date] = &entity.DailyStat{
-				Date: review.ReviewTime.Truncate(24 * time.Hour),
+				Date: date,
 			}
 		}
 		dailyStat := dailyMap[date]
@@ -90,47 +102,62 @@ func (s *MemoryStatsService) CalculateUserStats(ctx context.Context, userID uint
 }
 
 // CalculateDailyStats 计算每日统计
+// 按用户时区和学习日开始时间分组，只返回有复习或新学习记录的学习日（按日期升序）
 func (s *MemoryStatsService) CalculateDailyStats(ctx context.Context, userID uint32, startDate, endDate time.Time) ([]*entity.DailyStat, error) {
 	var stats []*entity.DailyStat
 
+	settings, err := s.settingsRepo.GetByUserID(ctx, entity.UID(userID))
+	if err != nil {
+		return nil, err
+	}
+
 	// 获取日期范围内的所有复习记录
 	reviews, err := s.memoryReviewRepo.ListByUserIDAndTimeRange(ctx, userID, startDate, endDate)
 	if err != nil {
 		return nil, err
 	}
 
-	// 按日期分组统计
+	// 获取日期范围内新学习的记忆单元
+	created, err := s.memoryUnitRepo.ListCreatedTimes(ctx, entity.UID(userID), startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	// 按用户时区和学习日开始时间分组统计
 	dailyMap := make(map[time.Time]*entity.DailyStat)
-	for _, review := range reviews {
-		date := time.Date(review.ReviewTime.Year(), review.ReviewTime.Month(), review.ReviewTime.Day(), 0, 0, 0, 0, time.Local)
+	responseTimes := make(map[time.Time]uint64)
+	dailyStat := func(t time.Time) *entity.DailyStat {
+		date := settings.StartOfDay(t)
 		if _, ok := dailyMap[date]; !ok {
-			dailyMap[date] = &entity.DailyStat{
-				Date:           date,
-				NewLearned:     0,
-				ReviewCount:    0,
-				CorrectCount:   0,
-				StudyTime:      0,
-				MasteredCount:  0,
-				RetentionRate:  0,
-				ReviewInterval: 0,
-			}
+			dailyMap[date] = &entity.DailyStat{Date: date}
 		}
+		return dailyMap[date]
+	}
 
-		stat := dailyMap[date]
+	for _, review := range reviews {
+		// 跳过不计入复习数量和保持率，只有跳过的学习日不算作学习过
+		if review.IsSkip() {
+			continue
+		}
+		stat := dailyStat(review.ReviewTime)
 		stat.ReviewCount++
 		if review.IsCorrect() {
 			stat.CorrectCount++
 		}
-		stat.StudyTime += review.ResponseTime / 1000 // 转换为秒
+		responseTimes[stat.Date] += uint64(review.ResponseTime)
 
 		// 更新保持率
 		if stat.ReviewCount > 0 {
 			stat.RetentionRate = float32(stat.CorrectCount) / float32(stat.ReviewCount)
 		}
 	}
+	for _, createdAt := range created {
+		dailyStat(createdAt).NewLearned++
+	}
 
 	// 转换为数组并排序
-	for _, stat := range dailyMap {
+	for date, stat := range dailyMap {
+		stat.StudyTime = uint32(responseTimes[date] / 1000) // 转换为秒
 		stats = append(stats, stat)
 	}
 
@@ -145,7 +172,7 @@ func (s *MemoryStatsService) CalculateDailyStats(ctx context.Context, userID uin
 // CalculateRetentionRate 计算记忆保持率
 func (s *MemoryStatsService) CalculateRetentionRate(ctx context.Context, userID uint32, unitType entity.MemoryUnitType) (float32, error) {
 	// 1. 获取指定类型的所有记忆单元
-	units, err := s.memoryUnitRepo.ListByUserIDAndType(ctx, userID, unitType)
+	units, err := s.memoryUnitRepo.ListByUserIDAndType(ctx, entity.UID(userID), unitType)
 	if err != nil {
 		return 0, err
 	}
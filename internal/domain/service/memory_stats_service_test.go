@@ -0,0 +1,64 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lazyjean/sla2/internal/domain/entity"
+	"github.com/lazyjean/sla2/internal/domain/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubStatsReviewRepository 返回固定复习记录的复习记录仓储
+type stubStatsReviewRepository struct {
+	repository.MemoryReviewRepository
+	reviews []*entity.MemoryReview
+}
+
+func (r *stubStatsReviewRepository) ListByUserIDAndTimeRange(context.Context, uint32, time.Time, time.Time) ([]*entity.MemoryReview, error) {
+	return r.reviews, nil
+}
+
+// stubStatsUnitRepository 没有新学习记录的记忆单元仓储
+type stubStatsUnitRepository struct {
+	repository.MemoryUnitRepository
+}
+
+func (r *stubStatsUnitRepository) ListCreatedTimes(context.Context, entity.UID, time.Time, time.Time) ([]time.Time, error) {
+	return nil, nil
+}
+
+// stubStatsSettingsRepository 返回默认偏好的用户偏好仓储
+type stubStatsSettingsRepository struct {
+	repository.UserSettingsRepository
+}
+
+func (r *stubStatsSettingsRepository) GetByUserID(_ context.Context, userID entity.UID) (*entity.UserSettings, error) {
+	settings := entity.NewUserSettings(userID)
+	settings.DayStartHour, settings.TimeZone = 0, "UTC"
+	return settings, nil
+}
+
+func TestMemoryStatsService_CalculateDailyStatsIgnoresSkips(t *testing.T) {
+	day1 := time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
+	day2 := day1.AddDate(0, 0, 1)
+	review := func(result entity.ReviewResult, at time.Time) *entity.MemoryReview {
+		return &entity.MemoryReview{Result: result, ReviewTime: at, ResponseTime: 2000}
+	}
+	reviews := &stubStatsReviewRepository{reviews: []*entity.MemoryReview{
+		review(entity.ReviewResultCorrect, day1),
+		review(entity.ReviewResultSkip, day1.Add(time.Minute)),
+		// 只有跳过的学习日
+		review(entity.ReviewResultSkip, day2),
+	}}
+	stats := NewMemoryStatsService(&stubStatsUnitRepository{}, reviews, &stubStatsSettingsRepository{})
+
+	days, err := stats.CalculateDailyStats(context.Background(), 1, day1.AddDate(0, 0, -1), day2.AddDate(0, 0, 1))
+	require.NoError(t, err)
+	require.Len(t, days, 1)
+	assert.Equal(t, uint32(1), days[0].ReviewCount)
+	assert.Equal(t, float32(1), days[0].RetentionRate)
+	assert.Equal(t, uint32(2), days[0].StudyTime)
+}
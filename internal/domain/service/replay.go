@@ -0,0 +1,231 @@
+package service
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/lazyjean/sla2/internal/domain/entity"
+)
+
+const (
+	// replayMinElapsed 参与评估的最短复习间隔，当天内的重学不评估回忆概率
+	replayMinElapsed = 24 * time.Hour
+	// replayIntervalRecall 不估算可提取概率的调度算法，假设在调度间隔到期时的回忆概率
+	replayIntervalRecall = 0.9
+	// replayEpsilon 计算对数损失时概率的截断值
+	replayEpsilon = 1e-6
+)
+
+// ReplayModel 复习回放模型
+// 按时间顺序应用一个记忆单元的历史评分，并在每次复习前预测回忆概率
+type ReplayModel interface {
+	// Predict 预测距离上次复习 elapsed 后的回忆概率
+	Predict(elapsed time.Duration) float64
+	// Review 应用一次复习评分，返回调度的下次复习间隔
+	Review(grade entity.ReviewGrade, elapsed time.Duration) time.Duration
+}
+
+// ReplayModelFactory 为每个记忆单元创建独立的回放模型
+type ReplayModelFactory func() ReplayModel
+
+// RetrievabilityEstimator 能够估算可提取概率的调度器
+type RetrievabilityEstimator interface {
+	Retrievability(unit *entity.MemoryUnit, elapsed time.Duration) float64
+}
+
+// ReplayMetrics 回放评估指标，可按用户累加后再计算平均值
+type ReplayMetrics struct {
+	Reviews           int     // 回放的复习次数
+	Predictions       int     // 参与评估的复习次数（距上次复习至少一天）
+	TotalLogLoss      float64 // 对数损失之和
+	TotalSquaredError float64 // 预测回忆概率与实际结果的平方误差之和
+	Workload          float64 // 按模型给出的最近一次间隔，平均每天需要的复习次数估计
+}
+
+// LogLoss 平均对数损失
+func (m ReplayMetrics) LogLoss() float64 {
+	if m.Predictions == 0 {
+		return 0
+	}
+	return m.TotalLogLoss / float64(m.Predictions)
+}
+
+// RMSE 预测回忆概率的均方根误差
+func (m ReplayMetrics) RMSE() float64 {
+	if m.Predictions == 0 {
+		return 0
+	}
+	return math.Sqrt(m.TotalSquaredError / float64(m.Predictions))
+}
+
+// Add 累加另一组回放的指标
+func (m *ReplayMetrics) Add(other ReplayMetrics) {
+	m.Reviews += other.Reviews
+	m.Predictions += other.Predictions
+	m.TotalLogLoss += other.TotalLogLoss
+	m.TotalSquaredError += other.TotalSquaredError
+	m.Workload += other.Workload
+}
+
+// GroupReviewHistories 将复习记录按记忆单元分组，组内按复习时间升序，跳过的复习不参与回放
+func GroupReviewHistories(reviews []*entity.MemoryReview) [][]*entity.MemoryReview {
+	groups := make(map[uint32][]*entity.MemoryReview)
+	var unitIDs []uint32
+	for _, review := range reviews {
+		if grade := replayGrade(review); grade == entity.ReviewGradeSkip || grade == entity.ReviewGradeUnspecified {
+			continue
+		}
+		if _, ok := groups[review.MemoryUnitID]; !ok {
+			unitIDs = append(unitIDs, review.MemoryUnitID)
+		}
+		groups[review.MemoryUnitID] = append(groups[review.MemoryUnitID], review)
+	}
+
+	sort.Slice(unitIDs, func(i, j int) bool { return unitIDs[i] < unitIDs[j] })
+	histories := make([][]*entity.MemoryReview, len(unitIDs))
+	for i, id := range unitIDs {
+		history := groups[id]
+		sort.SliceStable(history, func(i, j int) bool {
+			return history[i].ReviewTime.Before(history[j].ReviewTime)
+		})
+		histories[i] = history
+	}
+	return histories
+}
+
+// Replay 用回放模型依次重放各记忆单元的复习历史
+// 每次复习前用模型预测回忆概率并与实际结果比较；复习量按最后一次复习后模型给出的间隔估算，
+// 当天内的重学按一天计
+func Replay(histories [][]*entity.MemoryReview, factory ReplayModelFactory) ReplayMetrics {
+	var metrics ReplayMetrics
+	for _, history := range histories {
+		model := factory()
+		for i, review := range history {
+			var elapsed time.Duration
+			if i > 0 {
+				elapsed = review.ReviewTime.Sub(history[i-1].ReviewTime)
+			}
+
+			grade := replayGrade(review)
+			if i > 0 && elapsed >= replayMinElapsed {
+				p := math.Min(math.Max(model.Predict(elapsed), replayEpsilon), 1-replayEpsilon)
+				y := 0.0
+				if grade.IsRecalled() {
+					y = 1
+				}
+				metrics.Predictions++
+				metrics.TotalLogLoss -= y*math.Log(p) + (1-y)*math.Log(1-p)
+				metrics.TotalSquaredError += (p - y) * (p - y)
+			}
+
+			interval := model.Review(grade, elapsed)
+			metrics.Reviews++
+			if i == len(history)-1 {
+				metrics.Workload += float64(replayMinElapsed) / float64(max(interval, replayMinElapsed))
+			}
+		}
+	}
+	return metrics
+}
+
+// replayGrade 复习记录的评分，早期只记录了复习结果的记录按结果推断评分
+func replayGrade(review *entity.MemoryReview) entity.ReviewGrade {
+	if review.Grade != entity.ReviewGradeUnspecified {
+		return review.Grade
+	}
+	return review.Result.Grade()
+}
+
+// intervalRecall 假设调度间隔到期时的回忆概率为 replayIntervalRecall，按指数遗忘曲线估算回忆概率
+func intervalRecall(elapsed, interval time.Duration) float64 {
+	if interval <= 0 {
+		return 0
+	}
+	return math.Pow(replayIntervalRecall, float64(elapsed)/float64(interval))
+}
+
+// schedulerReplayModel 基于调度器的回放模型
+type schedulerReplayModel struct {
+	scheduler  Scheduler
+	unit       *entity.MemoryUnit
+	reviewedAt time.Time
+	interval   time.Duration
+}
+
+// SchedulerReplay 用调度器回放复习历史
+// 调度器能估算可提取概率（如 FSRS）时直接使用，否则按调度间隔估算回忆概率
+func SchedulerReplay(scheduler Scheduler) ReplayModelFactory {
+	return func() ReplayModel {
+		return &schedulerReplayModel{
+			scheduler:  scheduler,
+			unit:       entity.NewMemoryUnit(0, entity.MemoryUnitTypeUnspecified, 0),
+			reviewedAt: time.Unix(0, 0),
+		}
+	}
+}
+
+// Predict 预测回忆概率
+func (m *schedulerReplayModel) Predict(elapsed time.Duration) float64 {
+	if estimator, ok := m.scheduler.(RetrievabilityEstimator); ok {
+		return estimator.Retrievability(m.unit, elapsed)
+	}
+	return intervalRecall(elapsed, m.interval)
+}
+
+// Review 更新复习统计并由调度器计算下次复习间隔
+func (m *schedulerReplayModel) Review(grade entity.ReviewGrade, elapsed time.Duration) time.Duration {
+	m.reviewedAt = m.reviewedAt.Add(elapsed)
+	m.unit.UpdateReviewStats(grade, 0, m.reviewedAt)
+	m.interval = m.scheduler.Schedule(m.unit, grade, elapsed)
+	return m.interval
+}
+
+// sm2 算法参数
+const (
+	sm2InitialEase = 2.5
+	sm2MinimumEase = 1.3
+)
+
+// sm2ReplayModel SuperMemo-2 算法的回放模型，仅用于与现有调度算法比较
+type sm2ReplayModel struct {
+	ease        float64
+	repetitions int
+	interval    time.Duration
+}
+
+// SM2Replay 用 SuperMemo-2 算法回放复习历史
+func SM2Replay() ReplayModelFactory {
+	return func() ReplayModel {
+		return &sm2ReplayModel{ease: sm2InitialEase}
+	}
+}
+
+// Predict 按调度间隔估算回忆概率
+func (m *sm2ReplayModel) Predict(elapsed time.Duration) float64 {
+	return intervalRecall(elapsed, m.interval)
+}
+
+// Review 按 SM-2 规则更新易度因子和间隔
+// 四级评分映射为 SM-2 的回答质量：忘记 2、困难 3、良好 4、简单 5
+func (m *sm2ReplayModel) Review(grade entity.ReviewGrade, elapsed time.Duration) time.Duration {
+	quality := float64(grade) + 1
+	if quality < 3 {
+		m.repetitions = 0
+		m.interval = 24 * time.Hour
+		return m.interval
+	}
+
+	switch m.repetitions {
+	case 0:
+		m.interval = 24 * time.Hour
+	case 1:
+		m.interval = 6 * 24 * time.Hour
+	default:
+		days := math.Round(m.interval.Hours() / 24 * m.ease)
+		m.interval = time.Duration(days) * 24 * time.Hour
+	}
+	m.repetitions++
+	m.ease = math.Max(m.ease+0.1-(5-quality)*(0.08+(5-quality)*0.02), sm2MinimumEase)
+	return m.interval
+}
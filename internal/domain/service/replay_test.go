@@ -0,0 +1,131 @@
+package service
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/lazyjean/sla2/internal/domain/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newReplayReview 创建用于回放的复习记录
+func newReplayReview(unitID uint32, grade entity.ReviewGrade, reviewTime time.Time) *entity.MemoryReview {
+	review := entity.NewMemoryReview(unitID, 1, grade, 1000, 0, 0)
+	review.ReviewTime = reviewTime
+	return review
+}
+
+func TestGroupReviewHistories(t *testing.T) {
+	start := time.Date(2025, 1, 1, 8, 0, 0, 0, time.UTC)
+	legacy := newReplayReview(2, entity.ReviewGradeUnspecified, start)
+	legacy.Result = entity.ReviewResultWrong
+
+	histories := GroupReviewHistories([]*entity.MemoryReview{
+		newReplayReview(2, entity.ReviewGradeGood, start.Add(48*time.Hour)),
+		newReplayReview(1, entity.ReviewGradeGood, start),
+		newReplayReview(2, entity.ReviewGradeSkip, start.Add(24*time.Hour)),
+		legacy,
+	})
+
+	require.Len(t, histories, 2)
+	assert.Len(t, histories[0], 1)
+	// 跳过的复习不参与回放，只记录了结果的复习按结果推断评分
+	require.Len(t, histories[1], 2)
+	assert.Same(t, legacy, histories[1][0])
+	assert.Equal(t, entity.ReviewGradeAgain, replayGrade(histories[1][0]))
+}
+
+func TestSM2Replay(t *testing.T) {
+	model := SM2Replay()()
+
+	assert.Equal(t, 24*time.Hour, model.Review(entity.ReviewGradeGood, 0))
+	assert.Equal(t, 6*24*time.Hour, model.Review(entity.ReviewGradeGood, 24*time.Hour))
+	assert.Equal(t, 15*24*time.Hour, model.Review(entity.ReviewGradeGood, 6*24*time.Hour))
+	assert.InDelta(t, 0.9, model.Predict(15*24*time.Hour), 1e-9)
+	// 忘记后重新开始
+	assert.Equal(t, 24*time.Hour, model.Review(entity.ReviewGradeAgain, 15*24*time.Hour))
+}
+
+func TestReplay(t *testing.T) {
+	start := time.Date(2025, 1, 1, 8, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+	histories := [][]*entity.MemoryReview{{
+		newReplayReview(1, entity.ReviewGradeGood, start),
+		newReplayReview(1, entity.ReviewGradeGood, start.Add(3*day)),
+		newReplayReview(1, entity.ReviewGradeAgain, start.Add(10*day)),
+		newReplayReview(1, entity.ReviewGradeGood, start.Add(10*day+10*time.Minute)),
+	}}
+
+	metrics := Replay(histories, SM2Replay())
+
+	assert.Equal(t, 4, metrics.Reviews)
+	// 当天内的重学不参与评估
+	assert.Equal(t, 2, metrics.Predictions)
+	assert.Greater(t, metrics.LogLoss(), 0.0)
+	assert.Greater(t, metrics.RMSE(), 0.0)
+	// 遗忘后重学，SM-2 重新从1天间隔开始
+	assert.InDelta(t, 1, metrics.Workload, 1e-9)
+
+	var total ReplayMetrics
+	total.Add(metrics)
+	total.Add(metrics)
+	assert.Equal(t, 4, total.Predictions)
+	assert.InDelta(t, metrics.LogLoss(), total.LogLoss(), 1e-9)
+
+	// FSRS 直接使用可提取概率，经验阶梯算法按调度间隔估算
+	assert.Equal(t, 2, Replay(histories, SchedulerReplay(NewFSRSScheduler(SchedulerOptions{}))).Predictions)
+	assert.Equal(t, 2, Replay(histories, SchedulerReplay(NewHeuristicScheduler())).Predictions)
+}
+
+// simulateFSRSHistories 按给定的 FSRS 权重模拟复习历史，复习时间在调度间隔附近随机浮动
+func simulateFSRSHistories(weights []float64, units, reviews int) [][]*entity.MemoryReview {
+	rng := rand.New(rand.NewSource(1))
+	truth := NewFSRSScheduler(SchedulerOptions{Weights: weights})
+	start := time.Date(2025, 1, 1, 8, 0, 0, 0, time.UTC)
+
+	histories := make([][]*entity.MemoryReview, units)
+	for i := range histories {
+		unit := entity.NewMemoryUnit(1, entity.MemoryUnitTypeWord, uint32(i))
+		reviewedAt, interval := start, time.Duration(0)
+		for j := 0; j < reviews; j++ {
+			grade := entity.ReviewGradeGood
+			if j > 0 {
+				elapsed := time.Duration(float64(interval) * (0.5 + rng.Float64()*2))
+				reviewedAt = reviewedAt.Add(elapsed)
+				if rng.Float64() > truth.Retrievability(unit, elapsed) {
+					grade = entity.ReviewGradeAgain
+				}
+				interval = truth.Schedule(unit, grade, elapsed)
+			} else {
+				interval = truth.Schedule(unit, grade, 0)
+			}
+			if interval < 24*time.Hour {
+				interval = 24 * time.Hour
+			}
+			histories[i] = append(histories[i], newReplayReview(uint32(i), grade, reviewedAt))
+		}
+	}
+	return histories
+}
+
+func TestFitFSRSWeights(t *testing.T) {
+	truth := make([]float64, len(DefaultFSRSWeights))
+	copy(truth, DefaultFSRSWeights)
+	truth[2] = 12 // 初始稳定性远高于默认权重
+	histories := simulateFSRSHistories(truth, 200, 6)
+
+	defaults := Replay(histories, SchedulerReplay(NewFSRSScheduler(SchedulerOptions{})))
+	weights, fitted, err := FitFSRSWeights(histories, SchedulerOptions{}, 5)
+	require.NoError(t, err)
+	require.Len(t, weights, len(DefaultFSRSWeights))
+	assert.Less(t, fitted.LogLoss(), defaults.LogLoss())
+	assert.Greater(t, weights[2], DefaultFSRSWeights[2])
+	for i, w := range weights {
+		assert.Equal(t, clampFSRSWeight(i, w), w)
+	}
+
+	_, _, err = FitFSRSWeights(histories[:5], SchedulerOptions{}, 5)
+	assert.ErrorIs(t, err, ErrInsufficientReviewHistory)
+}
@@ -0,0 +1,28 @@
+package service
+
+import (
+	"sort"
+	"time"
+
+	"github.com/lazyjean/sla2/internal/domain/entity"
+)
+
+// SpreadReviews 将记忆单元的复习均匀分散到从 start 开始的 days 个学习日
+// 按原定复习时间排序后依次分配，逾期最久的最先复习；分到第一个学习日的记忆单元保持原定时间，
+// 之后各学习日的记忆单元安排在该学习日开始时。start 为第一个学习日的开始时间（用户时区）
+func SpreadReviews(units []*entity.MemoryUnit, start time.Time, days int) {
+	if len(units) == 0 || days <= 0 {
+		return
+	}
+
+	sorted := make([]*entity.MemoryUnit, len(units))
+	copy(sorted, units)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].NextReviewAt.Before(sorted[j].NextReviewAt)
+	})
+	for i, unit := range sorted {
+		if day := i * days / len(sorted); day > 0 {
+			unit.Reschedule(start.AddDate(0, 0, day))
+		}
+	}
+}
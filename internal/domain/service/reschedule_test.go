@@ -0,0 +1,39 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lazyjean/sla2/internal/domain/entity"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSpreadReviews(t *testing.T) {
+	start := time.Date(2025, 3, 1, 4, 0, 0, 0, time.UTC)
+	units := make([]*entity.MemoryUnit, 7)
+	for i := range units {
+		units[i] = entity.NewMemoryUnit(1, entity.MemoryUnitTypeWord, uint32(i))
+		// 逆序逾期，最后一个逾期最久
+		units[i].NextReviewAt = start.Add(-time.Duration(i+1) * time.Hour)
+	}
+	original := units[6].NextReviewAt
+
+	SpreadReviews(units, start, 3)
+
+	perDay := make(map[time.Time]int)
+	for _, unit := range units {
+		if unit.NextReviewAt.After(start) {
+			perDay[unit.NextReviewAt]++
+		} else {
+			perDay[start]++
+		}
+	}
+	assert.Equal(t, map[time.Time]int{start: 3, start.AddDate(0, 0, 1): 2, start.AddDate(0, 0, 2): 2}, perDay)
+	// 逾期最久的保持原定时间，最先复习
+	assert.Equal(t, original, units[6].NextReviewAt)
+	assert.Equal(t, start.AddDate(0, 0, 2), units[0].NextReviewAt)
+
+	// 天数无效时不调整
+	SpreadReviews(units, start, 0)
+	assert.Equal(t, original, units[6].NextReviewAt)
+}
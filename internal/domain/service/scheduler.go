@@ -0,0 +1,161 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lazyjean/sla2/internal/domain/entity"
+	domainErrors "github.com/lazyjean/sla2/internal/domain/errors"
+	"github.com/lazyjean/sla2/internal/domain/repository"
+)
+
+// SchedulerName 复习调度算法名称
+type SchedulerName string
+
+const (
+	SchedulerHeuristic SchedulerName = "heuristic" // 经验阶梯算法
+	SchedulerFSRS      SchedulerName = "fsrs"      // FSRS 算法
+)
+
+// ErrUnknownScheduler 未知的复习调度算法
+var ErrUnknownScheduler = errors.New("unknown review scheduler")
+
+// Scheduler 复习调度器
+// 根据一次复习的结果更新记忆单元的调度状态，并给出距离下次复习的间隔
+type Scheduler interface {
+	// Name 调度算法名称
+	Name() SchedulerName
+	// Schedule 计算下次复习间隔
+	// unit 已通过 UpdateReviewStats 更新复习统计，grade 为本次复习评分（不含跳过），
+	// elapsed 为本次复习距离上次复习的时长
+	Schedule(unit *entity.MemoryUnit, grade entity.ReviewGrade, elapsed time.Duration) time.Duration
+}
+
+// SchedulerOptions 调度器参数
+type SchedulerOptions struct {
+	DesiredRetention float64   // 期望记忆保持率（0-1），FSRS 使用
+	MaximumInterval  uint32    // 最大复习间隔（天），FSRS 使用
+	Weights          []float64 // FSRS 模型权重，为空时使用默认权重
+}
+
+// NewScheduler 根据名称创建调度器
+func NewScheduler(name SchedulerName, opts SchedulerOptions) (Scheduler, error) {
+	switch name {
+	case SchedulerHeuristic:
+		return NewHeuristicScheduler(), nil
+	case SchedulerFSRS:
+		return NewFSRSScheduler(opts), nil
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnknownScheduler, name)
+	}
+}
+
+// IsValidSchedulerName 检查调度算法名称是否有效
+func IsValidSchedulerName(name string) bool {
+	switch SchedulerName(name) {
+	case SchedulerHeuristic, SchedulerFSRS:
+		return true
+	default:
+		return false
+	}
+}
+
+// SchedulerProvider 复习调度器提供者
+// 优先使用用户自选的调度算法，用户未设置时使用部署配置的默认算法；
+// 用户在学习偏好中设置了期望记忆保持率、最大复习间隔或拟合了模型权重时，按用户参数创建调度器
+type SchedulerProvider struct {
+	userRepo         repository.UserRepository
+	settingsRepo     repository.UserSettingsRepository
+	opts             SchedulerOptions
+	defaultScheduler Scheduler
+	schedulers       map[SchedulerName]Scheduler
+}
+
+// NewSchedulerProvider 创建复习调度器提供者
+func NewSchedulerProvider(userRepo repository.UserRepository, settingsRepo repository.UserSettingsRepository, defaultName SchedulerName, opts SchedulerOptions) (*SchedulerProvider, error) {
+	if defaultName == "" {
+		defaultName = SchedulerHeuristic
+	}
+
+	schedulers := make(map[SchedulerName]Scheduler)
+	for _, name := range []SchedulerName{SchedulerHeuristic, SchedulerFSRS} {
+		scheduler, err := NewScheduler(name, opts)
+		if err != nil {
+			return nil, err
+		}
+		schedulers[name] = scheduler
+	}
+
+	defaultScheduler, ok := schedulers[defaultName]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownScheduler, defaultName)
+	}
+
+	return &SchedulerProvider{
+		userRepo:         userRepo,
+		settingsRepo:     settingsRepo,
+		opts:             opts,
+		defaultScheduler: defaultScheduler,
+		schedulers:       schedulers,
+	}, nil
+}
+
+// Default 获取部署默认的调度器
+func (p *SchedulerProvider) Default() Scheduler {
+	return p.defaultScheduler
+}
+
+// ForUser 获取指定用户使用的调度器
+func (p *SchedulerProvider) ForUser(ctx context.Context, userID entity.UID) (Scheduler, error) {
+	scheduler, err := p.userScheduler(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.settingsRepo == nil || scheduler.Name() != SchedulerFSRS {
+		return scheduler, nil
+	}
+
+	settings, err := p.settingsRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if settings.DesiredRetention == 0 && settings.MaximumInterval == 0 && len(settings.SchedulerWeights) == 0 {
+		return scheduler, nil
+	}
+
+	opts := p.opts
+	if settings.DesiredRetention != 0 {
+		opts.DesiredRetention = settings.DesiredRetention
+	}
+	if settings.MaximumInterval != 0 {
+		opts.MaximumInterval = settings.MaximumInterval
+	}
+	if len(settings.SchedulerWeights) == len(DefaultFSRSWeights) {
+		opts.Weights = settings.SchedulerWeights
+	}
+	return NewScheduler(scheduler.Name(), opts)
+}
+
+// userScheduler 获取用户选择的调度器，用户未选择时返回默认调度器
+func (p *SchedulerProvider) userScheduler(ctx context.Context, userID entity.UID) (Scheduler, error) {
+	if p.userRepo == nil {
+		return p.defaultScheduler, nil
+	}
+
+	user, err := p.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		var domainErr *domainErrors.Error
+		if errors.As(err, &domainErr) && domainErr.Code == domainErrors.CodeUserNotFound {
+			return p.defaultScheduler, nil
+		}
+		return nil, err
+	}
+
+	if scheduler, ok := p.schedulers[SchedulerName(user.Scheduler)]; ok {
+		return scheduler, nil
+	}
+	return p.defaultScheduler, nil
+}
@@ -0,0 +1,192 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lazyjean/sla2/internal/domain/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewScheduler(t *testing.T) {
+	heuristic, err := NewScheduler(SchedulerHeuristic, SchedulerOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, SchedulerHeuristic, heuristic.Name())
+
+	fsrs, err := NewScheduler(SchedulerFSRS, SchedulerOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, SchedulerFSRS, fsrs.Name())
+
+	_, err = NewScheduler("sm2", SchedulerOptions{})
+	assert.ErrorIs(t, err, ErrUnknownScheduler)
+}
+
+func TestHeuristicScheduler_Schedule(t *testing.T) {
+	tests := []struct {
+		name               string
+		masteryLevel       entity.MasteryLevel
+		consecutiveCorrect uint32
+		consecutiveWrong   uint32
+		expected           time.Duration
+	}{
+		{"未学习", entity.MasteryLevelUnlearned, 0, 1, 30 * time.Minute},
+		{"初学", entity.MasteryLevelBeginner, 1, 0, 288 * time.Minute},
+		{"熟悉", entity.MasteryLevelFamiliar, 5, 0, 144 * time.Hour},
+		{"掌握", entity.MasteryLevelMastered, 5, 0, 432 * time.Hour},
+		{"精通", entity.MasteryLevelExpert, 10, 0, 2016 * time.Hour},
+	}
+
+	scheduler := NewHeuristicScheduler()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			unit := entity.NewMemoryUnit(1, entity.MemoryUnitTypeWord, 100)
+			unit.MasteryLevel = tt.masteryLevel
+			unit.ConsecutiveCorrect = tt.consecutiveCorrect
+			unit.ConsecutiveWrong = tt.consecutiveWrong
+
+			interval := scheduler.Schedule(unit, entity.ReviewGradeGood, 0)
+
+			assert.Equal(t, tt.expected, interval)
+		})
+	}
+}
+
+func TestFSRSScheduler_Schedule(t *testing.T) {
+	scheduler := NewFSRSScheduler(SchedulerOptions{})
+	unit := entity.NewMemoryUnit(1, entity.MemoryUnitTypeWord, 100)
+
+	// 首次复习初始化稳定性和难度
+	interval := scheduler.Schedule(unit, entity.ReviewGradeGood, 0)
+	assert.InDelta(t, DefaultFSRSWeights[2], unit.Stability, 1e-9)
+	assert.InDelta(t, DefaultFSRSWeights[4], unit.Difficulty, 1e-9)
+	assert.Equal(t, 3*24*time.Hour, interval)
+
+	// 按期复习成功后稳定性和间隔增长
+	stability := unit.Stability
+	next := scheduler.Schedule(unit, entity.ReviewGradeGood, interval)
+	assert.Greater(t, unit.Stability, stability)
+	assert.Greater(t, next, interval)
+	assert.InDelta(t, 0.9, unit.Retrievability, 0.01)
+
+	// 遗忘后稳定性下降、难度上升，进入短期重学
+	stability = unit.Stability
+	difficulty := unit.Difficulty
+	relearn := scheduler.Schedule(unit, entity.ReviewGradeAgain, next)
+	assert.Less(t, unit.Stability, stability)
+	assert.Greater(t, unit.Difficulty, difficulty)
+	assert.Equal(t, fsrsRelearnStep, relearn)
+}
+
+func TestFSRSScheduler_Grades(t *testing.T) {
+	scheduler := NewFSRSScheduler(SchedulerOptions{})
+
+	intervals := make(map[entity.ReviewGrade]time.Duration)
+	difficulties := make(map[entity.ReviewGrade]float64)
+	for _, grade := range []entity.ReviewGrade{entity.ReviewGradeHard, entity.ReviewGradeGood, entity.ReviewGradeEasy} {
+		unit := entity.NewMemoryUnit(1, entity.MemoryUnitTypeWord, 100)
+		unit.Stability = 10
+		unit.Difficulty = 5
+
+		intervals[grade] = scheduler.Schedule(unit, grade, 10*24*time.Hour)
+		difficulties[grade] = unit.Difficulty
+	}
+
+	// 评分越高，间隔越长、难度越低
+	assert.Less(t, intervals[entity.ReviewGradeHard], intervals[entity.ReviewGradeGood])
+	assert.Less(t, intervals[entity.ReviewGradeGood], intervals[entity.ReviewGradeEasy])
+	assert.Greater(t, difficulties[entity.ReviewGradeHard], difficulties[entity.ReviewGradeGood])
+	assert.Greater(t, difficulties[entity.ReviewGradeGood], difficulties[entity.ReviewGradeEasy])
+}
+
+func TestFSRSScheduler_MaximumInterval(t *testing.T) {
+	scheduler := NewFSRSScheduler(SchedulerOptions{MaximumInterval: 30})
+	unit := entity.NewMemoryUnit(1, entity.MemoryUnitTypeWord, 100)
+	unit.Stability = 365
+	unit.Difficulty = 5
+
+	interval := scheduler.Schedule(unit, entity.ReviewGradeGood, 365*24*time.Hour)
+
+	assert.Equal(t, 30*24*time.Hour, interval)
+}
+
+func TestFSRSScheduler_DesiredRetention(t *testing.T) {
+	relaxed := NewFSRSScheduler(SchedulerOptions{DesiredRetention: 0.8})
+	strict := NewFSRSScheduler(SchedulerOptions{DesiredRetention: 0.95})
+
+	assert.Greater(t, relaxed.nextInterval(10), strict.nextInterval(10))
+	// 期望保持率为 90% 时间隔约等于稳定性
+	assert.Equal(t, 10*24*time.Hour, NewFSRSScheduler(SchedulerOptions{}).nextInterval(10))
+}
+
+func TestSchedulerProvider_ForUser(t *testing.T) {
+	provider, err := NewSchedulerProvider(nil, nil, SchedulerFSRS, SchedulerOptions{})
+	require.NoError(t, err)
+
+	scheduler, err := provider.ForUser(context.Background(), 1)
+	require.NoError(t, err)
+	assert.Equal(t, SchedulerFSRS, scheduler.Name())
+	assert.Equal(t, SchedulerFSRS, provider.Default().Name())
+
+	_, err = NewSchedulerProvider(nil, nil, "unknown", SchedulerOptions{})
+	assert.ErrorIs(t, err, ErrUnknownScheduler)
+}
+
+type stubUserSettingsRepository struct {
+	settings *entity.UserSettings
+}
+
+func (r *stubUserSettingsRepository) GetByUserID(ctx context.Context, userID entity.UID) (*entity.UserSettings, error) {
+	return r.settings, nil
+}
+
+func (r *stubUserSettingsRepository) Save(ctx context.Context, settings *entity.UserSettings) error {
+	r.settings = settings
+	return nil
+}
+
+func TestSchedulerProvider_UserSettings(t *testing.T) {
+	settings := entity.NewUserSettings(1)
+	provider, err := NewSchedulerProvider(nil, &stubUserSettingsRepository{settings: settings}, SchedulerFSRS, SchedulerOptions{})
+	require.NoError(t, err)
+
+	// 未设置调度参数时使用默认调度器
+	scheduler, err := provider.ForUser(context.Background(), 1)
+	require.NoError(t, err)
+	assert.Same(t, provider.Default(), scheduler)
+
+	// 按用户的最大复习间隔调度
+	settings.MaximumInterval = 30
+	scheduler, err = provider.ForUser(context.Background(), 1)
+	require.NoError(t, err)
+	unit := entity.NewMemoryUnit(1, entity.MemoryUnitTypeWord, 100)
+	unit.Stability = 365
+	unit.Difficulty = 5
+	assert.Equal(t, 30*24*time.Hour, scheduler.Schedule(unit, entity.ReviewGradeGood, 365*24*time.Hour))
+
+	// 按用户拟合的模型权重调度
+	settings.MaximumInterval = 0
+	settings.SchedulerWeights = make([]float64, len(DefaultFSRSWeights))
+	copy(settings.SchedulerWeights, DefaultFSRSWeights)
+	settings.SchedulerWeights[2] = 10
+	scheduler, err = provider.ForUser(context.Background(), 1)
+	require.NoError(t, err)
+	assert.Equal(t, 10*24*time.Hour, scheduler.Schedule(entity.NewMemoryUnit(1, entity.MemoryUnitTypeWord, 100), entity.ReviewGradeGood, 0))
+}
+
+func TestSimulateReviewOffsets(t *testing.T) {
+	scheduler := NewFSRSScheduler(SchedulerOptions{})
+
+	offsets := SimulateReviewOffsets(scheduler, 60*24*time.Hour)
+	require.NotEmpty(t, offsets)
+	// 首次学习后按初始稳定性安排第一次复习
+	assert.Equal(t, 3*24*time.Hour, offsets[0])
+	// 复习间隔逐渐拉长，且都在预测范围内
+	for i := 1; i < len(offsets); i++ {
+		assert.Greater(t, offsets[i]-offsets[i-1], offsets[0])
+		assert.Less(t, offsets[i], 60*24*time.Hour)
+	}
+
+	assert.Empty(t, SimulateReviewOffsets(scheduler, 24*time.Hour))
+}
@@ -0,0 +1,68 @@
+package service
+
+import (
+	"time"
+
+	"github.com/lazyjean/sla2/internal/domain/entity"
+)
+
+// CalculateStreak 根据每日统计计算连续学习天数
+// days 为按日期升序的每日统计，today 为今天的学习日开始时间，freezeDays 为一次连续学习中允许中断的天数；
+// 被断签保护跳过的学习日不计入连续学习天数，今天尚未学习也不会中断当前的连续学习
+func CalculateStreak(days []*entity.DailyStat, today time.Time, freezeDays uint32) *entity.StudyStreak {
+	streak := &entity.StudyStreak{}
+
+	var studied []int64
+	for _, day := range days {
+		if day.ReviewCount == 0 && day.NewLearned == 0 {
+			continue
+		}
+		if n := dayNumber(day.Date); len(studied) == 0 || n > studied[len(studied)-1] {
+			studied = append(studied, n)
+			streak.LastStudyDate = day.Date
+		}
+	}
+	if len(studied) == 0 {
+		return streak
+	}
+	freeze := int64(freezeDays)
+
+	// 最长连续学习：中断天数不超过断签保护天数的最长区间
+	var missed int64
+	for i, j := 0, 0; j < len(studied); j++ {
+		if j > 0 {
+			missed += studied[j] - studied[j-1] - 1
+		}
+		for missed > freeze {
+			missed -= studied[i+1] - studied[i] - 1
+			i++
+		}
+		streak.Longest = max(streak.Longest, uint32(j-i+1))
+	}
+
+	// 当前连续学习：从最近一次学习向前延伸，最近一次学习到今天之间的中断同样消耗断签保护
+	last, current := len(studied)-1, dayNumber(today)
+	streak.StudiedToday = studied[last] == current
+	missed = max(current-studied[last]-1, 0)
+	if missed > freeze {
+		return streak
+	}
+	i := last
+	for ; i > 0; i-- {
+		gap := studied[i] - studied[i-1] - 1
+		if missed+gap > freeze {
+			break
+		}
+		missed += gap
+	}
+	streak.Current = uint32(last - i + 1)
+	streak.FreezeDaysUsed = uint32(missed)
+	streak.Longest = max(streak.Longest, streak.Current)
+	return streak
+}
+
+// dayNumber 学习日在其所在时区的日历日序号，用于跨夏令时按天计算间隔
+func dayNumber(t time.Time) int64 {
+	year, month, day := t.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Unix() / 86400
+}
@@ -0,0 +1,55 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lazyjean/sla2/internal/domain/entity"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCalculateStreak(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("time zone data unavailable")
+	}
+	// 跨越夏令时结束（11月2日）的学习日
+	today := time.Date(2025, 11, 5, 4, 0, 0, 0, loc)
+	studied := func(offsets ...int) []*entity.DailyStat {
+		days := make([]*entity.DailyStat, len(offsets))
+		for i, offset := range offsets {
+			days[i] = &entity.DailyStat{Date: today.AddDate(0, 0, offset), ReviewCount: 1}
+		}
+		return days
+	}
+
+	tests := []struct {
+		name       string
+		days       []*entity.DailyStat
+		freezeDays uint32
+		expected   entity.StudyStreak
+	}{
+		{"从未学习", nil, 0, entity.StudyStreak{}},
+		{"今天已学习", studied(-2, -1, 0), 0, entity.StudyStreak{Current: 3, Longest: 3, StudiedToday: true}},
+		{"今天尚未学习", studied(-3, -2, -1), 0, entity.StudyStreak{Current: 3, Longest: 3}},
+		{"昨天中断", studied(-5, -4, -3, -2), 0, entity.StudyStreak{Current: 0, Longest: 4}},
+		{"断签保护昨天的中断", studied(-5, -4, -3, -2), 1, entity.StudyStreak{Current: 4, Longest: 4, FreezeDaysUsed: 1}},
+		{"断签保护用完", studied(-9, -8, -6, -4, -3, 0), 1, entity.StudyStreak{Current: 1, Longest: 3, StudiedToday: true}},
+		{"跨越中断的最长连续学习", studied(-9, -8, -6, -4, -3, 0), 2, entity.StudyStreak{Current: 3, Longest: 5, StudiedToday: true, FreezeDaysUsed: 2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			streak := CalculateStreak(tt.days, today, tt.freezeDays)
+
+			if len(tt.days) > 0 {
+				tt.expected.LastStudyDate = tt.days[len(tt.days)-1].Date
+			}
+			assert.Equal(t, tt.expected, *streak)
+		})
+	}
+
+	// 没有复习和新学习记录的学习日不计入
+	streak := CalculateStreak([]*entity.DailyStat{{Date: today}}, today, 0)
+	assert.Zero(t, streak.Current)
+}
@@ -0,0 +1,130 @@
+package service
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/lazyjean/sla2/internal/domain/entity"
+)
+
+// ExampleInflection 例句中出现的单词原形或词形
+type ExampleInflection struct {
+	Example   string              // 例句
+	Text      string              // 例句中出现的原文，保留大小写
+	Kind      entity.WordFormKind // 变化类型，原形或未区分类型的词形为未指定
+	Inflected bool                // 是否为屈折变化形式，false 表示原形
+	Start     int                 // 在例句中的起始位置（字符）
+	End       int                 // 在例句中的结束位置（字符，不含）
+}
+
+// inflectionCandidate 待在例句中查找的原形或词形
+type inflectionCandidate struct {
+	tokens    []string
+	kind      entity.WordFormKind
+	inflected bool
+}
+
+// exampleToken 例句中的一个词
+type exampleToken struct {
+	text       string // 小写
+	start, end int
+}
+
+// FindExampleInflections 找出单词的例句中出现的原形和词形，按例句和位置排序
+// 按整词匹配且不区分大小写，多个词的词形（如 passers-by）需逐词相连；
+// 同一位置能匹配多个候选时取最长的，相同长度时原形优先
+func FindExampleInflections(word *entity.Word, forms []*entity.WordForm) []ExampleInflection {
+	candidates := make([]inflectionCandidate, 0, len(forms)+1)
+	if tokens := tokenizeWords(word.Text); len(tokens) > 0 {
+		candidates = append(candidates, inflectionCandidate{tokens: tokenTexts(tokens)})
+	}
+	for _, form := range forms {
+		if tokens := tokenizeWords(form.Form); len(tokens) > 0 {
+			candidates = append(candidates, inflectionCandidate{tokens: tokenTexts(tokens), kind: form.Kind, inflected: true})
+		}
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	var inflections []ExampleInflection
+	for _, example := range wordExamples(word) {
+		runes := []rune(example)
+		tokens := tokenizeWords(example)
+		for i := 0; i < len(tokens); {
+			best, ok := matchCandidate(tokens, i, candidates)
+			if !ok {
+				i++
+				continue
+			}
+			start, end := tokens[i].start, tokens[i+len(best.tokens)-1].end
+			inflections = append(inflections, ExampleInflection{
+				Example:   example,
+				Text:      string(runes[start:end]),
+				Kind:      best.kind,
+				Inflected: best.inflected,
+				Start:     start,
+				End:       end,
+			})
+			i += len(best.tokens)
+		}
+	}
+	return inflections
+}
+
+// matchCandidate 找出从第 i 个词开始匹配的最长候选
+func matchCandidate(tokens []exampleToken, i int, candidates []inflectionCandidate) (inflectionCandidate, bool) {
+	var best inflectionCandidate
+	found := false
+	for _, candidate := range candidates {
+		if i+len(candidate.tokens) > len(tokens) || (found && len(candidate.tokens) <= len(best.tokens)) {
+			continue
+		}
+		matched := true
+		for j, token := range candidate.tokens {
+			if tokens[i+j].text != token {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			best, found = candidate, true
+		}
+	}
+	return best, found
+}
+
+// tokenizeWords 将文本拆分为小写的词及其字符位置
+func tokenizeWords(text string) []exampleToken {
+	var tokens []exampleToken
+	start := -1
+	var b strings.Builder
+	runes := []rune(text)
+	for i, r := range runes {
+		if isWordRune(r) {
+			if start < 0 {
+				start = i
+				b.Reset()
+			}
+			b.WriteRune(unicode.ToLower(r))
+			continue
+		}
+		if start >= 0 {
+			tokens = append(tokens, exampleToken{text: b.String(), start: start, end: i})
+			start = -1
+		}
+	}
+	if start >= 0 {
+		tokens = append(tokens, exampleToken{text: b.String(), start: start, end: len(runes)})
+	}
+	return tokens
+}
+
+// tokenTexts 词的文本
+func tokenTexts(tokens []exampleToken) []string {
+	texts := make([]string, len(tokens))
+	for i, token := range tokens {
+		texts[i] = token.text
+	}
+	return texts
+}
@@ -0,0 +1,50 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/lazyjean/sla2/internal/domain/entity"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindExampleInflections(t *testing.T) {
+	word := &entity.Word{
+		Text: "passer-by",
+		Definitions: []entity.Definition{
+			{Meaning: "过路人", Example: "A passer-by called the police."},
+		},
+		Examples: []string{
+			"Several Passers-by stopped to help.",
+			"A passer-by called the police.",
+			"Nobody noticed.",
+		},
+	}
+	forms := []*entity.WordForm{{Form: "passers-by", Kind: entity.WordFormKindPlural}}
+
+	assert.Equal(t, []ExampleInflection{
+		{Example: "A passer-by called the police.", Text: "passer-by", Start: 2, End: 11},
+		{
+			Example:   "Several Passers-by stopped to help.",
+			Text:      "Passers-by",
+			Kind:      entity.WordFormKindPlural,
+			Inflected: true,
+			Start:     8,
+			End:       18,
+		},
+	}, FindExampleInflections(word, forms))
+
+	t.Run("按整词匹配", func(t *testing.T) {
+		word := &entity.Word{Text: "run", Examples: []string{"The runner runs; he ran and is running."}}
+		forms := []*entity.WordForm{
+			{Form: "runs", Kind: entity.WordFormKindThirdPersonSingular},
+			{Form: "ran", Kind: entity.WordFormKindPast},
+			{Form: "running", Kind: entity.WordFormKindPresentParticiple},
+		}
+		inflections := FindExampleInflections(word, forms)
+		texts := make([]string, len(inflections))
+		for i, inflection := range inflections {
+			texts[i] = inflection.Text
+		}
+		assert.Equal(t, []string{"runs", "ran", "running"}, texts)
+	})
+}
@@ -0,0 +1,201 @@
+package service
+
+import (
+	"html"
+	"strings"
+	"unicode"
+
+	"github.com/lazyjean/sla2/internal/domain/entity"
+)
+
+const (
+	// HighlightStart 高亮片段的开始标记
+	HighlightStart = "<em>"
+	// HighlightEnd 高亮片段的结束标记
+	HighlightEnd = "</em>"
+	// highlightContext 片段中第一处匹配前后保留的字符数
+	highlightContext = 20
+	// maxExampleHighlights 每个单词最多返回的例句片段数
+	maxExampleHighlights = 2
+)
+
+// 单词中可以高亮的字段
+const (
+	WordFieldText    = "text"    // 单词
+	WordFieldMeaning = "meaning" // 释义
+	WordFieldSynonym = "synonym" // 同义词
+	WordFieldExample = "example" // 例句
+)
+
+// WordHighlight 检索结果中与关键词匹配的片段
+type WordHighlight struct {
+	Field    string // 匹配的字段
+	Fragment string // 用高亮标记包围匹配部分的片段
+}
+
+// HighlightWord 找出单词各字段中与关键词匹配的片段
+// 关键词按词拆分，拉丁字母的词按词首前缀匹配并高亮整个词，其他文字（如中文）按子串匹配；
+// 只因拼写相近而命中的单词没有高亮片段
+func HighlightWord(word *entity.Word, keyword string) []WordHighlight {
+	terms := highlightTerms(keyword)
+	if len(terms) == 0 {
+		return nil
+	}
+
+	var highlights []WordHighlight
+	add := func(field, text string) bool {
+		fragment, ok := highlightText(text, terms)
+		if ok {
+			highlights = append(highlights, WordHighlight{Field: field, Fragment: fragment})
+		}
+		return ok
+	}
+
+	add(WordFieldText, word.Text)
+	for _, definition := range word.Definitions {
+		add(WordFieldMeaning, definition.Meaning)
+	}
+	for _, definition := range word.Definitions {
+		for _, synonym := range definition.Synonyms {
+			add(WordFieldSynonym, synonym)
+		}
+	}
+
+	count := 0
+	for _, example := range wordExamples(word) {
+		if count == maxExampleHighlights {
+			break
+		}
+		if add(WordFieldExample, example) {
+			count++
+		}
+	}
+	return highlights
+}
+
+// wordExamples 单词释义中的例句和单词的例句，去掉重复的例句
+func wordExamples(word *entity.Word) []string {
+	examples := make([]string, 0, len(word.Examples)+len(word.Definitions))
+	seen := make(map[string]struct{})
+	for _, definition := range word.Definitions {
+		examples = append(examples, definition.Example)
+	}
+	examples = append(examples, word.Examples...)
+
+	unique := examples[:0]
+	for _, example := range examples {
+		if _, ok := seen[example]; ok {
+			continue
+		}
+		seen[example] = struct{}{}
+		unique = append(unique, example)
+	}
+	return unique
+}
+
+// highlightSpan 一处匹配的字符区间
+type highlightSpan struct {
+	start, end int
+}
+
+// highlightText 用高亮标记包围文本中匹配的部分，文本较长时截取第一处匹配附近的片段
+// 片段作为 HTML 展示，标记之外的文本都经过转义，避免单词数据中的标签被当作 HTML
+func highlightText(text string, terms [][]rune) (string, bool) {
+	runes := []rune(text)
+	lower := make([]rune, len(runes))
+	for i, r := range runes {
+		lower[i] = unicode.ToLower(r)
+	}
+
+	var spans []highlightSpan
+	for i := 0; i < len(lower); {
+		span, ok := matchTerm(lower, i, terms)
+		if !ok {
+			i++
+			continue
+		}
+		spans = append(spans, span)
+		i = span.end
+	}
+	if len(spans) == 0 {
+		return "", false
+	}
+
+	start := spans[0].start - highlightContext
+	if start < 0 {
+		start = 0
+	}
+	end := spans[0].end + highlightContext
+	if end > len(runes) {
+		end = len(runes)
+	}
+
+	var b strings.Builder
+	if start > 0 {
+		b.WriteString("…")
+	}
+	pos := start
+	for _, span := range spans {
+		if span.start < start || span.end > end {
+			continue
+		}
+		b.WriteString(html.EscapeString(string(runes[pos:span.start])))
+		b.WriteString(HighlightStart)
+		b.WriteString(html.EscapeString(string(runes[span.start:span.end])))
+		b.WriteString(HighlightEnd)
+		pos = span.end
+	}
+	b.WriteString(html.EscapeString(string(runes[pos:end])))
+	if end < len(runes) {
+		b.WriteString("…")
+	}
+	return b.String(), true
+}
+
+// matchTerm 判断从第 i 个字符开始是否匹配某个词，拉丁字母的词只在词首匹配并延伸到词尾
+func matchTerm(lower []rune, i int, terms [][]rune) (highlightSpan, bool) {
+	for _, term := range terms {
+		if i+len(term) > len(lower) || string(lower[i:i+len(term)]) != string(term) {
+			continue
+		}
+		if !isLatinTerm(term) {
+			return highlightSpan{start: i, end: i + len(term)}, true
+		}
+		if i > 0 && isWordRune(lower[i-1]) {
+			continue
+		}
+		end := i + len(term)
+		for end < len(lower) && isWordRune(lower[end]) {
+			end++
+		}
+		return highlightSpan{start: i, end: end}, true
+	}
+	return highlightSpan{}, false
+}
+
+// highlightTerms 将关键词拆分为小写的词
+func highlightTerms(keyword string) [][]rune {
+	fields := strings.FieldsFunc(strings.ToLower(keyword), func(r rune) bool {
+		return !isWordRune(r)
+	})
+	terms := make([][]rune, len(fields))
+	for i, field := range fields {
+		terms[i] = []rune(field)
+	}
+	return terms
+}
+
+// isLatinTerm 判断词是否只由拉丁字母和数字组成
+func isLatinTerm(term []rune) bool {
+	for _, r := range term {
+		if !unicode.Is(unicode.Latin, r) && !unicode.IsDigit(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// isWordRune 判断字符是否属于词的一部分
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
@@ -0,0 +1,59 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/lazyjean/sla2/internal/domain/entity"
+	"github.com/stretchr/testify/assert"
+)
+
+func testSearchWord() *entity.Word {
+	return &entity.Word{
+		Text: "resilient",
+		Definitions: []entity.Definition{
+			{
+				Meaning:  "有弹性的；能复原的；适应力强的",
+				Example:  "Children are generally more resilient than adults, who need a long time to recover from hardship.",
+				Synonyms: []string{"flexible", "adaptable"},
+			},
+		},
+		Examples: []string{"The company proved resilient during the economic crisis."},
+	}
+}
+
+func TestHighlightWord(t *testing.T) {
+	t.Run("前缀匹配整个词", func(t *testing.T) {
+		highlights := HighlightWord(testSearchWord(), "Resil")
+		assert.Equal(t, []WordHighlight{
+			{Field: WordFieldText, Fragment: "<em>resilient</em>"},
+			{Field: WordFieldExample, Fragment: "… are generally more <em>resilient</em> than adults, who ne…"},
+			{Field: WordFieldExample, Fragment: "The company proved <em>resilient</em> during the economic…"},
+		}, highlights)
+	})
+
+	t.Run("只在词首匹配", func(t *testing.T) {
+		// “apt” 不匹配 adaptable 中间的部分
+		assert.Empty(t, HighlightWord(testSearchWord(), "apt"))
+		assert.Equal(t, []WordHighlight{{Field: WordFieldSynonym, Fragment: "<em>adaptable</em>"}}, HighlightWord(testSearchWord(), "adapt"))
+	})
+
+	t.Run("中文按子串匹配", func(t *testing.T) {
+		highlights := HighlightWord(testSearchWord(), "复原")
+		assert.Equal(t, []WordHighlight{{Field: WordFieldMeaning, Fragment: "有弹性的；能<em>复原</em>的；适应力强的"}}, highlights)
+	})
+
+	t.Run("多个词", func(t *testing.T) {
+		highlights := HighlightWord(testSearchWord(), "economic crisis")
+		assert.Equal(t, []WordHighlight{
+			{Field: WordFieldExample, Fragment: "…esilient during the <em>economic</em> <em>crisis</em>."},
+		}, highlights)
+	})
+
+	t.Run("转义片段中的HTML", func(t *testing.T) {
+		word := &entity.Word{Text: "bold", Definitions: []entity.Definition{{Meaning: "<b>粗体</b>的 & 醒目的"}}}
+		highlights := HighlightWord(word, "粗体")
+		assert.Equal(t, []WordHighlight{
+			{Field: WordFieldMeaning, Fragment: "&lt;b&gt;<em>粗体</em>&lt;/b&gt;的 &amp; 醒目的"},
+		}, highlights)
+	})
+}
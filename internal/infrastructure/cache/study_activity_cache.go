@@ -0,0 +1,55 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/lazyjean/sla2/internal/domain/entity"
+	"github.com/lazyjean/sla2/internal/domain/repository"
+)
+
+// studyActivityTTL 学习活动缓存时长，学习日切换后最多延迟这么久才会出现新的学习日
+const studyActivityTTL = time.Hour
+
+// studyActivityCache 基于通用缓存的学习活动缓存
+type studyActivityCache struct {
+	cache Cache
+}
+
+// NewStudyActivityCache 创建学习活动缓存
+func NewStudyActivityCache(cache Cache) repository.StudyActivityCache {
+	return &studyActivityCache{cache: cache}
+}
+
+// Get 获取用户的每日学习统计快照，缓存不可用或数据损坏时视为未命中
+func (c *studyActivityCache) Get(ctx context.Context, userID entity.UID) (*repository.StudyActivity, bool) {
+	cached, err := c.cache.Get(ctx, studyActivityKey(userID))
+	if err != nil {
+		return nil, false
+	}
+	var activity repository.StudyActivity
+	if err := json.Unmarshal([]byte(cached), &activity); err != nil {
+		return nil, false
+	}
+	return &activity, true
+}
+
+// Set 缓存用户的每日学习统计快照
+func (c *studyActivityCache) Set(ctx context.Context, userID entity.UID, activity *repository.StudyActivity) error {
+	data, err := json.Marshal(activity)
+	if err != nil {
+		return err
+	}
+	return c.cache.Set(ctx, studyActivityKey(userID), string(data), studyActivityTTL)
+}
+
+// Delete 清除用户的每日学习统计快照
+func (c *studyActivityCache) Delete(ctx context.Context, userID entity.UID) error {
+	return c.cache.Delete(ctx, studyActivityKey(userID))
+}
+
+func studyActivityKey(userID entity.UID) string {
+	return fmt.Sprintf("study_activity:%d", userID)
+}
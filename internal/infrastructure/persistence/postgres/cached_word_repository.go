@@ -62,6 +62,10 @@ func (r *CachedWordRepository) Search(ctx context.Context, keyword string, offse
 	return r.repo.Search(ctx, keyword, offset, limit, filters)
 }
 
+func (r *CachedWordRepository) FullTextSearch(ctx context.Context, query *repository.WordSearchQuery) ([]*repository.WordSearchHit, int64, error) {
+	return r.repo.FullTextSearch(ctx, query)
+}
+
 func (r *CachedWordRepository) GetAllTags(ctx context.Context) ([]string, error) {
 	return r.repo.GetAllTags(ctx)
 }
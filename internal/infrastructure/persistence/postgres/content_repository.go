@@ -0,0 +1,150 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lazyjean/sla2/internal/domain/entity"
+	"github.com/lazyjean/sla2/internal/domain/repository"
+	"github.com/lazyjean/sla2/internal/domain/valueobject"
+	"gorm.io/gorm"
+)
+
+// contentRepository 通用学习内容仓储实现
+// 各类学习内容表都包含 id、level 和 tags（jsonb）列，按模型和展示文本列统一查询
+type contentRepository struct {
+	db         *gorm.DB
+	unitType   entity.MemoryUnitType
+	model      interface{}
+	table      string
+	textColumn string
+}
+
+// contentRow 学习内容的ID和展示文本
+type contentRow struct {
+	ID   uint32
+	Text string
+}
+
+// NewContentRepositories 创建所有可学习内容类型的学习内容仓储
+func NewContentRepositories(db *gorm.DB) repository.ContentRepositories {
+	return repository.NewContentRepositories(
+		newContentRepository(db, entity.MemoryUnitTypeHanChar, &entity.HanChar{}, "han_chars", "character"),
+		newContentRepository(db, entity.MemoryUnitTypeWord, &entity.Word{}, "words", "text"),
+		newContentRepository(db, entity.MemoryUnitTypePhrase, &entity.Phrase{}, "phrases", "text"),
+		newContentRepository(db, entity.MemoryUnitTypeSentence, &entity.Sentence{}, "sentences", "text"),
+		newContentRepository(db, entity.MemoryUnitTypeGrammarPoint, &entity.GrammarPoint{}, "grammar_points", "title"),
+		newCustomWordContentRepository(db),
+	)
+}
+
+// newContentRepository 创建单个类型的学习内容仓储
+func newContentRepository(db *gorm.DB, unitType entity.MemoryUnitType, model interface{}, table, textColumn string) *contentRepository {
+	return &contentRepository{
+		db:         db,
+		unitType:   unitType,
+		model:      model,
+		table:      table,
+		textColumn: textColumn,
+	}
+}
+
+// UnitType 学习内容对应的记忆单元类型
+func (r *contentRepository) UnitType() entity.MemoryUnitType {
+	return r.unitType
+}
+
+// Exists 检查学习内容是否存在，内容对所有用户可见
+func (r *contentRepository) Exists(ctx context.Context, _ entity.UID, id uint32) (bool, error) {
+	var count int64
+	err := dbFromContext(ctx, r.db).Model(r.model).Where("id = ?", id).Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// GetTexts 获取学习内容的展示文本，内容对所有用户可见
+func (r *contentRepository) GetTexts(ctx context.Context, _ entity.UID, ids []uint32) (map[uint32]string, error) {
+	texts := make(map[uint32]string, len(ids))
+	if len(ids) == 0 {
+		return texts, nil
+	}
+
+	var rows []contentRow
+	err := dbFromContext(ctx, r.db).Model(r.model).
+		Select(r.selectColumns()).
+		Where(r.table+".id IN ?", ids).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		texts[row.ID] = row.Text
+	}
+	return texts, nil
+}
+
+// ListNotLearned 获取用户尚未学习（没有记忆单元）的内容
+func (r *contentRepository) ListNotLearned(ctx context.Context, userID entity.UID, filter repository.NewContentFilter, limit int) ([]*entity.StudyItem, error) {
+	query := r.filter(dbFromContext(ctx, r.db).Model(r.model), filter).
+		Select(r.selectColumns()).
+		Where(fmt.Sprintf("NOT EXISTS (SELECT 1 FROM memory_units mu WHERE mu.user_id = ? AND mu.type = ? AND mu.content_id = %s.id)", r.table),
+			userID, r.unitType)
+
+	var rows []contentRow
+	if err := query.Order(r.table + ".id ASC").Limit(limit).Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	items := make([]*entity.StudyItem, len(rows))
+	for i, row := range rows {
+		items[i] = &entity.StudyItem{Type: r.unitType, ContentID: row.ID, Text: row.Text}
+	}
+	return items, nil
+}
+
+// ListIDs 获取符合过滤条件的内容ID
+func (r *contentRepository) ListIDs(ctx context.Context, filter repository.NewContentFilter) ([]uint32, error) {
+	var ids []uint32
+	err := r.filter(dbFromContext(ctx, r.db).Model(r.model), filter).
+		Order(r.table+".id ASC").
+		Pluck(r.table+".id", &ids).Error
+	if err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// filter 按难度等级、标签、分类和生词本过滤，生词本中只有单词，只有汉字有分类
+func (r *contentRepository) filter(query *gorm.DB, filter repository.NewContentFilter) *gorm.DB {
+	if filter.NotebookID != 0 {
+		if r.unitType != entity.MemoryUnitTypeWord {
+			return query.Where("1 = 0")
+		}
+		query = query.Where("EXISTS (SELECT 1 FROM word_notebook_entries e WHERE e.notebook_id = ? AND e.word_id = words.id)", filter.NotebookID)
+	}
+	if filter.Level != valueobject.WORD_DIFFICULTY_LEVEL_UNSPECIFIED {
+		query = query.Where("level = ?", filter.Level)
+	}
+	if len(filter.Tags) > 0 {
+		query = query.Where("jsonb_exists_any(tags, ARRAY[?])", filter.Tags)
+	}
+	if len(filter.CourseTags) > 0 {
+		query = query.Where("jsonb_exists_any(tags, ARRAY[?])", filter.CourseTags)
+	}
+	if len(filter.Categories) > 0 {
+		if r.unitType != entity.MemoryUnitTypeHanChar {
+			return query.Where("1 = 0")
+		}
+		query = query.Where("jsonb_exists_any(categories, ARRAY[?])", filter.Categories)
+	}
+	return query
+}
+
+// selectColumns 查询ID和展示文本的列
+func (r *contentRepository) selectColumns() string {
+	return fmt.Sprintf("%s.id, %s.%s AS text", r.table, r.table, r.textColumn)
+}
+
+var _ repository.ContentRepository = (*contentRepository)(nil)
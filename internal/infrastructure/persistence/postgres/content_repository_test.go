@@ -0,0 +1,127 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lazyjean/sla2/internal/domain/entity"
+	"github.com/lazyjean/sla2/internal/domain/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContentRepository_ListNotLearned(t *testing.T) {
+	db, cleanup := SetupTestDB(t)
+	defer cleanup()
+
+	hanCharRepo := NewHanCharRepository(db)
+	memoryRepo := NewMemoryUnitRepository(db)
+	contents := NewContentRepositories(db)[entity.MemoryUnitTypeHanChar]
+	ctx := context.Background()
+
+	chars := []*entity.HanChar{
+		entity.NewHanChar("一", "yī", 1),
+		entity.NewHanChar("二", "èr", 1),
+		entity.NewHanChar("三", "sān", 2),
+	}
+	for _, char := range chars {
+		char.Tags, char.Categories, char.Examples = []string{}, []string{}, []string{}
+	}
+	chars[1].Tags = []string{"数字"}
+	chars[2].Tags = []string{"数字", "课程"}
+	chars[2].Categories = []string{"常用字"}
+	for _, char := range chars {
+		_, err := hanCharRepo.Create(ctx, char)
+		require.NoError(t, err)
+	}
+
+	// 用户1已学习“一”
+	require.NoError(t, memoryRepo.Create(ctx, entity.NewMemoryUnit(1, entity.MemoryUnitTypeHanChar, uint32(chars[0].ID))))
+
+	got, err := contents.ListNotLearned(ctx, 1, repository.NewContentFilter{}, 10)
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	assert.Equal(t, uint32(chars[1].ID), got[0].ContentID)
+	assert.Equal(t, "二", got[0].Text)
+	assert.Equal(t, entity.MemoryUnitTypeHanChar, got[0].Type)
+	assert.Equal(t, uint32(chars[2].ID), got[1].ContentID)
+
+	// 其他用户不受影响
+	got, err = contents.ListNotLearned(ctx, 2, repository.NewContentFilter{}, 10)
+	require.NoError(t, err)
+	assert.Len(t, got, 3)
+
+	// 标签过滤
+	got, err = contents.ListNotLearned(ctx, 1, repository.NewContentFilter{Tags: []string{"数字"}}, 10)
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	assert.Equal(t, uint32(chars[1].ID), got[0].ContentID)
+
+	// 课程标签与标签需同时满足
+	got, err = contents.ListNotLearned(ctx, 1, repository.NewContentFilter{Tags: []string{"数字"}, CourseTags: []string{"课程"}}, 10)
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	assert.Equal(t, uint32(chars[2].ID), got[0].ContentID)
+
+	// 分类过滤，只有汉字有分类
+	got, err = contents.ListNotLearned(ctx, 1, repository.NewContentFilter{Categories: []string{"常用字"}}, 10)
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	assert.Equal(t, uint32(chars[2].ID), got[0].ContentID)
+	words, err := NewContentRepositories(db)[entity.MemoryUnitTypeWord].ListNotLearned(ctx, 1, repository.NewContentFilter{Categories: []string{"常用字"}}, 10)
+	require.NoError(t, err)
+	assert.Empty(t, words)
+
+	// 按标签获取内容ID，以及用户已学习的对应记忆单元
+	ids, err := contents.ListIDs(ctx, repository.NewContentFilter{Tags: []string{"数字"}})
+	require.NoError(t, err)
+	assert.Equal(t, []uint32{uint32(chars[1].ID), uint32(chars[2].ID)}, ids)
+	units, err := memoryRepo.ListByContentIDs(ctx, 1, entity.MemoryUnitTypeHanChar, []uint32{uint32(chars[0].ID), uint32(chars[1].ID)})
+	require.NoError(t, err)
+	require.Len(t, units, 1)
+	assert.Equal(t, uint32(chars[0].ID), units[0].ContentID)
+
+	// 今日新学习的记忆单元数量
+	count, err := memoryRepo.CountCreatedSince(ctx, 1, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+}
+
+func TestContentRepository_Phrases(t *testing.T) {
+	db, cleanup := SetupTestDB(t)
+	defer cleanup()
+
+	phraseRepo := NewPhraseRepository(db)
+	contents := NewContentRepositories(db)[entity.MemoryUnitTypePhrase]
+	ctx := context.Background()
+
+	kept := entity.NewPhrase("break the ice", entity.PhraseKindIdiom, "打破僵局", 2)
+	deleted := entity.NewPhrase("make a decision", entity.PhraseKindCollocation, "做决定", 1)
+	require.NoError(t, phraseRepo.Create(ctx, kept))
+	require.NoError(t, phraseRepo.Create(ctx, deleted))
+	require.NoError(t, phraseRepo.Delete(ctx, deleted.ID))
+
+	got, err := phraseRepo.GetByID(ctx, kept.ID)
+	require.NoError(t, err)
+	require.NotNil(t, got)
+	assert.Equal(t, entity.PhraseKindIdiom, got.Kind)
+
+	// 已删除的内容视为不存在
+	exists, err := contents.Exists(ctx, 1, uint32(kept.ID))
+	require.NoError(t, err)
+	assert.True(t, exists)
+	exists, err = contents.Exists(ctx, 1, uint32(deleted.ID))
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	texts, err := contents.GetTexts(ctx, 1, []uint32{uint32(kept.ID), uint32(deleted.ID)})
+	require.NoError(t, err)
+	assert.Equal(t, map[uint32]string{uint32(kept.ID): "break the ice"}, texts)
+
+	items, err := contents.ListNotLearned(ctx, 1, repository.NewContentFilter{Level: 2}, 10)
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	assert.Equal(t, entity.MemoryUnitTypePhrase, items[0].Type)
+	assert.Equal(t, "break the ice", items[0].Text)
+}
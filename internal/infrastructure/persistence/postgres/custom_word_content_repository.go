@@ -0,0 +1,102 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/lazyjean/sla2/internal/domain/entity"
+	"github.com/lazyjean/sla2/internal/domain/repository"
+	"github.com/lazyjean/sla2/internal/domain/valueobject"
+	"gorm.io/gorm"
+)
+
+// customWordContentRepository 生词本自定义词条的学习内容仓储实现
+// 自定义词条是 word_notebook_entries 中 word_id 为空的行，只属于创建它的用户，没有难度等级和标签
+type customWordContentRepository struct {
+	db *gorm.DB
+}
+
+// newCustomWordContentRepository 创建自定义词条的学习内容仓储
+func newCustomWordContentRepository(db *gorm.DB) *customWordContentRepository {
+	return &customWordContentRepository{
+		db: db,
+	}
+}
+
+// UnitType 学习内容对应的记忆单元类型
+func (r *customWordContentRepository) UnitType() entity.MemoryUnitType {
+	return entity.MemoryUnitTypeCustomWord
+}
+
+// Exists 检查用户自己的自定义词条是否存在
+func (r *customWordContentRepository) Exists(ctx context.Context, userID entity.UID, id uint32) (bool, error) {
+	var count int64
+	err := r.entries(ctx).Where("id = ? AND user_id = ?", id, userID).Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// GetTexts 获取用户自己的自定义词条的单词
+func (r *customWordContentRepository) GetTexts(ctx context.Context, userID entity.UID, ids []uint32) (map[uint32]string, error) {
+	texts := make(map[uint32]string, len(ids))
+	if len(ids) == 0 {
+		return texts, nil
+	}
+
+	var rows []contentRow
+	if err := r.entries(ctx).Select("id, text").Where("id IN ? AND user_id = ?", ids, userID).Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		texts[row.ID] = row.Text
+	}
+	return texts, nil
+}
+
+// ListNotLearned 获取用户尚未学习（没有记忆单元）的自定义词条
+func (r *customWordContentRepository) ListNotLearned(ctx context.Context, userID entity.UID, filter repository.NewContentFilter, limit int) ([]*entity.StudyItem, error) {
+	query := r.filter(r.entries(ctx), filter).
+		Select("id, text").
+		Where("user_id = ?", userID).
+		Where("NOT EXISTS (SELECT 1 FROM memory_units mu WHERE mu.user_id = ? AND mu.type = ? AND mu.content_id = word_notebook_entries.id)",
+			userID, entity.MemoryUnitTypeCustomWord)
+
+	var rows []contentRow
+	if err := query.Order("id ASC").Limit(limit).Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	items := make([]*entity.StudyItem, len(rows))
+	for i, row := range rows {
+		items[i] = &entity.StudyItem{Type: entity.MemoryUnitTypeCustomWord, ContentID: row.ID, Text: row.Text}
+	}
+	return items, nil
+}
+
+// ListIDs 获取符合过滤条件的自定义词条ID
+func (r *customWordContentRepository) ListIDs(ctx context.Context, filter repository.NewContentFilter) ([]uint32, error) {
+	var ids []uint32
+	if err := r.filter(r.entries(ctx), filter).Order("id ASC").Pluck("id", &ids).Error; err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// entries 自定义词条的查询
+func (r *customWordContentRepository) entries(ctx context.Context) *gorm.DB {
+	return dbFromContext(ctx, r.db).Model(&entity.WordNotebookEntry{}).Where("word_id IS NULL")
+}
+
+// filter 按生词本过滤，自定义词条没有难度等级、标签和分类，按这些条件过滤时没有结果
+func (r *customWordContentRepository) filter(query *gorm.DB, filter repository.NewContentFilter) *gorm.DB {
+	if filter.Level != valueobject.WORD_DIFFICULTY_LEVEL_UNSPECIFIED || len(filter.Tags) > 0 || len(filter.CourseTags) > 0 || len(filter.Categories) > 0 {
+		return query.Where("1 = 0")
+	}
+	if filter.NotebookID != 0 {
+		query = query.Where("notebook_id = ?", filter.NotebookID)
+	}
+	return query
+}
+
+var _ repository.ContentRepository = (*customWordContentRepository)(nil)
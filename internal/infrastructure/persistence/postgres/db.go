@@ -83,9 +83,21 @@ func autoMigrate(db *gorm.DB) error {
 			&entity.RolePermission{},
 			&entity.Admin{},
 			&entity.HanChar{},
+			&entity.HanCharLearning{},
+			&entity.HanCharStrokes{},
+			&entity.Phrase{},
+			&entity.Sentence{},
+			&entity.GrammarPoint{},
 			&entity.MemoryUnit{},
 			&entity.MemoryReview{},
+			&entity.StudySession{},
+			&entity.UserSettings{},
 			&entity.DailyStat{},
+			&entity.WordNotebook{},
+			&entity.WordNotebookEntry{},
+			&entity.WordForm{},
+			&entity.WordRelation{},
+			&entity.HanCharRelation{},
 		); err != nil {
 			return err
 		}
@@ -103,6 +115,32 @@ func autoMigrate(db *gorm.DB) error {
 			// 不返回错误，继续执行
 		}
 
-		return nil
+		// 4. 为新增检索拼音列之前写入的汉字生成检索用拼音
+		if err := backfillHanCharPinyin(tx); err != nil {
+			return err
+		}
+
+		// 5. 单词全文检索的扩展和索引
+		return migrateWordSearch(tx)
 	})
 }
+
+// backfillHanCharPinyin 为检索用拼音为空的汉字生成检索用拼音
+func backfillHanCharPinyin(tx *gorm.DB) error {
+	var hanChars []*entity.HanChar
+	if err := tx.Where("pinyin_search = '' AND pinyin_toneless = '' AND pinyin <> ''").Find(&hanChars).Error; err != nil {
+		return err
+	}
+	for _, hanChar := range hanChars {
+		hanChar.NormalizePinyin()
+		if err := tx.Model(hanChar).UpdateColumns(map[string]interface{}{
+			"pinyin":          hanChar.Pinyin,
+			"pinyin_numbered": hanChar.PinyinNumbered,
+			"pinyin_search":   hanChar.PinyinSearch,
+			"pinyin_toneless": hanChar.PinyinToneless,
+		}).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/lazyjean/sla2/internal/domain/entity"
+	"github.com/lazyjean/sla2/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+// grammarPointRepository PostgreSQL 语法点仓储实现
+type grammarPointRepository struct {
+	db *gorm.DB
+}
+
+// NewGrammarPointRepository 创建语法点仓储实例
+func NewGrammarPointRepository(db *gorm.DB) repository.GrammarPointRepository {
+	return &grammarPointRepository{
+		db: db,
+	}
+}
+
+// Create 创建语法点
+func (r *grammarPointRepository) Create(ctx context.Context, grammarPoint *entity.GrammarPoint) error {
+	return dbFromContext(ctx, r.db).Create(grammarPoint).Error
+}
+
+// Update 更新语法点
+func (r *grammarPointRepository) Update(ctx context.Context, grammarPoint *entity.GrammarPoint) error {
+	return dbFromContext(ctx, r.db).Save(grammarPoint).Error
+}
+
+// Delete 删除语法点
+func (r *grammarPointRepository) Delete(ctx context.Context, id entity.GrammarPointID) error {
+	return dbFromContext(ctx, r.db).Delete(&entity.GrammarPoint{}, id).Error
+}
+
+// GetByID 根据ID获取语法点
+func (r *grammarPointRepository) GetByID(ctx context.Context, id entity.GrammarPointID) (*entity.GrammarPoint, error) {
+	var grammarPoint entity.GrammarPoint
+	err := dbFromContext(ctx, r.db).First(&grammarPoint, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &grammarPoint, nil
+}
+
+// List 分页获取语法点列表
+func (r *grammarPointRepository) List(ctx context.Context, offset, limit int) ([]*entity.GrammarPoint, int64, error) {
+	var total int64
+	query := dbFromContext(ctx, r.db).Model(&entity.GrammarPoint{})
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var items []*entity.GrammarPoint
+	if err := query.Order("id ASC").Offset(offset).Limit(limit).Find(&items).Error; err != nil {
+		return nil, 0, err
+	}
+	return items, total, nil
+}
+
+var _ repository.GrammarPointRepository = (*grammarPointRepository)(nil)
@@ -0,0 +1,44 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/lazyjean/sla2/internal/domain/entity"
+	"github.com/lazyjean/sla2/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+// hanCharLearningRepository 汉字学习记录仓储实现
+type hanCharLearningRepository struct {
+	db *gorm.DB
+}
+
+// NewHanCharLearningRepository 创建汉字学习记录仓储实例
+func NewHanCharLearningRepository(db *gorm.DB) repository.HanCharLearningRepository {
+	return &hanCharLearningRepository{
+		db: db,
+	}
+}
+
+// GetByHanCharID 获取用户某个汉字的学习记录，不存在时返回 nil
+func (r *hanCharLearningRepository) GetByHanCharID(ctx context.Context, userID entity.UID, hanCharID entity.HanCharID) (*entity.HanCharLearning, error) {
+	var learning entity.HanCharLearning
+	err := dbFromContext(ctx, r.db).
+		Where("user_id = ? AND han_char_id = ?", userID, hanCharID).
+		First(&learning).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &learning, nil
+}
+
+// Save 保存汉字学习记录，不存在时创建
+func (r *hanCharLearningRepository) Save(ctx context.Context, learning *entity.HanCharLearning) error {
+	return dbFromContext(ctx, r.db).Save(learning).Error
+}
+
+var _ repository.HanCharLearningRepository = (*hanCharLearningRepository)(nil)
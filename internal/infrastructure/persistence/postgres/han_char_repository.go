@@ -3,10 +3,12 @@ package postgres
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/lazyjean/sla2/internal/domain/entity"
 	"github.com/lazyjean/sla2/internal/domain/repository"
 	"github.com/lazyjean/sla2/internal/domain/valueobject"
+	"github.com/lazyjean/sla2/pkg/pinyin"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 )
@@ -25,6 +27,7 @@ func NewHanCharRepository(db *gorm.DB) repository.HanCharRepository {
 
 // Create 创建汉字
 func (r *hanCharRepository) Create(ctx context.Context, hanChar *entity.HanChar) (entity.HanCharID, error) {
+	hanChar.NormalizePinyin()
 	// 使用 GORM 的 Create 方法，GORM 会自动处理 JSONB 字段的序列化
 	err := r.db.WithContext(ctx).
 		Clauses(clause.OnConflict{
@@ -40,12 +43,19 @@ func (r *hanCharRepository) Create(ctx context.Context, hanChar *entity.HanChar)
 
 // Update 更新汉字
 func (r *hanCharRepository) Update(ctx context.Context, hanChar *entity.HanChar) error {
+	hanChar.NormalizePinyin()
 	return r.db.WithContext(ctx).Save(hanChar).Error
 }
 
 // Delete 删除汉字
 func (r *hanCharRepository) Delete(ctx context.Context, id entity.HanCharID) error {
-	return r.db.WithContext(ctx).Delete(&entity.HanChar{}, id).Error
+	// 汉字是软删除，不会触发关联表的级联删除，需要同时删除其关联
+	return dbFromContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("source_id = ? OR target_id = ?", id, id).Delete(&entity.HanCharRelation{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&entity.HanChar{}, id).Error
+	})
 }
 
 // GetByID 根据ID获取汉字
@@ -76,26 +86,7 @@ func (r *hanCharRepository) List(ctx context.Context, offset, limit int, filters
 	var hanChars []*entity.HanChar
 	var total int64
 
-	query := r.db.WithContext(ctx).Model(&entity.HanChar{})
-
-	// 应用过滤条件
-	for key, value := range filters {
-		switch key {
-		case "level":
-			if v, ok := value.(valueobject.WordDifficultyLevel); ok {
-				query = query.Where("level = ?", v)
-				fmt.Printf("Filtering by level: %d\n", v)
-			}
-		case "tags":
-			if v, ok := value.([]string); ok && len(v) > 0 {
-				query = query.Where("tags @> ?", v)
-			}
-		case "categories":
-			if v, ok := value.([]string); ok && len(v) > 0 {
-				query = query.Where("categories @> ?", v)
-			}
-		}
-	}
+	query := applyHanCharFilters(r.db.WithContext(ctx).Model(&entity.HanChar{}), filters)
 
 	// 获取总数
 	err := query.Count(&total).Error
@@ -109,43 +100,158 @@ func (r *hanCharRepository) List(ctx context.Context, offset, limit int, filters
 		return nil, 0, err
 	}
 
-	fmt.Printf("Found %d han chars, total: %d\n", len(hanChars), total)
-
 	return hanChars, total, nil
 }
 
 // Search 搜索汉字
+// 关键字按字符、繁简体写法匹配，是拼音时按任意写法的拼音匹配：
+// 关键字不带声调或过滤条件 ignore_tone 为 true 时忽略声调，否则声调也需一致；
+// 拼音整体相等才匹配，ma 不匹配 mang、zhima
 func (r *hanCharRepository) Search(ctx context.Context, keyword string, offset, limit int, filters map[string]interface{}) ([]*entity.HanChar, int64, error) {
 	var hanChars []*entity.HanChar
 	var total int64
 
-	query := r.db.WithContext(ctx).
-		Where("character ILIKE ? OR pinyin ILIKE ?", "%"+keyword+"%", "%"+keyword+"%")
+	conditions := "character ILIKE ? OR traditional = ? OR simplified = ?"
+	vars := []interface{}{"%" + keyword + "%", keyword, keyword}
+	if pinyin.IsPinyin(keyword) {
+		ignoreTone, _ := filters["ignore_tone"].(bool)
+		if ignoreTone || !pinyin.HasTone(keyword) {
+			conditions += " OR pinyin_toneless = ?"
+			vars = append(vars, pinyin.Toneless(keyword))
+		} else {
+			conditions += " OR pinyin_search = ?"
+			vars = append(vars, pinyin.Normalize(keyword))
+		}
+	}
+	query := r.db.WithContext(ctx).Where(conditions, vars...)
+	query = applyHanCharFilters(query, filters)
+
+	if err := query.Model(&entity.HanChar{}).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	err := query.Session(&gorm.Session{PrepareStmt: true}).Offset(offset).Limit(limit).Find(&hanChars).Error
+	return hanChars, total, err
+}
+
+// ListByIDs 通过ID列表获取汉字，按ID升序
+func (r *hanCharRepository) ListByIDs(ctx context.Context, ids []entity.HanCharID) ([]*entity.HanChar, error) {
+	var hanChars []*entity.HanChar
+	if len(ids) == 0 {
+		return hanChars, nil
+	}
+	err := dbFromContext(ctx, r.db).Where("id IN ?", ids).Order("id ASC").Find(&hanChars).Error
+	if err != nil {
+		return nil, err
+	}
+	return hanChars, nil
+}
 
-	// 应用过滤条件
+// ListLearned 获取用户已学习的汉字，按下次复习时间升序（最该复习的在前）
+func (r *hanCharRepository) ListLearned(ctx context.Context, userID entity.UID, limit int, filters map[string]interface{}) ([]*entity.HanChar, error) {
+	var hanChars []*entity.HanChar
+	err := applyHanCharFilters(dbFromContext(ctx, r.db).Model(&entity.HanChar{}), filters).
+		Joins("JOIN memory_units mu ON mu.content_id = han_chars.id AND mu.user_id = ? AND mu.type = ?",
+			userID, entity.MemoryUnitTypeHanChar).
+		Order("mu.next_review_at ASC, han_chars.id ASC").
+		Limit(limit).
+		Find(&hanChars).Error
+	if err != nil {
+		return nil, err
+	}
+	return hanChars, nil
+}
+
+// ListDistractorCandidates 获取可作为测试干扰项的汉字
+// 依次为同音（忽略声调）、有相同标签或分类、相同难度等级的汉字，同一相关程度内按ID升序
+func (r *hanCharRepository) ListDistractorCandidates(ctx context.Context, hanChar *entity.HanChar, limit int) ([]*entity.HanChar, error) {
+	var conditions []string
+	var vars []interface{}
+	if toneless := pinyin.Toneless(hanChar.Pinyin); toneless != "" {
+		conditions = append(conditions, "han_chars.pinyin_toneless = ?")
+		vars = append(vars, toneless)
+	}
+	var related []string
+	var relatedVars []interface{}
+	if len(hanChar.Tags) > 0 {
+		related = append(related, "jsonb_exists_any(han_chars.tags, ARRAY[?])")
+		relatedVars = append(relatedVars, hanChar.Tags)
+	}
+	if len(hanChar.Categories) > 0 {
+		related = append(related, "jsonb_exists_any(han_chars.categories, ARRAY[?])")
+		relatedVars = append(relatedVars, hanChar.Categories)
+	}
+	if len(related) > 0 {
+		conditions = append(conditions, "("+strings.Join(related, " OR ")+")")
+		vars = append(vars, relatedVars...)
+	}
+	conditions = append(conditions, "han_chars.level = ?")
+	vars = append(vars, hanChar.Level)
+
+	// 相关程度为第一个满足的条件的序号
+	tier := "CASE"
+	for i, condition := range conditions {
+		tier += fmt.Sprintf(" WHEN %s THEN %d", condition, i)
+	}
+	tier += fmt.Sprintf(" ELSE %d END, han_chars.id ASC", len(conditions))
+
+	var hanChars []*entity.HanChar
+	err := dbFromContext(ctx, r.db).
+		Where("han_chars.id <> ?", hanChar.ID).
+		Where("("+strings.Join(conditions, " OR ")+")", vars...).
+		Order(clause.OrderBy{Expression: clause.Expr{SQL: tier, Vars: vars, WithoutParentheses: true}}).
+		Limit(limit).
+		Find(&hanChars).Error
+	if err != nil {
+		return nil, err
+	}
+	return hanChars, nil
+}
+
+// applyHanCharFilters 按难度等级、标签、分类、部首、笔画数范围、部件和拼音过滤汉字，标签和分类需全部包含
+// 拼音过滤的值为检索用拼音（pinyin 带声调，pinyin_toneless 不带声调）
+func applyHanCharFilters(query *gorm.DB, filters map[string]interface{}) *gorm.DB {
 	for key, value := range filters {
 		switch key {
 		case "level":
 			if v, ok := value.(valueobject.WordDifficultyLevel); ok {
-				query = query.Where("level = ?", v)
+				query = query.Where("han_chars.level = ?", v)
 			}
 		case "tags":
 			if v, ok := value.([]string); ok && len(v) > 0 {
-				query = query.Where("tags @> ?", v)
+				query = query.Where("jsonb_exists_all(han_chars.tags, ARRAY[?])", v)
 			}
 		case "categories":
 			if v, ok := value.([]string); ok && len(v) > 0 {
-				query = query.Where("categories @> ?", v)
+				query = query.Where("jsonb_exists_all(han_chars.categories, ARRAY[?])", v)
+			}
+		case "radical":
+			if v, ok := value.(string); ok && v != "" {
+				query = query.Where("han_chars.radical = ?", v)
+			}
+		case "min_strokes":
+			if v, ok := value.(uint32); ok && v > 0 {
+				query = query.Where("han_chars.stroke_count >= ?", v)
+			}
+		case "max_strokes":
+			if v, ok := value.(uint32); ok && v > 0 {
+				query = query.Where("han_chars.stroke_count <= ?", v)
+			}
+		case "pinyin":
+			if v, ok := value.(string); ok && v != "" {
+				query = query.Where("han_chars.pinyin_search = ?", v)
+			}
+		case "pinyin_toneless":
+			if v, ok := value.(string); ok && v != "" {
+				query = query.Where("han_chars.pinyin_toneless = ?", v)
+			}
+		case "component":
+			if v, ok := value.(string); ok && v != "" {
+				query = query.Where("jsonb_exists(han_chars.components, ?)", v)
 			}
 		}
 	}
-
-	if err := query.Model(&entity.HanChar{}).Count(&total).Error; err != nil {
-		return nil, 0, err
-	}
-
-	err := query.Session(&gorm.Session{PrepareStmt: true}).Offset(offset).Limit(limit).Find(&hanChars).Error
-	return hanChars, total, err
+	return query
 }
 
 var _ repository.HanCharRepository = (*hanCharRepository)(nil)
@@ -145,4 +145,149 @@ func TestHanCharRepository(t *testing.T) {
 		require.Error(t, err)
 		assert.Nil(t, got)
 	})
+	t.Run("Strokes", func(t *testing.T) {
+		ctx := context.Background()
+		hanChar := entity.NewHanChar("十", "shí", valueobject.WORD_DIFFICULTY_LEVEL_HSK1)
+		hanChar.Tags, hanChar.Categories, hanChar.Examples = []string{}, []string{}, []string{}
+		_, err := repo.Create(ctx, hanChar)
+		require.NoError(t, err)
+
+		strokesRepo := NewHanCharStrokesRepository(db)
+		got, err := strokesRepo.GetByHanCharID(ctx, hanChar.ID)
+		require.NoError(t, err)
+		assert.Nil(t, got)
+
+		require.NoError(t, strokesRepo.Save(ctx, entity.NewHanCharStrokes(hanChar.ID, []string{"M 0 0"}, []entity.StrokePolyline{{{0, 0}, {1, 1}}})))
+		// 重新导入时覆盖已有的笔顺数据
+		medians := []entity.StrokePolyline{{{100, 500}, {900, 500}}, {{500, 900}, {500, 100}}}
+		require.NoError(t, strokesRepo.Save(ctx, entity.NewHanCharStrokes(hanChar.ID, []string{"M 100 500", "M 500 900"}, medians)))
+
+		got, err = strokesRepo.GetByHanCharID(ctx, hanChar.ID)
+		require.NoError(t, err)
+		require.NotNil(t, got)
+		assert.Equal(t, []string{"M 100 500", "M 500 900"}, got.Strokes)
+		assert.Equal(t, medians, got.Medians)
+	})
+
+	t.Run("Pinyin Search", func(t *testing.T) {
+		ctx := context.Background()
+		for _, c := range []struct{ character, pinyin string }{{"妈", "mā"}, {"马", "ma3"}, {"骂", "mà"}, {"绿", "lv4"}, {"忙", "máng"}} {
+			hanChar := entity.NewHanChar(c.character, c.pinyin, valueobject.WORD_DIFFICULTY_LEVEL_HSK5)
+			hanChar.Tags, hanChar.Categories, hanChar.Examples = []string{}, []string{}, []string{}
+			_, err := repo.Create(ctx, hanChar)
+			require.NoError(t, err)
+		}
+
+		search := func(keyword string, filters map[string]interface{}) []string {
+			hanChars, _, err := repo.Search(ctx, keyword, 0, 10, filters)
+			require.NoError(t, err)
+			characters := make([]string, len(hanChars))
+			for i, hanChar := range hanChars {
+				characters[i] = hanChar.Character
+			}
+			return characters
+		}
+		// 数字标调写入的拼音统一为声调符号
+		got, err := repo.GetByCharacter(ctx, "马")
+		require.NoError(t, err)
+		assert.Equal(t, "mǎ", got.Pinyin)
+
+		// 声调符号与数字标调的写法互相匹配
+		assert.Equal(t, []string{"马"}, search("mǎ", nil))
+		assert.Equal(t, []string{"马"}, search("ma3", nil))
+		assert.Equal(t, []string{"绿"}, search("lǜ", nil))
+		assert.Equal(t, []string{"绿"}, search("lv4", nil))
+		// 不标调或忽略声调时匹配所有声调
+		assert.ElementsMatch(t, []string{"妈", "马", "骂"}, search("ma", nil))
+		assert.ElementsMatch(t, []string{"妈", "马", "骂"}, search("ma3", map[string]interface{}{"ignore_tone": true}))
+		// 拼音整体相等才匹配，ma 不匹配 mang
+		assert.Equal(t, []string{"忙"}, search("mang", nil))
+		assert.Equal(t, []string{"忙"}, search("máng", nil))
+		assert.Empty(t, search("ang", nil))
+
+		list := func(filters map[string]interface{}) []string {
+			hanChars, _, err := repo.List(ctx, 0, 10, filters)
+			require.NoError(t, err)
+			characters := make([]string, len(hanChars))
+			for i, hanChar := range hanChars {
+				characters[i] = hanChar.Character
+			}
+			return characters
+		}
+		assert.Equal(t, []string{"骂"}, list(map[string]interface{}{"pinyin": "ma4"}))
+		assert.ElementsMatch(t, []string{"妈", "马", "骂"}, list(map[string]interface{}{"pinyin_toneless": "ma"}))
+	})
+
+	t.Run("Details and Filters", func(t *testing.T) {
+		ctx := context.Background()
+		create := func(character, radical string, strokes uint32, components ...string) *entity.HanChar {
+			hanChar := entity.NewHanChar(character, "", valueobject.WORD_DIFFICULTY_LEVEL_HSK4)
+			hanChar.Tags, hanChar.Categories, hanChar.Examples = []string{}, []string{}, []string{}
+			hanChar.Radical, hanChar.StrokeCount, hanChar.Components = radical, strokes, components
+			_, err := repo.Create(ctx, hanChar)
+			require.NoError(t, err)
+			return hanChar
+		}
+		ming := create("明", "日", 8, "日", "月")
+		ming.Pinyin, ming.PinyinNumbered, ming.Meanings = "míng", "ming2", []string{"亮", "清楚"}
+		require.NoError(t, repo.Update(ctx, ming))
+		create("晴", "日", 12, "日", "青")
+		create("清", "氵", 11, "氵", "青")
+		learn := create("学", "子", 8)
+		learn.Traditional = "學"
+		require.NoError(t, repo.Update(ctx, learn))
+
+		got, err := repo.GetByID(ctx, ming.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "ming2", got.PinyinNumbered)
+		assert.Equal(t, []string{"日", "月"}, got.Components)
+		assert.Equal(t, []string{"亮", "清楚"}, got.Meanings)
+
+		list := func(filters map[string]interface{}) []string {
+			filters["level"] = valueobject.WORD_DIFFICULTY_LEVEL_HSK4
+			hanChars, _, err := repo.List(ctx, 0, 10, filters)
+			require.NoError(t, err)
+			characters := make([]string, len(hanChars))
+			for i, hanChar := range hanChars {
+				characters[i] = hanChar.Character
+			}
+			return characters
+		}
+		assert.ElementsMatch(t, []string{"明", "晴"}, list(map[string]interface{}{"radical": "日"}))
+		assert.ElementsMatch(t, []string{"晴", "清"}, list(map[string]interface{}{"min_strokes": uint32(9)}))
+		assert.ElementsMatch(t, []string{"明", "学"}, list(map[string]interface{}{"max_strokes": uint32(8)}))
+		assert.ElementsMatch(t, []string{"晴", "清"}, list(map[string]interface{}{"component": "青"}))
+
+		// 按繁体写法搜索
+		hanChars, _, err := repo.Search(ctx, "學", 0, 10, nil)
+		require.NoError(t, err)
+		require.Len(t, hanChars, 1)
+		assert.Equal(t, learn.ID, hanChars[0].ID)
+	})
+
+	t.Run("ListDistractorCandidates", func(t *testing.T) {
+		ctx := context.Background()
+		create := func(character, pinyin string, level valueobject.WordDifficultyLevel, categories ...string) *entity.HanChar {
+			hanChar := entity.NewHanChar(character, pinyin, level)
+			hanChar.Tags, hanChar.Categories, hanChar.Examples = []string{}, categories, []string{}
+			if hanChar.Categories == nil {
+				hanChar.Categories = []string{}
+			}
+			_, err := repo.Create(ctx, hanChar)
+			require.NoError(t, err)
+			return hanChar
+		}
+		target := create("山", "shān", valueobject.WORD_DIFFICULTY_LEVEL_HSK1, "自然")
+		sameLevel := create("大", "dà", valueobject.WORD_DIFFICULTY_LEVEL_HSK1)
+		related := create("水", "shuǐ", valueobject.WORD_DIFFICULTY_LEVEL_HSK2, "自然")
+		homophone := create("闪", "shǎn", valueobject.WORD_DIFFICULTY_LEVEL_HSK3)
+		create("龙", "lóng", valueobject.WORD_DIFFICULTY_LEVEL_HSK3)
+
+		got, err := repo.ListDistractorCandidates(ctx, target, 10)
+		require.NoError(t, err)
+		require.Len(t, got, 3)
+		assert.Equal(t, homophone.ID, got[0].ID)
+		assert.Equal(t, related.ID, got[1].ID)
+		assert.Equal(t, sameLevel.ID, got[2].ID)
+	})
 }
@@ -0,0 +1,50 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/lazyjean/sla2/internal/domain/entity"
+	"github.com/lazyjean/sla2/internal/domain/repository"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// hanCharStrokesRepository 汉字笔顺数据仓储实现
+type hanCharStrokesRepository struct {
+	db *gorm.DB
+}
+
+// NewHanCharStrokesRepository 创建汉字笔顺数据仓储实例
+func NewHanCharStrokesRepository(db *gorm.DB) repository.HanCharStrokesRepository {
+	return &hanCharStrokesRepository{
+		db: db,
+	}
+}
+
+// GetByHanCharID 获取汉字的笔顺数据，不存在时返回 nil
+func (r *hanCharStrokesRepository) GetByHanCharID(ctx context.Context, hanCharID entity.HanCharID) (*entity.HanCharStrokes, error) {
+	var strokes entity.HanCharStrokes
+	err := dbFromContext(ctx, r.db).
+		Where("han_char_id = ?", hanCharID).
+		First(&strokes).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &strokes, nil
+}
+
+// Save 保存汉字的笔顺数据，已存在时覆盖
+func (r *hanCharStrokesRepository) Save(ctx context.Context, strokes *entity.HanCharStrokes) error {
+	return dbFromContext(ctx, r.db).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "han_char_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"strokes", "medians", "updated_at"}),
+		}).
+		Create(strokes).Error
+}
+
+var _ repository.HanCharStrokesRepository = (*hanCharStrokesRepository)(nil)
@@ -0,0 +1,155 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/lazyjean/sla2/internal/domain/entity"
+	domainErrors "github.com/lazyjean/sla2/internal/domain/errors"
+	"github.com/lazyjean/sla2/internal/domain/repository"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// lexicalRelationRepository 单词关联和汉字关联仓储实现
+type lexicalRelationRepository struct {
+	db *gorm.DB
+}
+
+// NewLexicalRelationRepository 创建单词关联和汉字关联仓储实例
+func NewLexicalRelationRepository(db *gorm.DB) repository.LexicalRelationRepository {
+	return &lexicalRelationRepository{
+		db: db,
+	}
+}
+
+// CreateWordRelation 创建单词关联
+func (r *lexicalRelationRepository) CreateWordRelation(ctx context.Context, relation *entity.WordRelation) error {
+	created, err := insertRelation(dbFromContext(ctx, r.db), relation)
+	if err != nil {
+		return err
+	}
+	if !created {
+		return domainErrors.ErrRelationAlreadyExists
+	}
+	return nil
+}
+
+// ImportWordRelations 批量创建单词关联，跳过已存在的关联
+func (r *lexicalRelationRepository) ImportWordRelations(ctx context.Context, relations []*entity.WordRelation) (int, error) {
+	count := 0
+	err := dbFromContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		for _, relation := range relations {
+			created, err := insertRelation(tx, relation)
+			if err != nil {
+				return err
+			}
+			if created {
+				count++
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// DeleteWordRelation 删除单词关联
+func (r *lexicalRelationRepository) DeleteWordRelation(ctx context.Context, id entity.WordRelationID) error {
+	return deleteRelation(dbFromContext(ctx, r.db), &entity.WordRelation{}, uint32(id))
+}
+
+// ListWordRelations 获取单词作为源或目标的关联
+func (r *lexicalRelationRepository) ListWordRelations(ctx context.Context, wordID entity.WordID, types []entity.LexicalRelationType) ([]*entity.WordRelation, error) {
+	var relations []*entity.WordRelation
+	err := relationQuery(dbFromContext(ctx, r.db), uint32(wordID), types).Find(&relations).Error
+	if err != nil {
+		return nil, err
+	}
+	return relations, nil
+}
+
+// CreateHanCharRelation 创建汉字关联
+func (r *lexicalRelationRepository) CreateHanCharRelation(ctx context.Context, relation *entity.HanCharRelation) error {
+	created, err := insertRelation(dbFromContext(ctx, r.db), relation)
+	if err != nil {
+		return err
+	}
+	if !created {
+		return domainErrors.ErrRelationAlreadyExists
+	}
+	return nil
+}
+
+// ImportHanCharRelations 批量创建汉字关联，跳过已存在的关联
+func (r *lexicalRelationRepository) ImportHanCharRelations(ctx context.Context, relations []*entity.HanCharRelation) (int, error) {
+	count := 0
+	err := dbFromContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		for _, relation := range relations {
+			created, err := insertRelation(tx, relation)
+			if err != nil {
+				return err
+			}
+			if created {
+				count++
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// DeleteHanCharRelation 删除汉字关联
+func (r *lexicalRelationRepository) DeleteHanCharRelation(ctx context.Context, id entity.HanCharRelationID) error {
+	return deleteRelation(dbFromContext(ctx, r.db), &entity.HanCharRelation{}, uint32(id))
+}
+
+// ListHanCharRelations 获取汉字作为源或目标的关联
+func (r *lexicalRelationRepository) ListHanCharRelations(ctx context.Context, hanCharID entity.HanCharID, types []entity.LexicalRelationType) ([]*entity.HanCharRelation, error) {
+	var relations []*entity.HanCharRelation
+	err := relationQuery(dbFromContext(ctx, r.db), uint32(hanCharID), types).Find(&relations).Error
+	if err != nil {
+		return nil, err
+	}
+	return relations, nil
+}
+
+// insertRelation 插入一条关联，已存在同类关联时不插入并返回 false
+func insertRelation(db *gorm.DB, relation interface{}) (bool, error) {
+	result := db.Omit("Source", "Target").
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(relation)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// deleteRelation 按ID删除一条关联
+func deleteRelation(db *gorm.DB, model interface{}, id uint32) error {
+	result := db.Delete(model, id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return domainErrors.ErrRelationNotFound
+	}
+	return nil
+}
+
+// relationQuery 查询一端为 id 的关联并加载两端，按类型和创建顺序排序
+func relationQuery(db *gorm.DB, id uint32, types []entity.LexicalRelationType) *gorm.DB {
+	query := db.Preload("Source").
+		Preload("Target").
+		Where("(source_id = ? OR target_id = ?)", id, id)
+	if len(types) > 0 {
+		query = query.Where("type IN ?", types)
+	}
+	return query.Order("type ASC, id ASC")
+}
+
+var _ repository.LexicalRelationRepository = (*lexicalRelationRepository)(nil)
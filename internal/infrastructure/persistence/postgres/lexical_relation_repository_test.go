@@ -0,0 +1,99 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lazyjean/sla2/internal/domain/entity"
+	domainErrors "github.com/lazyjean/sla2/internal/domain/errors"
+	"github.com/lazyjean/sla2/internal/domain/valueobject"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLexicalRelationRepository(t *testing.T) {
+	db, cleanup := SetupTestDB(t)
+	defer cleanup()
+
+	repo := NewLexicalRelationRepository(db)
+	wordRepo := NewWordRepository(db)
+	hanCharRepo := NewHanCharRepository(db)
+	ctx := context.Background()
+
+	words := make([]*entity.Word, 3)
+	for i, text := range []string{"happy", "glad", "happiness"} {
+		words[i] = &entity.Word{Text: text, Definitions: []entity.Definition{{Meaning: text}}}
+		require.NoError(t, wordRepo.Create(ctx, words[i]))
+	}
+	happy, glad, happiness := words[0], words[1], words[2]
+
+	t.Run("单词关联", func(t *testing.T) {
+		synonym, err := entity.NewWordRelation(glad.ID, happy.ID, entity.LexicalRelationTypeSynonym, "")
+		require.NoError(t, err)
+		require.NoError(t, repo.CreateWordRelation(ctx, synonym))
+		assert.NotZero(t, synonym.ID)
+
+		// 反向的同义关联视为同一条
+		duplicate, err := entity.NewWordRelation(happy.ID, glad.ID, entity.LexicalRelationTypeSynonym, "")
+		require.NoError(t, err)
+		assert.ErrorIs(t, repo.CreateWordRelation(ctx, duplicate), domainErrors.ErrRelationAlreadyExists)
+
+		derived, err := entity.NewWordRelation(happiness.ID, happy.ID, entity.LexicalRelationTypeDerivedFrom, "")
+		require.NoError(t, err)
+		created, err := repo.ImportWordRelations(ctx, []*entity.WordRelation{duplicate, derived})
+		require.NoError(t, err)
+		assert.Equal(t, 1, created)
+
+		relations, err := repo.ListWordRelations(ctx, happy.ID, nil)
+		require.NoError(t, err)
+		require.Len(t, relations, 2)
+		word, inverse := relations[0].Other(happy.ID)
+		assert.Equal(t, "glad", word.Text)
+		assert.False(t, inverse)
+		word, inverse = relations[1].Other(happy.ID)
+		assert.Equal(t, "happiness", word.Text)
+		assert.True(t, inverse)
+
+		relations, err = repo.ListWordRelations(ctx, happy.ID, []entity.LexicalRelationType{entity.LexicalRelationTypeDerivedFrom})
+		require.NoError(t, err)
+		require.Len(t, relations, 1)
+
+		require.NoError(t, repo.DeleteWordRelation(ctx, synonym.ID))
+		assert.ErrorIs(t, repo.DeleteWordRelation(ctx, synonym.ID), domainErrors.ErrRelationNotFound)
+
+		// 删除单词时同时删除其关联
+		require.NoError(t, wordRepo.Delete(ctx, happiness.ID))
+		relations, err = repo.ListWordRelations(ctx, happy.ID, nil)
+		require.NoError(t, err)
+		assert.Empty(t, relations)
+	})
+
+	t.Run("汉字关联", func(t *testing.T) {
+		ri, err := hanCharRepo.Create(ctx, entity.NewHanChar("日", "rì", valueobject.WORD_DIFFICULTY_LEVEL_A1))
+		require.NoError(t, err)
+		yue, err := hanCharRepo.Create(ctx, entity.NewHanChar("曰", "yuē", valueobject.WORD_DIFFICULTY_LEVEL_A1))
+		require.NoError(t, err)
+
+		relation, err := entity.NewHanCharRelation(yue, ri, entity.LexicalRelationTypeConfusable, "字形相近")
+		require.NoError(t, err)
+		require.NoError(t, repo.CreateHanCharRelation(ctx, relation))
+
+		relations, err := repo.ListHanCharRelations(ctx, yue, nil)
+		require.NoError(t, err)
+		require.Len(t, relations, 1)
+		hanChar, inverse := relations[0].Other(yue)
+		assert.Equal(t, "日", hanChar.Character)
+		assert.False(t, inverse)
+		assert.Equal(t, "字形相近", relations[0].Note)
+
+		require.NoError(t, repo.DeleteHanCharRelation(ctx, relation.ID))
+		assert.ErrorIs(t, repo.DeleteHanCharRelation(ctx, relation.ID), domainErrors.ErrRelationNotFound)
+
+		// 删除汉字时同时删除其关联
+		require.NoError(t, repo.CreateHanCharRelation(ctx, &entity.HanCharRelation{SourceID: yue, TargetID: ri, Type: entity.LexicalRelationTypeConfusable}))
+		require.NoError(t, hanCharRepo.Delete(ctx, ri))
+		relations, err = repo.ListHanCharRelations(ctx, yue, nil)
+		require.NoError(t, err)
+		assert.Empty(t, relations)
+	})
+}
@@ -0,0 +1,132 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/lazyjean/sla2/internal/domain/entity"
+	"github.com/lazyjean/sla2/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+// memoryReviewRepository 记忆复习记录仓储实现
+type memoryReviewRepository struct {
+	db *gorm.DB
+}
+
+// NewMemoryReviewRepository 创建记忆复习记录仓储实例
+func NewMemoryReviewRepository(db *gorm.DB) repository.MemoryReviewRepository {
+	return &memoryReviewRepository{
+		db: db,
+	}
+}
+
+// Create 创建复习记录
+func (r *memoryReviewRepository) Create(ctx context.Context, review *entity.MemoryReview) error {
+	return dbFromContext(ctx, r.db).Create(review).Error
+}
+
+// ListByUserIDAndTimeRange 获取用户指定时间范围内的复习记录，不含人工调整记录
+func (r *memoryReviewRepository) ListByUserIDAndTimeRange(ctx context.Context, userID uint32, startTime, endTime time.Time) ([]*entity.MemoryReview, error) {
+	var reviews []*entity.MemoryReview
+	err := dbFromContext(ctx, r.db).
+		Where("user_id = ? AND review_time >= ? AND review_time < ?", userID, startTime, endTime).
+		Where("action = ?", entity.ReviewActionReview).
+		Order("review_time ASC, id ASC").
+		Find(&reviews).Error
+	if err != nil {
+		return nil, err
+	}
+	return reviews, nil
+}
+
+// ListByMemoryUnitID 获取记忆单元的复习记录（按复习时间升序，分页）
+func (r *memoryReviewRepository) ListByMemoryUnitID(ctx context.Context, memoryUnitID uint32, offset, limit int) ([]*entity.MemoryReview, error) {
+	var reviews []*entity.MemoryReview
+	err := dbFromContext(ctx, r.db).
+		Where("memory_unit_id = ?", memoryUnitID).
+		Order("review_time ASC, id ASC").
+		Offset(offset).
+		Limit(limit).
+		Find(&reviews).Error
+	if err != nil {
+		return nil, err
+	}
+	return reviews, nil
+}
+
+// CountByMemoryUnitID 计算记忆单元的复习记录总数
+func (r *memoryReviewRepository) CountByMemoryUnitID(ctx context.Context, memoryUnitID uint32) (int64, error) {
+	var count int64
+	err := dbFromContext(ctx, r.db).
+		Model(&entity.MemoryReview{}).
+		Where("memory_unit_id = ?", memoryUnitID).
+		Count(&count).Error
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// GetRetentionStats 按记忆单元类型统计到期复习的回忆情况
+func (r *memoryReviewRepository) GetRetentionStats(ctx context.Context, userID uint32, types []entity.MemoryUnitType, since time.Time) ([]*repository.RetentionStats, error) {
+	var stats []*repository.RetentionStats
+	query := dbFromContext(ctx, r.db).
+		Table("memory_reviews mr").
+		Select("mu.type AS type, COUNT(*) AS review_count, COUNT(*) FILTER (WHERE mr.result = ?) AS correct_count", entity.ReviewResultCorrect).
+		Joins("JOIN memory_units mu ON mu.id = mr.memory_unit_id").
+		Where("mr.user_id = ? AND mr.review_time >= ?", userID, since).
+		Where("mr.result IN ?", []entity.ReviewResult{entity.ReviewResultCorrect, entity.ReviewResultWrong}).
+		Where("mr.interval_before >= ? AND mr.review_time >= mr.scheduled_time", uint32(24*time.Hour/time.Second))
+
+	if len(types) > 0 {
+		query = query.Where("mu.type IN ?", types)
+	}
+
+	if err := query.Group("mu.type").Order("mu.type").Scan(&stats).Error; err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
+// ListIdempotencyKeys 返回 keys 中用户已提交过的幂等键
+func (r *memoryReviewRepository) ListIdempotencyKeys(ctx context.Context, userID uint32, keys []string) ([]string, error) {
+	if len(keys) == 0 {
+		return nil, nil
+	}
+	var existing []string
+	err := dbFromContext(ctx, r.db).
+		Model(&entity.MemoryReview{}).
+		Where("user_id = ? AND idempotency_key IN ?", userID, keys).
+		Pluck("idempotency_key", &existing).Error
+	if err != nil {
+		return nil, err
+	}
+	return existing, nil
+}
+
+// ListReviewedUserIDs 获取自 since 起有复习记录（不含人工调整记录）的用户ID（升序）
+func (r *memoryReviewRepository) ListReviewedUserIDs(ctx context.Context, since time.Time) ([]uint32, error) {
+	var userIDs []uint32
+	err := dbFromContext(ctx, r.db).
+		Model(&entity.MemoryReview{}).
+		Where("review_time >= ? AND action = ?", since, entity.ReviewActionReview).
+		Distinct("user_id").
+		Order("user_id ASC").
+		Pluck("user_id", &userIDs).Error
+	if err != nil {
+		return nil, err
+	}
+	return userIDs, nil
+}
+
+// DeleteBefore 删除指定时间之前的复习记录
+func (r *memoryReviewRepository) DeleteBefore(ctx context.Context, before time.Time) (int64, error) {
+	result := dbFromContext(ctx, r.db).
+		Where("review_time < ?", before).
+		Delete(&entity.MemoryReview{})
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	return result.RowsAffected, nil
+}
@@ -0,0 +1,165 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lazyjean/sla2/internal/domain/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryReviewRepository(t *testing.T) {
+	db, cleanup := SetupTestDB(t)
+	defer cleanup()
+
+	repo := NewMemoryReviewRepository(db)
+	ctx := context.Background()
+	now := time.Now()
+
+	// 创建测试数据：记忆单元 1 有三条复习记录，其中一条超过保留时长
+	reviews := []*entity.MemoryReview{
+		entity.NewMemoryReview(1, 100, entity.ReviewGradeGood, 1200, 0, time.Hour),
+		entity.NewMemoryReview(1, 100, entity.ReviewGradeAgain, 3000, time.Hour, 10*time.Minute),
+		entity.NewMemoryReview(1, 100, entity.ReviewGradeEasy, 800, 10*time.Minute, 24*time.Hour),
+		entity.NewMemoryReview(2, 200, entity.ReviewGradeSkip, 0, 0, 0),
+	}
+	reviews[0].ReviewTime = now.Add(-entity.MemoryReviewRetention - time.Hour)
+	reviews[1].ReviewTime = now.Add(-2 * time.Hour)
+	reviews[2].ReviewTime = now.Add(-time.Hour)
+	for _, review := range reviews {
+		require.NoError(t, repo.Create(ctx, review))
+		assert.NotZero(t, review.ID)
+	}
+
+	t.Run("ListByMemoryUnitID", func(t *testing.T) {
+		count, err := repo.CountByMemoryUnitID(ctx, 1)
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), count)
+
+		got, err := repo.ListByMemoryUnitID(ctx, 1, 1, 10)
+		require.NoError(t, err)
+		require.Len(t, got, 2)
+		assert.Equal(t, entity.ReviewGradeAgain, got[0].Grade)
+		assert.Equal(t, entity.ReviewResultWrong, got[0].Result)
+		assert.Equal(t, uint32(3600), got[0].IntervalBefore)
+		assert.Equal(t, uint32(600), got[0].IntervalAfter)
+		assert.Equal(t, entity.ReviewGradeEasy, got[1].Grade)
+	})
+
+	t.Run("ListByUserIDAndTimeRange", func(t *testing.T) {
+		got, err := repo.ListByUserIDAndTimeRange(ctx, 100, now.Add(-3*time.Hour), now)
+		require.NoError(t, err)
+		require.Len(t, got, 2)
+		assert.Equal(t, reviews[1].ID, got[0].ID)
+		assert.Equal(t, reviews[2].ID, got[1].ID)
+
+		// 人工调整记录不计入复习记录，但保留在记忆单元的复习日志中
+		unit := entity.NewMemoryUnit(100, entity.MemoryUnitTypeWord, 1)
+		unit.ID = 1
+		before := *unit
+		unit.Reset(now)
+		adjustment := entity.NewMemoryAdjustment(entity.ReviewActionReset, &before, unit)
+		adjustment.ReviewTime = now.Add(-30 * time.Minute)
+		require.NoError(t, repo.Create(ctx, adjustment))
+		got, err = repo.ListByUserIDAndTimeRange(ctx, 100, now.Add(-3*time.Hour), now)
+		require.NoError(t, err)
+		assert.Len(t, got, 2)
+		logs, err := repo.ListByMemoryUnitID(ctx, 1, 0, 10)
+		require.NoError(t, err)
+		require.Len(t, logs, 4)
+		assert.Equal(t, entity.ReviewActionReset, logs[3].Action)
+	})
+
+	t.Run("ListIdempotencyKeys", func(t *testing.T) {
+		reviews[1].IdempotencyKey = "offline-1"
+		require.NoError(t, db.Save(reviews[1]).Error)
+
+		// 幂等键在同一用户内唯一，不同用户可以重复
+		dup := entity.NewMemoryReview(1, 100, entity.ReviewGradeGood, 1000, 0, time.Hour)
+		dup.IdempotencyKey = "offline-1"
+		assert.Error(t, repo.Create(ctx, dup))
+		other := entity.NewMemoryReview(2, 200, entity.ReviewGradeGood, 1000, 0, time.Hour)
+		other.IdempotencyKey = "offline-1"
+		require.NoError(t, repo.Create(ctx, other))
+
+		got, err := repo.ListIdempotencyKeys(ctx, 100, []string{"offline-1", "offline-2"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"offline-1"}, got)
+
+		got, err = repo.ListIdempotencyKeys(ctx, 100, nil)
+		require.NoError(t, err)
+		assert.Empty(t, got)
+	})
+
+	t.Run("DeleteBefore", func(t *testing.T) {
+		deleted, err := repo.DeleteBefore(ctx, now.Add(-entity.MemoryReviewRetention))
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), deleted)
+
+		count, err := repo.CountByMemoryUnitID(ctx, 1)
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), count)
+	})
+}
+
+func TestMemoryReviewRepository_GetRetentionStats(t *testing.T) {
+	db, cleanup := SetupTestDB(t)
+	defer cleanup()
+
+	repo := NewMemoryReviewRepository(db)
+	unitRepo := NewMemoryUnitRepository(db)
+	ctx := context.Background()
+	now := time.Now()
+	day := 24 * time.Hour
+
+	hanChar := entity.NewMemoryUnit(1, entity.MemoryUnitTypeHanChar, 100)
+	word := entity.NewMemoryUnit(1, entity.MemoryUnitTypeWord, 200)
+	require.NoError(t, unitRepo.Create(ctx, hanChar))
+	require.NoError(t, unitRepo.Create(ctx, word))
+
+	newReview := func(unit *entity.MemoryUnit, grade entity.ReviewGrade, intervalBefore time.Duration, reviewTime, scheduledTime time.Time) {
+		review := entity.NewMemoryReview(uint32(unit.ID), 1, grade, 1000, intervalBefore, day)
+		review.ReviewTime = reviewTime
+		review.ScheduledTime = scheduledTime
+		require.NoError(t, repo.Create(ctx, review))
+	}
+	// 计入统计的到期复习：汉字一对一错，单词一对
+	newReview(hanChar, entity.ReviewGradeGood, 3*day, now.Add(-time.Hour), now.Add(-2*time.Hour))
+	newReview(hanChar, entity.ReviewGradeAgain, 2*day, now.Add(-10*day), now.Add(-10*day-time.Hour))
+	newReview(word, entity.ReviewGradeEasy, day, now.Add(-time.Hour), now.Add(-time.Hour))
+	// 不计入统计：首次学习、当天重学、提前复习、跳过
+	newReview(hanChar, entity.ReviewGradeGood, 0, now.Add(-time.Hour), now.Add(-time.Hour))
+	newReview(hanChar, entity.ReviewGradeGood, 10*time.Minute, now.Add(-time.Hour), now.Add(-2*time.Hour))
+	newReview(word, entity.ReviewGradeGood, 5*day, now.Add(-time.Hour), now.Add(day))
+	newReview(word, entity.ReviewGradeSkip, 5*day, now.Add(-time.Hour), now.Add(-2*time.Hour))
+
+	stats, err := repo.GetRetentionStats(ctx, 1, nil, now.Add(-30*day))
+	require.NoError(t, err)
+	require.Len(t, stats, 2)
+	assert.Equal(t, entity.MemoryUnitTypeHanChar, stats[0].Type)
+	assert.Equal(t, 2, stats[0].ReviewCount)
+	assert.Equal(t, 1, stats[0].CorrectCount)
+	assert.Equal(t, entity.MemoryUnitTypeWord, stats[1].Type)
+	assert.Equal(t, 1, stats[1].ReviewCount)
+	assert.Equal(t, 1, stats[1].CorrectCount)
+
+	// 窗口之外的复习不计入
+	stats, err = repo.GetRetentionStats(ctx, 1, []entity.MemoryUnitType{entity.MemoryUnitTypeHanChar}, now.Add(-7*day))
+	require.NoError(t, err)
+	require.Len(t, stats, 1)
+	assert.Equal(t, 1, stats[0].ReviewCount)
+	assert.Equal(t, 1, stats[0].CorrectCount)
+
+	// 有复习记录的用户
+	other := entity.NewMemoryReview(uint32(word.ID), 2, entity.ReviewGradeGood, 1000, 0, day)
+	other.ReviewTime = now.Add(-40 * day)
+	require.NoError(t, repo.Create(ctx, other))
+	userIDs, err := repo.ListReviewedUserIDs(ctx, now.Add(-30*day))
+	require.NoError(t, err)
+	assert.Equal(t, []uint32{1}, userIDs)
+	userIDs, err = repo.ListReviewedUserIDs(ctx, now.Add(-60*day))
+	require.NoError(t, err)
+	assert.Equal(t, []uint32{1, 2}, userIDs)
+}
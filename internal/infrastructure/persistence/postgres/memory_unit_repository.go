@@ -24,18 +24,20 @@ func NewMemoryUnitRepository(db *gorm.DB) repository.MemoryUnitRepository {
 
 // Create 创建记忆单元
 func (r *memoryUnitRepository) Create(ctx context.Context, unit *entity.MemoryUnit) error {
-	return r.db.WithContext(ctx).Create(unit).Error
+	return dbFromContext(ctx, r.db).Create(unit).Error
 }
 
 // Update 更新记忆单元
 func (r *memoryUnitRepository) Update(ctx context.Context, unit *entity.MemoryUnit) error {
-	return r.db.WithContext(ctx).Save(unit).Error
+	return dbFromContext(ctx, r.db).Save(unit).Error
 }
 
-// GetByID 根据ID获取记忆单元
-func (r *memoryUnitRepository) GetByID(ctx context.Context, id uint32) (*entity.MemoryUnit, error) {
+// GetByID 根据ID获取用户的记忆单元
+func (r *memoryUnitRepository) GetByID(ctx context.Context, userID entity.UID, id uint32) (*entity.MemoryUnit, error) {
 	var unit entity.MemoryUnit
-	err := r.db.WithContext(ctx).First(&unit, id).Error
+	err := dbFromContext(ctx, r.db).
+		Where("id = ? AND user_id = ?", id, userID).
+		First(&unit).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, nil
@@ -45,11 +47,11 @@ func (r *memoryUnitRepository) GetByID(ctx context.Context, id uint32) (*entity.
 	return &unit, nil
 }
 
-// GetByTypeAndContentID 根据类型和内容ID获取记忆单元
-func (r *memoryUnitRepository) GetByTypeAndContentID(ctx context.Context, unitType entity.MemoryUnitType, contentID uint32) (*entity.MemoryUnit, error) {
+// GetByTypeAndContentID 根据类型和内容ID获取用户的记忆单元
+func (r *memoryUnitRepository) GetByTypeAndContentID(ctx context.Context, userID entity.UID, unitType entity.MemoryUnitType, contentID uint32) (*entity.MemoryUnit, error) {
 	var unit entity.MemoryUnit
-	err := r.db.WithContext(ctx).
-		Where("type = ? AND content_id = ?", unitType, contentID).
+	err := dbFromContext(ctx, r.db).
+		Where("user_id = ? AND type = ? AND content_id = ?", userID, unitType, contentID).
 		First(&unit).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -60,11 +62,11 @@ func (r *memoryUnitRepository) GetByTypeAndContentID(ctx context.Context, unitTy
 	return &unit, nil
 }
 
-// ListNeedReview 获取需要复习的记忆单元列表
-func (r *memoryUnitRepository) ListNeedReview(ctx context.Context, unitType entity.MemoryUnitType, now time.Time, limit int) ([]*entity.MemoryUnit, error) {
+// ListNeedReview 获取用户需要复习的记忆单元列表
+func (r *memoryUnitRepository) ListNeedReview(ctx context.Context, userID entity.UID, unitType entity.MemoryUnitType, now time.Time, limit int) ([]*entity.MemoryUnit, error) {
 	var units []*entity.MemoryUnit
-	err := r.db.WithContext(ctx).
-		Where("type = ? AND next_review_at <= ?", unitType, now).
+	err := dbFromContext(ctx, r.db).
+		Where("user_id = ? AND type = ? AND next_review_at <= ? AND suspended = ?", userID, unitType, now, false).
 		Order("next_review_at ASC").
 		Limit(limit).
 		Find(&units).Error
@@ -75,9 +77,9 @@ func (r *memoryUnitRepository) ListNeedReview(ctx context.Context, unitType enti
 }
 
 // ListByUserID 获取用户的所有记忆单元
-func (r *memoryUnitRepository) ListByUserID(ctx context.Context, userID uint32) ([]*entity.MemoryUnit, error) {
+func (r *memoryUnitRepository) ListByUserID(ctx context.Context, userID entity.UID) ([]*entity.MemoryUnit, error) {
 	var units []*entity.MemoryUnit
-	err := r.db.WithContext(ctx).
+	err := dbFromContext(ctx, r.db).
 		Where("user_id = ?", userID).
 		Find(&units).Error
 	if err != nil {
@@ -87,9 +89,9 @@ func (r *memoryUnitRepository) ListByUserID(ctx context.Context, userID uint32)
 }
 
 // ListByUserIDAndType 获取用户指定类型的所有记忆单元
-func (r *memoryUnitRepository) ListByUserIDAndType(ctx context.Context, userID uint32, unitType entity.MemoryUnitType) ([]*entity.MemoryUnit, error) {
+func (r *memoryUnitRepository) ListByUserIDAndType(ctx context.Context, userID entity.UID, unitType entity.MemoryUnitType) ([]*entity.MemoryUnit, error) {
 	var units []*entity.MemoryUnit
-	err := r.db.WithContext(ctx).
+	err := dbFromContext(ctx, r.db).
 		Where("user_id = ? AND type = ?", userID, unitType).
 		Find(&units).Error
 	if err != nil {
@@ -98,11 +100,28 @@ func (r *memoryUnitRepository) ListByUserIDAndType(ctx context.Context, userID u
 	return units, nil
 }
 
-// ListNeedReviewByTypes 根据类型列表获取需要复习的记忆单元列表（分页）
-func (r *memoryUnitRepository) ListNeedReviewByTypes(ctx context.Context, types []entity.MemoryUnitType, before time.Time, offset uint32, limit int) ([]*entity.MemoryUnit, error) {
+// ListByContentIDs 获取用户指定类型和内容ID的记忆单元
+func (r *memoryUnitRepository) ListByContentIDs(ctx context.Context, userID entity.UID, unitType entity.MemoryUnitType, contentIDs []uint32) ([]*entity.MemoryUnit, error) {
+	if len(contentIDs) == 0 {
+		return nil, nil
+	}
+
+	var units []*entity.MemoryUnit
+	err := dbFromContext(ctx, r.db).
+		Where("user_id = ? AND type = ? AND content_id IN ?", userID, unitType, contentIDs).
+		Order("id ASC").
+		Find(&units).Error
+	if err != nil {
+		return nil, err
+	}
+	return units, nil
+}
+
+// ListNeedReviewByTypes 根据类型列表获取用户需要复习的记忆单元列表（分页）
+func (r *memoryUnitRepository) ListNeedReviewByTypes(ctx context.Context, userID entity.UID, types []entity.MemoryUnitType, before time.Time, offset uint32, limit int) ([]*entity.MemoryUnit, error) {
 	var units []*entity.MemoryUnit
-	query := r.db.WithContext(ctx).
-		Where("next_review_at <= ?", before)
+	query := dbFromContext(ctx, r.db).
+		Where("user_id = ? AND next_review_at <= ? AND suspended = ?", userID, before, false)
 
 	if len(types) > 0 {
 		query = query.Where("type IN ?", types)
@@ -119,12 +138,12 @@ func (r *memoryUnitRepository) ListNeedReviewByTypes(ctx context.Context, types
 	return units, nil
 }
 
-// CountNeedReviewByTypes 根据类型列表计算需要复习的记忆单元总数
-func (r *memoryUnitRepository) CountNeedReviewByTypes(ctx context.Context, types []entity.MemoryUnitType, before time.Time) (int64, error) {
+// CountNeedReviewByTypes 根据类型列表计算用户需要复习的记忆单元总数
+func (r *memoryUnitRepository) CountNeedReviewByTypes(ctx context.Context, userID entity.UID, types []entity.MemoryUnitType, before time.Time) (int64, error) {
 	var count int64
-	query := r.db.WithContext(ctx).
+	query := dbFromContext(ctx, r.db).
 		Model(&entity.MemoryUnit{}).
-		Where("next_review_at <= ?", before)
+		Where("user_id = ? AND next_review_at <= ? AND suspended = ?", userID, before, false)
 
 	if len(types) > 0 {
 		query = query.Where("type IN ?", types)
@@ -137,60 +156,157 @@ func (r *memoryUnitRepository) CountNeedReviewByTypes(ctx context.Context, types
 	return count, nil
 }
 
-// GetStats 获取统计信息
-func (r *memoryUnitRepository) GetStats(ctx context.Context, userID entity.UID, unitType entity.MemoryUnitType) (*repository.MemoryUnitStats, error) {
-	var stats repository.MemoryUnitStats
-	var totalCount, masteredCount, learningCount, newCount int64
-
-	// 获取总数
-	if err := r.db.WithContext(ctx).
+// ListReviewSchedules 获取用户在 before 之前到期的记忆单元的复习安排
+func (r *memoryUnitRepository) ListReviewSchedules(ctx context.Context, userID entity.UID, types []entity.MemoryUnitType, before time.Time) ([]*repository.ReviewSchedule, error) {
+	var schedules []*repository.ReviewSchedule
+	query := dbFromContext(ctx, r.db).
 		Model(&entity.MemoryUnit{}).
-		Where("user_id = ? AND type = ?", userID, unitType).
-		Count(&totalCount).Error; err != nil {
+		Select("type, next_review_at").
+		Where("user_id = ? AND next_review_at < ? AND suspended = ?", userID, before, false)
+
+	if len(types) > 0 {
+		query = query.Where("type IN ?", types)
+	}
+
+	if err := query.Order("next_review_at ASC").Scan(&schedules).Error; err != nil {
 		return nil, err
 	}
-	stats.TotalCount = int(totalCount)
+	return schedules, nil
+}
 
-	// 获取已掌握数量（掌握和精通）
-	if err := r.db.WithContext(ctx).
-		Model(&entity.MemoryUnit{}).
-		Where("user_id = ? AND type = ? AND mastery_level IN ?", userID, unitType, []entity.MasteryLevel{entity.MasteryLevelMastered, entity.MasteryLevelExpert}).
-		Count(&masteredCount).Error; err != nil {
+// ListLeeches 获取用户的顽固项（分页）
+func (r *memoryUnitRepository) ListLeeches(ctx context.Context, userID entity.UID, types []entity.MemoryUnitType, offset uint32, limit int) ([]*entity.MemoryUnit, error) {
+	var units []*entity.MemoryUnit
+	query := dbFromContext(ctx, r.db).
+		Where("user_id = ? AND is_leech = ?", userID, true)
+
+	if len(types) > 0 {
+		query = query.Where("type IN ?", types)
+	}
+
+	err := query.Order("lapses DESC, id ASC").
+		Offset(int(offset)).
+		Limit(limit).
+		Find(&units).Error
+	if err != nil {
 		return nil, err
 	}
-	stats.MasteredCount = int(masteredCount)
+	return units, nil
+}
 
-	// 获取学习中数量（初学和熟悉）
-	if err := r.db.WithContext(ctx).
+// CountLeeches 计算用户的顽固项总数
+func (r *memoryUnitRepository) CountLeeches(ctx context.Context, userID entity.UID, types []entity.MemoryUnitType) (int64, error) {
+	var count int64
+	query := dbFromContext(ctx, r.db).
 		Model(&entity.MemoryUnit{}).
-		Where("user_id = ? AND type = ? AND mastery_level IN ?", userID, unitType, []entity.MasteryLevel{entity.MasteryLevelBeginner, entity.MasteryLevelFamiliar}).
-		Count(&learningCount).Error; err != nil {
+		Where("user_id = ? AND is_leech = ?", userID, true)
+
+	if len(types) > 0 {
+		query = query.Where("type IN ?", types)
+	}
+
+	if err := query.Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// ListLeechContents 统计所有用户中被标记为顽固项次数最多的学习内容
+func (r *memoryUnitRepository) ListLeechContents(ctx context.Context, types []entity.MemoryUnitType, limit int) ([]*repository.LeechContentStats, error) {
+	var stats []*repository.LeechContentStats
+	query := dbFromContext(ctx, r.db).
+		Model(&entity.MemoryUnit{}).
+		Select("type, content_id, COUNT(*) AS leech_count, SUM(lapses) AS total_lapses").
+		Where("is_leech = ?", true)
+
+	if len(types) > 0 {
+		query = query.Where("type IN ?", types)
+	}
+
+	err := query.Group("type, content_id").
+		Order("leech_count DESC, total_lapses DESC, type ASC, content_id ASC").
+		Limit(limit).
+		Scan(&stats).Error
+	if err != nil {
 		return nil, err
 	}
-	stats.LearningCount = int(learningCount)
+	return stats, nil
+}
+
+// CountCreatedSince 计算用户在指定时间之后新建的记忆单元数量
+func (r *memoryUnitRepository) CountCreatedSince(ctx context.Context, userID entity.UID, since time.Time) (int64, error) {
+	var count int64
+	err := dbFromContext(ctx, r.db).
+		Model(&entity.MemoryUnit{}).
+		Where("user_id = ? AND created_at >= ?", userID, since).
+		Count(&count).Error
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
 
-	// 获取新内容数量（未学习）
-	if err := r.db.WithContext(ctx).
+// ListCreatedTimes 获取用户在 [start, end) 内新建的记忆单元的创建时间（按时间升序）
+func (r *memoryUnitRepository) ListCreatedTimes(ctx context.Context, userID entity.UID, start, end time.Time) ([]time.Time, error) {
+	var times []time.Time
+	err := dbFromContext(ctx, r.db).
 		Model(&entity.MemoryUnit{}).
-		Where("user_id = ? AND type = ? AND mastery_level = ?", userID, unitType, entity.MasteryLevelUnlearned).
-		Count(&newCount).Error; err != nil {
+		Where("user_id = ? AND created_at >= ? AND created_at < ?", userID, start, end).
+		Order("created_at ASC").
+		Pluck("created_at", &times).Error
+	if err != nil {
 		return nil, err
 	}
-	stats.NewCount = int(newCount)
+	return times, nil
+}
 
-	// 计算掌握率
-	if stats.TotalCount > 0 {
-		stats.MasteryRate = float64(stats.MasteredCount) / float64(stats.TotalCount)
+// memoryUnitStatsRow 按类型和掌握程度分组的统计行
+type memoryUnitStatsRow struct {
+	Type          entity.MemoryUnitType
+	MasteryLevel  entity.MasteryLevel
+	Count         int
+	StudyDuration uint64
+}
+
+// GetStats 获取统计信息
+// 按类型和掌握程度分组一次查询，再汇总各项数量
+func (r *memoryUnitRepository) GetStats(ctx context.Context, userID entity.UID, types []entity.MemoryUnitType) (*repository.MemoryUnitStats, error) {
+	var rows []memoryUnitStatsRow
+	query := dbFromContext(ctx, r.db).
+		Model(&entity.MemoryUnit{}).
+		Select("type, mastery_level, COUNT(*) AS count, COALESCE(SUM(study_duration), 0) AS study_duration").
+		Where("user_id = ?", userID)
+
+	if len(types) > 0 {
+		query = query.Where("type IN ?", types)
 	}
 
-	// 计算记忆保持率（这里使用一个简单的公式：掌握率 + 学习中的比例）
-	if stats.TotalCount > 0 {
-		stats.RetentionRate = float64(stats.MasteredCount+stats.LearningCount) / float64(stats.TotalCount)
+	if err := query.Group("type, mastery_level").Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	stats := &repository.MemoryUnitStats{
+		LevelCounts: make(map[entity.MasteryLevel]int),
+		TypeCounts:  make(map[entity.MemoryUnitType]int),
 	}
+	for _, row := range rows {
+		stats.TotalCount += row.Count
+		stats.TotalStudyTime += row.StudyDuration
+		stats.LevelCounts[row.MasteryLevel] += row.Count
+		stats.TypeCounts[row.Type] += row.Count
 
-	// 设置每日目标（这里使用固定值，实际应用中可能需要根据用户学习情况动态调整）
-	stats.DailyReviewGoal = 20
-	stats.DailyNewGoal = 10
+		switch row.MasteryLevel {
+		case entity.MasteryLevelMastered, entity.MasteryLevelExpert:
+			stats.MasteredCount += row.Count
+		case entity.MasteryLevelBeginner, entity.MasteryLevelFamiliar:
+			stats.LearningCount += row.Count
+		case entity.MasteryLevelUnlearned:
+			stats.NewCount += row.Count
+		}
+	}
 
-	return &stats, nil
+	if stats.TotalCount > 0 {
+		stats.MasteryRate = float64(stats.MasteredCount) / float64(stats.TotalCount)
+	}
+	return stats, nil
 }
@@ -0,0 +1,200 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lazyjean/sla2/internal/domain/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryUnitRepository_UserIsolation(t *testing.T) {
+	db, cleanup := SetupTestDB(t)
+	defer cleanup()
+
+	repo := NewMemoryUnitRepository(db)
+	ctx := context.Background()
+	user1, user2 := entity.UID(1), entity.UID(2)
+	past := time.Now().Add(-time.Hour)
+
+	// 创建测试数据：两个用户学习同一个单词，用户1另外学习一个汉字
+	unit1 := entity.NewMemoryUnit(user1, entity.MemoryUnitTypeWord, 100)
+	unit1.NextReviewAt = past
+	unit2 := entity.NewMemoryUnit(user2, entity.MemoryUnitTypeWord, 100)
+	unit2.NextReviewAt = past
+	unit3 := entity.NewMemoryUnit(user1, entity.MemoryUnitTypeHanChar, 200)
+	unit3.NextReviewAt = past
+	for _, unit := range []*entity.MemoryUnit{unit1, unit2, unit3} {
+		require.NoError(t, repo.Create(ctx, unit))
+	}
+
+	t.Run("GetByID", func(t *testing.T) {
+		got, err := repo.GetByID(ctx, user1, uint32(unit1.ID))
+		require.NoError(t, err)
+		require.NotNil(t, got)
+		assert.Equal(t, unit1.ID, got.ID)
+
+		// 其他用户的记忆单元视为不存在
+		got, err = repo.GetByID(ctx, user2, uint32(unit1.ID))
+		require.NoError(t, err)
+		assert.Nil(t, got)
+	})
+
+	t.Run("GetByTypeAndContentID", func(t *testing.T) {
+		got, err := repo.GetByTypeAndContentID(ctx, user1, entity.MemoryUnitTypeWord, 100)
+		require.NoError(t, err)
+		require.NotNil(t, got)
+		assert.Equal(t, unit1.ID, got.ID)
+
+		got, err = repo.GetByTypeAndContentID(ctx, user2, entity.MemoryUnitTypeWord, 100)
+		require.NoError(t, err)
+		require.NotNil(t, got)
+		assert.Equal(t, unit2.ID, got.ID)
+
+		got, err = repo.GetByTypeAndContentID(ctx, user2, entity.MemoryUnitTypeHanChar, 200)
+		require.NoError(t, err)
+		assert.Nil(t, got)
+	})
+
+	t.Run("ListNeedReview", func(t *testing.T) {
+		units, err := repo.ListNeedReview(ctx, user2, entity.MemoryUnitTypeWord, time.Now(), 10)
+		require.NoError(t, err)
+		require.Len(t, units, 1)
+		assert.Equal(t, unit2.ID, units[0].ID)
+	})
+
+	t.Run("ListNeedReviewByTypes", func(t *testing.T) {
+		units, err := repo.ListNeedReviewByTypes(ctx, user1, nil, time.Now(), 0, 10)
+		require.NoError(t, err)
+		assert.Len(t, units, 2)
+		for _, unit := range units {
+			assert.Equal(t, user1, unit.UserID)
+		}
+
+		count, err := repo.CountNeedReviewByTypes(ctx, user1, nil, time.Now())
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), count)
+
+		count, err = repo.CountNeedReviewByTypes(ctx, user2, []entity.MemoryUnitType{entity.MemoryUnitTypeHanChar}, time.Now())
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), count)
+	})
+}
+
+func TestMemoryUnitRepository_Leeches(t *testing.T) {
+	db, cleanup := SetupTestDB(t)
+	defer cleanup()
+
+	repo := NewMemoryUnitRepository(db)
+	ctx := context.Background()
+	past := time.Now().Add(-time.Hour)
+
+	// 用户1：汉字200已暂停，汉字201仅标记；用户2：汉字200仅标记
+	suspended := entity.NewMemoryUnit(1, entity.MemoryUnitTypeHanChar, 200)
+	suspended.Lapses, suspended.IsLeech, suspended.Suspended = 9, true, true
+	tagged := entity.NewMemoryUnit(1, entity.MemoryUnitTypeHanChar, 201)
+	tagged.Lapses, tagged.IsLeech = 8, true
+	other := entity.NewMemoryUnit(2, entity.MemoryUnitTypeHanChar, 200)
+	other.Lapses, other.IsLeech = 10, true
+	normal := entity.NewMemoryUnit(1, entity.MemoryUnitTypeWord, 100)
+	for _, unit := range []*entity.MemoryUnit{suspended, tagged, other, normal} {
+		unit.NextReviewAt = past
+		require.NoError(t, repo.Create(ctx, unit))
+	}
+
+	t.Run("ExcludeSuspended", func(t *testing.T) {
+		units, err := repo.ListNeedReviewByTypes(ctx, 1, nil, time.Now(), 0, 10)
+		require.NoError(t, err)
+		assert.Len(t, units, 2)
+		for _, unit := range units {
+			assert.NotEqual(t, suspended.ID, unit.ID)
+		}
+
+		count, err := repo.CountNeedReviewByTypes(ctx, 1, nil, time.Now())
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), count)
+
+		units, err = repo.ListNeedReview(ctx, 1, entity.MemoryUnitTypeHanChar, time.Now(), 10)
+		require.NoError(t, err)
+		require.Len(t, units, 1)
+		assert.Equal(t, tagged.ID, units[0].ID)
+
+		schedules, err := repo.ListReviewSchedules(ctx, 1, []entity.MemoryUnitType{entity.MemoryUnitTypeHanChar}, time.Now())
+		require.NoError(t, err)
+		require.Len(t, schedules, 1)
+		assert.Equal(t, entity.MemoryUnitTypeHanChar, schedules[0].Type)
+		assert.WithinDuration(t, past, schedules[0].NextReviewAt, time.Second)
+	})
+
+	t.Run("ListLeeches", func(t *testing.T) {
+		units, err := repo.ListLeeches(ctx, 1, nil, 0, 10)
+		require.NoError(t, err)
+		require.Len(t, units, 2)
+		assert.Equal(t, suspended.ID, units[0].ID)
+		assert.Equal(t, tagged.ID, units[1].ID)
+
+		count, err := repo.CountLeeches(ctx, 1, []entity.MemoryUnitType{entity.MemoryUnitTypeWord})
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), count)
+	})
+
+	t.Run("ListLeechContents", func(t *testing.T) {
+		stats, err := repo.ListLeechContents(ctx, nil, 10)
+		require.NoError(t, err)
+		require.Len(t, stats, 2)
+		assert.Equal(t, uint32(200), stats[0].ContentID)
+		assert.Equal(t, entity.MemoryUnitTypeHanChar, stats[0].Type)
+		assert.Equal(t, 2, stats[0].LeechCount)
+		assert.Equal(t, 19, stats[0].TotalLapses)
+		assert.Equal(t, uint32(201), stats[1].ContentID)
+	})
+}
+
+func TestMemoryUnitRepository_GetStats(t *testing.T) {
+	db, cleanup := SetupTestDB(t)
+	defer cleanup()
+
+	repo := NewMemoryUnitRepository(db)
+	ctx := context.Background()
+
+	units := []*entity.MemoryUnit{
+		entity.NewMemoryUnit(1, entity.MemoryUnitTypeHanChar, 100),
+		entity.NewMemoryUnit(1, entity.MemoryUnitTypeHanChar, 101),
+		entity.NewMemoryUnit(1, entity.MemoryUnitTypeWord, 200),
+		entity.NewMemoryUnit(2, entity.MemoryUnitTypeWord, 200),
+	}
+	units[0].MasteryLevel, units[0].StudyDuration = entity.MasteryLevelExpert, 30
+	units[1].MasteryLevel, units[1].StudyDuration = entity.MasteryLevelBeginner, 20
+	units[2].MasteryLevel, units[2].StudyDuration = entity.MasteryLevelFamiliar, 10
+	units[3].MasteryLevel, units[3].StudyDuration = entity.MasteryLevelMastered, 99
+	for _, unit := range units {
+		require.NoError(t, repo.Create(ctx, unit))
+	}
+
+	// 汇总全部类型
+	stats, err := repo.GetStats(ctx, 1, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 3, stats.TotalCount)
+	assert.Equal(t, 1, stats.MasteredCount)
+	assert.Equal(t, 2, stats.LearningCount)
+	assert.Zero(t, stats.NewCount)
+	assert.Equal(t, uint64(60), stats.TotalStudyTime)
+	assert.Equal(t, map[entity.MasteryLevel]int{
+		entity.MasteryLevelExpert:   1,
+		entity.MasteryLevelBeginner: 1,
+		entity.MasteryLevelFamiliar: 1,
+	}, stats.LevelCounts)
+	assert.Equal(t, map[entity.MemoryUnitType]int{
+		entity.MemoryUnitTypeHanChar: 2,
+		entity.MemoryUnitTypeWord:    1,
+	}, stats.TypeCounts)
+	assert.InDelta(t, 1.0/3, stats.MasteryRate, 1e-9)
+
+	// 指定类型
+	stats, err = repo.GetStats(ctx, 1, []entity.MemoryUnitType{entity.MemoryUnitTypeWord})
+	require.NoError(t, err)
+	assert.Equal(t, 1, stats.TotalCount)
+	assert.Equal(t, uint64(10), stats.TotalStudyTime)
+}
@@ -0,0 +1,67 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/lazyjean/sla2/internal/domain/entity"
+	"github.com/lazyjean/sla2/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+// phraseRepository PostgreSQL 短语仓储实现
+type phraseRepository struct {
+	db *gorm.DB
+}
+
+// NewPhraseRepository 创建短语仓储实例
+func NewPhraseRepository(db *gorm.DB) repository.PhraseRepository {
+	return &phraseRepository{
+		db: db,
+	}
+}
+
+// Create 创建短语
+func (r *phraseRepository) Create(ctx context.Context, phrase *entity.Phrase) error {
+	return dbFromContext(ctx, r.db).Create(phrase).Error
+}
+
+// Update 更新短语
+func (r *phraseRepository) Update(ctx context.Context, phrase *entity.Phrase) error {
+	return dbFromContext(ctx, r.db).Save(phrase).Error
+}
+
+// Delete 删除短语
+func (r *phraseRepository) Delete(ctx context.Context, id entity.PhraseID) error {
+	return dbFromContext(ctx, r.db).Delete(&entity.Phrase{}, id).Error
+}
+
+// GetByID 根据ID获取短语
+func (r *phraseRepository) GetByID(ctx context.Context, id entity.PhraseID) (*entity.Phrase, error) {
+	var phrase entity.Phrase
+	err := dbFromContext(ctx, r.db).First(&phrase, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &phrase, nil
+}
+
+// List 分页获取短语列表
+func (r *phraseRepository) List(ctx context.Context, offset, limit int) ([]*entity.Phrase, int64, error) {
+	var total int64
+	query := dbFromContext(ctx, r.db).Model(&entity.Phrase{})
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var items []*entity.Phrase
+	if err := query.Order("id ASC").Offset(offset).Limit(limit).Find(&items).Error; err != nil {
+		return nil, 0, err
+	}
+	return items, total, nil
+}
+
+var _ repository.PhraseRepository = (*phraseRepository)(nil)
@@ -0,0 +1,67 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/lazyjean/sla2/internal/domain/entity"
+	"github.com/lazyjean/sla2/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+// sentenceRepository PostgreSQL 例句仓储实现
+type sentenceRepository struct {
+	db *gorm.DB
+}
+
+// NewSentenceRepository 创建例句仓储实例
+func NewSentenceRepository(db *gorm.DB) repository.SentenceRepository {
+	return &sentenceRepository{
+		db: db,
+	}
+}
+
+// Create 创建例句
+func (r *sentenceRepository) Create(ctx context.Context, sentence *entity.Sentence) error {
+	return dbFromContext(ctx, r.db).Create(sentence).Error
+}
+
+// Update 更新例句
+func (r *sentenceRepository) Update(ctx context.Context, sentence *entity.Sentence) error {
+	return dbFromContext(ctx, r.db).Save(sentence).Error
+}
+
+// Delete 删除例句
+func (r *sentenceRepository) Delete(ctx context.Context, id entity.SentenceID) error {
+	return dbFromContext(ctx, r.db).Delete(&entity.Sentence{}, id).Error
+}
+
+// GetByID 根据ID获取例句
+func (r *sentenceRepository) GetByID(ctx context.Context, id entity.SentenceID) (*entity.Sentence, error) {
+	var sentence entity.Sentence
+	err := dbFromContext(ctx, r.db).First(&sentence, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &sentence, nil
+}
+
+// List 分页获取例句列表
+func (r *sentenceRepository) List(ctx context.Context, offset, limit int) ([]*entity.Sentence, int64, error) {
+	var total int64
+	query := dbFromContext(ctx, r.db).Model(&entity.Sentence{})
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var items []*entity.Sentence
+	if err := query.Order("id ASC").Offset(offset).Limit(limit).Find(&items).Error; err != nil {
+		return nil, 0, err
+	}
+	return items, total, nil
+}
+
+var _ repository.SentenceRepository = (*sentenceRepository)(nil)
@@ -0,0 +1,42 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/lazyjean/sla2/internal/domain/entity"
+	"github.com/lazyjean/sla2/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+// studySessionRepository 学习会话仓储实现
+type studySessionRepository struct {
+	db *gorm.DB
+}
+
+// NewStudySessionRepository 创建学习会话仓储实例
+func NewStudySessionRepository(db *gorm.DB) repository.StudySessionRepository {
+	return &studySessionRepository{
+		db: db,
+	}
+}
+
+// Create 创建学习会话
+func (r *studySessionRepository) Create(ctx context.Context, session *entity.StudySession) error {
+	return dbFromContext(ctx, r.db).Create(session).Error
+}
+
+// GetByID 根据ID获取用户的学习会话
+func (r *studySessionRepository) GetByID(ctx context.Context, userID entity.UID, id entity.StudySessionID) (*entity.StudySession, error) {
+	var session entity.StudySession
+	err := dbFromContext(ctx, r.db).
+		Where("id = ? AND user_id = ?", id, userID).
+		First(&session).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &session, nil
+}
@@ -0,0 +1,33 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lazyjean/sla2/internal/domain/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStudySessionRepository(t *testing.T) {
+	db, cleanup := SetupTestDB(t)
+	defer cleanup()
+
+	repo := NewStudySessionRepository(db)
+	ctx := context.Background()
+
+	session := entity.NewStudySession(1, 5, 3)
+	require.NoError(t, repo.Create(ctx, session))
+	require.NotZero(t, session.ID)
+
+	got, err := repo.GetByID(ctx, 1, session.ID)
+	require.NoError(t, err)
+	require.NotNil(t, got)
+	assert.Equal(t, uint32(5), got.ReviewCount)
+	assert.Equal(t, uint32(3), got.NewCount)
+
+	// 其他用户的学习会话视为不存在
+	got, err = repo.GetByID(ctx, 2, session.ID)
+	require.NoError(t, err)
+	assert.Nil(t, got)
+}
@@ -34,6 +34,20 @@ func SetupTestDB(t *testing.T) (*gorm.DB, func()) {
 		&entity.CourseSectionProgress{},
 		&entity.CourseSectionUnitProgress{},
 		&entity.HanChar{},
+		&entity.HanCharLearning{},
+		&entity.HanCharStrokes{},
+		&entity.Phrase{},
+		&entity.Sentence{},
+		&entity.GrammarPoint{},
+		&entity.MemoryUnit{},
+		&entity.MemoryReview{},
+		&entity.StudySession{},
+		&entity.UserSettings{},
+		&entity.WordNotebook{},
+		&entity.WordNotebookEntry{},
+		&entity.WordForm{},
+		&entity.WordRelation{},
+		&entity.HanCharRelation{},
 	)
 	require.NoError(t, err)
 
@@ -64,6 +78,20 @@ func cleanTestData(t *testing.T, db *gorm.DB) {
 		"course_section_progresses",
 		"course_section_unit_progresses",
 		"han_chars",
+		"han_char_learnings",
+		"han_char_strokes",
+		"phrases",
+		"sentences",
+		"grammar_points",
+		"memory_units",
+		"memory_reviews",
+		"study_sessions",
+		"user_settings",
+		"word_notebooks",
+		"word_notebook_entries",
+		"word_forms",
+		"word_relations",
+		"han_char_relations",
 	}
 
 	for _, table := range tables {
@@ -0,0 +1,38 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/lazyjean/sla2/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+// txContextKey 事务在 context 中的键
+type txContextKey struct{}
+
+// transactionManager 基于 GORM 的事务管理器
+type transactionManager struct {
+	db *gorm.DB
+}
+
+// NewTransactionManager 创建事务管理器
+func NewTransactionManager(db *gorm.DB) repository.TransactionManager {
+	return &transactionManager{
+		db: db,
+	}
+}
+
+// WithinTransaction 在事务中执行 fn，fn 返回错误时回滚事务
+func (m *transactionManager) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return m.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(context.WithValue(ctx, txContextKey{}, tx))
+	})
+}
+
+// dbFromContext 获取 ctx 中的事务，不在事务中时使用 db
+func dbFromContext(ctx context.Context, db *gorm.DB) *gorm.DB {
+	if tx, ok := ctx.Value(txContextKey{}).(*gorm.DB); ok {
+		return tx.WithContext(ctx)
+	}
+	return db.WithContext(ctx)
+}
@@ -0,0 +1,53 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/lazyjean/sla2/internal/domain/entity"
+	"github.com/lazyjean/sla2/internal/domain/repository"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// userSettingsRepository 用户学习偏好仓储实现
+type userSettingsRepository struct {
+	db *gorm.DB
+}
+
+// NewUserSettingsRepository 创建用户学习偏好仓储实例
+func NewUserSettingsRepository(db *gorm.DB) repository.UserSettingsRepository {
+	return &userSettingsRepository{
+		db: db,
+	}
+}
+
+// GetByUserID 获取用户学习偏好，用户未设置时返回默认偏好
+func (r *userSettingsRepository) GetByUserID(ctx context.Context, userID entity.UID) (*entity.UserSettings, error) {
+	var settings entity.UserSettings
+	err := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		First(&settings).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return entity.NewUserSettings(userID), nil
+		}
+		return nil, err
+	}
+	return &settings, nil
+}
+
+// Save 保存用户学习偏好，不存在时创建
+func (r *userSettingsRepository) Save(ctx context.Context, settings *entity.UserSettings) error {
+	settings.UpdatedAt = time.Now()
+	if settings.CreatedAt.IsZero() {
+		settings.CreatedAt = settings.UpdatedAt
+	}
+	return r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "user_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"daily_review_goal", "daily_new_goal", "desired_retention", "maximum_interval", "day_start_hour", "time_zone", "preferred_unit_types", "leech_threshold", "leech_action", "streak_freeze_days", "scheduler_weights", "updated_at"}),
+		}).
+		Create(settings).Error
+}
@@ -0,0 +1,70 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/lazyjean/sla2/internal/domain/entity"
+	"github.com/lazyjean/sla2/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+// wordFormRepository 单词词形仓储实现
+type wordFormRepository struct {
+	db *gorm.DB
+}
+
+// NewWordFormRepository 创建单词词形仓储实例
+func NewWordFormRepository(db *gorm.DB) repository.WordFormRepository {
+	return &wordFormRepository{
+		db: db,
+	}
+}
+
+// ReplaceByWordID 用新的词形替换单词已有的全部词形
+func (r *wordFormRepository) ReplaceByWordID(ctx context.Context, wordID entity.WordID, forms []*entity.WordForm) error {
+	return dbFromContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("word_id = ?", wordID).Delete(&entity.WordForm{}).Error; err != nil {
+			return err
+		}
+		if len(forms) == 0 {
+			return nil
+		}
+		return tx.Omit("Word").Create(forms).Error
+	})
+}
+
+// ListByWordIDs 获取单词的词形
+func (r *wordFormRepository) ListByWordIDs(ctx context.Context, wordIDs []entity.WordID) (map[entity.WordID][]*entity.WordForm, error) {
+	grouped := make(map[entity.WordID][]*entity.WordForm, len(wordIDs))
+	if len(wordIDs) == 0 {
+		return grouped, nil
+	}
+
+	var forms []*entity.WordForm
+	err := dbFromContext(ctx, r.db).
+		Where("word_id IN ?", wordIDs).
+		Order("id ASC").
+		Find(&forms).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, form := range forms {
+		grouped[form.WordID] = append(grouped[form.WordID], form)
+	}
+	return grouped, nil
+}
+
+// ListByForm 获取与词形相同的所有词形记录
+func (r *wordFormRepository) ListByForm(ctx context.Context, form string) ([]*entity.WordForm, error) {
+	var forms []*entity.WordForm
+	err := dbFromContext(ctx, r.db).
+		Where("form = ?", entity.NormalizeWordForm(form)).
+		Order("word_id ASC").
+		Find(&forms).Error
+	if err != nil {
+		return nil, err
+	}
+	return forms, nil
+}
+
+var _ repository.WordFormRepository = (*wordFormRepository)(nil)
@@ -0,0 +1,61 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lazyjean/sla2/internal/domain/entity"
+	"github.com/lazyjean/sla2/internal/domain/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWordFormRepository(t *testing.T) {
+	db, cleanup := SetupTestDB(t)
+	defer cleanup()
+
+	repo := NewWordFormRepository(db)
+	wordRepo := NewWordRepository(db)
+	ctx := context.Background()
+
+	leave := &entity.Word{Text: "leave", Definitions: []entity.Definition{{Meaning: "离开"}}}
+	left := &entity.Word{Text: "left", Definitions: []entity.Definition{{Meaning: "左边的"}}}
+	require.NoError(t, wordRepo.Create(ctx, leave))
+	require.NoError(t, wordRepo.Create(ctx, left))
+
+	require.NoError(t, repo.ReplaceByWordID(ctx, leave.ID, []*entity.WordForm{
+		{WordID: leave.ID, Form: "leaves", Kind: entity.WordFormKindThirdPersonSingular},
+		{WordID: leave.ID, Form: "leaving", Kind: entity.WordFormKindPresentParticiple},
+	}))
+	// 重新导入时覆盖已有的词形
+	require.NoError(t, repo.ReplaceByWordID(ctx, leave.ID, []*entity.WordForm{
+		{WordID: leave.ID, Form: "left", Kind: entity.WordFormKindPast},
+		{WordID: leave.ID, Form: "leaving", Kind: entity.WordFormKindPresentParticiple},
+	}))
+
+	forms, err := repo.ListByWordIDs(ctx, []entity.WordID{leave.ID, left.ID})
+	require.NoError(t, err)
+	require.Len(t, forms[leave.ID], 2)
+	assert.Empty(t, forms[left.ID])
+
+	matched, err := repo.ListByForm(ctx, "Left")
+	require.NoError(t, err)
+	require.Len(t, matched, 1)
+	assert.Equal(t, leave.ID, matched[0].WordID)
+	assert.Equal(t, entity.WordFormKindPast, matched[0].Kind)
+
+	t.Run("按词形检索原形", func(t *testing.T) {
+		hits, total, err := wordRepo.FullTextSearch(ctx, &repository.WordSearchQuery{Keyword: "leaving", Limit: 10})
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), total)
+		require.Len(t, hits, 1)
+		assert.Equal(t, "leave", hits[0].Word.Text)
+	})
+
+	t.Run("删除单词时删除词形", func(t *testing.T) {
+		require.NoError(t, wordRepo.Delete(ctx, leave.ID))
+		matched, err := repo.ListByForm(ctx, "leaving")
+		require.NoError(t, err)
+		assert.Empty(t, matched)
+	})
+}
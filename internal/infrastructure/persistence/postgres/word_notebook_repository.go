@@ -0,0 +1,231 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/lazyjean/sla2/internal/domain/entity"
+	"github.com/lazyjean/sla2/internal/domain/repository"
+	"github.com/lazyjean/sla2/internal/domain/valueobject"
+	"gorm.io/gorm"
+)
+
+// wordNotebookEntryText 词条的单词，词典单词取 words.text，自定义词条取 word_notebook_entries.text
+const wordNotebookEntryText = "lower(COALESCE(words.text, word_notebook_entries.text))"
+
+// wordNotebookRepository 生词本仓储实现
+type wordNotebookRepository struct {
+	db *gorm.DB
+}
+
+// NewWordNotebookRepository 创建生词本仓储实例
+func NewWordNotebookRepository(db *gorm.DB) repository.WordNotebookRepository {
+	return &wordNotebookRepository{
+		db: db,
+	}
+}
+
+// CreateNotebook 创建生词本
+func (r *wordNotebookRepository) CreateNotebook(ctx context.Context, notebook *entity.WordNotebook) error {
+	return dbFromContext(ctx, r.db).Create(notebook).Error
+}
+
+// UpdateNotebook 更新生词本
+func (r *wordNotebookRepository) UpdateNotebook(ctx context.Context, notebook *entity.WordNotebook) error {
+	return dbFromContext(ctx, r.db).Save(notebook).Error
+}
+
+// DeleteNotebook 删除生词本及其中的词条，以及自定义词条的记忆单元和复习记录
+func (r *wordNotebookRepository) DeleteNotebook(ctx context.Context, id entity.WordNotebookID) error {
+	return dbFromContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		customIDs := tx.Model(&entity.WordNotebookEntry{}).Select("id").Where("notebook_id = ? AND word_id IS NULL", id)
+		if err := r.deleteCustomWordUnits(tx, customIDs); err != nil {
+			return err
+		}
+		if err := tx.Where("notebook_id = ?", id).Delete(&entity.WordNotebookEntry{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&entity.WordNotebook{}, id).Error
+	})
+}
+
+// GetNotebook 获取用户的生词本
+func (r *wordNotebookRepository) GetNotebook(ctx context.Context, userID entity.UID, id entity.WordNotebookID) (*entity.WordNotebook, error) {
+	var notebook entity.WordNotebook
+	err := dbFromContext(ctx, r.db).
+		Where("id = ? AND user_id = ?", id, userID).
+		First(&notebook).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &notebook, nil
+}
+
+// GetNotebookByName 按名称获取用户的生词本
+func (r *wordNotebookRepository) GetNotebookByName(ctx context.Context, userID entity.UID, name string) (*entity.WordNotebook, error) {
+	var notebook entity.WordNotebook
+	err := dbFromContext(ctx, r.db).
+		Where("user_id = ? AND name = ?", userID, name).
+		First(&notebook).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &notebook, nil
+}
+
+// ListNotebooks 获取用户的所有生词本
+func (r *wordNotebookRepository) ListNotebooks(ctx context.Context, userID entity.UID) ([]*entity.WordNotebook, error) {
+	var notebooks []*entity.WordNotebook
+	err := dbFromContext(ctx, r.db).
+		Where("user_id = ?", userID).
+		Order("created_at ASC, id ASC").
+		Find(&notebooks).Error
+	if err != nil {
+		return nil, err
+	}
+	return notebooks, nil
+}
+
+// CountEntries 统计各生词本中的词条数量，没有词条的生词本不包含在结果中
+func (r *wordNotebookRepository) CountEntries(ctx context.Context, ids []entity.WordNotebookID) (map[entity.WordNotebookID]int64, error) {
+	counts := make(map[entity.WordNotebookID]int64, len(ids))
+	if len(ids) == 0 {
+		return counts, nil
+	}
+
+	var rows []struct {
+		NotebookID entity.WordNotebookID
+		Count      int64
+	}
+	err := dbFromContext(ctx, r.db).Model(&entity.WordNotebookEntry{}).
+		Select("notebook_id, COUNT(*) AS count").
+		Where("notebook_id IN ?", ids).
+		Group("notebook_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		counts[row.NotebookID] = row.Count
+	}
+	return counts, nil
+}
+
+// CreateEntry 创建词条
+func (r *wordNotebookRepository) CreateEntry(ctx context.Context, entry *entity.WordNotebookEntry) error {
+	return dbFromContext(ctx, r.db).Omit("Word").Create(entry).Error
+}
+
+// UpdateEntry 更新词条
+func (r *wordNotebookRepository) UpdateEntry(ctx context.Context, entry *entity.WordNotebookEntry) error {
+	return dbFromContext(ctx, r.db).Omit("Word").Save(entry).Error
+}
+
+// DeleteEntry 删除词条，以及自定义词条的记忆单元和复习记录
+func (r *wordNotebookRepository) DeleteEntry(ctx context.Context, id entity.WordNotebookEntryID) error {
+	return dbFromContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := r.deleteCustomWordUnits(tx, []entity.WordNotebookEntryID{id}); err != nil {
+			return err
+		}
+		return tx.Delete(&entity.WordNotebookEntry{}, id).Error
+	})
+}
+
+// deleteCustomWordUnits 删除以这些词条为内容的自定义词条记忆单元及其复习记录
+// 记忆单元的内容ID没有外键约束，删除词条后不删除记忆单元会留下无法展示的到期复习
+func (r *wordNotebookRepository) deleteCustomWordUnits(tx *gorm.DB, entryIDs interface{}) error {
+	unitIDs := tx.Model(&entity.MemoryUnit{}).Select("id").
+		Where("type = ? AND content_id IN (?)", entity.MemoryUnitTypeCustomWord, entryIDs)
+	if err := tx.Where("memory_unit_id IN (?)", unitIDs).Delete(&entity.MemoryReview{}).Error; err != nil {
+		return err
+	}
+	return tx.Where("type = ? AND content_id IN (?)", entity.MemoryUnitTypeCustomWord, entryIDs).
+		Delete(&entity.MemoryUnit{}).Error
+}
+
+// GetEntry 获取生词本中的词条
+func (r *wordNotebookRepository) GetEntry(ctx context.Context, notebookID entity.WordNotebookID, id entity.WordNotebookEntryID) (*entity.WordNotebookEntry, error) {
+	return r.firstEntry(dbFromContext(ctx, r.db).Preload("Word").
+		Where("notebook_id = ? AND id = ?", notebookID, id))
+}
+
+// GetEntryByWordID 获取生词本中引用该词典单词的词条
+func (r *wordNotebookRepository) GetEntryByWordID(ctx context.Context, notebookID entity.WordNotebookID, wordID entity.WordID) (*entity.WordNotebookEntry, error) {
+	return r.firstEntry(dbFromContext(ctx, r.db).
+		Where("notebook_id = ? AND word_id = ?", notebookID, wordID))
+}
+
+// GetCustomEntryByText 获取生词本中单词相同的自定义词条
+func (r *wordNotebookRepository) GetCustomEntryByText(ctx context.Context, notebookID entity.WordNotebookID, text string) (*entity.WordNotebookEntry, error) {
+	return r.firstEntry(dbFromContext(ctx, r.db).
+		Where("notebook_id = ? AND word_id IS NULL AND lower(text) = ?", notebookID, strings.ToLower(text)))
+}
+
+// firstEntry 查询第一条词条，不存在时返回 nil
+func (r *wordNotebookRepository) firstEntry(query *gorm.DB) (*entity.WordNotebookEntry, error) {
+	var entry entity.WordNotebookEntry
+	if err := query.First(&entry).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &entry, nil
+}
+
+// ListEntries 分页查询生词本中的词条
+// 关键词匹配单词前缀或个人笔记，难度等级和标签只对词典单词生效，按条件过滤时不包含自定义词条
+func (r *wordNotebookRepository) ListEntries(ctx context.Context, q *repository.WordNotebookEntryQuery) ([]*entity.WordNotebookEntry, int64, error) {
+	query := dbFromContext(ctx, r.db).Model(&entity.WordNotebookEntry{}).
+		Joins("LEFT JOIN words ON words.id = word_notebook_entries.word_id").
+		Where("word_notebook_entries.notebook_id = ?", q.NotebookID)
+	if keyword := strings.ToLower(strings.TrimSpace(q.Keyword)); keyword != "" {
+		pattern := escapeLike(keyword)
+		query = query.Where("("+wordNotebookEntryText+" LIKE ? OR word_notebook_entries.note ILIKE ?)", pattern+"%", "%"+pattern+"%")
+	}
+	if q.Level != valueobject.WORD_DIFFICULTY_LEVEL_UNSPECIFIED {
+		query = query.Where("words.level = ?", q.Level)
+	}
+	if len(q.Tags) > 0 {
+		query = query.Where("jsonb_exists_all(words.tags, ARRAY[?])", q.Tags)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var order string
+	switch q.Sort {
+	case repository.WordNotebookEntrySortAddedAsc:
+		order = "word_notebook_entries.created_at ASC, word_notebook_entries.id ASC"
+	case repository.WordNotebookEntrySortTextAsc:
+		order = wordNotebookEntryText + " ASC, word_notebook_entries.id ASC"
+	case repository.WordNotebookEntrySortTextDesc:
+		order = wordNotebookEntryText + " DESC, word_notebook_entries.id DESC"
+	default:
+		order = "word_notebook_entries.created_at DESC, word_notebook_entries.id DESC"
+	}
+
+	var entries []*entity.WordNotebookEntry
+	err := query.Session(&gorm.Session{}).
+		Select("word_notebook_entries.*").
+		Preload("Word").
+		Order(order).
+		Offset(q.Offset).
+		Limit(q.Limit).
+		Find(&entries).Error
+	if err != nil {
+		return nil, 0, err
+	}
+	return entries, total, nil
+}
+
+var _ repository.WordNotebookRepository = (*wordNotebookRepository)(nil)
@@ -0,0 +1,166 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lazyjean/sla2/internal/domain/entity"
+	"github.com/lazyjean/sla2/internal/domain/repository"
+	"github.com/lazyjean/sla2/internal/domain/valueobject"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWordNotebookRepository(t *testing.T) {
+	db, cleanup := SetupTestDB(t)
+	defer cleanup()
+
+	repo := NewWordNotebookRepository(db)
+	wordRepo := NewWordRepository(db)
+	memoryRepo := NewMemoryUnitRepository(db)
+	ctx := context.Background()
+
+	words := []*entity.Word{
+		{Text: "apple", Tags: []string{"水果"}, Level: valueobject.WORD_DIFFICULTY_LEVEL_A1},
+		{Text: "banana", Tags: []string{"水果"}, Level: valueobject.WORD_DIFFICULTY_LEVEL_A2},
+	}
+	for _, word := range words {
+		require.NoError(t, wordRepo.Create(ctx, word))
+	}
+
+	notebook, err := entity.NewWordNotebook(1, "水果", "")
+	require.NoError(t, err)
+	require.NoError(t, repo.CreateNotebook(ctx, notebook))
+
+	// 其他用户的生词本视为不存在
+	got, err := repo.GetNotebook(ctx, 2, notebook.ID)
+	require.NoError(t, err)
+	assert.Nil(t, got)
+	got, err = repo.GetNotebookByName(ctx, 1, "水果")
+	require.NoError(t, err)
+	require.NotNil(t, got)
+	assert.Equal(t, notebook.ID, got.ID)
+
+	banana, err := entity.NewWordNotebookEntry(notebook, words[1].ID, "香蕉", nil)
+	require.NoError(t, err)
+	require.NoError(t, repo.CreateEntry(ctx, banana))
+	apple, err := entity.NewWordNotebookEntry(notebook, words[0].ID, "", []string{"An apple a day."})
+	require.NoError(t, err)
+	require.NoError(t, repo.CreateEntry(ctx, apple))
+	custom, err := entity.NewCustomWordNotebookEntry(notebook, "Cherry", "樱桃", "", nil)
+	require.NoError(t, err)
+	require.NoError(t, repo.CreateEntry(ctx, custom))
+
+	entry, err := repo.GetEntryByWordID(ctx, notebook.ID, words[0].ID)
+	require.NoError(t, err)
+	require.NotNil(t, entry)
+	assert.Equal(t, apple.ID, entry.ID)
+	entry, err = repo.GetCustomEntryByText(ctx, notebook.ID, "cherry")
+	require.NoError(t, err)
+	require.NotNil(t, entry)
+	assert.Equal(t, custom.ID, entry.ID)
+
+	counts, err := repo.CountEntries(ctx, []entity.WordNotebookID{notebook.ID})
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), counts[notebook.ID])
+
+	t.Run("按单词排序", func(t *testing.T) {
+		entries, total, err := repo.ListEntries(ctx, &repository.WordNotebookEntryQuery{
+			NotebookID: notebook.ID,
+			Sort:       repository.WordNotebookEntrySortTextAsc,
+			Limit:      10,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), total)
+		require.Len(t, entries, 3)
+		assert.Equal(t, []string{"apple", "banana", "Cherry"},
+			[]string{entries[0].DisplayText(), entries[1].DisplayText(), entries[2].DisplayText()})
+		require.NotNil(t, entries[0].Word)
+		assert.Equal(t, []string{"An apple a day."}, entries[0].Examples)
+	})
+
+	t.Run("过滤", func(t *testing.T) {
+		entries, total, err := repo.ListEntries(ctx, &repository.WordNotebookEntryQuery{
+			NotebookID: notebook.ID,
+			Keyword:    "香",
+			Limit:      10,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), total)
+		require.Len(t, entries, 1)
+		assert.Equal(t, banana.ID, entries[0].ID)
+
+		// 按难度等级过滤时不包含自定义词条
+		_, total, err = repo.ListEntries(ctx, &repository.WordNotebookEntryQuery{
+			NotebookID: notebook.ID,
+			Level:      valueobject.WORD_DIFFICULTY_LEVEL_A1,
+			Limit:      10,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), total)
+	})
+
+	t.Run("学习内容", func(t *testing.T) {
+		contents := NewContentRepositories(db)
+		filter := repository.NewContentFilter{NotebookID: notebook.ID}
+
+		ids, err := contents[entity.MemoryUnitTypeWord].ListIDs(ctx, filter)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []uint32{uint32(words[0].ID), uint32(words[1].ID)}, ids)
+
+		// 自定义词条只属于创建它的用户
+		require.NoError(t, memoryRepo.Create(ctx, entity.NewMemoryUnit(1, entity.MemoryUnitTypeWord, uint32(words[0].ID))))
+		items, err := contents[entity.MemoryUnitTypeCustomWord].ListNotLearned(ctx, 1, filter, 10)
+		require.NoError(t, err)
+		require.Len(t, items, 1)
+		assert.Equal(t, "Cherry", items[0].Text)
+		items, err = contents[entity.MemoryUnitTypeCustomWord].ListNotLearned(ctx, 2, repository.NewContentFilter{}, 10)
+		require.NoError(t, err)
+		assert.Empty(t, items)
+
+		// 生词本中没有汉字
+		ids, err = contents[entity.MemoryUnitTypeHanChar].ListIDs(ctx, filter)
+		require.NoError(t, err)
+		assert.Empty(t, ids)
+	})
+
+	// 删除自定义词条时同时删除其记忆单元和复习记录，词典单词的记忆单元不受影响
+	reviewRepo := NewMemoryReviewRepository(db)
+	date, err := entity.NewCustomWordNotebookEntry(notebook, "Date", "枣", "", nil)
+	require.NoError(t, err)
+	require.NoError(t, repo.CreateEntry(ctx, date))
+	customUnits := make(map[entity.WordNotebookEntryID]*entity.MemoryUnit)
+	for _, e := range []*entity.WordNotebookEntry{custom, date} {
+		unit := entity.NewMemoryUnit(1, entity.MemoryUnitTypeCustomWord, uint32(e.ID))
+		require.NoError(t, memoryRepo.Create(ctx, unit))
+		require.NoError(t, reviewRepo.Create(ctx, entity.NewMemoryReview(uint32(unit.ID), 1, entity.ReviewGradeGood, 1000, 0, time.Hour)))
+		customUnits[e.ID] = unit
+	}
+
+	require.NoError(t, repo.DeleteEntry(ctx, date.ID))
+	unit, err := memoryRepo.GetByID(ctx, 1, uint32(customUnits[date.ID].ID))
+	require.NoError(t, err)
+	assert.Nil(t, unit)
+	count, err := reviewRepo.CountByMemoryUnitID(ctx, uint32(customUnits[date.ID].ID))
+	require.NoError(t, err)
+	assert.Zero(t, count)
+	unit, err = memoryRepo.GetByID(ctx, 1, uint32(customUnits[custom.ID].ID))
+	require.NoError(t, err)
+	assert.NotNil(t, unit)
+
+	// 删除生词本时同时删除词条
+	require.NoError(t, repo.DeleteNotebook(ctx, notebook.ID))
+	entry, err = repo.GetEntry(ctx, notebook.ID, apple.ID)
+	require.NoError(t, err)
+	assert.Nil(t, entry)
+	unit, err = memoryRepo.GetByID(ctx, 1, uint32(customUnits[custom.ID].ID))
+	require.NoError(t, err)
+	assert.Nil(t, unit)
+	count, err = reviewRepo.CountByMemoryUnitID(ctx, uint32(customUnits[custom.ID].ID))
+	require.NoError(t, err)
+	assert.Zero(t, count)
+	unit, err = memoryRepo.GetByTypeAndContentID(ctx, 1, entity.MemoryUnitTypeWord, uint32(words[0].ID))
+	require.NoError(t, err)
+	assert.NotNil(t, unit)
+}
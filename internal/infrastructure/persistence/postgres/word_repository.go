@@ -8,6 +8,7 @@ import (
 	"github.com/lazyjean/sla2/internal/domain/entity"
 	domainErrors "github.com/lazyjean/sla2/internal/domain/errors"
 	"github.com/lazyjean/sla2/internal/domain/repository"
+	"github.com/lazyjean/sla2/internal/domain/valueobject"
 	"gorm.io/gorm"
 )
 
@@ -84,6 +85,7 @@ func (r *WordRepository) Create(ctx context.Context, word *entity.Word) error {
 	}
 
 	// 只插入必要的字段
+	word.BuildSearchText()
 	if err := r.db.WithContext(ctx).Create(word).Error; err != nil {
 		return domainErrors.ErrFailedToSave
 	}
@@ -107,6 +109,7 @@ func (r *WordRepository) Save(ctx context.Context, word *entity.Word) error {
 	}
 
 	// 只插入必要的字段
+	word.BuildSearchText()
 	if err := r.db.WithContext(ctx).Select(
 		"Text",
 		"Phonetic",
@@ -114,6 +117,7 @@ func (r *WordRepository) Save(ctx context.Context, word *entity.Word) error {
 		"Examples",
 		"Tags",
 		"Difficulty",
+		"SearchText",
 		"CreatedAt",
 		"UpdatedAt",
 	).Create(word).Error; err != nil {
@@ -192,15 +196,25 @@ func (r *WordRepository) ListNeedReview(ctx context.Context, before time.Time, l
 	return words, nil
 }
 
-// Search 搜索单词
+// Search 搜索单词，有关键词时使用全文检索并容忍拼写错误，按相关度排序
 func (r *WordRepository) Search(ctx context.Context, keyword string, offset, limit int, filters map[string]interface{}) ([]*entity.Word, int64, error) {
-	db := r.db.WithContext(ctx).Model(&entity.Word{})
-
-	// 构建查询条件
 	if keyword != "" {
-		db = db.Where("text ILIKE ?", "%"+keyword+"%")
+		query := &repository.WordSearchQuery{Keyword: keyword, Fuzzy: true, Offset: offset, Limit: limit}
+		query.Tags, _ = filters["tags"].([]string)
+		query.Level, _ = filters["level"].(valueobject.WordDifficultyLevel)
+		hits, total, err := r.FullTextSearch(ctx, query)
+		if err != nil {
+			return nil, 0, err
+		}
+		words := make([]*entity.Word, len(hits))
+		for i, hit := range hits {
+			words[i] = hit.Word
+		}
+		return words, total, nil
 	}
 
+	db := r.db.WithContext(ctx).Model(&entity.Word{})
+
 	if tags, ok := filters["tags"].([]string); ok && len(tags) > 0 {
 		db = db.Where("tags @> ?", tags)
 	}
@@ -302,6 +316,7 @@ func (r *WordRepository) Update(ctx context.Context, word *entity.Word) error {
 	}
 
 	// 使用 Save 方法，GORM 会自动处理 JSON 类型的序列化
+	word.BuildSearchText()
 	if err := r.db.WithContext(ctx).Save(word).Error; err != nil {
 		return domainErrors.ErrFailedToSave
 	}
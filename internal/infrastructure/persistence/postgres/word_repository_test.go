@@ -6,6 +6,7 @@ import (
 
 	"github.com/lazyjean/sla2/internal/domain/entity"
 	"github.com/lazyjean/sla2/internal/domain/errors"
+	"github.com/lazyjean/sla2/internal/domain/repository"
 	"github.com/lazyjean/sla2/internal/domain/valueobject"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -163,3 +164,70 @@ func TestWordRepository_GetByID(t *testing.T) {
 	assert.Equal(t, word.Tags, found.Tags)
 	assert.Equal(t, word.Level, found.Level)
 }
+
+func TestWordRepository_FullTextSearch(t *testing.T) {
+	db, cleanup := SetupTestDB(t)
+	defer cleanup()
+	repo := NewWordRepository(db)
+	ctx := context.Background()
+
+	words := []*entity.Word{
+		{
+			Text: "resilient",
+			Definitions: []entity.Definition{
+				{Meaning: "有弹性的；能复原的", Synonyms: []string{"flexible", "adaptable"}},
+			},
+			Examples: []string{"The company proved resilient during the economic crisis."},
+			Tags:     []string{"adjective", "advanced"},
+			Level:    valueobject.WORD_DIFFICULTY_LEVEL_B2,
+		},
+		{
+			Text:        "resilience",
+			Definitions: []entity.Definition{{Meaning: "恢复力；弹性"}},
+			Tags:        []string{"noun"},
+			Level:       valueobject.WORD_DIFFICULTY_LEVEL_C1,
+		},
+		{
+			Text:        "flexible",
+			Definitions: []entity.Definition{{Meaning: "灵活的；柔韧的"}},
+			Tags:        []string{"adjective"},
+			Level:       valueobject.WORD_DIFFICULTY_LEVEL_B1,
+		},
+	}
+	for _, word := range words {
+		require.NoError(t, repo.Create(ctx, word))
+	}
+
+	search := func(q repository.WordSearchQuery) []string {
+		q.Limit = 10
+		hits, total, err := repo.FullTextSearch(ctx, &q)
+		require.NoError(t, err)
+		texts := make([]string, len(hits))
+		for i, hit := range hits {
+			texts[i] = hit.Word.Text
+		}
+		assert.Equal(t, int64(len(hits)), total)
+		return texts
+	}
+
+	t.Run("前缀匹配且完全相同的排在前面", func(t *testing.T) {
+		assert.Equal(t, []string{"resilience", "resilient"}, search(repository.WordSearchQuery{Keyword: "Resilien"}))
+		assert.Equal(t, []string{"resilient", "resilience"}, search(repository.WordSearchQuery{Keyword: "resilient"}))
+	})
+
+	t.Run("匹配释义、同义词和例句", func(t *testing.T) {
+		assert.Equal(t, []string{"resilient"}, search(repository.WordSearchQuery{Keyword: "复原"}))
+		assert.Equal(t, []string{"flexible", "resilient"}, search(repository.WordSearchQuery{Keyword: "flexible"}))
+		assert.Equal(t, []string{"resilient"}, search(repository.WordSearchQuery{Keyword: "econ cris"}))
+	})
+
+	t.Run("容忍拼写错误", func(t *testing.T) {
+		assert.Empty(t, search(repository.WordSearchQuery{Keyword: "flexable"}))
+		assert.Equal(t, []string{"flexible"}, search(repository.WordSearchQuery{Keyword: "flexable", Fuzzy: true})[:1])
+	})
+
+	t.Run("按等级和标签过滤", func(t *testing.T) {
+		assert.Equal(t, []string{"resilience"}, search(repository.WordSearchQuery{Keyword: "resil", Level: valueobject.WORD_DIFFICULTY_LEVEL_C1}))
+		assert.Equal(t, []string{"resilient"}, search(repository.WordSearchQuery{Keyword: "resil", Tags: []string{"adjective"}}))
+	})
+}
@@ -0,0 +1,138 @@
+package postgres
+
+import (
+	"context"
+	"strings"
+	"unicode"
+
+	"github.com/lazyjean/sla2/internal/domain/entity"
+	domainErrors "github.com/lazyjean/sla2/internal/domain/errors"
+	"github.com/lazyjean/sla2/internal/domain/repository"
+	"github.com/lazyjean/sla2/internal/domain/valueobject"
+	"gorm.io/gorm"
+)
+
+// wordSearchVector 单词全文检索的向量，单词本身权重最高，释义、例句和同义词次之
+// 必须与 migrateWordSearch 中表达式索引的定义完全一致才能使用索引
+const wordSearchVector = "(setweight(to_tsvector('english', words.text), 'A') || setweight(to_tsvector('english', words.search_text), 'B'))"
+
+// wordSearchRow 带相关度的单词查询结果
+type wordSearchRow struct {
+	entity.Word
+	Rank float64 `gorm:"column:rank"`
+}
+
+// FullTextSearch 在单词、释义、例句和同义词中全文检索单词，按相关度降序
+// 匹配方式包括单词前缀、检索文本中的子串、全文检索（每个词按前缀匹配）、关键词或其中的词是单词的某个词形，
+// 容忍拼写错误时还包括与单词或检索文本中的词的三元组相似度；
+// 相关度依次考虑单词或其词形与关键词完全相同、单词前缀相同、与单词的相似度和全文检索的排名
+func (r *WordRepository) FullTextSearch(ctx context.Context, q *repository.WordSearchQuery) ([]*repository.WordSearchHit, int64, error) {
+	keyword := strings.ToLower(strings.TrimSpace(q.Keyword))
+	pattern := escapeLike(keyword)
+	tsQuery := prefixTSQuery(keyword)
+	forms := append(searchTerms(keyword), keyword)
+
+	conditions := []string{
+		"lower(words.text) LIKE ?",
+		"words.search_text ILIKE ?",
+		"EXISTS (SELECT 1 FROM word_forms wf WHERE wf.word_id = words.id AND wf.form IN ?)",
+	}
+	vars := []interface{}{pattern + "%", "%" + pattern + "%", forms}
+	rank := "(CASE WHEN lower(words.text) = ? OR EXISTS (SELECT 1 FROM word_forms wf WHERE wf.word_id = words.id AND wf.form = ?) THEN 3 ELSE 0 END)" +
+		" + (CASE WHEN lower(words.text) LIKE ? THEN 1 ELSE 0 END)" +
+		" + similarity(lower(words.text), ?)"
+	rankVars := []interface{}{keyword, keyword, pattern + "%", keyword}
+	if tsQuery != "" {
+		conditions = append(conditions, wordSearchVector+" @@ to_tsquery('english', ?)")
+		vars = append(vars, tsQuery)
+		rank += " + ts_rank(" + wordSearchVector + ", to_tsquery('english', ?))"
+		rankVars = append(rankVars, tsQuery)
+	}
+	if q.Fuzzy {
+		conditions = append(conditions, "lower(words.text) % ?", "? <% words.search_text")
+		vars = append(vars, keyword, keyword)
+	}
+
+	query := dbFromContext(ctx, r.db).Model(&entity.Word{}).
+		Where("("+strings.Join(conditions, " OR ")+")", vars...)
+	if q.Level != valueobject.WORD_DIFFICULTY_LEVEL_UNSPECIFIED {
+		query = query.Where("words.level = ?", q.Level)
+	}
+	if len(q.Tags) > 0 {
+		query = query.Where("jsonb_exists_all(words.tags, ARRAY[?])", q.Tags)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, domainErrors.ErrFailedToQuery
+	}
+
+	var rows []*wordSearchRow
+	err := query.Session(&gorm.Session{}).
+		Select("words.*, ("+rank+") AS rank", rankVars...).
+		Order("rank DESC, words.id ASC").
+		Offset(q.Offset).
+		Limit(q.Limit).
+		Find(&rows).Error
+	if err != nil {
+		return nil, 0, domainErrors.ErrFailedToQuery
+	}
+
+	hits := make([]*repository.WordSearchHit, len(rows))
+	for i, row := range rows {
+		word := row.Word
+		hits[i] = &repository.WordSearchHit{Word: &word, Rank: row.Rank}
+	}
+	return hits, total, nil
+}
+
+// migrateWordSearch 创建单词全文检索所需的扩展和索引，并为已有单词生成检索文本
+func migrateWordSearch(tx *gorm.DB) error {
+	statements := []string{
+		"CREATE EXTENSION IF NOT EXISTS pg_trgm",
+		"CREATE INDEX IF NOT EXISTS idx_words_text_trgm ON words USING gin (lower(text) gin_trgm_ops)",
+		"CREATE INDEX IF NOT EXISTS idx_words_search_text_trgm ON words USING gin (search_text gin_trgm_ops)",
+		"CREATE INDEX IF NOT EXISTS idx_words_search_vector ON words USING gin (" + wordSearchVector + ")",
+	}
+	for _, statement := range statements {
+		if err := tx.Exec(statement).Error; err != nil {
+			return err
+		}
+	}
+
+	var words []*entity.Word
+	if err := tx.Where("search_text = ''").Find(&words).Error; err != nil {
+		return err
+	}
+	for _, word := range words {
+		word.BuildSearchText()
+		if word.SearchText == "" {
+			continue
+		}
+		if err := tx.Model(word).UpdateColumn("search_text", word.SearchText).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// prefixTSQuery 将关键词拆分为词并按前缀匹配，多个词需全部匹配，如 “break ic” 转换为 break:* & ic:*
+func prefixTSQuery(keyword string) string {
+	terms := searchTerms(keyword)
+	for i, term := range terms {
+		terms[i] = term + ":*"
+	}
+	return strings.Join(terms, " & ")
+}
+
+// searchTerms 将关键词拆分为词
+func searchTerms(keyword string) []string {
+	return strings.FieldsFunc(keyword, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// escapeLike 转义 LIKE 模式中的通配符
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
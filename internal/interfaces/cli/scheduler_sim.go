@@ -0,0 +1,263 @@
+package cli
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	"github.com/lazyjean/sla2/config"
+	"github.com/lazyjean/sla2/internal/application/dto"
+	"github.com/lazyjean/sla2/internal/application/service"
+	"github.com/lazyjean/sla2/internal/domain/entity"
+	domainService "github.com/lazyjean/sla2/internal/domain/service"
+	"github.com/lazyjean/sla2/internal/infrastructure/persistence/postgres"
+)
+
+// SchedulerSimCommand 复习调度模拟子命令
+const SchedulerSimCommand = "scheduler-sim"
+
+// fittedSchedulerName 汇总表中按用户拟合权重的 FSRS
+const fittedSchedulerName = "fsrs-fitted"
+
+// schedulerSimOptions 复习调度模拟参数
+type schedulerSimOptions struct {
+	userID     uint
+	days       int
+	file       string
+	export     string
+	fit        bool
+	save       bool
+	iterations int
+}
+
+// RunSchedulerSim 运行复习调度模拟
+// 从数据库或导出的 JSON Lines 文件加载复习记录，回放评估经验阶梯、FSRS 和 SM-2 算法的预测准确度和复习量，
+// 可按用户拟合 FSRS 模型权重并保存到用户的学习偏好中
+func RunSchedulerSim(ctx context.Context, cfg *config.Config, args []string, out io.Writer) error {
+	var opts schedulerSimOptions
+	flags := flag.NewFlagSet(SchedulerSimCommand, flag.ContinueOnError)
+	flags.SetOutput(out)
+	flags.UintVar(&opts.userID, "user", 0, "只处理指定用户，0 表示全部用户")
+	flags.IntVar(&opts.days, "days", 365, "从数据库加载最近多少天的复习记录")
+	flags.StringVar(&opts.file, "file", "", "从导出的 JSON Lines 文件加载复习记录，而不是数据库")
+	flags.StringVar(&opts.export, "export", "", "将加载的复习记录导出为 JSON Lines 文件")
+	flags.BoolVar(&opts.fit, "fit", false, "按用户拟合 FSRS 模型权重")
+	flags.BoolVar(&opts.save, "save", false, "保存拟合的权重到用户的学习偏好（需要 -fit）")
+	flags.IntVar(&opts.iterations, "iterations", 20, "拟合的最大搜索轮数")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if opts.save && !opts.fit {
+		return errors.New("-save requires -fit")
+	}
+
+	tuning, err := newSchedulerTuningService(cfg, opts)
+	if err != nil {
+		return err
+	}
+
+	reviews, err := loadSchedulerSimReviews(ctx, tuning, opts)
+	if err != nil {
+		return err
+	}
+	if opts.export != "" {
+		if err := exportReviews(opts.export, reviews); err != nil {
+			return err
+		}
+	}
+
+	return simulateSchedulers(ctx, tuning, reviews, opts, out)
+}
+
+// newSchedulerTuningService 创建复习调度调优服务，从文件加载且不保存时无需连接数据库
+func newSchedulerTuningService(cfg *config.Config, opts schedulerSimOptions) (*service.SchedulerTuningService, error) {
+	schedulerOpts := domainService.SchedulerOptions{
+		DesiredRetention: cfg.Scheduler.DesiredRetention,
+		MaximumInterval:  cfg.Scheduler.MaximumInterval,
+		Weights:          cfg.Scheduler.Weights,
+	}
+	if opts.file != "" && !opts.save {
+		return service.NewSchedulerTuningService(nil, nil, schedulerOpts), nil
+	}
+
+	db, err := postgres.NewDB(&cfg.Database)
+	if err != nil {
+		return nil, fmt.Errorf("connect database: %w", err)
+	}
+	return service.NewSchedulerTuningService(
+		postgres.NewMemoryReviewRepository(db),
+		postgres.NewUserSettingsRepository(db),
+		schedulerOpts,
+	), nil
+}
+
+// loadSchedulerSimReviews 加载复习记录并按用户分组
+func loadSchedulerSimReviews(ctx context.Context, tuning *service.SchedulerTuningService, opts schedulerSimOptions) (map[uint32][]*entity.MemoryReview, error) {
+	reviews := make(map[uint32][]*entity.MemoryReview)
+	if opts.file != "" {
+		all, err := readReviews(opts.file)
+		if err != nil {
+			return nil, err
+		}
+		for _, review := range all {
+			if opts.userID == 0 || review.UserID == uint32(opts.userID) {
+				reviews[review.UserID] = append(reviews[review.UserID], review)
+			}
+		}
+		return reviews, nil
+	}
+
+	until := time.Now()
+	since := until.AddDate(0, 0, -opts.days)
+	userIDs := []uint32{uint32(opts.userID)}
+	if opts.userID == 0 {
+		var err error
+		if userIDs, err = tuning.ListUserIDs(ctx, since); err != nil {
+			return nil, fmt.Errorf("list users: %w", err)
+		}
+	}
+	for _, userID := range userIDs {
+		userReviews, err := tuning.LoadReviews(ctx, userID, since, until)
+		if err != nil {
+			return nil, fmt.Errorf("load reviews of user %d: %w", userID, err)
+		}
+		if len(userReviews) > 0 {
+			reviews[userID] = userReviews
+		}
+	}
+	return reviews, nil
+}
+
+// simulateSchedulers 回放各用户的复习记录并输出评估报告
+func simulateSchedulers(ctx context.Context, tuning *service.SchedulerTuningService, reviews map[uint32][]*entity.MemoryReview, opts schedulerSimOptions, out io.Writer) error {
+	userIDs := make([]uint32, 0, len(reviews))
+	total := 0
+	for userID, userReviews := range reviews {
+		userIDs = append(userIDs, userID)
+		total += len(userReviews)
+	}
+	sort.Slice(userIDs, func(i, j int) bool { return userIDs[i] < userIDs[j] })
+
+	var names []string
+	totals := make(map[string]*domainService.ReplayMetrics)
+	var fits []*dto.SchedulerFit
+	var skipped []uint32
+	for _, userID := range userIDs {
+		for _, evaluation := range tuning.Evaluate(reviews[userID]) {
+			if _, ok := totals[evaluation.Name]; !ok {
+				names = append(names, evaluation.Name)
+				totals[evaluation.Name] = &domainService.ReplayMetrics{}
+			}
+			totals[evaluation.Name].Add(evaluation.Metrics)
+		}
+		if !opts.fit {
+			continue
+		}
+
+		fit, err := tuning.FitUserWeights(userID, reviews[userID], opts.iterations)
+		if errors.Is(err, domainService.ErrInsufficientReviewHistory) {
+			skipped = append(skipped, userID)
+			continue
+		} else if err != nil {
+			return err
+		}
+		fits = append(fits, fit)
+		if opts.save {
+			if err := tuning.SaveUserWeights(ctx, entity.UID(userID), fit.Weights); err != nil {
+				return fmt.Errorf("save weights of user %d: %w", userID, err)
+			}
+		}
+	}
+	if len(fits) > 0 {
+		fitted := &domainService.ReplayMetrics{}
+		for _, fit := range fits {
+			fitted.Add(fit.After)
+		}
+		names = append(names, fittedSchedulerName)
+		totals[fittedSchedulerName] = fitted
+	}
+
+	fmt.Fprintf(out, "用户数: %d，复习记录: %d\n\n", len(userIDs), total)
+	w := tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "算法\t复习次数\t可评估次数\t对数损失\tRMSE\t每日复习量")
+	for _, name := range names {
+		m := totals[name]
+		fmt.Fprintf(w, "%s\t%d\t%d\t%.4f\t%.4f\t%.1f\n", name, m.Reviews, m.Predictions, m.LogLoss(), m.RMSE(), m.Workload)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	if !opts.fit {
+		return nil
+	}
+
+	fmt.Fprintln(out)
+	w = tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "用户\t可评估次数\t对数损失（默认→拟合）\tRMSE（默认→拟合）\t已保存")
+	for _, fit := range fits {
+		fmt.Fprintf(w, "%d\t%d\t%.4f → %.4f\t%.4f → %.4f\t%t\n",
+			fit.UserID, fit.After.Predictions, fit.Before.LogLoss(), fit.After.LogLoss(), fit.Before.RMSE(), fit.After.RMSE(), opts.save)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	if len(skipped) > 0 {
+		fmt.Fprintf(out, "\n%d 位用户的可评估复习少于 %d 次，未拟合: %v\n", len(skipped), domainService.MinFSRSFitPredictions, skipped)
+	}
+	return nil
+}
+
+// readReviews 从 JSON Lines 文件读取复习记录
+func readReviews(path string) ([]*entity.MemoryReview, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var reviews []*entity.MemoryReview
+	decoder := json.NewDecoder(bufio.NewReader(file))
+	for {
+		var review entity.MemoryReview
+		if err := decoder.Decode(&review); err == io.EOF {
+			return reviews, nil
+		} else if err != nil {
+			return nil, fmt.Errorf("read %s: %w", path, err)
+		}
+		reviews = append(reviews, &review)
+	}
+}
+
+// exportReviews 将复习记录按用户导出为 JSON Lines 文件
+func exportReviews(path string, reviews map[uint32][]*entity.MemoryReview) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	userIDs := make([]uint32, 0, len(reviews))
+	for userID := range reviews {
+		userIDs = append(userIDs, userID)
+	}
+	sort.Slice(userIDs, func(i, j int) bool { return userIDs[i] < userIDs[j] })
+
+	writer := bufio.NewWriter(file)
+	encoder := json.NewEncoder(writer)
+	for _, userID := range userIDs {
+		for _, review := range reviews[userID] {
+			if err := encoder.Encode(review); err != nil {
+				return err
+			}
+		}
+	}
+	return writer.Flush()
+}
@@ -0,0 +1,140 @@
+package learning
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	pb "github.com/lazyjean/sla2/api/proto/v1"
+	"github.com/lazyjean/sla2/internal/application/dto"
+	domainErrors "github.com/lazyjean/sla2/internal/domain/errors"
+	"github.com/lazyjean/sla2/internal/domain/valueobject"
+	"github.com/lazyjean/sla2/internal/interfaces/grpc/vocabulary"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// GetNewHanCharLearning 获取生字学习内容
+func (s *LearningService) GetNewHanCharLearning(ctx context.Context, req *pb.GetNewHanCharLearningRequest) (*pb.GetNewHanCharLearningResponse, error) {
+	hanChars, err := s.hanCharLearningService.GetNewHanChars(ctx, int(req.Count), valueobject.WordDifficultyLevel(req.Level), req.Category)
+	if err != nil {
+		return nil, toHanCharStatusError(err, "failed to get new han chars")
+	}
+	return &pb.GetNewHanCharLearningResponse{
+		Contents: ToPBHanCharLearningContents(hanChars),
+	}, nil
+}
+
+// SubmitNewHanCharLearningResult 提交生字学习结果
+func (s *LearningService) SubmitNewHanCharLearningResult(ctx context.Context, req *pb.SubmitNewHanCharLearningResultRequest) (*pb.SubmitNewHanCharLearningResultResponse, error) {
+	learning, err := ToNewHanCharLearningRequest(req)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := s.hanCharLearningService.SubmitNewHanCharLearning(ctx, learning); err != nil {
+		return nil, toHanCharStatusError(err, "failed to submit new han char learning")
+	}
+	return &pb.SubmitNewHanCharLearningResultResponse{}, nil
+}
+
+// SubmitHanCharReview 提交汉字复习结果
+func (s *LearningService) SubmitHanCharReview(ctx context.Context, req *pb.SubmitHanCharReviewRequest) (*pb.SubmitHanCharReviewResponse, error) {
+	hanCharID, err := ParseHanCharID(req.HanCharId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	var reviewedAt time.Time
+	if req.ReviewTime != nil {
+		reviewedAt = req.ReviewTime.AsTime()
+	}
+	nextReviewAt, err := s.hanCharLearningService.SubmitHanCharReview(ctx, hanCharID, req.IsRecognized, reviewedAt)
+	if err != nil {
+		return nil, toHanCharStatusError(err, "failed to submit han char review")
+	}
+	return &pb.SubmitHanCharReviewResponse{
+		NextReviewTime: timestamppb.New(nextReviewAt),
+	}, nil
+}
+
+// GetHanCharTest 获取汉字测试
+func (s *LearningService) GetHanCharTest(ctx context.Context, req *pb.GetHanCharTestRequest) (*pb.GetHanCharTestResponse, error) {
+	test, err := s.hanCharLearningService.GetHanCharTest(ctx, &dto.HanCharTestRequest{
+		Count: int(req.Count),
+		Level: valueobject.WordDifficultyLevel(req.DifficultyLevel),
+		Types: ToEntityHanCharQuestionTypes(req.Types),
+		Seed:  req.Seed,
+	})
+	if err != nil {
+		return nil, toHanCharStatusError(err, "failed to get han char test")
+	}
+
+	hanChars := make([]*pb.HanChar, len(test.HanChars))
+	for i, hanChar := range test.HanChars {
+		hanChars[i] = vocabulary.ToProtoHanChar(hanChar)
+	}
+	return &pb.GetHanCharTestResponse{
+		HanChars:  hanChars,
+		Seed:      test.Seed,
+		Questions: ToPBHanCharQuestions(test.Questions),
+	}, nil
+}
+
+// SubmitHanCharTestResult 提交汉字测试结果
+func (s *LearningService) SubmitHanCharTestResult(ctx context.Context, req *pb.SubmitHanCharTestResultRequest) (*pb.SubmitHanCharTestResultResponse, error) {
+	if len(req.Answers) == 0 {
+		var testedAt time.Time
+		if req.TestTime != nil {
+			testedAt = req.TestTime.AsTime()
+		}
+		if err := s.hanCharLearningService.SubmitHanCharTestResults(ctx, ToHanCharTestResults(req.Results), testedAt); err != nil {
+			return nil, toHanCharStatusError(err, "failed to submit han char test result")
+		}
+		return &pb.SubmitHanCharTestResultResponse{}, nil
+	}
+
+	grades, err := s.hanCharLearningService.GradeHanCharTest(ctx, req.Seed, ToHanCharTestAnswers(req.Answers))
+	if err != nil {
+		return nil, toHanCharStatusError(err, "failed to grade han char test")
+	}
+	return &pb.SubmitHanCharTestResultResponse{
+		Grades: ToPBHanCharTestGrades(grades),
+	}, nil
+}
+
+// SubmitHanCharHandwriting 提交汉字书写练习
+func (s *LearningService) SubmitHanCharHandwriting(ctx context.Context, req *pb.SubmitHanCharHandwritingRequest) (*pb.SubmitHanCharHandwritingResponse, error) {
+	hanCharID, err := ParseHanCharID(req.HanCharId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	result, err := s.hanCharLearningService.SubmitHanCharHandwriting(ctx, &dto.HanCharHandwritingRequest{
+		HanCharID:     hanCharID,
+		Attempt:       int(req.Attempt),
+		Strokes:       vocabulary.ToStrokePolylines(req.Strokes),
+		StudyDuration: req.StudyDuration,
+	})
+	if err != nil {
+		return nil, toHanCharStatusError(err, "failed to submit han char handwriting")
+	}
+	return &pb.SubmitHanCharHandwritingResponse{
+		Correct:       result.Correct(),
+		StrokeCorrect: result.StrokeCorrect,
+		ExtraStrokes:  uint32(result.ExtraStrokes),
+	}, nil
+}
+
+// toHanCharStatusError 将汉字学习的领域错误转换为 gRPC 状态错误
+func toHanCharStatusError(err error, message string) error {
+	var domainErr *domainErrors.Error
+	if errors.As(err, &domainErr) && domainErr.Code == domainErrors.CodeNotFound {
+		return status.Errorf(codes.NotFound, "%s: %v", message, err)
+	} else if errors.As(err, &domainErr) && domainErr.Code == domainErrors.CodeInvalidArgument {
+		return status.Errorf(codes.InvalidArgument, "%s: %v", message, err)
+	} else if errors.As(err, &domainErr) && domainErr.Code == domainErrors.CodeUnauthenticated {
+		return status.Errorf(codes.Unauthenticated, "invalid user context: %v", err)
+	}
+	return status.Errorf(codes.Internal, "%s: %v", message, err)
+}
@@ -7,9 +7,11 @@ import (
 	"fmt"
 
 	pb "github.com/lazyjean/sla2/api/proto/v1"
+	"github.com/lazyjean/sla2/internal/application/dto"
 	"github.com/lazyjean/sla2/internal/application/service"
 	"github.com/lazyjean/sla2/internal/domain/entity"
 	domainErrors "github.com/lazyjean/sla2/internal/domain/errors"
+	"github.com/lazyjean/sla2/internal/domain/valueobject"
 	"github.com/lazyjean/sla2/pkg/logger"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
@@ -20,12 +22,19 @@ type LearningService struct {
 	pb.UnimplementedLearningServiceServer
 	learningService *service.LearningService
 	memoryService   service.MemoryService
+	sessionService  *service.StudySessionService
+	activityService *service.StudyActivityService
+
+	hanCharLearningService *service.HanCharLearningService
 }
 
-func NewLearningService(learningService *service.LearningService, memoryService service.MemoryService) *LearningService {
+func NewLearningService(learningService *service.LearningService, memoryService service.MemoryService, sessionService *service.StudySessionService, activityService *service.StudyActivityService, hanCharLearningService *service.HanCharLearningService) *LearningService {
 	return &LearningService{
-		learningService: learningService,
-		memoryService:   memoryService,
+		learningService:        learningService,
+		memoryService:          memoryService,
+		sessionService:         sessionService,
+		activityService:        activityService,
+		hanCharLearningService: hanCharLearningService,
 	}
 }
 
@@ -95,6 +104,14 @@ func (s *LearningService) UpdateUnitProgress(ctx context.Context, req *pb.Learni
 func (s *LearningService) UpdateMemoryStatus(ctx context.Context, req *pb.UpdateMemoryStatusRequest) (*pb.UpdateMemoryStatusResponse, error) {
 	err := s.learningService.UpdateMemoryStatus(ctx, req.MemoryUnitId, entity.MasteryLevel(req.MasteryLevel), req.StudyDuration)
 	if err != nil {
+		var domainErr *domainErrors.Error
+		if errors.As(err, &domainErr) && domainErr.Code == domainErrors.CodeNotFound {
+			return nil, status.Errorf(codes.NotFound, "memory unit with id %d not found", req.MemoryUnitId)
+		} else if errors.Is(err, domainErrors.ErrInvalidMasteryLevel) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid mastery level: %v", err)
+		} else if errors.As(err, &domainErr) && domainErr.Code == domainErrors.CodeUnauthenticated {
+			return nil, status.Errorf(codes.Unauthenticated, "invalid user context: %v", err)
+		}
 		return nil, err
 	}
 
@@ -104,7 +121,7 @@ func (s *LearningService) UpdateMemoryStatus(ctx context.Context, req *pb.Update
 // ReviewWord 复习单词
 func (s *LearningService) ReviewWord(ctx context.Context, req *pb.ReviewWordRequest) (*pb.ReviewWordResponse, error) {
 	log := logger.GetLogger(ctx)
-	err := s.memoryService.ReviewWord(ctx, entity.WordID(req.WordId), req.Result == pb.ReviewResult_REVIEW_RESULT_CORRECT, req.ResponseTime)
+	err := s.memoryService.ReviewWord(ctx, entity.WordID(req.WordId), ToEntityReviewGrade(req.Result, req.Grade), req.ResponseTime, entity.StudySessionID(req.SessionId))
 	if err != nil {
 		log.Error("[Debug] ReviewWord service returned error", zap.Error(err), zap.String("errorType", fmt.Sprintf("%T", err)))
 		// Check if the error is a domain Error
@@ -115,9 +132,11 @@ func (s *LearningService) ReviewWord(ctx context.Context, req *pb.ReviewWordRequ
 			case domainErrors.CodeWordNotFound:
 				log.Info("[Debug] Matched CodeWordNotFound")
 				return nil, status.Errorf(codes.NotFound, "word with id %d not found: %v", req.WordId, err)
-			case domainErrors.CodeInvalidUserID:
+			case domainErrors.CodeInvalidUserID, domainErrors.CodeUnauthenticated:
 				log.Info("[Debug] Matched CodeInvalidUserID")
 				return nil, status.Errorf(codes.Unauthenticated, "invalid user context: %v", err)
+			case domainErrors.CodeInvalidArgument:
+				return nil, status.Errorf(codes.InvalidArgument, "invalid review: %v", err)
 			default:
 				log.Warn("[Debug] Unknown domain error code", zap.Int("code", domainErr.Code))
 				return nil, status.Errorf(codes.Internal, "failed to review word: %v", err)
@@ -133,14 +152,16 @@ func (s *LearningService) ReviewWord(ctx context.Context, req *pb.ReviewWordRequ
 
 // ReviewHanChar 复习汉字
 func (s *LearningService) ReviewHanChar(ctx context.Context, req *pb.ReviewHanCharRequest) (*pb.ReviewHanCharResponse, error) {
-	err := s.memoryService.ReviewHanChar(ctx, req.HanCharId, req.Result == pb.ReviewResult_REVIEW_RESULT_CORRECT, req.ResponseTime)
+	err := s.memoryService.ReviewHanChar(ctx, req.HanCharId, ToEntityReviewGrade(req.Result, req.Grade), req.ResponseTime, entity.StudySessionID(req.SessionId))
 	if err != nil {
 		// Check if the error is a domain Error with CodeNotFound
 		var domainErr *domainErrors.Error
 		if errors.As(err, &domainErr) && domainErr.Code == domainErrors.CodeNotFound {
 			return nil, status.Errorf(codes.NotFound, "han char with id %d not found: %v", req.HanCharId, err)
-		} else if errors.As(err, &domainErr) && domainErr.Code == domainErrors.CodeInvalidUserID {
+		} else if errors.As(err, &domainErr) && (domainErr.Code == domainErrors.CodeInvalidUserID || domainErr.Code == domainErrors.CodeUnauthenticated) {
 			return nil, status.Errorf(codes.Unauthenticated, "invalid user context: %v", err)
+		} else if errors.As(err, &domainErr) && domainErr.Code == domainErrors.CodeInvalidArgument {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid review: %v", err)
 		}
 		// Handle other potential errors
 		return nil, status.Errorf(codes.Internal, "failed to review han char: %v", err)
@@ -148,11 +169,321 @@ func (s *LearningService) ReviewHanChar(ctx context.Context, req *pb.ReviewHanCh
 	return &pb.ReviewHanCharResponse{}, nil
 }
 
+// Review 复习学习内容
+func (s *LearningService) Review(ctx context.Context, req *pb.ReviewRequest) (*pb.ReviewResponse, error) {
+	err := s.memoryService.Review(ctx, ToEntityMemoryUnitType(req.Type), req.ContentId, ToEntityReviewGrade(req.Result, req.Grade), req.ResponseTime, entity.StudySessionID(req.SessionId))
+	if err != nil {
+		var domainErr *domainErrors.Error
+		if errors.As(err, &domainErr) && domainErr.Code == domainErrors.CodeNotFound {
+			return nil, status.Errorf(codes.NotFound, "content with type %s and id %d not found", req.Type, req.ContentId)
+		} else if errors.As(err, &domainErr) && domainErr.Code == domainErrors.CodeUnauthenticated {
+			return nil, status.Errorf(codes.Unauthenticated, "invalid user context: %v", err)
+		} else if errors.As(err, &domainErr) && domainErr.Code == domainErrors.CodeInvalidArgument {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid review: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to review: %v", err)
+	}
+	return &pb.ReviewResponse{}, nil
+}
+
+// SubmitReviewBatch 批量提交复习
+func (s *LearningService) SubmitReviewBatch(ctx context.Context, req *pb.SubmitReviewBatchRequest) (*pb.SubmitReviewBatchResponse, error) {
+	results, err := s.memoryService.SubmitReviewBatch(ctx, ToBatchReviewItems(req.Reviews))
+	if err != nil {
+		var domainErr *domainErrors.Error
+		if errors.As(err, &domainErr) && domainErr.Code == domainErrors.CodeInvalidArgument {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid review batch: %v", err)
+		} else if errors.As(err, &domainErr) && domainErr.Code == domainErrors.CodeUnauthenticated {
+			return nil, status.Errorf(codes.Unauthenticated, "invalid user context: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to submit review batch: %v", err)
+	}
+
+	return &pb.SubmitReviewBatchResponse{
+		Results: ToPBBatchReviewResults(results),
+	}, nil
+}
+
+// ListMemoryReviews 获取记忆单元的复习记录
+func (s *LearningService) ListMemoryReviews(ctx context.Context, req *pb.ListMemoryReviewsRequest) (*pb.ListMemoryReviewsResponse, error) {
+	page := req.Page
+	if page < 1 {
+		page = 1
+	}
+	pageSize := req.PageSize
+	if pageSize <= 0 {
+		pageSize = 20 // 默认值
+	}
+
+	reviews, total, err := s.memoryService.ListMemoryReviews(ctx, req.MemoryUnitId, page, pageSize)
+	if err != nil {
+		var domainErr *domainErrors.Error
+		if errors.As(err, &domainErr) && domainErr.Code == domainErrors.CodeNotFound {
+			return nil, status.Errorf(codes.NotFound, "memory unit with id %d not found", req.MemoryUnitId)
+		} else if errors.As(err, &domainErr) && domainErr.Code == domainErrors.CodeUnauthenticated {
+			return nil, status.Errorf(codes.Unauthenticated, "invalid user context: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to list memory reviews: %v", err)
+	}
+
+	return &pb.ListMemoryReviewsResponse{
+		Reviews: ToPBMemoryReviews(reviews),
+		Total:   uint32(total),
+	}, nil
+}
+
+// ForecastReviews 预测未来每天的复习量
+func (s *LearningService) ForecastReviews(ctx context.Context, req *pb.ForecastReviewsRequest) (*pb.ForecastReviewsResponse, error) {
+	forecast, err := s.memoryService.ForecastReviews(ctx, &dto.ReviewForecastRequest{
+		Days:      req.Days,
+		Types:     ToEntityMemoryUnitTypes(req.Types),
+		NewPerDay: req.NewPerDay,
+	})
+	if err != nil {
+		var domainErr *domainErrors.Error
+		if errors.As(err, &domainErr) && domainErr.Code == domainErrors.CodeInvalidArgument {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid forecast request: %v", err)
+		} else if errors.As(err, &domainErr) && domainErr.Code == domainErrors.CodeUnauthenticated {
+			return nil, status.Errorf(codes.Unauthenticated, "invalid user context: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to forecast reviews: %v", err)
+	}
+
+	return &pb.ForecastReviewsResponse{
+		Days:         ToPBReviewForecastDays(forecast.Days),
+		OverdueCount: uint32(forecast.OverdueCount),
+	}, nil
+}
+
+// ListLeeches 获取我的顽固项
+func (s *LearningService) ListLeeches(ctx context.Context, req *pb.ListLeechesRequest) (*pb.ListLeechesResponse, error) {
+	page := req.Page
+	if page < 1 {
+		page = 1
+	}
+	pageSize := req.PageSize
+	if pageSize <= 0 {
+		pageSize = 20 // 默认值
+	}
+
+	units, total, err := s.memoryService.ListLeeches(ctx, page, pageSize, ToEntityMemoryUnitTypes(req.Types))
+	if err != nil {
+		var domainErr *domainErrors.Error
+		if errors.As(err, &domainErr) && domainErr.Code == domainErrors.CodeUnauthenticated {
+			return nil, status.Errorf(codes.Unauthenticated, "invalid user context: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to list leeches: %v", err)
+	}
+
+	return &pb.ListLeechesResponse{
+		Leeches: ToPBMemoryUnits(units),
+		Total:   uint32(total),
+	}, nil
+}
+
+// UnsuspendMemoryUnit 恢复已暂停的记忆单元的复习
+func (s *LearningService) UnsuspendMemoryUnit(ctx context.Context, req *pb.UnsuspendMemoryUnitRequest) (*pb.UnsuspendMemoryUnitResponse, error) {
+	unit, err := s.memoryService.UnsuspendMemoryUnit(ctx, req.MemoryUnitId)
+	if err != nil {
+		return nil, toMemoryUnitStatusError(err, req.MemoryUnitId, "failed to unsuspend memory unit")
+	}
+	return &pb.UnsuspendMemoryUnitResponse{
+		Status: ToPBMemoryUnit(unit),
+	}, nil
+}
+
+// ResetLeech 清除记忆单元的顽固项状态
+func (s *LearningService) ResetLeech(ctx context.Context, req *pb.ResetLeechRequest) (*pb.ResetLeechResponse, error) {
+	unit, err := s.memoryService.ResetLeech(ctx, req.MemoryUnitId)
+	if err != nil {
+		return nil, toMemoryUnitStatusError(err, req.MemoryUnitId, "failed to reset leech")
+	}
+	return &pb.ResetLeechResponse{
+		Status: ToPBMemoryUnit(unit),
+	}, nil
+}
+
+// ResetMemoryUnit 将记忆单元重置为新内容
+func (s *LearningService) ResetMemoryUnit(ctx context.Context, req *pb.ResetMemoryUnitRequest) (*pb.ResetMemoryUnitResponse, error) {
+	unit, err := s.memoryService.ResetMemoryUnit(ctx, req.MemoryUnitId)
+	if err != nil {
+		return nil, toMemoryUnitStatusError(err, req.MemoryUnitId, "failed to reset memory unit")
+	}
+	return &pb.ResetMemoryUnitResponse{
+		Status: ToPBMemoryUnit(unit),
+	}, nil
+}
+
+// BuryMemoryUnit 搁置记忆单元到下一个学习日
+func (s *LearningService) BuryMemoryUnit(ctx context.Context, req *pb.BuryMemoryUnitRequest) (*pb.BuryMemoryUnitResponse, error) {
+	unit, err := s.memoryService.BuryMemoryUnit(ctx, req.MemoryUnitId)
+	if err != nil {
+		return nil, toMemoryUnitStatusError(err, req.MemoryUnitId, "failed to bury memory unit")
+	}
+	return &pb.BuryMemoryUnitResponse{
+		Status: ToPBMemoryUnit(unit),
+	}, nil
+}
+
+// RescheduleMemoryUnit 将记忆单元的下次复习调整到指定日期
+func (s *LearningService) RescheduleMemoryUnit(ctx context.Context, req *pb.RescheduleMemoryUnitRequest) (*pb.RescheduleMemoryUnitResponse, error) {
+	unit, err := s.memoryService.RescheduleMemoryUnit(ctx, req.MemoryUnitId, req.Date)
+	if err != nil {
+		return nil, toMemoryUnitStatusError(err, req.MemoryUnitId, "failed to reschedule memory unit")
+	}
+	return &pb.RescheduleMemoryUnitResponse{
+		Status: ToPBMemoryUnit(unit),
+	}, nil
+}
+
+// RescheduleDueReviews 将到期的复习分散到之后的若干个学习日
+func (s *LearningService) RescheduleDueReviews(ctx context.Context, req *pb.RescheduleDueReviewsRequest) (*pb.RescheduleDueReviewsResponse, error) {
+	count, err := s.memoryService.RescheduleDueReviews(ctx, &dto.RescheduleDueRequest{
+		Days:  req.Days,
+		Types: ToEntityMemoryUnitTypes(req.Types),
+	})
+	if err != nil {
+		var domainErr *domainErrors.Error
+		if errors.As(err, &domainErr) && domainErr.Code == domainErrors.CodeInvalidArgument {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid reschedule request: %v", err)
+		} else if errors.As(err, &domainErr) && domainErr.Code == domainErrors.CodeUnauthenticated {
+			return nil, status.Errorf(codes.Unauthenticated, "invalid user context: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to reschedule due reviews: %v", err)
+	}
+
+	return &pb.RescheduleDueReviewsResponse{
+		RescheduledCount: uint32(count),
+	}, nil
+}
+
+// ForgetCourse 将课程内容对应的记忆单元全部重置为新内容
+func (s *LearningService) ForgetCourse(ctx context.Context, req *pb.ForgetCourseRequest) (*pb.ForgetCourseResponse, error) {
+	count, err := s.memoryService.ForgetCourse(ctx, uint(req.CourseId))
+	if err != nil {
+		var domainErr *domainErrors.Error
+		if errors.As(err, &domainErr) && domainErr.Code == domainErrors.CodeNotFound {
+			return nil, status.Errorf(codes.NotFound, "course with id %d not found", req.CourseId)
+		} else if errors.As(err, &domainErr) && domainErr.Code == domainErrors.CodeInvalidArgument {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid course: %v", err)
+		} else if errors.As(err, &domainErr) && domainErr.Code == domainErrors.CodeUnauthenticated {
+			return nil, status.Errorf(codes.Unauthenticated, "invalid user context: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to forget course: %v", err)
+	}
+
+	return &pb.ForgetCourseResponse{
+		ResetCount: uint32(count),
+	}, nil
+}
+
+// ListHardContents 获取被最多用户标记为顽固项的学习内容
+func (s *LearningService) ListHardContents(ctx context.Context, req *pb.ListHardContentsRequest) (*pb.ListHardContentsResponse, error) {
+	limit := req.Limit
+	if limit == 0 {
+		limit = 20 // 默认值
+	}
+	if limit > 100 {
+		limit = 100
+	}
+
+	contents, err := s.memoryService.ListHardContents(ctx, ToEntityMemoryUnitTypes(req.Types), int(limit))
+	if err != nil {
+		var domainErr *domainErrors.Error
+		if errors.As(err, &domainErr) && domainErr.Code == domainErrors.CodeInvalidArgument {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to list hard contents: %v", err)
+	}
+
+	return &pb.ListHardContentsResponse{
+		Contents: ToPBHardContents(contents),
+	}, nil
+}
+
+// GetStudyActivity 获取连续学习天数和学习热力图
+func (s *LearningService) GetStudyActivity(ctx context.Context, req *pb.GetStudyActivityRequest) (*pb.GetStudyActivityResponse, error) {
+	activity, err := s.activityService.GetStudyActivity(ctx, &dto.StudyActivityRequest{
+		StartDate: req.StartDate,
+		EndDate:   req.EndDate,
+	})
+	if err != nil {
+		var domainErr *domainErrors.Error
+		if errors.As(err, &domainErr) && domainErr.Code == domainErrors.CodeInvalidArgument {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid activity request: %v", err)
+		} else if errors.As(err, &domainErr) && domainErr.Code == domainErrors.CodeUnauthenticated {
+			return nil, status.Errorf(codes.Unauthenticated, "invalid user context: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get study activity: %v", err)
+	}
+
+	return ToPBStudyActivity(activity), nil
+}
+
+// toMemoryUnitStatusError 将记忆单元操作的领域错误转换为 gRPC 状态错误
+func toMemoryUnitStatusError(err error, memoryUnitID uint32, message string) error {
+	var domainErr *domainErrors.Error
+	if errors.As(err, &domainErr) && domainErr.Code == domainErrors.CodeNotFound {
+		return status.Errorf(codes.NotFound, "memory unit with id %d not found", memoryUnitID)
+	} else if errors.As(err, &domainErr) && domainErr.Code == domainErrors.CodeInvalidArgument {
+		return status.Errorf(codes.InvalidArgument, "%s: %v", message, err)
+	} else if errors.As(err, &domainErr) && domainErr.Code == domainErrors.CodeUnauthenticated {
+		return status.Errorf(codes.Unauthenticated, "invalid user context: %v", err)
+	}
+	return status.Errorf(codes.Internal, "%s: %v", message, err)
+}
+
+// StartStudySession 开始学习会话
+func (s *LearningService) StartStudySession(ctx context.Context, req *pb.StartStudySessionRequest) (*pb.StartStudySessionResponse, error) {
+	types := make([]entity.MemoryUnitType, 0, len(req.Types))
+	for _, t := range req.Types {
+		if t == pb.MemoryUnitType_MEMORY_UNIT_TYPE_UNSPECIFIED {
+			continue
+		}
+		types = append(types, ToEntityMemoryUnitType(t))
+	}
+
+	session, items, err := s.sessionService.StartStudySession(ctx, &dto.StartStudySessionRequest{
+		Types:      types,
+		NewCount:   req.NewCount,
+		Level:      valueobject.WordDifficultyLevel(req.Level),
+		Tags:       req.Tags,
+		CourseID:   uint(req.CourseId),
+		NotebookID: entity.WordNotebookID(req.NotebookId),
+	})
+	if err != nil {
+		var domainErr *domainErrors.Error
+		if errors.Is(err, domainErrors.ErrNotebookNotFound) {
+			return nil, status.Errorf(codes.NotFound, "notebook with id %d not found", req.NotebookId)
+		} else if errors.As(err, &domainErr) && domainErr.Code == domainErrors.CodeNotFound {
+			return nil, status.Errorf(codes.NotFound, "course with id %d not found", req.CourseId)
+		} else if errors.As(err, &domainErr) && domainErr.Code == domainErrors.CodeInvalidArgument {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid study session request: %v", err)
+		} else if errors.As(err, &domainErr) && domainErr.Code == domainErrors.CodeUnauthenticated {
+			return nil, status.Errorf(codes.Unauthenticated, "invalid user context: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to start study session: %v", err)
+	}
+
+	return &pb.StartStudySessionResponse{
+		SessionId:   uint32(session.ID),
+		Items:       ToPBStudyItems(items),
+		ReviewCount: session.ReviewCount,
+		NewCount:    session.NewCount,
+	}, nil
+}
+
 // GetMemoryStatus 获取记忆单元状态
 func (s *LearningService) GetMemoryStatus(ctx context.Context, req *pb.GetMemoryStatusRequest) (*pb.GetMemoryStatusResponse, error) {
 	// 获取记忆单元
 	memoryUnit, err := s.memoryService.GetMemoryUnit(ctx, req.MemoryUnitId)
 	if err != nil {
+		var domainErr *domainErrors.Error
+		if errors.As(err, &domainErr) && domainErr.Code == domainErrors.CodeNotFound {
+			return nil, status.Errorf(codes.NotFound, "memory unit with id %d not found", req.MemoryUnitId)
+		} else if errors.As(err, &domainErr) && domainErr.Code == domainErrors.CodeUnauthenticated {
+			return nil, status.Errorf(codes.Unauthenticated, "invalid user context: %v", err)
+		}
 		return nil, err
 	}
 
@@ -199,7 +530,7 @@ func ToEntityMemoryUnitTypes(pbTypes []pb.MemoryUnitType) []entity.MemoryUnitTyp
 	}
 	entityTypes := make([]entity.MemoryUnitType, len(pbTypes))
 	for i, t := range pbTypes {
-		entityTypes[i] = entity.MemoryUnitType(t)
+		entityTypes[i] = ToEntityMemoryUnitType(t)
 	}
 	return entityTypes
 }
@@ -218,60 +549,27 @@ func (s *LearningService) GetMemoryStats(ctx context.Context, req *pb.GetMemoryS
 	log := logger.GetLogger(ctx)
 	log.Info("GetMemoryStats called", zap.Any("request", req))
 
-	// 处理可选的类型过滤
-	var unitType *entity.MemoryUnitType
+	// 未指定类型时汇总全部类型
+	statsReq := &dto.MemoryStatsRequest{RetentionWindows: req.RetentionWindows}
 	if req.Type != nil {
-		t := ToEntityMemoryUnitType(req.GetType())
-		unitType = &t
+		statsReq.Types = []entity.MemoryUnitType{ToEntityMemoryUnitType(req.GetType())}
 	}
 
 	// TODO: 处理 tag 和 category 过滤 (需要修改 service 和 repository)
 
-	// 调用 memory service 获取统计信息
-	stats, err := s.memoryService.GetMemoryStats(ctx, unitType)
+	stats, err := s.memoryService.GetMemoryStats(ctx, statsReq)
 	if err != nil {
 		log.Error("Failed to get memory stats from service", zap.Error(err))
-		return nil, status.Error(codes.Internal, "failed to get memory stats")
-	}
-
-	if stats == nil { // Handle case where service returns nil stats (e.g., no data)
-		log.Warn("Memory service returned nil stats")
-		return &pb.GetMemoryStatsResponse{}, nil // Return empty response
-	}
-
-	// 将领域统计信息映射到 PB 响应
-	pbResponse := &pb.GetMemoryStatsResponse{
-		TotalLearned:    uint32(stats.TotalCount),                     // 映射 TotalCount
-		MasteredCount:   uint32(stats.MasteredCount),                  // 映射 MasteredCount
-		NeedReviewCount: uint32(stats.LearningCount + stats.NewCount), // 估算: 学习中+新
-		TotalStudyTime:  0,                                            // repository.MemoryUnitStats 没有这个字段
-		LevelStats:      make(map[uint32]uint32),
-		RetentionRates:  make(map[uint32]float32),
-	}
-
-	// 填充 level_stats
-	pbResponse.LevelStats[uint32(entity.MasteryLevelMastered)] = uint32(stats.MasteredCount)
-	pbResponse.LevelStats[uint32(entity.MasteryLevelBeginner)] = 0 // Placeholder
-	pbResponse.LevelStats[uint32(entity.MasteryLevelFamiliar)] = 0 // Placeholder
-	if stats.LearningCount > 0 {                                   // Approximate learning count split if needed, better handled in repo/service
-		// This is just a guess, real breakdown needs more data
-		pbResponse.LevelStats[uint32(entity.MasteryLevelBeginner)] = uint32(stats.LearningCount / 2)
-		pbResponse.LevelStats[uint32(entity.MasteryLevelFamiliar)] = uint32(stats.LearningCount) - pbResponse.LevelStats[uint32(entity.MasteryLevelBeginner)]
-	}
-	pbResponse.LevelStats[uint32(entity.MasteryLevelUnlearned)] = uint32(stats.NewCount)
-	// Add Expert level if tracked
-	// pbResponse.LevelStats[uint32(entity.MasteryLevelExpert)] = ...
-
-	// 填充 retention_rates
-	if stats.TotalCount > 0 && stats.RetentionRate > 0 {
-		if unitType != nil {
-			pbResponse.RetentionRates[uint32(*unitType)] = float32(stats.RetentionRate)
-		} else {
-			// Maybe add an 'overall' key or calculate per type if repo provided richer data
-			pbResponse.RetentionRates[0] = float32(stats.RetentionRate) // Use 0 for overall/unspecified?
+		var domainErr *domainErrors.Error
+		if errors.As(err, &domainErr) && domainErr.Code == domainErrors.CodeInvalidArgument {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid stats request: %v", err)
+		} else if errors.As(err, &domainErr) && domainErr.Code == domainErrors.CodeUnauthenticated {
+			return nil, status.Errorf(codes.Unauthenticated, "invalid user context: %v", err)
 		}
+		return nil, status.Error(codes.Internal, "failed to get memory stats")
 	}
 
+	pbResponse := ToPBMemoryStats(stats)
 	log.Info("GetMemoryStats successful", zap.Any("response", pbResponse))
 	return pbResponse, nil
 }
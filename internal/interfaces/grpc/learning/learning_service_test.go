@@ -5,6 +5,9 @@ import (
 	"fmt"
 	"net"
 	"os"
+	"strconv"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -12,6 +15,8 @@ import (
 	"github.com/lazyjean/sla2/internal/application/service"
 	"github.com/lazyjean/sla2/internal/domain/entity"
 	"github.com/lazyjean/sla2/internal/domain/repository"
+	domainService "github.com/lazyjean/sla2/internal/domain/service"
+	"github.com/lazyjean/sla2/internal/infrastructure/cache"
 	pg "github.com/lazyjean/sla2/internal/infrastructure/persistence/postgres"
 	"github.com/lazyjean/sla2/pkg/logger"
 	"github.com/stretchr/testify/assert"
@@ -23,6 +28,7 @@ import (
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"gorm.io/gorm"
 )
 
@@ -33,6 +39,44 @@ var (
 	db             *gorm.DB
 )
 
+// memoryCache 测试用的内存缓存
+type memoryCache struct {
+	mu    sync.Mutex
+	items map[string]string
+}
+
+func newMemoryCache() *memoryCache {
+	return &memoryCache{items: make(map[string]string)}
+}
+
+func (c *memoryCache) Get(ctx context.Context, key string) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	value, ok := c.items[key]
+	if !ok {
+		return "", fmt.Errorf("cache miss: %s", key)
+	}
+	return value, nil
+}
+
+func (c *memoryCache) Set(ctx context.Context, key string, value string, expiration time.Duration) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.items[key] = value
+	return nil
+}
+
+func (c *memoryCache) Delete(ctx context.Context, key string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.items, key)
+	return nil
+}
+
+func (c *memoryCache) Close() error {
+	return nil
+}
+
 // ensureLogger 初始化基础 logger (如果尚未初始化)
 func ensureLogger() {
 	if logger.Log == nil {
@@ -52,7 +96,10 @@ func SetupTestDB(t *testing.T) error {
 	// Run migrations to ensure schema matches entities
 	err := db.AutoMigrate(
 		&entity.MemoryUnit{}, // Add other entities if needed for tests in this package
-		&entity.HanChar{},    // Ensure HanChar is migrated here too if SetupTestDB is used elsewhere
+		&entity.MemoryReview{},
+		&entity.StudySession{},
+		&entity.UserSettings{},
+		&entity.HanChar{}, // Ensure HanChar is migrated here too if SetupTestDB is used elsewhere
 		// &entity.CourseLearningProgress{}, // Example
 		// &entity.CourseSectionProgress{}, // Example
 		// &entity.CourseSectionUnitProgress{}, // Example
@@ -69,6 +116,18 @@ func SetupTestDB(t *testing.T) error {
 		logger.Log.Error("Failed to truncate memory_units", zap.Error(err))
 		return err
 	}
+	if err := db.Exec("TRUNCATE TABLE memory_reviews").Error; err != nil {
+		logger.Log.Error("Failed to truncate memory_reviews", zap.Error(err))
+		return err
+	}
+	if err := db.Exec("TRUNCATE TABLE study_sessions").Error; err != nil {
+		logger.Log.Error("Failed to truncate study_sessions", zap.Error(err))
+		return err
+	}
+	if err := db.Exec("TRUNCATE TABLE user_settings").Error; err != nil {
+		logger.Log.Error("Failed to truncate user_settings", zap.Error(err))
+		return err
+	}
 	logger.Log.Info("Truncating han_chars table...") // Truncate HanChar here too
 	if err := db.Exec("TRUNCATE TABLE han_chars CASCADE").Error; err != nil {
 		logger.Log.Error("Failed to truncate han_chars", zap.Error(err))
@@ -79,13 +138,24 @@ func SetupTestDB(t *testing.T) error {
 	// Initialize repositories used in tests
 	learningRepo = pg.NewLearningRepository(db)
 	memoryUnitRepo = pg.NewMemoryUnitRepository(db)
-	hanCharRepo := pg.NewHanCharRepository(db)
+	contents := pg.NewContentRepositories(db)
 	wordRepo := pg.NewWordRepository(db)
+	schedulers, err := domainService.NewSchedulerProvider(nil, nil, domainService.SchedulerHeuristic, domainService.SchedulerOptions{})
+	if err != nil {
+		return err
+	}
 
 	// Initialize services needed for tests (can be done here or in TestMain/specific tests)
-	memoryService := service.NewMemoryService(wordRepo, memoryUnitRepo, hanCharRepo)
+	reviewRepo := pg.NewMemoryReviewRepository(db)
+	sessionRepo := pg.NewStudySessionRepository(db)
+	settingsRepo := pg.NewUserSettingsRepository(db)
+	activityCache := cache.NewStudyActivityCache(newMemoryCache())
+	memoryService := service.NewMemoryService(wordRepo, memoryUnitRepo, contents, reviewRepo, sessionRepo, settingsRepo, pg.NewTransactionManager(db), schedulers, activityCache, pg.NewCourseRepository(db))
+	sessionService := service.NewStudySessionService(memoryUnitRepo, reviewRepo, sessionRepo, contents, pg.NewCourseRepository(db), settingsRepo, pg.NewWordNotebookRepository(db))
+	activityService := service.NewStudyActivityService(domainService.NewMemoryStatsService(memoryUnitRepo, reviewRepo, settingsRepo), settingsRepo, activityCache)
 	learningService := service.NewLearningService(learningRepo, memoryService)
-	grpcService = NewLearningService(learningService, memoryService)
+	hanCharLearningService := service.NewHanCharLearningService(pg.NewHanCharRepository(db), pg.NewHanCharLearningRepository(db), memoryUnitRepo, pg.NewHanCharStrokesRepository(db), pg.NewContentRepositories(db), memoryService)
+	grpcService = NewLearningService(learningService, memoryService, sessionService, activityService, hanCharLearningService)
 
 	return nil
 }
@@ -103,6 +173,9 @@ func setupRealGrpcTest(t *testing.T) (context.Context, pb.LearningServiceClient,
 	// Migrations (Ensure all necessary entities are included)
 	err := testDB.AutoMigrate(
 		&entity.MemoryUnit{},
+		&entity.MemoryReview{},
+		&entity.StudySession{},
+		&entity.UserSettings{},
 		&entity.HanChar{}, // Ensure HanChar is migrated
 		// Add other entities specific to this test suite if needed
 	)
@@ -110,16 +183,28 @@ func setupRealGrpcTest(t *testing.T) (context.Context, pb.LearningServiceClient,
 
 	// Truncate tables (Ensure all necessary tables are truncated)
 	require.NoError(t, testDB.Exec("TRUNCATE TABLE memory_units CASCADE").Error, "Truncate memory_units failed in setupRealGrpcTest")
+	require.NoError(t, testDB.Exec("TRUNCATE TABLE memory_reviews").Error, "Truncate memory_reviews failed in setupRealGrpcTest")
+	require.NoError(t, testDB.Exec("TRUNCATE TABLE study_sessions").Error, "Truncate study_sessions failed in setupRealGrpcTest")
+	require.NoError(t, testDB.Exec("TRUNCATE TABLE user_settings").Error, "Truncate user_settings failed in setupRealGrpcTest")
 	require.NoError(t, testDB.Exec("TRUNCATE TABLE han_chars CASCADE").Error, "Truncate han_chars failed in setupRealGrpcTest")
 	// Add other truncations if needed
 
 	// --- Initialize Repositories (Local Scope) ---
 	localLearningRepo := pg.NewLearningRepository(testDB)
 	localMemoryUnitRepo := pg.NewMemoryUnitRepository(testDB)
-	localHanCharRepo := pg.NewHanCharRepository(testDB)
+	localContents := pg.NewContentRepositories(testDB)
 	localWordRepo := pg.NewWordRepository(testDB)
-	localMemoryService := service.NewMemoryService(localWordRepo, localMemoryUnitRepo, localHanCharRepo)
+	localSchedulers, err := domainService.NewSchedulerProvider(nil, nil, domainService.SchedulerHeuristic, domainService.SchedulerOptions{})
+	require.NoError(t, err, "Scheduler setup failed in setupRealGrpcTest")
+	localReviewRepo := pg.NewMemoryReviewRepository(testDB)
+	localSessionRepo := pg.NewStudySessionRepository(testDB)
+	localSettingsRepo := pg.NewUserSettingsRepository(testDB)
+	localActivityCache := cache.NewStudyActivityCache(newMemoryCache())
+	localMemoryService := service.NewMemoryService(localWordRepo, localMemoryUnitRepo, localContents, localReviewRepo, localSessionRepo, localSettingsRepo, pg.NewTransactionManager(testDB), localSchedulers, localActivityCache, pg.NewCourseRepository(testDB))
+	localSessionService := service.NewStudySessionService(localMemoryUnitRepo, localReviewRepo, localSessionRepo, localContents, pg.NewCourseRepository(testDB), localSettingsRepo, pg.NewWordNotebookRepository(testDB))
+	localActivityService := service.NewStudyActivityService(domainService.NewMemoryStatsService(localMemoryUnitRepo, localReviewRepo, localSettingsRepo), localSettingsRepo, localActivityCache)
 	localLearningService := service.NewLearningService(localLearningRepo, localMemoryService)
+	localHanCharLearningService := service.NewHanCharLearningService(pg.NewHanCharRepository(testDB), pg.NewHanCharLearningRepository(testDB), localMemoryUnitRepo, pg.NewHanCharStrokesRepository(testDB), pg.NewContentRepositories(testDB), localMemoryService)
 
 	// --- Setup gRPC Server ---
 	ctx := context.Background()
@@ -145,8 +230,11 @@ func setupRealGrpcTest(t *testing.T) (context.Context, pb.LearningServiceClient,
 
 		// In a real app, validate the token ("Bearer test-token")
 		// For this test, we assume it's valid and corresponds to a user.
-		// Extract the UserID used in the test (User ID 1)
+		// "Bearer test-token" maps to User ID 1, "Bearer test-token-<id>" maps to <id>
 		testUserID := entity.UID(1) // Match the ID used in TestHanCharLearningFlow
+		if id, err := strconv.ParseUint(strings.TrimPrefix(authHeader[0], "Bearer test-token-"), 10, 32); err == nil {
+			testUserID = entity.UID(id)
+		}
 		newCtx := service.WithUserID(ctx, testUserID)
 		return handler(newCtx, req)
 	}
@@ -156,7 +244,7 @@ func setupRealGrpcTest(t *testing.T) (context.Context, pb.LearningServiceClient,
 	)
 
 	// Create the specific gRPC service instance using local services
-	grpcLearningSvcImpl := NewLearningService(localLearningService, localMemoryService)
+	grpcLearningSvcImpl := NewLearningService(localLearningService, localMemoryService, localSessionService, localActivityService, localHanCharLearningService)
 
 	// Register the service
 	pb.RegisterLearningServiceServer(grpcServer, grpcLearningSvcImpl)
@@ -344,7 +432,8 @@ func TestUpdateMemoryStatus(t *testing.T) {
 		MasteryLevel:  pb.MasteryLevel_MASTERY_LEVEL_FAMILIAR,
 		StudyDuration: 100,
 	}
-	resp, err := grpcService.UpdateMemoryStatus(context.Background(), req)
+	ctx := service.WithUserID(context.Background(), unit.UserID)
+	resp, err := grpcService.UpdateMemoryStatus(ctx, req)
 	if err != nil {
 		t.Fatalf("Failed to update memory status: %v", err)
 	}
@@ -353,12 +442,36 @@ func TestUpdateMemoryStatus(t *testing.T) {
 	assert.NotNil(t, resp)
 
 	// 验证数据库中的更新
-	updatedUnit, err := memoryUnitRepo.GetByID(context.Background(), uint32(unit.ID))
+	updatedUnit, err := memoryUnitRepo.GetByID(context.Background(), unit.UserID, uint32(unit.ID))
 	if err != nil {
 		t.Fatalf("Failed to get updated unit: %v", err)
 	}
 	assert.Equal(t, entity.MasteryLevelFamiliar, updatedUnit.MasteryLevel)
 	assert.Equal(t, uint32(100), updatedUnit.StudyDuration)
+	assert.Equal(t, unit.NextReviewAt.Unix(), updatedUnit.NextReviewAt.Unix())
+
+	// 手动设置的掌握程度记录到复习日志
+	reviews, err := pg.NewMemoryReviewRepository(db).ListByMemoryUnitID(context.Background(), uint32(unit.ID), 0, 10)
+	require.NoError(t, err)
+	require.Len(t, reviews, 1)
+	assert.Equal(t, entity.ReviewActionSetMastery, reviews[0].Action)
+	assert.Equal(t, entity.MasteryLevelBeginner, reviews[0].MasteryBefore)
+	assert.Equal(t, entity.MasteryLevelFamiliar, reviews[0].MasteryAfter)
+
+	// 无效的掌握程度
+	_, err = grpcService.UpdateMemoryStatus(ctx, &pb.UpdateMemoryStatusRequest{MemoryUnitId: uint32(unit.ID), MasteryLevel: pb.MasteryLevel(9)})
+	require.Error(t, err)
+	st, ok := status.FromError(err)
+	require.True(t, ok, "Error should be a gRPC status error")
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+
+	// 其他用户无法更新该记忆单元
+	otherCtx := service.WithUserID(context.Background(), entity.UID(2))
+	_, err = grpcService.UpdateMemoryStatus(otherCtx, req)
+	require.Error(t, err)
+	st, ok = status.FromError(err)
+	require.True(t, ok, "Error should be a gRPC status error")
+	assert.Equal(t, codes.NotFound, st.Code())
 }
 
 func TestReviewWord(t *testing.T) {
@@ -580,7 +693,44 @@ func TestHanCharLearningFlow(t *testing.T) {
 		t.Log("Completed multiple learning attempts.")
 	})
 
-	// --- 4. Check Statistics ---
+	// --- 4. Check Review History ---
+	t.Run("ReviewHistory", func(t *testing.T) {
+		unit, err := pg.NewMemoryUnitRepository(db).GetByTypeAndContentID(ctx, entity.UID(1), entity.MemoryUnitTypeHanChar, uint32(charIDs["测"]))
+		require.NoError(t, err)
+		require.NotNil(t, unit, "MemoryUnit for '测' should exist")
+
+		res, err := client.ListMemoryReviews(userCtx, &pb.ListMemoryReviewsRequest{MemoryUnitId: uint32(unit.ID)})
+		require.NoError(t, err, "ListMemoryReviews should succeed")
+		require.Equal(t, uint32(3), res.Total)
+		require.Len(t, res.Reviews, 3)
+
+		expectedGrades := []pb.ReviewGrade{pb.ReviewGrade_REVIEW_GRADE_GOOD, pb.ReviewGrade_REVIEW_GRADE_GOOD, pb.ReviewGrade_REVIEW_GRADE_AGAIN}
+		for i, review := range res.Reviews {
+			assert.Equal(t, expectedGrades[i], review.Grade)
+			assert.NotNil(t, review.ScheduledReviewTime)
+			if i > 0 {
+				assert.False(t, review.ReviewTime.AsTime().Before(res.Reviews[i-1].ReviewTime.AsTime()), "Reviews should be in chronological order")
+				assert.Equal(t, res.Reviews[i-1].MasteryAfter, review.MasteryBefore, "Mastery transitions should chain")
+			}
+		}
+		assert.Equal(t, uint32(1000), res.Reviews[0].ResponseTime)
+
+		// 分页
+		page, err := client.ListMemoryReviews(userCtx, &pb.ListMemoryReviewsRequest{MemoryUnitId: uint32(unit.ID), Page: 2, PageSize: 2})
+		require.NoError(t, err)
+		require.Equal(t, uint32(3), page.Total)
+		require.Len(t, page.Reviews, 1)
+		assert.Equal(t, res.Reviews[2].Id, page.Reviews[0].Id)
+
+		// 不存在的记忆单元
+		_, err = client.ListMemoryReviews(userCtx, &pb.ListMemoryReviewsRequest{MemoryUnitId: 999999})
+		require.Error(t, err)
+		st, ok := status.FromError(err)
+		require.True(t, ok, "Error should be a gRPC status error")
+		assert.Equal(t, codes.NotFound, st.Code())
+	})
+
+	// --- 5. Check Statistics ---
 	t.Run("CheckStats", func(t *testing.T) {
 		memoryType := pb.MemoryUnitType_MEMORY_UNIT_TYPE_HAN_CHAR // Define the type
 		statsReq := &pb.GetMemoryStatsRequest{
@@ -613,14 +763,974 @@ func TestHanCharLearningFlow(t *testing.T) {
 		actualNonMasteredSum := stats.LevelStats[beginnerKey] + stats.LevelStats[familiarKey] // Sum non-mastered levels tracked in LevelStats
 		require.Equal(t, expectedTotalLearned, actualNonMasteredSum, "Sum of non-mastered tracked levels (Beginner, Familiar) should match total learned count")
 
-		// Check default request
+		assert.Equal(t, map[uint32]uint32{uint32(entity.MemoryUnitTypeHanChar): expectedTotalLearned}, stats.TypeStats)
+		assert.NotZero(t, stats.TotalStudyTime, "Study time should be accumulated from reviews")
+		require.Len(t, stats.Retention, len(service.DefaultRetentionWindows), "Retention should be reported for each default window")
+		assert.Equal(t, service.DefaultRetentionWindows[0], stats.Retention[0].Days)
+
+		// 未指定类型时汇总全部类型，目前只学习了汉字
 		statsReqDefault := &pb.GetMemoryStatsRequest{}
 		statsResDefault, errDefault := client.GetMemoryStats(userCtx, statsReqDefault)
-		require.NoError(t, errDefault, "GetMemoryStats with default type should succeed")
-		require.NotNil(t, statsResDefault, "Default stats response should not be nil")
-		require.Equal(t, stats.TotalLearned, statsResDefault.TotalLearned, "Default stats (HAN_CHAR) should match explicitly requested HAN_CHAR stats")
+		require.NoError(t, errDefault, "GetMemoryStats without type should succeed")
+		require.NotNil(t, statsResDefault, "Aggregate stats response should not be nil")
+		require.Equal(t, stats.TotalLearned, statsResDefault.TotalLearned, "Aggregate stats should match HAN_CHAR stats while only han chars are learned")
+
+		// 自定义统计窗口
+		statsResWindow, err := client.GetMemoryStats(userCtx, &pb.GetMemoryStatsRequest{RetentionWindows: []uint32{1, 365}})
+		require.NoError(t, err)
+		require.Len(t, statsResWindow.Retention, 2)
+		assert.Equal(t, uint32(365), statsResWindow.Retention[1].Days)
+
+		_, err = client.GetMemoryStats(userCtx, &pb.GetMemoryStatsRequest{RetentionWindows: []uint32{0}})
+		st, ok := status.FromError(err)
+		require.True(t, ok, "Error should be a gRPC status error")
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+
+	})
+
+	// --- 6. Study Session ---
+	t.Run("StudySession", func(t *testing.T) {
+		newCount := uint32(1)
+		res, err := client.StartStudySession(userCtx, &pb.StartStudySessionRequest{
+			Types:    []pb.MemoryUnitType{pb.MemoryUnitType_MEMORY_UNIT_TYPE_HAN_CHAR},
+			NewCount: &newCount,
+		})
+		require.NoError(t, err, "StartStudySession should succeed")
+		require.NotZero(t, res.SessionId)
+		require.Equal(t, uint32(1), res.NewCount)
+		require.Len(t, res.Items, int(res.ReviewCount+res.NewCount))
+
+		// 新学习项为尚未学习的汉字中 ID 最小的一个
+		var fresh *pb.StudyItem
+		for _, item := range res.Items {
+			if item.IsNew {
+				fresh = item
+			}
+		}
+		require.NotNil(t, fresh)
+		assert.Equal(t, uint32(charIDs["学"]), fresh.ContentId)
+		assert.Equal(t, "学", fresh.Text)
+		assert.Zero(t, fresh.MemoryUnitId)
+
+		// 复习记录归属到学习会话
+		_, err = client.ReviewHanChar(userCtx, &pb.ReviewHanCharRequest{
+			HanCharId:    fresh.ContentId,
+			Result:       pb.ReviewResult_REVIEW_RESULT_CORRECT,
+			ResponseTime: 800,
+			SessionId:    res.SessionId,
+		})
+		require.NoError(t, err, "Review within the study session should succeed")
+
+		var sessionReviews int64
+		require.NoError(t, db.Model(&entity.MemoryReview{}).Where("session_id = ?", res.SessionId).Count(&sessionReviews).Error)
+		assert.Equal(t, int64(1), sessionReviews)
+
+		// 其他用户的学习会话不可使用
+		otherCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs("authorization", "Bearer test-token-2"))
+		_, err = client.ReviewHanChar(otherCtx, &pb.ReviewHanCharRequest{
+			HanCharId: fresh.ContentId,
+			Result:    pb.ReviewResult_REVIEW_RESULT_CORRECT,
+			SessionId: res.SessionId,
+		})
+		require.Error(t, err)
+		st, ok := status.FromError(err)
+		require.True(t, ok, "Error should be a gRPC status error")
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+
+		// 已学习的内容不再作为新学习项
+		next, err := client.StartStudySession(userCtx, &pb.StartStudySessionRequest{
+			Types:    []pb.MemoryUnitType{pb.MemoryUnitType_MEMORY_UNIT_TYPE_HAN_CHAR},
+			NewCount: &newCount,
+		})
+		require.NoError(t, err)
+		require.Equal(t, uint32(1), next.NewCount)
+		for _, item := range next.Items {
+			if item.IsNew {
+				assert.Equal(t, uint32(charIDs["习"]), item.ContentId)
+			}
+		}
+	})
+
+	t.Run("ReviewBatch", func(t *testing.T) {
+		base := time.Now().Add(-2 * time.Hour).Truncate(time.Second)
+		hanChar := func(key string, contentID entity.HanCharID, grade pb.ReviewGrade, reviewedAt time.Time) *pb.BatchReviewItem {
+			return &pb.BatchReviewItem{
+				IdempotencyKey: key,
+				Type:           pb.MemoryUnitType_MEMORY_UNIT_TYPE_HAN_CHAR,
+				ContentId:      uint32(contentID),
+				Grade:          grade,
+				ResponseTime:   1000,
+				ReviewedAt:     timestamppb.New(reviewedAt),
+			}
+		}
+
+		res, err := client.SubmitReviewBatch(userCtx, &pb.SubmitReviewBatchRequest{
+			Reviews: []*pb.BatchReviewItem{
+				hanChar("batch-1", charIDs["习"], pb.ReviewGrade_REVIEW_GRADE_GOOD, base.Add(time.Hour)),
+				hanChar("batch-2", charIDs["习"], pb.ReviewGrade_REVIEW_GRADE_AGAIN, base),
+				hanChar("batch-1", charIDs["习"], pb.ReviewGrade_REVIEW_GRADE_EASY, base.Add(90*time.Minute)),
+				hanChar("batch-3", 999999, pb.ReviewGrade_REVIEW_GRADE_GOOD, base),
+				hanChar("batch-4", charIDs["学"], pb.ReviewGrade_REVIEW_GRADE_GOOD, base),
+				{IdempotencyKey: "batch-5", Type: pb.MemoryUnitType_MEMORY_UNIT_TYPE_HAN_CHAR, ContentId: uint32(charIDs["习"]), Grade: pb.ReviewGrade_REVIEW_GRADE_GOOD},
+			},
+		})
+		require.NoError(t, err, "SubmitReviewBatch should succeed")
+		require.Len(t, res.Results, 6)
+
+		statuses := make([]pb.BatchReviewStatus, len(res.Results))
+		for i, r := range res.Results {
+			statuses[i] = r.Status
+		}
+		assert.Equal(t, []pb.BatchReviewStatus{
+			pb.BatchReviewStatus_BATCH_REVIEW_STATUS_APPLIED,
+			pb.BatchReviewStatus_BATCH_REVIEW_STATUS_APPLIED,
+			pb.BatchReviewStatus_BATCH_REVIEW_STATUS_DUPLICATE,
+			pb.BatchReviewStatus_BATCH_REVIEW_STATUS_NOT_FOUND,
+			pb.BatchReviewStatus_BATCH_REVIEW_STATUS_CONFLICT,
+			pb.BatchReviewStatus_BATCH_REVIEW_STATUS_INVALID,
+		}, statuses)
+		assert.Equal(t, res.Results[0].MemoryUnitId, res.Results[1].MemoryUnitId)
+
+		// 按复习时间顺序应用：先忘记后良好
+		unit, err := pg.NewMemoryUnitRepository(db).GetByTypeAndContentID(ctx, entity.UID(1), entity.MemoryUnitTypeHanChar, uint32(charIDs["习"]))
+		require.NoError(t, err)
+		require.NotNil(t, unit)
+		assert.Equal(t, uint32(2), unit.ReviewCount)
+		assert.Equal(t, uint32(1), unit.ConsecutiveCorrect)
+		assert.Zero(t, unit.ConsecutiveWrong)
+		assert.True(t, unit.LastReviewAt.Equal(base.Add(time.Hour)), "LastReviewAt should be the latest client review time")
+
+		reviews, err := client.ListMemoryReviews(userCtx, &pb.ListMemoryReviewsRequest{MemoryUnitId: uint32(unit.ID)})
+		require.NoError(t, err)
+		require.Len(t, reviews.Reviews, 2)
+		assert.Equal(t, pb.ReviewGrade_REVIEW_GRADE_AGAIN, reviews.Reviews[0].Grade)
+		assert.True(t, reviews.Reviews[0].ReviewTime.AsTime().Equal(base))
+
+		// 重复提交不会重复应用
+		res, err = client.SubmitReviewBatch(userCtx, &pb.SubmitReviewBatchRequest{
+			Reviews: []*pb.BatchReviewItem{hanChar("batch-1", charIDs["习"], pb.ReviewGrade_REVIEW_GRADE_GOOD, base.Add(time.Hour))},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, pb.BatchReviewStatus_BATCH_REVIEW_STATUS_DUPLICATE, res.Results[0].Status)
+
+		// 超过数量上限
+		oversized := make([]*pb.BatchReviewItem, service.MaxReviewBatchSize+1)
+		for i := range oversized {
+			oversized[i] = hanChar(fmt.Sprintf("oversized-%d", i), charIDs["习"], pb.ReviewGrade_REVIEW_GRADE_GOOD, base)
+		}
+		_, err = client.SubmitReviewBatch(userCtx, &pb.SubmitReviewBatchRequest{Reviews: oversized})
+		require.Error(t, err)
+		st, ok := status.FromError(err)
+		require.True(t, ok, "Error should be a gRPC status error")
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+	})
 
+	t.Run("GenericReview", func(t *testing.T) {
+		phrase := entity.NewPhrase("break the ice", entity.PhraseKindIdiom, "打破僵局", 2)
+		require.NoError(t, pg.NewPhraseRepository(db).Create(ctx, phrase))
+
+		_, err := client.Review(userCtx, &pb.ReviewRequest{
+			Type:         pb.MemoryUnitType_MEMORY_UNIT_TYPE_PHRASE,
+			ContentId:    uint32(phrase.ID),
+			Grade:        pb.ReviewGrade_REVIEW_GRADE_GOOD,
+			ResponseTime: 1500,
+		})
+		require.NoError(t, err, "Review of a phrase should succeed")
+
+		unit, err := pg.NewMemoryUnitRepository(db).GetByTypeAndContentID(ctx, entity.UID(1), entity.MemoryUnitTypePhrase, uint32(phrase.ID))
+		require.NoError(t, err)
+		require.NotNil(t, unit)
+		assert.Equal(t, uint32(1), unit.ReviewCount)
+
+		// 短语与汉字共用复习队列
+		res, err := client.ListMemoriesForReview(userCtx, &pb.ListMemoriesForReviewRequest{
+			Page:     1,
+			PageSize: 10,
+			Types:    []pb.MemoryUnitType{pb.MemoryUnitType_MEMORY_UNIT_TYPE_PHRASE},
+		})
+		require.NoError(t, err)
+		assert.Zero(t, res.Total, "Phrase should not be due right after review")
+
+		// 不存在的内容
+		_, err = client.Review(userCtx, &pb.ReviewRequest{
+			Type:      pb.MemoryUnitType_MEMORY_UNIT_TYPE_SENTENCE,
+			ContentId: 999999,
+			Grade:     pb.ReviewGrade_REVIEW_GRADE_GOOD,
+		})
+		st, ok := status.FromError(err)
+		require.True(t, ok, "Error should be a gRPC status error")
+		assert.Equal(t, codes.NotFound, st.Code())
+
+		// 未指定类型
+		_, err = client.Review(userCtx, &pb.ReviewRequest{
+			ContentId: uint32(phrase.ID),
+			Grade:     pb.ReviewGrade_REVIEW_GRADE_GOOD,
+		})
+		st, ok = status.FromError(err)
+		require.True(t, ok, "Error should be a gRPC status error")
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+	})
+
+	t.Run("Leeches", func(t *testing.T) {
+		sentence := entity.NewSentence("She sells seashells by the seashore.", "她在海边卖贝壳。", 1)
+		require.NoError(t, pg.NewSentenceRepository(db).Create(ctx, sentence))
+
+		// 默认遗忘8次后标记为顽固项并暂停复习
+		for i := 0; i < entity.DefaultLeechThreshold; i++ {
+			_, err := client.Review(userCtx, &pb.ReviewRequest{
+				Type:      pb.MemoryUnitType_MEMORY_UNIT_TYPE_SENTENCE,
+				ContentId: uint32(sentence.ID),
+				Grade:     pb.ReviewGrade_REVIEW_GRADE_AGAIN,
+			})
+			require.NoError(t, err)
+		}
+
+		leeches, err := client.ListLeeches(userCtx, &pb.ListLeechesRequest{
+			Types: []pb.MemoryUnitType{pb.MemoryUnitType_MEMORY_UNIT_TYPE_SENTENCE},
+		})
+		require.NoError(t, err)
+		require.Equal(t, uint32(1), leeches.Total)
+		leech := leeches.Leeches[0]
+		assert.Equal(t, uint32(sentence.ID), leech.ContentId)
+		assert.Equal(t, uint32(entity.DefaultLeechThreshold), leech.Lapses)
+		assert.True(t, leech.IsLeech)
+		assert.True(t, leech.Suspended)
+
+		// 已暂停的记忆单元不出现在复习队列中
+		require.NoError(t, db.Model(&entity.MemoryUnit{}).Where("id = ?", leech.Id).
+			Update("next_review_at", time.Now().Add(-time.Minute)).Error)
+		review, err := client.ListMemoriesForReview(userCtx, &pb.ListMemoriesForReviewRequest{
+			Page:     1,
+			PageSize: 10,
+			Types:    []pb.MemoryUnitType{pb.MemoryUnitType_MEMORY_UNIT_TYPE_SENTENCE},
+		})
+		require.NoError(t, err)
+		assert.Zero(t, review.Total)
+
+		// 教师可以看到难点内容
+		hard, err := client.ListHardContents(userCtx, &pb.ListHardContentsRequest{
+			Types: []pb.MemoryUnitType{pb.MemoryUnitType_MEMORY_UNIT_TYPE_SENTENCE},
+		})
+		require.NoError(t, err)
+		require.Len(t, hard.Contents, 1)
+		assert.Equal(t, sentence.Text, hard.Contents[0].Text)
+		assert.Equal(t, uint32(1), hard.Contents[0].LeechCount)
+
+		// 恢复复习后保留顽固项标记
+		unsuspended, err := client.UnsuspendMemoryUnit(userCtx, &pb.UnsuspendMemoryUnitRequest{MemoryUnitId: leech.Id})
+		require.NoError(t, err)
+		assert.False(t, unsuspended.Status.Suspended)
+		assert.True(t, unsuspended.Status.IsLeech)
+		review, err = client.ListMemoriesForReview(userCtx, &pb.ListMemoriesForReviewRequest{
+			Page:     1,
+			PageSize: 10,
+			Types:    []pb.MemoryUnitType{pb.MemoryUnitType_MEMORY_UNIT_TYPE_SENTENCE},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, uint32(1), review.Total)
+
+		// 清除顽固项状态
+		reset, err := client.ResetLeech(userCtx, &pb.ResetLeechRequest{MemoryUnitId: leech.Id})
+		require.NoError(t, err)
+		assert.False(t, reset.Status.IsLeech)
+		assert.Zero(t, reset.Status.Lapses)
+
+		// 其他用户的记忆单元视为不存在
+		otherUserCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs("authorization", "Bearer test-token-2"))
+		_, err = client.ResetLeech(otherUserCtx, &pb.ResetLeechRequest{MemoryUnitId: leech.Id})
+		st, ok := status.FromError(err)
+		require.True(t, ok, "Error should be a gRPC status error")
+		assert.Equal(t, codes.NotFound, st.Code())
 	})
 
 	t.Log("HanChar learning flow test completed successfully.")
 }
+
+func TestToEntityReviewGrade(t *testing.T) {
+	tests := []struct {
+		name     string
+		result   pb.ReviewResult
+		grade    pb.ReviewGrade
+		expected entity.ReviewGrade
+	}{
+		{"仅结果正确", pb.ReviewResult_REVIEW_RESULT_CORRECT, pb.ReviewGrade_REVIEW_GRADE_UNSPECIFIED, entity.ReviewGradeGood},
+		{"仅结果错误", pb.ReviewResult_REVIEW_RESULT_WRONG, pb.ReviewGrade_REVIEW_GRADE_UNSPECIFIED, entity.ReviewGradeAgain},
+		{"评分优先", pb.ReviewResult_REVIEW_RESULT_CORRECT, pb.ReviewGrade_REVIEW_GRADE_EASY, entity.ReviewGradeEasy},
+		{"跳过忽略评分", pb.ReviewResult_REVIEW_RESULT_SKIP, pb.ReviewGrade_REVIEW_GRADE_HARD, entity.ReviewGradeSkip},
+		{"均未指定", pb.ReviewResult_REVIEW_RESULT_UNSPECIFIED, pb.ReviewGrade_REVIEW_GRADE_UNSPECIFIED, entity.ReviewGradeUnspecified},
+		{"未定义的评分", pb.ReviewResult_REVIEW_RESULT_CORRECT, pb.ReviewGrade(5), entity.ReviewGradeUnspecified},
+		{"超出范围的评分不截断", pb.ReviewResult_REVIEW_RESULT_CORRECT, pb.ReviewGrade(259), entity.ReviewGradeUnspecified},
+		{"未定义的结果", pb.ReviewResult(259), pb.ReviewGrade_REVIEW_GRADE_UNSPECIFIED, entity.ReviewGradeUnspecified},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, ToEntityReviewGrade(tt.result, tt.grade))
+		})
+	}
+}
+
+func TestToEntityMemoryUnitType(t *testing.T) {
+	assert.Equal(t, entity.MemoryUnitTypeHanChar, ToEntityMemoryUnitType(pb.MemoryUnitType_MEMORY_UNIT_TYPE_HAN_CHAR))
+	assert.Equal(t, entity.MemoryUnitTypeUnspecified, ToEntityMemoryUnitType(pb.MemoryUnitType(257)))
+	assert.Equal(t, entity.MemoryUnitTypeUnspecified, ToEntityMemoryUnitType(pb.MemoryUnitType(-1)))
+	assert.Equal(t, []entity.MemoryUnitType{entity.MemoryUnitTypeWord, entity.MemoryUnitTypeUnspecified},
+		ToEntityMemoryUnitTypes([]pb.MemoryUnitType{pb.MemoryUnitType_MEMORY_UNIT_TYPE_WORD, pb.MemoryUnitType(258)}))
+}
+
+// TestReviewSkipWithoutMemoryUnit 验证跳过尚未学习的内容不会创建记忆单元和复习记录
+func TestReviewSkipWithoutMemoryUnit(t *testing.T) {
+	ctx, client, db, cleanup := setupRealGrpcTest(t)
+	defer cleanup()
+
+	userCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs("authorization", "Bearer test-token-1"))
+
+	hanChar := &entity.HanChar{Character: "略", Pinyin: "lüè", Level: 1, Tags: []string{}, Categories: []string{}, Examples: []string{}}
+	_, err := pg.NewHanCharRepository(db).Create(ctx, hanChar)
+	require.NoError(t, err)
+
+	_, err = client.ReviewHanChar(userCtx, &pb.ReviewHanCharRequest{
+		HanCharId:    uint32(hanChar.ID),
+		Result:       pb.ReviewResult_REVIEW_RESULT_SKIP,
+		ResponseTime: 500,
+	})
+	require.NoError(t, err)
+
+	unit, err := pg.NewMemoryUnitRepository(db).GetByTypeAndContentID(ctx, entity.UID(1), entity.MemoryUnitTypeHanChar, uint32(hanChar.ID))
+	require.NoError(t, err)
+	assert.Nil(t, unit)
+	var reviews int64
+	require.NoError(t, db.Model(&entity.MemoryReview{}).Count(&reviews).Error)
+	assert.Zero(t, reviews)
+
+	// 批量复习中只有跳过时同样不创建记忆单元
+	base := time.Now().Add(-time.Hour).Truncate(time.Second)
+	item := func(key string, result pb.ReviewResult, reviewedAt time.Time) *pb.BatchReviewItem {
+		return &pb.BatchReviewItem{
+			IdempotencyKey: key,
+			Type:           pb.MemoryUnitType_MEMORY_UNIT_TYPE_HAN_CHAR,
+			ContentId:      uint32(hanChar.ID),
+			Result:         result,
+			ResponseTime:   500,
+			ReviewedAt:     timestamppb.New(reviewedAt),
+		}
+	}
+	batch, err := client.SubmitReviewBatch(userCtx, &pb.SubmitReviewBatchRequest{Reviews: []*pb.BatchReviewItem{
+		item("skip-1", pb.ReviewResult_REVIEW_RESULT_SKIP, base),
+		item("skip-2", pb.ReviewResult_REVIEW_RESULT_SKIP, base.Add(time.Minute)),
+	}})
+	require.NoError(t, err)
+	require.Len(t, batch.Results, 2)
+	for _, result := range batch.Results {
+		assert.Equal(t, pb.BatchReviewStatus_BATCH_REVIEW_STATUS_APPLIED, result.Status)
+		assert.Zero(t, result.MemoryUnitId)
+	}
+	unit, err = pg.NewMemoryUnitRepository(db).GetByTypeAndContentID(ctx, entity.UID(1), entity.MemoryUnitTypeHanChar, uint32(hanChar.ID))
+	require.NoError(t, err)
+	assert.Nil(t, unit)
+	require.NoError(t, db.Model(&entity.MemoryReview{}).Count(&reviews).Error)
+	assert.Zero(t, reviews)
+
+	// 跳过之后再复习时，从第一次复习开始创建记忆单元
+	batch, err = client.SubmitReviewBatch(userCtx, &pb.SubmitReviewBatchRequest{Reviews: []*pb.BatchReviewItem{
+		item("skip-3", pb.ReviewResult_REVIEW_RESULT_SKIP, base.Add(2*time.Minute)),
+		item("learn-1", pb.ReviewResult_REVIEW_RESULT_CORRECT, base.Add(3*time.Minute)),
+	}})
+	require.NoError(t, err)
+	require.Len(t, batch.Results, 2)
+	assert.Zero(t, batch.Results[0].MemoryUnitId)
+	assert.NotZero(t, batch.Results[1].MemoryUnitId)
+	unit, err = pg.NewMemoryUnitRepository(db).GetByTypeAndContentID(ctx, entity.UID(1), entity.MemoryUnitTypeHanChar, uint32(hanChar.ID))
+	require.NoError(t, err)
+	require.NotNil(t, unit)
+	assert.Equal(t, uint32(1), unit.ReviewCount)
+	assert.True(t, unit.CreatedAt.Equal(base.Add(3*time.Minute)))
+	require.NoError(t, db.Model(&entity.MemoryReview{}).Count(&reviews).Error)
+	assert.Equal(t, int64(1), reviews)
+}
+
+// TestMemoryUnitIsolation 验证不同用户的记忆单元互不可见、互不影响
+func TestMemoryUnitIsolation(t *testing.T) {
+	ctx, client, db, cleanup := setupRealGrpcTest(t)
+	defer cleanup()
+
+	user1Ctx := metadata.NewOutgoingContext(ctx, metadata.Pairs("authorization", "Bearer test-token-1"))
+	user2Ctx := metadata.NewOutgoingContext(ctx, metadata.Pairs("authorization", "Bearer test-token-2"))
+
+	hanChar := &entity.HanChar{Character: "隔", Pinyin: "gé", Level: 1, Tags: []string{}, Categories: []string{}, Examples: []string{}}
+	_, err := pg.NewHanCharRepository(db).Create(ctx, hanChar)
+	require.NoError(t, err)
+
+	// 两个用户复习同一个汉字，各自拥有独立的记忆单元
+	_, err = client.ReviewHanChar(user1Ctx, &pb.ReviewHanCharRequest{
+		HanCharId:    uint32(hanChar.ID),
+		Result:       pb.ReviewResult_REVIEW_RESULT_CORRECT,
+		ResponseTime: 1000,
+	})
+	require.NoError(t, err)
+	_, err = client.ReviewHanChar(user2Ctx, &pb.ReviewHanCharRequest{
+		HanCharId:    uint32(hanChar.ID),
+		Result:       pb.ReviewResult_REVIEW_RESULT_WRONG,
+		ResponseTime: 2000,
+	})
+	require.NoError(t, err)
+
+	repo := pg.NewMemoryUnitRepository(db)
+	unit1, err := repo.GetByTypeAndContentID(ctx, entity.UID(1), entity.MemoryUnitTypeHanChar, uint32(hanChar.ID))
+	require.NoError(t, err)
+	require.NotNil(t, unit1)
+	unit2, err := repo.GetByTypeAndContentID(ctx, entity.UID(2), entity.MemoryUnitTypeHanChar, uint32(hanChar.ID))
+	require.NoError(t, err)
+	require.NotNil(t, unit2)
+
+	assert.NotEqual(t, unit1.ID, unit2.ID)
+	assert.Equal(t, uint32(1), unit1.ReviewCount)
+	assert.Equal(t, uint32(1), unit1.ConsecutiveCorrect)
+	assert.Equal(t, uint32(1), unit2.ReviewCount)
+	assert.Equal(t, uint32(1), unit2.ConsecutiveWrong)
+
+	// 用户只能看到自己的记忆单元
+	_, err = client.GetMemoryStatus(user2Ctx, &pb.GetMemoryStatusRequest{MemoryUnitId: uint32(unit1.ID)})
+	require.Error(t, err)
+	st, ok := status.FromError(err)
+	require.True(t, ok, "Error should be a gRPC status error")
+	assert.Equal(t, codes.NotFound, st.Code())
+
+	_, err = client.ListMemoryReviews(user2Ctx, &pb.ListMemoryReviewsRequest{MemoryUnitId: uint32(unit1.ID)})
+	require.Error(t, err)
+
+	// 待复习列表只包含当前用户的记忆单元
+	require.NoError(t, db.Model(&entity.MemoryUnit{}).Where("id IN ?", []entity.MemoryUnitID{unit1.ID, unit2.ID}).
+		Update("next_review_at", time.Now().Add(-time.Minute)).Error)
+	for uid, userCtx := range map[entity.UID]context.Context{1: user1Ctx, 2: user2Ctx} {
+		res, err := client.ListMemoriesForReview(userCtx, &pb.ListMemoriesForReviewRequest{Page: 1, PageSize: 10})
+		require.NoError(t, err)
+		require.Equal(t, uint32(1), res.Total)
+		require.Len(t, res.Statuses, 1)
+		assert.Equal(t, uint32(uid), res.Statuses[0].UserId)
+	}
+}
+
+func TestCustomWordIsolation(t *testing.T) {
+	ctx, client, db, cleanup := setupRealGrpcTest(t)
+	defer cleanup()
+
+	user1Ctx := metadata.NewOutgoingContext(ctx, metadata.Pairs("authorization", "Bearer test-token-1"))
+	user2Ctx := metadata.NewOutgoingContext(ctx, metadata.Pairs("authorization", "Bearer test-token-2"))
+
+	// 用户1的生词本中有一个自定义词条
+	notebookRepo := pg.NewWordNotebookRepository(db)
+	notebook, err := entity.NewWordNotebook(1, "私人", "")
+	require.NoError(t, err)
+	require.NoError(t, notebookRepo.CreateNotebook(ctx, notebook))
+	custom, err := entity.NewCustomWordNotebookEntry(notebook, "Cherry", "樱桃", "", nil)
+	require.NoError(t, err)
+	require.NoError(t, notebookRepo.CreateEntry(ctx, custom))
+
+	// 其他用户不能复习该词条
+	review := &pb.ReviewRequest{
+		Type:         pb.MemoryUnitType_MEMORY_UNIT_TYPE_CUSTOM_WORD,
+		ContentId:    uint32(custom.ID),
+		Result:       pb.ReviewResult_REVIEW_RESULT_CORRECT,
+		ResponseTime: 1000,
+	}
+	_, err = client.Review(user2Ctx, review)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	batch, err := client.SubmitReviewBatch(user2Ctx, &pb.SubmitReviewBatchRequest{Reviews: []*pb.BatchReviewItem{{
+		IdempotencyKey: "custom-1",
+		Type:           pb.MemoryUnitType_MEMORY_UNIT_TYPE_CUSTOM_WORD,
+		ContentId:      uint32(custom.ID),
+		Result:         pb.ReviewResult_REVIEW_RESULT_CORRECT,
+		ReviewedAt:     timestamppb.Now(),
+	}}})
+	require.NoError(t, err)
+	require.Len(t, batch.Results, 1)
+	assert.Equal(t, pb.BatchReviewStatus_BATCH_REVIEW_STATUS_NOT_FOUND, batch.Results[0].Status)
+
+	repo := pg.NewMemoryUnitRepository(db)
+	unit, err := repo.GetByTypeAndContentID(ctx, 2, entity.MemoryUnitTypeCustomWord, uint32(custom.ID))
+	require.NoError(t, err)
+	assert.Nil(t, unit)
+
+	// 所有者可以复习
+	_, err = client.Review(user1Ctx, review)
+	require.NoError(t, err)
+
+	// 即使其他用户已有该词条的记忆单元，学习队列和难点内容也不会泄露词条内容
+	leaked := entity.NewMemoryUnit(2, entity.MemoryUnitTypeCustomWord, uint32(custom.ID))
+	leaked.NextReviewAt, leaked.IsLeech = time.Now().Add(-time.Minute), true
+	require.NoError(t, repo.Create(ctx, leaked))
+
+	session, err := client.StartStudySession(user2Ctx, &pb.StartStudySessionRequest{
+		Types: []pb.MemoryUnitType{pb.MemoryUnitType_MEMORY_UNIT_TYPE_CUSTOM_WORD},
+	})
+	require.NoError(t, err)
+	for _, item := range session.Items {
+		assert.NotEqual(t, "Cherry", item.Text)
+	}
+
+	hard, err := client.ListHardContents(user1Ctx, &pb.ListHardContentsRequest{})
+	require.NoError(t, err)
+	for _, content := range hard.Contents {
+		assert.NotEqual(t, pb.MemoryUnitType_MEMORY_UNIT_TYPE_CUSTOM_WORD, content.Type)
+	}
+}
+
+func TestForecastReviews(t *testing.T) {
+	ctx, client, db, cleanup := setupRealGrpcTest(t)
+	defer cleanup()
+
+	userCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs("authorization", "Bearer test-token-1"))
+	repo := pg.NewMemoryUnitRepository(db)
+	now := time.Now()
+
+	// 逾期的汉字、两天后到期的单词、已暂停的单词、超出预测范围的单词
+	overdue := entity.NewMemoryUnit(1, entity.MemoryUnitTypeHanChar, 1)
+	overdue.NextReviewAt = now.Add(-72 * time.Hour)
+	due := entity.NewMemoryUnit(1, entity.MemoryUnitTypeWord, 2)
+	due.NextReviewAt = now.Add(48 * time.Hour)
+	suspended := entity.NewMemoryUnit(1, entity.MemoryUnitTypeWord, 3)
+	suspended.NextReviewAt, suspended.Suspended = now.Add(24*time.Hour), true
+	later := entity.NewMemoryUnit(1, entity.MemoryUnitTypeWord, 4)
+	later.NextReviewAt = now.Add(30 * 24 * time.Hour)
+	for _, unit := range []*entity.MemoryUnit{overdue, due, suspended, later} {
+		require.NoError(t, repo.Create(ctx, unit))
+	}
+
+	res, err := client.ForecastReviews(userCtx, &pb.ForecastReviewsRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Days, service.DefaultForecastDays)
+	assert.Equal(t, uint32(1), res.OverdueCount)
+	assert.Equal(t, uint32(1), res.Days[0].DueCount)
+	assert.Equal(t, pb.MemoryUnitType_MEMORY_UNIT_TYPE_HAN_CHAR, res.Days[0].DueByType[0].Type)
+	assert.Zero(t, res.Days[1].DueCount)
+	assert.Equal(t, uint32(1), res.Days[2].DueCount)
+	assert.Equal(t, pb.MemoryUnitType_MEMORY_UNIT_TYPE_WORD, res.Days[2].DueByType[0].Type)
+	assert.Equal(t, res.Days[2].DueCount, res.Days[2].TotalCount)
+
+	// 模拟每天学习新内容
+	res, err = client.ForecastReviews(userCtx, &pb.ForecastReviewsRequest{
+		Days:      14,
+		NewPerDay: 5,
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Days, 14)
+	simulated := uint32(0)
+	for _, day := range res.Days {
+		assert.Equal(t, uint32(5), day.NewCount)
+		assert.Equal(t, day.DueCount+day.SimulatedCount, day.TotalCount)
+		simulated += day.SimulatedCount
+	}
+	assert.NotZero(t, simulated)
+
+	// 只统计指定类型
+	res, err = client.ForecastReviews(userCtx, &pb.ForecastReviewsRequest{
+		Types: []pb.MemoryUnitType{pb.MemoryUnitType_MEMORY_UNIT_TYPE_WORD},
+	})
+	require.NoError(t, err)
+	assert.Zero(t, res.OverdueCount)
+	assert.Zero(t, res.Days[0].DueCount)
+
+	_, err = client.ForecastReviews(userCtx, &pb.ForecastReviewsRequest{Days: service.MaxForecastDays + 1})
+	st, ok := status.FromError(err)
+	require.True(t, ok, "Error should be a gRPC status error")
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+}
+
+func TestGetStudyActivity(t *testing.T) {
+	ctx, client, db, cleanup := setupRealGrpcTest(t)
+	defer cleanup()
+
+	userCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs("authorization", "Bearer test-token-1"))
+	settings := entity.NewUserSettings(1)
+	settings.TimeZone = "UTC"
+	settings.StreakFreezeDays = 1
+	require.NoError(t, pg.NewUserSettingsRepository(db).Save(ctx, settings))
+	reviewRepo := pg.NewMemoryReviewRepository(db)
+	today := settings.StartOfDay(time.Now())
+
+	// 前5天到前3天、前1天各学习一次，前2天中断由断签保护
+	for _, offset := range []int{-5, -4, -3, -1} {
+		review := entity.NewMemoryReview(1, 1, entity.ReviewGradeGood, 90000, 0, 24*time.Hour)
+		review.ReviewTime = today.AddDate(0, 0, offset).Add(time.Hour)
+		require.NoError(t, reviewRepo.Create(ctx, review))
+	}
+	unit := entity.NewMemoryUnit(1, entity.MemoryUnitTypeHanChar, 1)
+	require.NoError(t, pg.NewMemoryUnitRepository(db).Create(ctx, unit))
+	require.NoError(t, db.Model(&entity.MemoryUnit{}).Where("id = ?", unit.ID).Update("created_at", today.AddDate(0, 0, -1).Add(time.Hour)).Error)
+
+	res, err := client.GetStudyActivity(userCtx, &pb.GetStudyActivityRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, uint32(4), res.Streak.Current)
+	assert.Equal(t, uint32(4), res.Streak.Longest)
+	assert.Equal(t, uint32(1), res.Streak.FreezeDaysUsed)
+	assert.Equal(t, uint32(1), res.Streak.FreezeDays)
+	assert.False(t, res.Streak.StudiedToday)
+	assert.Equal(t, today.AddDate(0, 0, -1).Format(time.DateOnly), res.Streak.LastStudyDate)
+	require.Len(t, res.Days, 4)
+	yesterday := res.Days[3]
+	assert.Equal(t, uint32(1), yesterday.ReviewCount)
+	assert.Equal(t, uint32(1), yesterday.NewCount)
+	assert.Equal(t, uint32(2), yesterday.StudyMinutes)
+
+	// 日期范围过滤
+	res, err = client.GetStudyActivity(userCtx, &pb.GetStudyActivityRequest{
+		StartDate: today.AddDate(0, 0, -4).Format(time.DateOnly),
+		EndDate:   today.AddDate(0, 0, -3).Format(time.DateOnly),
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Days, 2)
+	assert.Equal(t, uint32(4), res.Streak.Current, "Streak should not depend on the heatmap range")
+
+	// 今天复习后缓存失效
+	hanChar := entity.NewHanChar("学", "xué", 1)
+	hanChar.Tags, hanChar.Categories, hanChar.Examples = []string{}, []string{}, []string{}
+	_, err = pg.NewHanCharRepository(db).Create(ctx, hanChar)
+	require.NoError(t, err)
+	_, err = client.Review(userCtx, &pb.ReviewRequest{
+		Type:         pb.MemoryUnitType_MEMORY_UNIT_TYPE_HAN_CHAR,
+		ContentId:    uint32(hanChar.ID),
+		Grade:        pb.ReviewGrade_REVIEW_GRADE_GOOD,
+		ResponseTime: 1000,
+	})
+	require.NoError(t, err)
+	res, err = client.GetStudyActivity(userCtx, &pb.GetStudyActivityRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, uint32(5), res.Streak.Current)
+	assert.True(t, res.Streak.StudiedToday)
+
+	_, err = client.GetStudyActivity(userCtx, &pb.GetStudyActivityRequest{StartDate: "2025-13-01"})
+	st, ok := status.FromError(err)
+	require.True(t, ok, "Error should be a gRPC status error")
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+}
+
+func TestMemoryUnitAdjustments(t *testing.T) {
+	ctx, client, db, cleanup := setupRealGrpcTest(t)
+	defer cleanup()
+
+	userCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs("authorization", "Bearer test-token-1"))
+	settings := entity.NewUserSettings(1)
+	settings.TimeZone = "UTC"
+	require.NoError(t, pg.NewUserSettingsRepository(db).Save(ctx, settings))
+	today := settings.StartOfDay(time.Now())
+
+	// 用户1有5个已逾期的汉字记忆单元，其中两个属于“数字”课程
+	hanCharRepo := pg.NewHanCharRepository(db)
+	memoryRepo := pg.NewMemoryUnitRepository(db)
+	units := make([]*entity.MemoryUnit, 5)
+	for i, char := range []string{"一", "二", "山", "水", "火"} {
+		hanChar := entity.NewHanChar(char, "", 1)
+		hanChar.Tags, hanChar.Categories, hanChar.Examples = []string{}, []string{}, []string{}
+		if i < 2 {
+			hanChar.Tags = []string{"数字"}
+		}
+		_, err := hanCharRepo.Create(ctx, hanChar)
+		require.NoError(t, err)
+
+		units[i] = entity.NewMemoryUnit(1, entity.MemoryUnitTypeHanChar, uint32(hanChar.ID))
+		units[i].MasteryLevel = entity.MasteryLevelFamiliar
+		units[i].ReviewCount = 5
+		units[i].LastReviewAt = today.AddDate(0, 0, -10)
+		units[i].NextReviewAt = today.AddDate(0, 0, -i-1)
+		require.NoError(t, memoryRepo.Create(ctx, units[i]))
+	}
+
+	t.Run("Reset", func(t *testing.T) {
+		res, err := client.ResetMemoryUnit(userCtx, &pb.ResetMemoryUnitRequest{MemoryUnitId: uint32(units[0].ID)})
+		require.NoError(t, err)
+		assert.Equal(t, pb.MasteryLevel_MASTERY_LEVEL_UNLEARNED, res.Status.MasteryLevel)
+		assert.Zero(t, res.Status.ReviewCount)
+
+		reviews, err := client.ListMemoryReviews(userCtx, &pb.ListMemoryReviewsRequest{MemoryUnitId: uint32(units[0].ID)})
+		require.NoError(t, err)
+		require.Len(t, reviews.Reviews, 1)
+		assert.Equal(t, pb.ReviewAction_REVIEW_ACTION_RESET, reviews.Reviews[0].Action)
+		assert.Equal(t, pb.MasteryLevel_MASTERY_LEVEL_FAMILIAR, reviews.Reviews[0].MasteryBefore)
+		assert.Equal(t, pb.ReviewResult_REVIEW_RESULT_UNSPECIFIED, reviews.Reviews[0].Result)
+	})
+
+	t.Run("Bury", func(t *testing.T) {
+		res, err := client.BuryMemoryUnit(userCtx, &pb.BuryMemoryUnitRequest{MemoryUnitId: uint32(units[1].ID)})
+		require.NoError(t, err)
+		assert.True(t, res.Status.NextReviewAt.AsTime().Equal(today.AddDate(0, 0, 1)))
+	})
+
+	t.Run("Reschedule", func(t *testing.T) {
+		date := today.AddDate(0, 0, 7)
+		res, err := client.RescheduleMemoryUnit(userCtx, &pb.RescheduleMemoryUnitRequest{
+			MemoryUnitId: uint32(units[2].ID),
+			Date:         date.Format(time.DateOnly),
+		})
+		require.NoError(t, err)
+		assert.True(t, res.Status.NextReviewAt.AsTime().Equal(date))
+
+		_, err = client.RescheduleMemoryUnit(userCtx, &pb.RescheduleMemoryUnitRequest{
+			MemoryUnitId: uint32(units[2].ID),
+			Date:         today.AddDate(0, 0, -1).Format(time.DateOnly),
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		// 其他用户的记忆单元不存在
+		otherCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs("authorization", "Bearer test-token-2"))
+		_, err = client.BuryMemoryUnit(otherCtx, &pb.BuryMemoryUnitRequest{MemoryUnitId: uint32(units[2].ID)})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("RescheduleDueReviews", func(t *testing.T) {
+		// 到期的是刚重置的 units[0] 以及 units[3]、units[4]，分散到今天和明天
+		res, err := client.RescheduleDueReviews(userCtx, &pb.RescheduleDueReviewsRequest{Days: 2})
+		require.NoError(t, err)
+		assert.Equal(t, uint32(1), res.RescheduledCount)
+
+		due, err := client.ListMemoriesForReview(userCtx, &pb.ListMemoriesForReviewRequest{Page: 1, PageSize: 10})
+		require.NoError(t, err)
+		assert.Equal(t, uint32(2), due.Total)
+
+		_, err = client.RescheduleDueReviews(userCtx, &pb.RescheduleDueReviewsRequest{Days: 0})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("ForgetCourse", func(t *testing.T) {
+		require.NoError(t, db.AutoMigrate(&entity.Course{}))
+		courseRepo := pg.NewCourseRepository(db)
+		course := &entity.Course{Title: "数字", Level: "beginner", Tags: []string{"数字"}, Resources: []string{}}
+		require.NoError(t, courseRepo.Create(ctx, course))
+
+		res, err := client.ForgetCourse(userCtx, &pb.ForgetCourseRequest{CourseId: uint32(course.ID)})
+		require.NoError(t, err)
+		assert.Equal(t, uint32(2), res.ResetCount)
+
+		unit, err := memoryRepo.GetByID(ctx, 1, uint32(units[1].ID))
+		require.NoError(t, err)
+		assert.Equal(t, entity.MasteryLevelUnlearned, unit.MasteryLevel)
+		assert.False(t, unit.NextReviewAt.After(time.Now()))
+
+		_, err = client.ForgetCourse(userCtx, &pb.ForgetCourseRequest{CourseId: uint32(course.ID) + 1000})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
+
+func TestNewHanCharLearningFlow(t *testing.T) {
+	ctx, client, db, cleanup := setupRealGrpcTest(t)
+	defer cleanup()
+
+	userCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs("authorization", "Bearer test-token-1"))
+
+	// “山”“水”属于自然分类，“一”为更高难度
+	hanCharRepo := pg.NewHanCharRepository(db)
+	ids := make(map[string]entity.HanCharID)
+	for char, pinyin := range map[string]string{"山": "shān", "水": "shuǐ", "一": "yī"} {
+		hanChar := entity.NewHanChar(char, pinyin, 1)
+		hanChar.Tags, hanChar.Categories, hanChar.Examples = []string{}, []string{"自然"}, []string{}
+		if char == "一" {
+			hanChar.Level, hanChar.Categories = 2, []string{"数字"}
+		}
+		_, err := hanCharRepo.Create(ctx, hanChar)
+		require.NoError(t, err)
+		ids[char] = hanChar.ID
+	}
+	idString := func(char string) string {
+		return strconv.FormatUint(uint64(ids[char]), 10)
+	}
+
+	t.Run("GetNewHanCharLearning", func(t *testing.T) {
+		res, err := client.GetNewHanCharLearning(userCtx, &pb.GetNewHanCharLearningRequest{Count: 10, Category: "自然"})
+		require.NoError(t, err)
+		require.Len(t, res.Contents, 2)
+		assert.Equal(t, "山", res.Contents[0].HanChar)
+		assert.Equal(t, idString("山"), res.Contents[0].HanCharId)
+
+		res, err = client.GetNewHanCharLearning(userCtx, &pb.GetNewHanCharLearningRequest{Level: pb.WordDifficultyLevel_WORD_DIFFICULTY_LEVEL_A2})
+		require.NoError(t, err)
+		require.Len(t, res.Contents, 1)
+		assert.Equal(t, "一", res.Contents[0].HanChar)
+	})
+
+	learningTime := time.Now().Add(-time.Hour)
+	submit := &pb.SubmitNewHanCharLearningResultRequest{
+		LearningTime:  timestamppb.New(learningTime),
+		StudyDuration: 60,
+		Results: []*pb.HanCharLearningResultItem{
+			{NewHanCharId: idString("山"), Result: &pb.HanCharLearningResult{FirstTryCorrect: true, Mastered: true, CorrectCount: 3}},
+			{NewHanCharId: idString("水"), Result: &pb.HanCharLearningResult{ErrorCount: 3}},
+		},
+	}
+
+	t.Run("SubmitNewHanCharLearningResult", func(t *testing.T) {
+		_, err := client.SubmitNewHanCharLearningResult(userCtx, submit)
+		require.NoError(t, err)
+		// 重复提交同一次学习不会重复计入
+		_, err = client.SubmitNewHanCharLearningResult(userCtx, submit)
+		require.NoError(t, err)
+
+		learning, err := pg.NewHanCharLearningRepository(db).GetByHanCharID(ctx, 1, ids["山"])
+		require.NoError(t, err)
+		require.NotNil(t, learning)
+		assert.True(t, learning.Mastered)
+		assert.Equal(t, uint32(3), learning.CorrectCount)
+		assert.Equal(t, uint32(30), learning.StudyDuration)
+
+		unit, err := pg.NewMemoryUnitRepository(db).GetByTypeAndContentID(ctx, 1, entity.MemoryUnitTypeHanChar, uint32(ids["山"]))
+		require.NoError(t, err)
+		require.NotNil(t, unit)
+		assert.Equal(t, uint32(unit.ID), learning.MemoryUnitID)
+		assert.Equal(t, uint32(1), unit.ReviewCount)
+
+		// 已学习的汉字不再作为生字
+		res, err := client.GetNewHanCharLearning(userCtx, &pb.GetNewHanCharLearningRequest{Category: "自然"})
+		require.NoError(t, err)
+		assert.Empty(t, res.Contents)
+
+		_, err = client.SubmitNewHanCharLearningResult(userCtx, &pb.SubmitNewHanCharLearningResultRequest{
+			LearningTime: timestamppb.New(learningTime),
+			Results:      []*pb.HanCharLearningResultItem{{NewHanCharId: "abc", Result: &pb.HanCharLearningResult{}}},
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("SubmitHanCharReview", func(t *testing.T) {
+		res, err := client.SubmitHanCharReview(userCtx, &pb.SubmitHanCharReviewRequest{
+			HanCharId:    idString("水"),
+			IsRecognized: true,
+			ReviewTime:   timestamppb.Now(),
+		})
+		require.NoError(t, err)
+		assert.True(t, res.NextReviewTime.AsTime().After(time.Now()))
+
+		_, err = client.SubmitHanCharReview(userCtx, &pb.SubmitHanCharReviewRequest{
+			HanCharId:  "999999",
+			ReviewTime: timestamppb.Now(),
+		})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("HanCharTest", func(t *testing.T) {
+		// 已学汉字在前，不足时用生字补齐
+		res, err := client.GetHanCharTest(userCtx, &pb.GetHanCharTestRequest{Count: 3})
+		require.NoError(t, err)
+		require.Len(t, res.HanChars, 3)
+		assert.ElementsMatch(t, []string{"山", "水"}, []string{res.HanChars[0].Character, res.HanChars[1].Character})
+		assert.Equal(t, "一", res.HanChars[2].Character)
+
+		submit := &pb.SubmitHanCharTestResultRequest{
+			Results: []*pb.HanCharTestResult{
+				{HanCharId: uint32(ids["山"]), IsRecognized: true},
+				{HanCharId: uint32(ids["一"]), IsRecognized: false},
+			},
+			TestTime: timestamppb.Now(),
+		}
+		_, err = client.SubmitHanCharTestResult(userCtx, submit)
+		require.NoError(t, err)
+		// 重试同一次提交不会重复计入
+		_, err = client.SubmitHanCharTestResult(userCtx, submit)
+		require.NoError(t, err)
+
+		unit, err := pg.NewMemoryUnitRepository(db).GetByTypeAndContentID(ctx, 1, entity.MemoryUnitTypeHanChar, uint32(ids["一"]))
+		require.NoError(t, err)
+		require.NotNil(t, unit)
+		assert.Equal(t, uint32(1), unit.ReviewCount)
+
+		_, err = client.SubmitHanCharTestResult(userCtx, &pb.SubmitHanCharTestResultRequest{})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		_, err = client.SubmitHanCharTestResult(userCtx, &pb.SubmitHanCharTestResultRequest{Results: submit.Results})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("GradeHanCharTest", func(t *testing.T) {
+		req := &pb.GetHanCharTestRequest{Count: 3, Types: []pb.HanCharQuestionType{pb.HanCharQuestionType_HAN_CHAR_QUESTION_TYPE_CHAR_TO_PINYIN}}
+		test, err := client.GetHanCharTest(userCtx, req)
+		require.NoError(t, err)
+		assert.NotZero(t, test.Seed)
+		require.Len(t, test.Questions, 3)
+		for _, question := range test.Questions {
+			assert.Len(t, question.Options, 4)
+		}
+
+		// 相同种子重新生成的题目一致
+		req.Seed = test.Seed
+		again, err := client.GetHanCharTest(userCtx, req)
+		require.NoError(t, err)
+		assert.Equal(t, test.Questions[0].Options, again.Questions[0].Options)
+
+		// 答对第一题，答错第二题
+		pinyins := map[uint32]string{uint32(ids["山"]): "shān", uint32(ids["水"]): "shuǐ", uint32(ids["一"]): "yī"}
+		answers := make([]*pb.HanCharTestAnswer, 2)
+		for i, question := range test.Questions[:2] {
+			answers[i] = &pb.HanCharTestAnswer{HanCharId: question.HanCharId, Type: question.Type}
+			for _, option := range question.Options {
+				if (option == pinyins[question.HanCharId]) == (i == 0) {
+					answers[i].SelectedOption = option
+					break
+				}
+			}
+		}
+
+		// 出题后新增的汉字改变了候选干扰项，不影响评分
+		homophone := entity.NewHanChar("杉", "shān", 1)
+		homophone.Tags, homophone.Categories, homophone.Examples = []string{}, []string{"自然"}, []string{}
+		_, err = hanCharRepo.Create(ctx, homophone)
+		require.NoError(t, err)
+
+		res, err := client.SubmitHanCharTestResult(userCtx, &pb.SubmitHanCharTestResultRequest{Seed: test.Seed, Answers: answers})
+		require.NoError(t, err)
+		require.Len(t, res.Grades, 2)
+		assert.True(t, res.Grades[0].Correct)
+		assert.False(t, res.Grades[1].Correct)
+		assert.Equal(t, answers[0].SelectedOption, res.Grades[0].Answer)
+
+		_, err = client.SubmitHanCharTestResult(userCtx, &pb.SubmitHanCharTestResultRequest{Answers: answers})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("SubmitHanCharHandwriting", func(t *testing.T) {
+		// “一”只有一笔横
+		strokes := entity.NewHanCharStrokes(ids["一"], []string{"M 100 500 L 900 500"}, []entity.StrokePolyline{{{100, 500}, {900, 500}}})
+		require.NoError(t, pg.NewHanCharStrokesRepository(db).Save(ctx, strokes))
+		polyline := func(points ...[2]float64) *pb.StrokePolyline {
+			polyline := &pb.StrokePolyline{}
+			for _, point := range points {
+				polyline.Points = append(polyline.Points, &pb.StrokePoint{X: point[0], Y: point[1]})
+			}
+			return polyline
+		}
+
+		// 第一次从右往左写错，第二次写对
+		res, err := client.SubmitHanCharHandwriting(userCtx, &pb.SubmitHanCharHandwritingRequest{
+			HanCharId: idString("一"),
+			Attempt:   1,
+			Strokes:   []*pb.StrokePolyline{polyline([2]float64{900, 500}, [2]float64{100, 500})},
+		})
+		require.NoError(t, err)
+		assert.False(t, res.Correct)
+		assert.Equal(t, []bool{false}, res.StrokeCorrect)
+
+		res, err = client.SubmitHanCharHandwriting(userCtx, &pb.SubmitHanCharHandwritingRequest{
+			HanCharId:     idString("一"),
+			Attempt:       2,
+			Strokes:       []*pb.StrokePolyline{polyline([2]float64{110, 520}, [2]float64{500, 490}, [2]float64{880, 500})},
+			StudyDuration: 5,
+		})
+		require.NoError(t, err)
+		assert.True(t, res.Correct)
+
+		learning, err := pg.NewHanCharLearningRepository(db).GetByHanCharID(ctx, 1, ids["一"])
+		require.NoError(t, err)
+		require.NotNil(t, learning)
+		assert.False(t, learning.FirstTryCorrect)
+		assert.True(t, learning.SecondTryCorrect)
+		assert.Equal(t, uint32(1), learning.ErrorCount)
+		assert.Equal(t, uint32(1), learning.CorrectCount)
+
+		// 没有笔顺数据的汉字无法评判
+		_, err = client.SubmitHanCharHandwriting(userCtx, &pb.SubmitHanCharHandwritingRequest{
+			HanCharId: idString("山"),
+			Strokes:   []*pb.StrokePolyline{polyline([2]float64{100, 500})},
+		})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+
+		_, err = client.SubmitHanCharHandwriting(userCtx, &pb.SubmitHanCharHandwritingRequest{HanCharId: idString("一"), Attempt: 4})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
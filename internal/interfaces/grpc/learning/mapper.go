@@ -1,7 +1,13 @@
 package learning
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
 	pb "github.com/lazyjean/sla2/api/proto/v1"
+	"github.com/lazyjean/sla2/internal/application/dto"
 	"github.com/lazyjean/sla2/internal/application/service"
 	"github.com/lazyjean/sla2/internal/domain/entity"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -33,7 +39,170 @@ func ToPBMemoryUnit(memoryUnit *entity.MemoryUnit) *pb.MemoryUnit {
 		RetentionRate:      memoryUnit.RetentionRate,
 		ConsecutiveCorrect: memoryUnit.ConsecutiveCorrect,
 		ConsecutiveWrong:   memoryUnit.ConsecutiveWrong,
+		Lapses:             memoryUnit.Lapses,
+		IsLeech:            memoryUnit.IsLeech,
+		Suspended:          memoryUnit.Suspended,
+	}
+}
+
+// ToPBMemoryReview 将领域实体转换为 PB 复习记录
+func ToPBMemoryReview(review *entity.MemoryReview) *pb.MemoryReview {
+	pbReview := &pb.MemoryReview{
+		Id:             review.ID,
+		MemoryUnitId:   review.MemoryUnitID,
+		UserId:         review.UserID,
+		Result:         pb.ReviewResult(review.Result),
+		ResponseTime:   review.ResponseTime,
+		ReviewTime:     timestamppb.New(review.ReviewTime),
+		CreatedAt:      timestamppb.New(review.CreatedAt),
+		Grade:          ToPBReviewGrade(review.Grade),
+		MasteryBefore:  pb.MasteryLevel(review.MasteryBefore),
+		MasteryAfter:   pb.MasteryLevel(review.MasteryAfter),
+		IntervalBefore: review.IntervalBefore,
+		IntervalAfter:  review.IntervalAfter,
+		Action:         pb.ReviewAction(review.Action),
+	}
+	if !review.ScheduledTime.IsZero() {
+		pbReview.ScheduledReviewTime = timestamppb.New(review.ScheduledTime)
+	}
+	return pbReview
+}
+
+// ToPBMemoryReviews 将领域实体列表转换为 PB 复习记录列表
+func ToPBMemoryReviews(reviews []*entity.MemoryReview) []*pb.MemoryReview {
+	pbReviews := make([]*pb.MemoryReview, len(reviews))
+	for i, r := range reviews {
+		pbReviews[i] = ToPBMemoryReview(r)
+	}
+	return pbReviews
+}
+
+// ToPBStudyItem 将领域学习项转换为 PB 学习项
+func ToPBStudyItem(item *entity.StudyItem) *pb.StudyItem {
+	pbItem := &pb.StudyItem{
+		Type:      pb.MemoryUnitType(item.Type),
+		ContentId: item.ContentID,
+		IsNew:     item.IsNew(),
+		Text:      item.Text,
+	}
+	if item.MemoryUnit != nil {
+		pbItem.MemoryUnitId = uint32(item.MemoryUnit.ID)
+	}
+	return pbItem
+}
+
+// ToPBStudyItems 将领域学习项列表转换为 PB 学习项列表
+func ToPBStudyItems(items []*entity.StudyItem) []*pb.StudyItem {
+	pbItems := make([]*pb.StudyItem, len(items))
+	for i, item := range items {
+		pbItems[i] = ToPBStudyItem(item)
 	}
+	return pbItems
+}
+
+// ToPBBatchReviewResults 将批量复习的处理结果转换为 PB 结果列表
+func ToPBBatchReviewResults(results []*dto.BatchReviewResult) []*pb.BatchReviewItemResult {
+	pbResults := make([]*pb.BatchReviewItemResult, len(results))
+	for i, r := range results {
+		pbResults[i] = &pb.BatchReviewItemResult{
+			IdempotencyKey: r.IdempotencyKey,
+			Status:         pb.BatchReviewStatus(r.Status),
+			MemoryUnitId:   r.MemoryUnitID,
+			Message:        r.Message,
+		}
+	}
+	return pbResults
+}
+
+// ToPBReviewForecastDays 将复习量预测转换为 PB 列表，类型分布按类型排序
+func ToPBReviewForecastDays(days []*dto.ReviewForecastDay) []*pb.ReviewForecastDay {
+	pbDays := make([]*pb.ReviewForecastDay, len(days))
+	for i, day := range days {
+		byType := make([]*pb.MemoryUnitTypeCount, 0, len(day.DueByType))
+		for _, t := range entity.MemoryUnitTypes {
+			if count := day.DueByType[t]; count > 0 {
+				byType = append(byType, &pb.MemoryUnitTypeCount{Type: pb.MemoryUnitType(t), Count: uint32(count)})
+			}
+		}
+		pbDays[i] = &pb.ReviewForecastDay{
+			Date:           day.Date.Format(time.DateOnly),
+			DueCount:       uint32(day.DueCount),
+			DueByType:      byType,
+			NewCount:       uint32(day.NewCount),
+			SimulatedCount: uint32(day.SimulatedCount),
+			TotalCount:     uint32(day.DueCount + day.SimulatedCount),
+		}
+	}
+	return pbDays
+}
+
+// ToPBMemoryStats 将记忆统计转换为 PB 响应
+// 各类型的记忆保持率取最长的统计窗口
+func ToPBMemoryStats(stats *dto.MemoryStats) *pb.GetMemoryStatsResponse {
+	res := &pb.GetMemoryStatsResponse{
+		TotalLearned:    uint32(stats.TotalCount),
+		MasteredCount:   uint32(stats.MasteredCount),
+		NeedReviewCount: uint32(stats.NeedReviewCount),
+		TotalStudyTime:  uint32(stats.TotalStudyTime),
+		LevelStats:      make(map[uint32]uint32, len(stats.LevelCounts)),
+		RetentionRates:  make(map[uint32]float32),
+		Retention:       make([]*pb.RetentionWindow, len(stats.Retention)),
+		TypeStats:       make(map[uint32]uint32, len(stats.TypeCounts)),
+	}
+	for level, count := range stats.LevelCounts {
+		res.LevelStats[uint32(level)] = uint32(count)
+	}
+	for unitType, count := range stats.TypeCounts {
+		res.TypeStats[uint32(unitType)] = uint32(count)
+	}
+
+	var longest *dto.RetentionWindow
+	for i, window := range stats.Retention {
+		rateByType := make(map[uint32]float32, len(window.RateByType))
+		for unitType, rate := range window.RateByType {
+			rateByType[uint32(unitType)] = float32(rate)
+		}
+		res.Retention[i] = &pb.RetentionWindow{
+			Days:         window.Days,
+			ReviewCount:  uint32(window.ReviewCount),
+			CorrectCount: uint32(window.CorrectCount),
+			Rate:         float32(window.Rate),
+			RateByType:   rateByType,
+		}
+		if longest == nil || window.Days > longest.Days {
+			longest = window
+		}
+	}
+	if longest != nil {
+		for unitType, rate := range longest.RateByType {
+			res.RetentionRates[uint32(unitType)] = float32(rate)
+		}
+	}
+	return res
+}
+
+// ToPBHardContents 将难点内容列表转换为 PB 列表
+func ToPBHardContents(contents []*service.HardContent) []*pb.HardContent {
+	pbContents := make([]*pb.HardContent, len(contents))
+	for i, content := range contents {
+		pbContents[i] = &pb.HardContent{
+			Type:        pb.MemoryUnitType(content.Type),
+			ContentId:   content.ContentID,
+			Text:        content.Text,
+			LeechCount:  uint32(content.LeechCount),
+			TotalLapses: uint32(content.TotalLapses),
+		}
+	}
+	return pbContents
+}
+
+// ToPBReviewGrade 将领域复习评分转换为 PB 复习评分
+// 跳过没有对应的评分，由复习结果表示
+func ToPBReviewGrade(grade entity.ReviewGrade) pb.ReviewGrade {
+	if !grade.IsValid() || grade == entity.ReviewGradeSkip {
+		return pb.ReviewGrade_REVIEW_GRADE_UNSPECIFIED
+	}
+	return pb.ReviewGrade(grade)
 }
 
 // ToPBReviewInterval 将领域实体转换为 PB 复习间隔
@@ -51,11 +220,197 @@ func ToEntityMasteryLevel(level pb.MasteryLevel) entity.MasteryLevel {
 }
 
 // ToEntityMemoryUnitType 将 PB 记忆单元类型转换为领域实体
+// 未定义的枚举值转换为未指定，由应用层判定为无效，避免截断为 uint8 后变成其他类型
 func ToEntityMemoryUnitType(unitType pb.MemoryUnitType) entity.MemoryUnitType {
+	if _, ok := pb.MemoryUnitType_name[int32(unitType)]; !ok {
+		return entity.MemoryUnitTypeUnspecified
+	}
 	return entity.MemoryUnitType(unitType)
 }
 
-// ToEntityReviewResult 将 PB 复习结果转换为领域实体
+// ToEntityReviewResult 将 PB 复习结果转换为领域实体，未定义的枚举值转换为未指定
 func ToEntityReviewResult(result pb.ReviewResult) entity.ReviewResult {
+	if _, ok := pb.ReviewResult_name[int32(result)]; !ok {
+		return entity.ReviewResultUnspecified
+	}
 	return entity.ReviewResult(result)
 }
+
+// ToEntityReviewGrade 将 PB 复习结果和评分转换为领域复习评分
+// 跳过优先；未指定评分时按复习结果推断；未定义的评分转换为未指定，由应用层判定为无效
+func ToEntityReviewGrade(result pb.ReviewResult, grade pb.ReviewGrade) entity.ReviewGrade {
+	if result == pb.ReviewResult_REVIEW_RESULT_SKIP {
+		return entity.ReviewGradeSkip
+	}
+	if _, ok := pb.ReviewGrade_name[int32(grade)]; !ok {
+		return entity.ReviewGradeUnspecified
+	}
+	if grade != pb.ReviewGrade_REVIEW_GRADE_UNSPECIFIED {
+		return entity.ReviewGrade(grade)
+	}
+	return ToEntityReviewResult(result).Grade()
+}
+
+// ToBatchReviewItems 将 PB 批量复习列表转换为应用层 DTO
+// 未设置复习时间时保留零值，由应用层判定为无效
+func ToBatchReviewItems(reviews []*pb.BatchReviewItem) []*dto.BatchReviewItem {
+	items := make([]*dto.BatchReviewItem, len(reviews))
+	for i, r := range reviews {
+		items[i] = &dto.BatchReviewItem{
+			IdempotencyKey: r.IdempotencyKey,
+			Type:           ToEntityMemoryUnitType(r.Type),
+			ContentID:      r.ContentId,
+			Grade:          ToEntityReviewGrade(r.Result, r.Grade),
+			ResponseTime:   r.ResponseTime,
+			SessionID:      entity.StudySessionID(r.SessionId),
+		}
+		if r.ReviewedAt != nil {
+			items[i].ReviewedAt = r.ReviewedAt.AsTime()
+		}
+	}
+	return items
+}
+
+// ToPBStudyActivity 将学习活动转换为 PB 响应，学习时长按分钟四舍五入
+func ToPBStudyActivity(activity *dto.StudyActivity) *pb.GetStudyActivityResponse {
+	streak := &pb.StudyStreak{
+		Current:        activity.Streak.Current,
+		Longest:        activity.Streak.Longest,
+		FreezeDaysUsed: activity.Streak.FreezeDaysUsed,
+		FreezeDays:     activity.FreezeDays,
+		StudiedToday:   activity.Streak.StudiedToday,
+	}
+	if !activity.Streak.LastStudyDate.IsZero() {
+		streak.LastStudyDate = activity.Streak.LastStudyDate.Format(time.DateOnly)
+	}
+
+	days := make([]*pb.StudyActivityDay, len(activity.Days))
+	for i, day := range activity.Days {
+		days[i] = &pb.StudyActivityDay{
+			Date:         day.Date.Format(time.DateOnly),
+			ReviewCount:  day.ReviewCount,
+			NewCount:     day.NewLearned,
+			StudyMinutes: (day.StudyTime + 30) / 60,
+		}
+	}
+	return &pb.GetStudyActivityResponse{Streak: streak, Days: days}
+}
+
+// ToPBHanCharLearningContents 将汉字实体转换为 PB 生字学习内容
+func ToPBHanCharLearningContents(hanChars []*entity.HanChar) []*pb.HanCharLearningContent {
+	contents := make([]*pb.HanCharLearningContent, len(hanChars))
+	for i, hanChar := range hanChars {
+		contents[i] = &pb.HanCharLearningContent{
+			HanCharId: strconv.FormatUint(uint64(hanChar.ID), 10),
+			HanChar:   hanChar.Character,
+			Pinyin:    hanChar.Pinyin,
+			Meaning:   strings.Join(hanChar.Meanings, "；"),
+			Examples:  hanChar.Examples,
+		}
+	}
+	return contents
+}
+
+// ToNewHanCharLearningRequest 将 PB 生字学习结果转换为 DTO，汉字ID无法解析时返回错误
+func ToNewHanCharLearningRequest(req *pb.SubmitNewHanCharLearningResultRequest) (*dto.NewHanCharLearningRequest, error) {
+	results := make([]*dto.HanCharLearningItem, len(req.Results))
+	for i, item := range req.Results {
+		id, err := ParseHanCharID(item.NewHanCharId)
+		if err != nil {
+			return nil, err
+		}
+		result := item.GetResult()
+		results[i] = &dto.HanCharLearningItem{
+			HanCharID: id,
+			Result: entity.HanCharLearningResult{
+				FirstTryCorrect:  result.GetFirstTryCorrect(),
+				SecondTryCorrect: result.GetSecondTryCorrect(),
+				ThirdTryCorrect:  result.GetThirdTryCorrect(),
+				Mastered:         result.GetMastered(),
+				ErrorCount:       result.GetErrorCount(),
+				CorrectCount:     result.GetCorrectCount(),
+			},
+		}
+	}
+
+	var learnedAt time.Time
+	if req.LearningTime != nil {
+		learnedAt = req.LearningTime.AsTime()
+	}
+	return &dto.NewHanCharLearningRequest{
+		LearnedAt:     learnedAt,
+		StudyDuration: req.StudyDuration,
+		Results:       results,
+	}, nil
+}
+
+// ToHanCharTestResults 将 PB 汉字测试结果转换为 DTO
+func ToHanCharTestResults(results []*pb.HanCharTestResult) []*dto.HanCharTestResult {
+	items := make([]*dto.HanCharTestResult, len(results))
+	for i, result := range results {
+		items[i] = &dto.HanCharTestResult{
+			HanCharID:  entity.HanCharID(result.HanCharId),
+			Recognized: result.IsRecognized,
+		}
+	}
+	return items
+}
+
+// ToEntityHanCharQuestionTypes 将 PB 汉字测试题型转换为领域题型
+func ToEntityHanCharQuestionTypes(types []pb.HanCharQuestionType) []entity.HanCharQuestionType {
+	result := make([]entity.HanCharQuestionType, len(types))
+	for i, t := range types {
+		result[i] = entity.HanCharQuestionType(t)
+	}
+	return result
+}
+
+// ToPBHanCharQuestions 将汉字选择题转换为 PB 消息
+func ToPBHanCharQuestions(questions []*entity.HanCharQuestion) []*pb.HanCharQuestion {
+	result := make([]*pb.HanCharQuestion, len(questions))
+	for i, question := range questions {
+		result[i] = &pb.HanCharQuestion{
+			HanCharId: uint32(question.HanCharID),
+			Type:      pb.HanCharQuestionType(question.Type),
+			Prompt:    question.Prompt,
+			Options:   question.Options,
+		}
+	}
+	return result
+}
+
+// ToHanCharTestAnswers 将 PB 汉字选择题答案转换为 DTO
+func ToHanCharTestAnswers(answers []*pb.HanCharTestAnswer) []*dto.HanCharTestAnswer {
+	result := make([]*dto.HanCharTestAnswer, len(answers))
+	for i, answer := range answers {
+		result[i] = &dto.HanCharTestAnswer{
+			HanCharID: entity.HanCharID(answer.HanCharId),
+			Type:      entity.HanCharQuestionType(answer.Type),
+			Selected:  answer.SelectedOption,
+		}
+	}
+	return result
+}
+
+// ToPBHanCharTestGrades 将汉字选择题评分转换为 PB 消息
+func ToPBHanCharTestGrades(grades []*dto.HanCharTestGrade) []*pb.HanCharTestGrade {
+	result := make([]*pb.HanCharTestGrade, len(grades))
+	for i, grade := range grades {
+		result[i] = &pb.HanCharTestGrade{
+			HanCharId: uint32(grade.HanCharID),
+			Type:      pb.HanCharQuestionType(grade.Type),
+			Correct:   grade.Correct,
+			Answer:    grade.Answer,
+		}
+	}
+	return result
+}
+
+// ParseHanCharID 解析字符串形式的汉字ID
+func ParseHanCharID(id string) (entity.HanCharID, error) {
+	value, err := strconv.ParseUint(id, 10, 32)
+	if err != nil || value == 0 {
+		return 0, fmt.Errorf("invalid han char id %q", id)
+	}
+	return entity.HanCharID(value), nil
+}
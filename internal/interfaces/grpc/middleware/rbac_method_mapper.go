@@ -33,6 +33,9 @@ func RegisterRBACMethodMappings(interceptor *RBACInterceptor) {
 	// 注册单词相关API权限
 	registerWordMethodPermissions(interceptor)
 
+	// 注册学习统计相关API权限
+	registerLearningStatsMethodPermissions(interceptor)
+
 	// 注册健康检查API到白名单
 	registerHealthCheckWhitelist(interceptor)
 
@@ -237,41 +240,97 @@ func registerQuestionMethodPermissions(interceptor *RBACInterceptor) {
 	)
 }
 
-// registerWordMethodPermissions 注册单词相关API权限
+// registerWordMethodPermissions 注册单词和汉字相关API权限
+// 词库的查询接口（包括旧版 WordService）对所有用户开放，只有修改词库需要授权
 func registerWordMethodPermissions(interceptor *RBACInterceptor) {
-	// 1. 单词资源的读取权限
+	// 1. 单词资源的写入权限
 	interceptor.RegisterMethodPermission(
-		"/proto.v1.WordService/Get",
+		"/proto.v1.VocabularyService/Create",
 		security.ResourceWord,
-		security.ActionRead,
+		security.ActionCreate,
 	)
 	interceptor.RegisterMethodPermission(
-		"/proto.v1.WordService/List",
+		"/proto.v1.VocabularyService/BatchCreate",
 		security.ResourceWord,
-		security.ActionList,
+		security.ActionCreate,
 	)
 	interceptor.RegisterMethodPermission(
-		"/proto.v1.WordService/Search",
+		"/proto.v1.VocabularyService/Update",
 		security.ResourceWord,
-		security.ActionList,
+		security.ActionUpdate,
 	)
-
-	// 2. 单词资源的写入权限
 	interceptor.RegisterMethodPermission(
-		"/proto.v1.WordService/Create",
+		"/proto.v1.VocabularyService/Delete",
 		security.ResourceWord,
-		security.ActionCreate,
+		security.ActionDelete,
 	)
 	interceptor.RegisterMethodPermission(
-		"/proto.v1.WordService/Update",
+		"/proto.v1.VocabularyService/BatchImportWordForms",
 		security.ResourceWord,
 		security.ActionUpdate,
 	)
 	interceptor.RegisterMethodPermission(
-		"/proto.v1.WordService/Delete",
+		"/proto.v1.VocabularyService/CreateRelation",
 		security.ResourceWord,
+		security.ActionUpdate,
+	)
+	interceptor.RegisterMethodPermission(
+		"/proto.v1.VocabularyService/DeleteRelation",
+		security.ResourceWord,
+		security.ActionUpdate,
+	)
+	interceptor.RegisterMethodPermission(
+		"/proto.v1.VocabularyService/BatchImportRelations",
+		security.ResourceWord,
+		security.ActionUpdate,
+	)
+
+	// 2. 汉字资源的写入权限
+	interceptor.RegisterMethodPermission(
+		"/proto.v1.VocabularyService/CreateHanChar",
+		security.ResourceHanChar,
+		security.ActionCreate,
+	)
+	interceptor.RegisterMethodPermission(
+		"/proto.v1.VocabularyService/BatchCreateHanChar",
+		security.ResourceHanChar,
+		security.ActionCreate,
+	)
+	interceptor.RegisterMethodPermission(
+		"/proto.v1.VocabularyService/BatchImportHanCharStrokes",
+		security.ResourceHanChar,
+		security.ActionUpdate,
+	)
+	interceptor.RegisterMethodPermission(
+		"/proto.v1.VocabularyService/UpdateHanChar",
+		security.ResourceHanChar,
+		security.ActionUpdate,
+	)
+	interceptor.RegisterMethodPermission(
+		"/proto.v1.VocabularyService/DeleteHanChar",
+		security.ResourceHanChar,
 		security.ActionDelete,
 	)
+	interceptor.RegisterMethodPermission(
+		"/proto.v1.VocabularyService/CreateHanCharRelation",
+		security.ResourceHanChar,
+		security.ActionUpdate,
+	)
+	interceptor.RegisterMethodPermission(
+		"/proto.v1.VocabularyService/DeleteHanCharRelation",
+		security.ResourceHanChar,
+		security.ActionUpdate,
+	)
+}
+
+// registerLearningStatsMethodPermissions 注册学习统计相关API权限
+// 用户自己的学习数据不需要权限检查，只有跨用户的统计需要授权
+func registerLearningStatsMethodPermissions(interceptor *RBACInterceptor) {
+	interceptor.RegisterMethodPermission(
+		"/proto.v1.LearningService/ListHardContents",
+		security.ResourceLearningStats,
+		security.ActionList,
+	)
 }
 
 // registerHealthCheckWhitelist 注册健康检查API到白名单
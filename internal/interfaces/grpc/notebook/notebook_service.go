@@ -0,0 +1,198 @@
+package notebook
+
+import (
+	"context"
+	"errors"
+
+	pb "github.com/lazyjean/sla2/api/proto/v1"
+	"github.com/lazyjean/sla2/internal/application/dto"
+	"github.com/lazyjean/sla2/internal/application/service"
+	"github.com/lazyjean/sla2/internal/domain/entity"
+	domainErrors "github.com/lazyjean/sla2/internal/domain/errors"
+	"github.com/lazyjean/sla2/internal/domain/repository"
+	"github.com/lazyjean/sla2/internal/domain/valueobject"
+	"github.com/lazyjean/sla2/internal/interfaces/grpc/vocabulary"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// NotebookService 生词本 gRPC 服务
+type NotebookService struct {
+	pb.UnimplementedNotebookServiceServer
+	service *service.WordNotebookService
+}
+
+// NewNotebookService 创建生词本 gRPC 服务实例
+func NewNotebookService(service *service.WordNotebookService) *NotebookService {
+	return &NotebookService{
+		service: service,
+	}
+}
+
+// CreateNotebook 创建生词本
+func (s *NotebookService) CreateNotebook(ctx context.Context, req *pb.NotebookServiceCreateNotebookRequest) (*pb.NotebookServiceCreateNotebookResponse, error) {
+	notebook, err := s.service.CreateNotebook(ctx, req.Name, req.Description)
+	if err != nil {
+		return nil, toStatusError(err, "failed to create notebook")
+	}
+	return &pb.NotebookServiceCreateNotebookResponse{
+		Notebook: toProtoNotebook(notebook, 0),
+	}, nil
+}
+
+// ListNotebooks 获取当前用户的生词本列表
+func (s *NotebookService) ListNotebooks(ctx context.Context, req *pb.NotebookServiceListNotebooksRequest) (*pb.NotebookServiceListNotebooksResponse, error) {
+	summaries, err := s.service.ListNotebooks(ctx)
+	if err != nil {
+		return nil, toStatusError(err, "failed to list notebooks")
+	}
+
+	notebooks := make([]*pb.WordNotebook, len(summaries))
+	for i, summary := range summaries {
+		notebooks[i] = toProtoNotebook(summary.Notebook, summary.EntryCount)
+	}
+	return &pb.NotebookServiceListNotebooksResponse{
+		Notebooks: notebooks,
+	}, nil
+}
+
+// UpdateNotebook 修改生词本名称和描述
+func (s *NotebookService) UpdateNotebook(ctx context.Context, req *pb.NotebookServiceUpdateNotebookRequest) (*pb.NotebookServiceUpdateNotebookResponse, error) {
+	notebook, err := s.service.UpdateNotebook(ctx, entity.WordNotebookID(req.Id), req.Name, req.Description)
+	if err != nil {
+		return nil, toStatusError(err, "failed to update notebook")
+	}
+	return &pb.NotebookServiceUpdateNotebookResponse{
+		Notebook: toProtoNotebook(notebook, 0),
+	}, nil
+}
+
+// DeleteNotebook 删除生词本及其中的词条
+func (s *NotebookService) DeleteNotebook(ctx context.Context, req *pb.NotebookServiceDeleteNotebookRequest) (*pb.NotebookServiceDeleteNotebookResponse, error) {
+	if err := s.service.DeleteNotebook(ctx, entity.WordNotebookID(req.Id)); err != nil {
+		return nil, toStatusError(err, "failed to delete notebook")
+	}
+	return &pb.NotebookServiceDeleteNotebookResponse{}, nil
+}
+
+// AddEntry 向生词本添加词典单词或自定义词条
+func (s *NotebookService) AddEntry(ctx context.Context, req *pb.NotebookServiceAddEntryRequest) (*pb.NotebookServiceAddEntryResponse, error) {
+	entry, err := s.service.AddEntry(ctx, &dto.AddNotebookEntryRequest{
+		NotebookID: entity.WordNotebookID(req.NotebookId),
+		WordID:     entity.WordID(req.WordId),
+		Text:       req.Text,
+		Meaning:    req.Meaning,
+		Note:       req.Note,
+		Examples:   req.Examples,
+	})
+	if err != nil {
+		return nil, toStatusError(err, "failed to add notebook entry")
+	}
+	return &pb.NotebookServiceAddEntryResponse{
+		Entry: toProtoEntry(entry),
+	}, nil
+}
+
+// UpdateEntry 修改词条的笔记和例句
+func (s *NotebookService) UpdateEntry(ctx context.Context, req *pb.NotebookServiceUpdateEntryRequest) (*pb.NotebookServiceUpdateEntryResponse, error) {
+	entry, err := s.service.UpdateEntry(ctx, &dto.UpdateNotebookEntryRequest{
+		NotebookID: entity.WordNotebookID(req.NotebookId),
+		EntryID:    entity.WordNotebookEntryID(req.Id),
+		Text:       req.Text,
+		Meaning:    req.Meaning,
+		Note:       req.Note,
+		Examples:   req.Examples,
+	})
+	if err != nil {
+		return nil, toStatusError(err, "failed to update notebook entry")
+	}
+	return &pb.NotebookServiceUpdateEntryResponse{
+		Entry: toProtoEntry(entry),
+	}, nil
+}
+
+// RemoveEntry 从生词本移除词条
+func (s *NotebookService) RemoveEntry(ctx context.Context, req *pb.NotebookServiceRemoveEntryRequest) (*pb.NotebookServiceRemoveEntryResponse, error) {
+	err := s.service.RemoveEntry(ctx, entity.WordNotebookID(req.NotebookId), entity.WordNotebookEntryID(req.Id))
+	if err != nil {
+		return nil, toStatusError(err, "failed to remove notebook entry")
+	}
+	return &pb.NotebookServiceRemoveEntryResponse{}, nil
+}
+
+// ListEntries 分页查询生词本中的词条
+func (s *NotebookService) ListEntries(ctx context.Context, req *pb.NotebookServiceListEntriesRequest) (*pb.NotebookServiceListEntriesResponse, error) {
+	entries, total, err := s.service.ListEntries(ctx, &dto.ListNotebookEntriesRequest{
+		NotebookID: entity.WordNotebookID(req.NotebookId),
+		Page:       int(req.Page),
+		PageSize:   int(req.PageSize),
+		Keyword:    req.Keyword,
+		Level:      valueobject.WordDifficultyLevel(req.Level),
+		Tags:       req.Tags,
+		Sort:       repository.WordNotebookEntrySort(req.Sort),
+	})
+	if err != nil {
+		return nil, toStatusError(err, "failed to list notebook entries")
+	}
+
+	pbEntries := make([]*pb.WordNotebookEntry, len(entries))
+	for i, entry := range entries {
+		pbEntries[i] = toProtoEntry(entry)
+	}
+	return &pb.NotebookServiceListEntriesResponse{
+		Entries: pbEntries,
+		Total:   uint32(total),
+	}, nil
+}
+
+// toProtoNotebook 将生词本实体转换为 protobuf 消息
+func toProtoNotebook(notebook *entity.WordNotebook, entryCount int64) *pb.WordNotebook {
+	return &pb.WordNotebook{
+		Id:          uint32(notebook.ID),
+		Name:        notebook.Name,
+		Description: notebook.Description,
+		EntryCount:  uint32(entryCount),
+		CreatedAt:   timestamppb.New(notebook.CreatedAt),
+		UpdatedAt:   timestamppb.New(notebook.UpdatedAt),
+	}
+}
+
+// toProtoEntry 将生词本词条实体转换为 protobuf 消息
+func toProtoEntry(entry *entity.WordNotebookEntry) *pb.WordNotebookEntry {
+	pbEntry := &pb.WordNotebookEntry{
+		Id:         uint32(entry.ID),
+		NotebookId: uint32(entry.NotebookID),
+		Word:       vocabulary.ToProtoWord(entry.Word),
+		Text:       entry.DisplayText(),
+		Meaning:    entry.Meaning,
+		Note:       entry.Note,
+		Examples:   entry.Examples,
+		Custom:     entry.IsCustom(),
+		CreatedAt:  timestamppb.New(entry.CreatedAt),
+		UpdatedAt:  timestamppb.New(entry.UpdatedAt),
+	}
+	if entry.WordID != nil {
+		pbEntry.WordId = uint32(*entry.WordID)
+	}
+	return pbEntry
+}
+
+// toStatusError 将领域错误转换为 gRPC 状态错误，无法识别的错误原样返回
+func toStatusError(err error, message string) error {
+	var domainErr *domainErrors.Error
+	if !errors.As(err, &domainErr) {
+		return err
+	}
+	switch domainErr.Code {
+	case domainErrors.CodeInvalidArgument:
+		return status.Errorf(codes.InvalidArgument, "%s: %v", message, err)
+	case domainErrors.CodeNotFound, domainErrors.CodeWordNotFound:
+		return status.Errorf(codes.NotFound, "%s: %v", message, err)
+	case domainErrors.CodeAlreadyExists:
+		return status.Errorf(codes.AlreadyExists, "%s: %v", message, err)
+	case domainErrors.CodeUnauthenticated:
+		return status.Errorf(codes.Unauthenticated, "%s: %v", message, err)
+	}
+	return err
+}
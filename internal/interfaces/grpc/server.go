@@ -26,9 +26,11 @@ import (
 	"github.com/lazyjean/sla2/internal/interfaces/grpc/course"
 	"github.com/lazyjean/sla2/internal/interfaces/grpc/learning"
 	grpcmiddleware "github.com/lazyjean/sla2/internal/interfaces/grpc/middleware"
+	"github.com/lazyjean/sla2/internal/interfaces/grpc/notebook"
 	"github.com/lazyjean/sla2/internal/interfaces/grpc/question"
 	"github.com/lazyjean/sla2/internal/interfaces/grpc/user"
 	"github.com/lazyjean/sla2/internal/interfaces/grpc/vocabulary"
+	"github.com/lazyjean/sla2/internal/interfaces/grpc/word"
 	"github.com/lazyjean/sla2/internal/interfaces/http/ws/handler"
 	"github.com/lazyjean/sla2/pkg/logger"
 	httpSwagger "github.com/swaggo/http-swagger"
@@ -46,11 +48,16 @@ type GRPCServer struct {
 	grpcServer        *grpc.Server
 	httpServer        *http.Server
 	userService       *service.UserService
+	settingsService   *service.UserSettingsService
 	questionService   *service.QuestionService
 	vocabularyService *service.VocabularyService
+	notebookService   *service.WordNotebookService
 	courseService     *service.CourseService
 	learningService   *service.LearningService
 	memoryService     service.MemoryService
+	sessionService    *service.StudySessionService
+	activityService   *service.StudyActivityService
+	hanCharService    *service.HanCharLearningService
 	adminService      *service.AdminService
 	wsHandler         *handler.WebSocketHandler
 	unaryInterceptor  grpc.UnaryServerInterceptor
@@ -94,11 +101,16 @@ func (s *healthServer) Watch(req *grpc_health_v1.HealthCheckRequest, stream grpc
 // NewGRPCServer 创建新的 gRPC 服务器
 func NewGRPCServer(
 	userService *service.UserService,
+	settingsService *service.UserSettingsService,
 	questionService *service.QuestionService,
 	vocabularyService *service.VocabularyService,
+	notebookService *service.WordNotebookService,
 	courseService *service.CourseService,
 	learningService *service.LearningService,
 	memoryService service.MemoryService,
+	sessionService *service.StudySessionService,
+	activityService *service.StudyActivityService,
+	hanCharService *service.HanCharLearningService,
 	adminService *service.AdminService,
 	wsHandler *handler.WebSocketHandler,
 	tokenService security.TokenService,
@@ -150,6 +162,12 @@ func NewGRPCServer(
 	if err := pb.RegisterVocabularyServiceHandlerFromEndpoint(ctx, mux, endpoint, opts); err != nil {
 		logger.GetLogger(ctx).Fatal("failed to register vocabulary service handler", zap.Error(err))
 	}
+	if err := pb.RegisterWordServiceHandlerFromEndpoint(ctx, mux, endpoint, opts); err != nil {
+		logger.GetLogger(ctx).Fatal("failed to register word service handler", zap.Error(err))
+	}
+	if err := pb.RegisterNotebookServiceHandlerFromEndpoint(ctx, mux, endpoint, opts); err != nil {
+		logger.GetLogger(ctx).Fatal("failed to register notebook service handler", zap.Error(err))
+	}
 	if err := pb.RegisterCourseServiceHandlerFromEndpoint(ctx, mux, endpoint, opts); err != nil {
 		logger.GetLogger(ctx).Fatal("failed to register course service handler", zap.Error(err))
 	}
@@ -176,11 +194,16 @@ func NewGRPCServer(
 		config:            config.GetConfig(),
 		grpcServer:        grpcServer,
 		userService:       userService,
+		settingsService:   settingsService,
 		questionService:   questionService,
 		vocabularyService: vocabularyService,
+		notebookService:   notebookService,
 		courseService:     courseService,
 		learningService:   learningService,
 		memoryService:     memoryService,
+		sessionService:    sessionService,
+		activityService:   activityService,
+		hanCharService:    hanCharService,
 		adminService:      adminService,
 		wsHandler:         wsHandler,
 		mux:               mux,
@@ -231,7 +254,7 @@ func NewGRPCServer(
 
 func (s *GRPCServer) registerServices() {
 	// 注册用户服务
-	pb.RegisterUserServiceServer(s.grpcServer, user.NewUserService(s.userService))
+	pb.RegisterUserServiceServer(s.grpcServer, user.NewUserService(s.userService, s.settingsService))
 
 	// 注册问题服务
 	pb.RegisterQuestionServiceServer(s.grpcServer, question.NewQuestionService(s.questionService))
@@ -239,11 +262,17 @@ func (s *GRPCServer) registerServices() {
 	// 注册词汇服务
 	pb.RegisterVocabularyServiceServer(s.grpcServer, vocabulary.NewVocabularyService(s.vocabularyService))
 
+	// 注册旧版单词服务，与词汇服务共用单词数据
+	pb.RegisterWordServiceServer(s.grpcServer, word.NewWordService(s.vocabularyService))
+
+	// 注册生词本服务
+	pb.RegisterNotebookServiceServer(s.grpcServer, notebook.NewNotebookService(s.notebookService))
+
 	// 注册课程服务
 	pb.RegisterCourseServiceServer(s.grpcServer, course.NewCourseService(s.courseService))
 
 	// 注册学习服务
-	pb.RegisterLearningServiceServer(s.grpcServer, learning.NewLearningService(s.learningService, s.memoryService))
+	pb.RegisterLearningServiceServer(s.grpcServer, learning.NewLearningService(s.learningService, s.memoryService, s.sessionService, s.activityService, s.hanCharService))
 
 	// 注册管理员服务
 	pb.RegisterAdminServiceServer(s.grpcServer, admin.NewAdminService(s.adminService))
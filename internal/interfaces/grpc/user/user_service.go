@@ -2,10 +2,13 @@ package user
 
 import (
 	"context"
+	"errors"
 
 	pb "github.com/lazyjean/sla2/api/proto/v1"
 	"github.com/lazyjean/sla2/internal/application/dto"
 	"github.com/lazyjean/sla2/internal/application/service"
+	"github.com/lazyjean/sla2/internal/domain/entity"
+	domainErrors "github.com/lazyjean/sla2/internal/domain/errors"
 	"github.com/lazyjean/sla2/internal/interfaces/grpc/middleware"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -15,12 +18,14 @@ import (
 
 type UserService struct {
 	pb.UnimplementedUserServiceServer
-	userService *service.UserService
+	userService     *service.UserService
+	settingsService *service.UserSettingsService
 }
 
-func NewUserService(userService *service.UserService) *UserService {
+func NewUserService(userService *service.UserService, settingsService *service.UserSettingsService) *UserService {
 	return &UserService{
-		userService: userService,
+		userService:     userService,
+		settingsService: settingsService,
 	}
 }
 
@@ -97,20 +102,22 @@ func (s *UserService) GetUserInfo(ctx context.Context, req *pb.GetUserInfoReques
 
 	return &pb.GetUserInfoResponse{
 		User: &pb.User{
-			Id:       uint64(user.ID),
-			Username: user.Username,
-			Email:    user.Email,
-			Nickname: user.Nickname,
-			Avatar:   user.Avatar,
-			Status:   pb.UserStatus_USER_STATUS_ACTIVE,
+			Id:        uint64(user.ID),
+			Username:  user.Username,
+			Email:     user.Email,
+			Nickname:  user.Nickname,
+			Avatar:    user.Avatar,
+			Status:    pb.UserStatus_USER_STATUS_ACTIVE,
+			Scheduler: user.Scheduler,
 		},
 	}, nil
 }
 
 func (s *UserService) UpdateUserInfo(ctx context.Context, req *pb.UpdateUserInfoRequest) (*pb.UpdateUserInfoResponse, error) {
 	err := s.userService.UpdateUser(ctx, &dto.UpdateUserRequest{
-		Nickname: req.Nickname,
-		Avatar:   req.Avatar,
+		Nickname:  req.Nickname,
+		Avatar:    req.Avatar,
+		Scheduler: req.Scheduler,
 	})
 	if err != nil {
 		return nil, err
@@ -119,6 +126,84 @@ func (s *UserService) UpdateUserInfo(ctx context.Context, req *pb.UpdateUserInfo
 	return &pb.UpdateUserInfoResponse{}, nil
 }
 
+// GetUserSettings 获取用户学习偏好
+func (s *UserService) GetUserSettings(ctx context.Context, req *pb.GetUserSettingsRequest) (*pb.GetUserSettingsResponse, error) {
+	settings, err := s.settingsService.GetSettings(ctx)
+	if err != nil {
+		return nil, toSettingsStatusError(err)
+	}
+
+	return &pb.GetUserSettingsResponse{
+		Settings: toPBUserSettings(settings),
+	}, nil
+}
+
+// UpdateUserSettings 更新用户学习偏好
+func (s *UserService) UpdateUserSettings(ctx context.Context, req *pb.UpdateUserSettingsRequest) (*pb.UpdateUserSettingsResponse, error) {
+	if req.Settings == nil {
+		return nil, status.Error(codes.InvalidArgument, "settings is required")
+	}
+
+	unitTypes := make([]entity.MemoryUnitType, len(req.Settings.PreferredUnitTypes))
+	for i, t := range req.Settings.PreferredUnitTypes {
+		unitTypes[i] = entity.MemoryUnitType(t)
+	}
+
+	settings, err := s.settingsService.UpdateSettings(ctx, &dto.UpdateUserSettingsRequest{
+		DailyReviewGoal:    req.Settings.DailyReviewGoal,
+		DailyNewGoal:       req.Settings.DailyNewGoal,
+		DesiredRetention:   req.Settings.DesiredRetention,
+		MaximumInterval:    req.Settings.MaximumInterval,
+		DayStartHour:       req.Settings.DayStartHour,
+		TimeZone:           req.Settings.TimeZone,
+		PreferredUnitTypes: unitTypes,
+		LeechThreshold:     req.Settings.LeechThreshold,
+		LeechAction:        entity.LeechAction(req.Settings.LeechAction),
+		StreakFreezeDays:   req.Settings.StreakFreezeDays,
+	})
+	if err != nil {
+		return nil, toSettingsStatusError(err)
+	}
+
+	return &pb.UpdateUserSettingsResponse{
+		Settings: toPBUserSettings(settings),
+	}, nil
+}
+
+// toPBUserSettings 将领域实体转换为 PB 用户学习偏好
+func toPBUserSettings(settings *entity.UserSettings) *pb.UserSettings {
+	unitTypes := make([]pb.MemoryUnitType, len(settings.PreferredUnitTypes))
+	for i, t := range settings.PreferredUnitTypes {
+		unitTypes[i] = pb.MemoryUnitType(t)
+	}
+	return &pb.UserSettings{
+		DailyReviewGoal:    settings.DailyReviewGoal,
+		DailyNewGoal:       settings.DailyNewGoal,
+		DesiredRetention:   settings.DesiredRetention,
+		MaximumInterval:    settings.MaximumInterval,
+		DayStartHour:       settings.DayStartHour,
+		TimeZone:           settings.TimeZone,
+		PreferredUnitTypes: unitTypes,
+		LeechThreshold:     settings.LeechThreshold,
+		LeechAction:        pb.LeechAction(settings.LeechAction),
+		StreakFreezeDays:   settings.StreakFreezeDays,
+	}
+}
+
+// toSettingsStatusError 将学习偏好相关的领域错误转换为 gRPC 状态错误
+func toSettingsStatusError(err error) error {
+	var domainErr *domainErrors.Error
+	if errors.As(err, &domainErr) {
+		switch domainErr.Code {
+		case domainErrors.CodeInvalidArgument:
+			return status.Error(codes.InvalidArgument, domainErr.Message)
+		case domainErrors.CodeUnauthenticated, domainErrors.CodeInvalidUserID:
+			return status.Errorf(codes.Unauthenticated, "invalid user context: %v", err)
+		}
+	}
+	return status.Errorf(codes.Internal, "failed to access user settings: %v", err)
+}
+
 func (s *UserService) ChangePassword(ctx context.Context, req *pb.ChangePasswordRequest) (*pb.ChangePasswordResponse, error) {
 	err := s.userService.ChangePassword(ctx, &dto.ChangePasswordRequest{
 		OldPassword: req.OldPassword,
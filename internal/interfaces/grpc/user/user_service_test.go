@@ -11,12 +11,15 @@ import (
 	"github.com/lazyjean/sla2/internal/application/dto"
 	"github.com/lazyjean/sla2/internal/application/service"
 	"github.com/lazyjean/sla2/internal/domain/entity"
+	domainErrors "github.com/lazyjean/sla2/internal/domain/errors"
 	domainOauth "github.com/lazyjean/sla2/internal/domain/oauth"
 	domainSecurity "github.com/lazyjean/sla2/internal/domain/security"
+	domainService "github.com/lazyjean/sla2/internal/domain/service"
 	"github.com/lazyjean/sla2/internal/infrastructure/persistence/postgres"
 	"github.com/lazyjean/sla2/pkg/logger"
 	"github.com/lazyjean/sla2/pkg/utils"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -132,7 +135,7 @@ func setupTestServer(t *testing.T) (*grpc.Server, func()) {
 	)
 
 	// 注册服务
-	pb.RegisterUserServiceServer(grpcServer, NewUserService(userService))
+	pb.RegisterUserServiceServer(grpcServer, NewUserService(userService, service.NewUserSettingsService(postgres.NewUserSettingsRepository(db))))
 
 	// 启动服务器
 	go func() {
@@ -399,6 +402,94 @@ func TestUserService_UpdateUserInfo(t *testing.T) {
 	assert.Equal(t, "new_avatar.jpg", user.Avatar)
 }
 
+// TestUserService_UserSettings 测试用户学习偏好
+func TestUserService_UserSettings(t *testing.T) {
+	ctx := context.Background()
+
+	// 初始化日志
+	log := zap.NewExample()
+	ctx = logger.WithContext(ctx, log)
+
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	settingsService := service.NewUserSettingsService(postgres.NewUserSettingsRepository(db))
+	ctx = service.WithUserID(ctx, entity.UID(1))
+
+	// 未设置时返回默认偏好
+	settings, err := settingsService.GetSettings(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(entity.DefaultDailyReviewGoal), settings.DailyReviewGoal)
+	assert.Equal(t, uint32(entity.DefaultDailyNewGoal), settings.DailyNewGoal)
+	assert.Equal(t, uint32(entity.DefaultDayStartHour), settings.DayStartHour)
+
+	// 更新后读取到新的偏好，零值不会被默认值覆盖
+	req := &dto.UpdateUserSettingsRequest{
+		DailyReviewGoal:    50,
+		DailyNewGoal:       0,
+		DesiredRetention:   0.85,
+		MaximumInterval:    180,
+		DayStartHour:       0,
+		TimeZone:           "Asia/Shanghai",
+		PreferredUnitTypes: []entity.MemoryUnitType{entity.MemoryUnitTypeHanChar},
+	}
+	_, err = settingsService.UpdateSettings(ctx, req)
+	require.NoError(t, err)
+
+	settings, err = settingsService.GetSettings(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(50), settings.DailyReviewGoal)
+	assert.Equal(t, uint32(0), settings.DailyNewGoal)
+	assert.Equal(t, 0.85, settings.DesiredRetention)
+	assert.Equal(t, uint32(180), settings.MaximumInterval)
+	assert.Equal(t, uint32(0), settings.DayStartHour)
+	assert.Equal(t, "Asia/Shanghai", settings.TimeZone)
+	assert.Equal(t, []entity.MemoryUnitType{entity.MemoryUnitTypeHanChar}, settings.PreferredUnitTypes)
+
+	// 再次更新覆盖已有偏好
+	req.TimeZone = "Europe/Berlin"
+	_, err = settingsService.UpdateSettings(ctx, req)
+	require.NoError(t, err)
+	settings, err = settingsService.GetSettings(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "Europe/Berlin", settings.TimeZone)
+
+	// 已有偏好时修改顽固项策略
+	req.LeechThreshold, req.LeechAction = 5, entity.LeechActionTag
+	_, err = settingsService.UpdateSettings(ctx, req)
+	require.NoError(t, err)
+	req.LeechThreshold, req.LeechAction = 12, entity.LeechActionDisabled
+	_, err = settingsService.UpdateSettings(ctx, req)
+	require.NoError(t, err)
+	settings, err = settingsService.GetSettings(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(12), settings.LeechThreshold)
+	assert.Equal(t, entity.LeechActionDisabled, settings.LeechAction)
+
+	// 已有偏好时修改断签保护天数
+	req.StreakFreezeDays = 2
+	_, err = settingsService.UpdateSettings(ctx, req)
+	require.NoError(t, err)
+	settings, err = settingsService.GetSettings(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(2), settings.StreakFreezeDays)
+
+	// 已有偏好时保存拟合的调度器权重
+	settingsRepo := postgres.NewUserSettingsRepository(db)
+	weights := []float64{0.4, 0.6, 2.4, 5.8}
+	tuning := service.NewSchedulerTuningService(postgres.NewMemoryReviewRepository(db), settingsRepo, domainService.SchedulerOptions{})
+	require.NoError(t, tuning.SaveUserWeights(ctx, 1, weights))
+	saved, err := settingsRepo.GetByUserID(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, weights, saved.SchedulerWeights)
+	assert.Equal(t, uint32(2), saved.StreakFreezeDays)
+
+	// 无效的时区
+	req.TimeZone = "Mars/Olympus"
+	_, err = settingsService.UpdateSettings(ctx, req)
+	assert.ErrorIs(t, err, domainErrors.ErrInvalidTimeZone)
+}
+
 // TestUserService_ChangePassword 测试修改密码功能
 func TestUserService_ChangePassword(t *testing.T) {
 	ctx := context.Background()
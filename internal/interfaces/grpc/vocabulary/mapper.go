@@ -2,7 +2,9 @@ package vocabulary
 
 import (
 	pb "github.com/lazyjean/sla2/api/proto/v1"
+	"github.com/lazyjean/sla2/internal/application/dto"
 	"github.com/lazyjean/sla2/internal/domain/entity"
+	domainService "github.com/lazyjean/sla2/internal/domain/service"
 	"github.com/lazyjean/sla2/internal/domain/valueobject"
 )
 
@@ -34,16 +36,231 @@ func ToProtoWord(word *entity.Word) *pb.Word {
 	}
 }
 
+// ToCreateWordRequest 将 Proto 单词消息转换为创建或更新单词的请求
+func ToCreateWordRequest(word *pb.Word) dto.CreateWordRequest {
+	definitions := make([]entity.Definition, len(word.Definitions))
+	for i, def := range word.Definitions {
+		definitions[i] = entity.Definition{
+			PartOfSpeech: def.PartOfSpeech.String(),
+			Meaning:      def.Meaning,
+			Example:      def.Example,
+			Synonyms:     def.Synonyms,
+			Antonyms:     def.Antonyms,
+		}
+	}
+
+	return dto.CreateWordRequest{
+		Text:        word.Word,
+		Phonetic:    word.Spelling,
+		Definitions: definitions,
+		Examples:    word.Examples,
+		Tags:        word.Tags,
+		Level:       ConvertLevelToValueObject(word.Level),
+	}
+}
+
+// ToProtoWordSearchResult 将单词检索结果转换为 Proto 消息
+func ToProtoWordSearchResult(result *dto.WordSearchResult) *pb.WordSearchResult {
+	highlights := make([]*pb.WordSearchHighlight, len(result.Highlights))
+	for i, highlight := range result.Highlights {
+		highlights[i] = &pb.WordSearchHighlight{
+			Field:    highlight.Field,
+			Fragment: highlight.Fragment,
+		}
+	}
+	return &pb.WordSearchResult{
+		Word:        ToProtoWord(result.Word),
+		Rank:        result.Rank,
+		Highlights:  highlights,
+		MatchedForm: ToProtoWordForm(result.MatchedForm),
+	}
+}
+
+// ToProtoWordForm 将词形实体转换为 Proto 消息
+func ToProtoWordForm(form *entity.WordForm) *pb.WordForm {
+	if form == nil {
+		return nil
+	}
+	return &pb.WordForm{
+		Form: form.Form,
+		Kind: pb.WordFormKind(form.Kind),
+	}
+}
+
+// ToProtoWordForms 将词形实体列表转换为 Proto 消息
+func ToProtoWordForms(forms []*entity.WordForm) []*pb.WordForm {
+	pbForms := make([]*pb.WordForm, len(forms))
+	for i, form := range forms {
+		pbForms[i] = ToProtoWordForm(form)
+	}
+	return pbForms
+}
+
+// ToProtoExampleInflections 将例句中出现的原形和词形转换为 Proto 消息
+func ToProtoExampleInflections(inflections []domainService.ExampleInflection) []*pb.WordExampleInflection {
+	pbInflections := make([]*pb.WordExampleInflection, len(inflections))
+	for i, inflection := range inflections {
+		pbInflections[i] = &pb.WordExampleInflection{
+			Example:   inflection.Example,
+			Text:      inflection.Text,
+			Kind:      pb.WordFormKind(inflection.Kind),
+			Inflected: inflection.Inflected,
+			Start:     uint32(inflection.Start),
+			End:       uint32(inflection.End),
+		}
+	}
+	return pbInflections
+}
+
+// ToImportWordFormsRequest 将 Proto 词形导入请求转换为导入请求
+func ToImportWordFormsRequest(req *pb.VocabularyServiceBatchImportWordFormsRequest) *dto.ImportWordFormsRequest {
+	words := make([]*dto.WordFormsRequest, len(req.Words))
+	for i, data := range req.Words {
+		forms := make([]dto.WordFormItem, len(data.Forms))
+		for j, form := range data.Forms {
+			forms[j] = dto.WordFormItem{Form: form.Form, Kind: entity.WordFormKind(form.Kind)}
+		}
+		words[i] = &dto.WordFormsRequest{Lemma: data.Lemma, Forms: forms}
+	}
+	return &dto.ImportWordFormsRequest{
+		Words:    words,
+		FormList: req.FormList,
+	}
+}
+
+// ToProtoWordRelation 将关联单词转换为 Proto 消息
+func ToProtoWordRelation(neighbor *dto.WordNeighbor) *pb.WordRelation {
+	return &pb.WordRelation{
+		Id:      uint32(neighbor.RelationID),
+		Type:    pb.LexicalRelationType(neighbor.Type),
+		Inverse: neighbor.Inverse,
+		Note:    neighbor.Note,
+		Word:    ToProtoWord(neighbor.Word),
+	}
+}
+
+// ToProtoHanCharRelation 将关联汉字转换为 Proto 消息
+func ToProtoHanCharRelation(neighbor *dto.HanCharNeighbor) *pb.HanCharRelation {
+	return &pb.HanCharRelation{
+		Id:      uint32(neighbor.RelationID),
+		Type:    pb.LexicalRelationType(neighbor.Type),
+		Inverse: neighbor.Inverse,
+		Note:    neighbor.Note,
+		HanChar: ToProtoHanChar(neighbor.HanChar),
+	}
+}
+
+// ToLexicalRelationTypes 将 Proto 关联类型列表转换为实体关联类型
+func ToLexicalRelationTypes(types []pb.LexicalRelationType) []entity.LexicalRelationType {
+	relationTypes := make([]entity.LexicalRelationType, len(types))
+	for i, relationType := range types {
+		relationTypes[i] = entity.LexicalRelationType(relationType)
+	}
+	return relationTypes
+}
+
+// ToImportRelationsRequest 将 Proto 关联导入请求转换为导入请求
+func ToImportRelationsRequest(req *pb.VocabularyServiceBatchImportRelationsRequest) *dto.ImportRelationsRequest {
+	toItems := func(data []*pb.RelationData) []*dto.RelationImportItem {
+		items := make([]*dto.RelationImportItem, len(data))
+		for i, item := range data {
+			items[i] = &dto.RelationImportItem{
+				Source: item.Source,
+				Target: item.Target,
+				Type:   entity.LexicalRelationType(item.Type),
+				Note:   item.Note,
+			}
+		}
+		return items
+	}
+	return &dto.ImportRelationsRequest{
+		Words:    toItems(req.Words),
+		HanChars: toItems(req.HanChars),
+	}
+}
+
 // ToProtoHanChar 将汉字实体转换为 Proto 消息
 func ToProtoHanChar(hanChar *entity.HanChar) *pb.HanChar {
 	return &pb.HanChar{
-		Id:         uint32(hanChar.ID),
-		Character:  hanChar.Character,
-		Pinyin:     hanChar.Pinyin,
-		Tags:       hanChar.Tags,
-		Categories: hanChar.Categories,
-		Examples:   hanChar.Examples,
-		Level:      ConvertLevelToProto(hanChar.Level),
+		Id:             uint32(hanChar.ID),
+		Character:      hanChar.Character,
+		Pinyin:         hanChar.Pinyin,
+		Tags:           hanChar.Tags,
+		Categories:     hanChar.Categories,
+		Examples:       hanChar.Examples,
+		Level:          ConvertLevelToProto(hanChar.Level),
+		PinyinNumbered: hanChar.PinyinNumbered,
+		Radical:        hanChar.Radical,
+		StrokeCount:    hanChar.StrokeCount,
+		Components:     hanChar.Components,
+		Traditional:    hanChar.Traditional,
+		Simplified:     hanChar.Simplified,
+		Meanings:       hanChar.Meanings,
+	}
+}
+
+// ToHanCharRequest 将 Proto 汉字消息转换为创建或更新汉字的请求
+func ToHanCharRequest(hanChar *pb.HanChar) *dto.HanCharRequest {
+	return &dto.HanCharRequest{
+		Character:      hanChar.Character,
+		Pinyin:         hanChar.Pinyin,
+		PinyinNumbered: hanChar.PinyinNumbered,
+		Level:          ConvertLevelToValueObject(hanChar.Level),
+		Tags:           hanChar.Tags,
+		Categories:     hanChar.Categories,
+		Examples:       hanChar.Examples,
+		Radical:        hanChar.Radical,
+		StrokeCount:    hanChar.StrokeCount,
+		Components:     hanChar.Components,
+		Traditional:    hanChar.Traditional,
+		Simplified:     hanChar.Simplified,
+		Meanings:       hanChar.Meanings,
+	}
+}
+
+// ToProtoHanCharStrokeOrder 将汉字笔顺数据转换为 Proto 消息，没有笔顺数据时返回 nil
+func ToProtoHanCharStrokeOrder(strokes *entity.HanCharStrokes) *pb.HanCharStrokeOrder {
+	if strokes == nil {
+		return nil
+	}
+	return &pb.HanCharStrokeOrder{
+		Strokes: strokes.Strokes,
+		Medians: ToProtoStrokePolylines(strokes.Medians),
+	}
+}
+
+// ToProtoStrokePolylines 将笔画折线转换为 Proto 消息
+func ToProtoStrokePolylines(polylines []entity.StrokePolyline) []*pb.StrokePolyline {
+	result := make([]*pb.StrokePolyline, len(polylines))
+	for i, polyline := range polylines {
+		points := make([]*pb.StrokePoint, len(polyline))
+		for j, point := range polyline {
+			points[j] = &pb.StrokePoint{X: point.X(), Y: point.Y()}
+		}
+		result[i] = &pb.StrokePolyline{Points: points}
+	}
+	return result
+}
+
+// ToStrokePolylines 将 Proto 笔画折线转换为实体
+func ToStrokePolylines(polylines []*pb.StrokePolyline) []entity.StrokePolyline {
+	result := make([]entity.StrokePolyline, len(polylines))
+	for i, polyline := range polylines {
+		points := make(entity.StrokePolyline, len(polyline.GetPoints()))
+		for j, point := range polyline.GetPoints() {
+			points[j] = entity.StrokePoint{point.X, point.Y}
+		}
+		result[i] = points
+	}
+	return result
+}
+
+// ToHanCharStrokesRequest 将 Proto 笔顺数据转换为导入请求
+func ToHanCharStrokesRequest(data *pb.HanCharStrokeData) *dto.HanCharStrokesRequest {
+	return &dto.HanCharStrokesRequest{
+		Character: data.Character,
+		Strokes:   data.GetStrokeOrder().GetStrokes(),
+		Medians:   ToStrokePolylines(data.GetStrokeOrder().GetMedians()),
 	}
 }
 
@@ -2,11 +2,13 @@ package vocabulary
 
 import (
 	"context"
+	"errors"
 
 	pb "github.com/lazyjean/sla2/api/proto/v1"
 	"github.com/lazyjean/sla2/internal/application/dto"
 	"github.com/lazyjean/sla2/internal/application/service"
-	"github.com/lazyjean/sla2/internal/domain/valueobject"
+	"github.com/lazyjean/sla2/internal/domain/entity"
+	domainErrors "github.com/lazyjean/sla2/internal/domain/errors"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -28,11 +30,32 @@ func NewVocabularyService(service *service.VocabularyService) *VocabularyService
 func (s *VocabularyService) Get(ctx context.Context, req *pb.VocabularyServiceGetRequest) (*pb.VocabularyServiceGetResponse, error) {
 	word, err := s.service.GetWord(ctx, uint(req.Id))
 	if err != nil {
-		return nil, err
+		return nil, toStatusError(err, "failed to get word")
+	}
+	forms, inflections, err := s.service.GetWordForms(ctx, word)
+	if err != nil {
+		return nil, toStatusError(err, "failed to get word forms")
 	}
 
 	return &pb.VocabularyServiceGetResponse{
-		Word: ToProtoWord(word),
+		Word:        ToProtoWord(word),
+		Forms:       ToProtoWordForms(forms),
+		Inflections: ToProtoExampleInflections(inflections),
+	}, nil
+}
+
+// Lookup 按单词或其屈折变化形式查找原形单词
+func (s *VocabularyService) Lookup(ctx context.Context, req *pb.VocabularyServiceLookupRequest) (*pb.VocabularyServiceLookupResponse, error) {
+	result, err := s.service.LookupWord(ctx, req.Text)
+	if err != nil {
+		return nil, toStatusError(err, "failed to look up word")
+	}
+
+	return &pb.VocabularyServiceLookupResponse{
+		Word:        ToProtoWord(result.Word),
+		MatchedForm: ToProtoWordForm(result.MatchedForm),
+		Forms:       ToProtoWordForms(result.Forms),
+		Inflections: ToProtoExampleInflections(result.Inflections),
 	}, nil
 }
 
@@ -61,6 +84,68 @@ func (s *VocabularyService) List(ctx context.Context, req *pb.VocabularyServiceL
 	}, nil
 }
 
+// Search 全文检索单词
+func (s *VocabularyService) Search(ctx context.Context, req *pb.VocabularyServiceSearchRequest) (*pb.VocabularyServiceSearchResponse, error) {
+	results, total, err := s.service.SearchWords(ctx, dto.SearchWordsRequest{
+		Keyword:  req.Keyword,
+		Page:     int(req.Page),
+		PageSize: int(req.PageSize),
+		Level:    ConvertLevelToValueObject(req.Level),
+		Tags:     req.Tags,
+		Fuzzy:    req.Fuzzy,
+	})
+	if err != nil {
+		return nil, toStatusError(err, "failed to search words")
+	}
+
+	pbResults := make([]*pb.WordSearchResult, len(results))
+	for i, result := range results {
+		pbResults[i] = ToProtoWordSearchResult(result)
+	}
+	return &pb.VocabularyServiceSearchResponse{
+		Results: pbResults,
+		Total:   uint32(total),
+	}, nil
+}
+
+// Create 创建单词
+func (s *VocabularyService) Create(ctx context.Context, req *pb.VocabularyServiceCreateRequest) (*pb.VocabularyServiceCreateResponse, error) {
+	if req.Word == nil {
+		return nil, status.Error(codes.InvalidArgument, "word is required")
+	}
+
+	word, err := s.service.CreateWord(ctx, ToCreateWordRequest(req.Word))
+	if err != nil {
+		return nil, toStatusError(err, "failed to create word")
+	}
+	return &pb.VocabularyServiceCreateResponse{
+		Word: ToProtoWord(word),
+	}, nil
+}
+
+// Update 更新单词
+func (s *VocabularyService) Update(ctx context.Context, req *pb.VocabularyServiceUpdateRequest) (*pb.VocabularyServiceUpdateResponse, error) {
+	if req.Word == nil {
+		return nil, status.Error(codes.InvalidArgument, "word is required")
+	}
+
+	word, err := s.service.UpdateWord(ctx, uint(req.Id), ToCreateWordRequest(req.Word))
+	if err != nil {
+		return nil, toStatusError(err, "failed to update word")
+	}
+	return &pb.VocabularyServiceUpdateResponse{
+		Word: ToProtoWord(word),
+	}, nil
+}
+
+// Delete 删除单词
+func (s *VocabularyService) Delete(ctx context.Context, req *pb.VocabularyServiceDeleteRequest) (*pb.VocabularyServiceDeleteResponse, error) {
+	if err := s.service.DeleteWord(ctx, uint(req.Id)); err != nil {
+		return nil, toStatusError(err, "failed to delete word")
+	}
+	return &pb.VocabularyServiceDeleteResponse{}, nil
+}
+
 // GetAllMetadata 获取所有标签和分类信息
 func (s *VocabularyService) GetAllMetadata(ctx context.Context, req *pb.VocabularyServiceGetAllMetadataRequest) (*pb.VocabularyServiceGetAllMetadataResponse, error) {
 	tags, categories, err := s.service.GetAllMetadata(ctx)
@@ -85,18 +170,17 @@ func (s *VocabularyService) ListHanChar(ctx context.Context, req *pb.VocabularyS
 		Level:      level,
 		Tags:       req.Tags,
 		Categories: req.Categories,
+		Radical:    req.Radical,
+		MinStrokes: req.MinStrokes,
+		MaxStrokes: req.MaxStrokes,
+		Component:  req.Component,
+		Pinyin:     req.Pinyin,
+		IgnoreTone: req.IgnoreTone,
 	}
 
-	hanChars, total, err := s.service.ListHanChars(
-		ctx,
-		request.Page,
-		request.PageSize,
-		request.Level,
-		request.Tags,
-		request.Categories,
-	)
+	hanChars, total, err := s.service.ListHanChars(ctx, request)
 	if err != nil {
-		return nil, err
+		return nil, toStatusError(err, "invalid han char filter")
 	}
 
 	var pbHanChars []*pb.HanChar
@@ -110,6 +194,85 @@ func (s *VocabularyService) ListHanChar(ctx context.Context, req *pb.VocabularyS
 	}, nil
 }
 
+// GetHanChar 按ID或字符查询汉字详情，包含笔顺数据
+func (s *VocabularyService) GetHanChar(ctx context.Context, req *pb.VocabularyServiceGetHanCharRequest) (*pb.VocabularyServiceGetHanCharResponse, error) {
+	if req.Id == 0 && req.Character == "" {
+		return nil, status.Error(codes.InvalidArgument, "id or character is required")
+	}
+
+	hanChar, strokes, err := s.service.LookupHanChar(ctx, entity.HanCharID(req.Id), req.Character)
+	if err != nil {
+		return nil, toStatusError(err, "failed to get han char")
+	}
+
+	pbHanChar := ToProtoHanChar(hanChar)
+	pbHanChar.StrokeOrder = ToProtoHanCharStrokeOrder(strokes)
+	return &pb.VocabularyServiceGetHanCharResponse{
+		HanChar: pbHanChar,
+	}, nil
+}
+
+// SearchHanChar 按汉字或拼音搜索汉字
+func (s *VocabularyService) SearchHanChar(ctx context.Context, req *pb.VocabularyServiceSearchHanCharRequest) (*pb.VocabularyServiceSearchHanCharResponse, error) {
+	hanChars, total, err := s.service.SearchHanChars(ctx, req.Keyword, dto.ListHanCharsDTO{
+		Page:       int(req.Page),
+		PageSize:   int(req.PageSize),
+		Level:      ConvertLevelToValueObject(req.Level),
+		Tags:       req.Tags,
+		IgnoreTone: req.IgnoreTone,
+	})
+	if err != nil {
+		return nil, toStatusError(err, "failed to search han chars")
+	}
+
+	pbHanChars := make([]*pb.HanChar, len(hanChars))
+	for i, hanChar := range hanChars {
+		pbHanChars[i] = ToProtoHanChar(hanChar)
+	}
+	return &pb.VocabularyServiceSearchHanCharResponse{
+		HanChars: pbHanChars,
+		Total:    uint32(total),
+	}, nil
+}
+
+// CreateHanChar 创建汉字
+func (s *VocabularyService) CreateHanChar(ctx context.Context, req *pb.VocabularyServiceCreateHanCharRequest) (*pb.VocabularyServiceCreateHanCharResponse, error) {
+	if req.HanChar == nil {
+		return nil, status.Error(codes.InvalidArgument, "han char is required")
+	}
+
+	hanChar, err := s.service.CreateHanChar(ctx, ToHanCharRequest(req.HanChar))
+	if err != nil {
+		return nil, toStatusError(err, "failed to create han char")
+	}
+	return &pb.VocabularyServiceCreateHanCharResponse{
+		HanChar: ToProtoHanChar(hanChar),
+	}, nil
+}
+
+// UpdateHanChar 更新汉字
+func (s *VocabularyService) UpdateHanChar(ctx context.Context, req *pb.VocabularyServiceUpdateHanCharRequest) (*pb.VocabularyServiceUpdateHanCharResponse, error) {
+	if req.HanChar == nil {
+		return nil, status.Error(codes.InvalidArgument, "han char is required")
+	}
+
+	hanChar, err := s.service.UpdateHanChar(ctx, entity.HanCharID(req.Id), ToHanCharRequest(req.HanChar))
+	if err != nil {
+		return nil, toStatusError(err, "failed to update han char")
+	}
+	return &pb.VocabularyServiceUpdateHanCharResponse{
+		HanChar: ToProtoHanChar(hanChar),
+	}, nil
+}
+
+// DeleteHanChar 删除汉字
+func (s *VocabularyService) DeleteHanChar(ctx context.Context, req *pb.VocabularyServiceDeleteHanCharRequest) (*pb.VocabularyServiceDeleteHanCharResponse, error) {
+	if err := s.service.DeleteHanChar(ctx, entity.HanCharID(req.Id)); err != nil {
+		return nil, toStatusError(err, "failed to delete han char")
+	}
+	return &pb.VocabularyServiceDeleteHanCharResponse{}, nil
+}
+
 // BatchCreate 批量创建英文单词
 func (s *VocabularyService) BatchCreate(ctx context.Context, req *pb.VocabularyServiceBatchCreateRequest) (*pb.VocabularyServiceBatchCreateResponse, error) {
 	var words []dto.BatchCreateWordRequest
@@ -141,7 +304,9 @@ func (s *VocabularyService) BatchCreate(ctx context.Context, req *pb.VocabularyS
 	}
 
 	err := s.service.BatchCreateWords(ctx, words)
-	if err != nil {
+	if errors.Is(err, domainErrors.ErrPermissionDenied) {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	} else if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
@@ -150,36 +315,14 @@ func (s *VocabularyService) BatchCreate(ctx context.Context, req *pb.VocabularyS
 
 // BatchCreateHanChar 批量创建汉字
 func (s *VocabularyService) BatchCreateHanChar(ctx context.Context, req *pb.VocabularyServiceBatchCreateHanCharRequest) (*pb.VocabularyServiceBatchCreateHanCharResponse, error) {
-	var hanChars []struct {
-		Character  string
-		Pinyin     string
-		Level      valueobject.WordDifficultyLevel
-		Tags       []string
-		Categories []string
-		Examples   []string
-	}
-
-	for _, hanCharPb := range req.HanChars {
-		hanChars = append(hanChars, struct {
-			Character  string
-			Pinyin     string
-			Level      valueobject.WordDifficultyLevel
-			Tags       []string
-			Categories []string
-			Examples   []string
-		}{
-			Character:  hanCharPb.Character,
-			Pinyin:     hanCharPb.Pinyin,
-			Level:      ConvertLevelToValueObject(hanCharPb.Level),
-			Tags:       hanCharPb.Tags,
-			Categories: hanCharPb.Categories,
-			Examples:   hanCharPb.Examples,
-		})
+	hanChars := make([]*dto.HanCharRequest, len(req.HanChars))
+	for i, hanCharPb := range req.HanChars {
+		hanChars[i] = ToHanCharRequest(hanCharPb)
 	}
 
 	ids, err := s.service.BatchCreateHanChars(ctx, hanChars)
 	if err != nil {
-		return nil, err
+		return nil, toStatusError(err, "failed to create han chars")
 	}
 
 	// 转换 []uint 为 []uint32
@@ -192,3 +335,140 @@ func (s *VocabularyService) BatchCreateHanChar(ctx context.Context, req *pb.Voca
 		Ids: uint32Ids,
 	}, nil
 }
+
+// BatchImportHanCharStrokes 批量导入汉字笔顺数据
+func (s *VocabularyService) BatchImportHanCharStrokes(ctx context.Context, req *pb.VocabularyServiceBatchImportHanCharStrokesRequest) (*pb.VocabularyServiceBatchImportHanCharStrokesResponse, error) {
+	items := make([]*dto.HanCharStrokesRequest, len(req.HanChars))
+	for i, data := range req.HanChars {
+		items[i] = ToHanCharStrokesRequest(data)
+	}
+
+	result, err := s.service.ImportHanCharStrokes(ctx, items)
+	if err != nil {
+		return nil, toStatusError(err, "failed to import han char strokes")
+	}
+	return &pb.VocabularyServiceBatchImportHanCharStrokesResponse{
+		Imported: uint32(result.Imported),
+		Missing:  result.Missing,
+	}, nil
+}
+
+// BatchImportWordForms 批量导入单词的屈折变化形式
+func (s *VocabularyService) BatchImportWordForms(ctx context.Context, req *pb.VocabularyServiceBatchImportWordFormsRequest) (*pb.VocabularyServiceBatchImportWordFormsResponse, error) {
+	result, err := s.service.ImportWordForms(ctx, ToImportWordFormsRequest(req))
+	if err != nil {
+		return nil, toStatusError(err, "failed to import word forms")
+	}
+	return &pb.VocabularyServiceBatchImportWordFormsResponse{
+		Imported: uint32(result.Imported),
+		Missing:  result.Missing,
+	}, nil
+}
+
+// GetRelations 获取单词的关联单词
+func (s *VocabularyService) GetRelations(ctx context.Context, req *pb.VocabularyServiceGetRelationsRequest) (*pb.VocabularyServiceGetRelationsResponse, error) {
+	word, neighbors, err := s.service.GetWordRelations(ctx, entity.WordID(req.Id), ToLexicalRelationTypes(req.Types))
+	if err != nil {
+		return nil, toStatusError(err, "failed to get word relations")
+	}
+
+	relations := make([]*pb.WordRelation, len(neighbors))
+	for i, neighbor := range neighbors {
+		relations[i] = ToProtoWordRelation(neighbor)
+	}
+	return &pb.VocabularyServiceGetRelationsResponse{
+		Word:      ToProtoWord(word),
+		Relations: relations,
+	}, nil
+}
+
+// CreateRelation 创建两个单词之间的关联
+func (s *VocabularyService) CreateRelation(ctx context.Context, req *pb.VocabularyServiceCreateRelationRequest) (*pb.VocabularyServiceCreateRelationResponse, error) {
+	neighbor, err := s.service.CreateWordRelation(ctx, entity.WordID(req.SourceId), entity.WordID(req.TargetId), entity.LexicalRelationType(req.Type), req.Note)
+	if err != nil {
+		return nil, toStatusError(err, "failed to create word relation")
+	}
+	return &pb.VocabularyServiceCreateRelationResponse{
+		Relation: ToProtoWordRelation(neighbor),
+	}, nil
+}
+
+// DeleteRelation 删除单词关联
+func (s *VocabularyService) DeleteRelation(ctx context.Context, req *pb.VocabularyServiceDeleteRelationRequest) (*pb.VocabularyServiceDeleteRelationResponse, error) {
+	if err := s.service.DeleteWordRelation(ctx, entity.WordRelationID(req.Id)); err != nil {
+		return nil, toStatusError(err, "failed to delete word relation")
+	}
+	return &pb.VocabularyServiceDeleteRelationResponse{}, nil
+}
+
+// GetHanCharRelations 获取汉字的关联汉字
+func (s *VocabularyService) GetHanCharRelations(ctx context.Context, req *pb.VocabularyServiceGetHanCharRelationsRequest) (*pb.VocabularyServiceGetHanCharRelationsResponse, error) {
+	hanChar, neighbors, err := s.service.GetHanCharRelations(ctx, entity.HanCharID(req.Id), ToLexicalRelationTypes(req.Types))
+	if err != nil {
+		return nil, toStatusError(err, "failed to get han char relations")
+	}
+
+	relations := make([]*pb.HanCharRelation, len(neighbors))
+	for i, neighbor := range neighbors {
+		relations[i] = ToProtoHanCharRelation(neighbor)
+	}
+	return &pb.VocabularyServiceGetHanCharRelationsResponse{
+		HanChar:   ToProtoHanChar(hanChar),
+		Relations: relations,
+	}, nil
+}
+
+// CreateHanCharRelation 创建两个汉字之间的关联
+func (s *VocabularyService) CreateHanCharRelation(ctx context.Context, req *pb.VocabularyServiceCreateHanCharRelationRequest) (*pb.VocabularyServiceCreateHanCharRelationResponse, error) {
+	neighbor, err := s.service.CreateHanCharRelation(ctx, entity.HanCharID(req.SourceId), entity.HanCharID(req.TargetId), entity.LexicalRelationType(req.Type), req.Note)
+	if err != nil {
+		return nil, toStatusError(err, "failed to create han char relation")
+	}
+	return &pb.VocabularyServiceCreateHanCharRelationResponse{
+		Relation: ToProtoHanCharRelation(neighbor),
+	}, nil
+}
+
+// DeleteHanCharRelation 删除汉字关联
+func (s *VocabularyService) DeleteHanCharRelation(ctx context.Context, req *pb.VocabularyServiceDeleteHanCharRelationRequest) (*pb.VocabularyServiceDeleteHanCharRelationResponse, error) {
+	if err := s.service.DeleteHanCharRelation(ctx, entity.HanCharRelationID(req.Id)); err != nil {
+		return nil, toStatusError(err, "failed to delete han char relation")
+	}
+	return &pb.VocabularyServiceDeleteHanCharRelationResponse{}, nil
+}
+
+// BatchImportRelations 批量导入单词关联和汉字关联
+func (s *VocabularyService) BatchImportRelations(ctx context.Context, req *pb.VocabularyServiceBatchImportRelationsRequest) (*pb.VocabularyServiceBatchImportRelationsResponse, error) {
+	result, err := s.service.ImportRelations(ctx, ToImportRelationsRequest(req))
+	if err != nil {
+		return nil, toStatusError(err, "failed to import relations")
+	}
+	return &pb.VocabularyServiceBatchImportRelationsResponse{
+		Created: uint32(result.Created),
+		Skipped: uint32(result.Skipped),
+		Missing: result.Missing,
+	}, nil
+}
+
+// toStatusError 将领域错误转换为 gRPC 状态错误，无法识别的错误原样返回
+func toStatusError(err error, message string) error {
+	if errors.Is(err, domainErrors.ErrInvalidWord) {
+		return status.Errorf(codes.InvalidArgument, "%s: %v", message, err)
+	}
+
+	var domainErr *domainErrors.Error
+	if !errors.As(err, &domainErr) {
+		return err
+	}
+	switch domainErr.Code {
+	case domainErrors.CodeInvalidArgument, domainErrors.CodeEmptyWordText, domainErrors.CodeEmptyDefinition:
+		return status.Errorf(codes.InvalidArgument, "%s: %v", message, err)
+	case domainErrors.CodeNotFound, domainErrors.CodeWordNotFound:
+		return status.Errorf(codes.NotFound, "%s: %v", message, err)
+	case domainErrors.CodeWordAlreadyExists, domainErrors.CodeHanCharAlreadyExists, domainErrors.CodeAlreadyExists:
+		return status.Errorf(codes.AlreadyExists, "%s: %v", message, err)
+	case domainErrors.CodePermissionDenied:
+		return status.Errorf(codes.PermissionDenied, "%s: %v", message, err)
+	}
+	return err
+}
@@ -2,69 +2,59 @@ package word
 
 import (
 	"context"
+	"errors"
 
 	pb "github.com/lazyjean/sla2/api/proto/v1"
 	"github.com/lazyjean/sla2/internal/application/service"
+	"github.com/lazyjean/sla2/internal/domain/entity"
+	domainErrors "github.com/lazyjean/sla2/internal/domain/errors"
+	"github.com/lazyjean/sla2/internal/domain/valueobject"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// WordService 旧版单词服务，保留 /api/v1/words 接口以兼容旧客户端，新功能请使用 VocabularyService
 type WordService struct {
 	pb.UnimplementedWordServiceServer
-	wordService *service.WordService
+	vocabularyService *service.VocabularyService
 }
 
-func NewWordService(wordService *service.WordService) *WordService {
+// NewWordService 创建旧版单词服务实例
+func NewWordService(vocabularyService *service.VocabularyService) *WordService {
 	return &WordService{
-		wordService: wordService,
+		vocabularyService: vocabularyService,
 	}
 }
 
-func (s *WordService) GetWord(ctx context.Context, req *pb.WordServiceGetRequest) (*pb.WordServiceGetResponse, error) {
-	word, err := s.wordService.GetWord(ctx, uint(req.WordId))
-	if err != nil {
+// Get 获取单词详情
+func (s *WordService) Get(ctx context.Context, req *pb.WordServiceGetRequest) (*pb.WordServiceGetResponse, error) {
+	word, err := s.vocabularyService.GetWord(ctx, uint(req.WordId))
+	if errors.Is(err, domainErrors.ErrWordNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	} else if err != nil {
 		return nil, err
 	}
 
-	var definitions []string
-	for _, def := range word.Definitions {
-		definitions = append(definitions, def.Meaning)
-	}
-
 	return &pb.WordServiceGetResponse{
-		Word: &pb.WordInfo{
-			Id:            uint32(word.ID),
-			Spelling:      word.Text,
-			Pronunciation: word.Phonetic,
-			Definitions:   definitions,
-			Examples:      word.Examples,
-			CreatedAt:     timestamppb.New(word.CreatedAt),
-			UpdatedAt:     timestamppb.New(word.UpdatedAt),
-		},
+		Word: toWordInfo(word),
 	}, nil
 }
 
-func (s *WordService) ListWords(ctx context.Context, req *pb.WordServiceListRequest) (*pb.WordServiceListResponse, error) {
-	words, total, err := s.wordService.ListWords(ctx, 0, int(req.Page)*int(req.PageSize), int(req.PageSize))
+// List 获取单词列表，页码从 1 开始
+func (s *WordService) List(ctx context.Context, req *pb.WordServiceListRequest) (*pb.WordServiceListResponse, error) {
+	page := int(req.Page)
+	if page < 1 {
+		page = 1
+	}
+	words, total, err := s.vocabularyService.ListWords(ctx, page, int(req.PageSize), valueobject.WORD_DIFFICULTY_LEVEL_UNSPECIFIED, nil, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	var pbWords []*pb.WordInfo
-	for _, word := range words {
-		var definitions []string
-		for _, def := range word.Definitions {
-			definitions = append(definitions, def.Meaning)
-		}
-
-		pbWords = append(pbWords, &pb.WordInfo{
-			Id:            uint32(word.ID),
-			Spelling:      word.Text,
-			Pronunciation: word.Phonetic,
-			Definitions:   definitions,
-			Examples:      word.Examples,
-			CreatedAt:     timestamppb.New(word.CreatedAt),
-			UpdatedAt:     timestamppb.New(word.UpdatedAt),
-		})
+	pbWords := make([]*pb.WordInfo, len(words))
+	for i, word := range words {
+		pbWords[i] = toWordInfo(word)
 	}
 
 	return &pb.WordServiceListResponse{
@@ -72,3 +62,21 @@ func (s *WordService) ListWords(ctx context.Context, req *pb.WordServiceListRequ
 		Total: uint32(total),
 	}, nil
 }
+
+// toWordInfo 将单词实体转换为旧版单词信息
+func toWordInfo(word *entity.Word) *pb.WordInfo {
+	var definitions []string
+	for _, def := range word.Definitions {
+		definitions = append(definitions, def.Meaning)
+	}
+
+	return &pb.WordInfo{
+		Id:            uint32(word.ID),
+		Spelling:      word.Text,
+		Pronunciation: word.Phonetic,
+		Definitions:   definitions,
+		Examples:      word.Examples,
+		CreatedAt:     timestamppb.New(word.CreatedAt),
+		UpdatedAt:     timestamppb.New(word.UpdatedAt),
+	}
+}
@@ -4,32 +4,41 @@ import (
 	"context"
 
 	"github.com/lazyjean/sla2/config"
+	"github.com/lazyjean/sla2/internal/application/service"
 	grpcserver "github.com/lazyjean/sla2/internal/interfaces/grpc"
 )
 
 // Application 应用程序结构体
 type Application struct {
-	config     *config.Config
-	grpcServer *grpcserver.GRPCServer
+	config         *config.Config
+	grpcServer     *grpcserver.GRPCServer
+	reviewPurgeJob *service.MemoryReviewPurgeJob
 }
 
 // NewApplication 创建新的应用程序
 func NewApplication(
 	config *config.Config,
 	grpcServer *grpcserver.GRPCServer,
+	reviewPurgeJob *service.MemoryReviewPurgeJob,
 ) *Application {
 	return &Application{
-		config:     config,
-		grpcServer: grpcServer,
+		config:         config,
+		grpcServer:     grpcServer,
+		reviewPurgeJob: reviewPurgeJob,
 	}
 }
 
 // Start 启动应用程序
 func (a *Application) Start(ctx context.Context) error {
+	// 启动后台任务
+	a.reviewPurgeJob.Start(ctx)
+
 	return a.grpcServer.Start()
 }
 
 // Stop 停止应用程序
 func (a *Application) Stop(ctx context.Context) error {
+	a.reviewPurgeJob.Stop()
+
 	return a.grpcServer.Stop()
 }
@@ -9,6 +9,8 @@ import (
 	"github.com/lazyjean/sla2/internal/application/service"
 	"github.com/lazyjean/sla2/internal/domain/repository"
 	domainsecurity "github.com/lazyjean/sla2/internal/domain/security"
+	domainservice "github.com/lazyjean/sla2/internal/domain/service"
+	"github.com/lazyjean/sla2/internal/infrastructure/cache"
 	"github.com/lazyjean/sla2/internal/infrastructure/cache/redis"
 	"github.com/lazyjean/sla2/internal/infrastructure/oauth"
 	"github.com/lazyjean/sla2/internal/infrastructure/persistence/postgres"
@@ -28,7 +30,7 @@ func ProvideLogger() *zap.Logger {
 // 配置集
 var configSet = wire.NewSet(
 	config.GetConfig,
-	wire.FieldsOf(new(*config.Config), "Database", "Redis", "JWT", "Apple", "RBAC"),
+	wire.FieldsOf(new(*config.Config), "Database", "Redis", "JWT", "Apple", "RBAC", "Scheduler"),
 )
 
 // 数据库集
@@ -39,6 +41,7 @@ var dbSet = wire.NewSet(
 // redis仓库集
 var cacheSet = wire.NewSet(
 	redis.NewRedisCache,
+	cache.NewStudyActivityCache,
 )
 
 // 仓储集
@@ -53,12 +56,23 @@ var repositorySet = wire.NewSet(
 	postgres.NewQuestionTagRepository,
 	postgres.NewQuestionRepository,
 	postgres.NewHanCharRepository,
+	postgres.NewHanCharLearningRepository,
+	postgres.NewHanCharStrokesRepository,
 	postgres.NewMemoryUnitRepository,
+	postgres.NewMemoryReviewRepository,
+	postgres.NewStudySessionRepository,
+	postgres.NewUserSettingsRepository,
+	postgres.NewWordNotebookRepository,
+	postgres.NewWordFormRepository,
+	postgres.NewLexicalRelationRepository,
+	postgres.NewContentRepositories,
+	postgres.NewTransactionManager,
 )
 
 // 服务集
 var serviceSet = wire.NewSet(
 	service.NewVocabularyService,
+	service.NewWordNotebookService,
 	service.NewLearningService,
 	service.NewUserService,
 	service.NewCourseService,
@@ -66,6 +80,13 @@ var serviceSet = wire.NewSet(
 	service.NewQuestionService,
 	service.NewQuestionTagService,
 	service.NewMemoryService,
+	service.NewStudySessionService,
+	service.NewUserSettingsService,
+	provideSchedulerProvider,
+	service.NewMemoryReviewPurgeJob,
+	domainservice.NewMemoryStatsService,
+	service.NewStudyActivityService,
+	service.NewHanCharLearningService,
 )
 
 // provideAdminService 提供管理员服务
@@ -83,6 +104,24 @@ func provideAdminService(
 	)
 }
 
+// provideSchedulerProvider 提供复习调度器
+func provideSchedulerProvider(
+	schedulerConfig *config.SchedulerConfig,
+	userRepo repository.UserRepository,
+	settingsRepo repository.UserSettingsRepository,
+) (*domainservice.SchedulerProvider, error) {
+	return domainservice.NewSchedulerProvider(
+		userRepo,
+		settingsRepo,
+		domainservice.SchedulerName(schedulerConfig.Algorithm),
+		domainservice.SchedulerOptions{
+			DesiredRetention: schedulerConfig.DesiredRetention,
+			MaximumInterval:  schedulerConfig.MaximumInterval,
+			Weights:          schedulerConfig.Weights,
+		},
+	)
+}
+
 // 认证集
 var authSet = wire.NewSet(
 	oauth.NewAppleConfig,
@@ -125,6 +164,7 @@ func InitializeApp() (*Application, error) {
 		NewApplication,
 		configSet,
 		dbSet,
+		cacheSet,
 		repositorySet,
 		serviceSet,
 		authSet,
@@ -12,6 +12,8 @@ import (
 	"github.com/lazyjean/sla2/internal/application/service"
 	"github.com/lazyjean/sla2/internal/domain/repository"
 	security2 "github.com/lazyjean/sla2/internal/domain/security"
+	service2 "github.com/lazyjean/sla2/internal/domain/service"
+	"github.com/lazyjean/sla2/internal/infrastructure/cache"
 	"github.com/lazyjean/sla2/internal/infrastructure/cache/redis"
 	"github.com/lazyjean/sla2/internal/infrastructure/oauth"
 	"github.com/lazyjean/sla2/internal/infrastructure/persistence/postgres"
@@ -38,18 +40,45 @@ func InitializeApp() (*Application, error) {
 	appleConfig := oauth.NewAppleConfig(configConfig)
 	appleAuthService := oauth.NewAppleAuthService(appleConfig)
 	userService := service.NewUserService(userRepository, tokenService, passwordService, appleAuthService)
+	userSettingsRepository := postgres.NewUserSettingsRepository(db)
+	userSettingsService := service.NewUserSettingsService(userSettingsRepository)
 	questionRepository := postgres.NewQuestionRepository(db)
 	questionService := service.NewQuestionService(questionRepository)
 	hanCharRepository := postgres.NewHanCharRepository(db)
 	wordRepository := postgres.NewWordRepository(db)
-	vocabularyService := service.NewVocabularyService(hanCharRepository, wordRepository)
+	hanCharStrokesRepository := postgres.NewHanCharStrokesRepository(db)
+	wordFormRepository := postgres.NewWordFormRepository(db)
+	lexicalRelationRepository := postgres.NewLexicalRelationRepository(db)
+	transactionManager := postgres.NewTransactionManager(db)
+	vocabularyService := service.NewVocabularyService(hanCharRepository, wordRepository, hanCharStrokesRepository, wordFormRepository, lexicalRelationRepository, transactionManager)
+	wordNotebookRepository := postgres.NewWordNotebookRepository(db)
+	wordNotebookService := service.NewWordNotebookService(wordNotebookRepository, wordRepository)
 	courseRepository := postgres.NewCourseRepository(db)
 	courseSectionRepository := postgres.NewCourseSectionRepository(db)
 	courseService := service.NewCourseService(courseRepository, courseSectionRepository)
 	learningRepository := postgres.NewLearningRepository(db)
 	memoryUnitRepository := postgres.NewMemoryUnitRepository(db)
-	memoryService := service.NewMemoryService(wordRepository, memoryUnitRepository, hanCharRepository)
+	contentRepositories := postgres.NewContentRepositories(db)
+	memoryReviewRepository := postgres.NewMemoryReviewRepository(db)
+	studySessionRepository := postgres.NewStudySessionRepository(db)
+	schedulerConfig := &configConfig.Scheduler
+	schedulerProvider, err := provideSchedulerProvider(schedulerConfig, userRepository, userSettingsRepository)
+	if err != nil {
+		return nil, err
+	}
+	redisConfig := &configConfig.Redis
+	cacheCache, err := redis.NewRedisCache(redisConfig)
+	if err != nil {
+		return nil, err
+	}
+	studyActivityCache := cache.NewStudyActivityCache(cacheCache)
+	memoryService := service.NewMemoryService(wordRepository, memoryUnitRepository, contentRepositories, memoryReviewRepository, studySessionRepository, userSettingsRepository, transactionManager, schedulerProvider, studyActivityCache, courseRepository)
 	learningService := service.NewLearningService(learningRepository, memoryService)
+	studySessionService := service.NewStudySessionService(memoryUnitRepository, memoryReviewRepository, studySessionRepository, contentRepositories, courseRepository, userSettingsRepository, wordNotebookRepository)
+	memoryStatsService := service2.NewMemoryStatsService(memoryUnitRepository, memoryReviewRepository, userSettingsRepository)
+	studyActivityService := service.NewStudyActivityService(memoryStatsService, userSettingsRepository, studyActivityCache)
+	hanCharLearningRepository := postgres.NewHanCharLearningRepository(db)
+	hanCharLearningService := service.NewHanCharLearningService(hanCharRepository, hanCharLearningRepository, memoryUnitRepository, hanCharStrokesRepository, contentRepositories, memoryService)
 	adminRepository := postgres.NewAdminRepository(db)
 	rbacConfig := &configConfig.RBAC
 	rbacProvider, err := security2.NewRBACProvider(db, rbacConfig)
@@ -59,8 +88,9 @@ func InitializeApp() (*Application, error) {
 	permissionHelper := rbacProvider.PermissionHelper
 	adminService := provideAdminService(adminRepository, passwordService, tokenService, permissionHelper)
 	webSocketHandler := handler.NewWebSocketHandler()
-	grpcServer := grpc.NewGRPCServer(userService, questionService, vocabularyService, courseService, learningService, memoryService, adminService, webSocketHandler, tokenService)
-	application := NewApplication(configConfig, grpcServer)
+	grpcServer := grpc.NewGRPCServer(userService, userSettingsService, questionService, vocabularyService, wordNotebookService, courseService, learningService, memoryService, studySessionService, studyActivityService, hanCharLearningService, adminService, webSocketHandler, tokenService)
+	memoryReviewPurgeJob := service.NewMemoryReviewPurgeJob(memoryReviewRepository)
+	application := NewApplication(configConfig, grpcServer, memoryReviewPurgeJob)
 	return application, nil
 }
 
@@ -73,19 +103,19 @@ func ProvideLogger() *zap.Logger {
 }
 
 // 配置集
-var configSet = wire.NewSet(config.GetConfig, wire.FieldsOf(new(*config.Config), "Database", "Redis", "JWT", "Apple", "RBAC"))
+var configSet = wire.NewSet(config.GetConfig, wire.FieldsOf(new(*config.Config), "Database", "Redis", "JWT", "Apple", "RBAC", "Scheduler"))
 
 // 数据库集
 var dbSet = wire.NewSet(postgres.NewDB)
 
 // redis仓库集
-var cacheSet = wire.NewSet(redis.NewRedisCache)
+var cacheSet = wire.NewSet(redis.NewRedisCache, cache.NewStudyActivityCache)
 
 // 仓储集
-var repositorySet = wire.NewSet(postgres.NewWordRepository, postgres.NewCachedWordRepository, postgres.NewLearningRepository, postgres.NewUserRepository, postgres.NewCourseRepository, postgres.NewCourseSectionRepository, postgres.NewAdminRepository, postgres.NewQuestionTagRepository, postgres.NewQuestionRepository, postgres.NewHanCharRepository, postgres.NewMemoryUnitRepository)
+var repositorySet = wire.NewSet(postgres.NewWordRepository, postgres.NewCachedWordRepository, postgres.NewLearningRepository, postgres.NewUserRepository, postgres.NewCourseRepository, postgres.NewCourseSectionRepository, postgres.NewAdminRepository, postgres.NewQuestionTagRepository, postgres.NewQuestionRepository, postgres.NewHanCharRepository, postgres.NewHanCharLearningRepository, postgres.NewHanCharStrokesRepository, postgres.NewMemoryUnitRepository, postgres.NewMemoryReviewRepository, postgres.NewStudySessionRepository, postgres.NewUserSettingsRepository, postgres.NewWordNotebookRepository, postgres.NewWordFormRepository, postgres.NewLexicalRelationRepository, postgres.NewContentRepositories, postgres.NewTransactionManager)
 
 // 服务集
-var serviceSet = wire.NewSet(service.NewVocabularyService, service.NewLearningService, service.NewUserService, service.NewCourseService, provideAdminService, service.NewQuestionService, service.NewQuestionTagService, service.NewMemoryService)
+var serviceSet = wire.NewSet(service.NewVocabularyService, service.NewWordNotebookService, service.NewLearningService, service.NewUserService, service.NewCourseService, provideAdminService, service.NewQuestionService, service.NewQuestionTagService, service.NewMemoryService, service.NewStudySessionService, service.NewUserSettingsService, provideSchedulerProvider, service.NewMemoryReviewPurgeJob, service2.NewMemoryStatsService, service.NewStudyActivityService, service.NewHanCharLearningService)
 
 // provideAdminService 提供管理员服务
 func provideAdminService(
@@ -102,6 +132,22 @@ func provideAdminService(
 	)
 }
 
+// provideSchedulerProvider 提供复习调度器
+func provideSchedulerProvider(
+	schedulerConfig *config.SchedulerConfig,
+	userRepo repository.UserRepository,
+	settingsRepo repository.UserSettingsRepository,
+) (*service2.SchedulerProvider, error) {
+	return service2.NewSchedulerProvider(
+		userRepo,
+		settingsRepo, service2.SchedulerName(schedulerConfig.Algorithm), service2.SchedulerOptions{
+			DesiredRetention: schedulerConfig.DesiredRetention,
+			MaximumInterval:  schedulerConfig.MaximumInterval,
+			Weights:          schedulerConfig.Weights,
+		},
+	)
+}
+
 // 认证集
 var authSet = wire.NewSet(oauth.NewAppleConfig, oauth.NewAppleAuthService)
 
@@ -8,6 +8,7 @@ import (
 	"syscall"
 
 	"github.com/lazyjean/sla2/config"
+	"github.com/lazyjean/sla2/internal/interfaces/cli"
 	"github.com/lazyjean/sla2/internal/wire"
 	"github.com/lazyjean/sla2/pkg/banner"
 	"github.com/lazyjean/sla2/pkg/logger"
@@ -34,9 +35,6 @@ import (
 // @description               Basic authentication for Swagger UI
 
 func main() {
-	// 显示启动 banner
-	banner.PrintBanner("1.0.0")
-
 	// 初始化配置
 	if err := config.InitConfig(); err != nil {
 		fmt.Printf("Failed to initialize config: %v\n", err)
@@ -50,6 +48,18 @@ func main() {
 		os.Exit(1)
 	}
 
+	// 复习调度模拟子命令
+	if len(os.Args) > 1 && os.Args[1] == cli.SchedulerSimCommand {
+		if err := cli.RunSchedulerSim(context.Background(), cfg, os.Args[2:], os.Stdout); err != nil {
+			fmt.Printf("%s: %v\n", cli.SchedulerSimCommand, err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	// 显示启动 banner
+	banner.PrintBanner("1.0.0")
+
 	// 创建上下文
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -0,0 +1,58 @@
+// Package morphology 解析开放的英语词形表，将屈折变化形式按原形分组
+package morphology
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Lemma 一个原形及其屈折变化形式
+type Lemma struct {
+	Text  string   // 原形
+	Forms []string // 词形，按在词形表中首次出现的顺序，不含原形本身
+}
+
+// ParseFormList 解析“原形<TAB>词形”格式的词形表，如 lemmatization-lists 的 lemmatization-en.txt
+// 每行一个词形，忽略空行和以 # 开头的注释行，多于两列时忽略其余列；
+// 原形和词形去掉首尾空白，词形转为小写，结果按原形首次出现的顺序排列
+func ParseFormList(r io.Reader) ([]*Lemma, error) {
+	var lemmas []*Lemma
+	index := make(map[string]*Lemma)
+	seen := make(map[string]map[string]struct{})
+
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		columns := strings.Split(text, "\t")
+		if len(columns) < 2 {
+			return nil, fmt.Errorf("line %d: expected lemma and form separated by a tab", line)
+		}
+		lemmaText := strings.TrimSpace(columns[0])
+		form := strings.ToLower(strings.TrimSpace(columns[1]))
+		if lemmaText == "" || form == "" {
+			return nil, fmt.Errorf("line %d: empty lemma or form", line)
+		}
+
+		lemma, ok := index[lemmaText]
+		if !ok {
+			lemma = &Lemma{Text: lemmaText}
+			index[lemmaText] = lemma
+			seen[lemmaText] = make(map[string]struct{})
+			lemmas = append(lemmas, lemma)
+		}
+		if _, ok := seen[lemmaText][form]; ok || form == strings.ToLower(lemmaText) {
+			continue
+		}
+		seen[lemmaText][form] = struct{}{}
+		lemma.Forms = append(lemma.Forms, form)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return lemmas, nil
+}
@@ -0,0 +1,34 @@
+package morphology
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFormList(t *testing.T) {
+	list := strings.Join([]string{
+		"# lemmatization-en",
+		"run\tran",
+		"run\tRunning",
+		"",
+		"child\tchildren",
+		"run\truns",
+		"run\tran",
+		"run\trun",
+		"be\tis\textra",
+	}, "\n")
+
+	lemmas, err := ParseFormList(strings.NewReader(list))
+	require.NoError(t, err)
+	assert.Equal(t, []*Lemma{
+		{Text: "run", Forms: []string{"ran", "running", "runs"}},
+		{Text: "child", Forms: []string{"children"}},
+		{Text: "be", Forms: []string{"is"}},
+	}, lemmas)
+
+	_, err = ParseFormList(strings.NewReader("run ran"))
+	assert.EqualError(t, err, "line 1: expected lemma and form separated by a tab")
+}
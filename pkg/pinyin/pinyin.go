@@ -0,0 +1,261 @@
+// Package pinyin 提供汉语拼音的格式转换：声调符号与数字标调互转、ü/v 处理和去掉声调
+package pinyin
+
+import (
+	"strings"
+	"unicode"
+)
+
+// toneMarks 各韵母的一到四声
+var toneMarks = map[rune][4]rune{
+	'a': {'ā', 'á', 'ǎ', 'à'},
+	'e': {'ē', 'é', 'ě', 'è'},
+	'i': {'ī', 'í', 'ǐ', 'ì'},
+	'o': {'ō', 'ó', 'ǒ', 'ò'},
+	'u': {'ū', 'ú', 'ǔ', 'ù'},
+	'ü': {'ǖ', 'ǘ', 'ǚ', 'ǜ'},
+}
+
+// markedVowel 带声调的韵母对应的无声调韵母和声调
+type markedVowel struct {
+	base rune
+	tone int
+}
+
+// markedVowels 带声调的韵母（含大写）对应的无声调韵母和声调
+var markedVowels = func() map[rune]markedVowel {
+	vowels := make(map[rune]markedVowel)
+	for base, marks := range toneMarks {
+		for i, mark := range marks {
+			vowels[mark] = markedVowel{base: base, tone: i + 1}
+			vowels[unicode.ToUpper(mark)] = markedVowel{base: base, tone: i + 1}
+		}
+	}
+	return vowels
+}()
+
+// Syllable 一个拼音音节
+type Syllable struct {
+	// Letters 不带声调的字母，ü 保持为 ü
+	Letters string
+	// Tone 声调 1-4，0 表示轻声或未标调
+	Tone int
+}
+
+// Numbered 数字标调的写法，轻声不加数字，如 ma3
+func (s Syllable) Numbered() string {
+	if s.Tone == 0 {
+		return s.Letters
+	}
+	return s.Letters + string(rune('0'+s.Tone))
+}
+
+// Marked 声调符号的写法，如 mǎ
+// 声调标在 a、e 上，ou 标在 o 上，其余标在最后一个韵母上
+func (s Syllable) Marked() string {
+	if s.Tone == 0 {
+		return s.Letters
+	}
+	runes := []rune(s.Letters)
+	index := -1
+	for i, r := range runes {
+		if r == 'a' || r == 'e' || (r == 'o' && i+1 < len(runes) && runes[i+1] == 'u') {
+			index = i
+			break
+		}
+	}
+	if index < 0 {
+		for i := len(runes) - 1; i >= 0; i-- {
+			if _, ok := toneMarks[runes[i]]; ok {
+				index = i
+				break
+			}
+		}
+	}
+	if index < 0 {
+		return s.Letters
+	}
+	runes[index] = toneMarks[runes[index]][s.Tone-1]
+	return string(runes)
+}
+
+// Parse 把任意写法的拼音拆分为音节
+// 支持声调符号（nǐ hǎo、nǐhǎo）、数字标调（ni3 hao3、ni3hao3，5 和 0 表示轻声）和不标调的写法，
+// v 和 u: 视为 ü，大小写不敏感；空格、隔音符号等非字母字符视为音节分隔
+// 没有分隔也没有声调的连续字母无法可靠切分，作为一个音节
+func Parse(s string) []Syllable {
+	runes := []rune(strings.ReplaceAll(strings.ToLower(s), "u:", "ü"))
+
+	var syllables []Syllable
+	var letters []rune
+	tone := 0
+	marked := false    // 当前音节已读到带声调符号的韵母
+	finalDone := false // 带声调的音节已读到韵尾 n、ng 或儿化的 r
+	flush := func() {
+		if len(letters) > 0 {
+			syllables = append(syllables, Syllable{Letters: string(letters), Tone: tone})
+		}
+		letters, tone, marked, finalDone = letters[:0:0], 0, false, false
+	}
+
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		if r == 'v' {
+			r = 'ü'
+		}
+		if v, ok := markedVowels[r]; ok {
+			if marked {
+				flush()
+			}
+			letters = append(letters, v.base)
+			tone, marked = v.tone, true
+			continue
+		}
+		switch {
+		case r >= '0' && r <= '5':
+			if len(letters) > 0 {
+				if r >= '1' && r <= '4' {
+					tone = int(r - '0')
+				} else {
+					tone = 0
+				}
+			}
+			flush()
+		case isLetter(r):
+			if !marked {
+				letters = append(letters, r)
+				continue
+			}
+			// 声调符号之后的字母：韵母和韵尾属于当前音节，其余字母开始新音节
+			next := rune(0)
+			if i+1 < len(runes) {
+				next = runes[i+1]
+			}
+			switch {
+			case isVowel(r) && !finalDone:
+				letters = append(letters, r)
+			case r == 'n' && next == 'g' && !finalDone && (i+2 >= len(runes) || !isVowel(runes[i+2])):
+				letters = append(letters, 'n', 'g')
+				finalDone = true
+				i++
+			case r == 'n' && !finalDone && !isVowel(next):
+				letters = append(letters, r)
+				finalDone = true
+			case r == 'r' && letters[len(letters)-1] != 'r' && !isVowel(next):
+				// 儿化韵可以跟在韵尾之后，如 diǎnr
+				letters = append(letters, r)
+				finalDone = true
+			default:
+				flush()
+				letters = append(letters, r)
+			}
+		default:
+			flush()
+		}
+	}
+	flush()
+	return syllables
+}
+
+// Normalize 统一为紧凑的数字标调写法，用于检索，如 “Nǐ hǎo”、“ni3 hao3” 都转换为 ni3hao3
+func Normalize(s string) string {
+	var b strings.Builder
+	for _, syllable := range Parse(s) {
+		b.WriteString(syllable.Numbered())
+	}
+	return b.String()
+}
+
+// Toneless 统一为紧凑的不带声调写法，用于忽略声调的检索，如 “nǐ hǎo” 转换为 nihao
+func Toneless(s string) string {
+	var b strings.Builder
+	for _, syllable := range Parse(s) {
+		b.WriteString(syllable.Letters)
+	}
+	return b.String()
+}
+
+// ToNumbered 转换为以空格分隔音节的数字标调写法，如 “nǐhǎo” 转换为 ni3 hao3
+func ToNumbered(s string) string {
+	syllables := Parse(s)
+	parts := make([]string, len(syllables))
+	for i, syllable := range syllables {
+		parts[i] = syllable.Numbered()
+	}
+	return strings.Join(parts, " ")
+}
+
+// ToMarked 转换为以空格分隔音节的声调符号写法，如 “ni3hao3” 转换为 nǐ hǎo
+func ToMarked(s string) string {
+	syllables := Parse(s)
+	parts := make([]string, len(syllables))
+	for i, syllable := range syllables {
+		parts[i] = syllable.Marked()
+	}
+	return strings.Join(parts, " ")
+}
+
+// HasTone 判断拼音是否标注了声调（声调符号或数字）
+func HasTone(s string) bool {
+	for _, syllable := range Parse(s) {
+		if syllable.Tone > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// IsPinyin 判断字符串是否可能是拼音：只包含拉丁字母、带声调的韵母、ü、数字和分隔符，且至少有一个字母
+func IsPinyin(s string) bool {
+	hasLetter := false
+	for _, r := range strings.ToLower(s) {
+		switch {
+		case isLetter(r):
+			hasLetter = true
+		case r >= '0' && r <= '9', r < unicode.MaxASCII && (unicode.IsSpace(r) || unicode.IsPunct(r)):
+		default:
+			if _, ok := markedVowels[r]; !ok {
+				return false
+			}
+			hasLetter = true
+		}
+	}
+	return hasLetter
+}
+
+// ToneVariants 生成同一音节其他声调（含轻声）的声调符号写法，拼音不带声调符号时返回 nil
+func ToneVariants(s string) []string {
+	runes := []rune(s)
+	for i, r := range runes {
+		v, ok := markedVowels[r]
+		if !ok {
+			continue
+		}
+		marks := toneMarks[v.base]
+		variants := make([]string, 0, len(marks))
+		for _, mark := range append(marks[:], v.base) {
+			if mark == r {
+				continue
+			}
+			runes[i] = mark
+			variants = append(variants, string(runes))
+		}
+		return variants
+	}
+	return nil
+}
+
+// isLetter 判断是否为拼音中的字母（不含带声调的韵母）
+func isLetter(r rune) bool {
+	return (r >= 'a' && r <= 'z') || r == 'ü'
+}
+
+// isVowel 判断是否为韵母字母
+func isVowel(r rune) bool {
+	switch r {
+	case 'a', 'e', 'i', 'o', 'u', 'ü', 'v':
+		return true
+	}
+	_, ok := markedVowels[r]
+	return ok
+}
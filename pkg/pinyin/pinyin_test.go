@@ -0,0 +1,64 @@
+package pinyin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConvert(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		numbered string
+		marked   string
+		toneless string
+	}{
+		{"声调符号", "shān", "shan1", "shān", "shan"},
+		{"数字标调", "ma3", "ma3", "mǎ", "ma"},
+		{"不标调", "ma", "ma", "ma", "ma"},
+		{"轻声", "ma5", "ma", "ma", "ma"},
+		{"v 表示 ü", "lv4", "lü4", "lǜ", "lü"},
+		{"u: 表示 ü", "nu:3", "nü3", "nǚ", "nü"},
+		{"声调标在 e 上", "lüè", "lüe4", "lüè", "lüe"},
+		{"ou 标在 o 上", "dou4", "dou4", "dòu", "dou"},
+		{"iu 标在 u 上", "liu2", "liu2", "liú", "liu"},
+		{"大小写", "Nǐ Hǎo", "ni3 hao3", "nǐ hǎo", "nihao"},
+		{"连写的声调符号", "nǐhǎo", "ni3 hao3", "nǐ hǎo", "nihao"},
+		{"连写的数字标调", "zhong1guo2", "zhong1 guo2", "zhōng guó", "zhongguo"},
+		{"连写的韵尾 ng", "Zhōngguó", "zhong1 guo2", "zhōng guó", "zhongguo"},
+		{"韵尾 n 与隔音符号", "xī'ān", "xi1 an1", "xī ān", "xian"},
+		{"儿化", "yīdiǎnr", "yi1 dianr3", "yī diǎnr", "yidianr"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.numbered, ToNumbered(tt.input))
+			assert.Equal(t, tt.marked, ToMarked(tt.input))
+			assert.Equal(t, tt.toneless, Toneless(tt.input))
+			// 任意写法统一后的检索值相同
+			assert.Equal(t, Normalize(tt.numbered), Normalize(tt.marked))
+		})
+	}
+}
+
+func TestHasTone(t *testing.T) {
+	assert.True(t, HasTone("mǎ"))
+	assert.True(t, HasTone("ma3"))
+	assert.False(t, HasTone("ma"))
+	assert.False(t, HasTone("ma5"))
+}
+
+func TestIsPinyin(t *testing.T) {
+	assert.True(t, IsPinyin("nǐ hǎo"))
+	assert.True(t, IsPinyin("ni3hao3"))
+	assert.True(t, IsPinyin("lv4"))
+	assert.False(t, IsPinyin("你好"))
+	assert.False(t, IsPinyin("123"))
+	assert.False(t, IsPinyin(""))
+}
+
+func TestToneVariants(t *testing.T) {
+	assert.Equal(t, []string{"mā", "mǎ", "mà", "ma"}, ToneVariants("má"))
+	assert.Nil(t, ToneVariants("ma"))
+}